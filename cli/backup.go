@@ -0,0 +1,153 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/util/log"
+
+	"github.com/spf13/cobra"
+)
+
+var backupIncrementalFrom []string
+
+// A backupCmd command backs up databases and tables.
+var backupCmd = &cobra.Command{
+	Use:   "backup [options] <uri> [<database>|<database>.<table>...]",
+	Short: "back up databases and tables",
+	Long: `
+Backs up the specified databases, or tables, to the storage at <uri>, which is
+a directory on the node serving the request unless it has the scheme of
+another storage. All the databases are backed up if none is specified.
+A backup only containing the changes since a previous backup is taken with
+--incremental-from, which lists the previous backups of the chain, starting
+with a full backup.
+`,
+	Run: runBackup,
+}
+
+func runBackup(cmd *cobra.Command, args []string) {
+	if len(args) == 0 {
+		mustUsage(cmd)
+		return
+	}
+	var databases, tables []string
+	for _, name := range args[1:] {
+		if strings.Contains(name, ".") {
+			tables = append(tables, name)
+		} else {
+			databases = append(databases, name)
+		}
+	}
+	targets, err := targetList(databases, tables)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "BACKUP %sTO $1", targets)
+	params := []interface{}{args[0]}
+	if len(backupIncrementalFrom) > 0 {
+		buf.WriteString(" INCREMENTAL FROM ")
+		for i, uri := range backupIncrementalFrom {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			params = append(params, uri)
+			fmt.Fprintf(&buf, "$%d", len(params))
+		}
+	}
+	db := makeSQLClient()
+	if err := runPrettyQuery(db, buf.String(), params...); err != nil {
+		log.Error(err)
+		return
+	}
+}
+
+// A restoreCmd command restores databases and tables.
+var restoreCmd = &cobra.Command{
+	Use:   "restore [options] <uri>...",
+	Short: "restore databases and tables from backups",
+	Long: `
+Restores databases and tables from the chain of backups at the specified URIs:
+a full backup followed by its incremental backups. All the databases of the
+backups are restored, unless --databases or --tables lists the databases,
+or tables, to restore. The restored databases must not exist, while the
+restored tables are added to the existing databases of the same name.
+`,
+	Run: runRestore,
+}
+
+var restoreDatabases, restoreTables []string
+
+func runRestore(cmd *cobra.Command, args []string) {
+	if len(args) == 0 {
+		mustUsage(cmd)
+		return
+	}
+	targets, err := targetList(restoreDatabases, restoreTables)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "RESTORE %sFROM ", targets)
+	params := make([]interface{}, len(args))
+	for i, uri := range args {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		params[i] = uri
+		fmt.Fprintf(&buf, "$%d", i+1)
+	}
+	db := makeSQLClient()
+	if err := runPrettyQuery(db, buf.String(), params...); err != nil {
+		log.Error(err)
+		return
+	}
+}
+
+// targetList returns the target list of a BACKUP or RESTORE statement,
+// followed by a space, for the specified databases or tables. Tables are
+// specified as <database>.<table>.
+func targetList(databases, tables []string) (string, error) {
+	if len(databases) > 0 && len(tables) > 0 {
+		return "", fmt.Errorf("cannot mix databases and tables")
+	}
+	if len(databases) > 0 {
+		quoted := make([]string, len(databases))
+		for i, name := range databases {
+			quoted[i] = parser.Name(name).String()
+		}
+		return fmt.Sprintf("DATABASE %s ", strings.Join(quoted, ", ")), nil
+	}
+	if len(tables) > 0 {
+		quoted := make([]string, len(tables))
+		for i, name := range tables {
+			parts := strings.Split(name, ".")
+			if len(parts) != 2 {
+				return "", fmt.Errorf("invalid table name: %q", name)
+			}
+			quoted[i] = parser.Name(parts[0]).String() + "." + parser.Name(parts[1]).String()
+		}
+		return fmt.Sprintf("TABLE %s ", strings.Join(quoted, ", ")), nil
+	}
+	return "", nil
+}
@@ -74,6 +74,10 @@ func init() {
 		userCmd,
 		rangeCmd,
 		zoneCmd,
+		nodeCmd,
+		backupCmd,
+		restoreCmd,
+		importCmd,
 
 		// Miscellaneous commands.
 		// TODO(pmattis): stats
@@ -422,6 +422,10 @@ Available Commands:
   user        get, set, list and remove users
   range       list, split and merge ranges
   zone        get, set, list and remove zones
+  node        decommission nodes
+  backup      back up databases and tables
+  restore     restore databases and tables from backups
+  import      import a table from CSV files
 
   version     output version information
 
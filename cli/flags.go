@@ -29,6 +29,8 @@ import (
 
 var maxResults int64
 
+var quitDrain bool
+
 // pflagValue wraps flag.Value and implements the extra methods of the
 // pflag.Value interface.
 type pflagValue struct {
@@ -97,6 +99,16 @@ var flagUsage = map[string]string{
 `,
 	"insecure": `
         Run over plain HTTP. WARNING: this is strongly discouraged.
+`,
+	"locality": `
+        An ordered, comma-separated list of key=value tiers describing the
+        failure domains of the node, from the most to the least inclusive.
+        Replicas of a range are spread across as many localities as
+        possible, and requests prefer replicas with a common locality
+        prefix. All nodes should specify the same tiers in the same order.
+        For example:
+
+          --locality=region=us-east,dc=1,rack=3.
 `,
 	"max-offset": `
         The maximum clock offset for the cluster. Clock offset is measured on
@@ -121,17 +133,71 @@ var flagUsage = map[string]string{
 		Adjusts the timeout for stores.  If there's been no gossiped updated
 		from a store after this time, the store is considered unavailable.
         Replicas on an unavailable store will be moved to available ones.
+`,
+	"drain": `
+        Gracefully drain the node before shutting it down: SQL clients are
+        given until --drain-timeout (set on start) to finish their open
+        transactions, and leader leases are transferred to other nodes.
+`,
+	"drain-timeout": `
+        The maximum time a draining node waits for SQL clients to finish
+        their open transactions before closing their connections.
 `,
 	"stores": `
         A comma-separated list of stores, specified by a colon-separated list
         of device attributes followed by '=' and either a filepath for a
         persistent store or an integer size in bytes for an in-memory
-        store. Device attributes typically include whether the store is flash
-        (ssd), spinny disk (hdd), fusion-io (fio), in-memory (mem); device
-        attributes might also include speeds and other specs (7200rpm,
-        200kiops, etc.). For example:
+        store. Prefixing the size with "gomem:" selects the pure-Go
+        in-memory engine instead of RocksDB. Device attributes typically
+        include whether the store is flash (ssd), spinny disk (hdd),
+        fusion-io (fio), in-memory (mem); device attributes might also
+        include speeds and other specs (7200rpm, 200kiops, etc.). For
+        example:
 
           --stores=hdd:7200rpm=/mnt/hda1,ssd=/mnt/ssd01,ssd=/mnt/ssd02,mem=1073741824.
+
+        The filepath of a persistent store may be followed by
+        semicolon-separated encryption options. key=<file> encrypts the
+        store at rest with the AES key in the file, which holds 16, 24 or
+        32 random bytes. old-key=<file>, which may be repeated, names a key
+        the store was previously encrypted with: after a key rotation, the
+        files encrypted with old keys are rewritten in the background. For
+        example:
+
+          --stores='ssd=/mnt/ssd01;key=/keys/new.key;old-key=/keys/old.key'
+
+        The encryption protects the confidentiality of the data, but not its
+        integrity: modified files are not detected. It also slows down the
+        reads and writes of the store.
+`,
+	"incremental-from": `
+        A comma-separated list of the URIs of the backups the backup is
+        incremental to: a full backup followed by its incremental backups.
+`,
+	"databases": `
+        A comma-separated list of the databases to restore.
+`,
+	"tables": `
+        A comma-separated list of the tables to restore, specified as
+        <database>.<table>.
+`,
+	"delimiter": `
+        The character separating the fields of the CSV files. Defaults to
+        ','.
+`,
+	"comment": `
+        The character starting the comment lines of the CSV files.
+`,
+	"nullif": `
+        The value of the fields of the CSV files that are NULL.
+`,
+	"skip": `
+        The number of records skipped at the start of each CSV file, such
+        as a header.
+`,
+	"lazy-quotes": `
+        Allow quotes in the unquoted fields of the CSV files, and
+        non-doubled quotes in their quoted fields.
 `,
 	"max-results": `
         Define the maximum number of results that will be retrieved.
@@ -172,6 +238,7 @@ func initFlags(ctx *server.Context) {
 		// Server flags.
 		f.StringVar(&ctx.Addr, "addr", ctx.Addr, flagUsage["addr"])
 		f.StringVar(&ctx.Attrs, "attrs", ctx.Attrs, flagUsage["attrs"])
+		f.Var(&ctx.Locality, "locality", flagUsage["locality"])
 		f.StringVar(&ctx.Stores, "stores", ctx.Stores, flagUsage["stores"])
 		f.DurationVar(&ctx.MaxOffset, "max-offset", ctx.MaxOffset, flagUsage["max-offset"])
 		f.DurationVar(&ctx.MetricsFrequency, "metrics-frequency", ctx.MetricsFrequency, flagUsage["metrics-frequency"])
@@ -192,6 +259,7 @@ func initFlags(ctx *server.Context) {
 		f.DurationVar(&ctx.ScanInterval, "scan-interval", ctx.ScanInterval, flagUsage["scan-interval"])
 		f.DurationVar(&ctx.ScanMaxIdleTime, "scan-max-idle-time", ctx.ScanMaxIdleTime, flagUsage["scan-max-idle-time"])
 		f.DurationVar(&ctx.TimeUntilStoreDead, "time-until-store-dead", ctx.TimeUntilStoreDead, flagUsage["time-until-store-dead"])
+		f.DurationVar(&ctx.DrainTimeout, "drain-timeout", ctx.DrainTimeout, flagUsage["drain-timeout"])
 
 		if err := startCmd.MarkFlagRequired("gossip"); err != nil {
 			panic(err)
@@ -223,7 +291,8 @@ func initFlags(ctx *server.Context) {
 
 	clientCmds := []*cobra.Command{
 		sqlShellCmd, kvCmd, rangeCmd,
-		userCmd, zoneCmd,
+		userCmd, zoneCmd, nodeCmd,
+		backupCmd, restoreCmd, importCmd,
 		exterminateCmd, quitCmd, /* startCmd is covered above */
 	}
 	for _, cmd := range clientCmds {
@@ -235,6 +304,31 @@ func initFlags(ctx *server.Context) {
 		f.StringVar(&ctx.Certs, "certs", ctx.Certs, flagUsage["certs"])
 	}
 
+	{
+		f := quitCmd.Flags()
+		f.BoolVar(&quitDrain, "drain", false, flagUsage["drain"])
+	}
+
+	{
+		f := backupCmd.Flags()
+		f.StringSliceVar(&backupIncrementalFrom, "incremental-from", nil, flagUsage["incremental-from"])
+	}
+
+	{
+		f := restoreCmd.Flags()
+		f.StringSliceVar(&restoreDatabases, "databases", nil, flagUsage["databases"])
+		f.StringSliceVar(&restoreTables, "tables", nil, flagUsage["tables"])
+	}
+
+	{
+		f := importCmd.Flags()
+		f.StringVar(&importDelimiter, "delimiter", ",", flagUsage["delimiter"])
+		f.StringVar(&importComment, "comment", "", flagUsage["comment"])
+		f.StringVar(&importNullif, "nullif", "", flagUsage["nullif"])
+		f.IntVar(&importSkip, "skip", 0, flagUsage["skip"])
+		f.BoolVar(&importLazyQuotes, "lazy-quotes", false, flagUsage["lazy-quotes"])
+	}
+
 	// Max results flag for scan, reverse scan, and range list.
 	for _, cmd := range []*cobra.Command{scanCmd, reverseScanCmd, lsRangesCmd} {
 		f := cmd.Flags()
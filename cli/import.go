@@ -0,0 +1,91 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/util/log"
+
+	"github.com/spf13/cobra"
+)
+
+var importDelimiter, importComment, importNullif string
+var importSkip int
+var importLazyQuotes bool
+
+// An importCmd command imports a table from CSV files.
+var importCmd = &cobra.Command{
+	Use:   "import [options] <database>.<table> <columns> <uri>...",
+	Short: "import a table from CSV files",
+	Long: `
+Creates the table <database>.<table>, whose column and index definitions are
+listed in <columns> as in CREATE TABLE, and loads its rows from the CSV files
+at the specified URIs. A URI is a file on the node serving the request unless
+it has the scheme of another storage. An interrupted import is resumed by
+running the same command again.
+`,
+	Run: runImport,
+}
+
+func runImport(cmd *cobra.Command, args []string) {
+	if len(args) < 3 {
+		mustUsage(cmd)
+		return
+	}
+	parts := strings.Split(args[0], ".")
+	if len(parts) != 2 {
+		log.Errorf("invalid table name: %q", args[0])
+		return
+	}
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "IMPORT TABLE %s.%s (%s) CSV DATA (",
+		parser.Name(parts[0]), parser.Name(parts[1]), args[1])
+	var params []interface{}
+	for i, uri := range args[2:] {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		params = append(params, uri)
+		fmt.Fprintf(&buf, "$%d", len(params))
+	}
+	buf.WriteString(")")
+
+	var options []string
+	addOption := func(flag, name string, value interface{}) {
+		if cmd.Flags().Changed(flag) {
+			params = append(params, value)
+			options = append(options, fmt.Sprintf("%s = $%d", name, len(params)))
+		}
+	}
+	addOption("delimiter", "delimiter", importDelimiter)
+	addOption("comment", "comment", importComment)
+	addOption("nullif", "nullif", importNullif)
+	addOption("skip", "skip", importSkip)
+	addOption("lazy-quotes", "lazy_quotes", importLazyQuotes)
+	if len(options) > 0 {
+		fmt.Fprintf(&buf, " WITH %s", strings.Join(options, ", "))
+	}
+
+	db := makeSQLClient()
+	if err := runPrettyQuery(db, buf.String(), params...); err != nil {
+		log.Error(err)
+		return
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/client"
+
+	"github.com/spf13/cobra"
+)
+
+// A decommissionNodeCmd command decommissions a node.
+var decommissionNodeCmd = &cobra.Command{
+	Use:   "decommission [options]",
+	Short: "decommission the node",
+	Long: `
+Marks the node at --addr as decommissioning. The cluster moves all replicas
+off of the node's stores, after which it can be shut down using "quit --drain".
+The decommissioning state is persisted, and remains in effect if the node is
+restarted.
+`,
+	Run: runDecommissionNode,
+}
+
+func runDecommissionNode(cmd *cobra.Command, args []string) {
+	if len(args) != 0 {
+		mustUsage(cmd)
+		return
+	}
+	admin := client.NewAdminClient(&context.Context, context.Addr, client.Decommission)
+	body, err := admin.Get()
+	if err != nil {
+		fmt.Printf("decommission node error: %s\n", err)
+		osExit(1)
+		return
+	}
+	fmt.Printf("node decommissioning: %s\n", body)
+}
+
+var nodeCmds = []*cobra.Command{
+	decommissionNodeCmd,
+}
+
+var nodeCmd = &cobra.Command{
+	Use:   "node",
+	Short: "decommission nodes",
+	Run: func(cmd *cobra.Command, args []string) {
+		mustUsage(cmd)
+	},
+}
+
+func init() {
+	nodeCmd.AddCommand(nodeCmds...)
+}
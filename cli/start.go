@@ -220,7 +220,11 @@ func runExterminate(_ *cobra.Command, _ []string) {
 
 	// First attempt to shutdown the server. Note that an error of EOF just
 	// means the HTTP server shutdown before the request to quit returned.
-	admin := client.NewAdminClient(&context.Context, context.Addr, client.Quit)
+	configType := client.Quit
+	if quitDrain {
+		configType = client.Drain
+	}
+	admin := client.NewAdminClient(&context.Context, context.Addr, configType)
 	body, err := admin.Get()
 	if err != nil {
 		log.Infof("shutdown node %s: %s", context.Addr, err)
@@ -246,9 +250,10 @@ var quitCmd = &cobra.Command{
 	Use:   "quit",
 	Short: "drain and shutdown node\n",
 	Long: `
-Shutdown the server. The first stage is drain, where any new requests
-will be ignored by the server. When all extant requests have been
-completed, the server exits.
+Shutdown the server. With --drain, the first stage is drain, where new
+SQL clients are turned away by the server, open transactions are given
+time to finish and leader leases are transferred to other nodes. When
+all extant requests have been completed, the server exits.
 `,
 	Run: runQuit,
 }
@@ -18,7 +18,10 @@
 package cli
 
 import (
+	"fmt"
+
 	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/parser"
 	"github.com/cockroachdb/cockroach/util/log"
 
 	"github.com/spf13/cobra"
@@ -63,7 +66,7 @@ func runLsUsers(cmd *cobra.Command, args []string) {
 		return
 	}
 	db := makeSQLClient()
-	err := runPrettyQuery(db, `SELECT username FROM system.users`)
+	err := runPrettyQuery(db, `SHOW USERS`)
 	if err != nil {
 		log.Error(err)
 		return
@@ -86,7 +89,7 @@ func runRmUser(cmd *cobra.Command, args []string) {
 		return
 	}
 	db := makeSQLClient()
-	err := runPrettyQuery(db, `DELETE FROM system.users WHERE username=$1`, args[0])
+	err := runPrettyQuery(db, fmt.Sprintf(`DROP USER %s`, parser.Name(args[0])))
 	if err != nil {
 		log.Error(err)
 		return
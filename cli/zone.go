@@ -170,20 +170,35 @@ argument: <object-id>) to the contents of the specified file
 
 The zone config format has the following YAML schema:
 
-  replicas:
+  num_replicas: <number of replicas>
+  constraints: [comma-separated constraint list]
+  lease_preferences:
     - attrs: [comma-separated attribute list]
     - attrs:  ...
   range_min_bytes: <size-in-bytes>
   range_max_bytes: <size-in-bytes>
 
+Each constraint is either a store attribute or a node locality tier
+(key=value). Constraints prefixed with "+" are required, those prefixed
+with "-" are prohibited, and all others are preferred but not required.
+Leader leases are placed on the first store matching a lease preference.
+
 For example:
 
+  num_replicas: 3
+  constraints: [+ssd, -region=us-west-1, datacenter=us-east-1a]
+  lease_preferences:
+    - attrs: [us-east-1a]
+  range_min_bytes: 8388608
+  range_max_bytes: 67108864
+
+Zone configs may instead list the required attributes of each replica,
+in which case the number of replicas is the length of the list:
+
   replicas:
     - attrs: [us-east-1a, ssd]
     - attrs: [us-east-1b, ssd]
     - attrs: [us-west-1b, ssd]
-  range_min_bytes: 8388608
-  range_max_bytes: 67108864
 `,
 	Run: runSetZone,
 }
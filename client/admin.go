@@ -35,6 +35,10 @@ const (
 
 	// Quit only handles Get requests.
 	Quit = "quit"
+	// Drain only handles Get requests.
+	Drain = "drain"
+	// Decommission only handles Get requests.
+	Decommission = "decommission"
 )
 
 // AdminClient issues http requests to admin endpoints.
@@ -152,6 +152,17 @@ func (b *Batch) fillResults(br *roachpb.BatchResponse, pErr *roachpb.Error) erro
 						dst.Value = &src.Value
 					}
 				}
+			case *roachpb.ExportRequest:
+				if result.Err == nil {
+					t := reply.(*roachpb.ExportResponse)
+					result.Rows = make([]KeyValue, len(t.Rows))
+					for j := range t.Rows {
+						src := &t.Rows[j]
+						dst := &result.Rows[j]
+						dst.Key = src.Key
+						dst.Value = &src.Value
+					}
+				}
 			case *roachpb.DeleteRequest:
 				row := &result.Rows[k]
 				row.Key = []byte(args.(*roachpb.DeleteRequest).Key)
@@ -170,6 +181,8 @@ func (b *Batch) fillResults(br *roachpb.BatchResponse, pErr *roachpb.Error) erro
 			case *roachpb.MergeRequest:
 			case *roachpb.TruncateLogRequest:
 			case *roachpb.LeaderLeaseRequest:
+			case *roachpb.TransferLeaseRequest:
+			case *roachpb.ClearRangeRequest:
 				// Nothing to do for these methods as they do not generate any
 				// rows.
 
@@ -46,6 +46,14 @@ var DefaultTxnRetryOptions = retry.Options{
 type txnSender Txn
 
 func (ts *txnSender) Send(ctx context.Context, ba roachpb.BatchRequest) (*roachpb.BatchResponse, *roachpb.Error) {
+	// Batches rolling back the transaction are sent regardless of the send
+	// check, so that an interrupted transaction can still be aborted.
+	if ts.sendCheck != nil && !isRollback(ba) {
+		if err := ts.sendCheck(); err != nil {
+			return nil, roachpb.NewError(err)
+		}
+	}
+
 	// Send call through wrapped sender.
 	ba.Txn = &ts.Proto
 	ba.SetNewRequest()
@@ -68,8 +76,9 @@ func (ts *txnSender) Send(ctx context.Context, ba roachpb.BatchRequest) (*roachp
 	} else if abrtErr, ok := err.(*roachpb.TransactionAbortedError); ok {
 		// On Abort, reset the transaction so we start anew on restart.
 		ts.Proto = roachpb.Transaction{
-			Name:      ts.Proto.Name,
-			Isolation: ts.Proto.Isolation,
+			Name:          ts.Proto.Name,
+			Isolation:     ts.Proto.Isolation,
+			OrigTimestamp: ts.fixedTimestamp,
 		}
 		if abrtTxn := abrtErr.Transaction(); abrtTxn != nil {
 			// Acts as a minimum priority on restart.
@@ -90,6 +99,11 @@ type Txn struct {
 	// systemDBTrigger is set to true when modifying keys from the
 	// SystemDB span. This sets the SystemDBTrigger on EndTransactionRequest.
 	systemDBTrigger bool
+	// fixedTimestamp is the timestamp at which a read-only transaction reads,
+	// or the zero timestamp if the transaction uses the current time.
+	fixedTimestamp roachpb.Timestamp
+	// sendCheck, if set, is called before each batch is sent.
+	sendCheck func() error
 }
 
 // NewTxn returns a new txn.
@@ -102,6 +116,14 @@ func NewTxn(db DB) *Txn {
 	return txn
 }
 
+// SetSendCheck sets a function which is called before each batch of the
+// transaction is sent, including the one committing it. If the function
+// returns an error, the batch isn't sent and the error is returned instead.
+// Batches which roll back the transaction are always sent.
+func (txn *Txn) SetSendCheck(fn func() error) {
+	txn.sendCheck = fn
+}
+
 // SetDebugName sets the debug name associated with the transaction which will
 // appear in log files and the web UI. Each transaction starts out with an
 // automatically assigned debug name composed of the file and line number where
@@ -132,6 +154,19 @@ func (txn *Txn) SetIsolation(isolation roachpb.IsolationType) error {
 	return nil
 }
 
+// SetFixedTimestamp makes the transaction read at the specified timestamp,
+// which is usually in the past, instead of the current time. A transaction
+// with a fixed timestamp is read-only. The timestamp must be set before any
+// operations are performed on the transaction.
+func (txn *Txn) SetFixedTimestamp(ts roachpb.Timestamp) error {
+	if txn.Proto.IsInitialized() && txn.fixedTimestamp != ts {
+		return fmt.Errorf("cannot set the timestamp of a running transaction")
+	}
+	txn.fixedTimestamp = ts
+	txn.Proto.OrigTimestamp = ts
+	return nil
+}
+
 // InternalSetPriority sets the transaction priority. It is intended for
 // internal (testing) use only.
 func (txn *Txn) InternalSetPriority(priority int32) {
@@ -359,6 +394,15 @@ func (txn *Txn) sendEndTxnReq(commit bool, deadline *roachpb.Timestamp) error {
 	return pErr.GoError()
 }
 
+// isRollback returns whether the batch ends the transaction without
+// committing it.
+func isRollback(ba roachpb.BatchRequest) bool {
+	if args, ok := ba.GetArg(roachpb.EndTransaction); ok {
+		return !args.(*roachpb.EndTransactionRequest).Commit
+	}
+	return false
+}
+
 func endTxnReq(commit bool, deadline *roachpb.Timestamp, hasTrigger bool) roachpb.Request {
 	req := &roachpb.EndTransactionRequest{
 		Commit:   commit,
@@ -441,6 +485,9 @@ func (txn *Txn) send(reqs ...roachpb.Request) (*roachpb.BatchResponse, *roachpb.
 	}
 
 	haveTxnWrite := firstWriteIndex != -1
+	if haveTxnWrite && txn.fixedTimestamp != roachpb.ZeroTimestamp {
+		return nil, roachpb.NewError(util.Errorf("cannot write in a transaction with a fixed timestamp"))
+	}
 	endTxnRequest, haveEndTxn := reqs[lastIndex].(*roachpb.EndTransactionRequest)
 	needBeginTxn := !txn.Proto.Writing && haveTxnWrite
 	needEndTxn := txn.Proto.Writing || haveTxnWrite
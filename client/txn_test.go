@@ -415,6 +415,42 @@ func TestAbortMutatingTransaction(t *testing.T) {
 	}
 }
 
+// TestTxnSendCheck verifies that batches aren't sent once the send check
+// of a transaction fails, and that the transaction is still rolled back.
+func TestTxnSendCheck(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	var calls []roachpb.Method
+	db := newDB(newTestSender(func(ba roachpb.BatchRequest) (*roachpb.BatchResponse, *roachpb.Error) {
+		calls = append(calls, ba.Methods()...)
+		if et, ok := ba.GetArg(roachpb.EndTransaction); ok && et.(*roachpb.EndTransactionRequest).Commit {
+			t.Errorf("expected commit to be false")
+		}
+		return ba.CreateReply(), nil
+	}, nil))
+
+	errInterrupted := errors.New("interrupted")
+	var interrupted bool
+	if err := db.Txn(func(txn *Txn) error {
+		txn.SetSendCheck(func() error {
+			if interrupted {
+				return errInterrupted
+			}
+			return nil
+		})
+		if err := txn.Put("a", "b"); err != nil {
+			return err
+		}
+		interrupted = true
+		return txn.Put("c", "d")
+	}); err == nil || err.Error() != errInterrupted.Error() {
+		t.Errorf("expected %s; got %v", errInterrupted, err)
+	}
+	expectedCalls := []roachpb.Method{roachpb.BeginTransaction, roachpb.Put, roachpb.EndTransaction}
+	if !reflect.DeepEqual(expectedCalls, calls) {
+		t.Errorf("expected %s, got %s", expectedCalls, calls)
+	}
+}
+
 // TestRunTransactionRetryOnErrors verifies that the transaction
 // is retried on the correct errors.
 func TestRunTransactionRetryOnErrors(t *testing.T) {
@@ -21,6 +21,7 @@ import (
 	"bytes"
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/roachpb"
@@ -82,8 +83,20 @@ func TestingDisableTableSplits() func() {
 // Validate verifies some ZoneConfig fields.
 // This should be used to validate user input when setting a new zone config.
 func (z ZoneConfig) Validate() error {
-	if len(z.ReplicaAttrs) == 0 {
-		return util.Errorf("attributes for at least one replica must be specified in zone config")
+	if z.NumReplicas < 0 {
+		return util.Errorf("NumReplicas %d must not be negative", z.NumReplicas)
+	}
+	if z.ReplicaCount() == 0 {
+		return util.Errorf("at least one replica must be specified in zone config")
+	}
+	if z.NumReplicas > 0 && len(z.ReplicaAttrs) > 0 && int(z.NumReplicas) != len(z.ReplicaAttrs) {
+		return util.Errorf("NumReplicas %d does not match the %d replicas specified by attributes",
+			z.NumReplicas, len(z.ReplicaAttrs))
+	}
+	for _, c := range z.Constraints {
+		if c.Value == "" {
+			return util.Errorf("constraint %q has an empty value", c)
+		}
 	}
 	if z.RangeMaxBytes < minRangeMaxBytes {
 		return util.Errorf("RangeMaxBytes %d less than minimum allowed %d", z.RangeMaxBytes, minRangeMaxBytes)
@@ -95,6 +108,127 @@ func (z ZoneConfig) Validate() error {
 	return nil
 }
 
+// InheritFrom sets the fields of the zone config which are unset to the
+// values of the corresponding fields of 'parent'. This is used to resolve
+// the zone config of a table from its own zone config, the zone config of
+// its database and the default zone config, in that order.
+func (z *ZoneConfig) InheritFrom(parent ZoneConfig) {
+	if z.ReplicaCount() == 0 {
+		z.ReplicaAttrs = parent.ReplicaAttrs
+		z.NumReplicas = parent.NumReplicas
+	}
+	if z.RangeMinBytes == 0 {
+		z.RangeMinBytes = parent.RangeMinBytes
+	}
+	if z.RangeMaxBytes == 0 {
+		z.RangeMaxBytes = parent.RangeMaxBytes
+	}
+	if z.GC == nil {
+		z.GC = parent.GC
+	}
+	if len(z.LeasePreferences) == 0 {
+		z.LeasePreferences = parent.LeasePreferences
+	}
+	if len(z.Constraints) == 0 {
+		z.Constraints = parent.Constraints
+	}
+}
+
+// ReplicaCount returns the number of replicas of ranges in the zone:
+// NumReplicas if set, or else the number of ReplicaAttrs.
+func (z ZoneConfig) ReplicaCount() int {
+	if z.NumReplicas > 0 {
+		return int(z.NumReplicas)
+	}
+	return len(z.ReplicaAttrs)
+}
+
+// RequiredAttrs returns the attributes required of the stores of all
+// replicas in the zone. Only zone configs which specify ReplicaAttrs
+// have required attributes.
+//
+// TODO(mrtracy): Handle non-homogenous and mismatched attribute sets.
+func (z ZoneConfig) RequiredAttrs() roachpb.Attributes {
+	if len(z.ReplicaAttrs) == 0 {
+		return roachpb.Attributes{}
+	}
+	return z.ReplicaAttrs[0]
+}
+
+// String returns the constraint in the form used by zone config YAML:
+// its value, prefixed by "key=" for locality constraints, and by "+" if
+// it is required or "-" if it is prohibited.
+func (c Constraint) String() string {
+	var prefix string
+	switch c.Type {
+	case Constraint_REQUIRED:
+		prefix = "+"
+	case Constraint_PROHIBITED:
+		prefix = "-"
+	}
+	if c.Key == "" {
+		return prefix + c.Value
+	}
+	return prefix + c.Key + "=" + c.Value
+}
+
+// Parse sets the constraint from its string form (see String).
+func (c *Constraint) Parse(s string) error {
+	*c = Constraint{}
+	if len(s) > 0 {
+		switch s[0] {
+		case '+':
+			c.Type = Constraint_REQUIRED
+			s = s[1:]
+		case '-':
+			c.Type = Constraint_PROHIBITED
+			s = s[1:]
+		}
+	}
+	parts := strings.Split(s, "=")
+	switch {
+	case len(parts) == 1 && parts[0] != "":
+		c.Value = parts[0]
+	case len(parts) == 2 && parts[0] != "" && parts[1] != "":
+		c.Key, c.Value = parts[0], parts[1]
+	default:
+		return util.Errorf("invalid constraint %q", s)
+	}
+	return nil
+}
+
+// GetYAML implements the yaml.Getter interface, representing the
+// constraint by its string form.
+func (c Constraint) GetYAML() (string, interface{}) {
+	return "", c.String()
+}
+
+// SetYAML implements the yaml.Setter interface, parsing the constraint
+// from its string form.
+func (c *Constraint) SetYAML(tag string, value interface{}) bool {
+	s, ok := value.(string)
+	return ok && c.Parse(s) == nil
+}
+
+// Matches returns whether the given store satisfies the constraint,
+// regardless of its type.
+func (c Constraint) Matches(store roachpb.StoreDescriptor) bool {
+	if c.Key == "" {
+		for _, attr := range store.CombinedAttrs().Attrs {
+			if attr == c.Value {
+				return true
+			}
+		}
+		return false
+	}
+	for _, tier := range store.Node.Locality.Tiers {
+		if tier.Key == c.Key && tier.Value == c.Value {
+			return true
+		}
+	}
+	return false
+}
+
 // ObjectIDForKey returns the object ID (table or database) for 'key',
 // or (_, false) if not within the structured key space.
 func ObjectIDForKey(key roachpb.RKey) (uint32, bool) {
@@ -199,6 +333,63 @@ func (s SystemConfig) GetLargestObjectID() (uint32, error) {
 	return uint32(id), nil
 }
 
+// GetProtectedTimestamps returns the protected timestamp records which
+// have not expired at 'now'.
+func (s SystemConfig) GetProtectedTimestamps(now roachpb.Timestamp) ([]ProtectedTimestamp, error) {
+	// The records are the values of the record column of the rows of
+	// system.protected_timestamps. The other keys of the table are row
+	// sentinels, which have empty values.
+	startKey := roachpb.Key(keys.MakeTablePrefix(keys.ProtectedTimestampsTableID))
+	endKey := startKey.PrefixEnd()
+	index := sort.Search(len(s.Values), func(i int) bool {
+		return bytes.Compare(s.Values[i].Key, startKey) >= 0
+	})
+
+	var records []ProtectedTimestamp
+	for _, kv := range s.Values[index:] {
+		if bytes.Compare(kv.Key, endKey) >= 0 {
+			break
+		}
+		if len(kv.Value.RawBytes) == 0 {
+			continue
+		}
+		var record ProtectedTimestamp
+		if err := kv.Value.GetProto(&record); err != nil {
+			return nil, err
+		}
+		if now.Less(record.Expiration) {
+			records = append(records, record)
+		}
+	}
+	return records, nil
+}
+
+// GetProtectedTimestampForSpan returns the earliest timestamp protected by
+// the unexpired protected timestamp records whose spans overlap the key
+// span [startKey, endKey), or false if there is none. Keeping the versions
+// needed to read the span at that timestamp keeps those needed to read it
+// at any later protected timestamp.
+func (s SystemConfig) GetProtectedTimestampForSpan(startKey, endKey roachpb.RKey,
+	now roachpb.Timestamp) (roachpb.Timestamp, bool, error) {
+	records, err := s.GetProtectedTimestamps(now)
+	if err != nil {
+		return roachpb.ZeroTimestamp, false, err
+	}
+	var ts roachpb.Timestamp
+	found := false
+	for _, record := range records {
+		if !roachpb.RKey(record.Span.Key).Less(endKey) ||
+			!startKey.Less(roachpb.RKey(record.Span.EndKey)) {
+			continue
+		}
+		if !found || record.Timestamp.Less(ts) {
+			ts = record.Timestamp
+			found = true
+		}
+	}
+	return ts, found, nil
+}
+
 // GetZoneConfigForKey looks up the zone config for the range containing 'key'.
 // It is the caller's responsibility to ensure that the range does not need to be split.
 func (s SystemConfig) GetZoneConfigForKey(key roachpb.RKey) (*ZoneConfig, error) {
@@ -10,6 +10,8 @@
 
 	It has these top-level messages:
 		GCPolicy
+		ProtectedTimestamp
+		Constraint
 		ZoneConfig
 		SystemConfig
 */
@@ -30,6 +32,47 @@ var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
+type Constraint_Type int32
+
+const (
+	// POSITIVE constraints are preferences: stores which match more of
+	// them are chosen over stores which match fewer.
+	Constraint_POSITIVE Constraint_Type = 0
+	// REQUIRED constraints must be matched by the stores of all replicas.
+	Constraint_REQUIRED Constraint_Type = 1
+	// PROHIBITED constraints must not be matched by the stores of any
+	// replica.
+	Constraint_PROHIBITED Constraint_Type = 2
+)
+
+var Constraint_Type_name = map[int32]string{
+	0: "POSITIVE",
+	1: "REQUIRED",
+	2: "PROHIBITED",
+}
+var Constraint_Type_value = map[string]int32{
+	"POSITIVE":   0,
+	"REQUIRED":   1,
+	"PROHIBITED": 2,
+}
+
+func (x Constraint_Type) Enum() *Constraint_Type {
+	p := new(Constraint_Type)
+	*p = x
+	return p
+}
+func (x Constraint_Type) String() string {
+	return proto.EnumName(Constraint_Type_name, int32(x))
+}
+func (x *Constraint_Type) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(Constraint_Type_value, data, "Constraint_Type")
+	if err != nil {
+		return err
+	}
+	*x = Constraint_Type(value)
+	return nil
+}
+
 // GCPolicy defines garbage collection policies which apply to MVCC
 // values within a zone.
 //
@@ -47,17 +90,60 @@ func (m *GCPolicy) Reset()         { *m = GCPolicy{} }
 func (m *GCPolicy) String() string { return proto.CompactTextString(m) }
 func (*GCPolicy) ProtoMessage()    {}
 
+// ProtectedTimestamp is a record, stored in the system.protected_timestamps
+// table, which holds off the GC of the versions of the keys in a span which
+// are needed to read the span at a timestamp.
+type ProtectedTimestamp struct {
+	ID   int64                   `protobuf:"varint,1,opt,name=id" json:"id"`
+	Span cockroach_roachpb1.Span `protobuf:"bytes,2,opt,name=span" json:"span"`
+	// Timestamp is the timestamp at which the span must remain readable.
+	Timestamp cockroach_roachpb1.Timestamp `protobuf:"bytes,3,opt,name=timestamp" json:"timestamp"`
+	// Expiration is the time after which the record no longer holds off
+	// GC, so that records which aren't released don't do so forever.
+	Expiration cockroach_roachpb1.Timestamp `protobuf:"bytes,4,opt,name=expiration" json:"expiration"`
+	// Description describes the operation which protects the timestamp.
+	Description string `protobuf:"bytes,5,opt,name=description" json:"description"`
+}
+
+func (m *ProtectedTimestamp) Reset()         { *m = ProtectedTimestamp{} }
+func (m *ProtectedTimestamp) String() string { return proto.CompactTextString(m) }
+func (*ProtectedTimestamp) ProtoMessage()    {}
+
+// Constraint restricts or guides the placement of the replicas of ranges
+// in a zone. A constraint with an empty key matches stores whose store or
+// node attributes contain its value; otherwise it matches stores on nodes
+// with a locality tier of the same key and value.
+type Constraint struct {
+	Type  Constraint_Type `protobuf:"varint,1,opt,name=type,enum=cockroach.config.Constraint_Type" json:"type"`
+	Key   string          `protobuf:"bytes,2,opt,name=key" json:"key"`
+	Value string          `protobuf:"bytes,3,opt,name=value" json:"value"`
+}
+
+func (m *Constraint) Reset()      { *m = Constraint{} }
+func (*Constraint) ProtoMessage() {}
+
 // ZoneConfig holds configuration that is needed for a range of KV pairs.
 type ZoneConfig struct {
 	// ReplicaAttrs is a slice of Attributes, each describing required attributes
 	// for each replica in the zone. The order in which the attributes are stored
-	// in ReplicaAttrs is arbitrary and may change.
+	// in ReplicaAttrs is arbitrary and may change. New zone configs should use
+	// NumReplicas and Constraints instead.
 	ReplicaAttrs  []cockroach_roachpb.Attributes `protobuf:"bytes,1,rep,name=replica_attrs" json:"replica_attrs" yaml:"replicas,omitempty"`
 	RangeMinBytes int64                          `protobuf:"varint,2,opt,name=range_min_bytes" json:"range_min_bytes" yaml:"range_min_bytes,omitempty"`
 	RangeMaxBytes int64                          `protobuf:"varint,3,opt,name=range_max_bytes" json:"range_max_bytes" yaml:"range_max_bytes,omitempty"`
 	// If GC policy is not set, uses the next highest, non-null policy
 	// in the zone config hierarchy, up to the default policy if necessary.
 	GC *GCPolicy `protobuf:"bytes,4,opt,name=gc" json:"gc,omitempty" yaml:"gc,omitempty"`
+	// LeasePreferences is an ordered list of Attributes. The leader lease of
+	// ranges in the zone is preferably held by a replica on a store which
+	// matches the first preference that any of the replicas' stores match.
+	// If empty, leases are spread evenly across the stores of the replicas.
+	LeasePreferences []cockroach_roachpb.Attributes `protobuf:"bytes,5,rep,name=lease_preferences" json:"lease_preferences,omitempty" yaml:"lease_preferences,omitempty"`
+	// NumReplicas is the number of replicas of ranges in the zone. If zero,
+	// the number of ReplicaAttrs is used instead.
+	NumReplicas int32 `protobuf:"varint,6,opt,name=num_replicas" json:"num_replicas,omitempty" yaml:"num_replicas,omitempty"`
+	// Constraints apply to all replicas of ranges in the zone.
+	Constraints []Constraint `protobuf:"bytes,7,rep,name=constraints" json:"constraints,omitempty" yaml:"constraints,flow,omitempty"`
 }
 
 func (m *ZoneConfig) Reset()         { *m = ZoneConfig{} }
@@ -74,8 +160,11 @@ func (*SystemConfig) ProtoMessage()    {}
 
 func init() {
 	proto.RegisterType((*GCPolicy)(nil), "cockroach.config.GCPolicy")
+	proto.RegisterType((*ProtectedTimestamp)(nil), "cockroach.config.ProtectedTimestamp")
+	proto.RegisterType((*Constraint)(nil), "cockroach.config.Constraint")
 	proto.RegisterType((*ZoneConfig)(nil), "cockroach.config.ZoneConfig")
 	proto.RegisterType((*SystemConfig)(nil), "cockroach.config.SystemConfig")
+	proto.RegisterEnum("cockroach.config.Constraint_Type", Constraint_Type_name, Constraint_Type_value)
 }
 func (m *GCPolicy) Marshal() (data []byte, err error) {
 	size := m.Size()
@@ -98,6 +187,84 @@ func (m *GCPolicy) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
+func (m *ProtectedTimestamp) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ProtectedTimestamp) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintConfig(data, i, uint64(m.ID))
+	data[i] = 0x12
+	i++
+	i = encodeVarintConfig(data, i, uint64(m.Span.Size()))
+	n1, err := m.Span.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n1
+	data[i] = 0x1a
+	i++
+	i = encodeVarintConfig(data, i, uint64(m.Timestamp.Size()))
+	n2, err := m.Timestamp.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n2
+	data[i] = 0x22
+	i++
+	i = encodeVarintConfig(data, i, uint64(m.Expiration.Size()))
+	n3, err := m.Expiration.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n3
+	data[i] = 0x2a
+	i++
+	i = encodeVarintConfig(data, i, uint64(len(m.Description)))
+	i += copy(data[i:], m.Description)
+	return i, nil
+}
+
+func (m *Constraint) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Constraint) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintConfig(data, i, uint64(m.Type))
+	data[i] = 0x12
+	i++
+	i = encodeVarintConfig(data, i, uint64(len(m.Key)))
+	i += copy(data[i:], m.Key)
+	data[i] = 0x1a
+	i++
+	i = encodeVarintConfig(data, i, uint64(len(m.Value)))
+	i += copy(data[i:], m.Value)
+	return i, nil
+}
+
 func (m *ZoneConfig) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
@@ -135,11 +302,38 @@ func (m *ZoneConfig) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x22
 		i++
 		i = encodeVarintConfig(data, i, uint64(m.GC.Size()))
-		n1, err := m.GC.MarshalTo(data[i:])
+		n4, err := m.GC.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n1
+		i += n4
+	}
+	if len(m.LeasePreferences) > 0 {
+		for _, msg := range m.LeasePreferences {
+			data[i] = 0x2a
+			i++
+			i = encodeVarintConfig(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	data[i] = 0x30
+	i++
+	i = encodeVarintConfig(data, i, uint64(m.NumReplicas))
+	if len(m.Constraints) > 0 {
+		for _, msg := range m.Constraints {
+			data[i] = 0x3a
+			i++
+			i = encodeVarintConfig(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	return i, nil
 }
@@ -208,6 +402,32 @@ func (m *GCPolicy) Size() (n int) {
 	return n
 }
 
+func (m *ProtectedTimestamp) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovConfig(uint64(m.ID))
+	l = m.Span.Size()
+	n += 1 + l + sovConfig(uint64(l))
+	l = m.Timestamp.Size()
+	n += 1 + l + sovConfig(uint64(l))
+	l = m.Expiration.Size()
+	n += 1 + l + sovConfig(uint64(l))
+	l = len(m.Description)
+	n += 1 + l + sovConfig(uint64(l))
+	return n
+}
+
+func (m *Constraint) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovConfig(uint64(m.Type))
+	l = len(m.Key)
+	n += 1 + l + sovConfig(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovConfig(uint64(l))
+	return n
+}
+
 func (m *ZoneConfig) Size() (n int) {
 	var l int
 	_ = l
@@ -223,6 +443,19 @@ func (m *ZoneConfig) Size() (n int) {
 		l = m.GC.Size()
 		n += 1 + l + sovConfig(uint64(l))
 	}
+	if len(m.LeasePreferences) > 0 {
+		for _, e := range m.LeasePreferences {
+			l = e.Size()
+			n += 1 + l + sovConfig(uint64(l))
+		}
+	}
+	n += 1 + sovConfig(uint64(m.NumReplicas))
+	if len(m.Constraints) > 0 {
+		for _, e := range m.Constraints {
+			l = e.Size()
+			n += 1 + l + sovConfig(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -320,6 +553,321 @@ func (m *GCPolicy) Unmarshal(data []byte) error {
 	}
 	return nil
 }
+func (m *ProtectedTimestamp) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProtectedTimestamp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProtectedTimestamp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ID |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Span", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Span.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Timestamp.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Expiration.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfig(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Constraint) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Constraint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Constraint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Type |= (Constraint_Type(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfig(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ZoneConfig) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
@@ -451,6 +999,87 @@ func (m *ZoneConfig) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeasePreferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeasePreferences = append(m.LeasePreferences, cockroach_roachpb.Attributes{})
+			if err := m.LeasePreferences[len(m.LeasePreferences)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumReplicas", wireType)
+			}
+			m.NumReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.NumReplicas |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constraints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Constraints = append(m.Constraints, Constraint{})
+			if err := m.Constraints[len(m.Constraints)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConfig(data[iNdEx:])
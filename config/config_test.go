@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/gogo/protobuf/proto"
+	yaml "gopkg.in/yaml.v1"
 
 	"github.com/cockroachdb/cockroach/config"
 	"github.com/cockroachdb/cockroach/keys"
@@ -176,7 +177,7 @@ func TestGetLargestID(t *testing.T) {
 		}, 12, ""},
 
 		// Real SQL layout.
-		{sql.GetInitialSystemValues(), keys.ZonesTableID, ""},
+		{sql.GetInitialSystemValues(), keys.ProtectedTimestampsTableID, ""},
 	}
 
 	cfg := config.SystemConfig{}
@@ -265,3 +266,168 @@ func TestComputeSplits(t *testing.T) {
 		}
 	}
 }
+
+func TestZoneConfigValidate(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	testCases := []struct {
+		zone     config.ZoneConfig
+		expected bool
+	}{
+		{config.ZoneConfig{}, false},
+		{config.ZoneConfig{NumReplicas: -1}, false},
+		{config.ZoneConfig{NumReplicas: 3}, true},
+		{config.ZoneConfig{ReplicaAttrs: []roachpb.Attributes{{}, {}}}, true},
+		{config.ZoneConfig{NumReplicas: 2, ReplicaAttrs: []roachpb.Attributes{{}, {}}}, true},
+		{config.ZoneConfig{NumReplicas: 3, ReplicaAttrs: []roachpb.Attributes{{}, {}}}, false},
+		{config.ZoneConfig{NumReplicas: 3, Constraints: []config.Constraint{{Value: "ssd"}}}, true},
+		{config.ZoneConfig{NumReplicas: 3, Constraints: []config.Constraint{{Key: "region"}}}, false},
+	}
+	for i, test := range testCases {
+		test.zone.RangeMinBytes = 1 << 20
+		test.zone.RangeMaxBytes = 64 << 20
+		if err := test.zone.Validate(); (err == nil) != test.expected {
+			t.Errorf("%d: expected valid=%t; got %v", i, test.expected, err)
+		}
+	}
+}
+
+func TestZoneConfigInheritFrom(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	parent := config.ZoneConfig{
+		NumReplicas:   3,
+		RangeMinBytes: 1 << 20,
+		RangeMaxBytes: 64 << 20,
+		GC:            &config.GCPolicy{TTLSeconds: 60},
+		Constraints:   []config.Constraint{{Value: "ssd"}},
+	}
+
+	zone := config.ZoneConfig{}
+	zone.InheritFrom(parent)
+	if !reflect.DeepEqual(zone, parent) {
+		t.Errorf("expected %+v; got %+v", parent, zone)
+	}
+
+	zone = config.ZoneConfig{
+		ReplicaAttrs:  []roachpb.Attributes{{}, {}, {}, {}, {}},
+		RangeMaxBytes: 128 << 20,
+		GC:            &config.GCPolicy{TTLSeconds: 0},
+	}
+	zone.InheritFrom(parent)
+	expected := config.ZoneConfig{
+		ReplicaAttrs:  []roachpb.Attributes{{}, {}, {}, {}, {}},
+		RangeMinBytes: 1 << 20,
+		RangeMaxBytes: 128 << 20,
+		GC:            &config.GCPolicy{TTLSeconds: 0},
+		Constraints:   []config.Constraint{{Value: "ssd"}},
+	}
+	if !reflect.DeepEqual(zone, expected) {
+		t.Errorf("expected %+v; got %+v", expected, zone)
+	}
+}
+
+func TestConstraintParse(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	testCases := []struct {
+		s        string
+		expected config.Constraint
+		err      bool
+	}{
+		{"ssd", config.Constraint{Value: "ssd"}, false},
+		{"+ssd", config.Constraint{Type: config.Constraint_REQUIRED, Value: "ssd"}, false},
+		{"-ssd", config.Constraint{Type: config.Constraint_PROHIBITED, Value: "ssd"}, false},
+		{"region=us", config.Constraint{Key: "region", Value: "us"}, false},
+		{"+region=us", config.Constraint{Type: config.Constraint_REQUIRED, Key: "region", Value: "us"}, false},
+		{"", config.Constraint{}, true},
+		{"+", config.Constraint{}, true},
+		{"region=", config.Constraint{}, true},
+		{"=us", config.Constraint{}, true},
+		{"a=b=c", config.Constraint{}, true},
+	}
+	for i, test := range testCases {
+		var c config.Constraint
+		err := c.Parse(test.s)
+		if (err != nil) != test.err {
+			t.Errorf("%d: expected error %t; got %v", i, test.err, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if c != test.expected {
+			t.Errorf("%d: expected %+v; got %+v", i, test.expected, c)
+		}
+		if s := c.String(); s != test.s {
+			t.Errorf("%d: expected %q to round-trip; got %q", i, test.s, s)
+		}
+	}
+}
+
+func TestZoneConfigYAML(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	const body = `num_replicas: 3
+constraints: [+ssd, -region=eu, dc=1]
+range_min_bytes: 1048576
+range_max_bytes: 67108864
+`
+	var zone config.ZoneConfig
+	if err := yaml.Unmarshal([]byte(body), &zone); err != nil {
+		t.Fatal(err)
+	}
+	expected := config.ZoneConfig{
+		NumReplicas: 3,
+		Constraints: []config.Constraint{
+			{Type: config.Constraint_REQUIRED, Value: "ssd"},
+			{Type: config.Constraint_PROHIBITED, Key: "region", Value: "eu"},
+			{Key: "dc", Value: "1"},
+		},
+		RangeMinBytes: 1048576,
+		RangeMaxBytes: 67108864,
+	}
+	if !reflect.DeepEqual(zone, expected) {
+		t.Fatalf("expected %+v; got %+v", expected, zone)
+	}
+
+	out, err := yaml.Marshal(zone)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var roundTripped config.ZoneConfig
+	if err := yaml.Unmarshal(out, &roundTripped); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(roundTripped, expected) {
+		t.Fatalf("expected %+v; got %+v from:\n%s", expected, roundTripped, out)
+	}
+}
+
+func TestConstraintMatches(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	var locality roachpb.Locality
+	if err := locality.Set("region=us,dc=1"); err != nil {
+		t.Fatal(err)
+	}
+	store := roachpb.StoreDescriptor{
+		Attrs: roachpb.Attributes{Attrs: []string{"ssd"}},
+		Node: roachpb.NodeDescriptor{
+			Attrs:    roachpb.Attributes{Attrs: []string{"gpu"}},
+			Locality: locality,
+		},
+	}
+	testCases := []struct {
+		c        config.Constraint
+		expected bool
+	}{
+		{config.Constraint{Value: "ssd"}, true},
+		{config.Constraint{Value: "gpu"}, true},
+		{config.Constraint{Value: "hdd"}, false},
+		{config.Constraint{Value: "us"}, false},
+		{config.Constraint{Key: "region", Value: "us"}, true},
+		{config.Constraint{Key: "region", Value: "eu"}, false},
+		{config.Constraint{Key: "dc", Value: "1"}, true},
+	}
+	for i, test := range testCases {
+		if matches := test.c.Matches(store); matches != test.expected {
+			t.Errorf("%d: expected %s to match=%t", i, test.c, test.expected)
+		}
+	}
+}
@@ -56,6 +56,9 @@ var (
 	// localStoreIdentSuffix stores an immutable identifier for this
 	// store, created when the store is first bootstrapped.
 	localStoreIdentSuffix = []byte("iden")
+	// localStoreDecommissioningSuffix marks the store as belonging to a
+	// node being decommissioned.
+	localStoreDecommissioningSuffix = []byte("dcom")
 
 	// LocalRangeIDPrefix is the prefix identifying per-range data
 	// indexed by Range ID. The Range ID is appended to this prefix,
@@ -84,6 +87,8 @@ var (
 	localRaftTruncatedStateSuffix = []byte("rftt")
 	// localRaftLastIndexSuffix is the suffix for raft's last index.
 	localRaftLastIndexSuffix = []byte("rfti")
+	// localRaftLogSizeSuffix is the suffix for the size of the raft log.
+	localRaftLogSizeSuffix = []byte("rfls")
 	// localRangeGCMetadataSuffix is the suffix for a range's GC metadata.
 	localRangeGCMetadataSuffix = []byte("rgcm")
 	// localRangeLastVerificationTimestampSuffix is the suffix for a range's
@@ -115,6 +120,14 @@ var (
 	// (storage/engine/rocksdb/db.cc).
 	localTransactionSuffix = roachpb.RKey("txn-")
 
+	// LocalRangeTombstonePrefix is the prefix identifying MVCC range
+	// tombstones. The start key of the deleted span is appended to this
+	// prefix, encoded using EncodeBytes, followed by the timestamp of the
+	// deletion encoded in decreasing order, so that the tombstones of a
+	// range sort together, newest first for each start key.
+	LocalRangeTombstonePrefix = roachpb.Key(MakeKey(localPrefix, roachpb.RKey("t")))
+	LocalRangeTombstoneMax    = LocalRangeTombstonePrefix.PrefixEnd()
+
 	// LocalMax is the end of the local key range.
 	LocalMax = roachpb.Key(localPrefix).PrefixEnd()
 
@@ -190,10 +203,12 @@ const (
 	// SystemDatabaseID and following are the database/table IDs for objects
 	// in the system span.
 	// NOTE: IDs should remain <= MaxReservedDescID.
-	SystemDatabaseID  = 1
-	NamespaceTableID  = 2
-	DescriptorTableID = 3
-	LeaseTableID      = 4
-	UsersTableID      = 5
-	ZonesTableID      = 6
+	SystemDatabaseID           = 1
+	NamespaceTableID           = 2
+	DescriptorTableID          = 3
+	LeaseTableID               = 4
+	UsersTableID               = 5
+	ZonesTableID               = 6
+	GroupMembersTableID        = 7
+	ProtectedTimestampsTableID = 8
 )
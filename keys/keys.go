@@ -45,6 +45,12 @@ func StoreIdentKey() roachpb.Key {
 	return MakeStoreKey(localStoreIdentSuffix, roachpb.RKey{})
 }
 
+// StoreDecommissioningKey returns a store-local key which is present while
+// the node of the store is being decommissioned.
+func StoreDecommissioningKey() roachpb.Key {
+	return MakeStoreKey(localStoreDecommissioningSuffix, roachpb.RKey{})
+}
+
 // StoreStatusKey returns the key for accessing the store status for the
 // specified store ID.
 func StoreStatusKey(storeID int32) roachpb.Key {
@@ -113,6 +119,11 @@ func RaftLastIndexKey(rangeID roachpb.RangeID) roachpb.Key {
 	return MakeRangeIDKey(rangeID, localRaftLastIndexSuffix, roachpb.RKey{})
 }
 
+// RaftLogSizeKey returns a system-local key for the size of a raft log.
+func RaftLogSizeKey(rangeID roachpb.RangeID) roachpb.Key {
+	return MakeRangeIDKey(rangeID, localRaftLogSizeSuffix, roachpb.RKey{})
+}
+
 // RangeStatsKey returns the key for accessing the MVCCStats struct
 // for the specified Range ID.
 func RangeStatsKey(rangeID roachpb.RangeID) roachpb.Key {
@@ -205,6 +216,20 @@ func TransactionKey(key roachpb.Key, id []byte) roachpb.Key {
 	return MakeRangeKey(Addr(key), localTransactionSuffix, roachpb.RKey(id))
 }
 
+// MakeRangeTombstoneKeyPrefix creates a key prefix under which all the
+// range tombstones starting at key can be found.
+func MakeRangeTombstoneKeyPrefix(key roachpb.RKey) roachpb.Key {
+	return MakeKey(LocalRangeTombstonePrefix, encoding.EncodeBytes(nil, key))
+}
+
+// RangeTombstoneKey returns the key of the range tombstone deleting,
+// at the given timestamp, the span starting at key.
+func RangeTombstoneKey(key roachpb.Key, timestamp roachpb.Timestamp) roachpb.Key {
+	k := MakeRangeTombstoneKeyPrefix(Addr(key))
+	k = encoding.EncodeUint64Decreasing(k, uint64(timestamp.WallTime))
+	return encoding.EncodeUint32Decreasing(k, uint32(timestamp.Logical))
+}
+
 // Addr returns the address for the key, used to lookup the range containing
 // the key. In the normal case, this is simply the key's value. However, for
 // local keys, such as transaction records, range-spanning binary tree node
@@ -228,13 +253,15 @@ func Addr(k roachpb.Key) roachpb.RKey {
 	if !bytes.HasPrefix(k, localPrefix) {
 		return roachpb.RKey(k)
 	}
-	if bytes.HasPrefix(k, LocalRangePrefix) {
-		k = k[len(LocalRangePrefix):]
-		_, k, err := encoding.DecodeBytes(k, nil)
-		if err != nil {
-			panic(err)
+	for _, prefix := range []roachpb.Key{LocalRangePrefix, LocalRangeTombstonePrefix} {
+		if bytes.HasPrefix(k, prefix) {
+			k = k[len(prefix):]
+			_, k, err := encoding.DecodeBytes(k, nil)
+			if err != nil {
+				panic(err)
+			}
+			return roachpb.RKey(k)
 		}
-		return roachpb.RKey(k)
 	}
 	log.Fatalf("local key %q malformed; should contain prefix %q",
 		k, LocalRangePrefix)
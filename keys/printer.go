@@ -44,6 +44,7 @@ var (
 			{name: "/Store", prefix: roachpb.Key(localStorePrefix), ppFunc: localStoreKeyPrint},
 			{name: "/RangeID", prefix: roachpb.Key(LocalRangeIDPrefix), ppFunc: localRangeIDKeyPrint},
 			{name: "/Range", prefix: LocalRangePrefix, ppFunc: localRangeKeyPrint},
+			{name: "/RangeTombstone", prefix: LocalRangeTombstonePrefix, ppFunc: rangeTombstoneKeyPrint},
 		}},
 		{name: "/System", start: SystemPrefix, end: SystemMax, entries: []dictEntry{
 			{name: "/Meta2", prefix: Meta2Prefix, ppFunc: print},
@@ -71,6 +72,7 @@ var (
 		{name: "RaftLog", suffix: localRaftLogSuffix, ppFunc: raftLogKeyPrint},
 		{name: "RaftTruncatedState", suffix: localRaftTruncatedStateSuffix},
 		{name: "RaftLastIndex", suffix: localRaftLastIndexSuffix},
+		{name: "RaftLogSize", suffix: localRaftLogSizeSuffix},
 		{name: "RangeGCMetadata", suffix: localRangeGCMetadataSuffix},
 		{name: "RangeLastVerificationTimestamp", suffix: localRangeLastVerificationTimestampSuffix},
 		{name: "RangeStats", suffix: localRangeStatsSuffix},
@@ -91,6 +93,9 @@ func localStoreKeyPrint(key roachpb.Key) string {
 	if bytes.HasPrefix(key, localStoreIdentSuffix) {
 		return "/storeIdent"
 	}
+	if bytes.HasPrefix(key, localStoreDecommissioningSuffix) {
+		return "/storeDecommissioning"
+	}
 
 	return fmt.Sprintf("%q", []byte(key))
 }
@@ -194,6 +199,30 @@ func sequenceCacheKeyPrint(key roachpb.Key) string {
 	return fmt.Sprintf("/%q/epoch:%d/seq:%d", id, epoch, seq)
 }
 
+func rangeTombstoneKeyPrint(key roachpb.Key) string {
+	b, startKey, err := encoding.DecodeBytes([]byte(key), nil)
+	if err != nil {
+		return fmt.Sprintf("/%q/err:%v", key, err)
+	}
+
+	if len(b) == 0 {
+		return fmt.Sprintf("/%q", startKey)
+	}
+
+	b, wallTime, err := encoding.DecodeUint64Decreasing(b)
+	if err != nil {
+		return fmt.Sprintf("/%q/err:%v", startKey, err)
+	}
+
+	_, logical, err := encoding.DecodeUint32Decreasing(b)
+	if err != nil {
+		return fmt.Sprintf("/%q/err:%v", startKey, err)
+	}
+
+	ts := roachpb.Timestamp{WallTime: int64(wallTime), Logical: int32(logical)}
+	return fmt.Sprintf("/%q/%s", startKey, ts)
+}
+
 func print(key roachpb.Key) string {
 	return fmt.Sprintf("/%q", []byte(key))
 }
@@ -261,6 +290,7 @@ func decodeKeyPrint(key roachpb.Key) string {
 //			/[rangeid]/RaftLog/logIndex:[logIndex]			"\x00\x00\x00s"+[rangeid]+"rftl"+[logIndex]
 //			/[rangeid]/RaftTruncatedState					"\x00\x00\x00s"+[rangeid]+"rftt"
 //			/[rangeid]/RaftLastIndex						"\x00\x00\x00s"+[rangeid]+"rfti"
+//			/[rangeid]/RaftLogSize							"\x00\x00\x00s"+[rangeid]+"rfls"
 //			/[rangeid]/RangeGCMetadata						"\x00\x00\x00s"+[rangeid]+"rgcm"
 //			/[rangeid]/RangeLastVerificationTimestamp		"\x00\x00\x00s"+[rangeid]+"rlvt"
 //			/[rangeid]/RangeStats							"\x00\x00\x00s"+[rangeid]+"stat"
@@ -268,6 +298,7 @@ func decodeKeyPrint(key roachpb.Key) string {
 //			/RangeDescriptor/[key]							"\x00\x00\x00k"+[key]+"rdsc"
 //			/RangeTreeNode/[key]							"\x00\x00\x00k"+[key]+"rtn-"
 //			/Transaction/addrKey:[key]/id:[id]				"\x00\x00\x00k"+[key]+"txn-"+[id]
+//		/RangeTombstone/[key]/[timestamp]					"\x00\x00\x00t"+[key]+[timestamp]
 // /Local/Max 												"\x00\x00\x01"
 //
 // /System/...												"\x00"
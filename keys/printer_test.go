@@ -37,6 +37,7 @@ func TestPrettyPrint(t *testing.T) {
 	}{
 		// local
 		{StoreIdentKey(), "/Local/Store/storeIdent"},
+		{StoreDecommissioningKey(), "/Local/Store/storeDecommissioning"},
 		{SequenceCacheKeyPrefix(roachpb.RangeID(1000001), []byte("test0")), `/Local/RangeID/1000001/SequenceCache/"test0"`},
 		{SequenceCacheKey(roachpb.RangeID(1000001), []byte("test0"), uint32(111), uint32(222)), `/Local/RangeID/1000001/SequenceCache/"test0"/epoch:111/seq:222`},
 		{RaftLeaderLeaseKey(roachpb.RangeID(1000001)), "/Local/RangeID/1000001/RaftLeaderLease"},
@@ -46,6 +47,7 @@ func TestPrettyPrint(t *testing.T) {
 		{RaftLogKey(roachpb.RangeID(1000001), uint64(200001)), "/Local/RangeID/1000001/RaftLog/logIndex:200001"},
 		{RaftTruncatedStateKey(roachpb.RangeID(1000001)), "/Local/RangeID/1000001/RaftTruncatedState"},
 		{RaftLastIndexKey(roachpb.RangeID(1000001)), "/Local/RangeID/1000001/RaftLastIndex"},
+		{RaftLogSizeKey(roachpb.RangeID(1000001)), "/Local/RangeID/1000001/RaftLogSize"},
 		{RangeGCMetadataKey(roachpb.RangeID(1000001)), "/Local/RangeID/1000001/RangeGCMetadata"},
 		{RangeLastVerificationTimestampKey(roachpb.RangeID(1000001)), "/Local/RangeID/1000001/RangeLastVerificationTimestamp"},
 		{RangeStatsKey(roachpb.RangeID(1000001)), "/Local/RangeID/1000001/RangeStats"},
@@ -54,6 +56,7 @@ func TestPrettyPrint(t *testing.T) {
 		{RangeDescriptorKey(roachpb.RKey("111")), `/Local/Range/RangeDescriptor/"111"`},
 		{RangeTreeNodeKey(roachpb.RKey("111")), `/Local/Range/RangeTreeNode/"111"`},
 		{TransactionKey(roachpb.Key("111"), []byte("22222")), `/Local/Range/Transaction/addrKey:/"111"/id:"22222"`},
+		{RangeTombstoneKey(roachpb.Key("111"), roachpb.Timestamp{WallTime: 1E9, Logical: 2}), `/Local/RangeTombstone/"111"/1.000000000,2`},
 
 		{LocalMax, "/Local/Max"},
 
@@ -248,10 +248,12 @@ func (ds *DistSender) optimizeReplicaOrder(replicas replicaSlice) rpc.OrderingPo
 	if nodeDesc == nil {
 		return order
 	}
-	// Sort replicas by attribute affinity, which we treat as a stand-in for
-	// proximity (for now).
-	if replicas.SortByCommonAttributePrefix(nodeDesc.Attrs.Attrs) > 0 {
-		// There's at least some attribute prefix, and we hope that the
+	// Sort replicas by locality, i.e. the failure domains they share with
+	// this node. Without a common locality, fall back to attribute affinity,
+	// which we treat as a stand-in for proximity.
+	if replicas.SortByCommonLocalityPrefix(nodeDesc.Locality) > 0 ||
+		replicas.SortByCommonAttributePrefix(nodeDesc.Attrs.Attrs) > 0 {
+		// There's at least some common prefix, and we hope that the
 		// replicas that come early in the slice are now located close to
 		// us and hence better candidates.
 		order = rpc.OrderStable
@@ -37,6 +37,19 @@ func (i replicaInfo) attrs() []string {
 	return i.NodeDesc.Attrs.Attrs
 }
 
+func (i replicaInfo) localityTiers() []string {
+	return localityTiers(i.NodeDesc.Locality)
+}
+
+// localityTiers returns the tiers of the given locality in "key=value" form.
+func localityTiers(l roachpb.Locality) []string {
+	tiers := make([]string, len(l.Tiers))
+	for i, t := range l.Tiers {
+		tiers[i] = t.String()
+	}
+	return tiers
+}
+
 // A replicaSlice is a slice of replicaInfo.
 type replicaSlice []replicaInfo
 
@@ -99,14 +112,29 @@ func (rs replicaSlice) FindReplicaByNodeID(nodeID roachpb.NodeID) int {
 // returned (hence, if the return value equals the length of the replicaSlice,
 // at least one replica matched all attributes).
 func (rs replicaSlice) SortByCommonAttributePrefix(attrs []string) int {
+	return rs.sortByCommonPrefix(attrs, replicaInfo.attrs)
+}
+
+// SortByCommonLocalityPrefix rearranges the replicaSlice by the number of
+// leading locality tiers each replica's node has in common with the given
+// locality, in the same manner as SortByCommonAttributePrefix. The number
+// of tiers successfully matched to at least one replica is returned.
+func (rs replicaSlice) SortByCommonLocalityPrefix(locality roachpb.Locality) int {
+	return rs.sortByCommonPrefix(localityTiers(locality), replicaInfo.localityTiers)
+}
+
+// sortByCommonPrefix implements SortByCommonAttributePrefix and
+// SortByCommonLocalityPrefix, using the supplied function to retrieve the
+// values of each replica which are compared against ref.
+func (rs replicaSlice) sortByCommonPrefix(ref []string, values func(replicaInfo) []string) int {
 	if len(rs) < 2 {
 		return 0
 	}
 	topIndex := len(rs) - 1
-	for bucket := 0; bucket < len(attrs); bucket++ {
+	for bucket := 0; bucket < len(ref); bucket++ {
 		firstNotOrdered := 0
 		for i := 0; i <= topIndex; i++ {
-			if bucket < len(rs[i].attrs()) && rs[i].attrs()[bucket] == attrs[bucket] {
+			if v := values(rs[i]); bucket < len(v) && v[bucket] == ref[bucket] {
 				// Move replica which matches this attribute to an earlier
 				// place in the array, just behind the last matching replica.
 				// This packs all matching replicas together.
@@ -122,7 +150,7 @@ func (rs replicaSlice) SortByCommonAttributePrefix(attrs []string) int {
 		}
 		topIndex = firstNotOrdered - 1
 	}
-	return len(attrs)
+	return len(ref)
 }
 
 // MoveToFront moves the replica at the given index to the front
@@ -82,6 +82,30 @@ func TestReplicaSetSortByCommonAttributePrefix(t *testing.T) {
 	}
 }
 
+func TestReplicaSetSortByCommonLocalityPrefix(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	parse := func(s string) roachpb.Locality {
+		var l roachpb.Locality
+		if err := l.Set(s); err != nil {
+			t.Fatal(err)
+		}
+		return l
+	}
+	rs := replicaSlice{}
+	for i, l := range []string{"region=eu,dc=1", "region=us,dc=2", "", "region=us,dc=1,rack=1"} {
+		rs = append(rs, replicaInfo{
+			ReplicaDescriptor: roachpb.ReplicaDescriptor{StoreID: roachpb.StoreID(i + 1)},
+			NodeDesc:          &roachpb.NodeDescriptor{Locality: parse(l)},
+		})
+	}
+	if prefixLen := rs.SortByCommonLocalityPrefix(parse("region=us,dc=1,rack=2")); prefixLen != 2 {
+		t.Errorf("expected common prefix of 2 tiers; got %d", prefixLen)
+	}
+	if stores := getStores(rs); stores[0] != 4 || stores[1] != 2 {
+		t.Errorf("expected stores 4 and 2 to be sorted first; got %v", stores)
+	}
+}
+
 func getStores(rs replicaSlice) (r []roachpb.StoreID) {
 	for i := range rs {
 		r = append(r, rs[i].StoreID)
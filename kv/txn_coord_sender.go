@@ -458,9 +458,15 @@ func (tc *TxnCoordSender) maybeBeginTxn(ba *roachpb.BatchRequest) error {
 	}
 	if len(ba.Txn.ID) == 0 {
 		// Create transaction without a key. The key is set when a begin
-		// transaction request is received.
+		// transaction request is received. A transaction reading at a fixed
+		// timestamp specifies it as its original timestamp and has no
+		// uncertainty interval.
+		now, maxOffset := tc.clock.Now(), tc.clock.MaxOffset().Nanoseconds()
+		if ba.Txn.OrigTimestamp != roachpb.ZeroTimestamp {
+			now, maxOffset = ba.Txn.OrigTimestamp, 0
+		}
 		newTxn := roachpb.NewTransaction(ba.Txn.Name, nil, ba.GetUserPriority(),
-			ba.Txn.Isolation, tc.clock.Now(), tc.clock.MaxOffset().Nanoseconds())
+			ba.Txn.Isolation, now, maxOffset)
 		// Use existing priority as a minimum. This is used on transaction
 		// aborts to ratchet priority when creating successor transaction.
 		if newTxn.Priority < ba.Txn.Priority {
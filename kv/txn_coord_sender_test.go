@@ -167,6 +167,45 @@ func TestTxnCoordSenderBeginTransactionMinPriority(t *testing.T) {
 	}
 }
 
+// TestTxnCoordSenderFixedTimestamp verifies that a transaction with a fixed
+// timestamp reads the values as of that timestamp and can't write.
+func TestTxnCoordSenderFixedTimestamp(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := createTestDB(t)
+	defer s.Stop()
+	defer teardownHeartbeats(s.Sender)
+
+	key := roachpb.Key("key")
+	if err := s.DB.Put(key, "old"); err != nil {
+		t.Fatal(err)
+	}
+	s.Manual.Increment(100)
+	ts := s.Clock.Now()
+	s.Manual.Increment(100)
+	if err := s.DB.Put(key, "new"); err != nil {
+		t.Fatal(err)
+	}
+
+	txn := client.NewTxn(*s.DB)
+	if err := txn.SetFixedTimestamp(ts); err != nil {
+		t.Fatal(err)
+	}
+	if kv, err := txn.Get(key); err != nil {
+		t.Fatal(err)
+	} else if v := string(kv.ValueBytes()); v != "old" {
+		t.Errorf("expected \"old\"; got %q", v)
+	}
+	if !txn.Proto.OrigTimestamp.Equal(ts) || !txn.Proto.MaxTimestamp.Equal(ts) {
+		t.Errorf("expected timestamps %s; got %s", ts, txn.Proto)
+	}
+	if err := txn.SetFixedTimestamp(ts.Add(1, 0)); !testutils.IsError(err, "running transaction") {
+		t.Errorf("expected error changing the timestamp; got %v", err)
+	}
+	if err := txn.Put(key, "newer"); !testutils.IsError(err, "fixed timestamp") {
+		t.Errorf("expected write error; got %v", err)
+	}
+}
+
 // TestTxnCoordSenderKeyRanges verifies that multiple requests to same or
 // overlapping key ranges causes the coordinator to keep track only of
 // the minimum number of ranges.
@@ -175,6 +175,29 @@ func (rr *ResolveIntentRangeResponse) Combine(c Response) error {
 	return nil
 }
 
+// Combine implements the Combinable interface.
+func (er *ExportResponse) Combine(c Response) error {
+	otherER := c.(*ExportResponse)
+	if er != nil {
+		er.Rows = append(er.Rows, otherER.Rows...)
+		if err := er.Header().Combine(otherER.Header()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Combine implements the Combinable interface.
+func (cr *ClearRangeResponse) Combine(c Response) error {
+	otherCR := c.(*ClearRangeResponse)
+	if cr != nil {
+		if err := cr.Header().Combine(otherCR.Header()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Header implements the Request interface for RequestHeader.
 func (rh *Span) Header() *Span {
 	return rh
@@ -210,6 +233,16 @@ func (sr *ScanResponse) Verify(req Request) error {
 	return nil
 }
 
+// Verify verifies the integrity of every value returned in the export.
+func (er *ExportResponse) Verify(req Request) error {
+	for _, kv := range er.Rows {
+		if err := kv.Value.Verify(kv.Key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Verify verifies the integrity of every value returned in the reverse scan.
 func (sr *ReverseScanResponse) Verify(req Request) error {
 	for _, kv := range sr.Rows {
@@ -257,6 +290,16 @@ func (sr *ReverseScanRequest) SetBound(bound int64) {
 	sr.MaxResults = bound
 }
 
+// GetBound returns the MaxResults field in ExportRequest.
+func (er *ExportRequest) GetBound() int64 {
+	return er.MaxResults
+}
+
+// SetBound sets the MaxResults field in ExportRequest.
+func (er *ExportRequest) SetBound(bound int64) {
+	er.MaxResults = bound
+}
+
 // Countable is implemented by response types which have a number of
 // result rows, such as Scan.
 type Countable interface {
@@ -273,6 +316,17 @@ func (sr *ReverseScanResponse) Count() int64 {
 	return int64(len(sr.Rows))
 }
 
+// Count returns the number of keys whose revisions are in ExportResponse.
+func (er *ExportResponse) Count() int64 {
+	var count int64
+	for i := range er.Rows {
+		if i == 0 || !er.Rows[i].Key.Equal(er.Rows[i-1].Key) {
+			count++
+		}
+	}
+	return count
+}
+
 // Method implements the Request interface.
 func (*GetRequest) Method() Method { return Get }
 
@@ -339,6 +393,15 @@ func (*TruncateLogRequest) Method() Method { return TruncateLog }
 // Method implements the Request interface.
 func (*LeaderLeaseRequest) Method() Method { return LeaderLease }
 
+// Method implements the Request interface.
+func (*TransferLeaseRequest) Method() Method { return TransferLease }
+
+// Method implements the Request interface.
+func (*ExportRequest) Method() Method { return Export }
+
+// Method implements the Request interface.
+func (*ClearRangeRequest) Method() Method { return ClearRange }
+
 // CreateReply implements the Request interface.
 func (*GetRequest) CreateReply() Response { return &GetResponse{} }
 
@@ -407,6 +470,15 @@ func (*TruncateLogRequest) CreateReply() Response { return &TruncateLogResponse{
 // CreateReply implements the Request interface.
 func (*LeaderLeaseRequest) CreateReply() Response { return &LeaderLeaseResponse{} }
 
+// CreateReply implements the Request interface.
+func (*TransferLeaseRequest) CreateReply() Response { return &TransferLeaseResponse{} }
+
+// CreateReply implements the Request interface.
+func (*ExportRequest) CreateReply() Response { return &ExportResponse{} }
+
+// CreateReply implements the Request interface.
+func (*ClearRangeRequest) CreateReply() Response { return &ClearRangeResponse{} }
+
 // NewGet returns a Request initialized to get the value at key.
 func NewGet(key Key) Request {
 	return &GetRequest{
@@ -532,3 +604,6 @@ func (*NoopRequest) flags() int               { return isRead } // slightly spec
 func (*MergeRequest) flags() int              { return isWrite }
 func (*TruncateLogRequest) flags() int        { return isWrite }
 func (*LeaderLeaseRequest) flags() int        { return isWrite }
+func (*TransferLeaseRequest) flags() int      { return isWrite }
+func (*ExportRequest) flags() int             { return isRead | isRange | isTxn }
+func (*ClearRangeRequest) flags() int         { return isWrite | isRange | isAlone }
@@ -105,6 +105,65 @@ func (a Attributes) SortedString() string {
 	return strings.Join(attrs, ",")
 }
 
+// String returns the tier in "key=value" form.
+func (t Tier) String() string {
+	return t.Key + "=" + t.Value
+}
+
+// String returns a comma-separated list of the locality's tiers.
+func (l Locality) String() string {
+	tiers := make([]string, len(l.Tiers))
+	for i, t := range l.Tiers {
+		tiers[i] = t.String()
+	}
+	return strings.Join(tiers, ",")
+}
+
+// Type returns the name of the flag type; it implements pflag.Value.
+func (l *Locality) Type() string {
+	return "Locality"
+}
+
+// Set parses a comma-separated list of "key=value" tiers into the
+// locality. It implements flag.Value.
+func (l *Locality) Set(value string) error {
+	var tiers []Tier
+	if value != "" {
+		for _, tier := range strings.Split(value, ",") {
+			parts := strings.Split(tier, "=")
+			if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+				return util.Errorf("invalid locality tier %q; expected key=value", tier)
+			}
+			tiers = append(tiers, Tier{Key: parts[0], Value: parts[1]})
+		}
+	}
+	l.Tiers = tiers
+	return nil
+}
+
+// DiversityScore returns a score between 0 and 1 describing how diverse
+// the two localities are: 0 if they share all tiers, 1 if they differ
+// already in the first tier, and the fraction of tiers from the first
+// differing one on otherwise. Tiers are compared by position and
+// only their values are considered. Localities of which one is a
+// strict prefix of the other are considered slightly diverse, and an
+// empty locality isn't diverse from any other.
+func (l Locality) DiversityScore(other Locality) float64 {
+	length := len(l.Tiers)
+	if len(other.Tiers) < length {
+		length = len(other.Tiers)
+	}
+	for i := 0; i < length; i++ {
+		if l.Tiers[i].Value != other.Tiers[i].Value {
+			return float64(length-i) / float64(length)
+		}
+	}
+	if length > 0 && len(l.Tiers) != len(other.Tiers) {
+		return 1 / float64(length+1)
+	}
+	return 0
+}
+
 // ContainsKey returns whether this RangeDescriptor contains the specified key.
 func (r *RangeDescriptor) ContainsKey(key RKey) bool {
 	rs := RSpan{Key: r.StartKey, EndKey: r.EndKey}
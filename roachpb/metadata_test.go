@@ -61,6 +61,66 @@ func TestAttributesSortedString(t *testing.T) {
 	}
 }
 
+func TestLocalitySet(t *testing.T) {
+	testCases := []struct {
+		value    string
+		expected string
+		err      bool
+	}{
+		{"", "", false},
+		{"region=us", "region=us", false},
+		{"region=us,dc=1,rack=3", "region=us,dc=1,rack=3", false},
+		{"region", "", true},
+		{"region=", "", true},
+		{"=us", "", true},
+		{"region=us,,dc=1", "", true},
+		{"region=us=east", "", true},
+	}
+	for i, test := range testCases {
+		var l Locality
+		err := l.Set(test.value)
+		if (err != nil) != test.err {
+			t.Errorf("%d: expected error %t; got %v", i, test.err, err)
+			continue
+		}
+		if s := l.String(); err == nil && s != test.expected {
+			t.Errorf("%d: expected %q; got %q", i, test.expected, s)
+		}
+	}
+}
+
+func TestLocalityDiversityScore(t *testing.T) {
+	parse := func(s string) Locality {
+		var l Locality
+		if err := l.Set(s); err != nil {
+			t.Fatal(err)
+		}
+		return l
+	}
+	testCases := []struct {
+		a, b     string
+		expected float64
+	}{
+		{"", "", 0},
+		{"", "region=us", 0},
+		{"region=us,dc=1,rack=1", "region=us,dc=1,rack=1", 0},
+		{"region=us,dc=1,rack=1", "region=us,dc=1,rack=2", 1.0 / 3},
+		{"region=us,dc=1,rack=1", "region=us,dc=2,rack=1", 2.0 / 3},
+		{"region=us,dc=1,rack=1", "region=eu,dc=1,rack=1", 1},
+		{"region=us,dc=1", "region=us,dc=2", 0.5},
+		{"region=us", "region=us,dc=1", 0.5},
+	}
+	for i, test := range testCases {
+		a, b := parse(test.a), parse(test.b)
+		if score := a.DiversityScore(b); score != test.expected {
+			t.Errorf("%d: expected diversity of %s and %s to be %f; got %f", i, a, b, test.expected, score)
+		}
+		if score := b.DiversityScore(a); score != test.expected {
+			t.Errorf("%d: expected diversity score to be symmetric; got %f", i, score)
+		}
+	}
+}
+
 func TestRangeDescriptorFindReplica(t *testing.T) {
 	desc := RangeDescriptor{
 		Replicas: []ReplicaDescriptor{
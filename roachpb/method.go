@@ -103,6 +103,15 @@ const (
 	TruncateLog
 	// LeaderLease requests a leader lease for a replica.
 	LeaderLease
+	// TransferLease hands the leader lease off from its current holder to
+	// another replica.
+	TransferLease
+	// Export returns the key/value revisions of a key range, for backups.
+	Export
+	// ClearRange removes all the key/value revisions of a key range from
+	// the storage engine, without writing MVCC tombstones. The call goes
+	// through Raft, so all range replicas clear the exact same keys.
+	ClearRange
 	// Batch implements batch processing of commands. This is a
 	// superset of the Batch method.
 	Batch
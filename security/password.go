@@ -69,6 +69,15 @@ func hashPassword(raw []byte) ([]byte, error) {
 	return bcrypt.GenerateFromPassword(raw, bcryptCost)
 }
 
+// HashPassword returns a bcrypt hashed password, refusing empty passwords.
+func HashPassword(password []byte) ([]byte, error) {
+	// TODO(marc): we may want to have a minimum length.
+	if len(password) == 0 {
+		return nil, util.Errorf("password cannot be empty")
+	}
+	return hashPassword(password)
+}
+
 // PromptForPasswordAndHash prompts for a password on the stdin twice,
 // and if both match, returns a bcrypt hashed password.
 func PromptForPasswordAndHash() ([]byte, error) {
@@ -76,9 +85,5 @@ func PromptForPasswordAndHash() ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	// TODO(marc): we may want to have a minimum length.
-	if len(password) == 0 {
-		return nil, util.Errorf("password cannot be empty")
-	}
-	return hashPassword(password)
+	return HashPassword(password)
 }
@@ -24,6 +24,8 @@ import (
 	_ "expvar"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	// Register the net/trace endpoint with http.DefaultServeMux.
@@ -33,6 +35,8 @@ import (
 	_ "net/http/pprof"
 
 	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/config"
+	"github.com/cockroachdb/cockroach/sql"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/stop"
 )
@@ -59,6 +63,13 @@ const (
 	healthPath = adminEndpoint + "health"
 	// quitPath is the quit endpoint.
 	quitPath = adminEndpoint + "quit"
+	// drainPath is the endpoint which drains the node before shutting it down.
+	drainPath = adminEndpoint + "drain"
+	// decommissionPath is the endpoint which decommissions the node.
+	decommissionPath = adminEndpoint + "decommission"
+	// protectedTimestampsPath is the endpoint which lists and releases
+	// protected timestamp records.
+	protectedTimestampsPath = adminEndpoint + "protected_timestamps"
 )
 
 // An actionHandler is an interface which provides Get, Put & Delete
@@ -74,21 +85,27 @@ type actionHandler interface {
 type adminServer struct {
 	db      *client.DB    // Key-value database client
 	stopper *stop.Stopper // Used to shutdown the server
+	server  *Server       // Used to drain and decommission the node
 	mux     *http.ServeMux
 }
 
 // newAdminServer allocates and returns a new REST server for
 // administrative APIs.
-func newAdminServer(db *client.DB, stopper *stop.Stopper) *adminServer {
+func newAdminServer(db *client.DB, stopper *stop.Stopper, s *Server) *adminServer {
 	server := &adminServer{
 		db:      db,
 		stopper: stopper,
+		server:  s,
 		mux:     http.NewServeMux(),
 	}
 
 	server.mux.HandleFunc(debugEndpoint, server.handleDebug)
 	server.mux.HandleFunc(healthPath, server.handleHealth)
 	server.mux.HandleFunc(quitPath, server.handleQuit)
+	server.mux.HandleFunc(drainPath, server.handleDrain)
+	server.mux.HandleFunc(decommissionPath, server.handleDecommission)
+	server.mux.HandleFunc(protectedTimestampsPath, server.handleProtectedTimestamps)
+	server.mux.HandleFunc(protectedTimestampsPath+"/", server.handleProtectedTimestamps)
 	return server
 }
 
@@ -114,6 +131,72 @@ func (s *adminServer) handleQuit(w http.ResponseWriter, r *http.Request) {
 	}()
 }
 
+// handleDrain is the graceful shutdown hook. The server is drained (see
+// Server.Drain) before responding, after which it exits.
+func (s *adminServer) handleDrain(w http.ResponseWriter, r *http.Request) {
+	remaining := s.server.Drain()
+	w.Header().Set(util.ContentTypeHeader, util.PlaintextContentType)
+	if remaining > 0 {
+		fmt.Fprintf(w, "ok (%d leader leases left to expire)\n", remaining)
+	} else {
+		fmt.Fprintln(w, "ok")
+	}
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		s.stopper.Stop()
+	}()
+}
+
+// handleDecommission marks the node as decommissioning, upon which
+// the cluster moves all replicas off of its stores.
+func (s *adminServer) handleDecommission(w http.ResponseWriter, r *http.Request) {
+	if err := s.server.node.SetDecommissioning(true); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set(util.ContentTypeHeader, util.PlaintextContentType)
+	fmt.Fprintln(w, "ok")
+}
+
+// handleProtectedTimestamps lists the protected timestamp records on GET,
+// and releases the record with the ID given as the last path component on
+// DELETE.
+func (s *adminServer) handleProtectedTimestamps(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		var records []config.ProtectedTimestamp
+		if err := s.db.Txn(func(txn *client.Txn) error {
+			var err error
+			records, err = sql.ListProtectedTimestamps(txn)
+			return err
+		}); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if records == nil {
+			records = []config.ProtectedTimestamp{}
+		}
+		respondAsJSON(w, r, records)
+	case "DELETE":
+		idParam := strings.TrimPrefix(r.URL.Path, protectedTimestampsPath+"/")
+		id, err := strconv.ParseInt(idParam, 10, 64)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid protected timestamp ID %q", idParam), http.StatusBadRequest)
+			return
+		}
+		if err := s.db.Txn(func(txn *client.Txn) error {
+			return sql.ReleaseProtectedTimestamp(txn, id)
+		}); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set(util.ContentTypeHeader, util.PlaintextContentType)
+		fmt.Fprintln(w, "ok")
+	default:
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+	}
+}
+
 // handleDebug passes requests with the debugPathPrefix onto the default
 // serve mux, which is preconfigured (by import of expvar and net/http/pprof)
 // to serve endpoints which access exported variables and pprof tools.
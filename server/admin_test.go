@@ -20,9 +20,14 @@ package server
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
+	"net/http"
 	"testing"
+	"time"
 
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/sql"
 	"github.com/cockroachdb/cockroach/testutils"
 	"github.com/cockroachdb/cockroach/util/leaktest"
 )
@@ -118,3 +123,70 @@ func TestAdminNetTrace(t *testing.T) {
 		}
 	}
 }
+
+// TestAdminProtectedTimestamps verifies that protected timestamp records
+// are listed and released via the /_admin/protected_timestamps endpoint.
+func TestAdminProtectedTimestamps(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := StartTestServer(t)
+	defer s.Stop()
+
+	url := s.Ctx.HTTPRequestScheme() + "://" + s.ServingAddr() + protectedTimestampsPath
+	list := func() []interface{} {
+		jI, err := getJSON(url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return jI.([]interface{})
+	}
+	request := func(method, url string) int {
+		client, err := testutils.NewTestBaseContext(TestUser).GetHTTPClient()
+		if err != nil {
+			t.Fatal(err)
+		}
+		req, err := http.NewRequest(method, url, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	if records := list(); len(records) != 0 {
+		t.Fatalf("expected no protected timestamps, got %v", records)
+	}
+
+	ts := s.Clock().Now()
+	span := roachpb.Span{Key: roachpb.Key("a"), EndKey: roachpb.Key("b")}
+	id, err := sql.ProtectTimestamp(s.DB(), span, ts, ts.Add(time.Hour.Nanoseconds(), 0), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	records := list()
+	if len(records) != 1 {
+		t.Fatalf("expected 1 protected timestamp, got %v", records)
+	}
+	record := records[0].(map[string]interface{})
+	if record["id"] != float64(id) || record["description"] != "test" {
+		t.Errorf("unexpected protected timestamp %v", record)
+	}
+
+	idURL := fmt.Sprintf("%s/%d", url, id)
+	if code := request("DELETE", idURL); code != http.StatusOK {
+		t.Fatalf("expected status %d releasing the protected timestamp, got %d", http.StatusOK, code)
+	}
+	if records := list(); len(records) != 0 {
+		t.Fatalf("expected no protected timestamps, got %v", records)
+	}
+	if code := request("DELETE", idURL); code != http.StatusInternalServerError {
+		t.Errorf("expected status %d releasing a released protected timestamp, got %d",
+			http.StatusInternalServerError, code)
+	}
+	if code := request("POST", url); code != http.StatusMethodNotAllowed {
+		t.Errorf("expected status %d, got %d", http.StatusMethodNotAllowed, code)
+	}
+}
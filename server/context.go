@@ -44,6 +44,7 @@ const (
 	defaultScanMaxIdleTime    = 5 * time.Second
 	defaultMetricsFrequency   = 10 * time.Second
 	defaultTimeUntilStoreDead = 5 * time.Minute
+	defaultDrainTimeout       = 10 * time.Second
 	defaultBalanceMode        = storage.BalanceModeUsage
 )
 
@@ -59,7 +60,8 @@ type Context struct {
 
 	// Stores is specified to enable durable key-value storage.
 	// Memory-backed key value stores may be optionally specified
-	// via mem=<integer byte size>.
+	// via mem=<integer byte size>, or via mem=gomem:<integer byte size>
+	// for the pure-Go in-memory engine.
 	//
 	// Stores specify a comma-separated list of stores specified by a
 	// colon-separated list of device attributes followed by '=' and
@@ -68,6 +70,12 @@ type Context struct {
 	// flash (ssd), spinny disk (hdd), fusion-io (fio), in-memory (mem); device
 	// attributes might also include speeds and other specs (7200rpm, 200kiops, etc.).
 	// For example, -store=hdd:7200rpm=/mnt/hda1,ssd=/mnt/ssd01,ssd=/mnt/ssd02,mem=1073741824
+	//
+	// The filepath of a persistent store may be followed by semicolon-separated
+	// encryption options: key=<key file> encrypts the store at rest with the
+	// key in the file, and old-key=<key file>, which may be repeated, names
+	// a key the store was previously encrypted with, which remains usable
+	// until the store is rewritten with the new key.
 	Stores string
 
 	// Attrs specifies a colon-separated list of node topography or machine
@@ -75,6 +83,11 @@ type Context struct {
 	// in zone configs.
 	Attrs string
 
+	// Locality is the node's position in the hierarchy of failure domains
+	// (e.g. region=us,dc=1,rack=3). Replicas are spread across localities
+	// and requests are preferably sent to nearby replicas.
+	Locality roachpb.Locality
+
 	// Maximum clock offset for the cluster.
 	MaxOffset time.Duration
 
@@ -125,6 +138,11 @@ type Context struct {
 	// TimeUntilStoreDead is the time after which if there is no new gossiped
 	// information about a store, it is considered dead.
 	TimeUntilStoreDead time.Duration
+
+	// DrainTimeout is the maximum time the server waits for open SQL
+	// transactions to finish when draining before closing their
+	// connections.
+	DrainTimeout time.Duration
 }
 
 // NewContext returns a Context with default values.
@@ -144,6 +162,7 @@ func (ctx *Context) InitDefaults() {
 	ctx.ScanMaxIdleTime = defaultScanMaxIdleTime
 	ctx.MetricsFrequency = defaultMetricsFrequency
 	ctx.TimeUntilStoreDead = defaultTimeUntilStoreDead
+	ctx.DrainTimeout = defaultDrainTimeout
 	ctx.BalanceMode = defaultBalanceMode
 }
 
@@ -200,12 +219,37 @@ func (ctx *Context) InitNode() error {
 
 var errUnsizedInMemStore = errors.New("unable to initialize an in-memory store with capacity 0")
 
+// goInMemPrefix prefixes the size of pure-Go in-memory stores.
+const goInMemPrefix = "gomem:"
+
 // initEngine parses the store attributes as a colon-separated list
 // and instantiates an engine based on the dir parameter. If dir parses
-// to an integer, it's taken to mean an in-memory engine; otherwise,
-// dir is treated as a path and a RocksDB engine is created.
+// to an integer, it's taken to mean an in-memory engine, and if it is
+// an integer prefixed by "gomem:", a pure-Go in-memory engine;
+// otherwise, dir is treated as a path and a RocksDB engine is created,
+// encrypted if the path is followed by encryption options.
 func (ctx *Context) initEngine(attrsStr, path string, stopper *stop.Stopper) (engine.Engine, error) {
 	attrs := parseAttributes(attrsStr)
+	if i := strings.Index(path, ";"); i >= 0 {
+		if _, err := strconv.ParseUint(path[:i], 10, 64); err == nil || strings.HasPrefix(path, goInMemPrefix) {
+			return nil, util.Errorf("in-memory store %q cannot be encrypted", path[:i])
+		}
+		encryption, err := parseEncryptionOptions(path[i+1:])
+		if err != nil {
+			return nil, err
+		}
+		return engine.NewEncryptedRocksDB(attrs, path[:i], ctx.CacheSize, encryption, stopper), nil
+	}
+	if strings.HasPrefix(path, goInMemPrefix) {
+		size, err := strconv.ParseUint(strings.TrimPrefix(path, goInMemPrefix), 10, 64)
+		if err != nil {
+			return nil, util.Errorf("unable to parse size of in-memory store %q: %s", path, err)
+		}
+		if size == 0 {
+			return nil, errUnsizedInMemStore
+		}
+		return engine.NewGoInMem(attrs, int64(size), stopper), nil
+	}
 	if size, err := strconv.ParseUint(path, 10, 64); err == nil {
 		if size == 0 {
 			return nil, errUnsizedInMemStore
@@ -215,6 +259,37 @@ func (ctx *Context) initEngine(attrsStr, path string, stopper *stop.Stopper) (en
 	return engine.NewRocksDB(attrs, path, ctx.CacheSize, stopper), nil
 }
 
+// parseEncryptionOptions parses the semicolon-separated encryption options
+// of a store and loads the key files they name.
+func parseEncryptionOptions(options string) (engine.EncryptionOptions, error) {
+	var encryption engine.EncryptionOptions
+	for _, option := range strings.Split(options, ";") {
+		kv := strings.SplitN(option, "=", 2)
+		if len(kv) != 2 || kv[1] == "" {
+			return encryption, util.Errorf("invalid store encryption option %q", option)
+		}
+		key, err := engine.LoadEncryptionKey(kv[1])
+		if err != nil {
+			return encryption, err
+		}
+		switch kv[0] {
+		case "key":
+			if encryption.ActiveKey.ID != "" {
+				return encryption, util.Errorf("more than one store encryption key specified")
+			}
+			encryption.ActiveKey = key
+		case "old-key":
+			encryption.OldKeys = append(encryption.OldKeys, key)
+		default:
+			return encryption, util.Errorf("unknown store encryption option %q", kv[0])
+		}
+	}
+	if encryption.ActiveKey.ID == "" {
+		return encryption, util.Errorf("store encryption options %q do not specify a key", options)
+	}
+	return encryption, nil
+}
+
 // SelfGossipAddr is a special flag that configures a node to gossip
 // only with itself. This avoids having to specify the port twice for
 // single-node clusters (i.e. once in --addr, and again in --gossip).
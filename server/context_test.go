@@ -18,10 +18,15 @@
 package server
 
 import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
 	"reflect"
 	"testing"
 
 	"github.com/cockroachdb/cockroach/gossip/resolver"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/leaktest"
 	"github.com/cockroachdb/cockroach/util/stop"
 )
@@ -74,3 +79,84 @@ func TestParseGossipBootstrapAddrs(t *testing.T) {
 		t.Fatalf("Unexpected bootstrap addresses: %v, expected: %v", ctx.GossipBootstrapResolvers, expected)
 	}
 }
+
+// TestInitStoresGoInMem verifies that "gomem:" store specifications
+// create pure-Go in-memory engines.
+func TestInitStoresGoInMem(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	ctx := NewContext()
+	ctx.Stores = "mem=gomem:1048576,ssd=1048576"
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+	if err := ctx.InitStores(stopper); err != nil {
+		t.Fatalf("Failed to initialize stores: %s", err)
+	}
+	if len(ctx.Engines) != 2 {
+		t.Fatalf("expected 2 engines; got %d", len(ctx.Engines))
+	}
+	if _, ok := ctx.Engines[0].(*engine.GoInMem); !ok {
+		t.Errorf("expected a pure-Go in-memory engine; got %T", ctx.Engines[0])
+	}
+	if _, ok := ctx.Engines[1].(engine.InMem); !ok {
+		t.Errorf("expected an in-memory engine; got %T", ctx.Engines[1])
+	}
+
+	for _, stores := range []string{"mem=gomem:0", "mem=gomem:foo"} {
+		ctx := NewContext()
+		ctx.Stores = stores
+		if err := ctx.InitStores(stopper); err == nil {
+			t.Errorf("%s: expected an error", stores)
+		}
+	}
+}
+
+// TestInitStoresEncryption verifies that the encryption options of store
+// specifications select encrypted RocksDB engines.
+func TestInitStoresEncryption(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tmp := util.CreateNTempDirs(t, "_context_test", 2)
+	defer util.CleanupDirs(tmp)
+	keyFile := filepath.Join(tmp[1], "store.key")
+	if err := ioutil.WriteFile(keyFile, make([]byte, 32), 0600); err != nil {
+		t.Fatal(err)
+	}
+	key, err := engine.LoadEncryptionKey(keyFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := NewContext()
+	ctx.Stores = fmt.Sprintf("ssd=%s;key=%s;old-key=%s", tmp[0], keyFile, keyFile)
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+	if err := ctx.InitStores(stopper); err != nil {
+		t.Fatalf("Failed to initialize stores: %s", err)
+	}
+	if len(ctx.Engines) != 1 {
+		t.Fatalf("expected 1 engine; got %d", len(ctx.Engines))
+	}
+	r, ok := ctx.Engines[0].(*engine.RocksDB)
+	if !ok {
+		t.Fatalf("expected a RocksDB engine; got %T", ctx.Engines[0])
+	}
+	if status, err := r.EncryptionStatus(); err != nil {
+		t.Fatal(err)
+	} else if status.ActiveKeyID != key.ID {
+		t.Errorf("expected active key %s; got %+v", key.ID, status)
+	}
+
+	for _, stores := range []string{
+		fmt.Sprintf("mem=1000;key=%s", keyFile),
+		fmt.Sprintf("ssd=%s;key=", tmp[0]),
+		fmt.Sprintf("ssd=%s;key=%s", tmp[0], filepath.Join(tmp[1], "missing.key")),
+		fmt.Sprintf("ssd=%s;cipher=%s", tmp[0], keyFile),
+		fmt.Sprintf("ssd=%s;old-key=%s", tmp[0], keyFile),
+		fmt.Sprintf("ssd=%s;key=%s;key=%s", tmp[0], keyFile, keyFile),
+	} {
+		ctx := NewContext()
+		ctx.Stores = stores
+		if err := ctx.InitStores(stopper); err == nil {
+			t.Errorf("%s: expected an error", stores)
+		}
+	}
+}
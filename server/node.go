@@ -174,10 +174,11 @@ func (n *Node) context() context.Context {
 }
 
 // initDescriptor initializes the node descriptor with the server
-// address and the node attributes.
-func (n *Node) initDescriptor(addr net.Addr, attrs roachpb.Attributes) {
+// address, the node attributes and the node locality.
+func (n *Node) initDescriptor(addr net.Addr, attrs roachpb.Attributes, locality roachpb.Locality) {
 	n.Descriptor.Address = util.MakeUnresolvedAddr(addr.Network(), addr.String())
 	n.Descriptor.Attrs = attrs
+	n.Descriptor.Locality = locality
 }
 
 // initNodeID updates the internal NodeDescriptor with the given ID. If zero is
@@ -223,8 +224,8 @@ func (n *Node) initNodeID(id roachpb.NodeID) {
 // RPC service "Node" and initializing stores for each specified
 // engine. Launches periodic store gossiping in a goroutine.
 func (n *Node) start(rpcServer *rpc.Server, engines []engine.Engine,
-	attrs roachpb.Attributes, stopper *stop.Stopper) error {
-	n.initDescriptor(rpcServer.Addr(), attrs)
+	attrs roachpb.Attributes, locality roachpb.Locality, stopper *stop.Stopper) error {
+	n.initDescriptor(rpcServer.Addr(), attrs, locality)
 	const method = "Node.Batch"
 	if err := rpcServer.Register(method, n.executeCmd, &roachpb.BatchRequest{}); err != nil {
 		log.Fatalf("unable to register node service with RPC server: %s", err)
@@ -238,6 +239,21 @@ func (n *Node) start(rpcServer *rpc.Server, engines []engine.Engine,
 		return err
 	}
 
+	// A node restarted while being decommissioned remains decommissioning,
+	// which requires the decommissioning state to be gossiped again.
+	var decommissioning bool
+	if err := n.stores.VisitStores(func(s *storage.Store) error {
+		decommissioning = decommissioning || s.IsDecommissioning()
+		return nil
+	}); err != nil {
+		return err
+	}
+	if decommissioning {
+		if err := n.SetDecommissioning(true); err != nil {
+			return err
+		}
+	}
+
 	n.startedAt = n.ctx.Clock.Now().WallTime
 
 	// Initialize publisher for Node Events. This requires the NodeID, which is
@@ -421,6 +437,45 @@ func (n *Node) gossipStores() {
 	}
 }
 
+// SetDraining (when true) puts the node's stores into draining mode, in
+// which they transfer their leader leases to other nodes and don't
+// acquire new ones. It returns the number of leases which could not be
+// transferred.
+func (n *Node) SetDraining(drain bool) int {
+	var remaining int
+	if err := n.stores.VisitStores(func(s *storage.Store) error {
+		remaining += s.SetDraining(drain)
+		return nil
+	}); err != nil {
+		panic(err)
+	}
+	return remaining
+}
+
+// SetDecommissioning marks the node as being decommissioned (or not) and
+// gossips the updated node and store descriptors, upon which the
+// allocators on all nodes start moving replicas off of this node's
+// stores. The setting is persisted in the stores and reapplied when the
+// node is restarted.
+//
+// n.Descriptor is shared with the stores, which read it concurrently, so
+// it is left untouched; the flag is kept by the stores and gossiped on a
+// copy of the descriptor.
+func (n *Node) SetDecommissioning(decommission bool) error {
+	if err := n.stores.VisitStores(func(s *storage.Store) error {
+		return s.SetDecommissioning(decommission)
+	}); err != nil {
+		return err
+	}
+	desc := n.Descriptor
+	desc.Decommissioning = decommission
+	if err := n.ctx.Gossip.SetNodeDescriptor(&desc); err != nil {
+		return err
+	}
+	n.gossipStores()
+	return nil
+}
+
 // startPublishStatuses starts a loop which periodically instructs each store to
 // publish its current status to the event feed.
 func (n *Node) startPublishStatuses(stopper *stop.Stopper) {
@@ -84,7 +84,7 @@ func createTestNode(addr net.Addr, engines []engine.Engine, gossipBS net.Addr, t
 func createAndStartTestNode(addr net.Addr, engines []engine.Engine, gossipBS net.Addr, t *testing.T) (
 	*rpc.Server, *Node, *stop.Stopper) {
 	rpcServer, _, node, stopper := createTestNode(addr, engines, gossipBS, t)
-	if err := node.start(rpcServer, engines, roachpb.Attributes{}, stopper); err != nil {
+	if err := node.start(rpcServer, engines, roachpb.Attributes{}, roachpb.Locality{}, stopper); err != nil {
 		t.Fatal(err)
 	}
 	return rpcServer, node, stopper
@@ -269,7 +269,7 @@ func TestCorruptedClusterID(t *testing.T) {
 
 	engines := []engine.Engine{e}
 	server, _, node, stopper := createTestNode(util.CreateTestAddr("tcp"), engines, nil, t)
-	if err := node.start(server, engines, roachpb.Attributes{}, stopper); err == nil {
+	if err := node.start(server, engines, roachpb.Attributes{}, roachpb.Locality{}, stopper); err == nil {
 		t.Errorf("unexpected success")
 	}
 	stopper.Stop()
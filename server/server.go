@@ -70,7 +70,7 @@ type Server struct {
 	db            *client.DB
 	kvDB          *kv.DBServer
 	sqlServer     sql.Server
-	pgServer      pgwire.Server
+	pgServer      *pgwire.Server
 	node          *Node
 	recorder      *status.NodeStatusRecorder
 	admin         *adminServer
@@ -145,7 +145,7 @@ func NewServer(ctx *Context, stopper *stop.Stopper) (*Server, error) {
 		return nil, err
 	}
 
-	s.pgServer = pgwire.MakeServer(&pgwire.Context{
+	s.pgServer = pgwire.NewServer(&pgwire.Context{
 		Context:  &s.ctx.Context,
 		Executor: s.sqlServer.Executor,
 		Stopper:  stopper,
@@ -168,8 +168,8 @@ func NewServer(ctx *Context, stopper *stop.Stopper) (*Server, error) {
 		},
 	}
 	s.node = NewNode(nCtx)
-	s.admin = newAdminServer(s.db, s.stopper)
-	s.status = newStatusServer(s.db, s.gossip, ctx)
+	s.admin = newAdminServer(s.db, s.stopper, s)
+	s.status = newStatusServer(s.db, s.gossip, s.sqlServer.Executor, ctx)
 	s.tsDB = ts.NewDB(s.db)
 	s.tsServer = ts.NewServer(s.tsDB)
 
@@ -197,7 +197,7 @@ func (s *Server) Start(selfBootstrap bool) error {
 	}
 	s.gossip.Start(s.rpc, s.stopper)
 
-	if err := s.node.start(s.rpc, s.ctx.Engines, s.ctx.NodeAttributes, s.stopper); err != nil {
+	if err := s.node.start(s.rpc, s.ctx.Engines, s.ctx.NodeAttributes, s.ctx.Locality, s.stopper); err != nil {
 		return err
 	}
 
@@ -213,6 +213,7 @@ func (s *Server) Start(selfBootstrap bool) error {
 	s.startWriteSummaries()
 
 	s.sqlServer.SetNodeID(s.node.Descriptor.NodeID)
+	s.sqlServer.Start(s.stopper)
 
 	log.Infof("starting %s server at %s", s.ctx.HTTPRequestScheme(), addr)
 	s.initHTTP()
@@ -301,6 +302,28 @@ func (s *Server) writeSummaries() error {
 	return nil
 }
 
+// drainPollInterval is the interval at which Drain checks whether all
+// SQL client connections have been closed.
+const drainPollInterval = 10 * time.Millisecond
+
+// Drain puts the server into draining mode. SQL clients which aren't in
+// the middle of a transaction are turned away, while clients with an
+// open transaction are given until the drain timeout to finish it, after
+// which their connections are closed. Finally, the leader leases held by
+// the node's stores are transferred to other nodes. Drain returns the
+// number of leases which could not be transferred. All other traffic
+// (e.g. KV and Raft) is still served so the node can be stopped cleanly.
+func (s *Server) Drain() int {
+	s.sqlServer.SetDraining(true)
+	s.pgServer.SetDraining(true)
+	deadline := time.Now().Add(s.ctx.DrainTimeout)
+	for s.pgServer.NumConns() > 0 && time.Now().Before(deadline) {
+		time.Sleep(drainPollInterval)
+	}
+	s.pgServer.CloseConns()
+	return s.node.SetDraining(true)
+}
+
 // Stop stops the server.
 func (s *Server) Stop() {
 	s.stopper.Stop()
@@ -353,6 +376,19 @@ func (w *gzipResponseWriter) Write(b []byte) (int, error) {
 	return w.WriteCloser.Write(b)
 }
 
+// Flush implements the http.Flusher interface, sending the data compressed
+// so far to the client.
+func (w *gzipResponseWriter) Flush() {
+	if gz, ok := w.WriteCloser.(*gzip.Writer); ok {
+		if err := gz.Flush(); err != nil {
+			return
+		}
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
 func (w *gzipResponseWriter) Close() {
 	if w.WriteCloser != nil {
 		w.WriteCloser.Close()
@@ -384,6 +420,14 @@ func (w *snappyResponseWriter) Write(b []byte) (int, error) {
 	return w.Writer.Write(b)
 }
 
+// Flush implements the http.Flusher interface. The snappy writer compresses
+// each write as it happens, so only the underlying writer needs flushing.
+func (w *snappyResponseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
 func (w *snappyResponseWriter) Close() {
 	if w.Writer != nil {
 		snappyWriterPool.Put(w.Writer)
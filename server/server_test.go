@@ -20,9 +20,11 @@ package server
 import (
 	"bytes"
 	"compress/gzip"
+	gosql "database/sql"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"strings"
 	"sync/atomic"
@@ -45,6 +47,7 @@ import (
 	"github.com/cockroachdb/cockroach/util/leaktest"
 	"github.com/cockroachdb/cockroach/util/stop"
 	"github.com/gogo/protobuf/proto"
+	_ "github.com/lib/pq"
 )
 
 var testContext = NewTestContext()
@@ -486,6 +489,68 @@ func TestSQLServer(t *testing.T) {
 	}
 }
 
+// TestServerDrain verifies that a draining server turns away new SQL
+// clients while letting open transactions finish.
+func TestServerDrain(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := StartTestServer(t)
+	defer s.Stop()
+
+	host, port, err := net.SplitHostPort(s.PGAddr())
+	if err != nil {
+		t.Fatal(err)
+	}
+	datasource := fmt.Sprintf("sslmode=disable host=%s port=%s", host, port)
+	db, err := gosql.Open("postgres", datasource)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec("SELECT 1"); err != nil {
+		t.Fatal(err)
+	}
+
+	drained := make(chan int, 1)
+	go func() {
+		drained <- s.Drain()
+	}()
+	util.SucceedsWithin(t, time.Second, func() error {
+		if !s.sqlServer.IsDraining() {
+			return util.Errorf("server is not draining yet")
+		}
+		return nil
+	})
+
+	// New clients are turned away.
+	db2, err := gosql.Open("postgres", datasource)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db2.Close()
+	if _, err := db2.Exec("SELECT 1"); err == nil {
+		t.Fatal("expected draining server to refuse new client")
+	}
+
+	// The open transaction can still finish.
+	if _, err := tx.Exec("SELECT 1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-drained:
+	case <-time.After(5 * time.Second):
+		t.Fatal("server did not finish draining")
+	}
+}
+
 func TestSystemDBGossip(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	s := StartTestServer(t)
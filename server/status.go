@@ -32,7 +32,9 @@ import (
 	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/server/status"
+	"github.com/cockroachdb/cockroach/sql"
 	"github.com/cockroachdb/cockroach/storage"
+	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/log"
 	"github.com/julienschmidt/httprouter"
@@ -51,10 +53,14 @@ const (
 		/_status/logs/:node_id           - log entries from a specific node
 		/_status/stacks/:node_id		 - exposes stack traces of running
 										   goroutines
+		/_status/statements/:node_id     - statistics of the SQL statements
+										   executed by a specific node
 		/_status/nodes				     - all nodes' status
 		/_status/nodes/:node_id		     - a specific node's status
 		/_status/stores                  - all stores' status
 		/_status/stores/:store_id        - a specific store's status
+		/_status/tables                  - all tables' data stats
+		/_status/tables/:table_id        - a specific table's data stats
 	*/
 
 	// statusPrefix is the root of the cluster statistics and metrics API.
@@ -81,6 +87,10 @@ const (
 	// stackTraceApproxSize is the approximate size of a goroutine stack trace.
 	stackTraceApproxSize = 1024
 
+	// statusStatementsPattern exposes the statistics of the SQL statements
+	// executed by a node.
+	statusStatementsPattern = "/_status/statements/:node_id"
+
 	// statusNodesPrefix exposes status for all nodes in the cluster.
 	statusNodesPrefix = "/_status/nodes/"
 	// statusNodePattern exposes status for a single node.
@@ -91,6 +101,11 @@ const (
 	// statusStorePattern exposes status for a single store.
 	statusStorePattern = "/_status/stores/:store_id"
 
+	// statusTablesPrefix exposes the stats of the data of all tables.
+	statusTablesPrefix = "/_status/tables/"
+	// statusTablePattern exposes the stats of the data of a single table.
+	statusTablePattern = "/_status/tables/:table_id"
+
 	// healthEndpoint is a shortcut for local details, intended for use by
 	// monitoring processes to verify that the server is up.
 	healthEndpoint = "/health"
@@ -106,10 +121,12 @@ type statusServer struct {
 	router      *httprouter.Router
 	ctx         *Context
 	proxyClient *http.Client
+	sqlExecutor *sql.Executor
 }
 
 // newStatusServer allocates and returns a statusServer.
-func newStatusServer(db *client.DB, gossip *gossip.Gossip, ctx *Context) *statusServer {
+func newStatusServer(db *client.DB, gossip *gossip.Gossip, sqlExecutor *sql.Executor,
+	ctx *Context) *statusServer {
 	// Create an http client with a timeout
 	tlsConfig, err := ctx.GetClientTLSConfig()
 	if err != nil {
@@ -127,6 +144,7 @@ func newStatusServer(db *client.DB, gossip *gossip.Gossip, ctx *Context) *status
 		router:      httprouter.New(),
 		ctx:         ctx,
 		proxyClient: httpClient,
+		sqlExecutor: sqlExecutor,
 	}
 
 	server.router.GET(statusGossipPattern, server.handleGossip)
@@ -135,10 +153,13 @@ func newStatusServer(db *client.DB, gossip *gossip.Gossip, ctx *Context) *status
 	server.router.GET(statusLogFilePattern, server.handleLogFile)
 	server.router.GET(statusLogsPattern, server.handleLogs)
 	server.router.GET(statusStacksPattern, server.handleStacks)
+	server.router.GET(statusStatementsPattern, server.handleStatements)
 	server.router.GET(statusNodesPrefix, server.handleNodesStatus)
 	server.router.GET(statusNodePattern, server.handleNodeStatus)
 	server.router.GET(statusStoresPrefix, server.handleStoresStatus)
 	server.router.GET(statusStorePattern, server.handleStoreStatus)
+	server.router.GET(statusTablesPrefix, server.handleTablesStats)
+	server.router.GET(statusTablePattern, server.handleTableStats)
 	server.router.GET(healthEndpoint, server.handleDetailsLocal)
 
 	return server
@@ -503,6 +524,37 @@ func (s *statusServer) handleStacks(w http.ResponseWriter, r *http.Request, ps h
 	}
 }
 
+// handleStatementsLocal handles local requests for the statistics of the SQL
+// statements.
+func (s *statusServer) handleStatementsLocal(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	statements := struct {
+		// LatencyBounds are the upper bounds of the buckets of the latency
+		// histograms, in nanoseconds.
+		LatencyBounds []time.Duration           `json:"latencyBounds"`
+		Statements    []sql.StatementStatistics `json:"statements"`
+	}{
+		LatencyBounds: sql.StatementLatencyBounds,
+		Statements:    s.sqlExecutor.StatementStatistics(),
+	}
+	respondAsJSON(w, r, statements)
+}
+
+// handleStatements handles GET requests for the statistics of the SQL
+// statements.
+func (s *statusServer) handleStatements(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	nodeID, local, err := s.extractNodeID(ps)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if local {
+		s.handleStatementsLocal(w, r, ps)
+	} else {
+		s.proxyRequest(nodeID, w, r)
+	}
+}
+
 // handleNodesStatus handles GET requests for all node statuses.
 func (s *statusServer) handleNodesStatus(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
 	startKey := keys.StatusNodePrefix
@@ -593,6 +645,83 @@ func (s *statusServer) handleStoreStatus(w http.ResponseWriter, r *http.Request,
 	respondAsJSON(w, r, storeStatus)
 }
 
+// tableStats contains the stats of the data of a table, rolled up from the
+// stats of its indexes.
+type tableStats struct {
+	TableID uint32               `json:"table_id"`
+	Stats   engine.MVCCStats     `json:"stats"`
+	Indexes []storage.IndexStats `json:"indexes"`
+}
+
+// getTablesStats rolls up the index stats of all the stores into table
+// stats. The index stats of each store are only as recent as the last
+// status it published.
+func (s *statusServer) getTablesStats() ([]tableStats, error) {
+	startKey := keys.StatusStorePrefix
+	endKey := startKey.PrefixEnd()
+
+	rows, err := s.db.Scan(startKey, endKey, 0)
+	if err != nil {
+		return nil, err
+	}
+	var indexStats []storage.IndexStats
+	for _, row := range rows {
+		storeStatus := &storage.StoreStatus{}
+		if err := row.ValueProto(storeStatus); err != nil {
+			return nil, err
+		}
+		indexStats = append(indexStats, storeStatus.IndexStats...)
+	}
+
+	tables := []tableStats{}
+	for _, is := range storage.AggregateIndexStats(indexStats) {
+		if len(tables) == 0 || tables[len(tables)-1].TableID != is.TableID {
+			tables = append(tables, tableStats{TableID: is.TableID})
+		}
+		table := &tables[len(tables)-1]
+		table.Stats.Add(&is.Stats)
+		table.Indexes = append(table.Indexes, is)
+	}
+	return tables, nil
+}
+
+// handleTablesStats handles GET requests for the stats of all tables.
+func (s *statusServer) handleTablesStats(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	tables, err := s.getTablesStats()
+	if err != nil {
+		log.Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	respondAsJSON(w, r, tables)
+}
+
+// handleTableStats handles GET requests for the stats of a single table.
+func (s *statusServer) handleTableStats(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id, err := strconv.ParseUint(ps.ByName("table_id"), 10, 32)
+	if err != nil {
+		http.Error(w,
+			fmt.Sprintf("table id could not be parsed: %s", err),
+			http.StatusBadRequest)
+		return
+	}
+
+	tables, err := s.getTablesStats()
+	if err != nil {
+		log.Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	table := tableStats{TableID: uint32(id)}
+	for _, t := range tables {
+		if t.TableID == table.TableID {
+			table = t
+			break
+		}
+	}
+	respondAsJSON(w, r, table)
+}
+
 func respondAsJSON(w http.ResponseWriter, r *http.Request, response interface{}) {
 	b, contentType, err := util.MarshalResponse(r, response, []util.EncodingType{util.JSONEncoding})
 	if err != nil {
@@ -38,10 +38,22 @@ type StoreStatusMonitor struct {
 	desc      *roachpb.StoreDescriptor
 	startedAt int64
 
+	// encryption at rest status.
+	encryption engine.EncryptionStatus
+
+	// storage engine statistics.
+	engineStats engine.Stats
+
+	// stats of the table indexes in the ranges led by the store.
+	indexStats []storage.IndexStats
+
 	// replication counts.
 	leaderRangeCount     int32
 	replicatedRangeCount int32
 	availableRangeCount  int32
+
+	// total size of the raft logs of the store's replicas.
+	raftLogSize int64
 }
 
 // NodeStatusMonitor monitors the status of a server node. Status information
@@ -176,6 +188,7 @@ func (nsm *NodeStatusMonitor) OnStoreStatus(event *storage.StoreStatusEvent) {
 	ssm.Lock()
 	defer ssm.Unlock()
 	ssm.desc = event.Desc
+	ssm.encryption = event.Encryption
 }
 
 // OnReplicationStatus receives ReplicationStatusEvents retrieved from a storage
@@ -188,6 +201,27 @@ func (nsm *NodeStatusMonitor) OnReplicationStatus(event *storage.ReplicationStat
 	ssm.leaderRangeCount = event.LeaderRangeCount
 	ssm.replicatedRangeCount = event.ReplicatedRangeCount
 	ssm.availableRangeCount = event.AvailableRangeCount
+	ssm.raftLogSize = event.RaftLogSize
+}
+
+// OnEngineStats receives EngineStatsEvents retrieved from a storage event
+// subscription. This method is part of the implementation of
+// store.StoreEventListener.
+func (nsm *NodeStatusMonitor) OnEngineStats(event *storage.EngineStatsEvent) {
+	ssm := nsm.GetStoreMonitor(event.StoreID)
+	ssm.Lock()
+	defer ssm.Unlock()
+	ssm.engineStats = event.Stats
+}
+
+// OnIndexStats receives IndexStatsEvents retrieved from a storage event
+// subscription. This method is part of the implementation of
+// store.StoreEventListener.
+func (nsm *NodeStatusMonitor) OnIndexStats(event *storage.IndexStatsEvent) {
+	ssm := nsm.GetStoreMonitor(event.StoreID)
+	ssm.Lock()
+	defer ssm.Unlock()
+	ssm.indexStats = event.Stats
 }
 
 // OnStartNode receives StartNodeEvents from a node event subscription. This
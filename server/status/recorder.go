@@ -127,11 +127,28 @@ func (nsr *NodeStatusRecorder) GetTimeSeriesData() []ts.TimeSeriesData {
 		data = append(data, ssr.recordInt("intentage", ssr.stats.IntentAge))
 		data = append(data, ssr.recordInt("gcbytesage", ssr.stats.GCBytesAge))
 		data = append(data, ssr.recordInt("lastupdatenanos", ssr.stats.LastUpdateNanos))
+		data = append(data, ssr.recordInt("raftlogsize", ssr.raftLogSize))
 		data = append(data, ssr.recordInt("ranges", ssr.rangeCount))
 		data = append(data, ssr.recordInt("ranges.leader", int64(ssr.leaderRangeCount)))
 		data = append(data, ssr.recordInt("ranges.replicated", int64(ssr.replicatedRangeCount)))
 		data = append(data, ssr.recordInt("ranges.available", int64(ssr.availableRangeCount)))
 
+		// Record statistics from the storage engine.
+		es := &ssr.engineStats
+		data = append(data, ssr.recordInt("rocksdb.block.cache.hits", es.BlockCacheHits))
+		data = append(data, ssr.recordInt("rocksdb.block.cache.misses", es.BlockCacheMisses))
+		data = append(data, ssr.recordInt("rocksdb.block.cache.usage", es.BlockCacheUsage))
+		data = append(data, ssr.recordInt("rocksdb.memtable.total-size", es.MemtableTotalSize))
+		data = append(data, ssr.recordInt("rocksdb.bytes.written", es.BytesWritten))
+		data = append(data, ssr.recordInt("rocksdb.flush.bytes.written", es.FlushBytesWritten))
+		data = append(data, ssr.recordInt("rocksdb.compaction.bytes.read", es.CompactionBytesRead))
+		data = append(data, ssr.recordInt("rocksdb.compaction.bytes.written", es.CompactionBytesWritten))
+		data = append(data, ssr.recordInt("rocksdb.compaction.pending", es.CompactionPending))
+		data = append(data, ssr.recordInt("rocksdb.table-readers-mem-estimate", es.TableReadersMemEstimate))
+		data = append(data, ssr.recordInt("rocksdb.read-amplification", es.ReadAmplification))
+		data = append(data, ssr.recordFloat("rocksdb.write-amplification", es.WriteAmplification()))
+		data = append(data, ssr.recordInt("rocksdb.stall.micros", es.StallMicros))
+
 		// Record statistics from descriptor.
 		if ssr.desc != nil {
 			capacity := ssr.desc.Capacity
@@ -195,6 +212,8 @@ func (nsr *NodeStatusRecorder) GetStatusSummaries() (*NodeStatus, []storage.Stor
 			LeaderRangeCount:     ssm.leaderRangeCount,
 			ReplicatedRangeCount: ssm.replicatedRangeCount,
 			AvailableRangeCount:  ssm.availableRangeCount,
+			Encryption:           ssm.encryption,
+			IndexStats:           ssm.indexStats,
 		}
 		storeStats = append(storeStats, status)
 	})
@@ -212,13 +231,19 @@ type storeStatusRecorder struct {
 // recordInt records a single int64 value from the StoreStatusMonitor as a
 // ts.TimeSeriesData object.
 func (ssr *storeStatusRecorder) recordInt(name string, data int64) ts.TimeSeriesData {
+	return ssr.recordFloat(name, float64(data))
+}
+
+// recordFloat records a single float64 value from the StoreStatusMonitor as a
+// ts.TimeSeriesData object.
+func (ssr *storeStatusRecorder) recordFloat(name string, data float64) ts.TimeSeriesData {
 	return ts.TimeSeriesData{
 		Name:   fmt.Sprintf(storeTimeSeriesNameFmt, name),
 		Source: ssr.source,
 		Datapoints: []*ts.TimeSeriesDatapoint{
 			{
 				TimestampNanos: ssr.timestampNanos,
-				Value:          float64(data),
+				Value:          data,
 			},
 		},
 	}
@@ -118,6 +118,18 @@ func TestNodeStatusRecorder(t *testing.T) {
 		GCBytesAge:      10,
 		LastUpdateNanos: 1 * 1E9,
 	}
+	encryption := engine.EncryptionStatus{
+		ActiveKeyID: "0123456789abcdef",
+		TotalFiles:  3,
+		TotalBytes:  300,
+		ActiveFiles: 2,
+		ActiveBytes: 100,
+		Rewriting:   true,
+	}
+	indexStats := []storage.IndexStats{
+		{TableID: 50, IndexID: 1, Stats: stats},
+		{TableID: 50, IndexID: 2, Stats: stats},
+	}
 
 	// Create a monitor and a recorder which uses the monitor.
 	monitor := NewNodeStatusMonitor()
@@ -138,7 +150,8 @@ func TestNodeStatusRecorder(t *testing.T) {
 		StartedAt: 70,
 	})
 	monitor.OnStoreStatus(&storage.StoreStatusEvent{
-		Desc: &storeDesc1,
+		Desc:       &storeDesc1,
+		Encryption: encryption,
 	})
 	monitor.OnStoreStatus(&storage.StoreStatusEvent{
 		Desc: &storeDesc2,
@@ -186,12 +199,35 @@ func TestNodeStatusRecorder(t *testing.T) {
 		LeaderRangeCount:     1,
 		AvailableRangeCount:  2,
 		ReplicatedRangeCount: 0,
+		RaftLogSize:          33,
 	})
 	monitor.OnReplicationStatus(&storage.ReplicationStatusEvent{
 		StoreID:              roachpb.StoreID(2),
 		LeaderRangeCount:     1,
 		AvailableRangeCount:  2,
 		ReplicatedRangeCount: 0,
+		RaftLogSize:          11,
+	})
+	monitor.OnEngineStats(&storage.EngineStatsEvent{
+		StoreID: roachpb.StoreID(1),
+		Stats: engine.Stats{
+			BlockCacheHits:          1,
+			BlockCacheMisses:        2,
+			BlockCacheUsage:         3,
+			MemtableTotalSize:       6,
+			BytesWritten:            10,
+			FlushBytesWritten:       10,
+			CompactionBytesRead:     7,
+			CompactionBytesWritten:  20,
+			CompactionPending:       1,
+			TableReadersMemEstimate: 8,
+			ReadAmplification:       9,
+			StallMicros:             11,
+		},
+	})
+	monitor.OnIndexStats(&storage.IndexStatsEvent{
+		StoreID: roachpb.StoreID(1),
+		Stats:   indexStats,
 	})
 	// Node Events.
 	monitor.OnCallSuccess(&CallSuccessEvent{
@@ -249,10 +285,24 @@ func TestNodeStatusRecorder(t *testing.T) {
 		generateStoreData(1, "intentage", 100, 27),
 		generateStoreData(1, "gcbytesage", 100, 30),
 		generateStoreData(1, "lastupdatenanos", 100, 1*1e9),
+		generateStoreData(1, "raftlogsize", 100, 33),
 		generateStoreData(1, "ranges", 100, 2),
 		generateStoreData(1, "ranges.leader", 100, 1),
 		generateStoreData(1, "ranges.available", 100, 2),
 		generateStoreData(1, "ranges.replicated", 100, 0),
+		generateStoreData(1, "rocksdb.block.cache.hits", 100, 1),
+		generateStoreData(1, "rocksdb.block.cache.misses", 100, 2),
+		generateStoreData(1, "rocksdb.block.cache.usage", 100, 3),
+		generateStoreData(1, "rocksdb.memtable.total-size", 100, 6),
+		generateStoreData(1, "rocksdb.bytes.written", 100, 10),
+		generateStoreData(1, "rocksdb.flush.bytes.written", 100, 10),
+		generateStoreData(1, "rocksdb.compaction.bytes.read", 100, 7),
+		generateStoreData(1, "rocksdb.compaction.bytes.written", 100, 20),
+		generateStoreData(1, "rocksdb.compaction.pending", 100, 1),
+		generateStoreData(1, "rocksdb.table-readers-mem-estimate", 100, 8),
+		generateStoreData(1, "rocksdb.read-amplification", 100, 9),
+		generateStoreData(1, "rocksdb.write-amplification", 100, 3),
+		generateStoreData(1, "rocksdb.stall.micros", 100, 11),
 		generateStoreData(1, "capacity", 100, 100),
 		generateStoreData(1, "capacity.available", 100, 50),
 
@@ -268,10 +318,24 @@ func TestNodeStatusRecorder(t *testing.T) {
 		generateStoreData(2, "intentage", 100, 9),
 		generateStoreData(2, "gcbytesage", 100, 10),
 		generateStoreData(2, "lastupdatenanos", 100, 1*1e9),
+		generateStoreData(2, "raftlogsize", 100, 11),
 		generateStoreData(2, "ranges", 100, 1),
 		generateStoreData(2, "ranges.leader", 100, 1),
 		generateStoreData(2, "ranges.available", 100, 2),
 		generateStoreData(2, "ranges.replicated", 100, 0),
+		generateStoreData(2, "rocksdb.block.cache.hits", 100, 0),
+		generateStoreData(2, "rocksdb.block.cache.misses", 100, 0),
+		generateStoreData(2, "rocksdb.block.cache.usage", 100, 0),
+		generateStoreData(2, "rocksdb.memtable.total-size", 100, 0),
+		generateStoreData(2, "rocksdb.bytes.written", 100, 0),
+		generateStoreData(2, "rocksdb.flush.bytes.written", 100, 0),
+		generateStoreData(2, "rocksdb.compaction.bytes.read", 100, 0),
+		generateStoreData(2, "rocksdb.compaction.bytes.written", 100, 0),
+		generateStoreData(2, "rocksdb.compaction.pending", 100, 0),
+		generateStoreData(2, "rocksdb.table-readers-mem-estimate", 100, 0),
+		generateStoreData(2, "rocksdb.read-amplification", 100, 0),
+		generateStoreData(2, "rocksdb.write-amplification", 100, 0),
+		generateStoreData(2, "rocksdb.stall.micros", 100, 0),
 		generateStoreData(2, "capacity", 100, 200),
 		generateStoreData(2, "capacity.available", 100, 75),
 
@@ -310,6 +374,8 @@ func TestNodeStatusRecorder(t *testing.T) {
 			LeaderRangeCount:     1,
 			AvailableRangeCount:  2,
 			ReplicatedRangeCount: 0,
+			Encryption:           encryption,
+			IndexStats:           indexStats,
 		},
 		{
 			Desc:                 storeDesc2,
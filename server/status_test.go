@@ -33,9 +33,14 @@ import (
 	"time"
 
 	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/server/status"
+	"github.com/cockroachdb/cockroach/sql"
+	"github.com/cockroachdb/cockroach/sql/driver"
 	"github.com/cockroachdb/cockroach/storage"
+	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/ts"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/leaktest"
@@ -70,6 +75,98 @@ func TestStatusLocalStacks(t *testing.T) {
 	}
 }
 
+// TestStatusStatements verifies that the statistics of the SQL statements
+// executed by a node are available.
+func TestStatusStatements(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := StartTestServer(t)
+	defer s.Stop()
+
+	for _, stmt := range []string{`SELECT 1`, `SELECT 2`, `SELECT 1 + 1`} {
+		if _, _, err := s.sqlServer.Executor.Execute(driver.Request{User: security.RootUser, Sql: stmt}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var data struct {
+		LatencyBounds []time.Duration           `json:"latencyBounds"`
+		Statements    []sql.StatementStatistics `json:"statements"`
+	}
+	body := getRequest(t, *s, "/_status/statements/local")
+	if err := json.Unmarshal(body, &data); err != nil {
+		t.Fatal(err)
+	}
+	if len(data.LatencyBounds) == 0 {
+		t.Errorf("expected latency bounds, but found none")
+	}
+	counts := map[string]int64{}
+	for _, stats := range data.Statements {
+		counts[stats.Fingerprint] = stats.Count
+	}
+	if counts["SELECT _"] != 2 || counts["SELECT _ + _"] != 1 {
+		t.Errorf("unexpected statement statistics %+v", data.Statements)
+	}
+}
+
+// TestStatusTables verifies that the index stats of the stores are rolled
+// up into table stats.
+func TestStatusTables(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := StartTestServer(t)
+	defer s.Stop()
+
+	const tableID = 1000
+	statuses := []storage.StoreStatus{
+		{IndexStats: []storage.IndexStats{
+			{TableID: tableID, IndexID: 1, Stats: engine.MVCCStats{LiveBytes: 10, LiveCount: 1}},
+			{TableID: tableID, IndexID: 2, Stats: engine.MVCCStats{LiveBytes: 5, LiveCount: 1}},
+		}},
+		{IndexStats: []storage.IndexStats{
+			{TableID: tableID, IndexID: 1, Stats: engine.MVCCStats{LiveBytes: 20, LiveCount: 2}},
+		}},
+	}
+	for i := range statuses {
+		if err := s.db.Put(keys.StoreStatusKey(int32(100+i)), &statuses[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	expected := tableStats{
+		TableID: tableID,
+		Stats:   engine.MVCCStats{LiveBytes: 35, LiveCount: 4},
+		Indexes: []storage.IndexStats{
+			{TableID: tableID, IndexID: 1, Stats: engine.MVCCStats{LiveBytes: 30, LiveCount: 3}},
+			{TableID: tableID, IndexID: 2, Stats: engine.MVCCStats{LiveBytes: 5, LiveCount: 1}},
+		},
+	}
+
+	var wrapper struct {
+		Data []tableStats `json:"d"`
+	}
+	if err := json.Unmarshal(getRequest(t, *s, statusTablesPrefix), &wrapper); err != nil {
+		t.Fatal(err)
+	}
+	var found bool
+	for _, table := range wrapper.Data {
+		if table.TableID == tableID {
+			found = true
+			if !reflect.DeepEqual(table, expected) {
+				t.Errorf("expected %+v, but found %+v", expected, table)
+			}
+		}
+	}
+	if !found {
+		t.Errorf("no stats for table %d in %+v", tableID, wrapper.Data)
+	}
+
+	var table tableStats
+	if err := json.Unmarshal(getRequest(t, *s, fmt.Sprintf("%s%d", statusTablesPrefix, tableID)), &table); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(table, expected) {
+		t.Errorf("expected %+v, but found %+v", expected, table)
+	}
+}
+
 // TestStatusJson verifies that status endpoints return expected Json results.
 // The content type of the responses is always util.JSONContentType.
 func TestStatusJson(t *testing.T) {
@@ -64,6 +64,8 @@ func (p *planner) AlterTable(n *parser.AlterTable) (planNode, error) {
 	}
 
 	numMutations := len(tableDesc.Mutations)
+	// Set when a command modifies the descriptor without queuing a mutation.
+	descChanged := false
 
 	for _, cmd := range n.Cmds {
 		switch t := cmd.(type) {
@@ -166,6 +168,50 @@ func (p *planner) AlterTable(n *parser.AlterTable) (planNode, error) {
 				}
 			}
 
+		case *parser.AlterTableSetDefault:
+			col, err := tableDesc.findColumnToAlter(t.Column)
+			if err != nil {
+				return nil, err
+			}
+			if err := col.setDefaultExpr(t.Default); err != nil {
+				return nil, err
+			}
+			descChanged = true
+
+		case *parser.AlterTableDropNotNull:
+			col, err := tableDesc.findColumnToAlter(t.Column)
+			if err != nil {
+				return nil, err
+			}
+			if tableDesc.PrimaryIndex.containsColumnID(col.ID) {
+				return nil, fmt.Errorf("column %q is referenced by the primary key", col.Name)
+			}
+			col.Nullable = true
+			descChanged = true
+
+		case *parser.AlterTableSetNotNull:
+			col, err := tableDesc.findColumnToAlter(t.Column)
+			if err != nil {
+				return nil, err
+			}
+			if !col.Nullable {
+				continue
+			}
+			// Verify that the existing rows satisfy the constraint.
+			//
+			// TODO(vivek): Nodes still using the previous version of the descriptor
+			// can insert NULLs until the new version has been published.
+			row, err := p.queryRow(fmt.Sprintf("SELECT 1 FROM %s WHERE %s IS NULL LIMIT 1",
+				n.Table, parser.Name(col.Name)))
+			if err != nil {
+				return nil, err
+			}
+			if row != nil {
+				return nil, fmt.Errorf("column %q contains null values", col.Name)
+			}
+			col.Nullable = false
+			descChanged = true
+
 		default:
 			return nil, util.Errorf("unsupported alter cmd: %T", cmd)
 		}
@@ -177,10 +223,19 @@ func (p *planner) AlterTable(n *parser.AlterTable) (planNode, error) {
 	// dummy mutations. Most tests trigger errors above
 	// this line, but tests that run redundant operations like dropping
 	// a column when it's already dropped will hit this condition and exit.
-	if numMutations == len(tableDesc.Mutations) {
+	if numMutations == len(tableDesc.Mutations) && !descChanged {
 		return &valuesNode{}, nil
 	}
 
+	mutationID := invalidMutationID
+	if numMutations != len(tableDesc.Mutations) {
+		if mutationID, err = tableDesc.finalizeMutation(); err != nil {
+			return nil, err
+		}
+	} else {
+		// Have the schema changer publish a new version of the descriptor.
+		tableDesc.UpVersion = true
+	}
 	if err := tableDesc.AllocateIDs(); err != nil {
 		return nil, err
 	}
@@ -188,11 +243,24 @@ func (p *planner) AlterTable(n *parser.AlterTable) (planNode, error) {
 	if err := p.txn.Put(MakeDescMetadataKey(tableDesc.GetID()), wrapDescriptor(tableDesc)); err != nil {
 		return nil, err
 	}
+	p.notifySchemaChange(tableDesc.ID, mutationID)
 
-	// Process mutations synchronously.
-	if err := p.applyMutations(tableDesc, n.Table); err != nil {
+	return &valuesNode{}, nil
+}
+
+// findColumnToAlter returns the public column with the specified name.
+func (desc *TableDescriptor) findColumnToAlter(name string) (*ColumnDescriptor, error) {
+	status, i, err := desc.FindColumnByName(name)
+	if err != nil {
 		return nil, err
 	}
-
-	return &valuesNode{}, nil
+	if status == DescriptorIncomplete {
+		switch desc.Mutations[i].Direction {
+		case DescriptorMutation_ADD:
+			return nil, fmt.Errorf("column %q in the middle of being added, try again later", name)
+		case DescriptorMutation_DROP:
+			return nil, fmt.Errorf("column %q in the middle of being dropped", name)
+		}
+	}
+	return &desc.Columns[i], nil
 }
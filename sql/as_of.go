@@ -0,0 +1,142 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/cockroach/config"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/sql/parser"
+)
+
+var errAsOfInTransaction = errors.New("AS OF SYSTEM TIME is not supported within a transaction")
+var errAsOfNotTopLevel = errors.New("AS OF SYSTEM TIME must be provided on a top-level statement")
+var errBackupInTransaction = errors.New("BACKUP is not supported within a transaction")
+
+// asOfClause returns the AS OF SYSTEM TIME clause of a statement, or nil if
+// the statement doesn't read at a fixed timestamp. A BACKUP always reads at
+// a fixed timestamp, which is the current time if its clause is empty.
+func asOfClause(stmt parser.Statement) *parser.AsOfClause {
+	switch t := stmt.(type) {
+	case *parser.Select:
+		if t.AsOf.Expr != nil {
+			return &t.AsOf
+		}
+	case *parser.ParenSelect:
+		return asOfClause(t.Select)
+	case *parser.Backup:
+		return &t.AsOf
+	}
+	return nil
+}
+
+// evalAsOf evaluates the timestamp of an AS OF SYSTEM TIME clause. The
+// clause specifies either a timestamp or an interval relative to now, which
+// must not be in the future. An empty clause specifies now.
+func (p *planner) evalAsOf(asOf *parser.AsOfClause, now time.Time) (roachpb.Timestamp, error) {
+	if asOf.Expr == nil {
+		return roachpb.Timestamp{WallTime: now.UnixNano()}, nil
+	}
+	if parser.ContainsVars(asOf.Expr) {
+		return roachpb.ZeroTimestamp, fmt.Errorf("AS OF SYSTEM TIME: argument must not contain variables")
+	}
+	normalized, err := p.parser.TypeCheckAndNormalizeExpr(p.evalCtx, asOf.Expr)
+	if err != nil {
+		return roachpb.ZeroTimestamp, err
+	}
+	d, err := normalized.Eval(p.evalCtx)
+	if err != nil {
+		return roachpb.ZeroTimestamp, err
+	}
+
+	var t time.Time
+	switch v := d.(type) {
+	case parser.DString:
+		if ts, err := p.evalCtx.ParseTimestamp(v); err == nil {
+			t = ts.Time
+		} else if d, err := time.ParseDuration(string(v)); err == nil {
+			t = now.Add(d)
+		} else {
+			return roachpb.ZeroTimestamp, fmt.Errorf("AS OF SYSTEM TIME: %q is neither a timestamp nor an interval", v)
+		}
+	case parser.DTimestamp:
+		t = v.Time
+	case parser.DInterval:
+		t = now.Add(v.Duration)
+	case parser.DInt:
+		// Nanoseconds since the Unix epoch.
+		t = time.Unix(0, int64(v))
+	default:
+		return roachpb.ZeroTimestamp, fmt.Errorf("AS OF SYSTEM TIME: expected a timestamp or an interval: %s is a %s",
+			asOf.Expr, d.Type())
+	}
+	if t.After(now) {
+		return roachpb.ZeroTimestamp, fmt.Errorf("AS OF SYSTEM TIME: cannot specify a timestamp in the future")
+	}
+	return roachpb.Timestamp{WallTime: t.UnixNano()}, nil
+}
+
+// gcThreshold returns the time before which the values of the table may
+// have been garbage collected. Values are kept for the TTL of the table's
+// zone, and since the timestamp of any protected timestamp record which
+// covers the whole table.
+func (p *planner) gcThreshold(id ID) (time.Time, error) {
+	zone, err := GetZoneConfig(p.systemConfig, uint32(id))
+	if err != nil {
+		return time.Time{}, err
+	}
+	policy := zone.GC
+	if policy == nil {
+		policy = config.DefaultZoneConfig.GC
+	}
+	now := time.Now()
+	threshold := now.Add(-time.Duration(policy.TTLSeconds) * time.Second)
+
+	records, err := p.systemConfig.GetProtectedTimestamps(roachpb.Timestamp{WallTime: now.UnixNano()})
+	if err != nil {
+		return time.Time{}, err
+	}
+	prefix := roachpb.Key(keys.MakeTablePrefix(uint32(id)))
+	for _, record := range records {
+		if bytes.Compare(record.Span.Key, prefix) > 0 ||
+			bytes.Compare(record.Span.EndKey, prefix.PrefixEnd()) < 0 {
+			continue
+		}
+		if ts := record.Timestamp.GoTime(); ts.Before(threshold) {
+			threshold = ts
+		}
+	}
+	return threshold, nil
+}
+
+// checkGCThreshold returns an error if the values of the table may have
+// been garbage collected at the timestamp the planner reads at.
+func (p *planner) checkGCThreshold(id ID, name string) error {
+	threshold, err := p.gcThreshold(id)
+	if err != nil {
+		return err
+	}
+	if ts := p.asOfSystemTime.GoTime(); ts.Before(threshold) {
+		return fmt.Errorf("AS OF SYSTEM TIME: timestamp %s is older than the GC threshold %s of table %q",
+			ts.UTC(), threshold.UTC(), name)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/testutils"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func TestAsOfSystemTime(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, _ := setup(t)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE d;
+CREATE TABLE d.t (a INT PRIMARY KEY, b INT);
+INSERT INTO d.t VALUES (1, 1);
+`); err != nil {
+		t.Fatal(err)
+	}
+	before := time.Now()
+	if _, err := sqlDB.Exec(`
+UPDATE d.t SET b = 2;
+CREATE TABLE d.u (a INT PRIMARY KEY);
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	var b int
+	if err := sqlDB.QueryRow(`SELECT b FROM d.t`).Scan(&b); err != nil {
+		t.Fatal(err)
+	} else if b != 2 {
+		t.Fatalf("expected 2, but found %d", b)
+	}
+	if err := sqlDB.QueryRow(`SELECT b FROM d.t AS OF SYSTEM TIME $1`, before).Scan(&b); err != nil {
+		t.Fatal(err)
+	} else if b != 1 {
+		t.Fatalf("expected 1, but found %d", b)
+	}
+	// NOW() returns the timestamp the statement reads at.
+	var now time.Time
+	if err := sqlDB.QueryRow(`SELECT NOW() FROM d.t AS OF SYSTEM TIME $1`, before).Scan(&now); err != nil {
+		t.Fatal(err)
+	} else if !now.Equal(before) {
+		t.Fatalf("expected %s, but found %s", before, now)
+	}
+
+	// The schema is resolved as of the timestamp.
+	if _, err := sqlDB.Query(`SELECT * FROM d.u AS OF SYSTEM TIME $1`, before); !testutils.IsError(err, `table "u" does not exist`) {
+		t.Fatalf("expected missing table error, but found %v", err)
+	}
+
+	testCases := []struct {
+		sql      string
+		expected string
+	}{
+		{`SELECT a FROM d.t AS OF SYSTEM TIME '-48h'`, `older than the GC threshold`},
+		{`SELECT a FROM d.t AS OF SYSTEM TIME INTERVAL '1h'`, `cannot specify a timestamp in the future`},
+		{`SELECT a FROM d.t AS OF SYSTEM TIME 'foo'`, `neither a timestamp nor an interval`},
+		{`SELECT a FROM d.t AS OF SYSTEM TIME a`, `must not contain variables`},
+		{`SELECT * FROM d.t WHERE a IN (SELECT a FROM d.t AS OF SYSTEM TIME '-1ms')`, `must be provided on a top-level statement`},
+		{`INSERT INTO d.t SELECT a + 1, b FROM d.t AS OF SYSTEM TIME '-1ms'`, `must be provided on a top-level statement`},
+		{`BEGIN; SELECT a FROM d.t AS OF SYSTEM TIME '-1ms'`, `not supported within a transaction`},
+	}
+	for _, tc := range testCases {
+		if _, err := sqlDB.Exec(tc.sql); !testutils.IsError(err, tc.expected) {
+			t.Errorf("%s: expected %q, but found %v", tc.sql, tc.expected, err)
+		}
+	}
+}
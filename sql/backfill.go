@@ -18,11 +18,15 @@
 package sql
 
 import (
+	"bytes"
 	"sort"
+	"time"
 
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/util/encoding"
 	"github.com/cockroachdb/cockroach/util/log"
 )
 
@@ -66,117 +70,442 @@ func (ids indexesByID) Swap(i, j int) {
 	ids[i], ids[j] = ids[j], ids[i]
 }
 
-func (p *planner) backfillBatch(b *client.Batch, tableName *parser.QualifiedName, oldTableDesc, newTableDesc *TableDescriptor) error {
-	table := &parser.AliasedTableExpr{Expr: tableName}
-	var droppedColumnDescs []ColumnDescriptor
-	var droppedIndexDescs []IndexDescriptor
-	var newIndexDescs []IndexDescriptor
-	for _, m := range oldTableDesc.Mutations {
-		switch m.Direction {
-		case DescriptorMutation_ADD:
-			switch t := m.Descriptor_.(type) {
-			case *DescriptorMutation_Column:
-				// TODO(vivek): Add column to new columns and use it
-				// to fill in default values.
+// backfillChunkSize is the maximum number of rows (or index entries, when
+// dropping an index) processed by a single backfill transaction.
+var backfillChunkSize int64 = 1000
 
-			case *DescriptorMutation_Index:
-				newIndexDescs = append(newIndexDescs, *t.Index)
+// TestingSetBackfillChunkSize sets the number of rows processed by a single
+// backfill transaction and returns a function restoring the default.
+func TestingSetBackfillChunkSize(size int64) func() {
+	saved := backfillChunkSize
+	backfillChunkSize = size
+	return func() {
+		backfillChunkSize = saved
+	}
+}
+
+// runBackfill runs the backfill for the mutations with the specified id: the
+// data of dropped indexes and columns is deleted, the default values of added
+// columns are written and added indexes are populated. The backfill is
+// performed in bounded chunks, each in its own transaction which also
+// persists the progress made in the mutations so that an interrupted backfill
+// can be resumed.
+func (sc *SchemaChanger) runBackfill(mutationID MutationID, lease *TableDescriptor_SchemaChangeLease) error {
+	if err := sc.truncateIndexes(mutationID, lease); err != nil {
+		return err
+	}
+	return sc.backfillIndexesAndColumns(mutationID, lease)
+}
+
+// runBackfillChunks runs the chunk function in successive transactions until
+// it reports that there is nothing left to do. Each chunk reads the table
+// descriptor, verifying that the lease is still held, and the descriptor
+// modified by the chunk is written in the same transaction.
+func (sc *SchemaChanger) runBackfillChunks(lease *TableDescriptor_SchemaChangeLease,
+	chunk func(txn *client.Txn, b *client.Batch, tableDesc *TableDescriptor) (bool, error)) error {
+	for {
+		if err := sc.maybeExtendLease(lease); err != nil {
+			return err
+		}
+		done := false
+		if err := sc.db.Txn(func(txn *client.Txn) error {
+			tableDesc, err := sc.findTableWithLease(txn, *lease)
+			if err != nil {
+				return err
 			}
+			b := &client.Batch{}
+			if done, err = chunk(txn, b, tableDesc); err != nil || done {
+				return err
+			}
+			txn.SetSystemDBTrigger()
+			b.Put(MakeDescMetadataKey(tableDesc.ID), wrapDescriptor(tableDesc))
+			return txn.CommitInBatch(b)
+		}); err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+	}
+}
+
+// truncateIndexes deletes the entries of the indexes dropped by the mutations
+// with the specified id.
+func (sc *SchemaChanger) truncateIndexes(mutationID MutationID, lease *TableDescriptor_SchemaChangeLease) error {
+	return sc.runBackfillChunks(lease, func(txn *client.Txn, b *client.Batch, tableDesc *TableDescriptor) (bool, error) {
+		for i := range tableDesc.Mutations {
+			m := &tableDesc.Mutations[i]
+			index := m.GetIndex()
+			if m.MutationID != mutationID || m.Direction != DescriptorMutation_DROP || index == nil {
+				continue
+			}
+			indexPrefix := roachpb.Key(MakeIndexKeyPrefix(tableDesc.ID, index.ID))
+			sp := resumeSpan(*m, roachpb.Span{Key: indexPrefix, EndKey: indexPrefix.PrefixEnd()})
+			if spanDone(sp) {
+				continue
+			}
+			kvs, err := txn.Scan(sp.Key, sp.EndKey, backfillChunkSize)
+			if err != nil {
+				return false, err
+			}
+			for _, kv := range kvs {
+				if log.V(2) {
+					log.Infof("Del %s", prettyKey(kv.Key, 0))
+				}
+				b.Del(kv.Key)
+			}
+			if int64(len(kvs)) < backfillChunkSize {
+				m.ResumeSpan = roachpb.Span{Key: sp.EndKey, EndKey: sp.EndKey}
+			} else {
+				m.ResumeSpan = roachpb.Span{Key: roachpb.Key(kvs[len(kvs)-1].Key).Next(), EndKey: sp.EndKey}
+			}
+			// Process one chunk of one index per transaction.
+			return false, nil
+		}
+		return true, nil
+	})
+}
 
-		case DescriptorMutation_DROP:
+// backfillIndexesAndColumns scans the primary index of the table, deleting
+// the data of the columns dropped by the mutations with the specified id and
+// writing the default values of the columns and the entries of the indexes
+// they add.
+func (sc *SchemaChanger) backfillIndexesAndColumns(mutationID MutationID, lease *TableDescriptor_SchemaChangeLease) error {
+	return sc.runBackfillChunks(lease, func(txn *client.Txn, b *client.Batch, tableDesc *TableDescriptor) (bool, error) {
+		var mutations []*DescriptorMutation
+		var addedColumns []ColumnDescriptor
+		var addedIndexes []IndexDescriptor
+		droppedColumnIDs := map[ColumnID]struct{}{}
+		for i := range tableDesc.Mutations {
+			m := &tableDesc.Mutations[i]
+			if m.MutationID != mutationID {
+				continue
+			}
 			switch t := m.Descriptor_.(type) {
 			case *DescriptorMutation_Column:
-				droppedColumnDescs = append(droppedColumnDescs, *t.Column)
+				if m.Direction == DescriptorMutation_ADD {
+					addedColumns = append(addedColumns, *t.Column)
+					if t.Column.DefaultExpr == nil && t.Column.Nullable {
+						// The column is NULL in all the existing rows: there is
+						// nothing to backfill unless it is indexed.
+						continue
+					}
+				} else {
+					droppedColumnIDs[t.Column.ID] = struct{}{}
+				}
 
 			case *DescriptorMutation_Index:
-				droppedIndexDescs = append(droppedIndexDescs, *t.Index)
+				if m.Direction != DescriptorMutation_ADD {
+					continue
+				}
+				addedIndexes = append(addedIndexes, *t.Index)
+			}
+			mutations = append(mutations, m)
+		}
+		if len(mutations) == 0 {
+			return true, nil
+		}
+
+		// All the mutations share the progress of the scan over the primary
+		// index.
+		primaryPrefix := roachpb.Key(MakeIndexKeyPrefix(tableDesc.ID, tableDesc.PrimaryIndex.ID))
+		sp := resumeSpan(*mutations[0], roachpb.Span{Key: primaryPrefix, EndKey: primaryPrefix.PrefixEnd()})
+		if spanDone(sp) {
+			return true, nil
+		}
+		p := sc.makePlanner(txn)
+		resume, err := backfillChunk(p, b, tableDesc, sp, addedColumns, addedIndexes, droppedColumnIDs)
+		if err != nil {
+			return false, err
+		}
+		for _, m := range mutations {
+			m.ResumeSpan = roachpb.Span{Key: resume, EndKey: sp.EndKey}
+		}
+		return false, nil
+	})
+}
+
+// makePlanner returns a planner used to evaluate expressions, such as the
+// default values of added columns, within the backfill transaction.
+func (sc *SchemaChanger) makePlanner(txn *client.Txn) *planner {
+	p := &planner{user: security.RootUser}
+	p.evalCtx.NodeID = sc.nodeID
+	p.evalCtx.GetLocation = p.session.getLocation
+	now := time.Now()
+	p.setTxn(txn, now)
+	p.evalCtx.StmtTimestamp = parser.DTimestamp{Time: now}
+	return p
+}
+
+// backfillChunk processes the complete rows among the first
+// backfillChunkSize rows of the span, adding to the batch the deletion of the
+// dropped columns, the default values of the added columns and the entries of
+// the added indexes. It returns the key at which the next chunk starts.
+func backfillChunk(p *planner, b *client.Batch, tableDesc *TableDescriptor, sp roachpb.Span,
+	addedColumns []ColumnDescriptor, addedIndexes []IndexDescriptor,
+	droppedColumnIDs map[ColumnID]struct{}) (roachpb.Key, error) {
+	txn := p.txn
+	// A row is made up of at most one key per column plus the row sentinel.
+	maxKVs := backfillChunkSize * int64(len(tableDesc.Columns)+len(tableDesc.Mutations)+1)
+	kvs, err := txn.Scan(sp.Key, sp.EndKey, maxKVs)
+	if err != nil {
+		return nil, err
+	}
+	resume := sp.EndKey
+	if int64(len(kvs)) == maxKVs {
+		// The scan was cut short and the last row might be incomplete: leave it
+		// to the next chunk. If the scan returned a single row, the row itself
+		// is bigger than expected; read all of it.
+		valTypes, err := makeKeyVals(tableDesc, tableDesc.PrimaryIndex.ColumnIDs)
+		if err != nil {
+			return nil, err
+		}
+		vals := make([]parser.Datum, len(valTypes))
+		rowPrefix := func(key roachpb.Key) (roachpb.Key, error) {
+			remaining, err := decodeIndexKey(tableDesc, tableDesc.PrimaryIndex, valTypes, vals, key)
+			if err != nil {
+				return nil, err
+			}
+			return key[:len(key)-len(remaining)], nil
+		}
+		lastRow, err := rowPrefix(kvs[len(kvs)-1].Key)
+		if err != nil {
+			return nil, err
+		}
+		firstRow, err := rowPrefix(kvs[0].Key)
+		if err != nil {
+			return nil, err
+		}
+		if firstRow.Equal(lastRow) {
+			resume = lastRow.PrefixEnd()
+			if kvs, err = txn.Scan(lastRow, resume, 0); err != nil {
+				return nil, err
+			}
+		} else {
+			resume = lastRow
+			for len(kvs) > 0 && bytes.HasPrefix(kvs[len(kvs)-1].Key, lastRow) {
+				kvs = kvs[:len(kvs)-1]
 			}
 		}
 	}
+	if len(kvs) == 0 {
+		return resume, nil
+	}
 
-	if len(droppedColumnDescs) > 0 {
-		var updateExprs parser.UpdateExprs
-		for _, droppedColumnDesc := range droppedColumnDescs {
-			updateExprs = append(updateExprs, &parser.UpdateExpr{
-				Names: parser.QualifiedNames{&parser.QualifiedName{Base: parser.Name(droppedColumnDesc.Name)}},
-				Expr:  parser.DNull,
-			})
+	// Evaluate the default values of the added columns.
+	defaults, err := makeColumnDefaults(p, addedColumns)
+	if err != nil {
+		return nil, err
+	}
+	if len(addedColumns) > 0 || len(droppedColumnIDs) > 0 {
+		if err := backfillColumns(b, tableDesc, kvs, addedColumns, defaults, droppedColumnIDs); err != nil {
+			return nil, err
+		}
+	}
+	if len(addedIndexes) > 0 {
+		if err := writeIndexEntries(p, b, tableDesc, kvs, addedColumns, defaults, addedIndexes); err != nil {
+			return nil, err
 		}
+	}
+	return resume, nil
+}
 
-		// Run `UPDATE <table> SET col1 = NULL, col2 = NULL, ...` to clear
-		// the data stored in the columns being dropped.
-		if _, err := p.Update(&parser.Update{
-			Table: table,
-			Exprs: updateExprs,
-		}); err != nil {
-			return err
+// makeColumnDefaults evaluates the default values of the columns. Columns
+// without a default expression default to NULL.
+func makeColumnDefaults(p *planner, cols []ColumnDescriptor) ([]parser.Datum, error) {
+	defaults := make([]parser.Datum, len(cols))
+	defaultExprs, err := p.makeDefaultExprs(cols)
+	if err != nil {
+		return nil, err
+	}
+	for i := range cols {
+		defaults[i] = parser.DNull
+		if defaultExprs == nil {
+			continue
+		}
+		if defaults[i], err = defaultExprs[i].Eval(p.evalCtx); err != nil {
+			return nil, err
 		}
 	}
+	return defaults, nil
+}
 
-	for _, indexDescriptor := range droppedIndexDescs {
-		indexPrefix := MakeIndexKeyPrefix(newTableDesc.ID, indexDescriptor.ID)
+// backfillColumns deletes the values of the dropped columns found among the
+// primary index key/value pairs and writes the default values of the added
+// columns in the rows that don't have a value for them yet. Rows written
+// since the added columns entered the WRITE_ONLY state already have their
+// default values. An added NOT NULL column without a non-NULL default value
+// makes the backfill fail if the table has rows.
+func backfillColumns(b *client.Batch, tableDesc *TableDescriptor, kvs []client.KeyValue,
+	addedColumns []ColumnDescriptor, defaults []parser.Datum,
+	droppedColumnIDs map[ColumnID]struct{}) error {
+	valTypes, err := makeKeyVals(tableDesc, tableDesc.PrimaryIndex.ColumnIDs)
+	if err != nil {
+		return err
+	}
+	vals := make([]parser.Datum, len(valTypes))
 
-		// Delete the index.
-		indexStartKey := roachpb.Key(indexPrefix)
-		indexEndKey := indexStartKey.PrefixEnd()
-		if log.V(2) {
-			log.Infof("DelRange %s - %s", prettyKey(indexStartKey, 0), prettyKey(indexEndKey, 0))
+	var rowKey []byte
+	present := map[ColumnID]struct{}{}
+	// Write the default values missing from the row that was just scanned.
+	finishRow := func() error {
+		if rowKey == nil {
+			return nil
 		}
-		b.DelRange(indexStartKey, indexEndKey)
+		for i, col := range addedColumns {
+			if _, ok := present[col.ID]; ok {
+				continue
+			}
+			if defaults[i] == parser.DNull {
+				if !col.Nullable {
+					return errNotNullConstraintViolation{column: col.Name}
+				}
+				continue
+			}
+			marshalled, err := marshalColumnValue(col, defaults[i])
+			if err != nil {
+				return err
+			}
+			key := MakeColumnKey(col.ID, rowKey)
+			if log.V(2) {
+				log.Infof("CPut %s -> %v", prettyKey(key, 0), defaults[i])
+			}
+			b.CPut(key, marshalled, nil)
+		}
+		return nil
 	}
 
-	if len(newIndexDescs) > 0 {
-		// Get all the rows affected.
-		// TODO(vivek): Avoid going through Select.
-		// TODO(tamird): Support partial indexes?
-		rows, err := p.Select(&parser.Select{
-			Exprs: parser.SelectExprs{parser.StarSelectExpr()},
-			From:  parser.TableExprs{table},
-		})
+	for _, kv := range kvs {
+		remaining, err := decodeIndexKey(tableDesc, tableDesc.PrimaryIndex, valTypes, vals, kv.Key)
 		if err != nil {
 			return err
 		}
-
-		// Construct a map from column ID to the index the value appears at within a
-		// row.
-		colIDtoRowIndex, err := makeColIDtoRowIndex(rows, oldTableDesc)
+		if len(remaining) == 0 {
+			// The row sentinel starts a new row.
+			if err := finishRow(); err != nil {
+				return err
+			}
+			rowKey = kv.Key
+			present = map[ColumnID]struct{}{}
+			continue
+		}
+		_, colID, err := encoding.DecodeUvarint(remaining)
 		if err != nil {
 			return err
 		}
+		present[ColumnID(colID)] = struct{}{}
+		if _, ok := droppedColumnIDs[ColumnID(colID)]; ok {
+			if log.V(2) {
+				log.Infof("Del %s", prettyKey(kv.Key, 0))
+			}
+			b.Del(kv.Key)
+		}
+	}
+	return finishRow()
+}
 
-		// TODO(tamird): This will fall down in production use. We need to do
-		// something better (see #2036). In particular, this implementation
-		// has the following problems:
-		// - Very large tables will generate an enormous batch here. This
-		// isn't really a problem in itself except that it will exacerbate
-		// the other issue:
-		// - Any non-quiescent table that this runs against will end up with
-		// an inconsistent index. This is because as inserts/updates continue
-		// to roll in behind this operation's read front, the written index
-		// will become incomplete/stale before it's written.
-
-		for rows.Next() {
-			rowVals := rows.Values()
-
-			for _, newIndexDesc := range newIndexDescs {
-				secondaryIndexEntries, err := encodeSecondaryIndexes(
-					oldTableDesc.ID, []IndexDescriptor{newIndexDesc}, colIDtoRowIndex, rowVals)
-				if err != nil {
-					return err
-				}
+// writeIndexEntries decodes the rows made up by the primary index key/value
+// pairs and adds the entries of the added indexes for these rows to the
+// batch. The added columns, which can be indexed by the added indexes, take
+// their default value in the rows that don't have a value for them yet.
+// Entries may already have been written by concurrent writers since the
+// indexes are in the WRITE_ONLY state; an existing entry with a different
+// value is a uniqueness violation.
+func writeIndexEntries(p *planner, b *client.Batch, tableDesc *TableDescriptor,
+	kvs []client.KeyValue, addedColumns []ColumnDescriptor, defaults []parser.Datum,
+	addedIndexes []IndexDescriptor) error {
+	txn := p.txn
+	// Decode the rows as if the added columns were already public.
+	scanDesc := *tableDesc
+	scanDesc.Columns = append(append([]ColumnDescriptor(nil), tableDesc.Columns...), addedColumns...)
+	scan := &scanNode{
+		planner:     p,
+		txn:         txn,
+		desc:        &scanDesc,
+		index:       &scanDesc.PrimaryIndex,
+		visibleCols: scanDesc.Columns,
+	}
+	if err := scan.initTargets(parser.SelectExprs{parser.StarSelectExpr()}); err != nil {
+		return err
+	}
+	scan.initOrdering(0)
+	// Hand the key/value pairs to the scanNode in place of letting it perform
+	// the scan itself.
+	scan.kvs = kvs
+	if !scan.initKeyVals() {
+		return scan.err
+	}
 
-				for _, secondaryIndexEntry := range secondaryIndexEntries {
-					if log.V(2) {
-						log.Infof("CPut %s -> %v", prettyKey(secondaryIndexEntry.key, 0),
-							secondaryIndexEntry.value)
-					}
-					b.CPut(secondaryIndexEntry.key, secondaryIndexEntry.value, nil)
+	// Construct a map from column ID to the index the value appears at within a
+	// row.
+	colIDtoRowIndex, err := makeColIDtoRowIndex(scan, &scanDesc)
+	if err != nil {
+		return err
+	}
+
+	type entry struct {
+		indexEntry
+		index *IndexDescriptor
+		vals  []parser.Datum
+	}
+	var entries []entry
+	for scan.Next() {
+		rowVals := scan.Values()
+		for i, col := range addedColumns {
+			if j := colIDtoRowIndex[col.ID]; rowVals[j] == parser.DNull {
+				rowVals[j] = defaults[i]
+			}
+		}
+		for i := range addedIndexes {
+			index := &addedIndexes[i]
+			secondaryIndexEntries, err := encodeSecondaryIndexes(
+				tableDesc.ID, []IndexDescriptor{*index}, colIDtoRowIndex, rowVals)
+			if err != nil {
+				return err
+			}
+			vals := make([]parser.Datum, len(index.ColumnIDs))
+			for j, id := range index.ColumnIDs {
+				vals[j] = parser.DNull
+				if k, ok := colIDtoRowIndex[id]; ok {
+					vals[j] = rowVals[k]
 				}
 			}
+			for _, e := range secondaryIndexEntries {
+				entries = append(entries, entry{indexEntry: e, index: index, vals: vals})
+			}
 		}
-
-		return rows.Err()
+	}
+	if err := scan.Err(); err != nil {
+		return err
 	}
 
+	// Look up the entries already present.
+	existing := &client.Batch{}
+	for _, e := range entries {
+		existing.Get(e.key)
+	}
+	if err := txn.Run(existing); err != nil {
+		return err
+	}
+	written := map[string][]byte{}
+	for i, e := range entries {
+		value, ok := written[string(e.key)]
+		if !ok {
+			if kv := existing.Results[i].Rows[0]; kv.Exists() {
+				value, ok = kv.ValueBytes(), true
+			}
+		}
+		if ok {
+			if !bytes.Equal(value, e.value) {
+				return errUniquenessConstraintViolation{index: e.index, vals: e.vals}
+			}
+			continue
+		}
+		written[string(e.key)] = e.value
+		if log.V(2) {
+			log.Infof("CPut %s -> %v", prettyKey(e.key, 0), e.value)
+		}
+		b.CPut(e.key, e.value, nil)
+	}
 	return nil
 }
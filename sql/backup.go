@@ -0,0 +1,694 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/config"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/sql/privilege"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/gogo/protobuf/proto"
+)
+
+const (
+	// backupDescriptorName is the name of the file holding the
+	// BackupDescriptor. It is written after the data files, so a backup
+	// is complete iff it exists.
+	backupDescriptorName = "BACKUP"
+	// restoreBatchSize is the number of key/values written by each of the
+	// transactions loading the data of a table.
+	restoreBatchSize = 1000
+	// backupProtectionTTL is how long the values read by a backup are
+	// protected from garbage collection if the backup fails to release
+	// the protection.
+	backupProtectionTTL = 24 * time.Hour
+)
+
+// exportFileKeys is the maximum number of keys whose revisions are written
+// to a single data file of a backup.
+var exportFileKeys int64 = 10000
+
+// TestingSetExportFileKeys sets the maximum number of keys whose revisions
+// are written to a data file of a backup and returns a function restoring
+// the default.
+func TestingSetExportFileKeys(count int64) func() {
+	saved := exportFileKeys
+	exportFileKeys = count
+	return func() {
+		exportFileKeys = saved
+	}
+}
+
+// Backup exports the key/values of databases and tables, along with their
+// descriptors, at the timestamp of the AS OF SYSTEM TIME clause or at the
+// current time. An incremental backup only exports the revisions written
+// since the end time of the previous backup of its chain.
+// Privileges: SELECT on the backed up tables; root to back up to a local
+// directory.
+func (p *planner) Backup(n *parser.Backup) (planNode, error) {
+	uri, err := p.getStringVal("BACKUP", parser.Exprs{n.To})
+	if err != nil {
+		return nil, err
+	}
+	storage, err := p.makeExportStorage("BACKUP", uri)
+	if err != nil {
+		return nil, err
+	}
+	defer storage.Close()
+
+	var startTime roachpb.Timestamp
+	if n.IncrementalFrom != nil {
+		storages, chain, err := p.readBackupChain("BACKUP", n.IncrementalFrom)
+		if err != nil {
+			return nil, err
+		}
+		closeExportStorages(storages)
+		startTime = chain[len(chain)-1].EndTime
+	}
+	endTime := p.asOfSystemTime
+	if !startTime.Less(endTime) {
+		return nil, fmt.Errorf("BACKUP: timestamp %s is not after the end time %s of the previous backup",
+			endTime.GoTime().UTC(), startTime.GoTime().UTC())
+	}
+
+	if n.AsOf.Expr != nil {
+		if err := p.checkGCThreshold(DescriptorTable.ID, DescriptorTable.Name); err != nil {
+			return nil, err
+		}
+	}
+	descriptors, tables, err := p.backupTargets(n.Targets)
+	if err != nil {
+		return nil, err
+	}
+	for _, table := range tables {
+		if err := p.checkPrivilege(table, privilege.SELECT); err != nil {
+			return nil, err
+		}
+		if n.AsOf.Expr != nil {
+			if err := p.checkGCThreshold(table.ID, table.Name); err != nil {
+				return nil, err
+			}
+		}
+		if startTime != roachpb.ZeroTimestamp {
+			threshold, err := p.gcThreshold(table.ID)
+			if err != nil {
+				return nil, err
+			}
+			if ts := startTime.GoTime(); ts.Before(threshold) {
+				return nil, fmt.Errorf("BACKUP: revisions of table %q since %s may have been garbage collected",
+					table.Name, ts.UTC())
+			}
+		}
+	}
+
+	release, err := p.protectBackupTables(tables, endTime)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	backup := BackupDescriptor{StartTime: startTime, EndTime: endTime}
+	for _, descriptor := range descriptors {
+		backup.Descriptors = append(backup.Descriptors, *wrapDescriptor(descriptor))
+	}
+	for _, table := range tables {
+		files, err := p.exportTable(storage, table.ID, startTime)
+		if err != nil {
+			return nil, err
+		}
+		backup.Files = append(backup.Files, files...)
+	}
+
+	content, err := backup.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	if err := storage.WriteFile(backupDescriptorName, content); err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
+
+// backupTargets returns the descriptors of the databases and tables to back
+// up, and the tables among them. Backing up a table also backs up the
+// descriptor of its database. Without targets, all the databases but the
+// system and virtual ones are backed up.
+func (p *planner) backupTargets(targets *parser.TargetList) ([]descriptorProto, []*TableDescriptor, error) {
+	dbs, tablesByDB, err := p.getAllDescriptors()
+	if err != nil {
+		return nil, nil, err
+	}
+	dbsByID := map[ID]*DatabaseDescriptor{}
+	for _, db := range dbs {
+		dbsByID[db.ID] = db
+	}
+
+	var descriptors []descriptorProto
+	var tables []*TableDescriptor
+	seen := map[ID]struct{}{}
+	add := func(descriptor descriptorProto) {
+		if _, ok := seen[descriptor.GetID()]; !ok {
+			seen[descriptor.GetID()] = struct{}{}
+			descriptors = append(descriptors, descriptor)
+			if table, ok := descriptor.(*TableDescriptor); ok {
+				tables = append(tables, table)
+			}
+		}
+	}
+	addDB := func(db *DatabaseDescriptor) {
+		add(db)
+		for _, table := range tablesByDB[db.ID] {
+			add(table)
+		}
+	}
+
+	if targets == nil {
+		for _, db := range dbs {
+			if db.ID != keys.SystemDatabaseID && !isVirtualDescriptor(db) {
+				addDB(db)
+			}
+		}
+		return descriptors, tables, nil
+	}
+
+	matched, err := p.getDescriptorsFromTargetList(*targets)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, descriptor := range matched {
+		if isVirtualDescriptor(descriptor) || IsSystemID(descriptor.GetID()) {
+			return nil, nil, fmt.Errorf("cannot back up %s %q", descriptor.TypeName(), descriptor.GetName())
+		}
+		switch t := descriptor.(type) {
+		case *DatabaseDescriptor:
+			addDB(dbsByID[t.ID])
+		case *TableDescriptor:
+			add(dbsByID[t.ParentID])
+			add(t)
+		}
+	}
+	return descriptors, tables, nil
+}
+
+// protectBackupTables protects the values of the tables needed to read at
+// the end time of a backup from garbage collection while they are exported,
+// and returns a function releasing the protection.
+func (p *planner) protectBackupTables(tables []*TableDescriptor, endTime roachpb.Timestamp) (func(), error) {
+	var ids []int64
+	release := func() {
+		err := p.leaseMgr.db.Txn(func(txn *client.Txn) error {
+			for _, id := range ids {
+				if err := ReleaseProtectedTimestamp(txn, id); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			log.Warningf("unable to release the protected timestamps of backup: %s", err)
+		}
+	}
+	expiration := roachpb.Timestamp{WallTime: time.Now().Add(backupProtectionTTL).UnixNano()}
+	for _, table := range tables {
+		prefix := roachpb.Key(keys.MakeTablePrefix(uint32(table.ID)))
+		span := roachpb.Span{Key: prefix, EndKey: prefix.PrefixEnd()}
+		id, err := ProtectTimestamp(&p.leaseMgr.db, span, endTime, expiration,
+			fmt.Sprintf("BACKUP of table %q", table.Name))
+		if err != nil {
+			release()
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return release, nil
+}
+
+// exportTable writes the key/values of a table to data files of the
+// backup. The table is exported in pages of at most exportFileKeys keys,
+// each written to its own file, so that a large table is never held in
+// memory at once.
+func (p *planner) exportTable(storage ExportStorage, id ID, startTime roachpb.Timestamp) ([]BackupFile, error) {
+	prefix := roachpb.Key(keys.MakeTablePrefix(uint32(id)))
+	span := roachpb.Span{Key: prefix, EndKey: prefix.PrefixEnd()}
+	var files []BackupFile
+	for {
+		b := client.Batch{}
+		b.InternalAddRequest(&roachpb.ExportRequest{
+			Span:       span,
+			StartTime:  startTime,
+			MaxResults: exportFileKeys,
+		})
+		if err := p.txn.Run(&b); err != nil {
+			return nil, err
+		}
+		rows := b.Results[0].Rows
+		if len(rows) == 0 {
+			return files, nil
+		}
+
+		var data BackupData
+		var count int64
+		for i, row := range rows {
+			if i == 0 || !bytes.Equal(row.Key, rows[i-1].Key) {
+				count++
+			}
+			data.KVs = append(data.KVs, roachpb.KeyValue{Key: row.Key, Value: *row.Value})
+		}
+		content, err := data.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		file := BackupFile{
+			TableID: id,
+			Path:    fmt.Sprintf("%d-%d.data", id, len(files)),
+			Entries: int64(len(data.KVs)),
+		}
+		if err := storage.WriteFile(file.Path, content); err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+
+		if count < exportFileKeys {
+			return files, nil
+		}
+		span.Key = roachpb.Key(rows[len(rows)-1].Key).Next()
+	}
+}
+
+// readBackupChain reads the descriptors of a chain of backups: a full
+// backup followed by incremental backups, each starting at the end time of
+// the previous one. The storages of the backups are returned along with
+// their descriptors; the caller closes them.
+func (p *planner) readBackupChain(op string, exprs parser.Exprs) ([]ExportStorage, []BackupDescriptor, error) {
+	var storages []ExportStorage
+	var chain []BackupDescriptor
+	var uris []string
+	for _, expr := range exprs {
+		uri, storage, backup, err := p.readBackup(op, expr)
+		if err != nil {
+			closeExportStorages(storages)
+			return nil, nil, err
+		}
+		storages = append(storages, storage)
+
+		if len(chain) == 0 {
+			if backup.StartTime != roachpb.ZeroTimestamp {
+				err = fmt.Errorf("%s: %q is not a full backup", op, uri)
+			}
+		} else if prev := chain[len(chain)-1]; backup.StartTime != prev.EndTime {
+			err = fmt.Errorf("%s: backup %q does not follow backup %q", op, uri, uris[len(uris)-1])
+		}
+		if err != nil {
+			closeExportStorages(storages)
+			return nil, nil, err
+		}
+		uris = append(uris, uri)
+		chain = append(chain, backup)
+	}
+	return storages, chain, nil
+}
+
+// readBackup opens the storage of the backup at the URI expr evaluates to,
+// and reads its descriptor.
+func (p *planner) readBackup(op string, expr parser.Expr) (string, ExportStorage, BackupDescriptor, error) {
+	var backup BackupDescriptor
+	uri, err := p.getStringVal(op, parser.Exprs{expr})
+	if err != nil {
+		return "", nil, backup, err
+	}
+	storage, err := p.makeExportStorage(op, uri)
+	if err != nil {
+		return "", nil, backup, err
+	}
+	content, err := storage.ReadFile(backupDescriptorName)
+	if err == nil {
+		err = backup.Unmarshal(content)
+	}
+	if err != nil {
+		_ = storage.Close()
+		return "", nil, backup, fmt.Errorf("%s: cannot read backup %q: %s", op, uri, err)
+	}
+	return uri, storage, backup, nil
+}
+
+func closeExportStorages(storages []ExportStorage) {
+	for _, storage := range storages {
+		_ = storage.Close()
+	}
+}
+
+// Restore recreates the databases and tables of a chain of backups with new
+// IDs, and loads their data as of the end time of the last backup. Without
+// targets, all the databases of the backup are restored. A restored table
+// is added to the existing database of the same name.
+// Privileges: root to restore databases or to restore from a local
+// directory; CREATE on the database of the restored tables.
+func (p *planner) Restore(n *parser.Restore) (planNode, error) {
+	storages, chain, err := p.readBackupChain("RESTORE", n.From)
+	if err != nil {
+		return nil, err
+	}
+	defer closeExportStorages(storages)
+
+	dbs, tables, err := p.restoreTargets(chain[len(chain)-1], n.Targets)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(dbs) > 0 && p.user != security.RootUser {
+		return nil, fmt.Errorf("only %s is allowed to restore databases", security.RootUser)
+	}
+	for _, db := range dbs {
+		if err := p.checkNotExists(databaseKey{db.Name}, db); err != nil {
+			return nil, err
+		}
+	}
+	// The existing databases the restored tables are added to, keyed by the
+	// IDs of the databases in the backup.
+	existingDBs := map[ID]*DatabaseDescriptor{}
+	restoredDBs := map[ID]struct{}{}
+	for _, db := range dbs {
+		restoredDBs[db.ID] = struct{}{}
+	}
+	for _, table := range tables {
+		if _, ok := restoredDBs[table.ParentID]; ok {
+			continue
+		}
+		if _, ok := existingDBs[table.ParentID]; !ok {
+			oldDB := restoreDatabaseByID(chain[len(chain)-1], table.ParentID)
+			if oldDB == nil {
+				return nil, util.Errorf("database %d of table %q is not in the backup", table.ParentID, table.Name)
+			}
+			db, err := p.getDatabaseDesc(oldDB.Name)
+			if err != nil {
+				return nil, err
+			}
+			if err := p.checkPrivilege(db, privilege.CREATE); err != nil {
+				return nil, err
+			}
+			existingDBs[table.ParentID] = db
+		}
+		if err := p.checkNotExists(tableKey{existingDBs[table.ParentID].ID, table.Name}, table); err != nil {
+			return nil, err
+		}
+	}
+
+	// The IDs are allocated outside of the transaction, so that concurrent
+	// schema changes aren't blocked on the ID generator until the restore
+	// commits.
+	count := int64(len(dbs) + len(tables))
+	kv, err := p.leaseMgr.db.Inc(keys.DescIDGenerator, count)
+	if err != nil {
+		return nil, err
+	}
+	nextID := ID(kv.ValueInt() - count)
+	newIDs := map[ID]ID{}
+	for _, db := range dbs {
+		newIDs[db.ID] = nextID
+		nextID++
+	}
+	for _, table := range tables {
+		newIDs[table.ID] = nextID
+		nextID++
+	}
+
+	// The data is loaded before the descriptors are written, so the restored
+	// tables are complete once they become visible. If the transaction fails,
+	// the loaded data remains under unused IDs.
+	for _, table := range tables {
+		if err := restoreTableData(&p.leaseMgr.db, storages, chain, table.ID, newIDs[table.ID]); err != nil {
+			return nil, err
+		}
+	}
+
+	b := client.Batch{}
+	var verifyKeys []roachpb.Key
+	var verifyIDs []ID
+	var verifyDescs []*Descriptor
+	write := func(plainKey descriptorKey, descriptor descriptorProto) error {
+		if err := descriptor.Validate(); err != nil {
+			return err
+		}
+		idKey := plainKey.Key()
+		descKey := MakeDescMetadataKey(descriptor.GetID())
+		descDesc := wrapDescriptor(descriptor)
+		b.CPut(idKey, descriptor.GetID(), nil)
+		b.CPut(descKey, descDesc, nil)
+		verifyKeys = append(verifyKeys, idKey, descKey)
+		verifyIDs = append(verifyIDs, descriptor.GetID())
+		verifyDescs = append(verifyDescs, descDesc)
+		return nil
+	}
+	for _, db := range dbs {
+		desc := proto.Clone(db).(*DatabaseDescriptor)
+		desc.ID = newIDs[db.ID]
+		if err := write(databaseKey{desc.Name}, desc); err != nil {
+			return nil, err
+		}
+	}
+	for _, table := range tables {
+		desc := proto.Clone(table).(*TableDescriptor)
+		desc.ID = newIDs[table.ID]
+		if db, ok := existingDBs[table.ParentID]; ok {
+			desc.ParentID = db.ID
+		} else {
+			desc.ParentID = newIDs[table.ParentID]
+		}
+		desc.Version = 1
+		desc.UpVersion = false
+		desc.Lease = nil
+		if err := write(tableKey{desc.ParentID, desc.Name}, desc); err != nil {
+			return nil, err
+		}
+		for _, m := range desc.Mutations {
+			p.notifySchemaChange(desc.ID, m.MutationID)
+		}
+	}
+
+	p.testingVerifyMetadata = func(systemConfig config.SystemConfig) error {
+		for i, id := range verifyIDs {
+			if err := expectDescriptorID(systemConfig, verifyKeys[2*i], id); err != nil {
+				return err
+			}
+			if err := expectDescriptor(systemConfig, verifyKeys[2*i+1], verifyDescs[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := p.txn.Run(&b); err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
+
+// restoreTargets returns the databases and the tables of a backup which
+// are matched by the targets of a RESTORE.
+func (p *planner) restoreTargets(backup BackupDescriptor, targets *parser.TargetList) (
+	[]*DatabaseDescriptor, []*TableDescriptor, error) {
+	var dbs []*DatabaseDescriptor
+	var tables []*TableDescriptor
+	seen := map[ID]struct{}{}
+	addTable := func(table *TableDescriptor) {
+		if _, ok := seen[table.ID]; !ok {
+			seen[table.ID] = struct{}{}
+			tables = append(tables, table)
+		}
+	}
+	addDB := func(db *DatabaseDescriptor) {
+		if _, ok := seen[db.ID]; ok {
+			return
+		}
+		seen[db.ID] = struct{}{}
+		dbs = append(dbs, db)
+		for i := range backup.Descriptors {
+			if table := backup.Descriptors[i].GetTable(); table != nil && table.ParentID == db.ID {
+				addTable(table)
+			}
+		}
+	}
+
+	if targets == nil {
+		for i := range backup.Descriptors {
+			if db := backup.Descriptors[i].GetDatabase(); db != nil {
+				addDB(db)
+			}
+		}
+		return dbs, tables, nil
+	}
+
+	if targets.Databases != nil {
+		if len(targets.Databases) == 0 {
+			return nil, nil, errNoDatabase
+		}
+		for _, name := range targets.Databases {
+			db := restoreDatabaseByName(backup, name)
+			if db == nil {
+				return nil, nil, fmt.Errorf("database %q is not in the backup", name)
+			}
+			addDB(db)
+		}
+		return dbs, tables, nil
+	}
+
+	if len(targets.Tables) == 0 {
+		return nil, nil, errNoTable
+	}
+	for _, qname := range targets.Tables {
+		var dbName, tableName string
+		if isTablePattern(qname) {
+			dbName = string(qname.Base)
+		} else {
+			if err := qname.NormalizeTableName(p.session.Database); err != nil {
+				return nil, nil, err
+			}
+			dbName, tableName = qname.Database(), qname.Table()
+		}
+		db := restoreDatabaseByName(backup, dbName)
+		if db == nil {
+			return nil, nil, fmt.Errorf("database %q is not in the backup", dbName)
+		}
+		found := false
+		for i := range backup.Descriptors {
+			table := backup.Descriptors[i].GetTable()
+			if table == nil || table.ParentID != db.ID {
+				continue
+			}
+			if tableName == "" || equalName(table.Name, tableName) {
+				addTable(table)
+				found = true
+			}
+		}
+		if !found && tableName != "" {
+			return nil, nil, fmt.Errorf("table %q is not in the backup", qname)
+		}
+	}
+	return dbs, tables, nil
+}
+
+func restoreDatabaseByName(backup BackupDescriptor, name string) *DatabaseDescriptor {
+	for i := range backup.Descriptors {
+		if db := backup.Descriptors[i].GetDatabase(); db != nil && equalName(db.Name, name) {
+			return db
+		}
+	}
+	return nil
+}
+
+func restoreDatabaseByID(backup BackupDescriptor, id ID) *DatabaseDescriptor {
+	for i := range backup.Descriptors {
+		if db := backup.Descriptors[i].GetDatabase(); db != nil && db.ID == id {
+			return db
+		}
+	}
+	return nil
+}
+
+// checkNotExists returns an error if the name of a descriptor is taken.
+func (p *planner) checkNotExists(plainKey descriptorKey, descriptor descriptorProto) error {
+	gr, err := p.txn.Get(plainKey.Key())
+	if err != nil {
+		return err
+	}
+	if gr.Exists() {
+		return fmt.Errorf("%s %q already exists", descriptor.TypeName(), plainKey.Name())
+	}
+	return nil
+}
+
+// isExportDeletion returns true if a value of an export is a deletion.
+// Values written through SQL always carry a checksum.
+func isExportDeletion(value roachpb.Value) bool {
+	return len(value.RawBytes) == 0 && value.Checksum == nil
+}
+
+// restoreTableData loads the key/values of a table found in a chain of
+// backups, rewriting their keys for the new ID of the table. The backups
+// are applied in order, one data file at a time, so that the latest
+// revision of each key is the one left without holding the table in
+// memory.
+func restoreTableData(db *client.DB, storages []ExportStorage, chain []BackupDescriptor, oldID, newID ID) error {
+	oldPrefix := keys.MakeTablePrefix(uint32(oldID))
+	newPrefix := keys.MakeTablePrefix(uint32(newID))
+	var reqs []roachpb.Request
+	flush := func() error {
+		if len(reqs) == 0 {
+			return nil
+		}
+		err := db.Txn(func(txn *client.Txn) error {
+			b := client.Batch{}
+			b.InternalAddRequest(reqs...)
+			return txn.Run(&b)
+		})
+		reqs = nil
+		return err
+	}
+
+	for i, backup := range chain {
+		for _, file := range backup.Files {
+			if file.TableID != oldID {
+				continue
+			}
+			content, err := storages[i].ReadFile(file.Path)
+			if err != nil {
+				return err
+			}
+			var data BackupData
+			if err := data.Unmarshal(content); err != nil {
+				return err
+			}
+			var prev roachpb.Key
+			for _, kv := range data.KVs {
+				// The revisions of a key are ordered by descending timestamp,
+				// so only the first one is the latest.
+				if prev != nil && kv.Key.Equal(prev) {
+					continue
+				}
+				prev = kv.Key
+				if !bytes.HasPrefix(kv.Key, oldPrefix) {
+					return util.Errorf("key %q is not in table %d", kv.Key, oldID)
+				}
+				newKey := append(append(roachpb.Key(nil), newPrefix...), kv.Key[len(oldPrefix):]...)
+				if isExportDeletion(kv.Value) {
+					reqs = append(reqs, roachpb.NewDelete(newKey))
+				} else {
+					reqs = append(reqs, roachpb.NewPut(newKey, roachpb.Value{RawBytes: kv.Value.RawBytes, Tag: kv.Value.Tag}))
+				}
+				if len(reqs) == restoreBatchSize {
+					if err := flush(); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		// The writes of a backup are committed before those of the next one,
+		// which may overwrite them.
+		if err := flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
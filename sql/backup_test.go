@@ -0,0 +1,151 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/sql"
+	"github.com/cockroachdb/cockroach/testutils"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func TestBackupRestore(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, _ := setup(t)
+	defer cleanup(s, sqlDB)
+	// Each data file holds the revisions of at most two keys, so that the
+	// tables are exported to several files.
+	defer sql.TestingSetExportFileKeys(2)()
+
+	dir, err := ioutil.TempDir("", "backup_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	full := filepath.Join(dir, "full")
+	incremental := filepath.Join(dir, "incremental")
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE d;
+CREATE TABLE d.t (a INT PRIMARY KEY, b INT);
+CREATE INDEX b ON d.t (b);
+INSERT INTO d.t VALUES (1, 1), (2, 2), (3, 3);
+`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sqlDB.Exec(`BACKUP DATABASE d TO $1`, full); err != nil {
+		t.Fatal(err)
+	}
+	if files, err := filepath.Glob(filepath.Join(full, "*.data")); err != nil {
+		t.Fatal(err)
+	} else if len(files) < 2 {
+		t.Fatalf("expected several data files, but found %v", files)
+	}
+	if _, err := sqlDB.Exec(`
+UPDATE d.t SET b = 20 WHERE a = 2;
+DELETE FROM d.t WHERE a = 3;
+INSERT INTO d.t VALUES (4, 4);
+`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sqlDB.Exec(`BACKUP DATABASE d TO $1 INCREMENTAL FROM $2`, incremental, full); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sqlDB.Exec(`DROP DATABASE d`); err != nil {
+		t.Fatal(err)
+	}
+
+	checkRows := func(query string, expected [][2]int) {
+		rows, err := sqlDB.Query(query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer rows.Close()
+		var found [][2]int
+		for rows.Next() {
+			var r [2]int
+			if err := rows.Scan(&r[0], &r[1]); err != nil {
+				t.Fatal(err)
+			}
+			found = append(found, r)
+		}
+		if len(found) != len(expected) {
+			t.Fatalf("%s: expected %v, but found %v", query, expected, found)
+		}
+		for i := range found {
+			if found[i] != expected[i] {
+				t.Fatalf("%s: expected %v, but found %v", query, expected, found)
+			}
+		}
+	}
+
+	// Restoring the full backup alone restores the initial rows.
+	if _, err := sqlDB.Exec(`RESTORE FROM $1`, full); err != nil {
+		t.Fatal(err)
+	}
+	checkRows(`SELECT a, b FROM d.t`, [][2]int{{1, 1}, {2, 2}, {3, 3}})
+	if _, err := sqlDB.Exec(`RESTORE DATABASE d FROM $1`, full); !testutils.IsError(err, `database "d" already exists`) {
+		t.Fatalf("expected existing database error, but found %v", err)
+	}
+	if _, err := sqlDB.Exec(`DROP DATABASE d`); err != nil {
+		t.Fatal(err)
+	}
+
+	// Restoring the chain restores the rows as of the incremental backup,
+	// including the secondary index.
+	if _, err := sqlDB.Exec(`RESTORE DATABASE d FROM $1, $2`, full, incremental); err != nil {
+		t.Fatal(err)
+	}
+	checkRows(`SELECT a, b FROM d.t`, [][2]int{{1, 1}, {2, 20}, {4, 4}})
+	checkRows(`SELECT a, b FROM d.t@b`, [][2]int{{1, 1}, {4, 4}, {2, 20}})
+
+	// A table is restored into an existing database.
+	if _, err := sqlDB.Exec(`DROP TABLE d.t`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sqlDB.Exec(`RESTORE TABLE d.t FROM $1, $2`, full, incremental); err != nil {
+		t.Fatal(err)
+	}
+	checkRows(`SELECT a, b FROM d.t`, [][2]int{{1, 1}, {2, 20}, {4, 4}})
+
+	testCases := []struct {
+		sql      string
+		args     []interface{}
+		expected string
+	}{
+		{`RESTORE FROM $1`, []interface{}{incremental}, `is not a full backup`},
+		{`RESTORE FROM $1, $2`, []interface{}{incremental, full}, `is not a full backup`},
+		{`RESTORE FROM $1, $2, $2`, []interface{}{full, incremental}, `does not follow`},
+		{`RESTORE TABLE d.u FROM $1`, []interface{}{full}, `table "d.u" is not in the backup`},
+		{`RESTORE FROM $1`, []interface{}{filepath.Join(dir, "missing")}, `cannot read backup`},
+		{`BACKUP DATABASE system TO $1`, []interface{}{filepath.Join(dir, "system")}, `cannot back up database "system"`},
+		{`BACKUP TO $1 AS OF SYSTEM TIME '-48h'`, []interface{}{filepath.Join(dir, "old")}, `older than the GC threshold`},
+		{`BEGIN; BACKUP TO $1`, []interface{}{filepath.Join(dir, "txn")}, `BACKUP is not supported within a transaction`},
+	}
+	for _, tc := range testCases {
+		if _, err := sqlDB.Exec(tc.sql, tc.args...); !testutils.IsError(err, tc.expected) {
+			t.Errorf("%s: expected %q, but found %v", tc.sql, tc.expected, err)
+		}
+	}
+}
@@ -25,20 +25,21 @@ func init() {
 	config.ZoneConfigHook = GetZoneConfig
 }
 
-// GetZoneConfig returns the zone config for the object with 'id'.
+// GetZoneConfig returns the zone config for the object with 'id'. Fields
+// which are not set in the zone config of a table are inherited from the
+// zone config of its database, and fields set by neither from the default
+// zone config.
 func GetZoneConfig(cfg config.SystemConfig, id uint32) (*config.ZoneConfig, error) {
+	zone := &config.ZoneConfig{}
 	// Look in the zones table.
 	if zoneVal := cfg.GetValue(MakeZoneKey(ID(id))); zoneVal != nil {
-		zone := &config.ZoneConfig{}
 		if err := zoneVal.GetProto(zone); err != nil {
 			return nil, err
 		}
-		// We're done.
-		return zone, nil
 	}
 
-	// No zone config for this ID. We need to figure out if it's a database
-	// or table. Lookup its descriptor.
+	// We need to figure out if this is a database or table. Lookup its
+	// descriptor.
 	if descVal := cfg.GetValue(MakeDescMetadataKey(ID(id))); descVal != nil {
 		// Determine whether this is a database or table.
 		desc := &Descriptor{}
@@ -46,12 +47,19 @@ func GetZoneConfig(cfg config.SystemConfig, id uint32) (*config.ZoneConfig, erro
 			return nil, err
 		}
 		if tableDesc := desc.GetTable(); tableDesc != nil {
-			// This is a table descriptor. Lookup its parent database zone config.
-			return GetZoneConfig(cfg, uint32(tableDesc.ParentID))
+			// This is a table descriptor. Inherit from its parent database zone
+			// config, which has in turn inherited from the default config.
+			parent, err := GetZoneConfig(cfg, uint32(tableDesc.ParentID))
+			if err != nil {
+				return nil, err
+			}
+			zone.InheritFrom(*parent)
+			return zone, nil
 		}
 	}
 
-	// No descriptor or not a table. This table/db could have been deleted, just
-	// return the default config.
-	return config.DefaultZoneConfig, nil
+	// A database, or no descriptor: this table/db could have been deleted.
+	// Inherit from the default config.
+	zone.InheritFrom(*config.DefaultZoneConfig)
+	return zone, nil
 }
@@ -167,7 +167,12 @@ func TestGetZoneConfig(t *testing.T) {
 	// db1: false
 	//   tb1: true
 	//   tb2: false
-	db1Cfg := config.ZoneConfig{ReplicaAttrs: []roachpb.Attributes{{[]string{"db1"}}}}
+	// Fields which are not set are inherited from the database zone config,
+	// and then from the default zone config.
+	db1Cfg := config.ZoneConfig{
+		ReplicaAttrs: []roachpb.Attributes{{[]string{"db1"}}},
+		GC:           &config.GCPolicy{TTLSeconds: 60},
+	}
 	tb11Cfg := config.ZoneConfig{ReplicaAttrs: []roachpb.Attributes{{[]string{"db1.tb1"}}}}
 	tb21Cfg := config.ZoneConfig{ReplicaAttrs: []roachpb.Attributes{{[]string{"db2.tb1"}}}}
 	for objID, objZone := range map[uint32]config.ZoneConfig{
@@ -189,6 +194,17 @@ func TestGetZoneConfig(t *testing.T) {
 		t.Fatalf("failed to get latest system config: %s", err)
 	}
 
+	db1Resolved := config.ZoneConfig{
+		ReplicaAttrs:  db1Cfg.ReplicaAttrs,
+		RangeMinBytes: config.DefaultZoneConfig.RangeMinBytes,
+		RangeMaxBytes: config.DefaultZoneConfig.RangeMaxBytes,
+		GC:            db1Cfg.GC,
+	}
+	tb11Resolved := db1Resolved
+	tb11Resolved.ReplicaAttrs = tb11Cfg.ReplicaAttrs
+	tb21Resolved := *config.DefaultZoneConfig
+	tb21Resolved.ReplicaAttrs = tb21Cfg.ReplicaAttrs
+
 	testCases = []struct {
 		key     roachpb.RKey
 		zoneCfg config.ZoneConfig
@@ -197,11 +213,11 @@ func TestGetZoneConfig(t *testing.T) {
 		{keys.Addr(keys.TableDataPrefix), *config.DefaultZoneConfig},
 		{keys.MakeTablePrefix(1), *config.DefaultZoneConfig},
 		{keys.MakeTablePrefix(keys.MaxReservedDescID), *config.DefaultZoneConfig},
-		{keys.MakeTablePrefix(db1), db1Cfg},
+		{keys.MakeTablePrefix(db1), db1Resolved},
 		{keys.MakeTablePrefix(db2), *config.DefaultZoneConfig},
-		{keys.MakeTablePrefix(tb11), tb11Cfg},
-		{keys.MakeTablePrefix(tb12), db1Cfg},
-		{keys.MakeTablePrefix(tb21), tb21Cfg},
+		{keys.MakeTablePrefix(tb11), tb11Resolved},
+		{keys.MakeTablePrefix(tb12), db1Resolved},
+		{keys.MakeTablePrefix(tb21), tb21Resolved},
 		{keys.MakeTablePrefix(tb22), *config.DefaultZoneConfig},
 	}
 
@@ -38,6 +38,14 @@ func (p *planner) CreateDatabase(n *parser.CreateDatabase) (planNode, error) {
 		return nil, fmt.Errorf("only %s is allowed to create databases", security.RootUser)
 	}
 
+	if getVirtualSchema(string(n.Name)) != nil {
+		if n.IfNotExists {
+			// Noop.
+			return &valuesNode{}, nil
+		}
+		return nil, fmt.Errorf("database %q already exists", string(n.Name))
+	}
+
 	desc := makeDatabaseDesc(n)
 
 	if err := p.createDescriptor(databaseKey{string(n.Name)}, &desc, n.IfNotExists); err != nil {
@@ -86,18 +94,18 @@ func (p *planner) CreateIndex(n *parser.CreateIndex) (planNode, error) {
 
 	tableDesc.addIndexMutation(indexDesc, DescriptorMutation_ADD)
 
-	if err := tableDesc.AllocateIDs(); err != nil {
+	mutationID, err := tableDesc.finalizeMutation()
+	if err != nil {
 		return nil, err
 	}
-
-	if err := p.txn.Put(MakeDescMetadataKey(tableDesc.GetID()), wrapDescriptor(tableDesc)); err != nil {
+	if err := tableDesc.AllocateIDs(); err != nil {
 		return nil, err
 	}
 
-	// Process mutation synchronously.
-	if err := p.applyMutations(tableDesc, n.Table); err != nil {
+	if err := p.txn.Put(MakeDescMetadataKey(tableDesc.GetID()), wrapDescriptor(tableDesc)); err != nil {
 		return nil, err
 	}
+	p.notifySchemaChange(tableDesc.ID, mutationID)
 
 	return &valuesNode{}, nil
 }
@@ -48,6 +48,10 @@ func makeDatabaseDesc(p *parser.CreateDatabase) DatabaseDescriptor {
 
 // getDatabaseDesc looks up the database descriptor given its name.
 func (p *planner) getDatabaseDesc(name string) (*DatabaseDescriptor, error) {
+	if e := getVirtualSchema(name); e != nil {
+		desc := e.desc
+		return &desc, nil
+	}
 	desc := &DatabaseDescriptor{}
 	if err := p.getDescriptor(databaseKey{name}, desc); err != nil {
 		return nil, err
@@ -61,6 +65,10 @@ func (p *planner) getCachedDatabaseDesc(name string) (*DatabaseDescriptor, error
 	if name == SystemDB.Name {
 		return &SystemDB, nil
 	}
+	if e := getVirtualSchema(name); e != nil {
+		desc := e.desc
+		return &desc, nil
+	}
 
 	nameKey := databaseKey{name}
 	nameVal := p.systemConfig.GetValue(nameKey.Key())
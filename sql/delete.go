@@ -114,6 +114,7 @@ func (p *planner) Delete(n *parser.Delete) (planNode, error) {
 	if err := p.txn.Run(&b); err != nil {
 		return nil, err
 	}
+	p.kvStats.addKV(writeStats(&b))
 
 	return result, nil
 }
@@ -63,13 +63,58 @@ type descriptorProto interface {
 	Validate() error
 }
 
-// checkPrivilege verifies that p.user has `privilege` on `descriptor`.
-func (p *planner) checkPrivilege(descriptor descriptorProto, privilege privilege.Kind) error {
-	if descriptor.GetPrivileges().CheckPrivilege(p.user, privilege) {
+// checkPrivilege verifies that p.user, or one of its groups, has `priv` on
+// `descriptor`.
+func (p *planner) checkPrivilege(descriptor descriptorProto, priv privilege.Kind) error {
+	if isVirtualDescriptor(descriptor) {
+		// Virtual schemas and tables can be read by everyone, but not modified.
+		if priv == privilege.SELECT {
+			return nil
+		}
+		return errVirtualReadOnly(descriptor.TypeName(), descriptor.GetName())
+	}
+	ok, err := p.hasPrivilege(descriptor.GetPrivileges(), priv)
+	if err != nil {
+		return err
+	}
+	if ok {
 		return nil
 	}
 	return fmt.Errorf("user %s does not have %s privilege on %s %s",
-		p.user, privilege, descriptor.TypeName(), descriptor.GetName())
+		p.user, priv, descriptor.TypeName(), descriptor.GetName())
+}
+
+// checkColumnPrivileges verifies that p.user, or one of its groups, has
+// `priv` on `table`, or on each of `cols`. When the privilege isn't granted
+// on any column of the table, the error is the one of the table.
+func (p *planner) checkColumnPrivileges(table *TableDescriptor, cols []ColumnDescriptor, priv privilege.Kind) error {
+	tableErr := p.checkPrivilege(table, priv)
+	if tableErr == nil {
+		return nil
+	}
+	granted := map[ColumnID]struct{}{}
+	for _, col := range table.Columns {
+		if col.Privileges == nil {
+			continue
+		}
+		ok, err := p.hasPrivilege(col.Privileges, priv)
+		if err != nil {
+			return err
+		}
+		if ok {
+			granted[col.ID] = struct{}{}
+		}
+	}
+	if len(granted) == 0 {
+		return tableErr
+	}
+	for _, col := range cols {
+		if _, ok := granted[col.ID]; !ok {
+			return fmt.Errorf("user %s does not have %s privilege on column %s of table %s",
+				p.user, priv, col.Name, table.Name)
+		}
+	}
+	return nil
 }
 
 // createDescriptor takes a Table or Database descriptor and creates it
@@ -160,33 +205,66 @@ func (p *planner) getDescriptor(plainKey descriptorKey, descriptor descriptorPro
 	return descriptor.Validate()
 }
 
-// getDescriptorFromTargetList examines a TargetList and fetches the
-// appropriate descriptor.
-// TODO(marc): support multiple targets.
-func (p *planner) getDescriptorFromTargetList(targets parser.TargetList) (descriptorProto, error) {
+// getDescriptorsFromTargetList examines a TargetList and fetches the
+// appropriate descriptors. A table pattern "database.*" matches all the
+// tables of the database. Each descriptor is only returned once.
+func (p *planner) getDescriptorsFromTargetList(targets parser.TargetList) ([]descriptorProto, error) {
+	var descriptors []descriptorProto
+	seen := map[ID]struct{}{}
+	add := func(descriptor descriptorProto) {
+		if _, ok := seen[descriptor.GetID()]; !ok {
+			seen[descriptor.GetID()] = struct{}{}
+			descriptors = append(descriptors, descriptor)
+		}
+	}
+
 	if targets.Databases != nil {
 		if len(targets.Databases) == 0 {
 			return nil, errNoDatabase
-		} else if len(targets.Databases) != 1 {
-			return nil, util.Errorf("TODO(marc): multiple targets not implemented")
 		}
-		descriptor, err := p.getDatabaseDesc(targets.Databases[0])
-		if err != nil {
-			return nil, err
+		for _, database := range targets.Databases {
+			descriptor, err := p.getDatabaseDesc(database)
+			if err != nil {
+				return nil, err
+			}
+			add(descriptor)
 		}
-		return descriptor, nil
+		return descriptors, nil
 	}
 
 	if len(targets.Tables) == 0 {
 		return nil, errNoTable
-	} else if len(targets.Tables) != 1 {
-		return nil, util.Errorf("TODO(marc): multiple targets not implemented")
 	}
-	descriptor, err := p.getTableDesc(targets.Tables[0])
-	if err != nil {
-		return nil, err
+	for _, qname := range targets.Tables {
+		qnames := parser.QualifiedNames{qname}
+		if isTablePattern(qname) {
+			dbDesc, err := p.getDatabaseDesc(string(qname.Base))
+			if err != nil {
+				return nil, err
+			}
+			if qnames, err = p.getTableNames(dbDesc); err != nil {
+				return nil, err
+			}
+		}
+		for _, qname := range qnames {
+			descriptor, err := p.getTableDesc(qname)
+			if err != nil {
+				return nil, err
+			}
+			add(descriptor)
+		}
+	}
+	return descriptors, nil
+}
+
+// isTablePattern returns true if the qualified name is of the form
+// "database.*".
+func isTablePattern(qname *parser.QualifiedName) bool {
+	if qname.Base == "" || len(qname.Indirect) != 1 {
+		return false
 	}
-	return descriptor, nil
+	_, ok := qname.Indirect[0].(parser.StarIndirection)
+	return ok
 }
 
 func wrapDescriptor(descriptor descriptorProto) *Descriptor {
@@ -21,6 +21,7 @@ import (
 	"database/sql/driver"
 	"errors"
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/cockroachdb/cockroach/util"
@@ -72,6 +73,9 @@ func (c *conn) Exec(stmt string, args []driver.Value) (driver.Result, error) {
 }
 
 func (c *conn) Query(stmt string, args []driver.Value) (driver.Rows, error) {
+	if s, ok := c.sender.(streamingSender); ok {
+		return c.queryStream(s, stmt, args)
+	}
 	result, err := c.internalQuery(stmt, args)
 	if err != nil {
 		return nil, err
@@ -104,7 +108,86 @@ func (c *conn) Query(stmt string, args []driver.Value) (driver.Rows, error) {
 	return driverRows, nil
 }
 
+// queryStream sends the statement to the server, returning rows which read
+// the last result as it is streamed back.
+func (c *conn) queryStream(s streamingSender, stmt string, args []driver.Value) (driver.Rows, error) {
+	req, err := c.makeRequest(stmt, args)
+	if err != nil {
+		return nil, err
+	}
+	// Forget the session state, and use the one provided at the end of the
+	// response for the next request.
+	c.session = nil
+
+	stream, err := s.SendStream(req)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		var frame ResponseFrame
+		if err := stream.next(&frame); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			_ = stream.Close()
+			return nil, err
+		}
+		if frame.Error != nil {
+			return nil, c.drainStream(stream, errors.New(*frame.Error))
+		}
+		result := frame.Result
+		if result == nil {
+			// Skip the rows of the results preceding the last one.
+			continue
+		}
+		if result.Error != nil {
+			return nil, c.drainStream(stream, errors.New(*result.Error))
+		}
+		if !frame.Last {
+			continue
+		}
+		driverRows := &streamRows{conn: c, stream: stream}
+		if resultRows := result.GetRows(); resultRows != nil {
+			for _, column := range resultRows.Columns {
+				driverRows.columns = append(driverRows.columns, column.Name)
+			}
+		}
+		return driverRows, nil
+	}
+}
+
+// drainStream reads the remainder of the response, picking up the session
+// state sent at its end, and closes it. The supplied error is returned
+// unless reading the response fails.
+func (c *conn) drainStream(stream *responseStream, err error) error {
+	for {
+		var frame ResponseFrame
+		if readErr := stream.next(&frame); readErr != nil {
+			if readErr != io.EOF {
+				err = readErr
+			}
+			break
+		}
+		if frame.Session != nil {
+			c.session = frame.Session
+		}
+	}
+	if closeErr := stream.Close(); closeErr != nil && err == nil {
+		err = closeErr
+	}
+	return err
+}
+
 func (c *conn) internalQuery(stmt string, args []driver.Value) (*Response_Result, error) {
+	req, err := c.makeRequest(stmt, args)
+	if err != nil {
+		return nil, err
+	}
+	return c.send(req)
+}
+
+// makeRequest builds the request executing the statement.
+func (c *conn) makeRequest(stmt string, args []driver.Value) (Request, error) {
 	if c.beginTransaction {
 		stmt = "BEGIN TRANSACTION; " + stmt
 		c.beginTransaction = false
@@ -113,21 +196,19 @@ func (c *conn) internalQuery(stmt string, args []driver.Value) (*Response_Result
 	for _, arg := range args {
 		datum, err := makeDatum(arg)
 		if err != nil {
-			return nil, err
+			return Request{}, err
 		}
 		dArgs = append(dArgs, datum)
 	}
-
-	return c.send(stmt, dArgs)
-}
-
-// send sends the statement to the server.
-func (c *conn) send(stmt string, dArgs []Datum) (*Response_Result, error) {
-	args := Request{
+	return Request{
 		Session: c.session,
 		Sql:     stmt,
 		Params:  dArgs,
-	}
+	}, nil
+}
+
+// send sends the request to the server.
+func (c *conn) send(args Request) (*Response_Result, error) {
 	// Forget the session state, and use the one provided in the server
 	// response for the next request.
 	c.session = nil
@@ -21,6 +21,7 @@ import (
 	"database/sql"
 	"fmt"
 	"reflect"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -512,3 +513,82 @@ func TestConcurrentIncrements(t *testing.T) {
 		concurrentIncrements(db, t)
 	}
 }
+
+func TestStreamingQuery(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, db := setup(t, time.Local)
+	defer cleanup(s, db)
+
+	if _, err := db.Exec(`CREATE DATABASE t; CREATE TABLE t.kv (k INT PRIMARY KEY, v INT)`); err != nil {
+		t.Fatal(err)
+	}
+	// Insert enough rows for the result to be streamed in several frames.
+	const numRows = 1000
+	for i := 0; i < numRows; i += 100 {
+		var values []string
+		for j := i; j < i+100; j++ {
+			values = append(values, fmt.Sprintf("(%d, %d)", j, 2*j))
+		}
+		if _, err := db.Exec(`INSERT INTO t.kv VALUES ` + strings.Join(values, ", ")); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The rows of the last result are streamed inside a transaction, whose
+	// session state is sent at the end of the stream.
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows, err := tx.Query(`SELECT k, v FROM t.kv`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	count := 0
+	for rows.Next() {
+		var k, v int
+		if err := rows.Scan(&k, &v); err != nil {
+			t.Fatal(err)
+		}
+		if k != count || v != 2*k {
+			t.Fatalf("expected row (%d, %d), but found (%d, %d)", count, 2*count, k, v)
+		}
+		count++
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if count != numRows {
+		t.Fatalf("expected %d rows, but found %d", numRows, count)
+	}
+	if _, err := tx.Exec(`DELETE FROM t.kv WHERE k >= 10`); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Closing the rows early discards the rest of the result.
+	rows, err = db.Query(`SELECT k FROM t.kv`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rows.Next() {
+		t.Fatalf("expected a row: %v", rows.Err())
+	}
+	if err := rows.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// An error in any of the statements is returned by Query.
+	if _, err := db.Query(`SELECT k FROM t.kv; SELECT k FROM t.missing; SELECT 1`); !testutils.IsError(err, `table "missing" does not exist`) {
+		t.Fatalf("expected missing table error, but found %v", err)
+	}
+
+	var n int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM t.kv`).Scan(&n); err != nil {
+		t.Fatal(err)
+	} else if n != 10 {
+		t.Fatalf("expected 10 rows, but found %d", n)
+	}
+}
@@ -140,6 +140,75 @@ func httpPost(c postContext, request, response proto.Message, method fmt.Stringe
 	return err
 }
 
+// httpPostStream posts the req like httpPost, asking for the response to
+// be streamed. On success, the returned stream reads the frames of the
+// response body as they arrive; the caller is responsible for closing it.
+//
+// Only failures to send the request and retryable HTTP response codes are
+// retried: once the server has started to stream the response, errors are
+// returned to the caller.
+func httpPostStream(c postContext, request proto.Message, method fmt.Stringer) (*responseStream, error) {
+	// Marshal the args into a request body.
+	body, err := proto.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	sharedClient, err := c.Context.GetHTTPClient()
+	if err != nil {
+		return nil, err
+	}
+
+	// The response is read at the pace of the consumer of the rows, so a
+	// timeout covering the whole exchange doesn't apply.
+	client := *sharedClient
+	client.Timeout = 0
+
+	url := c.Context.HTTPRequestScheme() + "://" + c.Server + c.Endpoint + method.String()
+
+	for r := retry.Start(c.RetryOpts); r.Next(); {
+		var req *http.Request
+		req, err = http.NewRequest("POST", url, bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Add(util.ContentTypeHeader, util.ProtoContentType)
+		req.Header.Add(util.AcceptHeader, StreamContentType)
+		req.Header.Add(util.AcceptEncodingHeader, util.SnappyEncoding)
+
+		var resp *http.Response
+		resp, err = client.Do(req)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+
+		switch resp.StatusCode {
+		case http.StatusOK:
+			// We're cool.
+		case http.StatusServiceUnavailable, http.StatusGatewayTimeout, StatusTooManyRequests:
+			// Retry on service unavailable and request timeout.
+			resp.Body.Close()
+			err = errors.New(resp.Status)
+			continue
+		default:
+			// Can't recover from all other errors.
+			resp.Body.Close()
+			return nil, errors.New(resp.Status)
+		}
+
+		if ct := resp.Header.Get(util.ContentTypeHeader); ct != StreamContentType {
+			resp.Body.Close()
+			return nil, fmt.Errorf("unexpected content type %q", ct)
+		}
+		if resp.Header.Get(util.ContentEncodingHeader) == util.SnappyEncoding {
+			resp.Body = &snappyReader{body: resp.Body}
+		}
+		return newResponseStream(resp.Body), nil
+	}
+	return nil, err
+}
+
 // snappyReader wraps a response body so it can lazily
 // call snappy.NewReader on the first call to Read
 type snappyReader struct {
@@ -25,6 +25,8 @@ import (
 	"github.com/cockroachdb/cockroach/util/retry"
 )
 
+var _ streamingSender = &httpSender{}
+
 func init() {
 	f := func(u *url.URL, ctx *base.Context, retryOpts retry.Options) (Sender, error) {
 		ctx.Insecure = (u.Scheme != "https")
@@ -69,3 +71,13 @@ func (s *httpSender) Send(args Request) (Response, error) {
 	reply := args.CreateReply()
 	return reply, httpPost(s.ctx, &args, &reply, args.Method())
 }
+
+// SendStream sends call to Cockroach via an HTTP post, returning the
+// stream of frames of the response.
+func (s *httpSender) SendStream(args Request) (*responseStream, error) {
+	// Prepare the args.
+	if args.GetUser() == "" {
+		args.User = s.ctx.Context.User
+	}
+	return httpPostStream(s.ctx, &args, args.Method())
+}
@@ -19,6 +19,7 @@ package driver
 
 import (
 	"database/sql/driver"
+	"errors"
 	"io"
 )
 
@@ -48,3 +49,60 @@ func (r *rows) Next(dest []driver.Value) error {
 	r.pos++
 	return nil
 }
+
+var _ driver.Rows = &streamRows{}
+
+// streamRows reads the rows of a result as they are streamed by the server.
+type streamRows struct {
+	conn    *conn
+	stream  *responseStream // nil once the response has been read
+	columns []string
+	rows    []Response_Result_Rows_Row // The rows of the current frame.
+}
+
+func (r *streamRows) Columns() []string {
+	return r.columns
+}
+
+// Close reads the remainder of the response, so that the session state
+// sent at its end is available to the next statement.
+func (r *streamRows) Close() error {
+	if r.stream == nil {
+		return nil
+	}
+	stream := r.stream
+	r.stream = nil
+	return r.conn.drainStream(stream, nil)
+}
+
+func (r *streamRows) Next(dest []driver.Value) error {
+	for len(r.rows) == 0 {
+		if r.stream == nil {
+			return io.EOF
+		}
+		var frame ResponseFrame
+		if err := r.stream.next(&frame); err != nil {
+			_ = r.stream.Close()
+			r.stream = nil
+			return err
+		}
+		if frame.Session != nil {
+			r.conn.session = frame.Session
+		}
+		if frame.Error != nil {
+			stream := r.stream
+			r.stream = nil
+			return r.conn.drainStream(stream, errors.New(*frame.Error))
+		}
+		r.rows = frame.Rows
+	}
+	for i, datum := range r.rows[0].Values {
+		val, err := datum.Value()
+		if err != nil {
+			return err
+		}
+		dest[i] = val
+	}
+	r.rows = r.rows[1:]
+	return nil
+}
@@ -44,6 +44,14 @@ type Sender interface {
 	Send(Request) (Response, error)
 }
 
+// streamingSender is implemented by the senders able to stream the results
+// of a request instead of returning a fully buffered Response.
+type streamingSender interface {
+	// SendStream dispatches a `Request` and returns the stream of frames of
+	// the response.
+	SendStream(Request) (*responseStream, error)
+}
+
 // NewSenderFunc creates a new sender for the registered scheme.
 type NewSenderFunc func(u *url.URL, ctx *base.Context, retryOpts retry.Options) (Sender, error)
 
@@ -0,0 +1,85 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package driver
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// StreamContentType is the content type of a response streamed as a
+// sequence of ResponseFrames. Clients request a streamed response by
+// setting the Accept header to StreamContentType.
+const StreamContentType = "application/x-protobuf-stream"
+
+// maxFrameSize is the largest ResponseFrame accepted by ReadFrame.
+const maxFrameSize = 64 << 20
+
+// WriteFrame writes the varint length-prefixed frame to w.
+func WriteFrame(w io.Writer, frame *ResponseFrame) error {
+	size := frame.Size()
+	buf := make([]byte, binary.MaxVarintLen64+size)
+	n := binary.PutUvarint(buf, uint64(size))
+	if _, err := frame.MarshalTo(buf[n:]); err != nil {
+		return err
+	}
+	_, err := w.Write(buf[:n+size])
+	return err
+}
+
+// ReadFrame reads a varint length-prefixed frame from r. It returns io.EOF
+// at the end of the stream.
+func ReadFrame(r *bufio.Reader, frame *ResponseFrame) error {
+	size, err := binary.ReadUvarint(r)
+	if err != nil {
+		return err
+	}
+	if size > maxFrameSize {
+		return util.Errorf("frame of %d bytes exceeds the maximum of %d bytes", size, maxFrameSize)
+	}
+	buf := make([]byte, size)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	frame.Reset()
+	return frame.Unmarshal(buf)
+}
+
+// responseStream reads the frames of a streamed response.
+type responseStream struct {
+	r    *bufio.Reader
+	body io.Closer
+}
+
+func newResponseStream(body io.ReadCloser) *responseStream {
+	return &responseStream{r: bufio.NewReader(body), body: body}
+}
+
+// next reads the next frame of the response. It returns io.EOF once the
+// response is complete.
+func (s *responseStream) next(frame *ResponseFrame) error {
+	return ReadFrame(s.r, frame)
+}
+
+func (s *responseStream) Close() error {
+	return s.body.Close()
+}
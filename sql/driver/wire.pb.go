@@ -12,6 +12,7 @@
 		Datum
 		Request
 		Response
+		ResponseFrame
 */
 package driver
 
@@ -486,6 +487,32 @@ func (m *Response_Result_Rows_Column) Reset()         { *m = Response_Result_Row
 func (m *Response_Result_Rows_Column) String() string { return proto.CompactTextString(m) }
 func (*Response_Result_Rows_Column) ProtoMessage()    {}
 
+// A ResponseFrame is one of the frames making up a response streamed to a
+// client, each frame being preceded by its varint-encoded length. The
+// results of the statements are streamed in order: a frame holding the
+// result without its rows starts the result of each statement, and frames
+// holding chunks of rows follow for statements returning rows. The last
+// frame holds the session.
+type ResponseFrame struct {
+	// Result starts the result of the next statement. The rows of a result
+	// returning rows are sent in the frames that follow.
+	Result *Response_Result `protobuf:"bytes,1,opt,name=result" json:"result,omitempty"`
+	// Last is set on the frame starting the result of the last statement.
+	Last bool `protobuf:"varint,2,opt,name=last" json:"last"`
+	// A chunk of rows of the current result.
+	Rows []Response_Result_Rows_Row `protobuf:"bytes,3,rep,name=rows" json:"rows"`
+	// Error is set if an error occurred after some of the rows of the current
+	// result were sent.
+	Error *string `protobuf:"bytes,4,opt,name=error" json:"error,omitempty"`
+	// Setting that should be reflected back in all subsequent requests, as in
+	// Response. Set on the last frame of the response only.
+	Session []byte `protobuf:"bytes,5,opt,name=session" json:"session,omitempty"`
+}
+
+func (m *ResponseFrame) Reset()         { *m = ResponseFrame{} }
+func (m *ResponseFrame) String() string { return proto.CompactTextString(m) }
+func (*ResponseFrame) ProtoMessage()    {}
+
 func init() {
 	proto.RegisterType((*Datum)(nil), "cockroach.sql.driver.Datum")
 	proto.RegisterType((*Datum_Timestamp)(nil), "cockroach.sql.driver.Datum.Timestamp")
@@ -496,6 +523,7 @@ func init() {
 	proto.RegisterType((*Response_Result_Rows)(nil), "cockroach.sql.driver.Response.Result.Rows")
 	proto.RegisterType((*Response_Result_Rows_Row)(nil), "cockroach.sql.driver.Response.Result.Rows.Row")
 	proto.RegisterType((*Response_Result_Rows_Column)(nil), "cockroach.sql.driver.Response.Result.Rows.Column")
+	proto.RegisterType((*ResponseFrame)(nil), "cockroach.sql.driver.ResponseFrame")
 }
 func (m *Datum) Marshal() (data []byte, err error) {
 	size := m.Size()
@@ -884,6 +912,66 @@ func (m *Response_Result_Rows_Column) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
+func (m *ResponseFrame) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ResponseFrame) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Result != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintWire(data, i, uint64(m.Result.Size()))
+		n7, err := m.Result.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	data[i] = 0x10
+	i++
+	if m.Last {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
+	if len(m.Rows) > 0 {
+		for _, msg := range m.Rows {
+			data[i] = 0x1a
+			i++
+			i = encodeVarintWire(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Error != nil {
+		data[i] = 0x22
+		i++
+		i = encodeVarintWire(data, i, uint64(len(*m.Error)))
+		i += copy(data[i:], *m.Error)
+	}
+	if m.Session != nil {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintWire(data, i, uint64(len(m.Session)))
+		i += copy(data[i:], m.Session)
+	}
+	return i, nil
+}
+
 func encodeFixed64Wire(data []byte, offset int, v uint64) int {
 	data[offset] = uint8(v)
 	data[offset+1] = uint8(v >> 8)
@@ -1102,6 +1190,31 @@ func (m *Response_Result_Rows_Column) Size() (n int) {
 	return n
 }
 
+func (m *ResponseFrame) Size() (n int) {
+	var l int
+	_ = l
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovWire(uint64(l))
+	}
+	n += 2
+	if len(m.Rows) > 0 {
+		for _, e := range m.Rows {
+			l = e.Size()
+			n += 1 + l + sovWire(uint64(l))
+		}
+	}
+	if m.Error != nil {
+		l = len(*m.Error)
+		n += 1 + l + sovWire(uint64(l))
+	}
+	if m.Session != nil {
+		l = len(m.Session)
+		n += 1 + l + sovWire(uint64(l))
+	}
+	return n
+}
+
 func sovWire(x uint64) (n int) {
 	for {
 		n++
@@ -2235,6 +2348,198 @@ func (m *Response_Result_Rows_Column) Unmarshal(data []byte) error {
 	}
 	return nil
 }
+func (m *ResponseFrame) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseFrame: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseFrame: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &Response_Result{}
+			}
+			if err := m.Result.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Last", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Last = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rows = append(m.Rows, Response_Result_Rows_Row{})
+			if err := m.Rows[len(m.Rows)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.Error = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Session = append([]byte{}, data[iNdEx:postIndex]...)
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipWire(data []byte) (n int, err error) {
 	l := len(data)
 	iNdEx := 0
@@ -19,6 +19,7 @@ package sql
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/config"
@@ -41,6 +42,9 @@ func (p *planner) DropDatabase(n *parser.DropDatabase) (planNode, error) {
 	if n.Name == "" {
 		return nil, errEmptyDatabaseName
 	}
+	if getVirtualSchema(string(n.Name)) != nil {
+		return nil, errVirtualReadOnly("database", string(n.Name))
+	}
 
 	nameKey := MakeNameMetadataKey(keys.RootNamespaceID, string(n.Name))
 	gr, err := p.txn.Get(nameKey)
@@ -147,6 +151,10 @@ func (p *planner) DropIndex(n *parser.DropIndex) (planNode, error) {
 				return &valuesNode{}, nil
 			}
 		}
+		mutationID, err := tableDesc.finalizeMutation()
+		if err != nil {
+			return nil, err
+		}
 		if err := tableDesc.Validate(); err != nil {
 			return nil, err
 		}
@@ -154,22 +162,18 @@ func (p *planner) DropIndex(n *parser.DropIndex) (planNode, error) {
 		if err := p.txn.Put(MakeDescMetadataKey(tableDesc.GetID()), wrapDescriptor(tableDesc)); err != nil {
 			return nil, err
 		}
-		// Process mutation synchronously.
-		if err := p.applyMutations(tableDesc, indexQualifiedName); err != nil {
-			return nil, err
-		}
+		p.notifySchemaChange(tableDesc.ID, mutationID)
 	}
 	return &valuesNode{}, nil
 }
 
-// DropTable drops a table.
+// DropTable drops a table. The data of the table is cleared asynchronously,
+// once the GC TTL of its zone has passed.
 // Privileges: DROP on table.
 //   Notes: postgres allows only the table owner to DROP a table.
 //          mysql requires the DROP privilege on the table.
 func (p *planner) DropTable(n *parser.DropTable) (planNode, error) {
-	// TODO(XisiHuang): should do truncate and delete descriptor in
-	// the same txn
-	for i, tableQualifiedName := range n.Names {
+	for _, tableQualifiedName := range n.Names {
 		if err := tableQualifiedName.NormalizeTableName(p.session.Database); err != nil {
 			return nil, err
 		}
@@ -178,6 +182,9 @@ func (p *planner) DropTable(n *parser.DropTable) (planNode, error) {
 		if err != nil {
 			return nil, err
 		}
+		if isVirtualDescriptor(dbDesc) {
+			return nil, errVirtualReadOnly("table", tableQualifiedName.Table())
+		}
 
 		tbKey := tableKey{dbDesc.ID, tableQualifiedName.Table()}
 		nameKey := tbKey.Key()
@@ -212,31 +219,58 @@ func (p *planner) DropTable(n *parser.DropTable) (planNode, error) {
 			return nil, err
 		}
 
-		if _, err := p.Truncate(&parser.Truncate{Tables: n.Names[i : i+1]}); err != nil {
+		if err := p.dropTable(tableDesc, nameKey); err != nil {
 			return nil, err
 		}
+	}
+	return &valuesNode{}, nil
+}
 
-		zoneKey := MakeZoneKey(tableDesc.ID)
+// dropTable marks the descriptor of a table as dropped and removes its
+// name. The schema changer clears the data of the table once the GC TTL of
+// its zone has passed, so that historical reads keep working until then,
+// and then deletes the descriptor and zone config of the table.
+func (p *planner) dropTable(tableDesc *TableDescriptor, nameKey roachpb.Key) error {
+	tableDesc.DropTime = p.evalCtx.TxnTimestamp.UnixNano()
+	tableDesc.UpVersion = true
+	if err := tableDesc.Validate(); err != nil {
+		return err
+	}
 
-		// Delete table descriptor
-		b := &client.Batch{}
-		b.Del(descKey)
+	descKey := MakeDescMetadataKey(tableDesc.ID)
+	descDesc := wrapDescriptor(tableDesc)
+	b := &client.Batch{}
+	b.Put(descKey, descDesc)
+	if nameKey != nil {
 		b.Del(nameKey)
-		// Delete the zone config entry for this table.
-		b.Del(zoneKey)
-
-		p.testingVerifyMetadata = func(systemConfig config.SystemConfig) error {
-			for _, key := range [...]roachpb.Key{descKey, nameKey, zoneKey} {
-				if err := expectDeleted(systemConfig, key); err != nil {
-					return err
-				}
+	}
+
+	p.testingVerifyMetadata = func(systemConfig config.SystemConfig) error {
+		if nameKey != nil {
+			if err := expectDeleted(systemConfig, nameKey); err != nil {
+				return err
 			}
-			return nil
 		}
+		return expectDescriptor(systemConfig, descKey, descDesc)
+	}
 
-		if err := p.txn.Run(b); err != nil {
-			return nil, err
-		}
+	if err := p.txn.Run(b); err != nil {
+		return err
 	}
-	return &valuesNode{}, nil
+	if p.droppedTables == nil {
+		p.droppedTables = make(map[ID]struct{})
+	}
+	p.droppedTables[tableDesc.ID] = struct{}{}
+	p.notifySchemaChange(tableDesc.ID, invalidMutationID)
+	return nil
+}
+
+// dropDeadline returns the time after which the data of a dropped table is
+// cleared.
+func dropDeadline(tableDesc *TableDescriptor, zone *config.ZoneConfig) time.Time {
+	policy := zone.GC
+	if policy == nil {
+		policy = config.DefaultZoneConfig.GC
+	}
+	return time.Unix(0, tableDesc.DropTime).Add(time.Duration(policy.TTLSeconds) * time.Second)
 }
@@ -24,11 +24,20 @@ import (
 	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/sql"
+	"github.com/cockroachdb/cockroach/testutils"
 	"github.com/cockroachdb/cockroach/util/encoding"
 	"github.com/cockroachdb/cockroach/util/leaktest"
 	"github.com/gogo/protobuf/proto"
 )
 
+// zeroTTLZoneConfig returns a copy of the default zone config with a zero
+// GC TTL.
+func zeroTTLZoneConfig() *config.ZoneConfig {
+	zone := *config.DefaultZoneConfig
+	zone.GC = &config.GCPolicy{TTLSeconds: 0}
+	return &zone
+}
+
 func TestDropDatabase(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	s, sqlDB, kvDB := setup(t)
@@ -71,8 +80,9 @@ INSERT INTO t.kv VALUES ('c', 'e'), ('a', 'c'), ('b', 'd');
 	}
 	tbDesc := desc.GetTable()
 
-	// Add a zone config for both the table and database.
-	buf, err := proto.Marshal(config.DefaultZoneConfig)
+	// Add a zone config for both the table and database. The zero GC TTL
+	// lets the data of the table be cleared right away.
+	buf, err := proto.Marshal(zeroTTLZoneConfig())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -252,8 +262,9 @@ INSERT INTO t.kv VALUES ('c', 'e'), ('a', 'c'), ('b', 'd');
 	}
 	tableDesc := desc.GetTable()
 
-	// Add a zone config for the table.
-	buf, err := proto.Marshal(config.DefaultZoneConfig)
+	// Add a zone config for the table. The zero GC TTL lets the data of the
+	// table be cleared right away.
+	buf, err := proto.Marshal(zeroTTLZoneConfig())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -308,3 +319,60 @@ INSERT INTO t.kv VALUES ('c', 'e'), ('a', 'c'), ('b', 'd');
 		t.Fatalf("zone config entry still exists after the table is dropped")
 	}
 }
+
+func TestDropTableDeferred(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, kvDB := setup(t)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k CHAR PRIMARY KEY, v CHAR);
+INSERT INTO t.kv VALUES ('c', 'e'), ('a', 'c'), ('b', 'd');
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	nameKey := sql.MakeNameMetadataKey(keys.MaxReservedDescID+1, "kv")
+	gr, err := kvDB.Get(nameKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !gr.Exists() {
+		t.Fatalf("Name entry %q does not exist", nameKey)
+	}
+	descKey := sql.MakeDescMetadataKey(sql.ID(gr.ValueInt()))
+
+	if _, err := sqlDB.Exec(`DROP TABLE t.kv`); err != nil {
+		t.Fatal(err)
+	}
+
+	// The name of the table is gone right away, but its data and descriptor
+	// are kept until the default GC TTL has passed.
+	if gr, err := kvDB.Get(nameKey); err != nil {
+		t.Fatal(err)
+	} else if gr.Exists() {
+		t.Fatalf("table namekey still exists after the table is dropped")
+	}
+
+	desc := &sql.Descriptor{}
+	if err := kvDB.GetProto(descKey, desc); err != nil {
+		t.Fatal(err)
+	}
+	tableDesc := desc.GetTable()
+	if tableDesc == nil || !tableDesc.Dropped() {
+		t.Fatalf("expected a dropped table descriptor, got %+v", desc)
+	}
+
+	tableStartKey := roachpb.Key(keys.MakeTablePrefix(uint32(tableDesc.ID)))
+	tableEndKey := tableStartKey.PrefixEnd()
+	if kvs, err := kvDB.Scan(tableStartKey, tableEndKey, 0); err != nil {
+		t.Fatal(err)
+	} else if l := 6; len(kvs) != l {
+		t.Fatalf("expected %d key value pairs, but got %d", l, len(kvs))
+	}
+
+	if _, err := sqlDB.Exec(`SELECT * FROM t.kv`); !testutils.IsError(err, `table "kv" does not exist`) {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
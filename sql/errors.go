@@ -43,6 +43,14 @@ func (e errUniquenessConstraintViolation) Error() string {
 		e.index.Name)
 }
 
+type errNotNullConstraintViolation struct {
+	column string
+}
+
+func (e errNotNullConstraintViolation) Error() string {
+	return fmt.Sprintf("null value in column %q violates not-null constraint", e.column)
+}
+
 func convertBatchError(tableDesc *TableDescriptor, b client.Batch, err error) error {
 	iErr, ok := err.(roachpb.IndexedError)
 	if !ok {
@@ -23,6 +23,7 @@ import (
 	"net/http"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
@@ -36,6 +37,8 @@ import (
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/hlc"
 	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/retry"
+	"github.com/cockroachdb/cockroach/util/stop"
 )
 
 var testingWaitForMetadata bool
@@ -53,6 +56,7 @@ var errNoTransactionInProgress = errors.New("there is no transaction in progress
 var errStaleMetadata = errors.New("metadata is still stale")
 var errTransactionAborted = errors.New("current transaction is aborted, commands ignored until end of transaction block")
 var errTransactionInProgress = errors.New("there is already a transaction in progress")
+var errDraining = errors.New("server is draining; no new transactions are accepted")
 
 var plannerPool = sync.Pool{
 	New: func() interface{} {
@@ -64,10 +68,14 @@ var plannerPool = sync.Pool{
 
 // An Executor executes SQL statements.
 type Executor struct {
-	db       client.DB
-	nodeID   uint32
-	reCache  *parser.RegexpCache
-	leaseMgr *LeaseManager
+	db              client.DB
+	nodeID          uint32
+	reCache         *parser.RegexpCache
+	leaseMgr        *LeaseManager
+	schemaChangeMgr *SchemaChangeManager
+	draining        int32 // Accessed atomically; non-zero while draining.
+	queries         queryRegistry
+	stmtStats       stmtStatsRegistry
 
 	// System Config and mutex.
 	systemConfig     config.SystemConfig
@@ -79,15 +87,25 @@ type Executor struct {
 // system config.
 func newExecutor(db client.DB, gossip *gossip.Gossip, clock *hlc.Clock) *Executor {
 	exec := &Executor{
-		db:       db,
-		reCache:  parser.NewRegexpCache(512),
-		leaseMgr: NewLeaseManager(0, db, clock),
+		db:        db,
+		reCache:   parser.NewRegexpCache(512),
+		leaseMgr:  NewLeaseManager(0, db, clock),
+		queries:   makeQueryRegistry(),
+		stmtStats: makeStmtStatsRegistry(),
 	}
+	exec.schemaChangeMgr = NewSchemaChangeManager(db, gossip, exec.leaseMgr)
 	exec.systemConfigCond = sync.NewCond(&exec.systemConfigMu)
 	gossip.RegisterSystemConfigCallback(exec.updateSystemConfig)
 	return exec
 }
 
+// Start starts the background processing of the Executor: the schema changes
+// that were not executed by the node that queued them are picked up. The node
+// ID must have been set.
+func (e *Executor) Start(stopper *stop.Stopper) {
+	e.schemaChangeMgr.Start(stopper)
+}
+
 // SetNodeID sets the node ID for the SQL server. This method must be called
 // before actually using the Executor.
 func (e *Executor) SetNodeID(nodeID roachpb.NodeID) {
@@ -95,12 +113,31 @@ func (e *Executor) SetNodeID(nodeID roachpb.NodeID) {
 	e.leaseMgr.nodeID = e.nodeID
 }
 
+// SetDraining puts the Executor into (or takes it out of) draining mode.
+// While draining, statements are only executed on behalf of sessions with
+// an open transaction; all other requests are refused with errDraining.
+func (e *Executor) SetDraining(drain bool) {
+	var v int32
+	if drain {
+		v = 1
+	}
+	atomic.StoreInt32(&e.draining, v)
+}
+
+// IsDraining returns true if the Executor is in draining mode.
+func (e *Executor) IsDraining() bool {
+	return atomic.LoadInt32(&e.draining) != 0
+}
+
 // updateSystemConfig is called whenever the system config gossip entry is updated.
 func (e *Executor) updateSystemConfig(cfg *config.SystemConfig) {
 	e.systemConfigMu.Lock()
 	e.systemConfig = *cfg
 	e.systemConfigCond.Broadcast()
 	e.systemConfigMu.Unlock()
+
+	// Release the leases on table descriptor versions that were superseded.
+	e.leaseMgr.refreshLeases(*cfg)
 }
 
 // getSystemConfig returns a pointer to the latest system config. May be nil,
@@ -115,6 +152,20 @@ func (e *Executor) getSystemConfig() config.SystemConfig {
 // Execute the statement(s) in the given request and return a response.
 // On error, the returned integer is an HTTP error code.
 func (e *Executor) Execute(args driver.Request) (driver.Response, int, error) {
+	var b responseBuilder
+	session, code, err := e.ExecuteStatements(args, &b)
+	if err != nil {
+		return args.CreateReply(), code, err
+	}
+	b.resp.Session = session
+	return b.resp, 0, nil
+}
+
+// ExecuteStatements executes the statement(s) in the given request, sending
+// their results to the ResultWriter as they are produced, and returns the
+// session state to reflect back in subsequent requests. On error, the
+// returned integer is an HTTP error code.
+func (e *Executor) ExecuteStatements(args driver.Request, w ResultWriter) ([]byte, int, error) {
 	planMaker := plannerPool.Get().(*planner)
 	defer plannerPool.Put(planMaker)
 
@@ -129,11 +180,17 @@ func (e *Executor) Execute(args driver.Request) (driver.Response, int, error) {
 		},
 		leaseMgr:     e.leaseMgr,
 		systemConfig: e.getSystemConfig(),
+		queries:      &e.queries,
+		stmtStats:    &e.stmtStats,
 	}
 
 	// Pick up current session state.
 	if err := proto.Unmarshal(args.Session, &planMaker.session); err != nil {
-		return args.CreateReply(), http.StatusBadRequest, err
+		return nil, http.StatusBadRequest, err
+	}
+	// Refuse new work while draining, but let open transactions finish.
+	if planMaker.session.Txn == nil && e.IsDraining() {
+		return nil, http.StatusServiceUnavailable, errDraining
 	}
 	// Resume a pending transaction if present.
 	if planMaker.session.Txn != nil {
@@ -148,7 +205,13 @@ func (e *Executor) Execute(args driver.Request) (driver.Response, int, error) {
 	// Send the Request for SQL execution and set the application-level error
 	// for each result in the reply.
 	planMaker.params = parameters(args.Params)
-	reply := e.execStmts(args.Sql, planMaker)
+	if err := e.execStmts(args.Sql, planMaker, w); err != nil {
+		// The results could not be sent: abandon the transaction.
+		if planMaker.txn != nil && planMaker.txn.Proto.Status == roachpb.PENDING {
+			planMaker.txn.Cleanup(err)
+		}
+		return nil, http.StatusInternalServerError, err
+	}
 
 	// Send back the session state even if there were application-level errors.
 	// Add transaction to session state.
@@ -161,46 +224,120 @@ func (e *Executor) Execute(args driver.Request) (driver.Response, int, error) {
 	}
 	bytes, err := proto.Marshal(&planMaker.session)
 	if err != nil {
-		return args.CreateReply(), http.StatusInternalServerError, err
+		return nil, http.StatusInternalServerError, err
 	}
-	reply.Session = bytes
+	return bytes, 0, nil
+}
+
+// CancelQueries cancels the statements sending their results to the
+// ResultWriter, returning the number of canceled statements.
+func (e *Executor) CancelQueries(w ResultWriter) int {
+	return e.queries.cancelOwner(w)
+}
 
-	return reply, 0, nil
+// StatementStatistics returns the statistics of the statements executed by
+// the node, ordered by fingerprint.
+func (e *Executor) StatementStatistics() []StatementStatistics {
+	return e.stmtStats.list()
 }
 
-// exec executes the request. Any error encountered is returned; it is
-// the caller's responsibility to update the response.
-func (e *Executor) execStmts(sql string, planMaker *planner) driver.Response {
-	var resp driver.Response
+// execStmts executes the statements, sending their results to the
+// ResultWriter. Errors encountered while executing the statements are
+// reported in their results; the returned error is the one returned by the
+// ResultWriter, if any.
+func (e *Executor) execStmts(sql string, planMaker *planner, w ResultWriter) error {
 	stmts, err := planMaker.parser.Parse(sql, parser.Syntax(planMaker.session.Syntax))
 	if err != nil {
 		// A parse error occurred: we can't determine if there were multiple
 		// statements or only one, so just pretend there was one.
-		resp.Results = append(resp.Results, makeResultFromError(planMaker, err))
-		return resp
+		buf := resultBuffer{w: w, last: true}
+		return buf.finish(planMaker, err)
 	}
-	for _, stmt := range stmts {
-		result, err := e.execStmt(stmt, planMaker)
-		if err != nil {
-			result = makeResultFromError(planMaker, err)
-		}
-		resp.Results = append(resp.Results, result)
+	for i, stmt := range stmts {
+		buf := resultBuffer{w: w, last: i == len(stmts)-1}
+		// The fingerprint is computed before the placeholders are replaced by
+		// the arguments.
+		fingerprint := parser.Fingerprint(stmt)
+		start := time.Now()
+		planMaker.activeQuery = e.queries.register(planMaker.user, stmt,
+			time.Duration(planMaker.session.StatementTimeout), w)
+		planMaker.userGroups, planMaker.userGroupsResolved = nil, false
+		err := e.execStmt(stmt, planMaker, &buf)
+		e.queries.unregister(planMaker.activeQuery)
+		planMaker.activeQuery = nil
 		// TODO(pmattis): Is this the correct time to be releasing leases acquired
 		// during execution of the statement?
 		//
 		// TODO(pmattis): Need to record the leases used by a transaction within
 		// the transaction state and restore it when the transaction is restored.
 		planMaker.releaseLeases(e.db)
+
+		// Drop the schema changes queued by a transaction that didn't commit.
+		if _, ok := stmt.(*parser.RollbackTransaction); ok || err != nil {
+			planMaker.schemaChangers = nil
+		}
+		if planMaker.txn == nil {
+			planMaker.droppedTables = nil
+		}
+		// Run the schema changes queued by the committed transaction. The
+		// statement that queued a schema change returns once the change is
+		// complete, or with the error that caused it to be reversed.
+		//
+		// TODO(vivek): Schema changes queued by a transaction that spans
+		// several requests are left to the SchemaChangeManager.
+		if planMaker.txn == nil && planMaker.schemaChangers != nil {
+			if scErr := e.runSchemaChangers(planMaker); scErr != nil && err == nil {
+				err = scErr
+			}
+		}
+		var retries int64
+		if buf.attempts > 1 {
+			retries = buf.attempts - 1
+		}
+		e.stmtStats.record(fingerprint, buf.rowCount, retries, time.Since(start), err)
+		if err := buf.finish(planMaker, err); err != nil {
+			return err
+		}
 	}
-	return resp
+	return nil
 }
 
-func (e *Executor) execStmt(stmt parser.Statement, planMaker *planner) (driver.Response_Result, error) {
-	var result driver.Response_Result
+// runSchemaChangers executes the schema changes queued by the planner,
+// returning the first error encountered.
+func (e *Executor) runSchemaChangers(planMaker *planner) error {
+	var firstErr error
+	for _, sc := range planMaker.schemaChangers {
+		sc.db = e.db
+		for r := retry.Start(schemaChangeRetryOptions); r.Next(); {
+			err := sc.Exec()
+			if err == errExistingSchemaChangeLease {
+				// Another node is executing a schema change on the table. Wait for
+				// it to finish; it will process our mutations as well if it gets to
+				// them first.
+				continue
+			}
+			if err == errTableDropDeferred {
+				// The data of the dropped table is cleared by the
+				// SchemaChangeManager once the GC TTL has passed.
+				err = nil
+			}
+			if err != nil && firstErr == nil {
+				firstErr = err
+			}
+			break
+		}
+	}
+	planMaker.schemaChangers = nil
+	return firstErr
+}
+
+// execStmt executes the statement, accumulating its result in the
+// resultBuffer.
+func (e *Executor) execStmt(stmt parser.Statement, planMaker *planner, buf *resultBuffer) error {
 	switch stmt.(type) {
 	case *parser.BeginTransaction:
 		if planMaker.txn != nil {
-			return result, errTransactionInProgress
+			return errTransactionInProgress
 		}
 		// Start a transaction here and not in planMaker to prevent begin
 		// transaction from being called within an auto-transaction below.
@@ -208,25 +345,25 @@ func (e *Executor) execStmt(stmt parser.Statement, planMaker *planner) (driver.R
 		planMaker.txn.SetDebugName("sql", 0)
 	case *parser.CommitTransaction, *parser.RollbackTransaction:
 		if planMaker.txn == nil {
-			return result, errNoTransactionInProgress
+			return errNoTransactionInProgress
 		} else if planMaker.txn.Proto.Status == roachpb.ABORTED {
 			// Reset to allow starting a new transaction.
 			planMaker.resetTxn()
-			return result, nil
+			return nil
 		}
 	case *parser.SetTransaction:
 		if planMaker.txn == nil {
-			return result, errNoTransactionInProgress
+			return errNoTransactionInProgress
 		}
 	default:
 		if planMaker.txn != nil && planMaker.txn.Proto.Status == roachpb.ABORTED {
-			return result, errTransactionAborted
+			return errTransactionAborted
 		}
 	}
 
 	// Bind all the placeholder variables in the stmt to actual values.
 	if err := parser.FillArgs(stmt, &planMaker.params); err != nil {
-		return result, err
+		return err
 	}
 
 	// Create a function which both makes and executes the plan, populating
@@ -236,6 +373,17 @@ func (e *Executor) execStmt(stmt parser.Statement, planMaker *planner) (driver.R
 	// some of the common code back out into execStmts and have execStmt contain
 	// only the body of this closure.
 	f := func(timestamp time.Time) error {
+		// Don't retry a statement which was canceled or timed out.
+		if buf.attempts > 0 {
+			if err := planMaker.checkQuery(); err != nil {
+				return err
+			}
+		}
+		// Start over if the statement is retried, unless some of its rows
+		// were already sent.
+		if err := buf.reset(); err != nil {
+			return err
+		}
 		planMaker.evalCtx.StmtTimestamp = parser.DTimestamp{Time: timestamp}
 		plan, err := planMaker.makePlan(stmt)
 		if err != nil {
@@ -244,13 +392,17 @@ func (e *Executor) execStmt(stmt parser.Statement, planMaker *planner) (driver.R
 
 		switch stmt.StatementType() {
 		case parser.DDL:
-			result.Union = &driver.Response_Result_DDL_{DDL: &driver.Response_Result_DDL{}}
+			buf.result.Union = &driver.Response_Result_DDL_{DDL: &driver.Response_Result_DDL{}}
 		case parser.RowsAffected:
 			resultRowsAffected := driver.Response_Result_RowsAffected{}
-			result.Union = &resultRowsAffected
 			for plan.Next() {
+				if err := planMaker.checkQuery(); err != nil {
+					return err
+				}
 				resultRowsAffected.RowsAffected++
 			}
+			buf.result.Union = &resultRowsAffected
+			buf.rowCount = int64(resultRowsAffected.RowsAffected)
 
 		case parser.Rows:
 			var resultRows driver.Response_Result_Rows
@@ -266,10 +418,13 @@ func (e *Executor) execStmt(stmt parser.Statement, planMaker *planner) (driver.R
 				})
 			}
 
-			result.Union = &driver.Response_Result_Rows_{
+			buf.result.Union = &driver.Response_Result_Rows_{
 				Rows: &resultRows,
 			}
 			for plan.Next() {
+				if err := planMaker.checkQuery(); err != nil {
+					return err
+				}
 				values := plan.Values()
 				row := driver.Response_Result_Rows_Row{Values: make([]driver.Datum, 0, len(values))}
 				for _, val := range values {
@@ -279,17 +434,47 @@ func (e *Executor) execStmt(stmt parser.Statement, planMaker *planner) (driver.R
 					}
 					row.Values = append(row.Values, datum)
 				}
-				resultRows.Rows = append(resultRows.Rows, row)
+				if err := buf.addRow(row); err != nil {
+					return err
+				}
 			}
 		}
 
 		return plan.Err()
 	}
 
+	if asOf := asOfClause(stmt); asOf != nil {
+		if planMaker.txn != nil {
+			if _, ok := stmt.(*parser.Backup); ok {
+				return errBackupInTransaction
+			}
+			return errAsOfInTransaction
+		}
+		ts, err := planMaker.evalAsOf(asOf, time.Now())
+		if err != nil {
+			return err
+		}
+		// Run the historical read in a read-only auto-transaction at the
+		// requested timestamp.
+		return e.db.Txn(func(txn *client.Txn) error {
+			if err := txn.SetFixedTimestamp(ts); err != nil {
+				return err
+			}
+			planMaker.setTxn(txn, ts.GoTime())
+			planMaker.asOfSystemTime = ts
+			err := f(ts.GoTime())
+			planMaker.asOfSystemTime = roachpb.ZeroTimestamp
+			planMaker.resetTxn()
+			return err
+		})
+	}
+
 	// If there is a pending transaction.
 	if planMaker.txn != nil {
-		err := f(time.Now())
-		return result, err
+		if _, ok := stmt.(*parser.Import); ok {
+			return errImportInTransaction
+		}
+		return f(time.Now())
 	}
 
 	if testingWaitForMetadata {
@@ -338,20 +523,18 @@ func (e *Executor) execStmt(stmt parser.Statement, planMaker *planner) (driver.R
 		}
 	}
 
-	return result, err
+	return err
 }
 
 // If we hit an error and there is a pending transaction, rollback
 // the transaction before returning. The client does not have to
 // deal with cleaning up transaction state.
-func makeResultFromError(planMaker *planner, err error) driver.Response_Result {
+func cleanupTxnOnError(planMaker *planner, err error) {
 	if planMaker.txn != nil {
 		if err != errTransactionAborted {
 			planMaker.txn.Cleanup(err)
 		}
 	}
-	errString := err.Error()
-	return driver.Response_Result{Error: &errString}
 }
 
 var _ parser.Args = parameters{}
@@ -20,8 +20,11 @@ package sql
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/sql/privilege"
 	"github.com/cockroachdb/cockroach/util"
 )
 
@@ -31,6 +34,7 @@ const (
 	explainNone explainMode = iota
 	explainDebug
 	explainPlan
+	explainAnalyze
 )
 
 // Explain executes the explain statement, providing debugging and analysis
@@ -41,12 +45,17 @@ func (p *planner) Explain(n *parser.Explain) (planNode, error) {
 	mode := explainNone
 	if len(n.Options) == 1 && strings.EqualFold(n.Options[0], "DEBUG") {
 		mode = explainDebug
+	} else if len(n.Options) == 1 && strings.EqualFold(n.Options[0], "ANALYZE") {
+		mode = explainAnalyze
 	} else if len(n.Options) == 0 {
 		mode = explainPlan
 	}
 	if mode == explainNone {
 		return nil, fmt.Errorf("unsupported EXPLAIN options: %s", n)
 	}
+	if mode == explainAnalyze {
+		return p.explainAnalyze(n.Statement)
+	}
 
 	plan, err := p.makePlan(n.Statement)
 	if err != nil {
@@ -77,6 +86,12 @@ func (p *planner) Explain(n *parser.Explain) (planNode, error) {
 func markDebug(plan planNode, mode explainMode) (planNode, error) {
 	switch t := plan.(type) {
 	case *scanNode:
+		// The debug output contains the keys and values of all the columns,
+		// which requires SELECT on the whole table.
+		if t.columnPrivileges {
+			return nil, fmt.Errorf("user %s does not have %s privilege on table %s",
+				t.planner.user, privilege.SELECT, t.desc.Name)
+		}
 		// Mark the node as being explained.
 		t.columns = []column{
 			{name: "RowIdx", typ: parser.DummyInt},
@@ -112,3 +127,184 @@ func populateExplain(v *valuesNode, plan planNode, level int) {
 		populateExplain(v, child, level+1)
 	}
 }
+
+// explainAnalyze executes the statement, returning its plan annotated with
+// the statistics collected during the execution. Each node reports the rows
+// it produced, the time spent producing them and the KV batches issued and
+// bytes read by the node and its children. Scans also report their spans,
+// and the selectivity of their constraints estimated by selectIndex next to
+// the actual ratio of the rows they returned to the rows they scanned. A
+// final "total" row covers planning, which for INSERT, UPDATE and DELETE
+// includes writing the rows and is the only row reporting the bytes written.
+func (p *planner) explainAnalyze(stmt parser.Statement) (planNode, error) {
+	kvStart := p.kvStats
+	start := time.Now()
+	plan, err := p.makePlan(stmt)
+	if err != nil {
+		return nil, err
+	}
+	plan = markAnalyze(plan)
+	var rows int64
+	for plan.Next() {
+		rows++
+	}
+	if err := plan.Err(); err != nil {
+		return nil, err
+	}
+	elapsed := time.Since(start)
+
+	v := &valuesNode{}
+	v.columns = []column{
+		{name: "Level", typ: parser.DummyInt},
+		{name: "Type", typ: parser.DummyString},
+		{name: "Description", typ: parser.DummyString},
+		{name: "Spans", typ: parser.DummyString},
+		{name: "Rows", typ: parser.DummyInt},
+		{name: "Estimated Selectivity", typ: parser.DummyFloat},
+		{name: "Selectivity", typ: parser.DummyFloat},
+		{name: "Time", typ: parser.DummyInterval},
+		{name: "KV Batches", typ: parser.DummyInt},
+		{name: "KV Bytes Read", typ: parser.DummyInt},
+		{name: "KV Bytes Written", typ: parser.DummyInt},
+	}
+	populateAnalyze(v, plan, 0)
+	v.rows = append(v.rows, parser.DTuple{
+		parser.DInt(0),
+		parser.DString("total"),
+		parser.DString(""),
+		parser.DNull,
+		parser.DInt(rows),
+		parser.DNull,
+		parser.DNull,
+		parser.DInterval{Duration: elapsed},
+		parser.DInt(p.kvStats.kvBatches - kvStart.kvBatches),
+		parser.DInt(p.kvStats.kvBytes - kvStart.kvBytes),
+		parser.DInt(p.kvStats.kvWriteBytes - kvStart.kvWriteBytes),
+	})
+	return v, nil
+}
+
+// planStats are the execution statistics of a planNode reported by EXPLAIN
+// ANALYZE.
+type planStats struct {
+	rows         int64         // The number of rows produced.
+	rowsScanned  int64         // The number of rows scanned, before filtering.
+	elapsed      time.Duration // The time spent producing the rows.
+	kvBatches    int64         // The number of KV batches issued.
+	kvBytes      int64         // The number of bytes read.
+	kvWriteBytes int64         // The number of bytes written.
+}
+
+// addKV adds the KV statistics of other to s.
+func (s *planStats) addKV(other planStats) {
+	s.kvBatches += other.kvBatches
+	s.kvBytes += other.kvBytes
+	s.kvWriteBytes += other.kvWriteBytes
+}
+
+// writeStats returns the KV statistics of a batch of writes which was run.
+func writeStats(b *client.Batch) planStats {
+	stats := planStats{kvBatches: 1}
+	for _, result := range b.Results {
+		for _, kv := range result.Rows {
+			stats.kvWriteBytes += int64(len(kv.Key))
+			if kv.Value != nil {
+				stats.kvWriteBytes += int64(len(kv.Value.RawBytes))
+			}
+		}
+	}
+	return stats
+}
+
+// analyzeNode wraps a planNode, collecting the number of rows it produces
+// and the time spent producing them.
+type analyzeNode struct {
+	planNode
+	stats planStats
+}
+
+func (n *analyzeNode) Next() bool {
+	start := time.Now()
+	ok := n.planNode.Next()
+	n.stats.elapsed += time.Since(start)
+	if ok {
+		n.stats.rows++
+	}
+	return ok
+}
+
+// markAnalyze instruments the plan for EXPLAIN ANALYZE. Scan nodes collect
+// their own statistics; the other nodes are wrapped in an analyzeNode.
+func markAnalyze(plan planNode) planNode {
+	switch t := plan.(type) {
+	case *scanNode:
+		t.explain = explainAnalyze
+		return t
+
+	case *indexJoinNode:
+		markAnalyze(t.index)
+		markAnalyze(t.table)
+
+	case *sortNode:
+		t.plan = markAnalyze(t.plan)
+
+	case *groupNode:
+		t.plan = markAnalyze(t.plan)
+
+	case *limitNode:
+		t.planNode = markAnalyze(t.planNode)
+
+	case *distinctNode:
+		t.planNode = markAnalyze(t.planNode)
+	}
+	return &analyzeNode{planNode: plan}
+}
+
+// populateAnalyze adds the rows describing the plan and its statistics to v,
+// returning the KV statistics of the plan.
+func populateAnalyze(v *valuesNode, plan planNode, level int) planStats {
+	name, description, children := plan.ExplainPlan()
+	var stats planStats
+	var spans, estSelectivity, selectivity parser.Datum = parser.DNull, parser.DNull, parser.DNull
+	switch t := plan.(type) {
+	case *analyzeNode:
+		stats = t.stats
+	case *scanNode:
+		stats = t.stats
+		if len(t.constraints) > 0 {
+			description = fmt.Sprintf("%s constraints: %s", description, t.constraints)
+		}
+		if len(t.spans) > 0 {
+			spans = parser.DString(prettySpans(t.spans, 2))
+		}
+		if t.estSelectivity > 0 {
+			estSelectivity = parser.DFloat(t.estSelectivity)
+		}
+		if stats.rowsScanned > 0 {
+			selectivity = parser.DFloat(float64(stats.rows) / float64(stats.rowsScanned))
+		}
+	}
+
+	row := len(v.rows)
+	v.rows = append(v.rows, nil)
+	for _, child := range children {
+		childStats := populateAnalyze(v, child, level+1)
+		if _, ok := plan.(*scanNode); !ok {
+			stats.addKV(childStats)
+		}
+	}
+	v.rows[row] = parser.DTuple{
+		parser.DInt(level),
+		parser.DString(name),
+		parser.DString(description),
+		spans,
+		parser.DInt(stats.rows),
+		estSelectivity,
+		selectivity,
+		parser.DInterval{Duration: stats.elapsed},
+		parser.DInt(stats.kvBatches),
+		parser.DInt(stats.kvBytes),
+		parser.DInt(stats.kvWriteBytes),
+	}
+	return stats
+}
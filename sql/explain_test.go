@@ -0,0 +1,136 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql_test
+
+import (
+	csql "database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+type analyzeRow struct {
+	level          int
+	typ            string
+	description    string
+	spans          csql.NullString
+	rows           int
+	estSelectivity csql.NullFloat64
+	selectivity    csql.NullFloat64
+	time           time.Duration
+	kvBatches      int
+	kvBytes        int
+	kvWritten      int
+}
+
+func explainAnalyze(t *testing.T, sqlDB *csql.DB, stmt string) []analyzeRow {
+	rows, err := sqlDB.Query(`EXPLAIN ANALYZE ` + stmt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	var result []analyzeRow
+	for rows.Next() {
+		var r analyzeRow
+		if err := rows.Scan(&r.level, &r.typ, &r.description, &r.spans, &r.rows,
+			&r.estSelectivity, &r.selectivity, &r.time, &r.kvBatches, &r.kvBytes,
+			&r.kvWritten); err != nil {
+			t.Fatal(err)
+		}
+		result = append(result, r)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return result
+}
+
+func TestExplainAnalyze(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, _ := setup(t)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k INT PRIMARY KEY, v INT);
+INSERT INTO t.kv VALUES (0, 0), (1, 1), (2, 2), (3, 3), (4, 4), (5, 5), (6, 6), (7, 7), (8, 8), (9, 9);
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	result := explainAnalyze(t, sqlDB, `SELECT k, v FROM t.kv WHERE k >= 8`)
+	if len(result) < 2 {
+		t.Fatalf("expected at least 2 rows, but found %+v", result)
+	}
+	var scan *analyzeRow
+	for i := range result {
+		if result[i].typ == "scan" {
+			scan = &result[i]
+		}
+	}
+	if scan == nil {
+		t.Fatalf("expected a scan, but found %+v", result)
+	}
+	if scan.rows != 2 || scan.kvBatches != 1 || scan.kvBytes == 0 {
+		t.Errorf("unexpected scan statistics %+v", *scan)
+	}
+	if e := "constraints: [k >= 8]"; !strings.Contains(scan.description, e) {
+		t.Errorf("expected description containing %q, but found %q", e, scan.description)
+	}
+	if e := "/8-"; !scan.spans.Valid || !strings.HasPrefix(scan.spans.String, e) {
+		t.Errorf("expected spans starting with %q, but found %+v", e, scan.spans)
+	}
+	if !scan.estSelectivity.Valid || scan.estSelectivity.Float64 >= 1 ||
+		!scan.selectivity.Valid || scan.selectivity.Float64 != 1 {
+		t.Errorf("unexpected scan selectivity %+v", *scan)
+	}
+	if total := result[len(result)-1]; total.typ != "total" || total.rows != 2 ||
+		total.kvBatches != 1 || total.kvWritten != 0 || total.spans.Valid || total.selectivity.Valid {
+		t.Errorf("unexpected total %+v", total)
+	}
+
+	// The filter which isn't a constraint of the index is applied to the rows
+	// scanned.
+	result = explainAnalyze(t, sqlDB, `SELECT k, v FROM t.kv WHERE k >= 6 AND v < 7`)
+	for i := range result {
+		if r := result[i]; r.typ == "scan" && (r.rows != 1 || r.selectivity.Float64 != 0.25) {
+			t.Errorf("expected 1 of 4 rows scanned to be returned, but found %+v", r)
+		}
+	}
+
+	// EXPLAIN ANALYZE executes the statement, and counts the writes.
+	result = explainAnalyze(t, sqlDB, `DELETE FROM t.kv WHERE k < 3`)
+	if total := result[len(result)-1]; total.typ != "total" || total.kvBatches != 2 {
+		t.Errorf("unexpected total %+v", total)
+	}
+	result = explainAnalyze(t, sqlDB, `INSERT INTO t.kv VALUES (10, 10)`)
+	if total := result[len(result)-1]; total.typ != "total" || total.kvBatches != 1 || total.kvWritten == 0 {
+		t.Errorf("unexpected total %+v", total)
+	}
+	var count int
+	if err := sqlDB.QueryRow(`SELECT COUNT(*) FROM t.kv`).Scan(&count); err != nil {
+		t.Fatal(err)
+	} else if count != 8 {
+		t.Fatalf("expected 8 rows, but found %d", count)
+	}
+
+	if _, err := sqlDB.Exec(`EXPLAIN (ANALYZE, DEBUG) SELECT 1`); err == nil ||
+		!strings.Contains(err.Error(), "unsupported EXPLAIN options") {
+		t.Fatalf("expected unsupported options error, but found %v", err)
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/cockroachdb/cockroach/security"
+)
+
+// ExportStorage stores the files of backups, and the files read by
+// imports. The storage is specified by a URI: a URI without a scheme, or
+// with the "file" or "nodelocal" scheme, is a directory on the node
+// executing the statement. Other storages are made available with
+// RegisterExportStorage.
+type ExportStorage interface {
+	// WriteFile writes a file, replacing it if it exists.
+	WriteFile(name string, content []byte) error
+	// ReadFile returns the content of a file.
+	ReadFile(name string) ([]byte, error)
+	// OpenFile returns a reader of the content of a file, which the caller
+	// has to close.
+	OpenFile(name string) (io.ReadCloser, error)
+	// Close releases the resources of the storage.
+	Close() error
+}
+
+// ExportStorageFactory returns the ExportStorage of a URI.
+type ExportStorageFactory func(uri *url.URL) (ExportStorage, error)
+
+// localStorageSchemes are the schemes of the URIs of local directories.
+var localStorageSchemes = map[string]struct{}{"": {}, "file": {}, "nodelocal": {}}
+
+var exportStorages = struct {
+	sync.Mutex
+	factories map[string]ExportStorageFactory
+}{
+	factories: map[string]ExportStorageFactory{
+		"":          makeLocalStorage,
+		"file":      makeLocalStorage,
+		"nodelocal": makeLocalStorage,
+	},
+}
+
+// RegisterExportStorage registers the factory of the storages of the URIs
+// with the specified scheme.
+func RegisterExportStorage(scheme string, factory ExportStorageFactory) {
+	exportStorages.Lock()
+	defer exportStorages.Unlock()
+	exportStorages.factories[scheme] = factory
+}
+
+// MakeExportStorage returns the ExportStorage of a URI.
+func MakeExportStorage(uri string) (ExportStorage, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, err
+	}
+	exportStorages.Lock()
+	factory, ok := exportStorages.factories[u.Scheme]
+	exportStorages.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("unsupported storage scheme: %q", u.Scheme)
+	}
+	return factory(u)
+}
+
+// checkExportStorage returns an error if the user isn't allowed to use the
+// storage of a URI. A local directory can be anywhere in the filesystem of
+// the node, so only root is allowed to use one.
+func (p *planner) checkExportStorage(op, uri string) error {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return err
+	}
+	if _, ok := localStorageSchemes[u.Scheme]; ok && p.user != security.RootUser {
+		return fmt.Errorf("%s: only %s is allowed to use local storage %q", op, security.RootUser, uri)
+	}
+	return nil
+}
+
+// makeExportStorage returns the ExportStorage of a URI used by a statement.
+func (p *planner) makeExportStorage(op, uri string) (ExportStorage, error) {
+	if err := p.checkExportStorage(op, uri); err != nil {
+		return nil, err
+	}
+	return MakeExportStorage(uri)
+}
+
+// localStorage stores files in a local directory.
+type localStorage struct {
+	dir string
+}
+
+func makeLocalStorage(uri *url.URL) (ExportStorage, error) {
+	dir := uri.Path
+	if uri.Host != "" {
+		// A relative "nodelocal://dir" URI.
+		dir = filepath.Join(uri.Host, dir)
+	}
+	if dir == "" {
+		return nil, fmt.Errorf("no directory specified in %q", uri)
+	}
+	return &localStorage{dir: dir}, nil
+}
+
+func (l *localStorage) WriteFile(name string, content []byte) error {
+	if err := os.MkdirAll(l.dir, 0755); err != nil {
+		return err
+	}
+	// Write a temporary file first, so that a failure doesn't leave a
+	// truncated file behind.
+	path := filepath.Join(l.dir, name)
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, content, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func (l *localStorage) ReadFile(name string) ([]byte, error) {
+	return ioutil.ReadFile(filepath.Join(l.dir, name))
+}
+
+func (l *localStorage) OpenFile(name string) (io.ReadCloser, error) {
+	return os.Open(filepath.Join(l.dir, name))
+}
+
+func (l *localStorage) Close() error {
+	return nil
+}
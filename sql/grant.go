@@ -18,49 +18,114 @@
 package sql
 
 import (
+	"fmt"
+
 	"github.com/cockroachdb/cockroach/sql/parser"
 	"github.com/cockroachdb/cockroach/sql/privilege"
 )
 
-func (p *planner) changePrivileges(targets parser.TargetList, grantees parser.NameList, changePrivilege func(*PrivilegeDescriptor, string)) (planNode, error) {
-	descriptor, err := p.getDescriptorFromTargetList(targets)
+func (p *planner) changePrivileges(
+	targets parser.TargetList,
+	privileges privilege.List,
+	columnPrivileges parser.ColumnPrivileges,
+	grantees parser.NameList,
+	changePrivilege func(*PrivilegeDescriptor, string, privilege.List),
+) (planNode, error) {
+	descriptors, err := p.getDescriptorsFromTargetList(targets)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := p.checkPrivilege(descriptor, privilege.GRANT); err != nil {
-		return nil, err
-	}
+	// All the descriptors are changed in the same transaction, so the
+	// privileges are changed on either all of the targets or none of them.
+	for _, descriptor := range descriptors {
+		if err := p.checkPrivilege(descriptor, privilege.GRANT); err != nil {
+			return nil, err
+		}
 
-	privileges := descriptor.GetPrivileges()
-	for _, grantee := range grantees {
-		changePrivilege(privileges, grantee)
-	}
+		if len(privileges) > 0 {
+			privDesc := descriptor.GetPrivileges()
+			for _, grantee := range grantees {
+				changePrivilege(privDesc, grantee, privileges)
+			}
+		}
+		if len(columnPrivileges) > 0 {
+			if err := changeColumnPrivileges(descriptor, columnPrivileges, grantees, changePrivilege); err != nil {
+				return nil, err
+			}
+		}
 
-	if err := descriptor.Validate(); err != nil {
-		return nil, err
-	}
+		if err := descriptor.Validate(); err != nil {
+			return nil, err
+		}
 
-	if tableDesc, ok := descriptor.(*TableDescriptor); ok {
-		// TODO(pmattis): This is a hack. Remove when schema change operations work
-		// properly.
-		p.hackNoteSchemaChange(tableDesc)
-	}
+		if tableDesc, ok := descriptor.(*TableDescriptor); ok {
+			// TODO(pmattis): This is a hack. Remove when schema change operations work
+			// properly.
+			p.hackNoteSchemaChange(tableDesc)
+		}
 
-	// Now update the descriptor.
-	// TODO(marc): do this inside a transaction. This will be needed
-	// when modifying multiple descriptors in the same op.
-	descKey := MakeDescMetadataKey(descriptor.GetID())
-	if err := p.txn.Put(descKey, wrapDescriptor(descriptor)); err != nil {
-		return nil, err
+		// Now update the descriptor.
+		descKey := MakeDescMetadataKey(descriptor.GetID())
+		if err := p.txn.Put(descKey, wrapDescriptor(descriptor)); err != nil {
+			return nil, err
+		}
 	}
 
 	return &valuesNode{}, nil
 }
 
+// changeColumnPrivileges changes the privileges on columns of a table.
+// Privileges on columns are kept in a privilege descriptor of their own,
+// which only exists while some privileges are granted on the column.
+func changeColumnPrivileges(
+	descriptor descriptorProto,
+	columnPrivileges parser.ColumnPrivileges,
+	grantees parser.NameList,
+	changePrivilege func(*PrivilegeDescriptor, string, privilege.List),
+) error {
+	tableDesc, ok := descriptor.(*TableDescriptor)
+	if !ok {
+		return fmt.Errorf("column privileges do not apply to %s %q",
+			descriptor.TypeName(), descriptor.GetName())
+	}
+	if IsSystemID(tableDesc.ID) {
+		return fmt.Errorf("column privileges do not apply to system table %q", tableDesc.Name)
+	}
+	for _, c := range columnPrivileges {
+		switch c.Privilege {
+		case privilege.SELECT, privilege.INSERT, privilege.UPDATE:
+		default:
+			return fmt.Errorf("%s privilege does not apply to columns", c.Privilege)
+		}
+		for _, name := range c.Columns {
+			status, i, err := tableDesc.FindColumnByName(name)
+			if err != nil {
+				return err
+			}
+			if status != DescriptorActive {
+				return fmt.Errorf("column %q in the middle of being added, try again later", name)
+			}
+			col := &tableDesc.Columns[i]
+			if col.Privileges == nil {
+				col.Privileges = &PrivilegeDescriptor{}
+			}
+			for _, grantee := range grantees {
+				changePrivilege(col.Privileges, grantee, privilege.List{c.Privilege})
+			}
+			if len(col.Privileges.Users) == 0 {
+				col.Privileges = nil
+			}
+		}
+	}
+	return nil
+}
+
 // Grant adds privileges to users.
 // Current status:
-// - Target: single database or table.
+// - Target: databases, tables, all the tables of databases ("db.*"), or
+//   columns of tables (SELECT, INSERT and UPDATE only).
+// - Grantee: users or groups.
 // TODO(marc): open questions:
 // - should we have root always allowed and not present in the permissions list?
 // - should we make users case-insensitive?
@@ -68,14 +133,17 @@ func (p *planner) changePrivileges(targets parser.TargetList, grantees parser.Na
 //   Notes: postgres requires the object owner.
 //          mysql requires the "grant option" and the same privileges, and sometimes superuser.
 func (p *planner) Grant(n *parser.Grant) (planNode, error) {
-	return p.changePrivileges(n.Targets, n.Grantees, func(privDesc *PrivilegeDescriptor, grantee string) {
-		privDesc.Grant(grantee, n.Privileges)
-	})
+	return p.changePrivileges(n.Targets, n.Privileges, n.ColumnPrivileges, n.Grantees,
+		func(privDesc *PrivilegeDescriptor, grantee string, privs privilege.List) {
+			privDesc.Grant(grantee, privs)
+		})
 }
 
 // Revoke removes privileges from users.
 // Current status:
-// - Target: single database or table.
+// - Target: databases, tables, all the tables of databases ("db.*"), or
+//   columns of tables (SELECT, INSERT and UPDATE only).
+// - Grantee: users or groups.
 // TODO(marc): open questions:
 // - should we have root always allowed and not present in the permissions list?
 // - should we make users case-insensitive?
@@ -83,7 +151,8 @@ func (p *planner) Grant(n *parser.Grant) (planNode, error) {
 //   Notes: postgres requires the object owner.
 //          mysql requires the "grant option" and the same privileges, and sometimes superuser.
 func (p *planner) Revoke(n *parser.Revoke) (planNode, error) {
-	return p.changePrivileges(n.Targets, n.Grantees, func(privDesc *PrivilegeDescriptor, grantee string) {
-		privDesc.Revoke(grantee, n.Privileges)
-	})
+	return p.changePrivileges(n.Targets, n.Privileges, n.ColumnPrivileges, n.Grantees,
+		func(privDesc *PrivilegeDescriptor, grantee string, privs privilege.List) {
+			privDesc.Revoke(grantee, privs)
+		})
 }
@@ -0,0 +1,723 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"bufio"
+	"bytes"
+	"container/heap"
+	"encoding/binary"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/sql/privilege"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+// importBatchSize is the number of key/values written by each of the
+// batches ingesting the data of an imported table.
+const importBatchSize = 10000
+
+// importSortSize is the maximum number of key/values of an imported table
+// sorted in memory. The key/values of a larger table are sorted in runs of
+// this size spilled to temporary files, which are then merged.
+var importSortSize = 1000000
+
+// TestingSetImportSortSize sets the maximum number of key/values of an
+// imported table sorted in memory and returns a function restoring the
+// default.
+func TestingSetImportSortSize(size int) func() {
+	saved := importSortSize
+	importSortSize = size
+	return func() {
+		importSortSize = saved
+	}
+}
+
+var errImportInTransaction = errors.New("IMPORT is not supported within a transaction")
+
+// csvOptions are the options of an IMPORT statement controlling how its
+// CSV files are parsed.
+type csvOptions struct {
+	delimiter rune
+	comment   rune
+	// Fields equal to nullif are NULL. Without nullif, no field is NULL.
+	nullif *string
+	// The number of records skipped at the start of each file, such as
+	// headers.
+	skip int
+	// Allow quotes in unquoted fields, and non-doubled quotes in quoted
+	// fields.
+	lazyQuotes bool
+}
+
+// Import creates a table and loads its rows from CSV files. The rows are
+// encoded into the key/values of the table, which are sorted and then
+// ingested in large non-transactional batches. The table can't be used
+// until the import completes. The progress of the import is persisted in
+// the table descriptor after every batch, so that running the same
+// statement again resumes an interrupted import; SHOW IMPORTS lists it.
+// Privileges: CREATE on database; root to import from a local directory.
+func (p *planner) Import(n *parser.Import) (planNode, error) {
+	if err := n.Table.NormalizeTableName(p.session.Database); err != nil {
+		return nil, err
+	}
+	dbDesc, err := p.getDatabaseDesc(n.Table.Database())
+	if err != nil {
+		return nil, err
+	}
+	if isVirtualDescriptor(dbDesc) {
+		return nil, errVirtualReadOnly("table", n.Table.Table())
+	}
+	if err := p.checkPrivilege(dbDesc, privilege.CREATE); err != nil {
+		return nil, err
+	}
+
+	// The statement recorded in the table descriptor has its values
+	// evaluated, so that it matches when resumed with other placeholders.
+	evaluated := *n
+	evaluated.Files = make(parser.Exprs, len(n.Files))
+	uris := make([]string, len(n.Files))
+	for i, file := range n.Files {
+		if uris[i], err = p.getStringVal("IMPORT", parser.Exprs{file}); err != nil {
+			return nil, err
+		}
+		if err := p.checkExportStorage("IMPORT", uris[i]); err != nil {
+			return nil, err
+		}
+		evaluated.Files[i] = parser.DString(uris[i])
+	}
+	var opts csvOptions
+	if opts, evaluated.Options, err = p.evalCSVOptions(n.Options); err != nil {
+		return nil, err
+	}
+
+	tableDesc, err := p.startImport(&evaluated, dbDesc)
+	if err != nil {
+		return nil, err
+	}
+	var sorter importSorter
+	defer sorter.close()
+	if err := p.encodeImportFiles(&sorter, tableDesc, uris, opts); err != nil {
+		return nil, err
+	}
+	if err := ingestImport(&p.leaseMgr.db, tableDesc, &sorter); err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
+
+// evalCSVOptions evaluates the options of an IMPORT statement. It returns
+// them along with their evaluated values.
+func (p *planner) evalCSVOptions(options parser.KVOptions) (csvOptions, parser.KVOptions, error) {
+	opts := csvOptions{delimiter: ','}
+	var evaluated parser.KVOptions
+	seen := map[string]struct{}{}
+	for _, opt := range options {
+		name := normalizeName(string(opt.Key))
+		if _, ok := seen[name]; ok {
+			return opts, nil, fmt.Errorf("IMPORT: option %q specified more than once", name)
+		}
+		seen[name] = struct{}{}
+		d, err := opt.Value.Eval(p.evalCtx)
+		if err != nil {
+			return opts, nil, err
+		}
+		evaluated = append(evaluated, parser.KVOption{Key: opt.Key, Value: d})
+
+		switch name {
+		case "delimiter", "comment":
+			s, ok := d.(parser.DString)
+			if !ok || utf8.RuneCountInString(string(s)) != 1 {
+				return opts, nil, fmt.Errorf("IMPORT: option %q requires a single character: %s", name, opt.Value)
+			}
+			r, _ := utf8.DecodeRuneInString(string(s))
+			if name == "delimiter" {
+				opts.delimiter = r
+			} else {
+				opts.comment = r
+			}
+		case "nullif":
+			s, ok := d.(parser.DString)
+			if !ok {
+				return opts, nil, fmt.Errorf("IMPORT: option %q requires a string value: %s is a %s",
+					name, opt.Value, d.Type())
+			}
+			nullif := string(s)
+			opts.nullif = &nullif
+		case "skip":
+			i, ok := d.(parser.DInt)
+			if !ok || i < 0 {
+				return opts, nil, fmt.Errorf("IMPORT: option %q requires a non-negative integer: %s", name, opt.Value)
+			}
+			opts.skip = int(i)
+		case "lazy_quotes":
+			b, ok := d.(parser.DBool)
+			if !ok {
+				return opts, nil, fmt.Errorf("IMPORT: option %q requires a boolean value: %s is a %s",
+					name, opt.Value, d.Type())
+			}
+			opts.lazyQuotes = bool(b)
+		default:
+			return opts, nil, fmt.Errorf("IMPORT: unknown option %q", name)
+		}
+	}
+	return opts, evaluated, nil
+}
+
+// startImport returns the descriptor of the table imported by the
+// statement. The descriptor is created, along with the name of the table,
+// unless an interrupted import of the same statement is resumed. It is
+// committed right away, so that the import can be resumed.
+func (p *planner) startImport(n *parser.Import, dbDesc *DatabaseDescriptor) (*TableDescriptor, error) {
+	statement := n.String()
+	nameKey := tableKey{dbDesc.ID, n.Table.Table()}
+	var tableDesc *TableDescriptor
+	err := p.leaseMgr.db.Txn(func(txn *client.Txn) error {
+		gr, err := txn.Get(nameKey.Key())
+		if err != nil {
+			return err
+		}
+		if gr.Exists() {
+			if tableDesc, err = getTableDescFromID(txn, ID(gr.ValueInt())); err != nil {
+				return err
+			}
+			if tableDesc.Import == nil || tableDesc.Import.Statement != statement {
+				return fmt.Errorf("table %q already exists", nameKey.Name())
+			}
+			log.Infof("IMPORT table %q: resuming after %d ingested key/values",
+				tableDesc.Name, tableDesc.Import.Ingested)
+			return nil
+		}
+
+		desc, err := makeTableDesc(&parser.CreateTable{Table: n.Table, Defs: n.Defs}, dbDesc.ID)
+		if err != nil {
+			return err
+		}
+		// Inherit permissions from the database descriptor.
+		desc.Privileges = dbDesc.GetPrivileges()
+		if err := desc.AllocateIDs(); err != nil {
+			return err
+		}
+		if ir, err := txn.Inc(keys.DescIDGenerator, 1); err == nil {
+			desc.ID = ID(ir.ValueInt() - 1)
+		} else {
+			return err
+		}
+		desc.Import = &TableDescriptor_ImportProgress{Statement: statement}
+		tableDesc = &desc
+
+		b := txn.NewBatch()
+		b.CPut(nameKey.Key(), desc.ID, nil)
+		b.CPut(MakeDescMetadataKey(desc.ID), wrapDescriptor(&desc), nil)
+		txn.SetSystemDBTrigger()
+		return txn.CommitInBatch(b)
+	})
+	return tableDesc, err
+}
+
+// importFile is a file read by an import, along with its storage.
+type importFile struct {
+	io.ReadCloser
+	storage ExportStorage
+}
+
+// Close closes the file and its storage.
+func (f *importFile) Close() error {
+	err := f.ReadCloser.Close()
+	if sErr := f.storage.Close(); err == nil {
+		err = sErr
+	}
+	return err
+}
+
+// openImportFile returns a reader of the file at a URI, which the caller
+// has to close. The directory of the file is an ExportStorage.
+func (p *planner) openImportFile(uri string) (io.ReadCloser, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, err
+	}
+	dir, name := path.Split(u.Path)
+	if name == "" {
+		return nil, fmt.Errorf("no file specified in %q", uri)
+	}
+	u.Path = dir
+	storage, err := p.makeExportStorage("IMPORT", u.String())
+	if err != nil {
+		return nil, err
+	}
+	f, err := storage.OpenFile(name)
+	if err != nil {
+		storage.Close()
+		return nil, err
+	}
+	return &importFile{ReadCloser: f, storage: storage}, nil
+}
+
+type importKVs []roachpb.KeyValue
+
+func (kvs importKVs) Len() int           { return len(kvs) }
+func (kvs importKVs) Less(i, j int) bool { return bytes.Compare(kvs[i].Key, kvs[j].Key) < 0 }
+func (kvs importKVs) Swap(i, j int)      { kvs[i], kvs[j] = kvs[j], kvs[i] }
+
+// importSorter sorts the key/values of an imported table with bounded
+// memory. At most importSortSize key/values are held in memory: once full,
+// they are sorted and spilled to a temporary file as a run, and the runs
+// are merged when the key/values are iterated over.
+type importSorter struct {
+	kvs  importKVs
+	runs []*os.File
+}
+
+// add adds a key/value to the sorter.
+func (s *importSorter) add(kv roachpb.KeyValue) error {
+	s.kvs = append(s.kvs, kv)
+	if len(s.kvs) < importSortSize {
+		return nil
+	}
+	return s.spill()
+}
+
+// spill sorts the key/values in memory and writes them to a new run. Each
+// key/value is written as its length followed by its encoding.
+func (s *importSorter) spill() error {
+	sort.Sort(s.kvs)
+	f, err := ioutil.TempFile("", "import")
+	if err != nil {
+		return err
+	}
+	s.runs = append(s.runs, f)
+	w := bufio.NewWriter(f)
+	var size [binary.MaxVarintLen64]byte
+	for i := range s.kvs {
+		data, err := s.kvs[i].Marshal()
+		if err != nil {
+			return err
+		}
+		n := binary.PutUvarint(size[:], uint64(len(data)))
+		if _, err := w.Write(size[:n]); err != nil {
+			return err
+		}
+		if _, err := w.Write(data); err != nil {
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	s.kvs = s.kvs[:0]
+	return nil
+}
+
+// iterate calls fn with the key/values in key order, and returns an error
+// if a key was added more than once.
+func (s *importSorter) iterate(fn func(kv roachpb.KeyValue) error) error {
+	var prev roachpb.Key
+	check := func(kv roachpb.KeyValue) error {
+		if prev != nil && prev.Equal(kv.Key) {
+			return fmt.Errorf("IMPORT: duplicate key %s", prettyKey(kv.Key, 0))
+		}
+		prev = kv.Key
+		return fn(kv)
+	}
+
+	if len(s.runs) == 0 {
+		sort.Sort(s.kvs)
+		for _, kv := range s.kvs {
+			if err := check(kv); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if len(s.kvs) > 0 {
+		if err := s.spill(); err != nil {
+			return err
+		}
+	}
+	var h importRunHeap
+	for _, f := range s.runs {
+		if _, err := f.Seek(0, 0); err != nil {
+			return err
+		}
+		r := &importRun{r: bufio.NewReader(f)}
+		if ok, err := r.next(); err != nil {
+			return err
+		} else if ok {
+			h = append(h, r)
+		}
+	}
+	heap.Init(&h)
+	for len(h) > 0 {
+		r := h[0]
+		if err := check(r.kv); err != nil {
+			return err
+		}
+		if ok, err := r.next(); err != nil {
+			return err
+		} else if ok {
+			heap.Fix(&h, 0)
+		} else {
+			heap.Pop(&h)
+		}
+	}
+	return nil
+}
+
+// close removes the runs of the sorter.
+func (s *importSorter) close() {
+	for _, f := range s.runs {
+		_ = f.Close()
+		if err := os.Remove(f.Name()); err != nil {
+			log.Warningf("unable to remove IMPORT sort file %s: %s", f.Name(), err)
+		}
+	}
+	s.runs = nil
+	s.kvs = nil
+}
+
+// importRun reads the key/values of a run of an importSorter.
+type importRun struct {
+	r  *bufio.Reader
+	kv roachpb.KeyValue
+}
+
+// next reads the next key/value of the run, returning false at the end of
+// the run.
+func (r *importRun) next() (bool, error) {
+	size, err := binary.ReadUvarint(r.r)
+	if err == io.EOF {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r.r, data); err != nil {
+		return false, err
+	}
+	r.kv = roachpb.KeyValue{}
+	return true, r.kv.Unmarshal(data)
+}
+
+// importRunHeap is a min-heap of runs ordered by their current key.
+type importRunHeap []*importRun
+
+func (h importRunHeap) Len() int            { return len(h) }
+func (h importRunHeap) Less(i, j int) bool  { return bytes.Compare(h[i].kv.Key, h[j].kv.Key) < 0 }
+func (h importRunHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *importRunHeap) Push(x interface{}) { *h = append(*h, x.(*importRun)) }
+func (h *importRunHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+// encodeImportFiles parses the CSV files of an import, and encodes their
+// rows into the key/values of the table the same way INSERT does. The
+// key/values are added to the sorter.
+func (p *planner) encodeImportFiles(sorter *importSorter, tableDesc *TableDescriptor,
+	uris []string, opts csvOptions) error {
+	cols := tableDesc.Columns
+	colIDtoRowIndex := map[ColumnID]int{}
+	for i, c := range cols {
+		colIDtoRowIndex[c.ID] = i
+	}
+
+	for _, uri := range uris {
+		if err := p.encodeImportFile(sorter, tableDesc, colIDtoRowIndex, uri, opts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// encodeImportFile parses a CSV file of an import as it's read, encoding
+// its rows into key/values added to the sorter.
+func (p *planner) encodeImportFile(sorter *importSorter, tableDesc *TableDescriptor,
+	colIDtoRowIndex map[ColumnID]int, uri string, opts csvOptions) error {
+	cols := tableDesc.Columns
+	f, err := p.openImportFile(uri)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	r := csv.NewReader(f)
+	r.Comma = opts.delimiter
+	r.Comment = opts.comment
+	r.LazyQuotes = opts.lazyQuotes
+	r.FieldsPerRecord = len(cols)
+	for i := 0; ; i++ {
+		record, err := r.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("IMPORT: %s: %s", uri, err)
+		}
+		if i < opts.skip {
+			continue
+		}
+		row := make([]parser.Datum, len(cols))
+		for j, field := range record {
+			if opts.nullif != nil && field == *opts.nullif {
+				row[j] = parser.DNull
+				continue
+			}
+			if row[j], err = parseCSVDatum(p.evalCtx, cols[j].Type.Kind, field); err != nil {
+				return fmt.Errorf("IMPORT: %s: record %d: column %q: %s", uri, i+1, cols[j].Name, err)
+			}
+		}
+		if err := encodeImportRow(sorter, tableDesc, colIDtoRowIndex, row); err != nil {
+			return fmt.Errorf("IMPORT: %s: record %d: %s", uri, i+1, err)
+		}
+	}
+}
+
+// parseCSVDatum parses a CSV field into a datum of the specified column
+// type, the same way a string is cast to the type.
+func parseCSVDatum(ctx parser.EvalContext, kind ColumnType_Kind, s string) (parser.Datum, error) {
+	switch kind {
+	case ColumnType_BOOL:
+		b, err := strconv.ParseBool(s)
+		return parser.DBool(b), err
+	case ColumnType_INT:
+		i, err := strconv.ParseInt(s, 0, 64)
+		return parser.DInt(i), err
+	case ColumnType_FLOAT:
+		f, err := strconv.ParseFloat(s, 64)
+		return parser.DFloat(f), err
+	case ColumnType_STRING:
+		if !utf8.ValidString(s) {
+			return nil, fmt.Errorf("invalid utf8: %q", s)
+		}
+		return parser.DString(s), nil
+	case ColumnType_BYTES:
+		return parser.DBytes(s), nil
+	case ColumnType_DATE:
+		return parser.ParseDate(parser.DString(s))
+	case ColumnType_TIMESTAMP:
+		return ctx.ParseTimestamp(parser.DString(s))
+	case ColumnType_INTERVAL:
+		d, err := time.ParseDuration(s)
+		return parser.DInterval{Duration: d}, err
+	}
+	return nil, util.Errorf("unsupported column type: %s", kind)
+}
+
+// encodeImportRow adds the key/values of a row to the sorter.
+func encodeImportRow(sorter *importSorter, tableDesc *TableDescriptor,
+	colIDtoRowIndex map[ColumnID]int, row []parser.Datum) error {
+	cols := tableDesc.Columns
+
+	// Check to see if NULL is being inserted into any non-nullable column.
+	for i, col := range cols {
+		if !col.Nullable && row[i] == parser.DNull {
+			return fmt.Errorf("null value in column %q violates not-null constraint", col.Name)
+		}
+	}
+
+	marshalled := make([]interface{}, len(cols))
+	for i, val := range row {
+		var err error
+		if marshalled[i], err = marshalColumnValue(cols[i], val); err != nil {
+			return err
+		}
+	}
+
+	primaryIndex := tableDesc.PrimaryIndex
+	primaryIndexKey, _, err := encodeIndexKey(primaryIndex.ColumnIDs, colIDtoRowIndex, row,
+		MakeIndexKeyPrefix(tableDesc.ID, primaryIndex.ID))
+	if err != nil {
+		return err
+	}
+	secondaryIndexEntries, err := encodeSecondaryIndexes(
+		tableDesc.ID, tableDesc.Indexes, colIDtoRowIndex, row)
+	if err != nil {
+		return err
+	}
+	add := func(key roachpb.Key, v interface{}) error {
+		value, err := importValue(v)
+		if err != nil {
+			return err
+		}
+		return sorter.add(roachpb.KeyValue{Key: key, Value: value})
+	}
+	for _, secondaryIndexEntry := range secondaryIndexEntries {
+		if err := add(secondaryIndexEntry.key, secondaryIndexEntry.value); err != nil {
+			return err
+		}
+	}
+
+	// The row sentinel.
+	if err := add(primaryIndexKey, nil); err != nil {
+		return err
+	}
+
+	primaryKeyCols := map[ColumnID]struct{}{}
+	for _, id := range primaryIndex.ColumnIDs {
+		primaryKeyCols[id] = struct{}{}
+	}
+	for i, col := range cols {
+		if _, ok := primaryKeyCols[col.ID]; ok {
+			// Primary key columns are encoded in the row sentinel key.
+			continue
+		}
+		if marshalled[i] != nil {
+			if err := add(MakeColumnKey(col.ID, primaryIndexKey), marshalled[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// importValue returns the value written by a Put of a marshalled column
+// value or index entry value.
+func importValue(v interface{}) (roachpb.Value, error) {
+	var value roachpb.Value
+	switch t := v.(type) {
+	case nil:
+	case bool:
+		if t {
+			value.SetInt(1)
+		} else {
+			value.SetInt(0)
+		}
+	case int64:
+		value.SetInt(t)
+	case float64:
+		value.SetFloat(t)
+	case string:
+		value.SetBytes([]byte(t))
+	case []byte:
+		value.SetBytes(t)
+	case time.Time:
+		value.SetTime(t)
+	case time.Duration:
+		value.SetInt(int64(t))
+	default:
+		return value, util.Errorf("unable to marshal value: %v", v)
+	}
+	return value, nil
+}
+
+// ingestImport writes the sorted key/values of an imported table, skipping
+// the ones ingested before the import was interrupted, and completes the
+// import. The key/values are written in non-transactional batches: the
+// coordinator only falls back to a transaction for the rare batch that
+// spans several ranges. Writing a key/value twice is harmless, which is
+// why the progress of the import only needs to be persisted after every
+// batch.
+func ingestImport(db *client.DB, tableDesc *TableDescriptor, sorter *importSorter) error {
+	progress := *tableDesc.Import
+	var reqs []roachpb.Request
+	var last roachpb.Key
+	flush := func() error {
+		b := &client.Batch{}
+		b.InternalAddRequest(reqs...)
+		if err := db.Run(b); err != nil {
+			return err
+		}
+		progress.Ingested += int64(len(reqs))
+		progress.ResumeKey = last.Next()
+		reqs = reqs[:0]
+		log.Infof("IMPORT table %q: ingested %d key/values", tableDesc.Name, progress.Ingested)
+		return updateImport(db, tableDesc, &progress)
+	}
+	if err := sorter.iterate(func(kv roachpb.KeyValue) error {
+		if bytes.Compare(kv.Key, progress.ResumeKey) < 0 {
+			return nil
+		}
+		reqs = append(reqs, roachpb.NewPut(kv.Key, kv.Value))
+		last = kv.Key
+		if len(reqs) < importBatchSize {
+			return nil
+		}
+		return flush()
+	}); err != nil {
+		return err
+	}
+	if len(reqs) > 0 {
+		if err := flush(); err != nil {
+			return err
+		}
+	}
+	return updateImport(db, tableDesc, nil)
+}
+
+// updateImport persists the progress of the import of a table in its
+// descriptor. A nil progress completes the import, which makes the table
+// usable.
+func updateImport(db *client.DB, tableDesc *TableDescriptor, progress *TableDescriptor_ImportProgress) error {
+	return db.Txn(func(txn *client.Txn) error {
+		desc, err := getTableDescFromID(txn, tableDesc.ID)
+		if err != nil {
+			return err
+		}
+		if desc.Dropped() || desc.Import == nil || desc.Import.Statement != tableDesc.Import.Statement {
+			return fmt.Errorf("table %q is no longer being imported", tableDesc.Name)
+		}
+		desc.Import = progress
+		txn.SetSystemDBTrigger()
+		return txn.Put(MakeDescMetadataKey(desc.ID), wrapDescriptor(desc))
+	})
+}
+
+// ShowImports lists the tables being imported, including the interrupted
+// imports, along with the number of key/values ingested so far.
+// Privileges: None.
+//   Notes: only the imports of the tables the user has SELECT on are listed.
+func (p *planner) ShowImports(n *parser.ShowImports) (planNode, error) {
+	v := &valuesNode{
+		columns: []column{
+			{name: "table", typ: parser.DummyString},
+			{name: "ingested", typ: parser.DummyInt},
+			{name: "statement", typ: parser.DummyString},
+		},
+	}
+	if err := p.forEachTableDesc(func(db *DatabaseDescriptor, table *TableDescriptor) {
+		if table.Import == nil || p.checkPrivilege(table, privilege.SELECT) != nil {
+			return
+		}
+		v.rows = append(v.rows, []parser.Datum{
+			parser.DString(fmt.Sprintf("%s.%s", db.Name, table.Name)),
+			parser.DInt(table.Import.Ingested),
+			parser.DString(table.Import.Statement),
+		})
+	}); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,146 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql_test
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	csql "github.com/cockroachdb/cockroach/sql"
+	"github.com/cockroachdb/cockroach/testutils"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func TestImport(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, _ := setup(t)
+	defer cleanup(s, sqlDB)
+	// The key/values are sorted in runs of two, which are merged.
+	defer csql.TestingSetImportSortSize(2)()
+
+	dir, err := ioutil.TempDir("", "import_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	writeFile := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	first := writeFile("first.csv", "a|b|c\n3|three|\"3|3\"\n1|one|N\n")
+	second := writeFile("second.csv", "a|b|c\n2|two|x\n")
+
+	if _, err := sqlDB.Exec(`CREATE DATABASE d`); err != nil {
+		t.Fatal(err)
+	}
+	const importStmt = `IMPORT TABLE d.t (a INT PRIMARY KEY, b STRING, c STRING, INDEX b (b))
+CSV DATA ($1, $2) WITH delimiter = '|', skip = 1, nullif = 'N'`
+
+	checkRows := func(query string, expected [][3]string) {
+		rows, err := sqlDB.Query(query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer rows.Close()
+		var found [][3]string
+		for rows.Next() {
+			var r [3]string
+			var c *string
+			if err := rows.Scan(&r[0], &r[1], &c); err != nil {
+				t.Fatal(err)
+			}
+			r[2] = "NULL"
+			if c != nil {
+				r[2] = *c
+			}
+			found = append(found, r)
+		}
+		if len(found) != len(expected) {
+			t.Fatalf("%s: expected %v, but found %v", query, expected, found)
+		}
+		for i := range found {
+			if found[i] != expected[i] {
+				t.Fatalf("%s: expected %v, but found %v", query, expected, found)
+			}
+		}
+	}
+
+	// A bad record fails the import after the table is created. The table
+	// can't be used until the import is resumed.
+	bad := writeFile("third.csv", "a|b|c\n4|four|x\nfive|five|x\n")
+	if _, err := sqlDB.Exec(importStmt, first, bad); !testutils.IsError(err, `record 3: column "a"`) {
+		t.Fatalf("expected bad record error, but found %v", err)
+	}
+	if _, err := sqlDB.Query(`SELECT * FROM d.t`); !testutils.IsError(err, `table "t" is being imported`) {
+		t.Fatalf("expected importing table error, but found %v", err)
+	}
+	if _, err := sqlDB.Exec(importStmt, first, second); !testutils.IsError(err, `table "t" already exists`) {
+		t.Fatalf("expected existing table error, but found %v", err)
+	}
+	var table, statement string
+	var ingested int
+	if err := sqlDB.QueryRow(`SHOW IMPORTS`).Scan(&table, &ingested, &statement); err != nil {
+		t.Fatal(err)
+	}
+	if table != "d.t" || ingested != 0 {
+		t.Fatalf("expected no key/values of d.t to be ingested, but found %s: %d", table, ingested)
+	}
+
+	// Running the same statement again resumes the import.
+	writeFile("third.csv", "a|b|c\n4|four|x\n5|five|x\n")
+	if _, err := sqlDB.Exec(importStmt, first, bad); err != nil {
+		t.Fatal(err)
+	}
+	checkRows(`SELECT a, b, c FROM d.t`, [][3]string{
+		{"1", "one", "NULL"}, {"3", "three", "3|3"}, {"4", "four", "x"}, {"5", "five", "x"},
+	})
+	checkRows(`SELECT a, b, c FROM d.t@b`, [][3]string{
+		{"5", "five", "x"}, {"4", "four", "x"}, {"1", "one", "NULL"}, {"3", "three", "3|3"},
+	})
+	if err := sqlDB.QueryRow(`SHOW IMPORTS`).Scan(&table, &ingested, &statement); err != sql.ErrNoRows {
+		t.Fatalf("expected no import, but found %v", err)
+	}
+
+	testCases := []struct {
+		sql      string
+		args     []interface{}
+		expected string
+	}{
+		{`IMPORT TABLE d.u (a INT PRIMARY KEY) CSV DATA ($1) WITH skip = 1, delimiter = '|'`,
+			[]interface{}{writeFile("dup.csv", "a\n1\n1\n")}, `duplicate key`},
+		{`IMPORT TABLE d.v (a INT PRIMARY KEY, b INT) CSV DATA ($1)`,
+			[]interface{}{second}, `wrong number of fields`},
+		{`IMPORT TABLE d.w (a INT PRIMARY KEY) CSV DATA ($1) WITH quote = '"'`,
+			[]interface{}{second}, `unknown option "quote"`},
+		{`BEGIN; IMPORT TABLE d.x (a INT PRIMARY KEY) CSV DATA ($1)`,
+			[]interface{}{second}, `IMPORT is not supported within a transaction`},
+	}
+	for _, tc := range testCases {
+		if _, err := sqlDB.Exec(tc.sql, tc.args...); !testutils.IsError(err, tc.expected) {
+			t.Errorf("%s: expected %q, but found %v", tc.sql, tc.expected, err)
+		}
+	}
+}
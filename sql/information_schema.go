@@ -0,0 +1,256 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/sql/privilege"
+)
+
+// Databases play the role of schemas in information_schema. As in mysql,
+// all of them belong to a single catalog.
+const informationSchemaCatalog = "def"
+
+// informationSchema describes the databases, tables, columns and privileges
+// as specified by the SQL standard.
+// Privileges: None.
+//   Notes: postgres and mysql only show the objects the user has privileges
+//          on. Like the SHOW statements, we show all of them.
+var informationSchema = virtualSchema{
+	name: "information_schema",
+	tables: []virtualSchemaTable{
+		informationSchemaSchemata,
+		informationSchemaTables,
+		informationSchemaColumns,
+		informationSchemaTablePrivileges,
+		informationSchemaColumnPrivileges,
+		informationSchemaKeyColumnUsage,
+	},
+}
+
+var informationSchemaSchemata = virtualSchemaTable{
+	schema: `
+CREATE TABLE information_schema.schemata (
+  catalog_name               STRING NOT NULL,
+  schema_name                STRING NOT NULL,
+  default_character_set_name STRING NOT NULL,
+  sql_path                   STRING
+);`,
+	populate: func(p *planner, addRow func(...parser.Datum)) error {
+		return p.forEachDatabaseDesc(func(db *DatabaseDescriptor) {
+			addRow(
+				parser.DString(informationSchemaCatalog),
+				parser.DString(db.Name),
+				parser.DString("UTF8"),
+				parser.DNull,
+			)
+		})
+	},
+}
+
+var informationSchemaTables = virtualSchemaTable{
+	schema: `
+CREATE TABLE information_schema.tables (
+  table_catalog STRING NOT NULL,
+  table_schema  STRING NOT NULL,
+  table_name    STRING NOT NULL,
+  table_type    STRING NOT NULL
+);`,
+	populate: func(p *planner, addRow func(...parser.Datum)) error {
+		return p.forEachTableDesc(func(db *DatabaseDescriptor, table *TableDescriptor) {
+			tableType := "BASE TABLE"
+			if isVirtualDescriptor(table) {
+				tableType = "SYSTEM VIEW"
+			}
+			addRow(
+				parser.DString(informationSchemaCatalog),
+				parser.DString(db.Name),
+				parser.DString(table.Name),
+				parser.DString(tableType),
+			)
+		})
+	},
+}
+
+var informationSchemaColumns = virtualSchemaTable{
+	schema: `
+CREATE TABLE information_schema.columns (
+  table_catalog            STRING NOT NULL,
+  table_schema             STRING NOT NULL,
+  table_name               STRING NOT NULL,
+  column_name              STRING NOT NULL,
+  ordinal_position         INT NOT NULL,
+  column_default           STRING,
+  is_nullable              STRING NOT NULL,
+  data_type                STRING NOT NULL,
+  character_maximum_length INT,
+  numeric_precision        INT,
+  numeric_scale            INT
+);`,
+	populate: func(p *planner, addRow func(...parser.Datum)) error {
+		return p.forEachTableDesc(func(db *DatabaseDescriptor, table *TableDescriptor) {
+			for i, col := range table.Columns {
+				defaultExpr := parser.Datum(parser.DNull)
+				if col.DefaultExpr != nil {
+					defaultExpr = parser.DString(*col.DefaultExpr)
+				}
+				var maxLength, precision, scale int32
+				switch col.Type.Kind {
+				case ColumnType_STRING:
+					maxLength = col.Type.Width
+				case ColumnType_FLOAT:
+					precision = col.Type.Precision
+				case ColumnType_DECIMAL:
+					precision = col.Type.Precision
+					scale = col.Type.Width
+				}
+				addRow(
+					parser.DString(informationSchemaCatalog),
+					parser.DString(db.Name),
+					parser.DString(table.Name),
+					parser.DString(col.Name),
+					parser.DInt(i+1),
+					defaultExpr,
+					yesOrNo(col.Nullable),
+					parser.DString(col.Type.Kind.String()),
+					dIntOrNull(maxLength),
+					dIntOrNull(precision),
+					dIntOrNull(scale),
+				)
+			}
+		})
+	},
+}
+
+var informationSchemaTablePrivileges = virtualSchemaTable{
+	schema: `
+CREATE TABLE information_schema.table_privileges (
+  grantor        STRING,
+  grantee        STRING NOT NULL,
+  table_catalog  STRING NOT NULL,
+  table_schema   STRING NOT NULL,
+  table_name     STRING NOT NULL,
+  privilege_type STRING NOT NULL,
+  is_grantable   STRING NOT NULL
+);`,
+	populate: func(p *planner, addRow func(...parser.Datum)) error {
+		return p.forEachTableDesc(func(db *DatabaseDescriptor, table *TableDescriptor) {
+			for _, u := range table.Privileges.Users {
+				privs := privilege.ListFromBitField(u.Privileges)
+				if isPrivilegeSet(u.Privileges, privilege.ALL) {
+					// ALL is shown as the privileges it stands for.
+					privs = privilege.List(privilege.ByValue[1:])
+				}
+				grantable := isPrivilegeSet(u.Privileges, privilege.ALL) ||
+					isPrivilegeSet(u.Privileges, privilege.GRANT)
+				for _, priv := range privs {
+					// The grantor of a privilege isn't recorded.
+					addRow(
+						parser.DNull,
+						parser.DString(u.User),
+						parser.DString(informationSchemaCatalog),
+						parser.DString(db.Name),
+						parser.DString(table.Name),
+						parser.DString(priv.String()),
+						yesOrNo(grantable),
+					)
+				}
+			}
+		})
+	},
+}
+
+var informationSchemaColumnPrivileges = virtualSchemaTable{
+	schema: `
+CREATE TABLE information_schema.column_privileges (
+  grantor        STRING,
+  grantee        STRING NOT NULL,
+  table_catalog  STRING NOT NULL,
+  table_schema   STRING NOT NULL,
+  table_name     STRING NOT NULL,
+  column_name    STRING NOT NULL,
+  privilege_type STRING NOT NULL,
+  is_grantable   STRING NOT NULL
+);`,
+	populate: func(p *planner, addRow func(...parser.Datum)) error {
+		return p.forEachTableDesc(func(db *DatabaseDescriptor, table *TableDescriptor) {
+			// Only the privileges granted on the columns themselves are shown,
+			// not those inherited from the table. They can't be granted further.
+			for _, col := range table.Columns {
+				if col.Privileges == nil {
+					continue
+				}
+				for _, u := range col.Privileges.Users {
+					for _, priv := range privilege.ListFromBitField(u.Privileges) {
+						addRow(
+							parser.DNull,
+							parser.DString(u.User),
+							parser.DString(informationSchemaCatalog),
+							parser.DString(db.Name),
+							parser.DString(table.Name),
+							parser.DString(col.Name),
+							parser.DString(priv.String()),
+							yesOrNo(false),
+						)
+					}
+				}
+			}
+		})
+	},
+}
+
+var informationSchemaKeyColumnUsage = virtualSchemaTable{
+	schema: `
+CREATE TABLE information_schema.key_column_usage (
+  constraint_catalog            STRING NOT NULL,
+  constraint_schema             STRING NOT NULL,
+  constraint_name               STRING NOT NULL,
+  table_catalog                 STRING NOT NULL,
+  table_schema                  STRING NOT NULL,
+  table_name                    STRING NOT NULL,
+  column_name                   STRING NOT NULL,
+  ordinal_position              INT NOT NULL,
+  position_in_unique_constraint INT
+);`,
+	populate: func(p *planner, addRow func(...parser.Datum)) error {
+		return p.forEachTableDesc(func(db *DatabaseDescriptor, table *TableDescriptor) {
+			// The primary key and the unique indexes are the key constraints.
+			// Virtual tables have none.
+			if isVirtualDescriptor(table) {
+				return
+			}
+			for _, index := range append([]IndexDescriptor{table.PrimaryIndex}, table.Indexes...) {
+				if !index.Unique {
+					continue
+				}
+				for i, col := range index.ColumnNames {
+					// position_in_unique_constraint only applies to foreign keys.
+					addRow(
+						parser.DString(informationSchemaCatalog),
+						parser.DString(db.Name),
+						parser.DString(index.Name),
+						parser.DString(informationSchemaCatalog),
+						parser.DString(db.Name),
+						parser.DString(table.Name),
+						parser.DString(col),
+						parser.DInt(i+1),
+						parser.DNull,
+					)
+				}
+			}
+		})
+	},
+}
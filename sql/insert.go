@@ -28,7 +28,7 @@ import (
 )
 
 // Insert inserts rows into the database.
-// Privileges: INSERT on table
+// Privileges: INSERT on table, or on the columns inserted into.
 //   Notes: postgres requires INSERT. No "on duplicate key update" option.
 //          mysql requires INSERT. Also requires UPDATE on "ON DUPLICATE KEY UPDATE".
 func (p *planner) Insert(n *parser.Insert) (planNode, error) {
@@ -42,15 +42,17 @@ func (p *planner) Insert(n *parser.Insert) (planNode, error) {
 		return nil, err
 	}
 
-	if err := p.checkPrivilege(tableDesc, privilege.INSERT); err != nil {
-		return nil, err
-	}
-
 	// Determine which columns we're inserting into.
 	cols, err := p.processColumns(tableDesc, n.Columns)
 	if err != nil {
 		return nil, err
 	}
+
+	// The columns receiving a default value don't require INSERT.
+	if err := p.checkColumnPrivileges(tableDesc, cols, privilege.INSERT); err != nil {
+		return nil, err
+	}
+
 	// Number of columns expecting an input. This doesn't include the
 	// columns receiving a default value.
 	numInputColumns := len(cols)
@@ -236,6 +238,7 @@ func (p *planner) Insert(n *parser.Insert) (planNode, error) {
 	if err := p.txn.Run(&b); err != nil {
 		return nil, convertBatchError(tableDesc, b, err)
 	}
+	p.kvStats.addKV(writeStats(&b))
 
 	return result, nil
 }
@@ -47,6 +47,7 @@ func makeIndexJoin(indexScan *scanNode, exactPrefix int) (*indexJoinNode, error)
 	table.spans = nil
 	table.reverse = false
 	table.isSecondaryIndex = false
+	table.constraints = nil
 	table.initOrdering(0)
 
 	// Clear the index filter expression. We want to pass all of the rows to the
@@ -27,6 +27,7 @@ import (
 	"time"
 
 	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/config"
 	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/sql/parser"
 	"github.com/cockroachdb/cockroach/util"
@@ -48,6 +49,7 @@ var (
 	// acquisition. Exported for testing purposes only.
 	MinLeaseDuration       = time.Minute
 	errLeaseVersionChanged = errors.New("lease version changed")
+	errTableDropped        = errors.New("table is being dropped")
 )
 
 // LeaseState holds the state for a lease. Exported only for testing.
@@ -114,6 +116,12 @@ func (s LeaseStore) Acquire(txn *client.Txn, tableID ID, minVersion uint32) (*Le
 	if tableDesc == nil {
 		return nil, util.Errorf("ID %d is not a table", tableID)
 	}
+	if tableDesc.Dropped() {
+		return nil, errTableDropped
+	}
+	if tableDesc.Import != nil {
+		return nil, fmt.Errorf("table %q is being imported", tableDesc.Name)
+	}
 	lease.TableDescriptor = *tableDesc
 
 	if err := lease.Validate(); err != nil {
@@ -171,48 +179,65 @@ func (s LeaseStore) Release(lease *LeaseState) error {
 	})
 }
 
-// Publish a new version of a table descriptor. The update closure may be
-// called multiple times if retries occur: make sure it does not have side
-// effects.
-func (s LeaseStore) Publish(tableID ID, update func(*TableDescriptor) error) error {
+// waitForOneVersion returns once there are no unexpired leases on the
+// previous version of the table descriptor. It returns the current version.
+// After returning there can only be versions of the descriptor >= to the
+// returned version. Lease acquisition (see acquire()) maintains the
+// invariant that no new leases for desc.Version-1 will be granted once
+// desc.Version exists.
+func (s LeaseStore) waitForOneVersion(tableID ID, retryOpts retry.Options) (uint32, error) {
 	desc := &Descriptor{}
 	descKey := MakeDescMetadataKey(tableID)
-
-	retryOpts := retry.Options{
-		InitialBackoff: 20 * time.Millisecond,
-		MaxBackoff:     2 * time.Second,
-		Multiplier:     2,
-	}
-
+	var tableDesc *TableDescriptor
 	for r := retry.Start(retryOpts); r.Next(); {
 		// Get the current version of the table descriptor non-transactionally.
 		//
 		// TODO(pmattis): Do an inconsistent read here?
 		if err := s.db.GetProto(descKey, desc); err != nil {
-			return err
+			return 0, err
 		}
-		tableDesc := desc.GetTable()
+		tableDesc = desc.GetTable()
 		if tableDesc == nil {
-			return util.Errorf("ID %d is not a table", tableID)
+			return 0, util.Errorf("ID %d is not a table", tableID)
 		}
 		// Check to see if there are any leases that still exist on the previous
 		// version of the descriptor.
 		now := s.clock.Now()
 		count, err := s.countLeases(tableDesc.ID, tableDesc.Version-1, now.GoTime())
 		if err != nil {
-			return err
+			return 0, err
 		}
-		if count != 0 {
-			log.Infof("publish (count leases): descID=%d version=%d count=%d",
-				tableDesc.ID, tableDesc.Version-1, count)
-			continue
+		if count == 0 {
+			break
+		}
+		log.Infof("publish (count leases): descID=%d version=%d count=%d",
+			tableDesc.ID, tableDesc.Version-1, count)
+	}
+	return tableDesc.Version, nil
+}
+
+// Publish a new version of a table descriptor. The update closure may be
+// called multiple times if retries occur: make sure it does not have side
+// effects.
+func (s LeaseStore) Publish(tableID ID, update func(*TableDescriptor) error) error {
+	desc := &Descriptor{}
+	descKey := MakeDescMetadataKey(tableID)
+
+	retryOpts := retry.Options{
+		InitialBackoff: 20 * time.Millisecond,
+		MaxBackoff:     2 * time.Second,
+		Multiplier:     2,
+	}
+
+	for {
+		// Wait until there are no unexpired leases on the previous version of
+		// the descriptor. At that point, expectedVersion is the only version of
+		// the descriptor that has leases outstanding.
+		expectedVersion, err := s.waitForOneVersion(tableID, retryOpts)
+		if err != nil {
+			return err
 		}
 
-		// At this point, desc.Version is the only version of the descriptor that
-		// has leases outstanding. Lease acquisition (see acquire()) maintains the
-		// invariant that no new leases for desc.Version-1 will be granted once
-		// desc.Version exists.
-		expectedVersion := tableDesc.Version
 		err = s.db.Txn(func(txn *client.Txn) error {
 			// Re-read the current version of the table descriptor, this time
 			// transactionally.
@@ -239,6 +264,7 @@ func (s LeaseStore) Publish(tableID ID, update func(*TableDescriptor) error) err
 			}
 
 			// Bump the version and modification time.
+			now := s.clock.Now()
 			tableDesc.Version = tableDesc.Version + 1
 			tableDesc.ModificationTime = now
 			if log.V(3) {
@@ -257,8 +283,6 @@ func (s LeaseStore) Publish(tableID ID, update func(*TableDescriptor) error) err
 			return err
 		}
 	}
-
-	panic("not reached")
 }
 
 // countLeases returns the number of unexpired leases for a particular version
@@ -387,14 +411,24 @@ type tableState struct {
 	// nil if there is no lease acquisition in progress for the table. If
 	// non-nil, the channel will be closed when lease acquisition completes.
 	acquiring chan struct{}
+	// The newest version of the descriptor known to exist. Leases on older
+	// versions are released as soon as they are no longer referenced so that
+	// they don't hold up the publication of further versions.
+	newestVersion uint32
 }
 
 func (t *tableState) acquire(txn *client.Txn, version uint32, store LeaseStore) (*LeaseState, error) {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
+	acquired := false
 	for {
 		s := t.active.findNewest(version)
+		if s != nil && version == 0 && !acquired && s.Version < t.newestVersion {
+			// A newer version of the descriptor is known to exist. Don't hand out
+			// the stale lease; acquire a lease on the newest version instead.
+			s = nil
+		}
 		if s != nil {
 			if version != 0 && s != t.active.findNewest(0) {
 				// If a lease was requested for an old version of the descriptor,
@@ -439,6 +473,10 @@ func (t *tableState) acquire(txn *client.Txn, version uint32, store LeaseStore)
 				return nil, err
 			}
 			t.active.insert(s)
+			acquired = true
+			if err := t.purgeOldLeases(s.Version, store); err != nil {
+				log.Warning(err)
+			}
 		}
 
 		// A new lease was added, so loop and perform the lookup again.
@@ -475,7 +513,7 @@ func (t *tableState) release(lease *LeaseState, store LeaseStore) error {
 	}
 	if s.refcount == 0 {
 		n := t.active.findNewest(0)
-		if s != n {
+		if s != n || s.Version < t.newestVersion {
 			if s.Version < n.Version {
 				// TODO(pmattis): If an active transaction is releasing the lease for
 				// an older version, hold on to it for a few seconds in anticipation of
@@ -490,6 +528,30 @@ func (t *tableState) release(lease *LeaseState, store LeaseStore) error {
 	return nil
 }
 
+// purgeOldLeases records that the specified version of the descriptor exists
+// and releases the unreferenced leases on older versions. Referenced leases on
+// older versions are released when their last reference is dropped.
+func (t *tableState) purgeOldLeases(version uint32, store LeaseStore) error {
+	// We're called with mu locked.
+	if version > t.newestVersion {
+		t.newestVersion = version
+	}
+	var toRelease []*LeaseState
+	for _, s := range t.active.data {
+		if s.Version < t.newestVersion && s.refcount == 0 {
+			toRelease = append(toRelease, s)
+		}
+	}
+	var err error
+	for _, s := range toRelease {
+		t.active.remove(s)
+		if rErr := t.releaseNodeLease(s, store); rErr != nil {
+			err = rErr
+		}
+	}
+	return err
+}
+
 func (t *tableState) releaseNodeLease(lease *LeaseState, store LeaseStore) error {
 	// We're called with mu locked, but need to unlock it while releasing the
 	// lease.
@@ -538,6 +600,52 @@ func (m *LeaseManager) Release(lease *LeaseState) error {
 	return t.release(lease, m.LeaseStore)
 }
 
+// purgeOldLeases releases the unreferenced leases this node holds on versions
+// of the table descriptor older than the specified version. It is called when
+// a new version of the descriptor is known to have been published: holding on
+// to leases on the old version would otherwise delay the publication of the
+// next version until they expire.
+func (m *LeaseManager) purgeOldLeases(tableID ID, version uint32) error {
+	t := m.findTableState(tableID, false)
+	if t == nil {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.purgeOldLeases(version, m.LeaseStore)
+}
+
+// refreshLeases purges the leases on table descriptor versions that have been
+// superseded according to the system config. This is how a node learns about
+// versions published by other nodes.
+func (m *LeaseManager) refreshLeases(cfg config.SystemConfig) {
+	m.mu.Lock()
+	tableIDs := make([]ID, 0, len(m.tables))
+	for id := range m.tables {
+		tableIDs = append(tableIDs, id)
+	}
+	m.mu.Unlock()
+
+	for _, id := range tableIDs {
+		v := cfg.GetValue(MakeDescMetadataKey(id))
+		if v == nil {
+			continue
+		}
+		desc := &Descriptor{}
+		if err := v.GetProto(desc); err != nil {
+			log.Warningf("unable to unmarshal descriptor %d: %s", id, err)
+			continue
+		}
+		tableDesc := desc.GetTable()
+		if tableDesc == nil {
+			continue
+		}
+		if err := m.purgeOldLeases(id, tableDesc.Version); err != nil {
+			log.Warning(err)
+		}
+	}
+}
+
 func (m *LeaseManager) findTableState(tableID ID, create bool) *tableState {
 	m.mu.Lock()
 	defer m.mu.Unlock()
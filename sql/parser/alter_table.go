@@ -63,6 +63,9 @@ func (*AlterTableAddColumn) alterTableCmd()      {}
 func (*AlterTableAddConstraint) alterTableCmd()  {}
 func (*AlterTableDropColumn) alterTableCmd()     {}
 func (*AlterTableDropConstraint) alterTableCmd() {}
+func (*AlterTableSetDefault) alterTableCmd()     {}
+func (*AlterTableDropNotNull) alterTableCmd()    {}
+func (*AlterTableSetNotNull) alterTableCmd()     {}
 
 // AlterTableAddColumn represents an ADD COLUMN command.
 type AlterTableAddColumn struct {
@@ -122,3 +125,60 @@ type AlterTableDropConstraint struct {
 func (node *AlterTableDropConstraint) String() string {
 	return fmt.Sprintf("DROP CONSTRAINT %s", node.Constraint)
 }
+
+// AlterTableSetDefault represents an ALTER COLUMN SET DEFAULT
+// or DROP DEFAULT command.
+type AlterTableSetDefault struct {
+	columnKeyword bool
+	Column        string
+	Default       Expr
+}
+
+func (node *AlterTableSetDefault) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("ALTER")
+	if node.columnKeyword {
+		_, _ = buf.WriteString(" COLUMN")
+	}
+	fmt.Fprintf(&buf, " %s", node.Column)
+	if node.Default == nil {
+		_, _ = buf.WriteString(" DROP DEFAULT")
+	} else {
+		fmt.Fprintf(&buf, " SET DEFAULT %s", node.Default)
+	}
+	return buf.String()
+}
+
+// AlterTableDropNotNull represents an ALTER COLUMN DROP NOT NULL
+// command.
+type AlterTableDropNotNull struct {
+	columnKeyword bool
+	Column        string
+}
+
+func (node *AlterTableDropNotNull) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("ALTER")
+	if node.columnKeyword {
+		_, _ = buf.WriteString(" COLUMN")
+	}
+	fmt.Fprintf(&buf, " %s DROP NOT NULL", node.Column)
+	return buf.String()
+}
+
+// AlterTableSetNotNull represents an ALTER COLUMN SET NOT NULL
+// command.
+type AlterTableSetNotNull struct {
+	columnKeyword bool
+	Column        string
+}
+
+func (node *AlterTableSetNotNull) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("ALTER")
+	if node.columnKeyword {
+		_, _ = buf.WriteString(" COLUMN")
+	}
+	fmt.Fprintf(&buf, " %s SET NOT NULL", node.Column)
+	return buf.String()
+}
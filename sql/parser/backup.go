@@ -0,0 +1,60 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package parser
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// Backup represents a BACKUP statement. A nil Targets backs up all the
+// databases of the cluster.
+type Backup struct {
+	Targets         *TargetList
+	To              Expr
+	AsOf            AsOfClause
+	IncrementalFrom Exprs
+}
+
+func (node *Backup) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("BACKUP ")
+	if node.Targets != nil {
+		fmt.Fprintf(&buf, "%s ", node.Targets)
+	}
+	fmt.Fprintf(&buf, "TO %s%s", node.To, node.AsOf)
+	if node.IncrementalFrom != nil {
+		fmt.Fprintf(&buf, " INCREMENTAL FROM %s", node.IncrementalFrom)
+	}
+	return buf.String()
+}
+
+// Restore represents a RESTORE statement. A nil Targets restores all the
+// databases of the backup.
+type Restore struct {
+	Targets *TargetList
+	From    Exprs
+}
+
+func (node *Restore) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("RESTORE ")
+	if node.Targets != nil {
+		fmt.Fprintf(&buf, "%s ", node.Targets)
+	}
+	fmt.Fprintf(&buf, "FROM %s", node.From)
+	return buf.String()
+}
@@ -44,6 +44,26 @@ func (node *CreateDatabase) String() string {
 	return buf.String()
 }
 
+// CreateUser represents a CREATE USER statement.
+type CreateUser struct {
+	IfNotExists bool
+	Name        Name
+	Password    Expr
+}
+
+func (node *CreateUser) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("CREATE USER ")
+	if node.IfNotExists {
+		buf.WriteString("IF NOT EXISTS ")
+	}
+	buf.WriteString(node.Name.String())
+	if node.Password != nil {
+		fmt.Fprintf(&buf, " WITH PASSWORD %s", node.Password)
+	}
+	return buf.String()
+}
+
 // CreateIndex represents a CREATE INDEX statement.
 type CreateIndex struct {
 	Name        Name
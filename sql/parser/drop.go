@@ -41,6 +41,38 @@ func (node *DropDatabase) String() string {
 	return buf.String()
 }
 
+// DropGroup represents a DROP GROUP statement.
+type DropGroup struct {
+	Name     Name
+	IfExists bool
+}
+
+func (node *DropGroup) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("DROP GROUP ")
+	if node.IfExists {
+		buf.WriteString("IF EXISTS ")
+	}
+	buf.WriteString(node.Name.String())
+	return buf.String()
+}
+
+// DropUser represents a DROP USER statement.
+type DropUser struct {
+	Names    NameList
+	IfExists bool
+}
+
+func (node *DropUser) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("DROP USER ")
+	if node.IfExists {
+		buf.WriteString("IF EXISTS ")
+	}
+	buf.WriteString(node.Names.String())
+	return buf.String()
+}
+
 // DropIndex represents a DROP INDEX statement.
 type DropIndex struct {
 	Names    QualifiedNames
@@ -0,0 +1,68 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package parser
+
+import "github.com/cockroachdb/cockroach/util"
+
+// hiddenConst replaces a constant in the fingerprint of a statement.
+type hiddenConst struct{}
+
+func (hiddenConst) String() string {
+	return "_"
+}
+
+// Walk implements the Expr interface.
+func (hiddenConst) Walk(_ Visitor) {}
+
+// TypeCheck implements the Expr interface.
+func (expr hiddenConst) TypeCheck() (Datum, error) {
+	return nil, util.Errorf("unhandled type %T", expr)
+}
+
+// Eval implements the Expr interface.
+func (expr hiddenConst) Eval(_ EvalContext) (Datum, error) {
+	return nil, util.Errorf("unhandled type %T", expr)
+}
+
+type hideConstsVisitor struct{}
+
+var _ Visitor = hideConstsVisitor{}
+
+func (v hideConstsVisitor) Visit(expr Expr, pre bool) (Visitor, Expr) {
+	if !pre {
+		return nil, expr
+	}
+	switch expr.(type) {
+	case *IntVal, NumVal, DBool, DBytes, DDate, DFloat, DInt, DInterval, DString, DTimestamp:
+		return nil, hiddenConst{}
+	}
+	return v, expr
+}
+
+// Fingerprint returns the statement with its constants replaced by "_". The
+// statements which only differ by their constants have the same fingerprint.
+// NULL and placeholders are kept.
+func Fingerprint(stmt Statement) string {
+	// The constants are hidden in a copy of the statement, obtained by parsing
+	// it again.
+	stmts, err := ParseTraditional(stmt.String())
+	if err != nil || len(stmts) != 1 {
+		return stmt.String()
+	}
+	stmt = stmts[0]
+	WalkStmt(hideConstsVisitor{}, stmt)
+	return stmt.String()
+}
@@ -0,0 +1,50 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package parser
+
+import "testing"
+
+func TestFingerprint(t *testing.T) {
+	testData := []struct {
+		sql      string
+		expected string
+	}{
+		{`SELECT 1`, `SELECT _`},
+		{`SELECT a, b + 1.5 FROM t WHERE c = 'foo' AND d IS NULL LIMIT 10`,
+			`SELECT a, b + _ FROM t WHERE c = _ AND d IS NULL LIMIT _`},
+		{`SELECT a FROM t WHERE b = $1 AND c = TRUE`, `SELECT a FROM t WHERE b = $1 AND c = _`},
+		{`SELECT a FROM t WHERE b IN (1, 2) AND c = DATE '2015-01-01'`,
+			`SELECT a FROM t WHERE b IN (_, _) AND c = CAST(_ AS DATE)`},
+		{`INSERT INTO t VALUES (1, 'a', b'c')`, `INSERT INTO t VALUES (_, _, _)`},
+		{`UPDATE t SET a = 1 WHERE b = 2`, `UPDATE t SET a = _ WHERE b = _`},
+		{`DELETE FROM t WHERE a > 1`, `DELETE FROM t WHERE a > _`},
+		{`CREATE TABLE t (a INT PRIMARY KEY)`, `CREATE TABLE t (a INT PRIMARY KEY)`},
+	}
+	for _, d := range testData {
+		stmts, err := ParseTraditional(d.sql)
+		if err != nil {
+			t.Fatalf("%s: %v", d.sql, err)
+		}
+		before := stmts[0].String()
+		if f := Fingerprint(stmts[0]); d.expected != f {
+			t.Errorf("%s: expected %s, but found %s", d.sql, d.expected, f)
+		}
+		// The statement itself is unchanged.
+		if after := stmts[0].String(); before != after {
+			t.Errorf("%s: statement was modified: %s", d.sql, after)
+		}
+	}
+}
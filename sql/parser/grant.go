@@ -24,6 +24,7 @@
 package parser
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/cockroachdb/cockroach/sql/privilege"
@@ -31,9 +32,40 @@ import (
 
 // Grant represents a GRANT statement.
 type Grant struct {
-	Privileges privilege.List
-	Targets    TargetList
-	Grantees   NameList
+	Privileges       privilege.List
+	ColumnPrivileges ColumnPrivileges
+	Targets          TargetList
+	Grantees         NameList
+}
+
+// ColumnPrivilege represents a privilege on some columns of the targets.
+type ColumnPrivilege struct {
+	Privilege privilege.Kind
+	Columns   NameList
+}
+
+// ColumnPrivileges represents a list of column privileges.
+type ColumnPrivileges []ColumnPrivilege
+
+// privilegeSpec holds the privileges of a GRANT or REVOKE statement while
+// they are being parsed.
+type privilegeSpec struct {
+	privileges privilege.List
+	columns    ColumnPrivileges
+}
+
+// formatPrivileges formats the privileges on the targets followed by the
+// privileges on their columns.
+func formatPrivileges(privileges privilege.List, columns ColumnPrivileges) string {
+	var buf bytes.Buffer
+	buf.WriteString(privileges.String())
+	for _, c := range columns {
+		if buf.Len() > 0 {
+			buf.WriteString(", ")
+		}
+		fmt.Fprintf(&buf, "%s (%s)", c.Privilege, c.Columns)
+	}
+	return buf.String()
 }
 
 // TargetType represents the type of target.
@@ -70,7 +102,7 @@ func (tl TargetList) String() string {
 
 func (node *Grant) String() string {
 	return fmt.Sprintf("GRANT %s ON %s TO %v",
-		node.Privileges,
+		formatPrivileges(node.Privileges, node.ColumnPrivileges),
 		node.Targets,
 		node.Grantees)
 }
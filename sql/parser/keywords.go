@@ -16,6 +16,7 @@ var keywords = map[string]int{
 	"ASC":               ASC,
 	"ASYMMETRIC":        ASYMMETRIC,
 	"AT":                AT,
+	"BACKUP":            BACKUP,
 	"BEGIN":             BEGIN,
 	"BETWEEN":           BETWEEN,
 	"BIGINT":            BIGINT,
@@ -26,6 +27,7 @@ var keywords = map[string]int{
 	"BOTH":              BOTH,
 	"BY":                BY,
 	"BYTES":             BYTES,
+	"CANCEL":            CANCEL,
 	"CASCADE":           CASCADE,
 	"CASE":              CASE,
 	"CAST":              CAST,
@@ -39,11 +41,14 @@ var keywords = map[string]int{
 	"COLUMNS":           COLUMNS,
 	"COMMIT":            COMMIT,
 	"COMMITTED":         COMMITTED,
+	"CONFIGURATION":     CONFIGURATION,
+	"CONFIGURE":         CONFIGURE,
 	"CONFLICT":          CONFLICT,
 	"CONSTRAINT":        CONSTRAINT,
 	"COVERING":          COVERING,
 	"CREATE":            CREATE,
 	"CROSS":             CROSS,
+	"CSV":               CSV,
 	"CUBE":              CUBE,
 	"CURRENT":           CURRENT,
 	"CURRENT_CATALOG":   CURRENT_CATALOG,
@@ -93,7 +98,10 @@ var keywords = map[string]int{
 	"HOUR":              HOUR,
 	"IF":                IF,
 	"IFNULL":            IFNULL,
+	"IMPORT":            IMPORT,
+	"IMPORTS":           IMPORTS,
 	"IN":                IN,
+	"INCREMENTAL":       INCREMENTAL,
 	"INDEX":             INDEX,
 	"INITIALLY":         INITIALLY,
 	"INNER":             INNER,
@@ -147,19 +155,26 @@ var keywords = map[string]int{
 	"OVERLAY":           OVERLAY,
 	"PARTIAL":           PARTIAL,
 	"PARTITION":         PARTITION,
+	"PASSWORD":          PASSWORD,
 	"PLACING":           PLACING,
 	"POSITION":          POSITION,
 	"PRECEDING":         PRECEDING,
 	"PRECISION":         PRECISION,
 	"PRIMARY":           PRIMARY,
+	"PROTECTED":         PROTECTED,
+	"QUERIES":           QUERIES,
+	"QUERY":             QUERY,
 	"RANGE":             RANGE,
 	"READ":              READ,
 	"REAL":              REAL,
 	"RECURSIVE":         RECURSIVE,
 	"REF":               REF,
 	"REFERENCES":        REFERENCES,
+	"RELEASE":           RELEASE,
 	"RENAME":            RENAME,
 	"REPEATABLE":        REPEATABLE,
+	"RESET":             RESET,
+	"RESTORE":           RESTORE,
 	"RESTRICT":          RESTRICT,
 	"RETURNING":         RETURNING,
 	"REVOKE":            REVOKE,
@@ -182,17 +197,21 @@ var keywords = map[string]int{
 	"SNAPSHOT":          SNAPSHOT,
 	"SOME":              SOME,
 	"SQL":               SQL,
+	"STATEMENT":         STATEMENT,
+	"STATISTICS":        STATISTICS,
 	"STORING":           STORING,
 	"STRICT":            STRICT,
 	"STRING":            STRING,
 	"SUBSTRING":         SUBSTRING,
 	"SYMMETRIC":         SYMMETRIC,
+	"SYSTEM":            SYSTEM,
 	"TABLE":             TABLE,
 	"TABLES":            TABLES,
 	"TEXT":              TEXT,
 	"THEN":              THEN,
 	"TIME":              TIME,
 	"TIMESTAMP":         TIMESTAMP,
+	"TIMESTAMPS":        TIMESTAMPS,
 	"TO":                TO,
 	"TRAILING":          TRAILING,
 	"TRANSACTION":       TRANSACTION,
@@ -208,6 +227,7 @@ var keywords = map[string]int{
 	"UNKNOWN":           UNKNOWN,
 	"UPDATE":            UPDATE,
 	"USER":              USER,
+	"USERS":             USERS,
 	"USING":             USING,
 	"VALID":             VALID,
 	"VALIDATE":          VALIDATE,
@@ -87,10 +87,16 @@ func TestParse(t *testing.T) {
 		{`DROP TABLE a, b`},
 		{`DROP TABLE IF EXISTS a`},
 		{`DROP INDEX a.b@c`},
+		{`DROP GROUP a`},
+		{`DROP USER a`},
+		{`DROP USER a, b`},
+		{`DROP USER IF EXISTS a`},
+		{`DROP GROUP IF EXISTS a`},
 		{`DROP INDEX IF EXISTS a.b@c`},
 
 		{`EXPLAIN SELECT 1`},
 		{`EXPLAIN (DEBUG) SELECT 1`},
+		{`EXPLAIN (ANALYZE) SELECT 1`},
 		{`EXPLAIN (A, B, C) SELECT 1`},
 
 		{`SHOW BARFOO`},
@@ -98,6 +104,11 @@ func TestParse(t *testing.T) {
 		{`SHOW SYNTAX`},
 
 		{`SHOW DATABASES`},
+		{`SHOW IMPORTS`},
+		{`SHOW PROTECTED TIMESTAMPS`},
+		{`SHOW QUERIES`},
+		{`SHOW USERS`},
+		{`SHOW STATEMENT STATISTICS`},
 		{`SHOW TABLES`},
 		{`SHOW TABLES FROM a`},
 		{`SHOW TABLES FROM a.b.c`},
@@ -117,6 +128,11 @@ func TestParse(t *testing.T) {
 		{`SHOW GRANTS FOR bar, baz`},
 
 		{`SHOW TRANSACTION ISOLATION LEVEL`},
+		{`SHOW TABLE STATISTICS FROM a`},
+		{`SHOW TABLE STATISTICS FROM a.b`},
+		{`SHOW ZONE CONFIGURATION FOR TABLE a`},
+		{`SHOW ZONE CONFIGURATION FOR TABLE a.b`},
+		{`SHOW ZONE CONFIGURATION FOR DATABASE a`},
 
 		// Tables are the default, but can also be specified with
 		// GRANT x ON TABLE y. However, the stringer does not output TABLE.
@@ -127,6 +143,10 @@ func TestParse(t *testing.T) {
 		{`GRANT SELECT, INSERT ON DATABASE bar TO foo, bar, baz`},
 		{`GRANT SELECT, INSERT ON DATABASE db1, db2 TO foo, bar, baz`},
 		{`GRANT SELECT, INSERT ON DATABASE db1, db2 TO "test-user"`},
+		{`GRANT SELECT ON db.* TO root`},
+		{`GRANT SELECT ON db1.*, db2.foo TO root`},
+		{`GRANT SELECT (a, b) ON foo TO root`},
+		{`GRANT SELECT, INSERT (a), UPDATE (b, c) ON foo TO root`},
 
 		// Tables are the default, but can also be specified with
 		// REVOKE x ON TABLE y. However, the stringer does not output TABLE.
@@ -136,6 +156,39 @@ func TestParse(t *testing.T) {
 		{`REVOKE ALL ON DATABASE foo FROM root, test`},
 		{`REVOKE SELECT, INSERT ON DATABASE bar FROM foo, bar, baz`},
 		{`REVOKE SELECT, INSERT ON DATABASE db1, db2 FROM foo, bar, baz`},
+		{`REVOKE SELECT ON db.* FROM root`},
+		{`REVOKE UPDATE (b) ON foo FROM root`},
+
+		{`ALTER GROUP a ADD USER b`},
+		{`ALTER GROUP a ADD USER b, c`},
+		{`ALTER GROUP a DROP USER b, c`},
+
+		{`CREATE USER a`},
+		{`CREATE USER IF NOT EXISTS a`},
+		{`CREATE USER a WITH PASSWORD 'b'`},
+		{`CREATE USER a WITH PASSWORD $1`},
+		{`ALTER USER a WITH PASSWORD 'b'`},
+		{`ALTER USER a WITH PASSWORD NULL`},
+
+		{`ALTER TABLE a CONFIGURE ZONE 'num_replicas: 5'`},
+		{`ALTER TABLE a.b CONFIGURE ZONE $1`},
+		{`ALTER TABLE a.b CONFIGURE ZONE NULL`},
+		{`ALTER DATABASE a CONFIGURE ZONE 'gc: {ttlseconds: 60}'`},
+
+		{`BACKUP TO 'a'`},
+		{`BACKUP a, b.c TO 'd'`},
+		{`BACKUP DATABASE a, b TO 'c'`},
+		{`BACKUP DATABASE a TO 'b' AS OF SYSTEM TIME '-1s'`},
+		{`BACKUP DATABASE a TO 'b' INCREMENTAL FROM 'c', 'd'`},
+		{`BACKUP TO $1 AS OF SYSTEM TIME $2 INCREMENTAL FROM $3`},
+		{`RESTORE FROM 'a'`},
+		{`RESTORE a, b.c FROM 'd', 'e'`},
+		{`RESTORE DATABASE a FROM 'b'`},
+		{`RESTORE DATABASE a FROM $1, $2`},
+
+		{`IMPORT TABLE a (b INT PRIMARY KEY, c STRING) CSV DATA ('d')`},
+		{`IMPORT TABLE a.b (c INT, d STRING, INDEX (d)) CSV DATA ('e', 'f') WITH delimiter = '|'`},
+		{`IMPORT TABLE a (b INT) CSV DATA ($1) WITH nullif = '', skip = 1, comment = '#'`},
 
 		{`INSERT INTO a VALUES (1)`},
 		{`INSERT INTO a.b VALUES (1)`},
@@ -215,6 +268,10 @@ func TestParse(t *testing.T) {
 		{`SELECT FROM (SELECT 1 FROM t) AS bar`},
 		{`SELECT FROM t1, t2`},
 		{`SELECT FROM t AS t1`},
+		{`SELECT a FROM t AS OF SYSTEM TIME '2015-11-05 12:00:00'`},
+		{`SELECT a FROM t AS OF SYSTEM TIME CAST('-10s' AS INTERVAL) WHERE a > 1`},
+		{`SELECT DISTINCT a FROM t AS t1 AS OF SYSTEM TIME $1`},
+		{`SELECT a AS of FROM t AS of`},
 		{`SELECT FROM s.t`},
 
 		{`SELECT COUNT(DISTINCT a) FROM t`},
@@ -315,6 +372,13 @@ func TestParse(t *testing.T) {
 		{`SET a = 3`},
 		{`SET a = 3, 4`},
 		{`SET a = '3'`},
+		{`SET statement_timeout = '5s'`},
+
+		{`CANCEL QUERY 3`},
+		{`CANCEL QUERY $1`},
+		{`RELEASE PROTECTED TIMESTAMP 3`},
+		{`RELEASE PROTECTED TIMESTAMP $1`},
+		{`RESET STATEMENT STATISTICS`},
 		{`SET a = 3.0`},
 		{`SET a = $1`},
 		{`SET TRANSACTION ISOLATION LEVEL SNAPSHOT`},
@@ -371,6 +435,16 @@ func TestParse(t *testing.T) {
 		{`ALTER TABLE a DROP COLUMN IF EXISTS b, DROP CONSTRAINT a_idx`},
 		{`ALTER TABLE IF EXISTS a DROP COLUMN b, DROP CONSTRAINT a_idx`},
 		{`ALTER TABLE IF EXISTS a DROP COLUMN IF EXISTS b, DROP CONSTRAINT a_idx`},
+
+		{`ALTER TABLE a ALTER b SET DEFAULT 42`},
+		{`ALTER TABLE a ALTER b SET DEFAULT NULL`},
+		{`ALTER TABLE a ALTER COLUMN b SET DEFAULT now()`},
+		{`ALTER TABLE a ALTER b DROP DEFAULT`},
+		{`ALTER TABLE a ALTER COLUMN b DROP DEFAULT`},
+		{`ALTER TABLE a ALTER b DROP NOT NULL`},
+		{`ALTER TABLE a ALTER COLUMN b DROP NOT NULL`},
+		{`ALTER TABLE a ALTER b SET NOT NULL`},
+		{`ALTER TABLE a ALTER COLUMN b SET NOT NULL, ALTER c DROP DEFAULT`},
 	}
 	for _, d := range testData {
 		stmts, err := ParseTraditional(d.sql)
@@ -393,10 +467,23 @@ func TestParse2(t *testing.T) {
 		expected string
 	}{
 		{`CREATE INDEX ON a (b ASC, c DESC)`, `CREATE INDEX ON a (b, c)`},
+		{`SELECT a FROM t AS OF SYSTEM TIME INTERVAL '-10s'`,
+			`SELECT a FROM t AS OF SYSTEM TIME CAST('-10s' AS INTERVAL)`},
 		{`CREATE TABLE a (b INT, UNIQUE INDEX foo (b))`,
 			`CREATE TABLE a (b INT, CONSTRAINT foo UNIQUE (b))`},
 		{`CREATE INDEX ON a (b) COVERING (c)`, `CREATE INDEX ON a (b) STORING (c)`},
 
+		{`GRANT SELECT ON ALL TABLES IN DATABASE db1, db2 TO root`,
+			`GRANT SELECT ON db1.*, db2.* TO root`},
+		{`REVOKE SELECT ON ALL TABLES IN DATABASE db FROM root`,
+			`REVOKE SELECT ON db.* FROM root`},
+		{`GRANT SELECT (a), INSERT ON foo TO root`, `GRANT INSERT, SELECT (a) ON foo TO root`},
+		{`BACKUP TABLE a TO 'b'`, `BACKUP a TO 'b'`},
+		{`RESTORE TABLE a FROM 'b'`, `RESTORE a FROM 'b'`},
+
+		{`EXPLAIN ANALYZE SELECT 1`, `EXPLAIN (ANALYZE) SELECT 1`},
+		{`EXPLAIN ANALYZE DELETE FROM a`, `EXPLAIN (ANALYZE) DELETE FROM a`},
+
 		{`SELECT BOOL 'foo'`, `SELECT CAST('foo' AS BOOL)`},
 		{`SELECT INT 'foo'`, `SELECT CAST('foo' AS INT)`},
 		{`SELECT REAL 'foo'`, `SELECT CAST('foo' AS REAL)`},
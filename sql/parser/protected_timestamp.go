@@ -0,0 +1,35 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package parser
+
+import "fmt"
+
+// ShowProtectedTimestamps represents a SHOW PROTECTED TIMESTAMPS statement.
+type ShowProtectedTimestamps struct{}
+
+func (node *ShowProtectedTimestamps) String() string {
+	return "SHOW PROTECTED TIMESTAMPS"
+}
+
+// ReleaseProtectedTimestamp represents a RELEASE PROTECTED TIMESTAMP
+// statement.
+type ReleaseProtectedTimestamp struct {
+	ID Expr
+}
+
+func (node *ReleaseProtectedTimestamp) String() string {
+	return fmt.Sprintf("RELEASE PROTECTED TIMESTAMP %s", node.ID)
+}
@@ -30,16 +30,17 @@ import (
 )
 
 // Revoke represents a REVOKE statements.
-// PrivilegeList and TargetList are defined in grant.go
+// ColumnPrivileges and TargetList are defined in grant.go
 type Revoke struct {
-	Privileges privilege.List
-	Targets    TargetList
-	Grantees   NameList
+	Privileges       privilege.List
+	ColumnPrivileges ColumnPrivileges
+	Targets          TargetList
+	Grantees         NameList
 }
 
 func (node *Revoke) String() string {
 	return fmt.Sprintf("REVOKE %s ON %s FROM %v",
-		node.Privileges,
+		formatPrivileges(node.Privileges, node.ColumnPrivileges),
 		node.Targets,
 		node.Grantees)
 }
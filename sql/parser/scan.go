@@ -32,9 +32,8 @@ const singleQuote = '\''
 type scanner struct {
 	in          string
 	pos         int
-	tokBuf      sqlSymType
 	lastTok     sqlSymType
-	nextTok     *sqlSymType
+	lookahead   []sqlSymType
 	lastError   string
 	stmts       []Statement
 	identQuote  int
@@ -51,6 +50,7 @@ func makeScanner(str string, syntax Syntax) scanner {
 func (s *scanner) init(str string, syntax Syntax) {
 	s.in = str
 	s.syntax = syntax
+	s.lookahead = nil
 	switch syntax {
 	case Traditional:
 		s.identQuote = '"'
@@ -67,41 +67,53 @@ func (s *scanner) Lex(lval *sqlSymType) int {
 	// cases. These special cases are handled below and the returned tokens are
 	// adjusted to reflect the lookahead (LA) that occurred.
 
-	if s.nextTok != nil {
-		*lval = *s.nextTok
-		s.nextTok = nil
+	if len(s.lookahead) > 0 {
+		*lval = s.lookahead[0]
+		s.lookahead = s.lookahead[1:]
 	} else {
 		s.scan(lval)
 	}
 
 	switch lval.id {
-	case NOT, NULLS, WITH:
+	case NOT, NULLS, WITH, AS:
 	default:
 		s.lastTok = *lval
 		return lval.id
 	}
 
-	s.nextTok = &s.tokBuf
-	s.scan(s.nextTok)
-
 	switch lval.id {
 	case NOT:
-		switch s.nextTok.id {
+		switch s.peekToken(0).id {
 		case BETWEEN, IN, LIKE, SIMILAR:
 			lval.id = NOT_LA
 		}
 
 	case WITH:
-		switch s.nextTok.id {
+		switch s.peekToken(0).id {
 		case TIME, ORDINALITY:
 			lval.id = WITH_LA
 		}
+
+	case AS:
+		if s.peekToken(0).id == OF && s.peekToken(1).id == SYSTEM {
+			lval.id = AS_LA
+		}
 	}
 
 	s.lastTok = *lval
 	return lval.id
 }
 
+// peekToken returns the i-th token following the last token returned by Lex,
+// scanning it if necessary.
+func (s *scanner) peekToken(i int) *sqlSymType {
+	for len(s.lookahead) <= i {
+		s.lookahead = append(s.lookahead, sqlSymType{})
+		s.scan(&s.lookahead[len(s.lookahead)-1])
+	}
+	return &s.lookahead[i]
+}
+
 func (s *scanner) Error(e string) {
 	var buf bytes.Buffer
 	if s.lastTok.id == ERROR {
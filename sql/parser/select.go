@@ -53,6 +53,7 @@ type Select struct {
 	Distinct    bool
 	Exprs       SelectExprs
 	From        TableExprs
+	AsOf        AsOfClause
 	Where       *Where
 	GroupBy     GroupBy
 	Having      *Where
@@ -70,9 +71,9 @@ func (node *Select) String() string {
 	if node.Distinct {
 		distinct = " DISTINCT"
 	}
-	return fmt.Sprintf("SELECT%s%s%s%s%s%s%s%s%s",
+	return fmt.Sprintf("SELECT%s%s%s%s%s%s%s%s%s%s",
 		distinct, node.Exprs,
-		node.From, node.Where,
+		node.From, node.AsOf, node.Where,
 		node.GroupBy, node.Having, node.OrderBy,
 		node.Limit, node.Lock)
 }
@@ -129,6 +130,19 @@ func (node TableExprs) String() string {
 	return buf.String()
 }
 
+// AsOfClause represents an AS OF SYSTEM TIME clause, which makes a SELECT
+// read the data as of the specified timestamp.
+type AsOfClause struct {
+	Expr Expr
+}
+
+func (node AsOfClause) String() string {
+	if node.Expr == nil {
+		return ""
+	}
+	return fmt.Sprintf(" AS OF SYSTEM TIME %s", node.Expr)
+}
+
 // TableExpr represents a table expression.
 type TableExpr interface {
 	tableExpr()
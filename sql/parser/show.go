@@ -66,6 +66,31 @@ func (node *ShowIndex) String() string {
 	return fmt.Sprintf("SHOW INDEX FROM %s", node.Table)
 }
 
+// ShowQueries represents a SHOW QUERIES statement.
+type ShowQueries struct {
+}
+
+func (node *ShowQueries) String() string {
+	return "SHOW QUERIES"
+}
+
+// ShowStatementStatistics represents a SHOW STATEMENT STATISTICS statement.
+type ShowStatementStatistics struct {
+}
+
+func (node *ShowStatementStatistics) String() string {
+	return "SHOW STATEMENT STATISTICS"
+}
+
+// ShowTableStatistics represents a SHOW TABLE STATISTICS statement.
+type ShowTableStatistics struct {
+	Table *QualifiedName
+}
+
+func (node *ShowTableStatistics) String() string {
+	return fmt.Sprintf("SHOW TABLE STATISTICS FROM %s", node.Table)
+}
+
 // ShowTables represents a SHOW TABLES statement.
 type ShowTables struct {
 	Name *QualifiedName
@@ -80,6 +105,14 @@ func (node *ShowTables) String() string {
 	return buf.String()
 }
 
+// ShowUsers represents a SHOW USERS statement.
+type ShowUsers struct {
+}
+
+func (node *ShowUsers) String() string {
+	return "SHOW USERS"
+}
+
 // ShowGrants represents a SHOW GRANTS statement.
 // TargetList is defined in grant.go.
 type ShowGrants struct {
@@ -52,6 +52,7 @@ type sqlSymType struct {
 	targetListPtr  *TargetList
 	privilegeType  privilege.Kind
 	privilegeList  privilege.List
+	privSpec       privilegeSpec
 	orderBy        OrderBy
 	orders         []*Order
 	order          *Order
@@ -60,6 +61,8 @@ type sqlSymType struct {
 	alterTableCmd  AlterTableCmd
 	alterTableCmds AlterTableCmds
 	isoLevel       IsolationLevel
+	kvOption       KVOption
+	kvOptions      KVOptions
 }
 
 const IDENT = 57346
@@ -87,222 +90,243 @@ const AS = 57367
 const ASC = 57368
 const ASYMMETRIC = 57369
 const AT = 57370
-const BEGIN = 57371
-const BETWEEN = 57372
-const BIGINT = 57373
-const BIT = 57374
-const BLOB = 57375
-const BOOL = 57376
-const BOOLEAN = 57377
-const BOTH = 57378
-const BY = 57379
-const BYTES = 57380
-const CASCADE = 57381
-const CASE = 57382
-const CAST = 57383
-const CHAR = 57384
-const CHARACTER = 57385
-const CHECK = 57386
-const COALESCE = 57387
-const COLLATE = 57388
-const COLLATION = 57389
-const COLUMN = 57390
-const COLUMNS = 57391
-const COMMIT = 57392
-const COMMITTED = 57393
-const CONCAT = 57394
-const CONFLICT = 57395
-const CONSTRAINT = 57396
-const COVERING = 57397
-const CREATE = 57398
-const CROSS = 57399
-const CUBE = 57400
-const CURRENT = 57401
-const CURRENT_CATALOG = 57402
-const CURRENT_DATE = 57403
-const CURRENT_ROLE = 57404
-const CURRENT_TIME = 57405
-const CURRENT_TIMESTAMP = 57406
-const CURRENT_USER = 57407
-const CYCLE = 57408
-const DATA = 57409
-const DATABASE = 57410
-const DATABASES = 57411
-const DATE = 57412
-const DAY = 57413
-const DEC = 57414
-const DECIMAL = 57415
-const DEFAULT = 57416
-const DEFERRABLE = 57417
-const DELETE = 57418
-const DESC = 57419
-const DISTINCT = 57420
-const DO = 57421
-const DOUBLE = 57422
-const DROP = 57423
-const ELSE = 57424
-const END = 57425
-const ESCAPE = 57426
-const EXCEPT = 57427
-const EXISTS = 57428
-const EXPLAIN = 57429
-const EXTRACT = 57430
-const FALSE = 57431
-const FETCH = 57432
-const FILTER = 57433
-const FIRST = 57434
-const FLOAT = 57435
-const FOLLOWING = 57436
-const FOR = 57437
-const FOREIGN = 57438
-const FROM = 57439
-const FULL = 57440
-const GRANT = 57441
-const GRANTS = 57442
-const GREATEST = 57443
-const GROUP = 57444
-const GROUPING = 57445
-const HAVING = 57446
-const HOUR = 57447
-const IF = 57448
-const IFNULL = 57449
-const IN = 57450
-const INDEX = 57451
-const INITIALLY = 57452
-const INNER = 57453
-const INSERT = 57454
-const INT = 57455
-const INT64 = 57456
-const INTEGER = 57457
-const INTERSECT = 57458
-const INTERVAL = 57459
-const INTO = 57460
-const IS = 57461
-const ISOLATION = 57462
-const JOIN = 57463
-const KEY = 57464
-const LATERAL = 57465
-const LEADING = 57466
-const LEAST = 57467
-const LEFT = 57468
-const LEVEL = 57469
-const LIKE = 57470
-const LIMIT = 57471
-const LOCAL = 57472
-const LOCALTIME = 57473
-const LOCALTIMESTAMP = 57474
-const LSHIFT = 57475
-const MATCH = 57476
-const MINUTE = 57477
-const MONTH = 57478
-const NAME = 57479
-const NAMES = 57480
-const NATURAL = 57481
-const NEXT = 57482
-const NO = 57483
-const NOT = 57484
-const NOTHING = 57485
-const NULL = 57486
-const NULLIF = 57487
-const NULLS = 57488
-const NUMERIC = 57489
-const OF = 57490
-const OFF = 57491
-const OFFSET = 57492
-const ON = 57493
-const ONLY = 57494
-const OR = 57495
-const ORDER = 57496
-const ORDINALITY = 57497
-const OUT = 57498
-const OUTER = 57499
-const OVER = 57500
-const OVERLAPS = 57501
-const OVERLAY = 57502
-const PARTIAL = 57503
-const PARTITION = 57504
-const PLACING = 57505
-const POSITION = 57506
-const PRECEDING = 57507
-const PRECISION = 57508
-const PRIMARY = 57509
-const RANGE = 57510
-const READ = 57511
-const REAL = 57512
-const RECURSIVE = 57513
-const REF = 57514
-const REFERENCES = 57515
-const RENAME = 57516
-const REPEATABLE = 57517
-const RESTRICT = 57518
-const RETURNING = 57519
-const REVOKE = 57520
-const RIGHT = 57521
-const ROLLBACK = 57522
-const ROLLUP = 57523
-const ROW = 57524
-const ROWS = 57525
-const RSHIFT = 57526
-const SEARCH = 57527
-const SECOND = 57528
-const SELECT = 57529
-const SERIALIZABLE = 57530
-const SESSION = 57531
-const SESSION_USER = 57532
-const SET = 57533
-const SHOW = 57534
-const SIMILAR = 57535
-const SIMPLE = 57536
-const SMALLINT = 57537
-const SNAPSHOT = 57538
-const SOME = 57539
-const SQL = 57540
-const STRICT = 57541
-const STRING = 57542
-const STORING = 57543
-const SUBSTRING = 57544
-const SYMMETRIC = 57545
-const TABLE = 57546
-const TABLES = 57547
-const TEXT = 57548
-const THEN = 57549
-const TIME = 57550
-const TIMESTAMP = 57551
-const TO = 57552
-const TRAILING = 57553
-const TRANSACTION = 57554
-const TREAT = 57555
-const TRIM = 57556
-const TRUE = 57557
-const TRUNCATE = 57558
-const TYPE = 57559
-const UNBOUNDED = 57560
-const UNCOMMITTED = 57561
-const UNION = 57562
-const UNIQUE = 57563
-const UNKNOWN = 57564
-const UPDATE = 57565
-const USER = 57566
-const USING = 57567
-const VALID = 57568
-const VALIDATE = 57569
-const VALUE = 57570
-const VALUES = 57571
-const VARCHAR = 57572
-const VARIADIC = 57573
-const VARYING = 57574
-const WHEN = 57575
-const WHERE = 57576
-const WINDOW = 57577
-const WITH = 57578
-const WITHIN = 57579
-const WITHOUT = 57580
-const YEAR = 57581
-const ZONE = 57582
-const NOT_LA = 57583
-const WITH_LA = 57584
-const POSTFIXOP = 57585
-const UMINUS = 57586
+const BACKUP = 57371
+const BEGIN = 57372
+const BETWEEN = 57373
+const BIGINT = 57374
+const BIT = 57375
+const BLOB = 57376
+const BOOL = 57377
+const BOOLEAN = 57378
+const BOTH = 57379
+const BY = 57380
+const BYTES = 57381
+const CANCEL = 57382
+const CASCADE = 57383
+const CASE = 57384
+const CAST = 57385
+const CHAR = 57386
+const CHARACTER = 57387
+const CHECK = 57388
+const COALESCE = 57389
+const COLLATE = 57390
+const COLLATION = 57391
+const COLUMN = 57392
+const COLUMNS = 57393
+const COMMIT = 57394
+const COMMITTED = 57395
+const CONCAT = 57396
+const CONFIGURATION = 57397
+const CONFIGURE = 57398
+const CONFLICT = 57399
+const CONSTRAINT = 57400
+const COVERING = 57401
+const CREATE = 57402
+const CROSS = 57403
+const CSV = 57404
+const CUBE = 57405
+const CURRENT = 57406
+const CURRENT_CATALOG = 57407
+const CURRENT_DATE = 57408
+const CURRENT_ROLE = 57409
+const CURRENT_TIME = 57410
+const CURRENT_TIMESTAMP = 57411
+const CURRENT_USER = 57412
+const CYCLE = 57413
+const DATA = 57414
+const DATABASE = 57415
+const DATABASES = 57416
+const DATE = 57417
+const DAY = 57418
+const DEC = 57419
+const DECIMAL = 57420
+const DEFAULT = 57421
+const DEFERRABLE = 57422
+const DELETE = 57423
+const DESC = 57424
+const DISTINCT = 57425
+const DO = 57426
+const DOUBLE = 57427
+const DROP = 57428
+const ELSE = 57429
+const END = 57430
+const ESCAPE = 57431
+const EXCEPT = 57432
+const EXISTS = 57433
+const EXPLAIN = 57434
+const EXTRACT = 57435
+const FALSE = 57436
+const FETCH = 57437
+const FILTER = 57438
+const FIRST = 57439
+const FLOAT = 57440
+const FOLLOWING = 57441
+const FOR = 57442
+const FOREIGN = 57443
+const FROM = 57444
+const FULL = 57445
+const GRANT = 57446
+const GRANTS = 57447
+const GREATEST = 57448
+const GROUP = 57449
+const GROUPING = 57450
+const HAVING = 57451
+const HOUR = 57452
+const IF = 57453
+const IFNULL = 57454
+const IMPORT = 57455
+const IMPORTS = 57456
+const IN = 57457
+const INCREMENTAL = 57458
+const INDEX = 57459
+const INITIALLY = 57460
+const INNER = 57461
+const INSERT = 57462
+const INT = 57463
+const INT64 = 57464
+const INTEGER = 57465
+const INTERSECT = 57466
+const INTERVAL = 57467
+const INTO = 57468
+const IS = 57469
+const ISOLATION = 57470
+const JOIN = 57471
+const KEY = 57472
+const LATERAL = 57473
+const LEADING = 57474
+const LEAST = 57475
+const LEFT = 57476
+const LEVEL = 57477
+const LIKE = 57478
+const LIMIT = 57479
+const LOCAL = 57480
+const LOCALTIME = 57481
+const LOCALTIMESTAMP = 57482
+const LSHIFT = 57483
+const MATCH = 57484
+const MINUTE = 57485
+const MONTH = 57486
+const NAME = 57487
+const NAMES = 57488
+const NATURAL = 57489
+const NEXT = 57490
+const NO = 57491
+const NOT = 57492
+const NOTHING = 57493
+const NULL = 57494
+const NULLIF = 57495
+const NULLS = 57496
+const NUMERIC = 57497
+const OF = 57498
+const OFF = 57499
+const OFFSET = 57500
+const ON = 57501
+const ONLY = 57502
+const OR = 57503
+const ORDER = 57504
+const ORDINALITY = 57505
+const OUT = 57506
+const OUTER = 57507
+const OVER = 57508
+const OVERLAPS = 57509
+const OVERLAY = 57510
+const PARTIAL = 57511
+const PARTITION = 57512
+const PASSWORD = 57513
+const PLACING = 57514
+const POSITION = 57515
+const PRECEDING = 57516
+const PRECISION = 57517
+const PRIMARY = 57518
+const PROTECTED = 57519
+const QUERIES = 57520
+const QUERY = 57521
+const RANGE = 57522
+const READ = 57523
+const REAL = 57524
+const RECURSIVE = 57525
+const REF = 57526
+const REFERENCES = 57527
+const RELEASE = 57528
+const RENAME = 57529
+const REPEATABLE = 57530
+const RESET = 57531
+const RESTORE = 57532
+const RESTRICT = 57533
+const RETURNING = 57534
+const REVOKE = 57535
+const RIGHT = 57536
+const ROLLBACK = 57537
+const ROLLUP = 57538
+const ROW = 57539
+const ROWS = 57540
+const RSHIFT = 57541
+const SEARCH = 57542
+const SECOND = 57543
+const SELECT = 57544
+const SERIALIZABLE = 57545
+const SESSION = 57546
+const SESSION_USER = 57547
+const SET = 57548
+const SHOW = 57549
+const SIMILAR = 57550
+const SIMPLE = 57551
+const SMALLINT = 57552
+const SNAPSHOT = 57553
+const SOME = 57554
+const SQL = 57555
+const STATEMENT = 57556
+const STATISTICS = 57557
+const STRICT = 57558
+const STRING = 57559
+const STORING = 57560
+const SUBSTRING = 57561
+const SYMMETRIC = 57562
+const SYSTEM = 57563
+const TABLE = 57564
+const TABLES = 57565
+const TEXT = 57566
+const THEN = 57567
+const TIME = 57568
+const TIMESTAMP = 57569
+const TIMESTAMPS = 57570
+const TO = 57571
+const TRAILING = 57572
+const TRANSACTION = 57573
+const TREAT = 57574
+const TRIM = 57575
+const TRUE = 57576
+const TRUNCATE = 57577
+const TYPE = 57578
+const UNBOUNDED = 57579
+const UNCOMMITTED = 57580
+const UNION = 57581
+const UNIQUE = 57582
+const UNKNOWN = 57583
+const UPDATE = 57584
+const USER = 57585
+const USERS = 57586
+const USING = 57587
+const VALID = 57588
+const VALIDATE = 57589
+const VALUE = 57590
+const VALUES = 57591
+const VARCHAR = 57592
+const VARIADIC = 57593
+const VARYING = 57594
+const WHEN = 57595
+const WHERE = 57596
+const WINDOW = 57597
+const WITH = 57598
+const WITHIN = 57599
+const WITHOUT = 57600
+const YEAR = 57601
+const ZONE = 57602
+const NOT_LA = 57603
+const WITH_LA = 57604
+const AS_LA = 57605
+const POSTFIXOP = 57606
+const UMINUS = 57607
 
 var sqlToknames = [...]string{
 	"$end",
@@ -333,6 +357,7 @@ var sqlToknames = [...]string{
 	"ASC",
 	"ASYMMETRIC",
 	"AT",
+	"BACKUP",
 	"BEGIN",
 	"BETWEEN",
 	"BIGINT",
@@ -343,6 +368,7 @@ var sqlToknames = [...]string{
 	"BOTH",
 	"BY",
 	"BYTES",
+	"CANCEL",
 	"CASCADE",
 	"CASE",
 	"CAST",
@@ -357,11 +383,14 @@ var sqlToknames = [...]string{
 	"COMMIT",
 	"COMMITTED",
 	"CONCAT",
+	"CONFIGURATION",
+	"CONFIGURE",
 	"CONFLICT",
 	"CONSTRAINT",
 	"COVERING",
 	"CREATE",
 	"CROSS",
+	"CSV",
 	"CUBE",
 	"CURRENT",
 	"CURRENT_CATALOG",
@@ -412,7 +441,10 @@ var sqlToknames = [...]string{
 	"HOUR",
 	"IF",
 	"IFNULL",
+	"IMPORT",
+	"IMPORTS",
 	"IN",
+	"INCREMENTAL",
 	"INDEX",
 	"INITIALLY",
 	"INNER",
@@ -467,19 +499,26 @@ var sqlToknames = [...]string{
 	"OVERLAY",
 	"PARTIAL",
 	"PARTITION",
+	"PASSWORD",
 	"PLACING",
 	"POSITION",
 	"PRECEDING",
 	"PRECISION",
 	"PRIMARY",
+	"PROTECTED",
+	"QUERIES",
+	"QUERY",
 	"RANGE",
 	"READ",
 	"REAL",
 	"RECURSIVE",
 	"REF",
 	"REFERENCES",
+	"RELEASE",
 	"RENAME",
 	"REPEATABLE",
+	"RESET",
+	"RESTORE",
 	"RESTRICT",
 	"RETURNING",
 	"REVOKE",
@@ -503,17 +542,21 @@ var sqlToknames = [...]string{
 	"SNAPSHOT",
 	"SOME",
 	"SQL",
+	"STATEMENT",
+	"STATISTICS",
 	"STRICT",
 	"STRING",
 	"STORING",
 	"SUBSTRING",
 	"SYMMETRIC",
+	"SYSTEM",
 	"TABLE",
 	"TABLES",
 	"TEXT",
 	"THEN",
 	"TIME",
 	"TIMESTAMP",
+	"TIMESTAMPS",
 	"TO",
 	"TRAILING",
 	"TRANSACTION",
@@ -529,6 +572,7 @@ var sqlToknames = [...]string{
 	"UNKNOWN",
 	"UPDATE",
 	"USER",
+	"USERS",
 	"USING",
 	"VALID",
 	"VALIDATE",
@@ -547,6 +591,7 @@ var sqlToknames = [...]string{
 	"ZONE",
 	"NOT_LA",
 	"WITH_LA",
+	"AS_LA",
 	"'<'",
 	"'>'",
 	"'='",
@@ -578,2163 +623,2425 @@ const sqlEofCode = 1
 const sqlErrCode = 2
 const sqlInitialStackSize = 16
 
-//line sql.y:3774
+//line sql.y:4067
 
 //line yacctab:1
 var sqlExca = [...]int{
 	-1, 0,
-	1, 19,
-	263, 19,
-	-2, 289,
+	1, 28,
+	284, 28,
+	-2, 340,
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 29,
-	1, 260,
-	151, 260,
-	261, 260,
-	263, 260,
-	-2, 270,
-	-1, 38,
-	1, 263,
-	151, 263,
-	261, 263,
-	263, 263,
-	-2, 269,
-	-1, 47,
-	1, 19,
-	263, 19,
-	-2, 289,
-	-1, 83,
-	1, 127,
-	263, 127,
-	-2, 738,
-	-1, 234,
-	129, 299,
-	150, 299,
-	-2, 266,
-	-1, 237,
-	129, 298,
-	150, 298,
-	-2, 264,
-	-1, 339,
-	129, 298,
-	150, 298,
-	-2, 267,
-	-1, 396,
-	260, 688,
-	-2, 683,
-	-1, 397,
-	260, 689,
-	-2, 684,
-	-1, 403,
-	6, 417,
-	260, 417,
-	-2, 811,
-	-1, 425,
-	6, 387,
-	-2, 790,
-	-1, 426,
-	6, 414,
-	260, 414,
-	-2, 791,
-	-1, 427,
-	6, 395,
-	-2, 792,
-	-1, 428,
-	6, 394,
-	-2, 793,
-	-1, 429,
-	6, 414,
-	260, 414,
-	-2, 795,
-	-1, 430,
-	6, 414,
-	260, 414,
-	-2, 796,
-	-1, 431,
-	6, 415,
-	-2, 798,
-	-1, 432,
-	6, 382,
+	-1, 45,
+	1, 311,
+	159, 311,
+	282, 311,
+	284, 311,
+	-2, 321,
+	-1, 54,
+	1, 314,
+	159, 314,
+	282, 314,
+	284, 314,
+	-2, 320,
+	-1, 63,
+	1, 28,
+	284, 28,
+	-2, 340,
+	-1, 270,
+	1, 164,
+	284, 164,
 	-2, 799,
-	-1, 433,
-	6, 382,
-	-2, 800,
-	-1, 434,
-	6, 397,
-	-2, 803,
-	-1, 435,
-	6, 383,
-	-2, 808,
-	-1, 436,
-	6, 384,
-	-2, 809,
-	-1, 437,
-	6, 385,
-	-2, 810,
-	-1, 438,
-	6, 382,
-	-2, 814,
-	-1, 439,
-	6, 388,
-	-2, 819,
-	-1, 440,
-	6, 386,
-	-2, 821,
-	-1, 441,
-	6, 416,
-	-2, 825,
-	-1, 442,
-	6, 412,
-	260, 412,
-	-2, 829,
-	-1, 683,
-	85, 270,
-	116, 270,
-	129, 270,
-	150, 270,
-	154, 270,
-	220, 270,
-	-2, 519,
-	-1, 691,
-	260, 668,
-	-2, 662,
-	-1, 876,
+	-1, 302,
+	137, 350,
+	158, 350,
+	-2, 317,
+	-1, 305,
+	137, 349,
+	158, 349,
+	-2, 315,
+	-1, 373,
+	281, 741,
+	-2, 736,
+	-1, 374,
+	281, 742,
+	-2, 737,
+	-1, 380,
+	6, 470,
+	281, 470,
+	-2, 884,
+	-1, 402,
+	6, 440,
+	-2, 863,
+	-1, 403,
+	6, 467,
+	281, 467,
+	-2, 864,
+	-1, 404,
+	6, 448,
+	-2, 865,
+	-1, 405,
+	6, 447,
+	-2, 866,
+	-1, 406,
+	6, 467,
+	281, 467,
+	-2, 868,
+	-1, 407,
+	6, 467,
+	281, 467,
+	-2, 869,
+	-1, 408,
+	6, 468,
+	-2, 871,
+	-1, 409,
+	6, 435,
+	-2, 872,
+	-1, 410,
+	6, 435,
+	-2, 873,
+	-1, 411,
+	6, 450,
+	-2, 876,
+	-1, 412,
+	6, 436,
+	-2, 881,
+	-1, 413,
+	6, 437,
+	-2, 882,
+	-1, 414,
+	6, 438,
+	-2, 883,
+	-1, 415,
+	6, 435,
+	-2, 887,
+	-1, 416,
+	6, 441,
+	-2, 892,
+	-1, 417,
+	6, 439,
+	-2, 894,
+	-1, 418,
+	6, 469,
+	-2, 898,
+	-1, 419,
+	6, 465,
+	281, 465,
+	-2, 902,
+	-1, 521,
+	137, 349,
+	158, 349,
+	-2, 318,
+	-1, 608,
+	90, 321,
+	124, 321,
+	137, 321,
+	158, 321,
+	162, 321,
+	239, 321,
+	-2, 572,
+	-1, 616,
+	281, 721,
+	-2, 715,
+	-1, 924,
 	12, 0,
 	13, 0,
 	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 450,
-	-1, 877,
+	264, 0,
+	265, 0,
+	266, 0,
+	-2, 503,
+	-1, 925,
 	12, 0,
 	13, 0,
 	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 451,
-	-1, 878,
+	264, 0,
+	265, 0,
+	266, 0,
+	-2, 504,
+	-1, 926,
 	12, 0,
 	13, 0,
 	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 452,
-	-1, 882,
+	264, 0,
+	265, 0,
+	266, 0,
+	-2, 505,
+	-1, 930,
 	12, 0,
 	13, 0,
 	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 456,
-	-1, 883,
+	264, 0,
+	265, 0,
+	266, 0,
+	-2, 509,
+	-1, 931,
 	12, 0,
 	13, 0,
 	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 457,
-	-1, 884,
+	264, 0,
+	265, 0,
+	266, 0,
+	-2, 510,
+	-1, 932,
 	12, 0,
 	13, 0,
 	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 458,
-	-1, 887,
-	30, 0,
-	108, 0,
-	128, 0,
-	193, 0,
-	241, 0,
-	-2, 463,
-	-1, 918,
-	159, 589,
-	-2, 592,
-	-1, 1064,
-	85, 270,
-	116, 270,
-	129, 270,
-	150, 270,
-	154, 270,
-	220, 270,
-	-2, 340,
-	-1, 1072,
-	30, 0,
-	108, 0,
-	128, 0,
-	193, 0,
-	241, 0,
-	-2, 464,
-	-1, 1077,
-	30, 0,
-	108, 0,
-	128, 0,
-	193, 0,
-	241, 0,
-	-2, 465,
-	-1, 1096,
-	159, 588,
-	-2, 591,
-	-1, 1233,
-	30, 0,
-	108, 0,
-	128, 0,
-	193, 0,
-	241, 0,
-	-2, 466,
-	-1, 1238,
-	119, 0,
-	-2, 476,
-	-1, 1247,
-	159, 590,
-	-2, 593,
-	-1, 1287,
+	264, 0,
+	265, 0,
+	266, 0,
+	-2, 511,
+	-1, 935,
+	31, 0,
+	115, 0,
+	136, 0,
+	208, 0,
+	261, 0,
+	-2, 516,
+	-1, 965,
+	167, 642,
+	-2, 645,
+	-1, 1152,
+	31, 0,
+	115, 0,
+	136, 0,
+	208, 0,
+	261, 0,
+	-2, 517,
+	-1, 1157,
+	31, 0,
+	115, 0,
+	136, 0,
+	208, 0,
+	261, 0,
+	-2, 518,
+	-1, 1176,
+	167, 641,
+	-2, 644,
+	-1, 1311,
+	90, 321,
+	124, 321,
+	137, 321,
+	158, 321,
+	162, 321,
+	239, 321,
+	-2, 393,
+	-1, 1342,
+	31, 0,
+	115, 0,
+	136, 0,
+	208, 0,
+	261, 0,
+	-2, 519,
+	-1, 1347,
+	127, 0,
+	-2, 529,
+	-1, 1357,
+	167, 643,
+	-2, 646,
+	-1, 1396,
 	12, 0,
 	13, 0,
 	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 500,
-	-1, 1288,
+	264, 0,
+	265, 0,
+	266, 0,
+	-2, 553,
+	-1, 1397,
 	12, 0,
 	13, 0,
 	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 501,
-	-1, 1289,
+	264, 0,
+	265, 0,
+	266, 0,
+	-2, 554,
+	-1, 1398,
 	12, 0,
 	13, 0,
 	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 502,
-	-1, 1293,
+	264, 0,
+	265, 0,
+	266, 0,
+	-2, 555,
+	-1, 1402,
 	12, 0,
 	13, 0,
 	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 506,
-	-1, 1294,
+	264, 0,
+	265, 0,
+	266, 0,
+	-2, 559,
+	-1, 1403,
 	12, 0,
 	13, 0,
 	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 507,
-	-1, 1295,
+	264, 0,
+	265, 0,
+	266, 0,
+	-2, 560,
+	-1, 1404,
 	12, 0,
 	13, 0,
 	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 508,
-	-1, 1387,
-	119, 0,
-	-2, 477,
-	-1, 1391,
-	30, 0,
-	108, 0,
-	128, 0,
-	193, 0,
-	241, 0,
-	-2, 480,
-	-1, 1392,
-	30, 0,
-	108, 0,
-	128, 0,
-	193, 0,
-	241, 0,
-	-2, 482,
-	-1, 1471,
-	30, 0,
-	108, 0,
-	128, 0,
-	193, 0,
-	241, 0,
-	-2, 481,
-	-1, 1472,
-	30, 0,
-	108, 0,
-	128, 0,
-	193, 0,
-	241, 0,
-	-2, 483,
-	-1, 1480,
-	119, 0,
-	-2, 509,
-	-1, 1517,
-	119, 0,
-	-2, 510,
-	-1, 1562,
-	30, 0,
-	128, 0,
-	193, 0,
-	241, 0,
-	-2, 789,
+	264, 0,
+	265, 0,
+	266, 0,
+	-2, 561,
+	-1, 1497,
+	127, 0,
+	-2, 530,
+	-1, 1501,
+	31, 0,
+	115, 0,
+	136, 0,
+	208, 0,
+	261, 0,
+	-2, 533,
+	-1, 1502,
+	31, 0,
+	115, 0,
+	136, 0,
+	208, 0,
+	261, 0,
+	-2, 535,
+	-1, 1586,
+	31, 0,
+	115, 0,
+	136, 0,
+	208, 0,
+	261, 0,
+	-2, 534,
+	-1, 1587,
+	31, 0,
+	115, 0,
+	136, 0,
+	208, 0,
+	261, 0,
+	-2, 536,
+	-1, 1595,
+	127, 0,
+	-2, 562,
+	-1, 1644,
+	127, 0,
+	-2, 563,
+	-1, 1706,
+	31, 0,
+	136, 0,
+	208, 0,
+	261, 0,
+	-2, 862,
 }
 
-const sqlNprod = 921
+const sqlNprod = 994
 const sqlPrivate = 57344
 
 var sqlTokenNames []string
 var sqlStates []string
 
-const sqlLast = 18232
+const sqlLast = 20855
 
 var sqlAct = [...]int{
 
-	915, 1544, 1561, 1582, 370, 1522, 1546, 1428, 1545, 1560,
-	817, 1488, 769, 1267, 1358, 1453, 395, 1325, 394, 265,
-	387, 1461, 1373, 1359, 686, 238, 1060, 1213, 762, 801,
-	1153, 1239, 1154, 243, 28, 688, 1052, 481, 1222, 455,
-	1099, 803, 84, 931, 460, 739, 770, 1367, 748, 621,
-	262, 1048, 804, 262, 935, 271, 903, 828, 262, 28,
-	281, 970, 900, 1063, 13, 717, 499, 245, 37, 637,
-	925, 798, 463, 721, 643, 1240, 465, 369, 18, 10,
-	237, 28, 248, 6, 360, 57, 88, 510, 826, 641,
-	59, 286, 526, 37, 806, 342, 282, 973, 343, 284,
-	490, 825, 38, 341, 61, 60, 497, 501, 397, 62,
-	66, 1455, 275, 443, 39, 37, 242, 483, 458, 458,
-	81, 353, 456, 456, 483, 457, 457, 491, 1558, 767,
-	763, 1452, 928, 1552, 261, 1551, 821, 268, 821, 87,
-	235, 1543, 276, 242, 1390, 290, 287, 644, 1092, 644,
-	87, 87, 234, 1538, 87, 1510, 821, 87, 87, 87,
-	1021, 1300, 87, 87, 87, 87, 929, 289, 1519, 1246,
-	1513, 1390, 291, 821, 1501, 1498, 389, 821, 1452, 1473,
-	1032, 1468, 1390, 279, 821, 87, 87, 1451, 1448, 1433,
-	1452, 821, 821, 737, 1050, 1432, 930, 927, 821, 1413,
-	1393, 1389, 1092, 1092, 1390, 1335, 1243, 1204, 821, 1092,
-	482, 1034, 821, 1200, 43, 956, 482, 1171, 1169, 1168,
-	1172, 1092, 1092, 482, 1167, 1096, 1098, 1092, 1092, 1094,
-	1093, 45, 486, 262, 1095, 1092, 822, 736, 488, 821,
-	735, 489, 43, 911, 816, 792, 645, 932, 354, 307,
-	260, 47, 525, 1092, 484, 321, 46, 361, 361, 45,
-	340, 484, 1559, 41, 1557, 1514, 1450, 461, 452, 42,
-	1418, 1414, 1406, 334, 1405, 339, 1400, 43, 262, 476,
-	450, 1399, 1398, 1397, 46, 1384, 43, 40, 1352, 454,
-	1315, 41, 1310, 1126, 45, 1309, 1308, 42, 1250, 1228,
-	926, 1212, 1174, 45, 1173, 1161, 1152, 1125, 1122, 1120,
-	1109, 281, 1103, 281, 1021, 766, 645, 333, 1036, 46,
-	1033, 1070, 1489, 458, 445, 985, 41, 456, 46, 281,
-	457, 942, 42, 941, 444, 908, 694, 87, 353, 87,
-	352, 87, 482, 1269, 1509, 629, 631, 1490, 235, 618,
-	58, 1482, 638, 1464, 1458, 1447, 87, 1425, 1411, 40,
-	234, 1378, 276, 1356, 1382, 677, 678, 679, 680, 681,
-	617, 1237, 87, 1227, 684, 1210, 1209, 1207, 1186, 1185,
-	1151, 1117, 87, 87, 1116, 87, 474, 1108, 1089, 1085,
-	905, 722, 725, 646, 697, 999, 998, 1351, 980, 1126,
-	290, 290, 940, 820, 727, 715, 714, 691, 529, 495,
-	713, 648, 712, 909, 711, 87, 1140, 87, 494, 710,
-	709, 514, 289, 289, 521, 613, 708, 291, 291, 647,
-	528, 87, 646, 87, 87, 530, 87, 639, 707, 627,
-	706, 626, 705, 610, 625, 87, 614, 704, 615, 235,
-	648, 703, 235, 235, 702, 646, 701, 692, 729, 734,
-	690, 633, 40, 87, 634, 635, 87, 1141, 647, 999,
-	619, 685, 266, 648, 402, 262, 357, 1470, 761, 1469,
-	689, 1230, 773, 1229, 359, 451, 1354, 777, 1022, 1071,
-	281, 647, 328, 520, 730, 719, 720, 281, 316, 346,
-	699, 1368, 763, 1270, 936, 646, 718, 723, 1018, 28,
-	1528, 246, 726, 753, 755, 1571, 1343, 1497, 225, 742,
-	1112, 1028, 28, 648, 728, 1441, 1440, 1198, 1178, 779,
-	284, 1132, 1133, 1134, 1127, 1128, 1129, 1130, 1131, 1177,
-	765, 647, 311, 529, 529, 466, 1107, 467, 232, 731,
-	733, 363, 1106, 59, 255, 662, 37, 1105, 1126, 758,
-	1104, 745, 87, 1126, 1073, 528, 528, 61, 60, 315,
-	530, 530, 62, 1572, 781, 87, 290, 287, 662, 87,
-	892, 782, 87, 785, 780, 783, 87, 760, 87, 87,
-	695, 87, 759, 866, 87, 87, 87, 1496, 289, 741,
-	229, 87, 87, 291, 1530, 1197, 663, 902, 1188, 468,
-	741, 51, 529, 778, 447, 1381, 740, 446, 797, 466,
-	784, 467, 466, 262, 467, 477, 932, 1259, 662, 663,
-	814, 815, 1430, 399, 528, 1579, 651, 652, 653, 530,
-	1127, 1128, 1129, 1130, 1131, 902, 361, 52, 1013, 262,
-	867, 868, 869, 870, 871, 872, 873, 874, 875, 876,
-	877, 878, 879, 880, 881, 882, 883, 884, 885, 886,
-	887, 936, 823, 649, 650, 651, 652, 653, 932, 663,
-	472, 1140, 313, 468, 230, 471, 468, 890, 1491, 1027,
-	865, 1585, 657, 654, 655, 656, 649, 650, 651, 652,
-	653, 233, 1010, 54, 943, 928, 954, 800, 964, 966,
-	971, 974, 975, 976, 749, 1029, 646, 314, 1189, 1478,
-	1571, 87, 831, 1540, 1578, 916, 730, 87, 87, 946,
-	830, 730, 1141, 483, 648, 716, 461, 682, 1541, 929,
-	349, 350, 449, 990, 55, 656, 649, 650, 651, 652,
-	653, 1126, 647, 87, 1115, 469, 87, 984, 53, 355,
-	907, 1195, 529, 1223, 1014, 891, 752, 331, 906, 930,
-	927, 281, 738, 646, 242, 1547, 464, 49, 1431, 281,
-	988, 345, 1548, 1075, 528, 888, 1435, 912, 917, 530,
-	920, 648, 1570, 1583, 1568, 1577, 949, 994, 1134, 1127,
-	1128, 1129, 1130, 1131, 1366, 965, 1129, 1130, 1131, 647,
-	1016, 977, 978, 979, 989, 1038, 1024, 638, 50, 788,
-	932, 901, 996, 837, 810, 789, 324, 308, 1584, 469,
-	950, 1009, 469, 306, 262, 1409, 1434, 751, 791, 56,
-	241, 1423, 1339, 1586, 1020, 1549, 790, 87, 87, 87,
-	889, 1256, 1037, 87, 28, 1025, 87, 1035, 1026, 1030,
-	951, 948, 87, 87, 87, 87, 87, 1031, 87, 87,
-	484, 240, 1592, 926, 1140, 87, 1180, 87, 1550, 290,
-	1296, 1257, 64, 87, 993, 1043, 1041, 1065, 37, 1072,
-	1059, 750, 87, 1077, 1017, 87, 662, 632, 811, 1045,
-	1044, 289, 1023, 624, 1046, 1410, 291, 620, 1069, 242,
-	1338, 952, 1091, 48, 1255, 1523, 87, 344, 87, 87,
-	67, 87, 1100, 837, 723, 1141, 726, 616, 720, 719,
-	87, 1066, 932, 496, 344, 87, 87, 1113, 87, 1424,
-	72, 1118, 1591, 1001, 1297, 68, 1097, 663, 1076, 1342,
-	1298, 1000, 1376, 1074, 1055, 345, 1341, 649, 650, 651,
-	652, 653, 684, 69, 947, 1218, 67, 1058, 971, 971,
-	971, 856, 1217, 312, 898, 239, 71, 1221, 1051, 329,
-	274, 855, 1056, 240, 336, 896, 72, 1214, 1176, 1111,
-	1049, 68, 1127, 1128, 1129, 1130, 1131, 939, 1481, 1183,
-	1408, 1155, 1236, 1088, 1121, 1084, 786, 1090, 644, 69,
-	327, 654, 655, 656, 649, 650, 651, 652, 653, 1055,
-	1101, 1102, 71, 461, 1340, 325, 773, 322, 273, 1158,
-	1159, 1160, 1058, 1156, 700, 1057, 1175, 612, 894, 938,
-	893, 1322, 1053, 1192, 899, 1194, 1182, 1056, 1193, 1201,
-	1191, 70, 1179, 1082, 1039, 262, 1196, 812, 262, 1150,
-	1054, 1264, 809, 487, 1080, 485, 480, 1203, 1202, 473,
-	1163, 856, 1232, 470, 1233, 1442, 1206, 347, 258, 1572,
-	516, 855, 741, 818, 741, 1238, 1208, 73, 756, 1184,
-	754, 1220, 318, 1248, 75, 1224, 1225, 70, 1444, 1248,
-	1057, 757, 1199, 646, 1455, 3, 646, 1493, 87, 1516,
-	1215, 895, 351, 1265, 1511, 768, 1086, 1087, 897, 1078,
-	640, 836, 1274, 1083, 648, 1276, 1252, 1253, 1254, 1068,
-	87, 1249, 1589, 73, 819, 1590, 63, 348, 259, 647,
-	1126, 87, 647, 87, 224, 87, 646, 1383, 87, 1258,
-	1260, 1261, 1216, 267, 1275, 1219, 1305, 1306, 1271, 87,
-	319, 1126, 87, 382, 74, 1312, 1313, 1314, 1316, 1262,
-	87, 309, 310, 87, 1147, 1148, 1149, 793, 226, 227,
-	794, 1231, 1170, 983, 982, 1304, 1303, 1244, 981, 933,
-	1079, 795, 1395, 1263, 85, 1273, 796, 1081, 693, 228,
-	1346, 1429, 1277, 1317, 65, 249, 249, 611, 323, 264,
-	1402, 1539, 264, 270, 264, 1369, 1114, 264, 277, 264,
-	85, 836, 262, 262, 87, 1477, 262, 1364, 1460, 1363,
-	937, 1365, 1321, 1307, 28, 698, 23, 1387, 1361, 1357,
-	85, 85, 1391, 1392, 375, 1323, 1181, 1394, 805, 1301,
-	531, 517, 1396, 506, 398, 1380, 326, 500, 957, 509,
-	1311, 858, 837, 945, 857, 1353, 448, 1401, 400, 1388,
-	834, 1404, 401, 835, 724, 1336, 1337, 388, 832, 285,
-	833, 771, 934, 1110, 1140, 696, 87, 87, 87, 374,
-	1234, 1235, 380, 379, 87, 87, 837, 1355, 913, 371,
-	87, 1412, 87, 837, 87, 87, 87, 87, 1407, 79,
-	80, 1015, 1350, 1370, 764, 813, 87, 1379, 87, 1371,
-	1372, 628, 1190, 1377, 231, 1123, 87, 87, 963, 955,
-	87, 953, 944, 332, 837, 1141, 87, 87, 1427, 459,
-	772, 358, 1436, 1278, 1279, 1280, 1281, 1282, 1283, 1284,
-	1285, 1286, 1287, 1288, 1289, 1290, 1291, 1292, 1293, 1294,
-	1295, 858, 1299, 1457, 857, 1419, 1443, 320, 1364, 1422,
-	1363, 1459, 1365, 824, 1445, 1420, 1465, 1456, 87, 1067,
-	833, 262, 1438, 1439, 356, 1454, 1471, 1472, 636, 257,
-	256, 802, 264, 317, 85, 1463, 337, 787, 1135, 1132,
-	1133, 1134, 1127, 1128, 1129, 1130, 1131, 475, 330, 1492,
-	856, 249, 1527, 1187, 837, 44, 1485, 17, 16, 1437,
-	855, 15, 1476, 14, 12, 11, 1487, 264, 1042, 9,
-	8, 87, 7, 87, 22, 87, 21, 264, 264, 1486,
-	478, 20, 87, 1483, 856, 5, 1449, 4, 461, 2,
-	1, 856, 957, 957, 855, 0, 1500, 0, 0, 1502,
-	0, 855, 0, 0, 1504, 87, 1474, 1506, 1467, 1364,
-	264, 1363, 264, 1365, 0, 87, 1503, 87, 1466, 0,
-	0, 0, 856, 0, 0, 87, 85, 87, 264, 85,
-	0, 85, 855, 0, 730, 0, 1526, 0, 1505, 0,
-	623, 0, 0, 1515, 0, 0, 0, 0, 0, 1532,
-	957, 957, 957, 0, 1518, 0, 0, 0, 249, 0,
-	837, 642, 0, 1533, 1535, 1537, 1364, 1531, 1363, 1554,
-	1365, 0, 1536, 0, 1534, 773, 1529, 0, 1553, 0,
-	0, 1565, 1565, 1555, 1556, 0, 0, 1331, 1426, 87,
-	87, 1566, 1567, 87, 1512, 1569, 1508, 0, 0, 1573,
-	836, 0, 856, 1565, 87, 1576, 1575, 837, 0, 0,
-	0, 0, 855, 87, 0, 1588, 1587, 1332, 0, 1524,
-	1525, 0, 0, 0, 1574, 0, 0, 0, 837, 0,
-	1565, 0, 1593, 0, 836, 0, 0, 0, 87, 87,
-	87, 836, 87, 519, 507, 518, 0, 512, 0, 0,
-	1331, 0, 1326, 0, 1542, 0, 0, 264, 0, 87,
-	1324, 0, 0, 0, 1480, 0, 957, 957, 0, 0,
-	746, 0, 836, 0, 264, 0, 0, 264, 0, 87,
-	1332, 264, 0, 775, 776, 1327, 264, 1328, 0, 264,
-	85, 85, 0, 0, 0, 0, 264, 642, 0, 837,
-	0, 0, 0, 1051, 0, 0, 0, 0, 856, 0,
-	1330, 0, 0, 522, 0, 0, 1333, 0, 855, 957,
-	957, 957, 957, 957, 957, 957, 957, 957, 957, 957,
-	957, 957, 957, 957, 957, 957, 957, 1517, 957, 0,
-	858, 0, 0, 857, 1055, 0, 0, 0, 1327, 0,
-	1328, 0, 836, 0, 0, 856, 524, 1058, 0, 833,
-	0, 0, 0, 0, 1329, 855, 0, 1053, 0, 523,
-	0, 0, 1056, 1330, 858, 0, 856, 857, 0, 1333,
-	0, 858, 0, 0, 857, 1054, 855, 0, 0, 0,
-	0, 0, 0, 833, 376, 29, 0, 1375, 0, 0,
-	833, 0, 0, 0, 214, 0, 0, 0, 0, 0,
-	0, 0, 858, 0, 0, 857, 799, 0, 223, 0,
-	29, 0, 264, 746, 0, 1057, 0, 1329, 0, 0,
-	0, 833, 236, 0, 0, 244, 0, 0, 0, 0,
-	0, 0, 29, 0, 0, 0, 0, 856, 264, 216,
-	0, 85, 0, 244, 0, 0, 0, 855, 836, 0,
-	1126, 0, 1142, 1143, 1144, 0, 0, 0, 215, 217,
-	0, 1374, 1386, 0, 0, 0, 646, 0, 664, 665,
-	666, 0, 0, 0, 0, 0, 0, 0, 667, 0,
-	513, 508, 858, 0, 648, 857, 673, 0, 0, 0,
-	218, 0, 1139, 0, 0, 836, 0, 0, 0, 219,
-	0, 833, 647, 0, 0, 0, 0, 0, 661, 0,
-	0, 0, 0, 0, 957, 0, 836, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 264, 991, 992, 0, 0, 0, 746, 0,
-	0, 997, 0, 0, 0, 0, 0, 1002, 1003, 1005,
-	1007, 1008, 0, 1011, 1012, 0, 0, 0, 0, 1145,
-	264, 0, 1019, 0, 674, 0, 0, 0, 264, 0,
-	0, 0, 0, 1140, 0, 672, 0, 799, 0, 0,
-	799, 0, 0, 0, 669, 0, 0, 836, 858, 662,
-	957, 857, 0, 0, 0, 220, 0, 0, 221, 0,
-	19, 623, 222, 85, 264, 0, 1040, 833, 0, 668,
-	32, 0, 0, 0, 0, 1047, 0, 0, 0, 0,
-	1062, 1062, 0, 264, 1141, 646, 0, 0, 0, 0,
-	236, 33, 0, 0, 0, 858, 0, 36, 857, 0,
-	663, 0, 0, 648, 1126, 673, 1142, 1143, 1144, 671,
-	0, 0, 0, 0, 833, 0, 858, 0, 0, 857,
-	0, 647, 24, 957, 0, 0, 0, 661, 25, 0,
-	0, 0, 0, 0, 0, 833, 0, 0, 0, 0,
-	26, 0, 0, 1136, 1137, 1138, 1139, 1135, 1132, 1133,
-	1134, 1127, 1128, 1129, 1130, 1131, 0, 670, 0, 658,
-	659, 660, 0, 657, 654, 655, 656, 649, 650, 651,
-	652, 653, 0, 0, 0, 986, 0, 0, 0, 0,
-	0, 0, 987, 674, 0, 0, 0, 858, 0, 0,
-	857, 236, 0, 0, 236, 236, 0, 0, 0, 0,
-	0, 0, 1146, 669, 0, 0, 833, 0, 662, 0,
-	0, 0, 0, 1145, 0, 0, 0, 0, 683, 27,
-	0, 34, 687, 0, 0, 0, 0, 1140, 43, 0,
-	0, 0, 30, 31, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 45, 0, 0, 0, 0,
-	0, 0, 0, 642, 0, 0, 0, 35, 0, 663,
-	0, 646, 0, 664, 665, 666, 0, 0, 671, 0,
-	46, 0, 0, 667, 0, 264, 0, 41, 1141, 648,
-	0, 673, 0, 42, 0, 0, 1205, 0, 746, 0,
-	623, 0, 0, 1211, 0, 0, 0, 647, 0, 0,
-	0, 40, 0, 661, 264, 0, 0, 264, 0, 0,
-	0, 0, 0, 0, 0, 1226, 670, 0, 1062, 0,
-	29, 0, 657, 654, 655, 656, 649, 650, 651, 652,
-	653, 0, 0, 29, 0, 0, 0, 1136, 1137, 1138,
-	0, 1135, 1132, 1133, 1134, 1127, 1128, 1129, 1130, 1131,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 674,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1268,
-	672, 0, 0, 0, 0, 0, 0, 0, 0, 669,
-	0, 0, 0, 0, 662, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 668, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 646, 0,
-	664, 665, 666, 0, 0, 0, 0, 0, 0, 0,
-	667, 1319, 1320, 746, 0, 663, 648, 0, 673, 642,
-	642, 646, 0, 0, 671, 1344, 0, 1345, 0, 264,
-	1347, 1348, 1349, 0, 647, 0, 0, 0, 0, 648,
-	661, 642, 0, 746, 1360, 0, 0, 0, 0, 0,
-	0, 264, 264, 0, 0, 264, 0, 647, 0, 0,
-	0, 642, 1062, 661, 0, 0, 0, 0, 0, 827,
-	0, 0, 670, 0, 658, 659, 660, 0, 657, 654,
-	655, 656, 649, 650, 651, 652, 653, 0, 0, 0,
-	0, 0, 0, 0, 1126, 1415, 674, 0, 0, 904,
-	0, 0, 0, 1403, 0, 0, 0, 672, 0, 0,
-	0, 0, 0, 0, 0, 0, 669, 0, 0, 0,
-	0, 662, 0, 0, 0, 0, 0, 0, 646, 0,
-	664, 665, 666, 0, 0, 0, 1139, 0, 0, 0,
-	0, 668, 0, 0, 662, 0, 648, 0, 673, 0,
-	0, 0, 0, 0, 0, 0, 746, 0, 1421, 0,
-	85, 0, 0, 0, 647, 0, 0, 264, 0, 0,
-	661, 0, 663, 0, 0, 0, 0, 0, 0, 0,
-	0, 671, 0, 0, 0, 1360, 0, 0, 0, 0,
-	642, 244, 0, 0, 0, 663, 0, 0, 0, 0,
-	264, 0, 1462, 0, 0, 0, 0, 0, 0, 0,
-	264, 0, 642, 0, 0, 0, 0, 1140, 0, 0,
-	0, 0, 0, 0, 0, 0, 674, 0, 0, 670,
-	0, 658, 659, 660, 0, 657, 654, 655, 656, 649,
-	650, 651, 652, 653, 0, 29, 669, 0, 0, 0,
-	0, 662, 1166, 0, 1064, 0, 0, 0, 657, 654,
-	655, 656, 649, 650, 651, 652, 653, 0, 1141, 0,
-	0, 0, 0, 0, 1494, 1495, 0, 0, 1499, 0,
-	0, 0, 0, 0, 0, 0, 1360, 0, 0, 85,
-	0, 0, 0, 0, 0, 0, 0, 0, 642, 0,
-	0, 0, 663, 0, 0, 0, 0, 0, 0, 0,
-	0, 671, 0, 0, 0, 0, 904, 0, 0, 0,
-	0, 0, 0, 642, 642, 264, 0, 85, 0, 0,
-	683, 1135, 1132, 1133, 1134, 1127, 1128, 1129, 1130, 1131,
-	0, 0, 0, 1360, 1462, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 670,
-	0, 658, 659, 660, 264, 657, 654, 655, 656, 649,
-	650, 651, 652, 653, 0, 0, 0, 0, 0, 0,
-	396, 384, 385, 386, 383, 372, 683, 0, 0, 0,
-	0, 0, 89, 90, 922, 91, 0, 0, 0, 0,
-	378, 0, 0, 0, 92, 93, 174, 425, 426, 94,
-	427, 428, 0, 95, 179, 96, 393, 411, 429, 430,
-	0, 421, 0, 404, 0, 97, 98, 99, 0, 100,
-	0, 101, 0, 294, 102, 103, 0, 405, 407, 0,
-	406, 408, 104, 105, 106, 107, 431, 108, 432, 433,
-	0, 0, 109, 0, 923, 0, 424, 111, 0, 0,
-	0, 0, 377, 112, 412, 391, 0, 113, 114, 434,
-	115, 0, 0, 0, 295, 827, 116, 422, 827, 190,
-	0, 117, 418, 420, 0, 0, 0, 296, 118, 435,
-	436, 437, 0, 403, 0, 297, 119, 298, 120, 0,
-	0, 423, 299, 121, 300, 0, 250, 0, 0, 0,
-	122, 123, 124, 125, 251, 301, 126, 127, 367, 128,
-	392, 419, 129, 438, 130, 131, 0, 0, 0, 0,
-	0, 132, 200, 302, 133, 303, 413, 134, 135, 0,
-	414, 136, 203, 0, 137, 138, 439, 139, 140, 0,
-	141, 142, 143, 0, 144, 304, 145, 146, 381, 147,
-	0, 148, 149, 0, 150, 252, 409, 151, 152, 305,
-	153, 440, 154, 0, 155, 157, 207, 156, 415, 0,
-	0, 158, 159, 0, 254, 441, 0, 0, 253, 416,
-	417, 390, 160, 161, 162, 163, 0, 0, 164, 165,
-	410, 0, 166, 167, 168, 212, 442, 921, 169, 0,
-	0, 0, 0, 170, 171, 172, 173, 368, 0, 0,
-	0, 0, 0, 0, 0, 29, 0, 364, 365, 924,
-	0, 0, 0, 366, 0, 0, 373, 919, 0, 0,
-	0, 0, 827, 827, 0, 0, 827, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	374, 1692, 1705, 1737, 1734, 1669, 1693, 1376, 1694, 1570,
+	1664, 1042, 1704, 1633, 372, 271, 1098, 306, 1539, 1613,
+	1035, 371, 366, 444, 1466, 1105, 1553, 1620, 364, 1548,
+	78, 340, 1540, 1307, 1020, 611, 1234, 1063, 327, 1060,
+	44, 1444, 1299, 78, 978, 813, 1452, 78, 817, 241,
+	22, 613, 1348, 1233, 551, 1062, 1043, 861, 78, 78,
+	852, 1108, 78, 1310, 1179, 78, 78, 78, 78, 78,
+	313, 53, 837, 78, 78, 832, 972, 243, 27, 772,
+	71, 242, 16, 1081, 1017, 951, 982, 244, 11, 79,
+	948, 558, 347, 877, 560, 1349, 466, 311, 642, 542,
+	571, 783, 268, 238, 1106, 657, 461, 646, 53, 346,
+	1065, 316, 443, 22, 54, 524, 523, 420, 774, 525,
+	305, 69, 75, 770, 55, 753, 451, 541, 248, 314,
+	487, 528, 754, 754, 53, 75, 447, 1615, 310, 535,
+	445, 27, 1036, 446, 310, 16, 1745, 422, 447, 572,
+	975, 11, 445, 439, 325, 446, 767, 1716, 838, 332,
+	1612, 336, 838, 1689, 259, 75, 324, 574, 303, 1040,
+	1280, 333, 1700, 1264, 1699, 654, 1691, 654, 302, 654,
+	1684, 654, 1681, 767, 976, 1500, 1667, 573, 318, 654,
+	1409, 1654, 1650, 587, 654, 1612, 1646, 1629, 1611, 1500,
+	654, 1612, 1608, 1588, 1575, 654, 1500, 654, 1574, 59,
+	1524, 654, 1504, 767, 1356, 767, 1499, 977, 974, 1500,
+	1476, 1433, 1432, 654, 654, 438, 850, 1428, 1352, 61,
+	438, 767, 1297, 78, 78, 78, 78, 78, 78, 1251,
+	1249, 470, 1252, 767, 1248, 1247, 1176, 767, 767, 767,
+	1174, 1271, 757, 463, 1173, 1175, 62, 78, 1097, 767,
+	1075, 1178, 959, 57, 78, 78, 839, 767, 1102, 58,
+	1059, 654, 478, 654, 845, 979, 759, 844, 536, 760,
+	588, 311, 755, 755, 438, 239, 476, 323, 56, 63,
+	1273, 798, 59, 497, 1715, 482, 78, 1703, 78, 1641,
+	78, 78, 1610, 59, 421, 1532, 1529, 1525, 1517, 1516,
+	53, 1511, 61, 514, 1510, 1509, 78, 459, 1508, 1494,
+	522, 1458, 1443, 61, 1424, 325, 1419, 78, 1418, 75,
+	471, 1417, 1359, 973, 1283, 1270, 1254, 452, 589, 62,
+	1253, 1264, 467, 464, 1241, 839, 57, 1232, 1205, 379,
+	62, 1202, 58, 1200, 1189, 1150, 1585, 1183, 472, 1113,
+	989, 424, 546, 423, 376, 988, 572, 521, 447, 535,
+	619, 1039, 445, 956, 1378, 446, 438, 810, 534, 1634,
+	1662, 1635, 56, 1625, 574, 610, 1618, 1607, 325, 1606,
+	1597, 311, 1567, 1558, 1537, 616, 1522, 1492, 1457, 1440,
+	513, 1439, 1436, 1346, 573, 1324, 1323, 583, 580, 581,
+	582, 575, 576, 577, 578, 579, 1231, 572, 1197, 548,
+	614, 1196, 511, 1188, 1170, 1165, 953, 1531, 303, 647,
+	1206, 650, 1222, 1223, 1224, 574, 1129, 1128, 302, 78,
+	531, 532, 1496, 1101, 572, 537, 660, 660, 545, 1206,
+	78, 78, 1058, 78, 1129, 573, 1027, 957, 987, 811,
+	764, 328, 574, 440, 652, 1206, 59, 656, 640, 639,
+	638, 1206, 637, 636, 1219, 635, 78, 634, 78, 633,
+	632, 631, 573, 630, 629, 78, 61, 628, 627, 626,
+	470, 470, 617, 615, 56, 539, 477, 588, 660, 78,
+	1584, 78, 78, 572, 1339, 644, 645, 78, 1338, 1219,
+	547, 1206, 1746, 62, 1534, 793, 766, 78, 648, 1265,
+	57, 574, 557, 651, 601, 262, 58, 1151, 872, 849,
+	507, 653, 492, 624, 491, 661, 661, 78, 1713, 818,
+	78, 573, 325, 746, 556, 240, 1621, 1225, 1036, 1379,
+	78, 983, 742, 643, 452, 589, 1206, 1261, 1675, 827,
+	1724, 1220, 78, 662, 662, 847, 78, 561, 75, 562,
+	78, 846, 1192, 1649, 78, 588, 744, 75, 230, 471,
+	471, 1723, 1484, 292, 854, 1276, 1583, 661, 1582, 913,
+	1336, 1316, 1335, 75, 1315, 885, 1220, 761, 1187, 855,
+	1186, 800, 1220, 799, 804, 762, 768, 472, 472, 806,
+	1185, 794, 1184, 299, 787, 662, 1153, 231, 842, 1221,
+	1057, 940, 803, 866, 868, 580, 581, 582, 575, 576,
+	577, 578, 579, 589, 874, 656, 563, 873, 809, 820,
+	656, 822, 843, 303, 821, 620, 303, 303, 834, 960,
+	964, 502, 967, 828, 1221, 78, 829, 830, 325, 338,
+	1221, 489, 875, 1004, 1648, 479, 963, 1012, 879, 1491,
+	871, 876, 858, 1024, 1025, 1026, 1353, 914, 296, 575,
+	576, 577, 578, 579, 1216, 1217, 1218, 1220, 1215, 1212,
+	1213, 1214, 1207, 1208, 1209, 1210, 1211, 1085, 490, 457,
+	442, 1169, 583, 580, 581, 582, 575, 576, 577, 578,
+	579, 1207, 1208, 1209, 1210, 1211, 256, 955, 950, 950,
+	904, 1723, 1572, 1215, 1212, 1213, 1214, 1207, 1208, 1209,
+	1210, 1211, 1214, 1207, 1208, 1209, 1210, 1211, 983, 232,
+	547, 504, 503, 480, 561, 1221, 562, 233, 78, 258,
+	78, 78, 78, 78, 862, 1162, 854, 78, 78, 78,
+	309, 470, 297, 1333, 853, 78, 1160, 577, 578, 579,
+	1275, 1071, 1682, 1053, 463, 1209, 1210, 1211, 1072, 747,
+	301, 234, 979, 300, 792, 780, 791, 1298, 785, 485,
+	486, 236, 1678, 1074, 308, 1038, 938, 564, 1206, 660,
+	1729, 1073, 754, 78, 1326, 1144, 78, 568, 1368, 865,
+	1679, 78, 78, 563, 1215, 1212, 1213, 1214, 1207, 1208,
+	1209, 1210, 1211, 566, 1158, 561, 993, 562, 1163, 1302,
+	53, 1636, 310, 1003, 1095, 1096, 1696, 1052, 1277, 78,
+	1046, 660, 67, 229, 257, 1305, 1084, 78, 78, 75,
+	471, 1087, 78, 78, 78, 1300, 1365, 1056, 78, 795,
+	1303, 78, 467, 1055, 641, 1054, 979, 78, 78, 78,
+	78, 78, 1092, 1301, 78, 78, 64, 903, 472, 1593,
+	939, 1573, 885, 848, 864, 1555, 68, 607, 661, 1366,
+	1195, 1740, 975, 1453, 563, 1124, 237, 310, 996, 1114,
+	1695, 936, 1697, 325, 1080, 797, 1159, 1722, 1083, 307,
+	359, 1155, 949, 1161, 1140, 1086, 662, 235, 796, 1720,
+	1304, 1547, 884, 1728, 1327, 1259, 976, 1089, 526, 1220,
+	661, 325, 997, 500, 906, 851, 905, 881, 1103, 1110,
+	76, 763, 1698, 1112, 1120, 1119, 1111, 979, 863, 527,
+	954, 484, 755, 76, 567, 1126, 475, 272, 662, 977,
+	974, 527, 1149, 1139, 1577, 998, 995, 1472, 317, 317,
+	1145, 1554, 76, 937, 564, 330, 331, 76, 335, 76,
+	1004, 1004, 1576, 76, 441, 1177, 1565, 1221, 1168, 1318,
+	1123, 66, 1171, 1090, 311, 559, 1520, 819, 1727, 1738,
+	1473, 1749, 816, 836, 1181, 1182, 648, 904, 651, 645,
+	644, 1405, 65, 1483, 812, 1364, 1480, 979, 1670, 526,
+	1482, 807, 769, 999, 1566, 1156, 1131, 1130, 572, 1154,
+	1556, 1448, 1447, 78, 488, 246, 508, 1004, 1004, 1004,
+	78, 1739, 78, 1230, 450, 878, 574, 308, 78, 311,
+	516, 655, 786, 781, 1243, 564, 1741, 1549, 78, 78,
+	1207, 1208, 1209, 1210, 1211, 1445, 573, 78, 1191, 1521,
+	78, 1468, 986, 1469, 1748, 973, 470, 249, 1406, 1596,
+	311, 994, 1519, 1235, 1407, 78, 78, 1479, 1345, 78,
+	78, 1481, 78, 1201, 78, 1164, 1146, 1471, 254, 1238,
+	1239, 1240, 1069, 250, 1284, 838, 1474, 78, 78, 805,
+	78, 506, 1272, 501, 498, 1263, 481, 449, 1463, 1236,
+	1260, 251, 808, 1302, 1296, 802, 625, 985, 1266, 1331,
+	78, 1329, 75, 1314, 1317, 1313, 1286, 253, 1093, 1305,
+	75, 1268, 1206, 76, 453, 455, 441, 76, 272, 1451,
+	311, 1292, 1091, 1274, 1303, 1278, 1279, 1088, 758, 588,
+	756, 1470, 752, 751, 903, 471, 750, 272, 569, 565,
+	1306, 1312, 53, 1290, 272, 272, 1373, 1281, 1267, 1294,
+	1601, 1337, 1285, 1293, 1724, 1320, 1004, 1004, 885, 1295,
+	1032, 1535, 789, 472, 494, 554, 78, 1434, 1361, 1362,
+	1363, 1334, 325, 529, 321, 1354, 76, 1603, 272, 884,
+	517, 519, 3, 870, 1304, 1099, 1051, 589, 1427, 252,
+	854, 906, 885, 905, 881, 854, 317, 1358, 869, 885,
+	1367, 1369, 1370, 867, 509, 291, 245, 76, 1615, 1004,
+	1004, 1004, 1004, 1004, 1004, 1004, 1004, 1004, 1004, 1004,
+	1004, 1004, 1004, 1004, 1004, 1004, 1004, 1384, 1004, 255,
+	885, 1330, 1380, 1332, 555, 78, 1410, 495, 530, 322,
+	1412, 1100, 78, 1220, 78, 1638, 329, 1420, 1643, 1550,
+	261, 78, 533, 1690, 1429, 293, 294, 78, 1413, 582,
+	575, 576, 577, 578, 579, 1382, 1041, 835, 78, 1148,
+	1744, 78, 1386, 946, 572, 572, 1747, 1206, 572, 78,
+	1115, 1116, 78, 904, 944, 1493, 78, 78, 78, 1437,
+	1431, 1430, 574, 1446, 78, 78, 1449, 1435, 1425, 1371,
+	78, 1221, 78, 1416, 78, 78, 78, 78, 1442, 1340,
+	1250, 1078, 573, 573, 1450, 1076, 1030, 904, 1077, 76,
+	1454, 1455, 1029, 1028, 904, 1462, 1459, 1046, 980, 1506,
+	76, 76, 1372, 748, 1079, 618, 295, 1571, 247, 885,
+	942, 801, 941, 499, 1513, 1677, 947, 1194, 1592, 78,
+	1663, 984, 1472, 623, 1467, 904, 76, 35, 441, 1542,
+	325, 352, 1464, 325, 1465, 76, 1319, 1064, 663, 1498,
+	790, 1212, 1213, 1214, 1207, 1208, 1209, 1210, 1211, 272,
+	779, 76, 272, 375, 505, 1473, 773, 272, 782, 992,
+	437, 1518, 377, 882, 378, 883, 1487, 815, 649, 365,
+	880, 465, 1044, 981, 1190, 621, 351, 78, 357, 356,
+	78, 961, 348, 266, 267, 1258, 1530, 317, 78, 78,
+	441, 1037, 78, 1094, 943, 1545, 823, 1328, 78, 78,
+	76, 945, 1544, 298, 78, 1203, 78, 1010, 78, 1546,
+	903, 1002, 859, 1551, 1552, 78, 76, 1557, 1533, 1538,
+	76, 1000, 991, 1564, 272, 512, 1468, 483, 1469, 1561,
+	550, 1045, 1560, 540, 904, 496, 1255, 1733, 1712, 1104,
+	1147, 1562, 538, 831, 903, 1004, 320, 885, 319, 1061,
+	493, 903, 1471, 1070, 745, 884, 510, 1637, 1674, 1325,
+	60, 1474, 1269, 885, 26, 1591, 25, 906, 24, 905,
+	881, 23, 21, 20, 18, 17, 1291, 15, 78, 14,
+	325, 325, 903, 13, 325, 885, 1545, 12, 78, 884,
+	1602, 1004, 34, 1544, 33, 32, 884, 78, 339, 78,
+	1546, 906, 1616, 905, 881, 1031, 31, 1569, 906, 10,
+	905, 881, 19, 1604, 9, 1598, 1470, 1614, 8, 1624,
+	1579, 7, 1627, 78, 78, 1623, 6, 884, 1631, 1630,
+	5, 4, 2, 1322, 1, 0, 1589, 0, 0, 906,
+	0, 905, 881, 0, 78, 572, 0, 0, 0, 0,
+	1642, 1580, 1581, 0, 0, 0, 78, 0, 1653, 0,
+	885, 1655, 78, 574, 0, 0, 78, 1545, 1004, 0,
+	1657, 1658, 904, 1659, 1544, 78, 78, 78, 656, 78,
+	1619, 1546, 1652, 573, 0, 1656, 0, 311, 904, 325,
+	1645, 903, 0, 1661, 1676, 0, 0, 1298, 76, 0,
+	1048, 1049, 1050, 441, 1685, 0, 0, 76, 272, 272,
+	904, 1683, 0, 0, 0, 859, 0, 1545, 1680, 1687,
+	1686, 1688, 0, 78, 1544, 0, 0, 1701, 0, 0,
+	78, 1546, 1710, 0, 1711, 0, 884, 0, 1718, 1302,
+	1717, 1721, 1719, 1714, 0, 0, 0, 1725, 906, 1726,
+	905, 881, 0, 1082, 78, 1305, 272, 448, 0, 0,
+	0, 76, 859, 0, 0, 1300, 1736, 1743, 1742, 1673,
+	1303, 0, 1732, 0, 0, 0, 588, 0, 0, 0,
+	0, 0, 0, 1301, 0, 904, 78, 1750, 0, 76,
+	1751, 0, 0, 0, 0, 0, 0, 441, 441, 0,
+	0, 0, 76, 1121, 1122, 0, 0, 0, 859, 0,
+	0, 1127, 0, 0, 0, 1046, 0, 1132, 1133, 1135,
+	1137, 1138, 1166, 1167, 1142, 1143, 0, 1477, 1478, 903,
+	1304, 0, 249, 0, 589, 0, 0, 353, 45, 0,
+	0, 0, 0, 0, 0, 903, 0, 543, 543, 0,
+	0, 0, 0, 254, 0, 0, 552, 0, 250, 0,
+	0, 0, 0, 0, 0, 0, 570, 903, 0, 602,
+	603, 604, 605, 606, 884, 45, 251, 0, 609, 1227,
+	1228, 1229, 0, 0, 0, 0, 906, 0, 905, 881,
+	884, 304, 253, 0, 312, 0, 0, 0, 622, 0,
+	0, 45, 906, 0, 905, 881, 0, 575, 576, 577,
+	578, 579, 884, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 906, 0, 905, 881, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1536, 0, 903, 0, 572, 0, 590, 591, 592, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1559, 574, 0, 0, 599, 0, 0, 0, 0,
+	0, 0, 0, 0, 252, 743, 0, 0, 0, 0,
+	0, 0, 573, 441, 0, 0, 0, 884, 587, 0,
+	76, 0, 1262, 0, 0, 0, 0, 0, 76, 906,
+	0, 905, 881, 0, 0, 0, 0, 765, 1082, 441,
+	0, 0, 0, 0, 255, 0, 0, 815, 0, 0,
+	1082, 0, 0, 0, 0, 0, 0, 0, 1343, 1344,
+	0, 0, 0, 0, 0, 76, 1282, 0, 0, 272,
+	76, 1609, 1287, 0, 1289, 0, 0, 0, 0, 600,
+	0, 0, 0, 0, 824, 826, 0, 1309, 1309, 0,
+	76, 833, 1628, 0, 0, 0, 0, 0, 0, 0,
+	595, 0, 0, 0, 0, 588, 0, 45, 312, 0,
+	441, 1387, 1388, 1389, 1390, 1391, 1392, 1393, 1394, 1395,
+	1396, 1397, 1398, 1399, 1400, 1401, 1402, 1403, 1404, 0,
+	1408, 0, 0, 915, 916, 917, 918, 919, 920, 921,
+	922, 923, 924, 925, 926, 927, 928, 929, 930, 931,
+	932, 933, 934, 935, 0, 0, 0, 0, 0, 1666,
+	0, 0, 0, 589, 0, 0, 0, 0, 1671, 1672,
+	0, 0, 597, 0, 0, 0, 1377, 0, 0, 0,
+	0, 304, 0, 0, 0, 0, 990, 0, 1001, 0,
+	1011, 1013, 1018, 1021, 1022, 1023, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 608, 0,
+	0, 0, 612, 0, 0, 596, 0, 0, 584, 585,
+	586, 0, 583, 580, 581, 582, 575, 576, 577, 578,
+	579, 0, 0, 0, 0, 76, 0, 0, 0, 0,
+	0, 0, 859, 0, 815, 0, 0, 0, 0, 0,
+	0, 1438, 0, 0, 0, 0, 0, 1441, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 76, 0,
+	0, 76, 0, 0, 0, 0, 0, 0, 0, 1456,
+	28, 0, 1309, 0, 0, 0, 1460, 1461, 859, 0,
+	29, 48, 0, 0, 441, 441, 0, 0, 0, 0,
+	1485, 30, 1486, 0, 76, 1488, 1489, 1490, 0, 0,
+	0, 0, 0, 49, 0, 1068, 0, 0, 0, 0,
+	0, 52, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 36, 0, 1514,
+	0, 0, 0, 37, 0, 0, 0, 0, 0, 0,
+	572, 0, 590, 591, 592, 38, 0, 1568, 0, 0,
+	0, 0, 593, 0, 39, 0, 0, 0, 574, 0,
+	0, 599, 0, 0, 0, 0, 304, 543, 0, 304,
+	304, 0, 552, 0, 0, 0, 1117, 1118, 573, 0,
+	0, 0, 0, 0, 587, 0, 0, 441, 0, 0,
+	859, 1541, 0, 1595, 0, 0, 0, 0, 76, 76,
+	1141, 0, 76, 0, 0, 0, 0, 0, 441, 1309,
+	0, 0, 0, 0, 859, 0, 1563, 0, 272, 0,
+	0, 0, 0, 0, 0, 76, 0, 40, 0, 0,
+	41, 42, 0, 0, 43, 0, 50, 0, 952, 0,
+	0, 0, 0, 59, 0, 600, 0, 46, 47, 0,
+	0, 0, 0, 0, 0, 274, 0, 598, 0, 0,
+	0, 0, 0, 61, 1152, 0, 595, 0, 1157, 290,
+	1644, 588, 0, 0, 0, 0, 51, 0, 0, 0,
+	0, 0, 1541, 0, 0, 0, 0, 1172, 441, 0,
+	62, 594, 0, 0, 0, 0, 1180, 57, 76, 0,
+	0, 0, 276, 58, 0, 0, 0, 76, 0, 441,
+	0, 1193, 0, 0, 0, 1198, 0, 0, 0, 0,
+	0, 0, 56, 0, 275, 277, 0, 0, 0, 589,
+	0, 0, 0, 1639, 1640, 0, 609, 0, 597, 0,
+	0, 0, 1018, 1018, 1018, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1651, 0, 278, 0, 0, 0,
+	0, 0, 1256, 1541, 1257, 279, 272, 0, 280, 0,
+	0, 0, 1665, 0, 0, 0, 441, 0, 0, 0,
+	0, 0, 0, 45, 0, 441, 441, 76, 0, 272,
+	0, 596, 0, 0, 584, 585, 586, 45, 583, 580,
+	581, 582, 575, 576, 577, 578, 579, 0, 0, 0,
+	1033, 0, 0, 1541, 0, 0, 0, 1034, 0, 0,
+	1288, 0, 0, 0, 0, 833, 0, 0, 281, 282,
+	0, 0, 0, 76, 0, 0, 0, 0, 0, 0,
+	1665, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	572, 0, 590, 591, 592, 0, 1321, 0, 0, 0,
+	0, 0, 593, 0, 1735, 284, 0, 0, 574, 0,
+	0, 599, 0, 285, 286, 0, 1107, 287, 0, 1341,
+	0, 1342, 288, 0, 0, 0, 0, 0, 573, 0,
+	0, 0, 1347, 0, 587, 283, 1735, 0, 572, 0,
+	590, 591, 592, 0, 0, 0, 1068, 0, 0, 0,
+	593, 289, 0, 0, 0, 0, 574, 0, 0, 599,
+	1374, 0, 0, 0, 0, 0, 0, 0, 0, 1383,
+	0, 0, 1385, 0, 0, 1206, 573, 1222, 1223, 1224,
+	0, 0, 587, 0, 0, 0, 0, 1495, 0, 0,
+	0, 0, 0, 0, 0, 600, 0, 0, 0, 0,
+	0, 0, 0, 1414, 1415, 0, 0, 598, 0, 0,
+	0, 0, 1421, 1422, 1423, 0, 595, 952, 0, 1219,
+	0, 588, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 608, 0, 552, 0, 0, 0, 0, 0, 0,
+	0, 594, 0, 600, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 598, 0, 0, 0, 0,
+	0, 0, 0, 0, 595, 0, 0, 0, 0, 588,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 589,
+	0, 0, 0, 0, 0, 0, 608, 0, 597, 594,
+	0, 0, 1225, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1220, 0, 0, 0,
+	0, 0, 0, 1497, 0, 0, 0, 312, 1501, 1502,
+	0, 1503, 0, 0, 1505, 0, 0, 589, 1507, 0,
+	0, 0, 0, 0, 0, 0, 597, 0, 0, 0,
+	0, 596, 0, 1512, 584, 585, 586, 1515, 583, 580,
+	581, 582, 575, 576, 577, 578, 579, 0, 0, 0,
+	0, 0, 0, 0, 1221, 1526, 0, 0, 0, 0,
+	572, 0, 590, 591, 592, 0, 0, 1523, 0, 45,
+	0, 0, 593, 0, 0, 0, 0, 1311, 574, 596,
+	0, 599, 584, 585, 586, 0, 583, 580, 581, 582,
+	575, 576, 577, 578, 579, 0, 0, 572, 573, 590,
+	591, 592, 0, 1246, 587, 0, 0, 0, 0, 593,
+	0, 0, 0, 0, 0, 574, 0, 0, 599, 1216,
+	1217, 1218, 0, 1215, 1212, 1213, 1214, 1207, 1208, 1209,
+	1210, 1211, 0, 0, 0, 573, 0, 0, 0, 0,
+	1578, 587, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1586, 1587, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 600, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1206, 598, 1222, 1223,
+	1224, 0, 0, 0, 1600, 0, 595, 0, 1351, 0,
+	0, 588, 0, 0, 0, 0, 1206, 0, 1222, 1223,
+	1224, 1617, 600, 0, 0, 0, 0, 1622, 1350, 0,
+	0, 594, 0, 1626, 598, 0, 0, 0, 0, 0,
+	1219, 0, 0, 595, 0, 1632, 0, 0, 588, 0,
+	0, 0, 0, 0, 0, 1206, 0, 1222, 1223, 1224,
+	1219, 0, 0, 0, 0, 0, 0, 0, 594, 589,
+	0, 0, 0, 0, 0, 0, 0, 1206, 597, 1222,
+	1223, 1224, 0, 0, 552, 1107, 0, 0, 1107, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1219,
+	0, 0, 0, 0, 0, 0, 589, 0, 0, 0,
+	0, 0, 0, 1225, 1206, 597, 1222, 1223, 1224, 0,
+	0, 1219, 0, 0, 0, 0, 0, 1220, 0, 0,
+	0, 596, 0, 1225, 584, 585, 586, 0, 583, 580,
+	581, 582, 575, 576, 577, 578, 579, 1220, 0, 0,
+	0, 0, 0, 1702, 0, 1245, 1709, 1709, 1219, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 596, 0,
+	0, 584, 585, 586, 0, 583, 580, 581, 582, 575,
+	576, 577, 578, 579, 1709, 1221, 1220, 0, 0, 0,
+	0, 0, 1244, 0, 1225, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1221, 0, 0, 1220, 0,
+	0, 0, 0, 0, 0, 0, 45, 0, 0, 1226,
+	0, 0, 1709, 0, 1752, 0, 0, 0, 0, 0,
+	0, 1225, 0, 0, 0, 1107, 1107, 0, 0, 1107,
+	0, 0, 0, 0, 1221, 1220, 0, 0, 0, 0,
+	1216, 1217, 1218, 0, 1215, 1212, 1213, 1214, 1207, 1208,
+	1209, 1210, 1211, 0, 0, 0, 1221, 0, 0, 0,
+	1216, 1217, 1218, 0, 1215, 1212, 1213, 1214, 1207, 1208,
+	1209, 1210, 1211, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1221, 0, 0, 0, 0, 0, 1216,
+	1217, 1218, 0, 1215, 1212, 1213, 1214, 1207, 1208, 1209,
+	1210, 1211, 1605, 0, 0, 0, 0, 0, 0, 0,
+	0, 1216, 1217, 1218, 0, 1215, 1212, 1213, 1214, 1207,
+	1208, 1209, 1210, 1211, 1107, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1216, 1217,
+	1218, 0, 1215, 1212, 1213, 1214, 1207, 1208, 1209, 1210,
+	1211, 0, 0, 659, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 608, 80, 81, 664, 82, 665,
+	666, 667, 668, 669, 670, 671, 672, 83, 84, 85,
+	188, 189, 190, 86, 191, 192, 673, 87, 193, 88,
+	89, 674, 675, 194, 195, 676, 196, 677, 474, 678,
+	90, 91, 92, 0, 93, 94, 95, 679, 96, 680,
+	425, 97, 98, 99, 681, 682, 683, 684, 685, 686,
+	100, 101, 273, 102, 197, 103, 198, 199, 687, 688,
+	104, 689, 690, 691, 105, 106, 692, 693, 0, 694,
+	200, 107, 201, 695, 696, 108, 109, 202, 110, 697,
+	698, 699, 426, 700, 111, 203, 701, 204, 702, 112,
+	205, 206, 113, 114, 703, 115, 704, 705, 427, 116,
+	207, 208, 209, 706, 210, 707, 428, 117, 429, 118,
+	708, 709, 211, 430, 119, 431, 710, 120, 711, 712,
+	0, 121, 122, 123, 124, 125, 432, 126, 127, 713,
+	128, 714, 212, 129, 213, 130, 131, 715, 716, 717,
+	718, 719, 132, 214, 433, 133, 434, 215, 134, 135,
+	136, 720, 216, 137, 217, 721, 138, 139, 140, 141,
+	142, 218, 143, 144, 722, 145, 146, 147, 148, 149,
+	150, 723, 151, 435, 152, 153, 219, 154, 0, 155,
+	156, 724, 157, 158, 725, 159, 160, 436, 161, 220,
+	162, 726, 163, 164, 165, 167, 221, 166, 222, 727,
+	168, 728, 169, 170, 729, 223, 224, 171, 730, 731,
+	172, 225, 226, 732, 173, 174, 175, 176, 733, 734,
+	177, 178, 735, 179, 736, 180, 181, 182, 227, 228,
+	737, 183, 738, 739, 740, 741, 184, 185, 186, 187,
+	0, 0, 659, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 658, 80, 81, 664, 82, 665, 666,
+	667, 668, 669, 670, 671, 672, 83, 84, 85, 188,
+	189, 190, 86, 191, 192, 673, 87, 193, 88, 89,
+	674, 675, 194, 195, 676, 196, 677, 474, 678, 90,
+	91, 92, 0, 93, 94, 95, 679, 96, 680, 425,
+	97, 98, 99, 681, 682, 683, 684, 685, 686, 100,
+	101, 273, 102, 197, 103, 198, 199, 687, 688, 104,
+	689, 690, 691, 105, 106, 692, 693, 0, 694, 200,
+	107, 201, 695, 696, 108, 109, 202, 110, 697, 698,
+	699, 426, 700, 111, 203, 701, 204, 702, 112, 205,
+	206, 113, 114, 703, 115, 704, 705, 427, 116, 207,
+	208, 209, 706, 210, 707, 428, 117, 429, 118, 708,
+	709, 211, 430, 119, 431, 710, 120, 711, 712, 0,
+	121, 122, 123, 124, 125, 432, 126, 127, 713, 128,
+	714, 212, 129, 213, 130, 131, 715, 716, 717, 718,
+	719, 132, 214, 433, 133, 434, 215, 134, 135, 136,
+	720, 216, 137, 217, 721, 138, 139, 140, 141, 142,
+	218, 143, 144, 722, 145, 146, 147, 148, 149, 150,
+	723, 151, 435, 152, 153, 219, 154, 0, 155, 156,
+	724, 157, 158, 725, 159, 160, 436, 161, 220, 162,
+	726, 163, 164, 165, 167, 221, 166, 222, 727, 168,
+	728, 169, 170, 729, 223, 224, 171, 730, 731, 172,
+	225, 226, 732, 173, 174, 175, 176, 733, 734, 177,
+	178, 735, 179, 736, 180, 181, 182, 227, 228, 737,
+	183, 738, 739, 740, 741, 184, 185, 186, 187, 373,
+	361, 362, 363, 360, 349, 0, 0, 0, 0, 0,
+	0, 80, 81, 969, 82, 0, 0, 0, 0, 355,
+	0, 0, 0, 83, 84, 85, 188, 402, 403, 86,
+	404, 405, 0, 87, 193, 88, 89, 370, 388, 406,
+	407, 0, 398, 0, 381, 0, 90, 91, 92, 0,
+	93, 94, 95, 0, 96, 0, 425, 97, 98, 99,
+	0, 382, 384, 0, 383, 385, 100, 101, 273, 102,
+	408, 103, 409, 410, 0, 0, 104, 0, 970, 0,
+	401, 106, 0, 0, 0, 0, 354, 107, 389, 368,
+	0, 108, 109, 411, 110, 0, 0, 0, 426, 0,
+	111, 399, 0, 204, 0, 112, 395, 397, 113, 114,
+	0, 115, 0, 0, 427, 116, 412, 413, 414, 0,
+	380, 0, 428, 117, 429, 118, 0, 0, 400, 430,
+	119, 431, 0, 120, 0, 0, 0, 121, 122, 123,
+	124, 125, 432, 126, 127, 344, 128, 369, 396, 129,
+	415, 130, 131, 0, 0, 0, 0, 0, 132, 214,
+	433, 133, 434, 390, 134, 135, 136, 0, 391, 137,
+	217, 0, 138, 139, 140, 141, 142, 416, 143, 144,
+	0, 145, 146, 147, 148, 149, 150, 0, 151, 435,
+	152, 153, 358, 154, 0, 155, 156, 0, 157, 158,
+	386, 159, 160, 436, 161, 417, 162, 0, 163, 164,
+	165, 167, 221, 166, 392, 0, 168, 0, 169, 170,
+	0, 223, 418, 171, 0, 0, 172, 393, 394, 367,
+	173, 174, 175, 176, 0, 0, 177, 178, 387, 179,
+	0, 180, 181, 182, 227, 419, 968, 183, 0, 0,
+	0, 0, 184, 185, 186, 187, 345, 0, 0, 373,
+	361, 362, 363, 360, 349, 0, 0, 341, 342, 971,
+	0, 80, 81, 343, 82, 0, 350, 966, 0, 355,
+	0, 0, 0, 83, 84, 85, 188, 402, 403, 86,
+	404, 405, 0, 87, 193, 88, 89, 370, 388, 406,
+	407, 0, 398, 0, 381, 0, 90, 91, 92, 0,
+	93, 94, 95, 0, 96, 0, 425, 97, 98, 99,
+	0, 382, 384, 0, 383, 385, 100, 101, 273, 102,
+	408, 103, 409, 410, 553, 0, 104, 0, 0, 0,
+	401, 106, 0, 0, 0, 0, 354, 107, 389, 368,
+	0, 108, 109, 411, 110, 0, 0, 0, 426, 0,
+	111, 399, 0, 204, 0, 112, 395, 397, 113, 114,
+	0, 115, 0, 0, 427, 116, 412, 413, 414, 0,
+	380, 0, 428, 117, 429, 118, 0, 0, 400, 430,
+	119, 431, 0, 120, 0, 0, 0, 121, 122, 123,
+	124, 125, 432, 126, 127, 344, 128, 369, 396, 129,
+	415, 130, 131, 0, 0, 0, 0, 0, 132, 214,
+	433, 133, 434, 390, 134, 135, 136, 0, 391, 137,
+	217, 0, 138, 139, 140, 141, 142, 416, 143, 144,
+	0, 145, 146, 147, 148, 149, 150, 0, 151, 435,
+	152, 153, 358, 154, 0, 155, 156, 59, 157, 158,
+	386, 159, 160, 436, 161, 417, 162, 0, 163, 164,
+	165, 167, 221, 166, 392, 0, 168, 61, 169, 170,
+	0, 223, 418, 171, 0, 0, 172, 393, 394, 367,
+	173, 174, 175, 176, 0, 0, 177, 178, 387, 179,
+	0, 180, 181, 182, 473, 419, 0, 183, 0, 0,
+	0, 57, 184, 185, 186, 187, 345, 58, 0, 373,
+	361, 362, 363, 360, 349, 0, 0, 341, 342, 0,
+	0, 80, 81, 343, 82, 0, 350, 0, 0, 355,
+	0, 0, 0, 83, 84, 85, 188, 402, 403, 86,
+	404, 405, 0, 87, 193, 88, 89, 370, 388, 406,
+	407, 0, 398, 0, 381, 0, 90, 91, 92, 0,
+	93, 94, 95, 0, 96, 0, 425, 97, 98, 99,
+	0, 382, 384, 0, 383, 385, 100, 101, 273, 102,
+	408, 103, 409, 410, 0, 0, 104, 0, 0, 0,
+	401, 106, 0, 0, 0, 0, 354, 107, 389, 368,
+	0, 108, 109, 411, 110, 0, 0, 0, 426, 0,
+	111, 399, 0, 204, 0, 112, 395, 397, 113, 114,
+	0, 115, 0, 0, 427, 116, 412, 413, 414, 0,
+	380, 0, 428, 117, 429, 118, 0, 0, 400, 430,
+	119, 431, 0, 120, 0, 0, 0, 121, 122, 123,
+	124, 125, 432, 126, 127, 344, 128, 369, 396, 129,
+	415, 130, 131, 0, 0, 0, 0, 0, 132, 214,
+	433, 133, 434, 390, 134, 135, 136, 0, 391, 137,
+	217, 0, 138, 139, 140, 141, 142, 416, 143, 144,
+	0, 145, 146, 147, 148, 149, 150, 0, 151, 435,
+	152, 153, 358, 154, 0, 155, 156, 59, 157, 158,
+	386, 159, 160, 436, 161, 417, 162, 0, 163, 164,
+	165, 167, 221, 166, 392, 0, 168, 61, 169, 170,
+	0, 223, 418, 171, 0, 0, 172, 393, 394, 367,
+	173, 174, 175, 176, 0, 0, 177, 178, 387, 179,
+	0, 180, 181, 182, 473, 419, 0, 183, 0, 0,
+	0, 57, 184, 185, 186, 187, 345, 58, 0, 373,
+	361, 362, 363, 360, 349, 0, 0, 341, 342, 0,
+	0, 80, 81, 343, 82, 0, 350, 0, 0, 355,
+	0, 0, 0, 83, 84, 85, 188, 402, 403, 86,
+	404, 405, 1014, 87, 193, 88, 89, 370, 388, 406,
+	407, 0, 398, 0, 381, 0, 90, 91, 92, 0,
+	93, 94, 95, 0, 96, 0, 425, 97, 98, 99,
+	0, 382, 384, 0, 383, 385, 100, 101, 273, 102,
+	408, 103, 409, 410, 0, 0, 104, 0, 0, 0,
+	401, 106, 0, 0, 0, 0, 354, 107, 389, 368,
+	0, 108, 109, 411, 110, 0, 0, 1019, 426, 0,
+	111, 399, 0, 204, 0, 112, 395, 397, 113, 114,
+	0, 115, 0, 0, 427, 116, 412, 413, 414, 0,
+	380, 0, 428, 117, 429, 118, 0, 1015, 400, 430,
+	119, 431, 0, 120, 0, 0, 0, 121, 122, 123,
+	124, 125, 432, 126, 127, 344, 128, 369, 396, 129,
+	415, 130, 131, 0, 0, 0, 0, 0, 132, 214,
+	433, 133, 434, 390, 134, 135, 136, 0, 391, 137,
+	217, 0, 138, 139, 140, 141, 142, 416, 143, 144,
+	0, 145, 146, 147, 148, 149, 150, 0, 151, 435,
+	152, 153, 358, 154, 0, 155, 156, 0, 157, 158,
+	386, 159, 160, 436, 161, 417, 162, 0, 163, 164,
+	165, 167, 221, 166, 392, 0, 168, 0, 169, 170,
+	0, 223, 418, 171, 0, 1016, 172, 393, 394, 367,
+	173, 174, 175, 176, 0, 0, 177, 178, 387, 179,
+	0, 180, 181, 182, 227, 419, 0, 183, 0, 0,
+	0, 0, 184, 185, 186, 187, 345, 373, 361, 362,
+	363, 360, 349, 0, 0, 0, 0, 341, 342, 80,
+	81, 0, 82, 343, 0, 0, 350, 355, 0, 0,
+	0, 83, 84, 85, 188, 402, 403, 86, 404, 405,
+	0, 87, 193, 88, 89, 370, 388, 406, 407, 0,
+	398, 0, 381, 0, 90, 91, 92, 0, 93, 94,
+	95, 0, 96, 0, 425, 97, 98, 99, 0, 382,
+	384, 0, 383, 385, 100, 101, 273, 102, 408, 103,
+	409, 410, 0, 0, 104, 0, 0, 0, 401, 106,
+	0, 0, 0, 0, 354, 107, 389, 368, 0, 108,
+	109, 411, 110, 0, 0, 0, 426, 0, 111, 399,
+	0, 204, 0, 112, 395, 397, 113, 114, 0, 115,
+	0, 0, 427, 116, 412, 413, 414, 0, 380, 0,
+	428, 117, 429, 118, 0, 0, 400, 430, 119, 431,
+	0, 120, 0, 0, 0, 121, 122, 123, 124, 125,
+	432, 126, 127, 344, 128, 369, 396, 129, 415, 130,
+	131, 0, 0, 0, 0, 0, 132, 214, 433, 133,
+	434, 390, 134, 135, 136, 0, 391, 137, 217, 0,
+	138, 139, 140, 141, 142, 416, 143, 144, 0, 145,
+	146, 147, 148, 149, 150, 0, 151, 435, 152, 153,
+	358, 154, 0, 155, 156, 0, 157, 158, 386, 159,
+	160, 436, 161, 417, 162, 0, 163, 164, 165, 167,
+	221, 166, 392, 0, 168, 0, 169, 170, 0, 223,
+	418, 171, 0, 0, 172, 393, 394, 367, 173, 174,
+	175, 176, 0, 0, 177, 178, 387, 179, 0, 180,
+	181, 182, 227, 419, 0, 183, 0, 0, 0, 0,
+	184, 185, 186, 187, 345, 0, 0, 373, 361, 362,
+	363, 360, 349, 0, 0, 341, 342, 0, 0, 80,
+	81, 343, 82, 0, 350, 1411, 0, 355, 0, 0,
+	0, 83, 84, 85, 188, 402, 403, 86, 404, 405,
+	0, 87, 193, 88, 89, 370, 388, 406, 407, 0,
+	398, 0, 381, 0, 90, 91, 92, 0, 93, 94,
+	95, 0, 96, 0, 425, 97, 98, 99, 0, 382,
+	384, 0, 383, 385, 100, 101, 273, 102, 408, 103,
+	409, 410, 0, 0, 104, 0, 0, 0, 401, 106,
+	0, 0, 0, 0, 354, 107, 389, 368, 0, 108,
+	109, 411, 110, 0, 0, 0, 426, 0, 111, 399,
+	0, 204, 0, 112, 395, 397, 113, 114, 0, 115,
+	0, 0, 427, 116, 412, 413, 414, 0, 380, 0,
+	428, 117, 429, 118, 0, 0, 400, 430, 119, 431,
+	0, 120, 0, 0, 0, 121, 122, 123, 124, 125,
+	432, 126, 127, 344, 128, 369, 396, 129, 415, 130,
+	131, 0, 0, 0, 0, 0, 132, 214, 433, 133,
+	434, 390, 134, 135, 136, 0, 391, 137, 217, 0,
+	138, 139, 140, 141, 142, 416, 143, 144, 0, 145,
+	146, 147, 148, 149, 150, 0, 151, 435, 152, 153,
+	358, 154, 0, 155, 156, 0, 157, 158, 386, 159,
+	160, 436, 161, 417, 162, 0, 163, 164, 165, 167,
+	221, 166, 392, 0, 168, 0, 169, 170, 0, 223,
+	418, 171, 0, 0, 172, 393, 394, 367, 173, 174,
+	175, 176, 0, 0, 177, 178, 387, 179, 0, 180,
+	181, 182, 227, 419, 0, 183, 0, 0, 0, 0,
+	184, 185, 186, 187, 345, 0, 0, 373, 361, 362,
+	363, 360, 349, 0, 0, 341, 342, 0, 0, 80,
+	81, 343, 82, 0, 350, 1355, 0, 355, 0, 0,
+	0, 83, 84, 85, 188, 402, 403, 86, 404, 405,
+	0, 87, 193, 88, 89, 370, 388, 406, 407, 0,
+	398, 0, 381, 0, 90, 91, 92, 0, 93, 94,
+	95, 0, 96, 0, 425, 97, 98, 99, 0, 382,
+	384, 0, 383, 385, 100, 101, 273, 102, 408, 103,
+	409, 410, 0, 0, 104, 0, 0, 0, 401, 106,
+	0, 0, 0, 0, 354, 107, 389, 368, 0, 108,
+	109, 411, 110, 0, 0, 0, 426, 0, 111, 399,
+	0, 204, 0, 112, 395, 397, 113, 114, 0, 115,
+	0, 0, 427, 116, 412, 413, 414, 0, 380, 0,
+	428, 117, 429, 118, 0, 0, 400, 430, 119, 431,
+	0, 120, 0, 0, 0, 121, 122, 123, 124, 125,
+	432, 126, 127, 344, 128, 369, 396, 129, 415, 130,
+	131, 0, 0, 0, 0, 0, 132, 214, 433, 133,
+	434, 390, 134, 135, 136, 0, 391, 137, 217, 0,
+	138, 139, 140, 141, 142, 416, 143, 144, 0, 145,
+	146, 147, 148, 149, 150, 0, 151, 435, 152, 153,
+	358, 154, 0, 155, 156, 0, 157, 158, 386, 159,
+	160, 436, 161, 417, 162, 0, 163, 164, 165, 167,
+	221, 166, 392, 0, 168, 0, 169, 170, 0, 223,
+	418, 171, 0, 0, 172, 393, 394, 367, 173, 174,
+	175, 176, 0, 0, 177, 178, 387, 179, 0, 180,
+	181, 182, 227, 419, 0, 183, 0, 0, 0, 0,
+	184, 185, 186, 187, 345, 0, 0, 373, 361, 362,
+	363, 360, 349, 0, 0, 341, 342, 0, 0, 80,
+	81, 343, 82, 0, 350, 965, 0, 355, 0, 0,
+	0, 83, 84, 85, 188, 402, 403, 86, 404, 405,
+	0, 87, 193, 88, 89, 370, 388, 406, 407, 0,
+	398, 0, 381, 0, 90, 91, 92, 0, 93, 94,
+	95, 0, 96, 0, 425, 97, 98, 99, 0, 382,
+	384, 0, 383, 385, 100, 101, 273, 102, 408, 103,
+	409, 410, 0, 0, 104, 0, 0, 0, 401, 106,
+	0, 0, 0, 0, 354, 107, 389, 368, 0, 108,
+	109, 411, 110, 0, 0, 0, 426, 0, 111, 399,
+	0, 204, 0, 112, 395, 397, 113, 114, 0, 115,
+	0, 0, 427, 116, 412, 413, 414, 0, 380, 0,
+	428, 117, 429, 118, 0, 0, 400, 430, 119, 431,
+	0, 120, 0, 0, 0, 121, 122, 123, 124, 125,
+	432, 126, 127, 344, 128, 369, 396, 129, 415, 130,
+	131, 0, 0, 0, 0, 0, 132, 214, 433, 133,
+	434, 390, 134, 135, 136, 0, 391, 137, 217, 0,
+	138, 139, 140, 141, 142, 416, 143, 144, 0, 145,
+	146, 147, 148, 149, 150, 0, 151, 435, 152, 153,
+	358, 154, 0, 155, 156, 0, 157, 158, 386, 159,
+	160, 436, 161, 417, 162, 0, 163, 164, 165, 167,
+	221, 166, 392, 0, 168, 0, 169, 170, 0, 223,
+	418, 171, 0, 0, 172, 393, 394, 367, 173, 174,
+	175, 176, 0, 0, 177, 178, 387, 179, 0, 180,
+	181, 182, 227, 419, 0, 183, 0, 0, 0, 0,
+	184, 185, 186, 187, 345, 373, 361, 362, 363, 360,
+	349, 0, 0, 0, 0, 341, 342, 80, 81, 0,
+	82, 343, 614, 962, 350, 355, 0, 0, 0, 83,
+	84, 85, 188, 402, 403, 86, 404, 405, 0, 87,
+	193, 88, 89, 370, 388, 406, 407, 0, 398, 0,
+	381, 0, 90, 91, 92, 0, 93, 94, 95, 0,
+	96, 0, 425, 97, 98, 99, 0, 382, 384, 0,
+	383, 385, 100, 101, 273, 102, 408, 103, 409, 410,
+	553, 0, 104, 0, 0, 0, 401, 106, 0, 0,
+	0, 0, 354, 107, 389, 368, 0, 108, 109, 411,
+	110, 0, 0, 0, 426, 0, 111, 399, 0, 204,
+	0, 112, 395, 397, 113, 114, 0, 115, 0, 0,
+	427, 116, 412, 413, 414, 0, 380, 0, 428, 117,
+	429, 118, 0, 0, 400, 430, 119, 431, 0, 120,
+	0, 0, 0, 121, 122, 123, 124, 125, 432, 126,
+	127, 344, 128, 369, 396, 129, 415, 130, 131, 0,
+	0, 0, 0, 0, 132, 214, 433, 133, 434, 390,
+	134, 135, 136, 0, 391, 137, 217, 0, 138, 139,
+	140, 141, 142, 416, 143, 144, 0, 145, 146, 147,
+	148, 149, 150, 0, 151, 435, 152, 153, 358, 154,
+	0, 155, 156, 0, 157, 158, 386, 159, 160, 436,
+	161, 417, 162, 0, 163, 164, 165, 167, 221, 166,
+	392, 0, 168, 0, 169, 170, 0, 223, 418, 171,
+	0, 0, 172, 393, 394, 367, 173, 174, 175, 176,
+	0, 0, 177, 178, 387, 179, 0, 180, 181, 182,
+	227, 419, 0, 183, 0, 0, 0, 0, 184, 185,
+	186, 187, 345, 373, 361, 362, 363, 360, 349, 0,
+	0, 0, 0, 341, 342, 80, 81, 0, 82, 343,
+	0, 0, 350, 355, 0, 0, 0, 83, 84, 85,
+	188, 402, 403, 86, 404, 405, 0, 87, 193, 88,
+	89, 370, 388, 406, 407, 0, 398, 0, 381, 0,
+	90, 91, 92, 0, 93, 94, 95, 0, 96, 0,
+	425, 97, 98, 99, 0, 382, 384, 0, 383, 385,
+	100, 101, 273, 102, 408, 103, 409, 410, 0, 0,
+	104, 0, 0, 0, 401, 106, 0, 0, 0, 0,
+	354, 107, 389, 368, 0, 108, 109, 411, 110, 0,
+	0, 0, 426, 0, 111, 399, 0, 204, 0, 112,
+	395, 397, 113, 114, 0, 115, 0, 0, 427, 116,
+	412, 413, 414, 0, 380, 0, 428, 117, 429, 118,
+	0, 0, 400, 430, 119, 431, 0, 120, 0, 0,
+	0, 121, 122, 123, 124, 125, 432, 126, 127, 344,
+	128, 369, 396, 129, 415, 130, 131, 0, 0, 0,
+	0, 0, 132, 214, 433, 133, 434, 390, 134, 135,
+	136, 0, 391, 137, 217, 0, 138, 139, 140, 141,
+	142, 416, 143, 144, 0, 145, 146, 147, 148, 149,
+	150, 0, 151, 435, 152, 153, 358, 154, 0, 155,
+	156, 0, 157, 158, 386, 159, 160, 436, 161, 417,
+	162, 0, 163, 164, 165, 167, 221, 166, 392, 0,
+	168, 0, 169, 170, 0, 223, 418, 171, 0, 0,
+	172, 393, 394, 367, 173, 174, 175, 176, 0, 0,
+	177, 178, 387, 179, 0, 180, 181, 182, 227, 419,
+	1360, 183, 0, 0, 0, 0, 184, 185, 186, 187,
+	345, 373, 361, 362, 363, 360, 349, 0, 0, 0,
+	0, 341, 342, 80, 81, 0, 82, 343, 0, 0,
+	350, 355, 0, 0, 0, 83, 84, 85, 188, 402,
+	403, 86, 404, 405, 0, 87, 193, 88, 89, 370,
+	388, 406, 407, 0, 398, 0, 381, 0, 90, 91,
+	92, 0, 93, 94, 95, 0, 96, 0, 425, 97,
+	98, 99, 0, 382, 384, 0, 383, 385, 100, 101,
+	273, 102, 408, 103, 409, 410, 0, 0, 104, 0,
+	0, 0, 401, 106, 0, 0, 0, 0, 354, 107,
+	389, 368, 0, 108, 109, 411, 110, 0, 0, 1019,
+	426, 0, 111, 399, 0, 204, 0, 112, 395, 397,
+	113, 114, 0, 115, 0, 0, 427, 116, 412, 413,
+	414, 0, 380, 0, 428, 117, 429, 118, 0, 0,
+	400, 430, 119, 431, 0, 120, 0, 0, 0, 121,
+	122, 123, 124, 125, 432, 126, 127, 344, 128, 369,
+	396, 129, 415, 130, 131, 0, 0, 0, 0, 0,
+	132, 214, 433, 133, 434, 390, 134, 135, 136, 0,
+	391, 137, 217, 0, 138, 139, 140, 141, 142, 416,
+	143, 144, 0, 145, 146, 147, 148, 149, 150, 0,
+	151, 435, 152, 153, 358, 154, 0, 155, 156, 0,
+	157, 158, 386, 159, 160, 436, 161, 417, 162, 0,
+	163, 164, 165, 167, 221, 166, 392, 0, 168, 0,
+	169, 170, 0, 223, 418, 171, 0, 0, 172, 393,
+	394, 367, 173, 174, 175, 176, 0, 0, 177, 178,
+	387, 179, 0, 180, 181, 182, 227, 419, 0, 183,
+	0, 0, 0, 0, 184, 185, 186, 187, 345, 373,
+	361, 362, 363, 360, 349, 0, 0, 0, 0, 341,
+	342, 80, 81, 0, 82, 343, 0, 0, 350, 355,
+	0, 0, 0, 83, 84, 85, 188, 402, 403, 86,
+	404, 405, 0, 87, 193, 88, 89, 370, 388, 406,
+	407, 0, 398, 0, 381, 0, 90, 91, 92, 0,
+	93, 94, 95, 0, 96, 0, 425, 97, 98, 99,
+	0, 382, 384, 0, 383, 385, 100, 101, 273, 102,
+	408, 103, 409, 410, 0, 0, 104, 0, 0, 0,
+	401, 106, 0, 0, 0, 0, 354, 107, 389, 368,
+	0, 108, 109, 411, 110, 0, 0, 0, 426, 0,
+	111, 399, 0, 204, 0, 112, 395, 397, 113, 114,
+	0, 115, 0, 0, 427, 116, 412, 413, 414, 0,
+	380, 0, 428, 117, 429, 118, 0, 0, 400, 430,
+	119, 431, 0, 120, 0, 0, 0, 121, 122, 123,
+	124, 125, 432, 126, 127, 344, 128, 369, 396, 129,
+	415, 130, 131, 0, 0, 0, 0, 0, 132, 214,
+	433, 133, 434, 390, 134, 135, 136, 0, 391, 137,
+	217, 0, 138, 139, 140, 141, 142, 416, 143, 144,
+	0, 145, 146, 147, 148, 149, 150, 0, 151, 435,
+	152, 153, 358, 154, 0, 155, 156, 0, 157, 158,
+	386, 159, 160, 436, 161, 417, 162, 0, 163, 164,
+	165, 167, 221, 166, 392, 0, 168, 0, 169, 170,
+	0, 223, 418, 171, 0, 0, 172, 393, 394, 367,
+	173, 174, 175, 176, 0, 0, 177, 178, 387, 179,
+	0, 180, 181, 182, 227, 419, 0, 183, 0, 0,
+	0, 0, 184, 185, 186, 187, 345, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 341, 342, 544,
+	0, 0, 0, 343, 0, 0, 350, 373, 361, 362,
+	363, 360, 349, 0, 0, 0, 0, 0, 0, 80,
+	81, 825, 82, 0, 0, 0, 0, 355, 0, 0,
+	0, 83, 84, 85, 188, 402, 403, 86, 404, 405,
+	0, 87, 193, 88, 89, 370, 388, 406, 407, 0,
+	398, 0, 381, 0, 90, 91, 92, 0, 93, 94,
+	95, 0, 96, 0, 425, 97, 98, 99, 0, 382,
+	384, 0, 383, 385, 100, 101, 273, 102, 408, 103,
+	409, 410, 0, 0, 104, 0, 0, 0, 401, 106,
+	0, 0, 0, 0, 354, 107, 389, 368, 0, 108,
+	109, 411, 110, 0, 0, 0, 426, 0, 111, 399,
+	0, 204, 0, 112, 395, 397, 113, 114, 0, 115,
+	0, 0, 427, 116, 412, 413, 414, 0, 380, 0,
+	428, 117, 429, 118, 0, 0, 400, 430, 119, 431,
+	0, 120, 0, 0, 0, 121, 122, 123, 124, 125,
+	432, 126, 127, 344, 128, 369, 396, 129, 415, 130,
+	131, 0, 0, 0, 0, 0, 132, 214, 433, 133,
+	434, 390, 134, 135, 136, 0, 391, 137, 217, 0,
+	138, 139, 140, 141, 142, 416, 143, 144, 0, 145,
+	146, 147, 148, 149, 150, 0, 151, 435, 152, 153,
+	358, 154, 0, 155, 156, 0, 157, 158, 386, 159,
+	160, 436, 161, 417, 162, 0, 163, 164, 165, 167,
+	221, 166, 392, 0, 168, 0, 169, 170, 0, 223,
+	418, 171, 0, 0, 172, 393, 394, 367, 173, 174,
+	175, 176, 0, 0, 177, 178, 387, 179, 0, 180,
+	181, 182, 227, 419, 0, 183, 0, 0, 0, 0,
+	184, 185, 186, 187, 345, 373, 361, 362, 363, 360,
+	349, 0, 0, 0, 0, 341, 342, 80, 81, 0,
+	82, 343, 0, 0, 350, 355, 0, 0, 0, 83,
+	84, 85, 188, 402, 403, 86, 404, 405, 0, 87,
+	193, 88, 89, 370, 388, 406, 407, 0, 398, 0,
+	381, 0, 90, 91, 92, 0, 93, 94, 95, 0,
+	96, 0, 425, 97, 98, 99, 0, 382, 384, 0,
+	383, 385, 100, 101, 273, 102, 408, 103, 409, 410,
+	0, 0, 104, 0, 0, 0, 401, 106, 0, 0,
+	0, 0, 354, 107, 389, 368, 0, 108, 109, 411,
+	110, 0, 0, 0, 426, 0, 111, 399, 0, 204,
+	0, 112, 395, 397, 113, 114, 0, 115, 0, 0,
+	427, 116, 412, 413, 414, 0, 380, 0, 428, 117,
+	429, 118, 0, 0, 400, 430, 119, 431, 0, 120,
+	0, 0, 0, 121, 122, 123, 124, 125, 432, 126,
+	127, 344, 128, 369, 396, 129, 415, 130, 131, 0,
+	0, 0, 0, 0, 132, 214, 433, 133, 434, 390,
+	134, 135, 136, 0, 391, 137, 217, 0, 138, 139,
+	140, 141, 142, 416, 143, 144, 0, 145, 146, 147,
+	148, 149, 150, 0, 151, 435, 152, 153, 358, 154,
+	0, 155, 156, 0, 157, 158, 386, 159, 160, 436,
+	161, 417, 162, 0, 163, 164, 165, 167, 221, 166,
+	392, 0, 168, 0, 169, 170, 0, 223, 418, 171,
+	0, 0, 172, 393, 394, 367, 173, 174, 175, 176,
+	0, 0, 177, 178, 387, 179, 0, 180, 181, 182,
+	227, 419, 0, 183, 0, 0, 0, 0, 184, 185,
+	186, 187, 345, 373, 361, 362, 363, 360, 349, 0,
+	0, 0, 0, 341, 342, 80, 81, 0, 82, 343,
+	0, 0, 350, 355, 0, 0, 0, 83, 84, 85,
+	188, 402, 403, 86, 404, 405, 0, 87, 193, 88,
+	89, 370, 388, 406, 407, 0, 398, 0, 381, 0,
+	90, 91, 92, 0, 93, 94, 95, 0, 96, 0,
+	425, 97, 98, 1708, 0, 382, 384, 0, 383, 385,
+	100, 101, 273, 102, 408, 103, 409, 410, 0, 0,
+	104, 0, 0, 0, 401, 106, 0, 0, 0, 0,
+	354, 107, 389, 368, 0, 108, 109, 411, 110, 0,
+	0, 0, 426, 0, 111, 399, 0, 204, 0, 112,
+	395, 397, 113, 114, 0, 115, 0, 0, 427, 116,
+	412, 413, 414, 0, 380, 0, 428, 117, 429, 118,
+	0, 0, 400, 430, 119, 431, 0, 120, 0, 0,
+	0, 121, 122, 123, 124, 125, 432, 126, 127, 344,
+	128, 369, 396, 129, 415, 130, 131, 0, 0, 0,
+	0, 0, 132, 214, 433, 133, 434, 390, 134, 135,
+	136, 0, 391, 137, 217, 0, 138, 139, 140, 141,
+	142, 416, 143, 144, 0, 145, 146, 147, 148, 149,
+	150, 0, 151, 435, 152, 153, 358, 154, 0, 155,
+	156, 0, 157, 158, 386, 159, 160, 436, 161, 417,
+	162, 0, 163, 164, 165, 167, 221, 166, 392, 0,
+	168, 0, 169, 170, 0, 223, 418, 171, 0, 0,
+	172, 393, 394, 367, 173, 174, 1707, 176, 0, 0,
+	177, 178, 387, 179, 0, 180, 181, 182, 227, 419,
+	0, 183, 0, 0, 0, 0, 184, 185, 186, 187,
+	345, 373, 361, 362, 363, 360, 349, 0, 0, 0,
+	0, 341, 342, 80, 81, 0, 82, 343, 0, 0,
+	350, 355, 0, 0, 0, 83, 84, 85, 1706, 402,
+	403, 86, 404, 405, 0, 87, 193, 88, 89, 370,
+	388, 406, 407, 0, 398, 0, 381, 0, 90, 91,
+	92, 0, 93, 94, 95, 0, 96, 0, 425, 97,
+	98, 1708, 0, 382, 384, 0, 383, 385, 100, 101,
+	273, 102, 408, 103, 409, 410, 0, 0, 104, 0,
+	0, 0, 401, 106, 0, 0, 0, 0, 354, 107,
+	389, 368, 0, 108, 109, 411, 110, 0, 0, 0,
+	426, 0, 111, 399, 0, 204, 0, 112, 395, 397,
+	113, 114, 0, 115, 0, 0, 427, 116, 412, 413,
+	414, 0, 380, 0, 428, 117, 429, 118, 0, 0,
+	400, 430, 119, 431, 0, 120, 0, 0, 0, 121,
+	122, 123, 124, 125, 432, 126, 127, 344, 128, 369,
+	396, 129, 415, 130, 131, 0, 0, 0, 0, 0,
+	132, 214, 433, 133, 434, 390, 134, 135, 136, 0,
+	391, 137, 217, 0, 138, 139, 140, 141, 142, 416,
+	143, 144, 0, 145, 146, 147, 148, 149, 150, 0,
+	151, 435, 152, 153, 358, 154, 0, 155, 156, 0,
+	157, 158, 386, 159, 160, 436, 161, 417, 162, 0,
+	163, 164, 165, 167, 221, 166, 392, 0, 168, 0,
+	169, 170, 0, 223, 418, 171, 0, 0, 172, 393,
+	394, 367, 173, 174, 1707, 176, 0, 0, 177, 178,
+	387, 179, 0, 180, 181, 182, 227, 419, 0, 183,
+	0, 0, 0, 0, 184, 185, 186, 187, 345, 373,
+	361, 362, 363, 360, 349, 0, 0, 0, 0, 341,
+	342, 80, 81, 0, 82, 343, 0, 0, 350, 355,
+	0, 0, 0, 83, 84, 85, 188, 402, 403, 86,
+	404, 405, 0, 87, 193, 88, 89, 370, 388, 406,
+	407, 0, 398, 0, 381, 0, 90, 91, 92, 0,
+	93, 94, 95, 0, 96, 0, 425, 97, 98, 99,
+	0, 382, 384, 0, 383, 385, 100, 101, 273, 102,
+	408, 103, 409, 410, 0, 0, 104, 0, 0, 0,
+	401, 106, 0, 0, 0, 0, 354, 107, 389, 368,
+	0, 108, 109, 411, 110, 0, 0, 0, 426, 0,
+	111, 399, 0, 204, 0, 112, 395, 397, 113, 114,
+	0, 115, 0, 0, 427, 116, 412, 413, 414, 0,
+	380, 0, 428, 117, 429, 118, 0, 0, 400, 430,
+	119, 431, 0, 120, 0, 0, 0, 121, 122, 123,
+	124, 125, 432, 126, 127, 0, 128, 369, 396, 129,
+	415, 130, 131, 0, 0, 0, 0, 0, 132, 214,
+	433, 133, 434, 390, 134, 135, 136, 0, 391, 137,
+	217, 0, 138, 139, 140, 141, 142, 416, 143, 144,
+	0, 145, 146, 147, 148, 149, 150, 0, 151, 435,
+	152, 153, 1009, 154, 0, 155, 156, 0, 157, 158,
+	386, 159, 160, 436, 161, 417, 162, 0, 163, 164,
+	165, 167, 221, 166, 392, 0, 168, 0, 169, 170,
+	0, 223, 418, 171, 0, 0, 172, 393, 394, 367,
+	173, 174, 175, 176, 0, 0, 177, 178, 387, 179,
+	0, 180, 181, 182, 227, 419, 0, 183, 0, 0,
+	0, 0, 184, 185, 186, 187, 0, 373, 361, 362,
+	363, 360, 349, 0, 0, 0, 0, 1005, 1006, 80,
+	81, 0, 82, 1007, 0, 0, 1008, 355, 0, 0,
+	0, 83, 84, 85, 0, 402, 403, 86, 404, 405,
+	0, 87, 193, 88, 89, 370, 388, 406, 407, 0,
+	398, 0, 381, 0, 90, 91, 92, 0, 93, 94,
+	95, 0, 96, 0, 425, 97, 98, 1708, 0, 382,
+	384, 0, 383, 385, 100, 101, 273, 102, 408, 103,
+	409, 410, 0, 0, 104, 0, 0, 0, 401, 106,
+	0, 0, 0, 0, 354, 107, 389, 368, 0, 108,
+	109, 411, 110, 0, 0, 0, 426, 0, 111, 399,
+	0, 204, 0, 112, 395, 397, 113, 114, 0, 115,
+	0, 0, 427, 116, 412, 413, 414, 0, 380, 0,
+	0, 117, 429, 118, 0, 0, 400, 430, 119, 0,
+	0, 120, 0, 0, 0, 121, 122, 123, 124, 125,
+	432, 126, 127, 344, 128, 369, 396, 129, 415, 130,
+	131, 0, 0, 0, 0, 0, 132, 214, 433, 133,
+	434, 390, 134, 135, 136, 0, 391, 137, 217, 0,
+	138, 139, 140, 141, 142, 416, 143, 144, 0, 145,
+	146, 147, 148, 149, 150, 0, 151, 435, 152, 153,
+	358, 154, 0, 155, 156, 0, 157, 158, 386, 159,
+	160, 0, 161, 417, 162, 0, 163, 164, 165, 167,
+	221, 166, 392, 0, 168, 0, 169, 170, 0, 223,
+	418, 171, 0, 0, 172, 393, 394, 367, 173, 174,
+	1707, 176, 0, 0, 177, 178, 387, 179, 0, 180,
+	181, 182, 227, 419, 0, 183, 0, 0, 0, 0,
+	184, 185, 186, 187, 0, 373, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 341, 342, 80, 81, 0,
+	82, 343, 0, 0, 350, 0, 0, 0, 0, 83,
+	84, 85, 188, 189, 190, 86, 191, 192, 0, 87,
+	193, 88, 89, 0, 388, 194, 195, 0, 398, 0,
+	381, 0, 90, 91, 92, 0, 93, 94, 95, 0,
+	96, 0, 425, 97, 98, 99, 0, 382, 384, 0,
+	383, 385, 100, 101, 273, 102, 197, 103, 198, 199,
+	0, 0, 104, 0, 0, 0, 105, 106, 0, 0,
+	0, 0, 200, 107, 389, 0, 0, 108, 109, 202,
+	110, 0, 0, 0, 426, 0, 111, 399, 0, 204,
+	0, 112, 395, 397, 113, 114, 0, 115, 0, 0,
+	427, 116, 207, 208, 209, 0, 210, 0, 428, 117,
+	429, 118, 0, 0, 400, 430, 119, 431, 0, 120,
+	0, 0, 0, 121, 122, 123, 124, 125, 432, 126,
+	127, 0, 128, 0, 396, 129, 213, 130, 131, 0,
+	0, 0, 0, 0, 132, 214, 433, 133, 434, 390,
+	134, 135, 136, 0, 391, 137, 217, 0, 138, 139,
+	140, 141, 142, 218, 143, 144, 0, 145, 146, 147,
+	148, 149, 150, 0, 151, 435, 152, 153, 219, 154,
+	0, 155, 156, 0, 157, 158, 386, 159, 160, 436,
+	161, 220, 162, 0, 163, 164, 165, 167, 221, 166,
+	392, 0, 168, 0, 169, 170, 0, 223, 224, 171,
+	0, 0, 172, 393, 394, 0, 173, 174, 175, 176,
+	0, 0, 177, 178, 387, 179, 0, 180, 181, 182,
+	227, 228, 0, 183, 0, 0, 0, 0, 184, 185,
+	186, 187, 469, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 80, 81, 0, 82, 0, 468,
+	0, 0, 1543, 0, 0, 0, 83, 84, 85, 188,
+	189, 190, 86, 191, 192, 0, 87, 193, 88, 89,
+	0, 0, 194, 195, 0, 196, 0, 474, 0, 90,
+	91, 92, 0, 93, 94, 95, 0, 96, 0, 425,
+	97, 98, 99, 0, 0, 0, 0, 0, 0, 100,
+	101, 273, 102, 197, 103, 198, 199, 0, 0, 104,
+	0, 0, 0, 105, 106, 0, 0, 0, 0, 200,
+	107, 201, 0, 0, 108, 109, 202, 110, 0, 0,
+	0, 426, 0, 111, 203, 0, 204, 0, 112, 205,
+	206, 113, 114, 0, 115, 0, 0, 427, 116, 207,
+	208, 209, 0, 210, 0, 428, 117, 429, 118, 0,
+	0, 211, 430, 119, 431, 0, 120, 0, 0, 0,
+	121, 122, 123, 124, 125, 432, 126, 127, 0, 128,
+	0, 212, 129, 213, 130, 131, 0, 0, 0, 0,
+	0, 132, 214, 433, 133, 434, 215, 134, 135, 136,
+	0, 216, 137, 217, 0, 138, 139, 140, 141, 142,
+	218, 143, 144, 0, 145, 146, 147, 148, 149, 150,
+	0, 151, 435, 152, 153, 219, 154, 0, 155, 156,
+	59, 157, 158, 0, 159, 160, 436, 161, 220, 162,
+	0, 163, 164, 165, 167, 221, 166, 222, 0, 168,
+	61, 169, 170, 0, 223, 224, 171, 0, 0, 172,
+	225, 226, 0, 173, 174, 175, 176, 0, 0, 177,
+	178, 0, 179, 0, 180, 181, 182, 473, 228, 0,
+	183, 0, 0, 0, 57, 184, 185, 186, 187, 0,
+	58, 469, 780, 784, 0, 785, 775, 0, 0, 0,
+	0, 0, 0, 80, 81, 0, 82, 0, 0, 56,
+	0, 0, 0, 0, 0, 83, 84, 85, 188, 189,
+	190, 86, 191, 192, 0, 87, 193, 88, 89, 0,
+	0, 194, 195, 0, 196, 0, 474, 0, 90, 91,
+	92, 0, 93, 94, 95, 0, 96, 0, 425, 97,
+	98, 99, 0, 0, 0, 0, 0, 0, 100, 101,
+	273, 102, 197, 103, 198, 199, 788, 0, 104, 0,
+	0, 0, 105, 106, 0, 0, 0, 0, 200, 107,
+	201, 777, 0, 108, 109, 202, 110, 0, 0, 0,
+	426, 0, 111, 203, 0, 204, 0, 112, 205, 206,
+	113, 114, 0, 115, 0, 0, 427, 116, 207, 208,
+	209, 0, 210, 0, 428, 117, 429, 118, 0, 0,
+	211, 430, 119, 431, 0, 120, 0, 0, 0, 121,
+	122, 123, 124, 125, 432, 126, 127, 0, 128, 0,
+	212, 129, 213, 130, 131, 0, 778, 0, 0, 0,
+	132, 214, 433, 133, 434, 215, 134, 135, 136, 0,
+	216, 137, 217, 0, 138, 139, 140, 141, 142, 218,
+	143, 144, 0, 145, 146, 147, 148, 149, 150, 0,
+	151, 435, 152, 153, 219, 154, 0, 155, 156, 0,
+	157, 158, 0, 159, 160, 436, 161, 220, 162, 0,
+	163, 164, 165, 167, 221, 166, 222, 0, 168, 0,
+	169, 170, 0, 223, 224, 171, 0, 0, 172, 225,
+	226, 776, 173, 174, 175, 176, 0, 0, 177, 178,
+	0, 179, 0, 180, 181, 182, 227, 228, 0, 183,
+	0, 0, 0, 0, 184, 185, 186, 187, 0, 469,
+	780, 784, 0, 785, 775, 0, 0, 0, 0, 786,
+	781, 80, 81, 0, 82, 0, 0, 0, 0, 0,
+	0, 0, 0, 83, 84, 85, 188, 189, 190, 86,
+	191, 192, 0, 87, 193, 88, 89, 0, 0, 194,
+	195, 0, 196, 0, 474, 0, 90, 91, 92, 0,
+	93, 94, 95, 0, 96, 0, 425, 97, 98, 99,
+	0, 0, 0, 0, 0, 0, 100, 101, 273, 102,
+	197, 103, 198, 199, 771, 0, 104, 0, 0, 0,
+	105, 106, 0, 0, 0, 0, 200, 107, 201, 777,
+	0, 108, 109, 202, 110, 0, 0, 0, 426, 0,
+	111, 203, 0, 204, 0, 112, 205, 206, 113, 114,
+	0, 115, 0, 0, 427, 116, 207, 208, 209, 0,
+	210, 0, 428, 117, 429, 118, 0, 0, 211, 430,
+	119, 431, 0, 120, 0, 0, 0, 121, 122, 123,
+	124, 125, 432, 126, 127, 0, 128, 0, 212, 129,
+	213, 130, 131, 0, 778, 0, 0, 0, 132, 214,
+	433, 133, 434, 215, 134, 135, 136, 0, 216, 137,
+	217, 0, 138, 139, 140, 141, 142, 218, 143, 144,
+	0, 145, 146, 147, 148, 149, 150, 0, 151, 435,
+	152, 153, 219, 154, 0, 155, 156, 0, 157, 158,
+	0, 159, 160, 436, 161, 220, 162, 0, 163, 164,
+	165, 167, 221, 166, 222, 0, 168, 0, 169, 170,
+	0, 223, 224, 171, 0, 0, 172, 225, 226, 776,
+	173, 174, 175, 176, 0, 0, 177, 178, 0, 179,
+	0, 180, 181, 182, 227, 228, 0, 183, 0, 0,
+	0, 0, 184, 185, 186, 187, 0, 469, 780, 784,
+	0, 785, 775, 0, 0, 0, 0, 786, 781, 80,
+	81, 0, 82, 0, 0, 0, 0, 0, 0, 0,
+	0, 83, 84, 85, 188, 189, 190, 86, 191, 192,
+	0, 87, 193, 88, 89, 0, 0, 194, 195, 0,
+	196, 0, 474, 0, 90, 91, 92, 0, 93, 94,
+	95, 0, 96, 0, 425, 97, 98, 99, 0, 0,
+	0, 0, 0, 0, 100, 101, 273, 102, 197, 103,
+	198, 199, 0, 0, 104, 0, 0, 0, 105, 106,
+	0, 0, 0, 0, 200, 107, 201, 777, 0, 108,
+	109, 202, 110, 0, 0, 0, 426, 0, 111, 203,
+	0, 204, 0, 112, 205, 206, 113, 114, 0, 115,
+	0, 0, 427, 116, 207, 208, 209, 0, 210, 0,
+	428, 117, 429, 118, 0, 0, 211, 430, 119, 431,
+	0, 120, 0, 0, 0, 121, 122, 123, 124, 125,
+	432, 126, 127, 0, 128, 0, 212, 129, 213, 130,
+	131, 0, 778, 0, 0, 0, 132, 214, 433, 133,
+	434, 215, 134, 135, 136, 0, 216, 137, 217, 0,
+	138, 139, 140, 141, 142, 218, 143, 144, 0, 145,
+	146, 147, 148, 149, 150, 0, 151, 435, 152, 153,
+	219, 154, 0, 155, 156, 0, 157, 158, 0, 159,
+	160, 436, 161, 220, 162, 0, 163, 164, 165, 167,
+	221, 166, 222, 0, 168, 0, 169, 170, 0, 223,
+	224, 171, 0, 0, 172, 225, 226, 776, 173, 174,
+	175, 176, 0, 0, 177, 178, 0, 179, 0, 180,
+	181, 182, 227, 228, 77, 183, 0, 0, 0, 0,
+	184, 185, 186, 187, 0, 0, 80, 81, 0, 82,
+	0, 0, 0, 0, 0, 786, 781, 0, 83, 84,
+	85, 188, 189, 190, 86, 191, 192, 0, 87, 193,
+	88, 89, 0, 0, 194, 195, 0, 196, 0, 0,
+	0, 90, 91, 92, 0, 93, 94, 95, 0, 96,
+	0, 0, 97, 98, 99, 0, 0, 0, 0, 0,
+	0, 100, 101, 273, 102, 197, 103, 198, 199, 0,
+	0, 104, 0, 0, 0, 105, 106, 0, 0, 0,
+	0, 200, 107, 201, 0, 0, 108, 109, 202, 110,
+	0, 0, 0, 0, 0, 111, 203, 0, 204, 0,
+	112, 205, 206, 113, 114, 0, 115, 0, 0, 0,
+	116, 207, 208, 209, 0, 210, 0, 0, 117, 0,
+	118, 0, 0, 211, 0, 119, 0, 0, 120, 0,
+	0, 0, 121, 122, 123, 124, 125, 0, 126, 127,
+	0, 128, 0, 212, 129, 213, 130, 131, 0, 0,
+	326, 0, 0, 132, 214, 0, 133, 0, 215, 134,
+	135, 136, 0, 216, 137, 217, 0, 138, 139, 140,
+	141, 142, 218, 143, 144, 0, 145, 146, 147, 148,
+	149, 150, 0, 151, 0, 152, 153, 219, 154, 0,
+	155, 156, 59, 157, 158, 0, 159, 160, 0, 161,
+	220, 162, 0, 163, 164, 165, 167, 221, 166, 222,
+	0, 168, 61, 169, 170, 0, 223, 224, 171, 0,
+	0, 172, 225, 226, 0, 173, 174, 175, 176, 0,
+	0, 177, 178, 0, 179, 0, 180, 181, 182, 473,
+	228, 0, 183, 0, 0, 0, 57, 184, 185, 186,
+	187, 77, 58, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 80, 81, 0, 82, 0, 0, 0,
+	0, 1109, 0, 0, 0, 83, 84, 85, 188, 189,
+	190, 86, 191, 192, 0, 87, 193, 88, 89, 0,
+	0, 194, 195, 0, 196, 0, 0, 0, 90, 91,
+	92, 0, 93, 94, 95, 0, 96, 0, 0, 97,
+	98, 99, 0, 0, 0, 0, 0, 0, 100, 101,
+	273, 102, 197, 103, 198, 199, 0, 0, 104, 0,
+	0, 0, 105, 106, 0, 0, 0, 0, 200, 107,
+	201, 0, 0, 108, 109, 202, 110, 0, 0, 0,
+	0, 0, 111, 203, 0, 204, 0, 112, 205, 206,
+	113, 114, 0, 115, 0, 0, 0, 116, 207, 208,
+	209, 0, 210, 0, 0, 117, 0, 118, 0, 0,
+	211, 0, 119, 0, 0, 120, 0, 0, 0, 121,
+	122, 123, 124, 125, 0, 126, 127, 0, 128, 0,
+	212, 129, 213, 130, 131, 0, 0, 0, 0, 0,
+	132, 214, 0, 133, 0, 215, 134, 135, 136, 0,
+	216, 137, 217, 0, 138, 139, 140, 141, 142, 218,
+	143, 144, 0, 145, 146, 147, 148, 149, 150, 0,
+	151, 0, 152, 153, 219, 154, 0, 155, 156, 59,
+	157, 158, 0, 159, 160, 0, 161, 220, 162, 0,
+	163, 164, 165, 167, 221, 166, 222, 0, 168, 61,
+	169, 170, 0, 223, 224, 171, 0, 0, 172, 225,
+	226, 0, 173, 174, 175, 176, 0, 0, 177, 178,
+	0, 179, 0, 180, 181, 182, 473, 228, 0, 183,
+	0, 0, 0, 57, 184, 185, 186, 187, 77, 58,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	80, 81, 0, 82, 0, 0, 0, 0, 56, 1308,
+	0, 0, 83, 84, 85, 188, 189, 190, 86, 191,
+	192, 0, 87, 193, 88, 89, 0, 0, 194, 195,
+	0, 196, 0, 0, 0, 90, 91, 92, 0, 93,
+	94, 95, 0, 96, 0, 0, 97, 98, 99, 0,
+	0, 0, 0, 0, 0, 100, 101, 273, 102, 197,
+	103, 198, 199, 0, 0, 104, 0, 0, 0, 105,
+	106, 0, 0, 0, 0, 200, 107, 201, 0, 0,
+	108, 109, 202, 110, 0, 0, 0, 0, 0, 111,
+	203, 0, 204, 0, 112, 205, 206, 113, 114, 0,
+	115, 0, 0, 0, 116, 207, 208, 209, 0, 210,
+	0, 0, 117, 0, 118, 0, 0, 211, 0, 119,
+	0, 0, 120, 0, 0, 0, 121, 122, 123, 124,
+	125, 0, 126, 127, 0, 128, 0, 212, 129, 213,
+	130, 131, 0, 0, 0, 0, 0, 132, 214, 0,
+	133, 0, 215, 134, 135, 136, 0, 216, 137, 217,
+	0, 138, 139, 140, 141, 142, 218, 143, 144, 0,
+	145, 146, 147, 148, 149, 150, 0, 151, 0, 152,
+	153, 219, 154, 0, 155, 156, 0, 157, 158, 0,
+	159, 160, 0, 161, 220, 162, 0, 163, 164, 165,
+	167, 221, 166, 222, 0, 168, 0, 169, 170, 0,
+	223, 224, 171, 0, 0, 172, 225, 226, 0, 173,
+	174, 175, 176, 0, 0, 177, 178, 0, 179, 0,
+	180, 181, 182, 227, 228, 0, 183, 0, 0, 0,
+	0, 184, 185, 186, 187, 77, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 80, 81, 0,
+	82, 0, 0, 0, 0, 0, 535, 0, 0, 83,
+	84, 85, 188, 189, 190, 86, 191, 192, 0, 87,
+	193, 88, 89, 0, 0, 194, 195, 0, 196, 0,
+	0, 0, 90, 91, 92, 0, 93, 94, 95, 0,
+	96, 0, 0, 97, 98, 99, 0, 0, 0, 0,
+	0, 0, 100, 101, 273, 102, 197, 103, 198, 199,
+	0, 0, 104, 0, 0, 0, 105, 106, 0, 0,
+	0, 0, 200, 107, 201, 0, 0, 108, 109, 202,
+	110, 0, 0, 0, 0, 0, 111, 203, 0, 204,
+	0, 112, 205, 206, 113, 114, 0, 115, 0, 0,
+	0, 116, 207, 208, 209, 0, 210, 0, 0, 117,
+	0, 118, 0, 0, 211, 0, 119, 0, 0, 120,
+	0, 0, 0, 121, 122, 123, 124, 125, 0, 126,
+	127, 0, 128, 0, 212, 129, 213, 130, 131, 0,
+	0, 326, 0, 0, 132, 214, 0, 133, 0, 215,
+	134, 135, 136, 0, 216, 137, 217, 0, 138, 139,
+	140, 141, 142, 218, 143, 144, 0, 145, 146, 147,
+	148, 149, 150, 0, 151, 0, 152, 153, 219, 154,
+	0, 155, 156, 0, 157, 158, 0, 159, 160, 0,
+	161, 220, 162, 0, 163, 164, 165, 167, 221, 166,
+	222, 0, 168, 0, 169, 170, 0, 223, 224, 171,
+	0, 0, 172, 225, 226, 0, 173, 174, 175, 176,
+	0, 0, 177, 178, 0, 179, 0, 180, 181, 182,
+	227, 228, 0, 183, 0, 0, 0, 0, 184, 185,
+	186, 187, 77, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 80, 81, 0, 82, 0, 0,
+	0, 0, 1109, 0, 0, 0, 83, 84, 85, 188,
+	189, 190, 86, 191, 192, 0, 87, 193, 88, 89,
+	0, 0, 194, 195, 0, 196, 0, 0, 0, 90,
+	91, 92, 0, 93, 94, 95, 0, 96, 0, 0,
+	97, 98, 99, 0, 0, 0, 0, 0, 0, 100,
+	101, 273, 102, 197, 103, 198, 199, 0, 0, 104,
+	0, 0, 0, 105, 106, 0, 0, 0, 0, 200,
+	107, 201, 0, 0, 108, 109, 202, 110, 0, 0,
+	0, 0, 0, 111, 203, 0, 204, 0, 112, 205,
+	206, 113, 114, 0, 115, 0, 0, 0, 116, 207,
+	208, 209, 0, 210, 0, 0, 117, 0, 118, 0,
+	0, 211, 0, 119, 0, 0, 120, 0, 0, 0,
+	121, 122, 123, 124, 125, 0, 126, 127, 0, 128,
+	0, 212, 129, 213, 130, 131, 0, 0, 0, 0,
+	0, 132, 214, 0, 133, 0, 215, 134, 135, 136,
+	0, 216, 137, 217, 0, 138, 139, 140, 141, 142,
+	218, 143, 144, 0, 145, 146, 147, 148, 149, 150,
+	0, 151, 0, 152, 153, 219, 154, 0, 155, 156,
+	0, 157, 158, 0, 159, 160, 0, 161, 220, 162,
+	0, 163, 164, 165, 167, 221, 166, 222, 0, 168,
+	0, 169, 170, 0, 223, 224, 171, 0, 0, 172,
+	225, 226, 0, 173, 174, 175, 176, 0, 0, 177,
+	178, 0, 179, 0, 180, 181, 182, 227, 228, 0,
+	183, 0, 0, 0, 0, 184, 185, 186, 187, 77,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 80, 81, 0, 82, 0, 0, 0, 0, 1047,
+	0, 0, 0, 83, 84, 85, 188, 189, 190, 86,
+	191, 192, 0, 87, 193, 88, 89, 0, 0, 194,
+	195, 0, 196, 0, 0, 0, 90, 91, 92, 0,
+	93, 94, 95, 0, 96, 0, 0, 97, 98, 99,
+	0, 0, 0, 0, 0, 0, 100, 101, 273, 102,
+	197, 103, 198, 199, 0, 0, 104, 0, 0, 0,
+	105, 106, 0, 0, 0, 0, 200, 107, 201, 0,
+	0, 108, 109, 202, 110, 0, 0, 0, 0, 0,
+	111, 203, 0, 204, 0, 112, 205, 206, 113, 114,
+	0, 115, 0, 0, 0, 116, 207, 208, 209, 0,
+	210, 0, 0, 117, 0, 118, 0, 0, 211, 0,
+	119, 0, 0, 120, 0, 0, 0, 121, 122, 123,
+	124, 125, 0, 126, 127, 0, 128, 0, 212, 129,
+	213, 130, 131, 0, 0, 0, 0, 0, 132, 214,
+	0, 133, 0, 215, 134, 135, 136, 0, 216, 137,
+	217, 0, 138, 139, 140, 141, 142, 218, 143, 144,
+	0, 145, 146, 147, 148, 149, 150, 0, 151, 0,
+	152, 153, 219, 154, 0, 155, 156, 0, 157, 158,
+	0, 159, 160, 0, 161, 220, 162, 0, 163, 164,
+	165, 167, 221, 166, 222, 0, 168, 0, 169, 170,
+	0, 223, 224, 171, 0, 0, 172, 225, 226, 0,
+	173, 174, 175, 176, 0, 0, 177, 178, 0, 179,
+	0, 180, 181, 182, 227, 228, 0, 183, 0, 0,
+	0, 0, 184, 185, 186, 187, 77, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 80, 81,
+	0, 82, 0, 0, 0, 0, 1378, 0, 0, 0,
+	83, 84, 85, 188, 189, 190, 86, 191, 192, 0,
+	87, 193, 88, 89, 0, 0, 194, 195, 0, 196,
+	0, 0, 0, 90, 91, 92, 0, 93, 94, 95,
+	0, 96, 0, 0, 97, 98, 99, 0, 0, 0,
+	0, 0, 0, 100, 101, 273, 102, 197, 103, 198,
+	199, 0, 0, 104, 0, 0, 0, 105, 106, 0,
+	0, 0, 0, 200, 107, 201, 0, 0, 108, 109,
+	202, 110, 0, 0, 0, 0, 0, 111, 203, 0,
+	204, 0, 112, 205, 206, 113, 114, 0, 115, 0,
+	0, 0, 116, 207, 208, 209, 0, 210, 0, 0,
+	117, 0, 118, 0, 0, 211, 0, 119, 0, 0,
+	120, 0, 0, 0, 121, 122, 123, 124, 125, 0,
+	126, 127, 0, 128, 0, 212, 129, 213, 130, 131,
+	0, 0, 0, 0, 0, 132, 214, 0, 133, 0,
+	215, 134, 135, 136, 0, 216, 137, 217, 0, 138,
+	139, 140, 141, 142, 218, 143, 144, 0, 145, 146,
+	147, 148, 149, 150, 0, 151, 0, 152, 153, 219,
+	154, 0, 155, 156, 0, 157, 158, 0, 159, 160,
+	0, 161, 220, 162, 0, 163, 164, 165, 167, 221,
+	166, 222, 0, 168, 0, 169, 170, 0, 223, 224,
+	171, 0, 0, 172, 225, 226, 0, 173, 174, 175,
+	176, 0, 0, 177, 178, 0, 179, 0, 180, 181,
+	182, 227, 228, 0, 183, 0, 0, 0, 0, 184,
+	185, 186, 187, 469, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 80, 81, 0, 82, 0,
+	468, 0, 0, 549, 0, 0, 0, 83, 84, 85,
+	188, 189, 190, 86, 191, 192, 0, 87, 193, 88,
+	89, 0, 0, 194, 195, 0, 196, 0, 474, 0,
+	90, 91, 92, 0, 93, 94, 95, 0, 96, 0,
+	425, 97, 98, 99, 0, 0, 0, 0, 0, 0,
+	100, 101, 273, 102, 197, 103, 198, 199, 0, 0,
+	104, 0, 0, 0, 105, 106, 0, 0, 0, 0,
+	200, 107, 201, 0, 0, 108, 109, 202, 110, 0,
+	0, 0, 426, 0, 111, 203, 0, 204, 0, 112,
+	205, 206, 113, 114, 0, 115, 0, 0, 427, 116,
+	207, 208, 209, 0, 210, 0, 428, 117, 429, 118,
+	0, 0, 211, 430, 119, 431, 0, 120, 0, 0,
+	0, 121, 122, 123, 124, 125, 432, 126, 127, 0,
+	128, 0, 212, 129, 213, 130, 131, 0, 0, 0,
+	0, 0, 132, 214, 433, 133, 434, 215, 134, 135,
+	136, 0, 216, 137, 217, 0, 138, 139, 140, 141,
+	142, 218, 143, 144, 0, 145, 146, 147, 148, 149,
+	150, 0, 151, 435, 152, 153, 219, 154, 0, 155,
+	156, 0, 157, 158, 0, 159, 160, 436, 161, 220,
+	162, 0, 163, 164, 165, 167, 221, 166, 222, 0,
+	168, 0, 169, 170, 0, 223, 224, 171, 0, 0,
+	172, 225, 226, 0, 173, 174, 175, 176, 0, 0,
+	177, 178, 0, 179, 0, 180, 181, 182, 227, 228,
+	77, 183, 0, 0, 0, 0, 184, 185, 186, 187,
+	0, 0, 80, 81, 0, 82, 0, 0, 0, 0,
+	0, 0, 0, 0, 83, 84, 85, 188, 189, 190,
+	86, 191, 192, 0, 87, 193, 88, 89, 0, 0,
+	194, 195, 862, 196, 0, 0, 0, 90, 91, 92,
+	0, 93, 94, 95, 860, 96, 0, 0, 97, 98,
+	99, 0, 0, 0, 0, 0, 0, 100, 101, 273,
+	102, 197, 103, 198, 199, 0, 0, 104, 0, 0,
+	0, 105, 106, 0, 0, 0, 0, 200, 107, 201,
+	0, 0, 108, 109, 202, 110, 0, 865, 0, 0,
+	0, 111, 203, 0, 204, 0, 112, 205, 206, 113,
+	114, 0, 115, 1066, 0, 0, 116, 207, 208, 209,
+	0, 210, 0, 0, 117, 0, 118, 0, 0, 211,
+	0, 119, 0, 0, 120, 0, 0, 0, 121, 122,
+	123, 124, 125, 0, 126, 127, 0, 128, 0, 212,
+	129, 213, 130, 131, 0, 0, 0, 0, 0, 132,
+	214, 0, 133, 0, 215, 134, 135, 136, 0, 216,
+	137, 217, 864, 138, 139, 140, 141, 142, 218, 143,
+	144, 0, 145, 146, 147, 148, 149, 150, 0, 151,
+	0, 152, 153, 219, 154, 0, 155, 156, 0, 157,
+	158, 0, 159, 160, 0, 161, 220, 162, 0, 163,
+	164, 165, 167, 221, 166, 222, 0, 168, 0, 169,
+	170, 0, 223, 224, 171, 0, 0, 172, 225, 226,
+	0, 173, 174, 175, 176, 0, 1067, 177, 178, 0,
+	179, 0, 180, 181, 182, 227, 228, 77, 183, 0,
+	0, 0, 0, 184, 185, 186, 187, 0, 0, 80,
+	81, 0, 82, 0, 0, 0, 0, 0, 0, 0,
+	0, 83, 84, 85, 188, 189, 190, 86, 191, 192,
+	0, 87, 193, 88, 89, 0, 0, 194, 195, 862,
+	196, 0, 0, 857, 90, 91, 92, 0, 93, 94,
+	95, 860, 96, 0, 0, 97, 98, 99, 0, 0,
+	0, 0, 0, 0, 100, 101, 273, 102, 197, 103,
+	198, 199, 0, 0, 104, 0, 0, 0, 105, 106,
+	0, 0, 0, 0, 200, 107, 201, 0, 0, 108,
+	109, 202, 110, 0, 865, 0, 0, 0, 111, 203,
+	0, 204, 0, 112, 856, 206, 113, 114, 0, 115,
+	0, 0, 0, 116, 207, 208, 209, 0, 210, 0,
+	0, 117, 0, 118, 0, 0, 211, 0, 119, 0,
+	0, 120, 0, 0, 0, 121, 122, 123, 124, 125,
+	0, 126, 127, 0, 128, 0, 212, 129, 213, 130,
+	131, 0, 0, 0, 0, 0, 132, 214, 0, 133,
+	0, 215, 134, 135, 136, 0, 216, 137, 217, 864,
+	138, 139, 140, 141, 142, 218, 143, 144, 0, 145,
+	146, 147, 148, 149, 150, 0, 151, 0, 152, 153,
+	219, 154, 0, 155, 156, 0, 157, 158, 0, 159,
+	160, 0, 161, 220, 162, 0, 163, 164, 165, 167,
+	221, 166, 222, 0, 168, 0, 169, 170, 0, 223,
+	224, 171, 0, 0, 172, 225, 226, 0, 173, 174,
+	175, 176, 0, 863, 177, 178, 0, 179, 0, 180,
+	181, 182, 227, 228, 77, 183, 0, 0, 0, 0,
+	184, 185, 186, 187, 0, 0, 80, 81, 74, 82,
+	0, 0, 0, 0, 0, 0, 0, 0, 83, 84,
+	85, 188, 189, 190, 86, 191, 192, 0, 87, 193,
+	88, 89, 0, 0, 194, 195, 0, 196, 0, 0,
+	0, 90, 91, 92, 0, 93, 94, 95, 0, 96,
+	0, 0, 97, 98, 99, 0, 0, 0, 0, 0,
+	0, 100, 101, 73, 102, 197, 103, 198, 199, 0,
+	0, 104, 0, 0, 0, 105, 106, 0, 0, 0,
+	0, 200, 107, 201, 0, 0, 108, 109, 202, 110,
+	0, 0, 260, 0, 0, 111, 203, 0, 204, 0,
+	112, 205, 206, 113, 114, 0, 115, 0, 0, 0,
+	116, 207, 208, 209, 0, 210, 0, 0, 117, 0,
+	118, 0, 0, 211, 0, 119, 0, 0, 120, 0,
+	0, 0, 121, 122, 123, 124, 125, 0, 126, 127,
+	0, 128, 0, 212, 129, 213, 130, 131, 0, 0,
+	0, 0, 0, 132, 214, 0, 133, 0, 215, 134,
+	135, 136, 0, 216, 137, 217, 0, 138, 139, 140,
+	141, 142, 218, 143, 144, 0, 145, 146, 147, 148,
+	149, 150, 0, 151, 0, 152, 153, 219, 154, 0,
+	155, 156, 0, 157, 158, 0, 159, 160, 0, 161,
+	220, 162, 0, 163, 164, 165, 167, 221, 166, 222,
+	0, 168, 72, 169, 170, 0, 223, 224, 171, 0,
+	0, 172, 225, 226, 0, 173, 174, 175, 176, 0,
+	0, 177, 178, 0, 179, 0, 180, 181, 182, 227,
+	228, 77, 183, 0, 0, 0, 0, 184, 185, 186,
+	187, 0, 0, 80, 81, 74, 82, 0, 0, 0,
+	0, 0, 0, 0, 0, 83, 84, 85, 188, 189,
+	190, 86, 191, 192, 0, 87, 193, 88, 89, 0,
+	0, 194, 195, 0, 196, 0, 0, 0, 90, 91,
+	92, 0, 93, 94, 95, 0, 96, 0, 0, 97,
+	98, 99, 0, 0, 0, 0, 0, 0, 100, 101,
+	73, 102, 197, 103, 198, 199, 0, 0, 104, 0,
+	0, 0, 105, 106, 0, 0, 0, 0, 200, 107,
+	201, 0, 0, 108, 109, 202, 110, 0, 0, 0,
+	0, 0, 111, 203, 0, 204, 0, 112, 205, 206,
+	113, 114, 0, 115, 0, 0, 0, 116, 207, 208,
+	209, 0, 210, 0, 0, 117, 0, 118, 0, 0,
+	211, 0, 119, 0, 0, 120, 0, 0, 0, 121,
+	122, 123, 124, 125, 0, 126, 127, 0, 128, 0,
+	212, 129, 213, 130, 131, 0, 0, 0, 0, 0,
+	132, 214, 0, 133, 0, 215, 134, 135, 136, 0,
+	216, 137, 217, 0, 138, 139, 140, 141, 142, 218,
+	143, 144, 0, 145, 146, 147, 148, 149, 150, 0,
+	151, 0, 152, 153, 219, 154, 0, 155, 156, 0,
+	157, 158, 0, 159, 160, 0, 161, 220, 162, 0,
+	163, 164, 165, 167, 221, 166, 222, 0, 168, 72,
+	169, 170, 0, 223, 224, 171, 70, 0, 172, 225,
+	226, 0, 173, 174, 175, 176, 0, 0, 177, 178,
+	0, 179, 0, 180, 181, 182, 227, 228, 77, 183,
+	0, 0, 0, 0, 184, 185, 186, 187, 0, 0,
+	80, 81, 74, 82, 0, 0, 0, 0, 0, 0,
+	0, 0, 83, 84, 85, 188, 189, 190, 86, 191,
+	192, 0, 87, 193, 88, 89, 0, 0, 194, 195,
+	0, 196, 0, 0, 0, 90, 91, 92, 0, 93,
+	94, 95, 0, 96, 0, 0, 97, 98, 99, 0,
+	0, 0, 0, 0, 0, 100, 101, 73, 102, 197,
+	103, 198, 199, 0, 0, 104, 0, 0, 0, 105,
+	106, 0, 0, 0, 0, 200, 107, 201, 0, 0,
+	108, 109, 202, 110, 0, 0, 0, 0, 0, 111,
+	203, 0, 204, 0, 112, 205, 206, 113, 114, 0,
+	115, 0, 0, 0, 116, 207, 208, 209, 0, 210,
+	0, 0, 117, 0, 118, 0, 0, 211, 0, 119,
+	0, 0, 120, 0, 0, 0, 121, 122, 123, 124,
+	125, 0, 126, 127, 0, 128, 0, 212, 129, 213,
+	130, 131, 0, 0, 0, 0, 0, 132, 214, 0,
+	133, 0, 215, 134, 135, 136, 0, 216, 137, 217,
+	0, 138, 139, 140, 141, 142, 218, 143, 144, 0,
+	145, 146, 147, 148, 149, 150, 0, 151, 0, 152,
+	153, 219, 154, 0, 155, 156, 0, 157, 158, 0,
+	159, 160, 0, 161, 220, 162, 0, 163, 164, 165,
+	167, 221, 166, 222, 0, 168, 72, 169, 170, 0,
+	223, 224, 171, 0, 0, 172, 225, 226, 0, 173,
+	174, 175, 176, 0, 0, 177, 178, 0, 179, 0,
+	180, 181, 182, 227, 228, 77, 183, 0, 0, 0,
+	0, 184, 185, 186, 187, 0, 0, 80, 81, 0,
+	82, 0, 0, 0, 0, 0, 1308, 0, 0, 83,
+	84, 85, 188, 189, 190, 86, 191, 192, 0, 87,
+	193, 88, 89, 0, 0, 194, 195, 0, 196, 0,
+	0, 0, 90, 91, 92, 0, 93, 94, 95, 0,
+	96, 0, 0, 97, 98, 99, 0, 0, 0, 0,
+	0, 0, 100, 101, 273, 102, 197, 103, 198, 199,
+	0, 0, 104, 0, 0, 0, 105, 106, 0, 0,
+	0, 0, 200, 107, 201, 0, 0, 108, 109, 202,
+	110, 0, 0, 0, 0, 0, 111, 203, 0, 204,
+	0, 112, 205, 206, 113, 114, 0, 115, 0, 0,
+	0, 116, 207, 208, 209, 0, 210, 0, 0, 117,
+	0, 118, 0, 0, 211, 0, 119, 0, 0, 120,
+	0, 0, 0, 121, 122, 123, 124, 125, 0, 126,
+	127, 0, 128, 0, 212, 129, 213, 130, 131, 0,
+	0, 0, 0, 0, 132, 214, 0, 133, 0, 215,
+	134, 135, 136, 0, 216, 137, 217, 0, 138, 139,
+	140, 141, 142, 218, 143, 144, 0, 145, 146, 147,
+	148, 149, 150, 0, 151, 0, 152, 153, 219, 154,
+	0, 155, 156, 0, 157, 158, 0, 159, 160, 0,
+	161, 220, 162, 0, 163, 164, 165, 167, 221, 166,
+	222, 0, 168, 0, 169, 170, 0, 223, 224, 171,
+	0, 0, 172, 225, 226, 0, 173, 174, 175, 176,
+	0, 0, 177, 178, 0, 179, 0, 180, 181, 182,
+	227, 228, 77, 183, 0, 0, 0, 0, 184, 185,
+	186, 187, 0, 0, 80, 81, 0, 82, 0, 0,
+	0, 0, 0, 0, 0, 0, 83, 84, 85, 188,
+	189, 190, 86, 191, 192, 0, 87, 193, 88, 89,
+	0, 0, 194, 195, 0, 196, 0, 0, 0, 90,
+	91, 92, 0, 93, 94, 95, 0, 96, 0, 0,
+	97, 98, 99, 0, 0, 0, 0, 0, 0, 100,
+	101, 273, 102, 197, 103, 198, 199, 0, 0, 104,
+	0, 0, 0, 105, 106, 0, 0, 0, 0, 200,
+	107, 201, 0, 0, 108, 109, 202, 110, 0, 0,
+	0, 0, 0, 111, 203, 0, 204, 0, 112, 205,
+	206, 113, 114, 0, 115, 0, 0, 0, 116, 207,
+	208, 209, 0, 210, 0, 0, 117, 0, 118, 0,
+	0, 211, 0, 119, 0, 0, 120, 0, 0, 0,
+	121, 122, 123, 124, 125, 0, 126, 127, 0, 128,
+	0, 212, 129, 213, 130, 131, 0, 0, 326, 0,
+	0, 132, 214, 0, 133, 0, 215, 134, 135, 136,
+	0, 216, 137, 217, 0, 138, 139, 140, 141, 142,
+	218, 143, 144, 0, 145, 146, 147, 148, 149, 150,
+	0, 151, 0, 152, 153, 219, 154, 0, 155, 156,
+	0, 157, 158, 0, 159, 160, 0, 161, 220, 162,
+	0, 163, 164, 165, 167, 221, 166, 222, 0, 168,
+	0, 169, 170, 0, 223, 224, 171, 0, 0, 172,
+	225, 226, 0, 173, 174, 175, 176, 0, 0, 177,
+	178, 0, 179, 0, 180, 181, 182, 227, 228, 77,
+	183, 0, 0, 0, 0, 184, 185, 186, 187, 0,
+	0, 80, 81, 0, 82, 0, 0, 0, 0, 0,
+	0, 0, 0, 83, 84, 85, 188, 189, 190, 86,
+	191, 192, 0, 87, 193, 88, 89, 0, 0, 194,
+	195, 0, 196, 0, 0, 0, 90, 91, 92, 0,
+	93, 94, 95, 0, 96, 0, 0, 97, 98, 99,
+	0, 0, 0, 0, 0, 0, 100, 101, 273, 102,
+	197, 103, 198, 199, 0, 0, 104, 0, 0, 0,
+	105, 106, 0, 0, 0, 0, 200, 107, 201, 0,
+	0, 108, 109, 202, 110, 0, 0, 0, 0, 0,
+	111, 203, 0, 204, 0, 112, 334, 206, 113, 114,
+	0, 115, 0, 0, 0, 116, 207, 208, 209, 0,
+	210, 0, 0, 117, 0, 118, 0, 0, 211, 0,
+	119, 0, 0, 120, 0, 0, 0, 121, 122, 123,
+	124, 125, 0, 126, 127, 0, 128, 0, 212, 129,
+	213, 130, 131, 0, 0, 326, 0, 0, 132, 214,
+	0, 133, 0, 215, 134, 135, 136, 0, 216, 137,
+	217, 0, 138, 139, 140, 141, 142, 218, 143, 144,
+	0, 145, 146, 147, 148, 149, 150, 0, 151, 0,
+	152, 153, 219, 154, 0, 155, 156, 0, 157, 158,
+	0, 159, 160, 0, 161, 220, 162, 0, 163, 164,
+	165, 167, 221, 166, 222, 0, 168, 0, 169, 170,
+	0, 223, 224, 171, 0, 0, 172, 225, 226, 0,
+	173, 174, 175, 176, 0, 0, 177, 178, 0, 179,
+	0, 180, 181, 182, 227, 228, 77, 183, 0, 0,
+	0, 0, 184, 185, 186, 187, 0, 0, 80, 81,
+	0, 82, 0, 0, 0, 0, 0, 0, 0, 0,
+	83, 84, 85, 188, 189, 190, 86, 191, 192, 0,
+	87, 193, 88, 89, 0, 0, 194, 195, 0, 196,
+	0, 0, 0, 90, 91, 92, 0, 93, 94, 95,
+	0, 96, 0, 0, 97, 98, 99, 0, 0, 0,
+	0, 0, 0, 100, 101, 273, 102, 197, 103, 198,
+	199, 0, 0, 104, 0, 0, 0, 105, 106, 0,
+	0, 0, 0, 200, 107, 201, 0, 0, 108, 109,
+	202, 110, 0, 0, 0, 0, 0, 111, 203, 0,
+	204, 0, 112, 205, 206, 113, 114, 0, 115, 0,
+	0, 0, 116, 207, 208, 209, 0, 210, 0, 0,
+	117, 0, 118, 0, 0, 211, 0, 119, 0, 0,
+	120, 0, 0, 0, 121, 122, 123, 124, 125, 0,
+	126, 127, 0, 128, 0, 212, 129, 213, 130, 131,
+	0, 0, 0, 0, 0, 132, 214, 0, 133, 0,
+	215, 134, 135, 136, 0, 216, 137, 217, 0, 138,
+	139, 140, 141, 142, 218, 143, 144, 0, 145, 146,
+	147, 148, 149, 150, 0, 151, 0, 152, 153, 219,
+	154, 0, 155, 156, 0, 157, 158, 0, 159, 160,
+	0, 161, 220, 162, 0, 163, 164, 165, 167, 221,
+	166, 222, 0, 168, 0, 169, 170, 0, 223, 224,
+	171, 0, 0, 172, 225, 226, 0, 173, 174, 175,
+	176, 0, 0, 177, 178, 0, 179, 0, 180, 181,
+	182, 227, 228, 77, 183, 0, 0, 0, 0, 184,
+	185, 186, 187, 0, 0, 80, 81, 0, 82, 0,
+	0, 0, 0, 0, 0, 0, 0, 83, 84, 85,
+	188, 189, 190, 86, 191, 192, 0, 87, 193, 88,
+	89, 0, 0, 194, 195, 0, 196, 0, 0, 0,
+	90, 91, 92, 0, 93, 94, 95, 0, 96, 0,
+	0, 97, 98, 99, 0, 0, 0, 0, 0, 0,
+	100, 101, 273, 102, 197, 103, 198, 199, 0, 0,
+	104, 0, 0, 0, 105, 106, 0, 0, 0, 0,
+	200, 107, 201, 0, 0, 108, 109, 202, 110, 0,
+	0, 0, 0, 0, 111, 203, 0, 204, 0, 112,
+	1136, 206, 113, 114, 0, 115, 0, 0, 0, 116,
+	207, 208, 209, 0, 210, 0, 0, 117, 0, 118,
+	0, 0, 211, 0, 119, 0, 0, 120, 0, 0,
+	0, 121, 122, 123, 124, 125, 0, 126, 127, 0,
+	128, 0, 212, 129, 213, 130, 131, 0, 0, 0,
+	0, 0, 132, 214, 0, 133, 0, 215, 134, 135,
+	136, 0, 216, 137, 217, 0, 138, 139, 140, 141,
+	142, 218, 143, 144, 0, 145, 146, 147, 148, 149,
+	150, 0, 151, 0, 152, 153, 219, 154, 0, 155,
+	156, 0, 157, 158, 0, 159, 160, 0, 161, 220,
+	162, 0, 163, 164, 165, 167, 221, 166, 222, 0,
+	168, 0, 169, 170, 0, 223, 224, 171, 0, 0,
+	172, 225, 226, 0, 173, 174, 175, 176, 0, 0,
+	177, 178, 0, 179, 0, 180, 181, 182, 227, 228,
+	77, 183, 0, 0, 0, 0, 184, 185, 186, 187,
+	0, 0, 80, 81, 0, 82, 0, 0, 0, 0,
+	0, 0, 0, 0, 83, 84, 85, 188, 189, 190,
+	86, 191, 192, 0, 87, 193, 88, 89, 0, 0,
+	194, 195, 0, 196, 0, 0, 0, 90, 91, 92,
+	0, 93, 94, 95, 0, 96, 0, 0, 97, 98,
+	99, 0, 0, 0, 0, 0, 0, 100, 101, 273,
+	102, 197, 103, 198, 199, 0, 0, 104, 0, 0,
+	0, 105, 106, 0, 0, 0, 0, 200, 107, 201,
+	0, 0, 108, 109, 202, 110, 0, 0, 0, 0,
+	0, 111, 203, 0, 204, 0, 112, 1134, 206, 113,
+	114, 0, 115, 0, 0, 0, 116, 207, 208, 209,
+	0, 210, 0, 0, 117, 0, 118, 0, 0, 211,
+	0, 119, 0, 0, 120, 0, 0, 0, 121, 122,
+	123, 124, 125, 0, 126, 127, 0, 128, 0, 212,
+	129, 213, 130, 131, 0, 0, 0, 0, 0, 132,
+	214, 0, 133, 0, 215, 134, 135, 136, 0, 216,
+	137, 217, 0, 138, 139, 140, 141, 142, 218, 143,
+	144, 0, 145, 146, 147, 148, 149, 150, 0, 151,
+	0, 152, 153, 219, 154, 0, 155, 156, 0, 157,
+	158, 0, 159, 160, 0, 161, 220, 162, 0, 163,
+	164, 165, 167, 221, 166, 222, 0, 168, 0, 169,
+	170, 0, 223, 224, 171, 0, 0, 172, 225, 226,
+	0, 173, 174, 175, 176, 0, 0, 177, 178, 0,
+	179, 0, 180, 181, 182, 227, 228, 77, 183, 0,
+	0, 0, 0, 184, 185, 186, 187, 0, 0, 80,
+	81, 0, 82, 0, 0, 0, 0, 0, 0, 0,
+	0, 83, 84, 85, 188, 189, 190, 86, 191, 192,
+	0, 87, 193, 88, 89, 0, 0, 194, 195, 0,
+	196, 0, 0, 0, 90, 91, 92, 0, 93, 94,
+	95, 0, 96, 0, 0, 97, 98, 99, 0, 0,
+	0, 0, 0, 0, 100, 101, 273, 102, 197, 103,
+	198, 199, 0, 0, 104, 0, 0, 0, 105, 106,
+	0, 0, 0, 0, 200, 107, 201, 0, 0, 108,
+	109, 202, 110, 0, 0, 0, 0, 0, 111, 203,
+	0, 204, 0, 112, 1125, 206, 113, 114, 0, 115,
+	0, 0, 0, 116, 207, 208, 209, 0, 210, 0,
+	0, 117, 0, 118, 0, 0, 211, 0, 119, 0,
+	0, 120, 0, 0, 0, 121, 122, 123, 124, 125,
+	0, 126, 127, 0, 128, 0, 212, 129, 213, 130,
+	131, 0, 0, 0, 0, 0, 132, 214, 0, 133,
+	0, 215, 134, 135, 136, 0, 216, 137, 217, 0,
+	138, 139, 140, 141, 142, 218, 143, 144, 0, 145,
+	146, 147, 148, 149, 150, 0, 151, 0, 152, 153,
+	219, 154, 0, 155, 156, 0, 157, 158, 0, 159,
+	160, 0, 161, 220, 162, 0, 163, 164, 165, 167,
+	221, 166, 222, 0, 168, 0, 169, 170, 0, 223,
+	224, 171, 0, 0, 172, 225, 226, 0, 173, 174,
+	175, 176, 0, 0, 177, 178, 0, 179, 0, 180,
+	181, 182, 227, 228, 77, 183, 0, 0, 0, 0,
+	184, 185, 186, 187, 0, 0, 80, 81, 0, 82,
+	0, 0, 0, 0, 0, 0, 0, 0, 83, 84,
+	85, 188, 189, 190, 86, 191, 192, 0, 87, 193,
+	88, 89, 0, 0, 194, 195, 0, 196, 0, 0,
+	0, 90, 91, 92, 0, 93, 94, 95, 0, 96,
+	0, 0, 97, 98, 99, 0, 0, 0, 0, 0,
+	0, 100, 101, 273, 102, 197, 103, 198, 199, 0,
+	0, 104, 0, 0, 0, 105, 106, 0, 0, 0,
+	0, 200, 107, 201, 0, 0, 108, 109, 202, 110,
+	0, 0, 0, 0, 0, 111, 203, 0, 204, 0,
+	112, 814, 206, 113, 114, 0, 115, 0, 0, 0,
+	116, 207, 208, 209, 0, 210, 0, 0, 117, 0,
+	118, 0, 0, 211, 0, 119, 0, 0, 120, 0,
+	0, 0, 121, 122, 123, 124, 125, 0, 126, 127,
+	0, 128, 0, 212, 129, 213, 130, 131, 0, 0,
+	0, 0, 0, 132, 214, 0, 133, 0, 215, 134,
+	135, 136, 0, 216, 137, 217, 0, 138, 139, 140,
+	141, 142, 218, 143, 144, 0, 145, 146, 147, 148,
+	149, 150, 0, 151, 0, 152, 153, 219, 154, 0,
+	155, 156, 0, 157, 158, 0, 159, 160, 0, 161,
+	220, 162, 0, 163, 164, 165, 167, 221, 166, 222,
+	0, 168, 0, 169, 170, 0, 223, 224, 171, 0,
+	0, 172, 225, 226, 0, 173, 174, 175, 176, 0,
+	0, 177, 178, 0, 179, 0, 180, 181, 182, 227,
+	228, 77, 183, 0, 0, 0, 0, 184, 185, 186,
+	187, 0, 0, 80, 81, 0, 82, 0, 0, 0,
+	0, 0, 749, 0, 0, 83, 84, 85, 188, 189,
+	190, 86, 191, 192, 0, 87, 193, 88, 89, 0,
+	0, 194, 195, 0, 196, 0, 0, 0, 90, 91,
+	92, 0, 93, 94, 95, 0, 96, 0, 0, 97,
+	98, 99, 0, 0, 0, 0, 0, 0, 100, 101,
+	273, 102, 197, 103, 198, 199, 0, 0, 104, 0,
+	0, 0, 105, 106, 0, 0, 0, 0, 200, 107,
+	201, 0, 0, 108, 109, 202, 110, 0, 0, 0,
+	0, 0, 111, 203, 0, 204, 0, 112, 205, 206,
+	113, 114, 0, 115, 0, 0, 0, 116, 207, 208,
+	209, 0, 210, 0, 0, 117, 0, 118, 0, 0,
+	211, 0, 119, 0, 0, 120, 0, 0, 0, 121,
+	122, 123, 124, 125, 0, 126, 127, 0, 128, 0,
+	212, 129, 213, 130, 131, 0, 0, 0, 0, 0,
+	132, 214, 0, 133, 0, 215, 134, 135, 136, 0,
+	216, 137, 217, 0, 138, 139, 140, 141, 142, 218,
+	143, 144, 0, 145, 146, 147, 148, 149, 150, 0,
+	151, 0, 152, 153, 219, 154, 0, 155, 156, 0,
+	157, 158, 0, 0, 160, 0, 161, 220, 162, 0,
+	163, 164, 165, 167, 221, 166, 222, 0, 168, 0,
+	169, 170, 0, 223, 224, 171, 0, 0, 172, 225,
+	226, 0, 173, 174, 175, 176, 0, 0, 177, 178,
+	0, 179, 0, 180, 181, 182, 227, 228, 77, 183,
+	0, 0, 0, 0, 184, 185, 186, 187, 0, 0,
+	80, 81, 0, 82, 0, 0, 0, 0, 0, 0,
+	0, 0, 83, 84, 85, 188, 189, 190, 86, 191,
+	192, 0, 87, 193, 88, 89, 0, 0, 194, 195,
+	0, 196, 0, 0, 0, 90, 91, 92, 0, 93,
+	94, 95, 0, 96, 0, 0, 97, 98, 99, 0,
+	0, 0, 0, 0, 0, 100, 101, 273, 102, 197,
+	103, 198, 199, 0, 0, 104, 0, 0, 0, 105,
+	106, 0, 0, 0, 0, 200, 107, 201, 0, 0,
+	108, 109, 202, 110, 0, 0, 0, 0, 0, 111,
+	203, 0, 204, 0, 112, 520, 206, 113, 114, 0,
+	115, 0, 0, 0, 116, 207, 208, 209, 0, 210,
+	0, 0, 117, 0, 118, 0, 0, 211, 0, 119,
+	0, 0, 120, 0, 0, 0, 121, 122, 123, 124,
+	125, 0, 126, 127, 0, 128, 0, 212, 129, 213,
+	130, 131, 0, 0, 0, 0, 0, 132, 214, 0,
+	133, 0, 215, 134, 135, 136, 0, 216, 137, 217,
+	0, 138, 139, 140, 141, 142, 218, 143, 144, 0,
+	145, 146, 147, 148, 149, 150, 0, 151, 0, 152,
+	153, 219, 154, 0, 155, 156, 0, 157, 158, 0,
+	159, 160, 0, 161, 220, 162, 0, 163, 164, 165,
+	167, 221, 166, 222, 0, 168, 0, 169, 170, 0,
+	223, 224, 171, 0, 0, 172, 225, 226, 0, 173,
+	174, 175, 176, 0, 0, 177, 178, 0, 179, 0,
+	180, 181, 182, 227, 228, 77, 183, 0, 0, 0,
+	0, 184, 185, 186, 187, 0, 0, 80, 81, 0,
+	82, 0, 0, 0, 0, 0, 0, 0, 0, 83,
+	84, 85, 188, 189, 190, 86, 191, 192, 0, 87,
+	193, 88, 89, 0, 0, 194, 195, 0, 196, 0,
+	0, 0, 90, 91, 92, 0, 93, 94, 95, 0,
+	96, 0, 0, 97, 98, 99, 0, 0, 0, 0,
+	0, 0, 100, 101, 273, 102, 197, 103, 198, 199,
+	0, 0, 104, 0, 0, 0, 105, 106, 0, 0,
+	0, 0, 200, 107, 201, 0, 0, 108, 109, 202,
+	110, 0, 0, 0, 0, 0, 111, 203, 0, 204,
+	0, 112, 518, 206, 113, 114, 0, 115, 0, 0,
+	0, 116, 207, 208, 209, 0, 210, 0, 0, 117,
+	0, 118, 0, 0, 211, 0, 119, 0, 0, 120,
+	0, 0, 0, 121, 122, 123, 124, 125, 0, 126,
+	127, 0, 128, 0, 212, 129, 213, 130, 131, 0,
+	0, 0, 0, 0, 132, 214, 0, 133, 0, 215,
+	134, 135, 136, 0, 216, 137, 217, 0, 138, 139,
+	140, 141, 142, 218, 143, 144, 0, 145, 146, 147,
+	148, 149, 150, 0, 151, 0, 152, 153, 219, 154,
+	0, 155, 156, 0, 157, 158, 0, 159, 160, 0,
+	161, 220, 162, 0, 163, 164, 165, 167, 221, 166,
+	222, 0, 168, 0, 169, 170, 0, 223, 224, 171,
+	0, 0, 172, 225, 226, 0, 173, 174, 175, 176,
+	0, 0, 177, 178, 0, 179, 0, 180, 181, 182,
+	227, 228, 77, 183, 0, 0, 0, 0, 184, 185,
+	186, 187, 0, 0, 80, 81, 0, 82, 0, 0,
+	0, 0, 0, 0, 0, 0, 83, 84, 85, 188,
+	189, 190, 86, 191, 192, 0, 87, 193, 88, 89,
+	0, 0, 194, 195, 0, 196, 0, 0, 0, 90,
+	91, 92, 0, 93, 94, 95, 0, 96, 0, 0,
+	97, 98, 99, 0, 0, 0, 0, 0, 0, 100,
+	101, 273, 102, 197, 103, 198, 199, 0, 0, 104,
+	0, 0, 0, 105, 106, 0, 0, 0, 0, 200,
+	107, 201, 0, 0, 108, 109, 202, 110, 0, 0,
+	0, 0, 0, 111, 203, 0, 204, 0, 112, 515,
+	206, 113, 114, 0, 115, 0, 0, 0, 116, 207,
+	208, 209, 0, 210, 0, 0, 117, 0, 118, 0,
+	0, 211, 0, 119, 0, 0, 120, 0, 0, 0,
+	121, 122, 123, 124, 125, 0, 126, 127, 0, 128,
+	0, 212, 129, 213, 130, 131, 0, 0, 0, 0,
+	0, 132, 214, 0, 133, 0, 215, 134, 135, 136,
+	0, 216, 137, 217, 0, 138, 139, 140, 141, 142,
+	218, 143, 144, 0, 145, 146, 147, 148, 149, 150,
+	0, 151, 0, 152, 153, 219, 154, 0, 155, 156,
+	0, 157, 158, 0, 159, 160, 0, 161, 220, 162,
+	0, 163, 164, 165, 167, 221, 166, 222, 0, 168,
+	0, 169, 170, 0, 223, 224, 171, 0, 0, 172,
+	225, 226, 0, 173, 174, 175, 176, 0, 0, 177,
+	178, 0, 179, 0, 180, 181, 182, 227, 228, 77,
+	183, 0, 0, 0, 0, 184, 185, 186, 187, 0,
+	0, 80, 81, 0, 82, 0, 0, 0, 0, 0,
+	0, 0, 0, 83, 84, 85, 188, 189, 190, 86,
+	191, 192, 0, 87, 193, 88, 89, 0, 0, 194,
+	195, 0, 196, 0, 0, 0, 90, 91, 92, 0,
+	93, 94, 95, 0, 96, 0, 0, 97, 98, 99,
+	0, 0, 0, 0, 0, 0, 100, 101, 273, 102,
+	197, 103, 198, 199, 0, 0, 104, 0, 0, 0,
+	105, 106, 0, 0, 0, 0, 200, 107, 201, 0,
+	0, 108, 109, 202, 110, 0, 0, 0, 0, 0,
+	111, 203, 0, 204, 0, 112, 205, 206, 113, 114,
+	0, 115, 0, 0, 0, 116, 207, 208, 209, 0,
+	210, 0, 0, 117, 0, 118, 0, 0, 211, 0,
+	119, 0, 0, 120, 0, 0, 0, 121, 122, 123,
+	124, 270, 0, 126, 127, 0, 128, 0, 212, 129,
+	213, 130, 131, 0, 0, 0, 0, 0, 132, 214,
+	0, 133, 0, 215, 134, 135, 136, 0, 216, 137,
+	217, 0, 138, 139, 140, 141, 142, 218, 143, 144,
+	0, 145, 146, 147, 148, 149, 150, 0, 151, 0,
+	152, 153, 219, 154, 0, 155, 156, 0, 157, 158,
+	0, 159, 160, 0, 161, 220, 162, 0, 163, 164,
+	165, 167, 221, 166, 222, 0, 168, 0, 169, 170,
+	0, 269, 224, 171, 0, 0, 265, 225, 226, 0,
+	173, 174, 175, 176, 0, 0, 177, 178, 0, 179,
+	0, 180, 181, 182, 227, 228, 77, 183, 0, 0,
+	0, 0, 184, 185, 186, 187, 0, 0, 80, 81,
+	0, 82, 0, 0, 0, 0, 0, 0, 0, 0,
+	83, 84, 85, 188, 189, 190, 86, 191, 192, 0,
+	87, 193, 88, 89, 0, 0, 194, 195, 0, 196,
+	0, 0, 0, 90, 91, 92, 0, 93, 94, 95,
+	0, 96, 0, 0, 97, 98, 99, 0, 0, 0,
+	0, 0, 0, 100, 101, 273, 102, 197, 103, 198,
+	199, 0, 0, 104, 0, 0, 0, 105, 106, 0,
+	0, 0, 0, 200, 107, 201, 0, 0, 108, 109,
+	202, 110, 0, 0, 0, 0, 0, 111, 203, 0,
+	204, 0, 112, 462, 206, 113, 114, 0, 115, 0,
+	0, 0, 116, 207, 208, 209, 0, 210, 0, 0,
+	117, 0, 118, 0, 0, 211, 0, 119, 0, 0,
+	120, 0, 0, 0, 121, 122, 123, 124, 125, 0,
+	126, 127, 0, 128, 0, 212, 129, 213, 130, 131,
+	0, 0, 0, 0, 0, 132, 214, 0, 133, 0,
+	215, 134, 135, 136, 0, 216, 137, 217, 0, 138,
+	139, 140, 141, 142, 218, 143, 144, 0, 145, 146,
+	147, 148, 149, 150, 0, 151, 0, 152, 153, 219,
+	154, 0, 155, 156, 0, 157, 158, 0, 159, 160,
+	0, 161, 220, 162, 0, 163, 164, 165, 167, 221,
+	166, 222, 0, 168, 0, 169, 170, 0, 223, 224,
+	171, 0, 0, 172, 225, 226, 0, 173, 174, 175,
+	176, 0, 0, 177, 178, 0, 179, 0, 180, 181,
+	182, 227, 228, 77, 183, 0, 0, 0, 0, 184,
+	185, 186, 187, 0, 0, 80, 81, 0, 82, 0,
+	0, 0, 0, 0, 0, 0, 0, 83, 84, 85,
+	188, 189, 190, 86, 191, 192, 0, 87, 193, 88,
+	89, 0, 0, 194, 195, 0, 196, 0, 0, 0,
+	90, 91, 92, 0, 93, 94, 95, 0, 96, 0,
+	0, 97, 98, 99, 0, 0, 0, 0, 0, 0,
+	100, 101, 273, 102, 197, 103, 198, 199, 0, 0,
+	104, 0, 0, 0, 105, 106, 0, 0, 0, 0,
+	200, 107, 201, 0, 0, 108, 109, 202, 110, 0,
+	0, 0, 0, 0, 111, 203, 0, 204, 0, 112,
+	460, 206, 113, 114, 0, 115, 0, 0, 0, 116,
+	207, 208, 209, 0, 210, 0, 0, 117, 0, 118,
+	0, 0, 211, 0, 119, 0, 0, 120, 0, 0,
+	0, 121, 122, 123, 124, 125, 0, 126, 127, 0,
+	128, 0, 212, 129, 213, 130, 131, 0, 0, 0,
+	0, 0, 132, 214, 0, 133, 0, 215, 134, 135,
+	136, 0, 216, 137, 217, 0, 138, 139, 140, 141,
+	142, 218, 143, 144, 0, 145, 146, 147, 148, 149,
+	150, 0, 151, 0, 152, 153, 219, 154, 0, 155,
+	156, 0, 157, 158, 0, 159, 160, 0, 161, 220,
+	162, 0, 163, 164, 165, 167, 221, 166, 222, 0,
+	168, 0, 169, 170, 0, 223, 224, 171, 0, 0,
+	172, 225, 226, 0, 173, 174, 175, 176, 0, 0,
+	177, 178, 0, 179, 0, 180, 181, 182, 227, 228,
+	77, 183, 0, 0, 0, 0, 184, 185, 186, 187,
+	0, 0, 80, 81, 0, 82, 0, 0, 0, 0,
+	0, 0, 0, 0, 83, 84, 85, 188, 189, 190,
+	86, 191, 192, 0, 87, 193, 88, 89, 0, 0,
+	194, 195, 0, 196, 0, 0, 0, 90, 91, 92,
+	0, 93, 94, 95, 0, 96, 0, 0, 97, 98,
+	99, 0, 0, 0, 0, 0, 0, 100, 101, 273,
+	102, 197, 103, 198, 199, 0, 0, 104, 0, 0,
+	0, 105, 106, 0, 0, 0, 0, 200, 107, 201,
+	0, 0, 108, 109, 202, 110, 0, 0, 0, 0,
+	0, 111, 203, 0, 204, 0, 112, 458, 206, 113,
+	114, 0, 115, 0, 0, 0, 116, 207, 208, 209,
+	0, 210, 0, 0, 117, 0, 118, 0, 0, 211,
+	0, 119, 0, 0, 120, 0, 0, 0, 121, 122,
+	123, 124, 125, 0, 126, 127, 0, 128, 0, 212,
+	129, 213, 130, 131, 0, 0, 0, 0, 0, 132,
+	214, 0, 133, 0, 215, 134, 135, 136, 0, 216,
+	137, 217, 0, 138, 139, 140, 141, 142, 218, 143,
+	144, 0, 145, 146, 147, 148, 149, 150, 0, 151,
+	0, 152, 153, 219, 154, 0, 155, 156, 0, 157,
+	158, 0, 159, 160, 0, 161, 220, 162, 0, 163,
+	164, 165, 167, 221, 166, 222, 0, 168, 0, 169,
+	170, 0, 223, 224, 171, 0, 0, 172, 225, 226,
+	0, 173, 174, 175, 176, 0, 0, 177, 178, 0,
+	179, 0, 180, 181, 182, 227, 228, 77, 183, 0,
+	0, 0, 0, 184, 185, 186, 187, 0, 0, 80,
+	81, 0, 82, 0, 0, 0, 0, 0, 0, 0,
+	0, 83, 84, 85, 188, 189, 190, 86, 191, 192,
+	0, 87, 193, 88, 89, 0, 0, 194, 195, 0,
+	196, 0, 0, 0, 90, 91, 92, 0, 93, 94,
+	95, 0, 96, 0, 0, 97, 98, 99, 0, 0,
+	0, 0, 0, 0, 100, 101, 273, 102, 197, 103,
+	198, 199, 0, 0, 104, 0, 0, 0, 105, 106,
+	0, 0, 0, 0, 200, 107, 201, 0, 0, 108,
+	109, 202, 110, 0, 0, 0, 0, 0, 111, 203,
+	0, 204, 0, 112, 456, 206, 113, 114, 0, 115,
+	0, 0, 0, 116, 207, 208, 209, 0, 210, 0,
+	0, 117, 0, 118, 0, 0, 211, 0, 119, 0,
+	0, 120, 0, 0, 0, 121, 122, 123, 124, 125,
+	0, 126, 127, 0, 128, 0, 212, 129, 213, 130,
+	131, 0, 0, 0, 0, 0, 132, 214, 0, 133,
+	0, 215, 134, 135, 136, 0, 216, 137, 217, 0,
+	138, 139, 140, 141, 142, 218, 143, 144, 0, 145,
+	146, 147, 148, 149, 150, 0, 151, 0, 152, 153,
+	219, 154, 0, 155, 156, 0, 157, 158, 0, 159,
+	160, 0, 161, 220, 162, 0, 163, 164, 165, 167,
+	221, 166, 222, 0, 168, 0, 169, 170, 0, 223,
+	224, 171, 0, 0, 172, 225, 226, 0, 173, 174,
+	175, 176, 0, 0, 177, 178, 0, 179, 0, 180,
+	181, 182, 227, 228, 77, 183, 0, 0, 0, 0,
+	184, 185, 186, 187, 0, 0, 80, 81, 0, 82,
+	0, 0, 0, 0, 0, 0, 0, 0, 83, 84,
+	85, 188, 189, 190, 86, 191, 192, 0, 87, 193,
+	88, 89, 0, 0, 194, 195, 0, 196, 0, 0,
+	0, 90, 91, 92, 0, 93, 94, 95, 0, 96,
+	0, 0, 97, 98, 99, 0, 0, 0, 0, 0,
+	0, 100, 101, 273, 102, 197, 103, 198, 199, 0,
+	0, 104, 0, 0, 0, 105, 106, 0, 0, 0,
+	0, 200, 107, 201, 0, 0, 108, 109, 202, 110,
+	0, 0, 0, 0, 0, 111, 203, 0, 204, 0,
+	112, 454, 206, 113, 114, 0, 115, 0, 0, 0,
+	116, 207, 208, 209, 0, 210, 0, 0, 117, 0,
+	118, 0, 0, 211, 0, 119, 0, 0, 120, 0,
+	0, 0, 121, 122, 123, 124, 125, 0, 126, 127,
+	0, 128, 0, 212, 129, 213, 130, 131, 0, 0,
+	0, 0, 0, 132, 214, 0, 133, 0, 215, 134,
+	135, 136, 0, 216, 137, 217, 0, 138, 139, 140,
+	141, 142, 218, 143, 144, 0, 145, 146, 147, 148,
+	149, 150, 0, 151, 0, 152, 153, 219, 154, 0,
+	155, 156, 0, 157, 158, 0, 159, 160, 0, 161,
+	220, 162, 0, 163, 164, 165, 167, 221, 166, 222,
+	0, 168, 0, 169, 170, 0, 223, 224, 171, 0,
+	0, 172, 225, 226, 0, 173, 174, 175, 176, 0,
+	0, 177, 178, 0, 179, 0, 180, 181, 182, 227,
+	228, 77, 183, 0, 0, 0, 0, 184, 185, 186,
+	187, 0, 0, 80, 81, 0, 82, 0, 0, 0,
+	0, 0, 0, 0, 0, 83, 84, 85, 188, 189,
+	190, 86, 191, 192, 0, 87, 193, 88, 89, 0,
+	0, 194, 195, 0, 196, 0, 0, 0, 90, 91,
+	92, 0, 93, 94, 95, 0, 96, 0, 0, 97,
+	98, 99, 0, 0, 0, 0, 0, 0, 100, 101,
+	273, 102, 197, 103, 198, 199, 0, 0, 104, 0,
+	0, 0, 105, 106, 0, 0, 0, 0, 200, 107,
+	201, 0, 0, 108, 109, 202, 110, 0, 0, 0,
+	0, 0, 111, 203, 0, 204, 0, 112, 337, 206,
+	113, 114, 0, 115, 0, 0, 0, 116, 207, 208,
+	209, 0, 210, 0, 0, 117, 0, 118, 0, 0,
+	211, 0, 119, 0, 0, 120, 0, 0, 0, 121,
+	122, 123, 124, 125, 0, 126, 127, 0, 128, 0,
+	212, 129, 213, 130, 131, 0, 0, 0, 0, 0,
+	132, 214, 0, 133, 0, 215, 134, 135, 136, 0,
+	216, 137, 217, 0, 138, 139, 140, 141, 142, 218,
+	143, 144, 0, 145, 146, 147, 148, 149, 150, 0,
+	151, 0, 152, 153, 219, 154, 0, 155, 156, 0,
+	157, 158, 0, 159, 160, 0, 161, 220, 162, 0,
+	163, 164, 165, 167, 221, 166, 222, 0, 168, 0,
+	169, 170, 0, 223, 224, 171, 0, 0, 172, 225,
+	226, 0, 173, 174, 175, 176, 0, 0, 177, 178,
+	0, 179, 0, 180, 181, 182, 227, 228, 77, 183,
+	0, 0, 0, 0, 184, 185, 186, 187, 0, 0,
+	80, 81, 0, 82, 0, 0, 0, 0, 0, 0,
+	0, 0, 83, 84, 85, 188, 189, 190, 86, 191,
+	192, 0, 87, 193, 88, 89, 0, 0, 194, 195,
+	0, 196, 0, 0, 0, 90, 91, 92, 0, 93,
+	94, 95, 0, 96, 0, 0, 97, 98, 99, 0,
+	0, 0, 0, 0, 0, 100, 101, 273, 102, 197,
+	103, 198, 199, 0, 0, 104, 0, 0, 0, 105,
+	106, 0, 0, 0, 0, 200, 107, 201, 0, 0,
+	108, 109, 202, 110, 0, 0, 0, 0, 0, 111,
+	203, 0, 204, 0, 112, 205, 206, 113, 114, 0,
+	115, 0, 0, 0, 116, 207, 208, 209, 0, 210,
+	0, 0, 117, 0, 118, 0, 0, 211, 0, 119,
+	0, 0, 120, 0, 0, 0, 121, 122, 123, 124,
+	125, 0, 126, 127, 0, 128, 0, 212, 129, 213,
+	130, 131, 0, 0, 0, 0, 0, 132, 214, 0,
+	133, 0, 215, 134, 135, 136, 0, 216, 137, 217,
+	0, 138, 139, 140, 141, 142, 218, 315, 144, 0,
+	145, 146, 147, 148, 149, 150, 0, 151, 0, 152,
+	153, 219, 154, 0, 155, 156, 0, 157, 158, 0,
+	159, 160, 0, 161, 220, 162, 0, 163, 164, 165,
+	167, 221, 166, 222, 0, 168, 0, 169, 170, 0,
+	223, 224, 171, 0, 0, 172, 225, 226, 0, 173,
+	174, 175, 176, 0, 0, 177, 178, 0, 179, 0,
+	180, 181, 182, 227, 228, 77, 183, 0, 0, 0,
+	0, 184, 185, 186, 187, 0, 0, 80, 81, 0,
+	82, 0, 0, 0, 0, 0, 0, 0, 0, 83,
+	84, 85, 188, 189, 190, 86, 191, 192, 0, 87,
+	193, 88, 89, 0, 0, 194, 195, 0, 196, 0,
+	0, 0, 90, 91, 92, 0, 93, 94, 95, 0,
+	96, 0, 0, 97, 98, 99, 0, 0, 0, 0,
+	0, 0, 100, 101, 273, 102, 197, 103, 198, 199,
+	0, 0, 104, 0, 0, 0, 105, 106, 0, 0,
+	0, 0, 200, 107, 201, 0, 0, 108, 109, 202,
+	110, 0, 0, 0, 0, 0, 111, 203, 0, 204,
+	0, 112, 205, 206, 113, 114, 0, 115, 0, 0,
+	0, 116, 207, 208, 209, 0, 210, 0, 0, 117,
+	0, 118, 0, 0, 211, 0, 119, 0, 0, 263,
+	0, 0, 0, 121, 122, 123, 124, 270, 0, 126,
+	127, 0, 128, 0, 212, 129, 213, 130, 131, 0,
+	0, 0, 0, 0, 132, 214, 0, 133, 0, 215,
+	134, 135, 136, 0, 216, 137, 217, 0, 138, 139,
+	140, 141, 142, 218, 143, 144, 0, 145, 146, 147,
+	148, 149, 150, 0, 151, 0, 152, 153, 219, 154,
+	0, 155, 156, 0, 157, 264, 0, 159, 160, 0,
+	161, 220, 162, 0, 163, 164, 165, 167, 221, 166,
+	222, 0, 168, 0, 169, 170, 0, 269, 224, 171,
+	0, 0, 265, 225, 226, 0, 173, 174, 175, 176,
+	0, 0, 177, 178, 0, 179, 0, 180, 181, 182,
+	227, 228, 77, 183, 0, 0, 0, 0, 184, 185,
+	186, 187, 0, 0, 80, 81, 0, 82, 0, 0,
+	0, 0, 0, 0, 0, 0, 83, 84, 85, 188,
+	189, 190, 86, 191, 192, 0, 87, 193, 88, 89,
+	0, 0, 194, 195, 0, 196, 0, 0, 0, 90,
+	91, 92, 0, 93, 94, 95, 0, 96, 0, 0,
+	97, 98, 99, 0, 0, 0, 0, 0, 0, 100,
+	101, 273, 102, 197, 103, 198, 199, 0, 0, 104,
+	0, 0, 0, 105, 106, 0, 0, 0, 0, 200,
+	107, 201, 0, 0, 108, 109, 202, 110, 0, 0,
+	0, 0, 0, 111, 203, 0, 204, 0, 112, 205,
+	206, 113, 114, 0, 115, 0, 0, 0, 116, 207,
+	208, 209, 0, 210, 0, 0, 117, 0, 118, 0,
+	0, 211, 0, 119, 0, 0, 120, 0, 0, 0,
+	121, 122, 123, 124, 125, 0, 126, 127, 0, 128,
+	0, 212, 129, 213, 130, 131, 0, 0, 0, 0,
+	0, 132, 214, 0, 133, 0, 215, 134, 0, 136,
+	0, 216, 137, 217, 0, 138, 139, 140, 0, 142,
+	218, 143, 144, 0, 145, 146, 147, 148, 149, 150,
+	0, 151, 0, 152, 153, 219, 0, 0, 155, 156,
+	0, 157, 158, 0, 159, 160, 0, 161, 220, 162,
+	0, 163, 164, 165, 167, 221, 166, 222, 0, 168,
+	0, 169, 170, 0, 223, 224, 171, 0, 0, 172,
+	225, 226, 0, 173, 174, 175, 176, 0, 0, 177,
+	178, 0, 179, 0, 180, 181, 182, 227, 228, 572,
+	183, 590, 591, 592, 0, 184, 185, 186, 187, 0,
+	0, 593, 0, 0, 0, 0, 0, 574, 0, 0,
+	599, 572, 0, 590, 591, 592, 0, 0, 0, 0,
+	0, 0, 0, 593, 0, 0, 0, 573, 0, 574,
+	0, 0, 599, 587, 0, 0, 0, 0, 572, 0,
+	590, 591, 592, 0, 0, 0, 0, 0, 0, 573,
+	593, 0, 0, 0, 0, 587, 574, 0, 0, 599,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1446,
+	0, 0, 0, 0, 0, 0, 573, 0, 0, 0,
+	0, 0, 587, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 600, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 598, 0, 0, 0,
+	0, 0, 0, 0, 0, 595, 600, 0, 0, 0,
+	588, 0, 0, 0, 0, 0, 0, 0, 598, 0,
+	0, 0, 0, 0, 0, 0, 0, 595, 0, 0,
+	594, 0, 588, 600, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 598, 0, 0, 0, 0,
+	0, 0, 594, 0, 595, 0, 0, 0, 0, 588,
+	0, 0, 0, 0, 0, 0, 0, 0, 589, 0,
+	0, 0, 0, 0, 0, 0, 0, 597, 0, 594,
+	572, 0, 590, 591, 592, 0, 0, 0, 0, 0,
+	589, 0, 593, 0, 0, 0, 0, 0, 574, 597,
+	0, 599, 0, 0, 0, 0, 0, 0, 0, 0,
+	572, 0, 590, 591, 592, 0, 0, 589, 573, 0,
+	0, 0, 593, 0, 587, 0, 597, 0, 574, 0,
+	596, 599, 0, 584, 585, 586, 0, 583, 580, 581,
+	582, 575, 576, 577, 578, 579, 0, 0, 573, 0,
+	0, 1668, 596, 0, 587, 584, 585, 586, 0, 583,
+	580, 581, 582, 575, 576, 577, 578, 579, 0, 0,
+	0, 0, 0, 1660, 0, 0, 0, 0, 0, 596,
+	0, 0, 584, 585, 586, 600, 583, 580, 581, 582,
+	575, 576, 577, 578, 579, 0, 0, 598, 0, 0,
+	1647, 0, 0, 0, 0, 0, 595, 0, 0, 0,
+	0, 588, 0, 0, 0, 600, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 598, 0, 0,
+	0, 594, 0, 0, 0, 0, 595, 0, 0, 0,
+	0, 588, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 527, 0, 0, 0, 0, 0, 0, 0,
-	0, 827, 0, 0, 89, 90, 532, 91, 533, 534,
-	535, 536, 537, 538, 539, 540, 92, 93, 174, 175,
-	176, 94, 177, 178, 541, 95, 179, 96, 542, 543,
-	180, 181, 544, 182, 545, 293, 546, 97, 98, 99,
-	0, 100, 547, 101, 548, 294, 102, 103, 549, 550,
-	551, 552, 553, 554, 104, 105, 106, 107, 183, 108,
-	184, 185, 555, 556, 109, 557, 558, 559, 110, 111,
-	560, 561, 683, 562, 186, 112, 187, 563, 564, 113,
-	114, 188, 115, 565, 566, 567, 295, 568, 116, 189,
-	569, 190, 570, 117, 191, 192, 571, 572, 573, 296,
-	118, 193, 194, 195, 574, 196, 575, 297, 119, 298,
-	120, 576, 577, 197, 299, 121, 300, 578, 250, 579,
-	580, 0, 122, 123, 124, 125, 251, 301, 126, 127,
-	581, 128, 582, 198, 129, 199, 130, 131, 583, 584,
-	585, 586, 587, 132, 200, 302, 133, 303, 201, 134,
-	135, 588, 202, 136, 203, 589, 137, 138, 204, 139,
-	140, 590, 141, 142, 143, 591, 144, 304, 145, 146,
-	205, 147, 0, 148, 149, 592, 150, 252, 593, 151,
-	152, 305, 153, 206, 154, 594, 155, 157, 207, 156,
-	208, 595, 596, 158, 159, 597, 254, 209, 598, 599,
-	253, 210, 211, 600, 160, 161, 162, 163, 601, 602,
-	164, 165, 603, 604, 166, 167, 168, 212, 213, 605,
-	169, 606, 607, 608, 609, 170, 171, 172, 173, 0,
-	527, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 732, 89, 90, 532, 91, 533, 534, 535, 536,
-	537, 538, 539, 540, 92, 93, 174, 175, 176, 94,
-	177, 178, 541, 95, 179, 96, 542, 543, 180, 181,
-	544, 182, 545, 293, 546, 97, 98, 99, 0, 100,
-	547, 101, 548, 294, 102, 103, 549, 550, 551, 552,
-	553, 554, 104, 105, 106, 107, 183, 108, 184, 185,
-	555, 556, 109, 557, 558, 559, 110, 111, 560, 561,
-	0, 562, 186, 112, 187, 563, 564, 113, 114, 188,
-	115, 565, 566, 567, 295, 568, 116, 189, 569, 190,
-	570, 117, 191, 192, 571, 572, 573, 296, 118, 193,
-	194, 195, 574, 196, 575, 297, 119, 298, 120, 576,
-	577, 197, 299, 121, 300, 578, 250, 579, 580, 0,
-	122, 123, 124, 125, 251, 301, 126, 127, 581, 128,
-	582, 198, 129, 199, 130, 131, 583, 584, 585, 586,
-	587, 132, 200, 302, 133, 303, 201, 134, 135, 588,
-	202, 136, 203, 589, 137, 138, 204, 139, 140, 590,
-	141, 142, 143, 591, 144, 304, 145, 146, 205, 147,
-	0, 148, 149, 592, 150, 252, 593, 151, 152, 305,
-	153, 206, 154, 594, 155, 157, 207, 156, 208, 595,
-	596, 158, 159, 597, 254, 209, 598, 599, 253, 210,
-	211, 600, 160, 161, 162, 163, 601, 602, 164, 165,
-	603, 604, 166, 167, 168, 212, 213, 605, 169, 606,
-	607, 608, 609, 170, 171, 172, 173, 396, 384, 385,
-	386, 383, 372, 0, 0, 0, 0, 0, 0, 89,
-	90, 0, 91, 0, 0, 0, 0, 378, 0, 0,
-	0, 92, 93, 174, 425, 426, 94, 427, 428, 0,
-	95, 179, 96, 393, 411, 429, 430, 0, 421, 0,
-	404, 0, 97, 98, 99, 0, 100, 0, 101, 0,
-	294, 102, 103, 0, 405, 407, 0, 406, 408, 104,
-	105, 106, 107, 431, 108, 432, 433, 462, 0, 109,
-	0, 0, 0, 424, 111, 0, 0, 0, 0, 377,
-	112, 412, 391, 0, 113, 114, 434, 115, 0, 0,
-	0, 295, 0, 116, 422, 0, 190, 0, 117, 418,
-	420, 0, 0, 0, 296, 118, 435, 436, 437, 0,
-	403, 0, 297, 119, 298, 120, 0, 0, 423, 299,
-	121, 300, 0, 250, 0, 0, 0, 122, 123, 124,
-	125, 251, 301, 126, 127, 367, 128, 392, 419, 129,
-	438, 130, 131, 0, 0, 0, 0, 0, 132, 200,
-	302, 133, 303, 413, 134, 135, 0, 414, 136, 203,
-	0, 137, 138, 439, 139, 140, 0, 141, 142, 143,
-	0, 144, 304, 145, 146, 381, 147, 0, 148, 149,
-	43, 150, 252, 409, 151, 152, 305, 153, 440, 154,
-	0, 155, 157, 207, 156, 415, 0, 45, 158, 159,
-	0, 254, 441, 0, 0, 253, 416, 417, 390, 160,
-	161, 162, 163, 0, 0, 164, 165, 410, 0, 166,
-	167, 168, 292, 442, 0, 169, 0, 0, 0, 41,
-	170, 171, 172, 173, 368, 42, 396, 384, 385, 386,
-	383, 372, 0, 0, 364, 365, 0, 0, 89, 90,
-	366, 91, 0, 373, 0, 0, 378, 0, 0, 0,
-	92, 93, 174, 425, 426, 94, 427, 428, 0, 95,
-	179, 96, 393, 411, 429, 430, 0, 421, 0, 404,
-	0, 97, 98, 99, 0, 100, 0, 101, 0, 294,
-	102, 103, 0, 405, 407, 0, 406, 408, 104, 105,
-	106, 107, 431, 108, 432, 433, 0, 0, 109, 0,
-	0, 0, 424, 111, 0, 0, 0, 0, 377, 112,
-	412, 391, 0, 113, 114, 434, 115, 0, 0, 0,
-	295, 0, 116, 422, 0, 190, 0, 117, 418, 420,
-	0, 0, 0, 296, 118, 435, 436, 437, 0, 403,
-	0, 297, 119, 298, 120, 0, 0, 423, 299, 121,
-	300, 0, 250, 0, 0, 0, 122, 123, 124, 125,
-	251, 301, 126, 127, 367, 128, 392, 419, 129, 438,
-	130, 131, 0, 0, 0, 0, 0, 132, 200, 302,
-	133, 303, 413, 134, 135, 0, 414, 136, 203, 0,
-	137, 138, 439, 139, 140, 0, 141, 142, 143, 0,
-	144, 304, 145, 146, 381, 147, 0, 148, 149, 43,
-	150, 252, 409, 151, 152, 305, 153, 440, 154, 0,
-	155, 157, 207, 156, 415, 0, 45, 158, 159, 0,
-	254, 441, 0, 0, 253, 416, 417, 390, 160, 161,
-	162, 163, 0, 0, 164, 165, 410, 0, 166, 167,
-	168, 292, 442, 0, 169, 0, 0, 0, 41, 170,
-	171, 172, 173, 368, 42, 396, 384, 385, 386, 383,
-	372, 0, 0, 364, 365, 0, 0, 89, 90, 366,
-	91, 0, 373, 0, 0, 378, 0, 0, 0, 92,
-	93, 174, 425, 426, 94, 427, 428, 967, 95, 179,
-	96, 393, 411, 429, 430, 0, 421, 0, 404, 0,
-	97, 98, 99, 0, 100, 0, 101, 0, 294, 102,
-	103, 0, 405, 407, 0, 406, 408, 104, 105, 106,
-	107, 431, 108, 432, 433, 0, 0, 109, 0, 0,
-	0, 424, 111, 0, 0, 0, 0, 377, 112, 412,
-	391, 0, 113, 114, 434, 115, 0, 0, 972, 295,
-	0, 116, 422, 0, 190, 0, 117, 418, 420, 0,
-	0, 0, 296, 118, 435, 436, 437, 0, 403, 0,
-	297, 119, 298, 120, 0, 968, 423, 299, 121, 300,
-	0, 250, 0, 0, 0, 122, 123, 124, 125, 251,
-	301, 126, 127, 367, 128, 392, 419, 129, 438, 130,
-	131, 0, 0, 0, 0, 0, 132, 200, 302, 133,
-	303, 413, 134, 135, 0, 414, 136, 203, 0, 137,
-	138, 439, 139, 140, 0, 141, 142, 143, 0, 144,
-	304, 145, 146, 381, 147, 0, 148, 149, 0, 150,
-	252, 409, 151, 152, 305, 153, 440, 154, 0, 155,
-	157, 207, 156, 415, 0, 0, 158, 159, 0, 254,
-	441, 0, 969, 253, 416, 417, 390, 160, 161, 162,
-	163, 0, 0, 164, 165, 410, 0, 166, 167, 168,
-	212, 442, 0, 169, 0, 0, 0, 0, 170, 171,
-	172, 173, 368, 0, 396, 384, 385, 386, 383, 372,
-	0, 0, 364, 365, 0, 0, 89, 90, 366, 91,
-	0, 373, 0, 0, 378, 0, 0, 0, 92, 93,
-	174, 425, 426, 94, 427, 428, 0, 95, 179, 96,
-	393, 411, 429, 430, 0, 421, 0, 404, 0, 97,
-	98, 99, 0, 100, 0, 101, 0, 294, 102, 103,
-	0, 405, 407, 0, 406, 408, 104, 105, 106, 107,
-	431, 108, 432, 433, 0, 0, 109, 0, 0, 0,
-	424, 111, 0, 0, 0, 0, 377, 112, 412, 391,
-	0, 113, 114, 434, 115, 0, 0, 0, 295, 0,
-	116, 422, 0, 190, 0, 117, 418, 420, 0, 0,
-	0, 296, 118, 435, 436, 437, 0, 403, 0, 297,
-	119, 298, 120, 0, 0, 423, 299, 121, 300, 0,
-	250, 0, 0, 0, 122, 123, 124, 125, 251, 301,
-	126, 127, 367, 128, 392, 419, 129, 438, 130, 131,
-	0, 0, 0, 0, 0, 132, 200, 302, 133, 303,
-	413, 134, 135, 0, 414, 136, 203, 0, 137, 138,
-	439, 139, 140, 0, 141, 142, 143, 0, 144, 304,
-	145, 146, 381, 147, 0, 148, 149, 0, 150, 252,
-	409, 151, 152, 305, 153, 440, 154, 0, 155, 157,
-	207, 156, 415, 0, 0, 158, 159, 0, 254, 441,
-	0, 0, 253, 416, 417, 390, 160, 161, 162, 163,
-	0, 0, 164, 165, 410, 0, 166, 167, 168, 212,
-	442, 0, 169, 0, 0, 0, 0, 170, 171, 172,
-	173, 368, 0, 396, 384, 385, 386, 383, 372, 0,
-	0, 364, 365, 0, 0, 89, 90, 366, 91, 0,
-	373, 1302, 0, 378, 0, 0, 0, 92, 93, 174,
-	425, 426, 94, 427, 428, 0, 95, 179, 96, 393,
-	411, 429, 430, 0, 421, 0, 404, 0, 97, 98,
-	99, 0, 100, 0, 101, 0, 294, 102, 103, 0,
-	405, 407, 0, 406, 408, 104, 105, 106, 107, 431,
-	108, 432, 433, 0, 0, 109, 0, 0, 0, 424,
-	111, 0, 0, 0, 0, 377, 112, 412, 391, 0,
-	113, 114, 434, 115, 0, 0, 0, 295, 0, 116,
-	422, 0, 190, 0, 117, 418, 420, 0, 0, 0,
-	296, 118, 435, 436, 437, 0, 403, 0, 297, 119,
-	298, 120, 0, 0, 423, 299, 121, 300, 0, 250,
-	0, 0, 0, 122, 123, 124, 125, 251, 301, 126,
-	127, 367, 128, 392, 419, 129, 438, 130, 131, 0,
-	0, 0, 0, 0, 132, 200, 302, 133, 303, 413,
-	134, 135, 0, 414, 136, 203, 0, 137, 138, 439,
-	139, 140, 0, 141, 142, 143, 0, 144, 304, 145,
-	146, 381, 147, 0, 148, 149, 0, 150, 252, 409,
-	151, 152, 305, 153, 440, 154, 0, 155, 157, 207,
-	156, 415, 0, 0, 158, 159, 0, 254, 441, 0,
-	0, 253, 416, 417, 390, 160, 161, 162, 163, 0,
-	0, 164, 165, 410, 0, 166, 167, 168, 212, 442,
-	0, 169, 0, 0, 0, 0, 170, 171, 172, 173,
-	368, 0, 396, 384, 385, 386, 383, 372, 0, 0,
-	364, 365, 0, 0, 89, 90, 366, 91, 0, 373,
-	1245, 0, 378, 0, 0, 0, 92, 93, 174, 425,
-	426, 94, 427, 428, 0, 95, 179, 96, 393, 411,
-	429, 430, 0, 421, 0, 404, 0, 97, 98, 99,
-	0, 100, 0, 101, 0, 294, 102, 103, 0, 405,
-	407, 0, 406, 408, 104, 105, 106, 107, 431, 108,
-	432, 433, 0, 0, 109, 0, 0, 0, 424, 111,
-	0, 0, 0, 0, 377, 112, 412, 391, 0, 113,
-	114, 434, 115, 0, 0, 0, 295, 0, 116, 422,
-	0, 190, 0, 117, 418, 420, 0, 0, 0, 296,
-	118, 435, 436, 437, 0, 403, 0, 297, 119, 298,
-	120, 0, 0, 423, 299, 121, 300, 0, 250, 0,
-	0, 0, 122, 123, 124, 125, 251, 301, 126, 127,
-	367, 128, 392, 419, 129, 438, 130, 131, 0, 0,
-	0, 0, 0, 132, 200, 302, 133, 303, 413, 134,
-	135, 0, 414, 136, 203, 0, 137, 138, 439, 139,
-	140, 0, 141, 142, 143, 0, 144, 304, 145, 146,
-	381, 147, 0, 148, 149, 0, 150, 252, 409, 151,
-	152, 305, 153, 440, 154, 0, 155, 157, 207, 156,
-	415, 0, 0, 158, 159, 0, 254, 441, 0, 0,
-	253, 416, 417, 390, 160, 161, 162, 163, 0, 0,
-	164, 165, 410, 0, 166, 167, 168, 212, 442, 0,
-	169, 0, 0, 0, 0, 170, 171, 172, 173, 368,
-	0, 396, 384, 385, 386, 383, 372, 0, 0, 364,
-	365, 0, 0, 89, 90, 366, 91, 0, 373, 918,
-	0, 378, 0, 0, 0, 92, 93, 174, 425, 426,
-	94, 427, 428, 0, 95, 179, 96, 393, 411, 429,
-	430, 0, 421, 0, 404, 0, 97, 98, 99, 0,
-	100, 0, 101, 0, 294, 102, 103, 0, 405, 407,
-	0, 406, 408, 104, 105, 106, 107, 431, 108, 432,
-	433, 0, 0, 109, 0, 0, 0, 424, 111, 0,
-	0, 0, 0, 377, 112, 412, 391, 0, 113, 114,
-	434, 115, 0, 0, 0, 295, 0, 116, 422, 0,
-	190, 0, 117, 418, 420, 0, 0, 0, 296, 118,
-	435, 436, 437, 0, 403, 0, 297, 119, 298, 120,
-	0, 0, 423, 299, 121, 300, 0, 250, 0, 0,
-	0, 122, 123, 124, 125, 251, 301, 126, 127, 367,
-	128, 392, 419, 129, 438, 130, 131, 0, 0, 0,
-	0, 0, 132, 200, 302, 133, 303, 413, 134, 135,
-	0, 414, 136, 203, 0, 137, 138, 439, 139, 140,
-	0, 141, 142, 143, 0, 144, 304, 145, 146, 381,
-	147, 0, 148, 149, 0, 150, 252, 409, 151, 152,
-	305, 153, 440, 154, 0, 155, 157, 207, 156, 415,
-	0, 0, 158, 159, 0, 254, 441, 0, 0, 253,
-	416, 417, 390, 160, 161, 162, 163, 0, 0, 164,
-	165, 410, 0, 166, 167, 168, 212, 442, 0, 169,
-	0, 0, 0, 0, 170, 171, 172, 173, 368, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 364, 365,
-	0, 0, 0, 0, 366, 689, 914, 373, 396, 384,
-	385, 386, 383, 372, 0, 0, 0, 0, 0, 0,
-	89, 90, 0, 91, 0, 0, 0, 0, 378, 0,
-	0, 0, 92, 93, 174, 425, 426, 94, 427, 428,
-	0, 95, 179, 96, 393, 411, 429, 430, 0, 421,
-	0, 404, 0, 97, 98, 99, 0, 100, 0, 101,
-	0, 294, 102, 103, 0, 405, 407, 0, 406, 408,
-	104, 105, 106, 107, 431, 108, 432, 433, 0, 0,
-	109, 0, 0, 0, 424, 111, 0, 0, 0, 0,
-	377, 112, 412, 391, 0, 113, 114, 434, 115, 0,
-	0, 0, 295, 0, 116, 422, 0, 190, 0, 117,
-	418, 420, 0, 0, 0, 296, 118, 435, 436, 437,
-	0, 403, 0, 297, 119, 298, 120, 0, 0, 423,
-	299, 121, 300, 0, 250, 0, 0, 0, 122, 123,
-	124, 125, 251, 301, 126, 127, 367, 128, 392, 419,
-	129, 438, 130, 131, 0, 0, 0, 0, 0, 132,
-	200, 302, 133, 303, 413, 134, 135, 0, 414, 136,
-	203, 0, 137, 138, 439, 139, 140, 0, 141, 142,
-	143, 0, 144, 304, 145, 146, 381, 147, 0, 148,
-	149, 0, 150, 252, 409, 151, 152, 305, 153, 440,
-	154, 0, 155, 157, 207, 156, 415, 0, 0, 158,
-	159, 0, 254, 441, 0, 0, 253, 416, 417, 390,
-	160, 161, 162, 163, 0, 0, 164, 165, 410, 0,
-	166, 167, 168, 212, 442, 1251, 169, 0, 0, 0,
-	0, 170, 171, 172, 173, 368, 0, 396, 384, 385,
-	386, 383, 372, 0, 0, 364, 365, 0, 0, 89,
-	90, 366, 91, 0, 373, 0, 0, 378, 0, 0,
-	0, 92, 93, 174, 425, 426, 94, 427, 428, 0,
-	95, 179, 96, 393, 411, 429, 430, 0, 421, 0,
-	404, 0, 97, 98, 99, 0, 100, 0, 101, 0,
-	294, 102, 103, 0, 405, 407, 0, 406, 408, 104,
-	105, 106, 107, 431, 108, 432, 433, 462, 0, 109,
-	0, 0, 0, 424, 111, 0, 0, 0, 0, 377,
-	112, 412, 391, 0, 113, 114, 434, 115, 0, 0,
-	0, 295, 0, 116, 422, 0, 190, 0, 117, 418,
-	420, 0, 0, 0, 296, 118, 435, 436, 437, 0,
-	403, 0, 297, 119, 298, 120, 0, 0, 423, 299,
-	121, 300, 0, 250, 0, 0, 0, 122, 123, 124,
-	125, 251, 301, 126, 127, 367, 128, 392, 419, 129,
-	438, 130, 131, 0, 0, 0, 0, 0, 132, 200,
-	302, 133, 303, 413, 134, 135, 0, 414, 136, 203,
-	0, 137, 138, 439, 139, 140, 0, 141, 142, 143,
-	0, 144, 304, 145, 146, 381, 147, 0, 148, 149,
-	0, 150, 252, 409, 151, 152, 305, 153, 440, 154,
-	0, 155, 157, 207, 156, 415, 0, 0, 158, 159,
-	0, 254, 441, 0, 0, 253, 416, 417, 390, 160,
-	161, 162, 163, 0, 0, 164, 165, 410, 0, 166,
-	167, 168, 212, 442, 0, 169, 0, 0, 0, 0,
-	170, 171, 172, 173, 368, 0, 396, 384, 385, 386,
-	383, 372, 0, 0, 364, 365, 0, 0, 89, 90,
-	366, 91, 0, 373, 0, 0, 378, 0, 0, 0,
-	92, 93, 174, 425, 426, 94, 427, 428, 0, 95,
-	179, 96, 393, 411, 429, 430, 0, 421, 0, 404,
-	0, 97, 98, 99, 0, 100, 0, 101, 0, 294,
-	102, 103, 0, 405, 407, 0, 406, 408, 104, 105,
-	106, 107, 431, 108, 432, 433, 0, 0, 109, 0,
-	0, 0, 424, 111, 0, 0, 0, 0, 377, 112,
-	412, 391, 0, 113, 114, 434, 115, 0, 0, 972,
-	295, 0, 116, 422, 0, 190, 0, 117, 418, 420,
-	0, 0, 0, 296, 118, 435, 436, 437, 0, 403,
-	0, 297, 119, 298, 120, 0, 0, 423, 299, 121,
-	300, 0, 250, 0, 0, 0, 122, 123, 124, 125,
-	251, 301, 126, 127, 367, 128, 392, 419, 129, 438,
-	130, 131, 0, 0, 0, 0, 0, 132, 200, 302,
-	133, 303, 413, 134, 135, 0, 414, 136, 203, 0,
-	137, 138, 439, 139, 140, 0, 141, 142, 143, 0,
-	144, 304, 145, 146, 381, 147, 0, 148, 149, 0,
-	150, 252, 409, 151, 152, 305, 153, 440, 154, 0,
-	155, 157, 207, 156, 415, 0, 0, 158, 159, 0,
-	254, 441, 0, 0, 253, 416, 417, 390, 160, 161,
-	162, 163, 0, 0, 164, 165, 410, 0, 166, 167,
-	168, 212, 442, 0, 169, 0, 0, 0, 0, 170,
-	171, 172, 173, 368, 0, 396, 384, 385, 386, 383,
-	372, 0, 0, 364, 365, 0, 0, 89, 90, 366,
-	91, 0, 373, 0, 0, 378, 0, 0, 0, 92,
-	93, 174, 425, 426, 94, 427, 428, 0, 95, 179,
-	96, 393, 411, 429, 430, 0, 421, 0, 404, 0,
-	97, 98, 99, 0, 100, 0, 101, 0, 294, 102,
-	103, 0, 405, 407, 0, 406, 408, 104, 105, 106,
-	107, 431, 108, 432, 433, 0, 0, 109, 0, 0,
-	0, 424, 111, 0, 0, 0, 0, 377, 112, 412,
-	391, 0, 113, 114, 434, 115, 0, 0, 0, 295,
-	0, 116, 422, 0, 190, 0, 117, 418, 420, 0,
-	0, 0, 296, 118, 435, 436, 437, 0, 403, 0,
-	297, 119, 298, 120, 0, 0, 423, 299, 121, 300,
-	0, 250, 0, 0, 0, 122, 123, 124, 125, 251,
-	301, 126, 127, 367, 128, 392, 419, 129, 438, 130,
-	131, 0, 0, 0, 0, 0, 132, 200, 302, 133,
-	303, 413, 134, 135, 0, 414, 136, 203, 0, 137,
-	138, 439, 139, 140, 0, 141, 142, 143, 0, 144,
-	304, 145, 146, 381, 147, 0, 148, 149, 0, 150,
-	252, 409, 151, 152, 305, 153, 440, 154, 0, 155,
-	157, 207, 156, 415, 0, 0, 158, 159, 0, 254,
-	441, 0, 0, 253, 416, 417, 390, 160, 161, 162,
-	163, 0, 0, 164, 165, 410, 0, 166, 167, 168,
-	212, 442, 0, 169, 0, 0, 0, 0, 170, 171,
-	172, 173, 368, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 364, 365, 362, 0, 0, 0, 366, 0,
-	0, 373, 396, 384, 385, 386, 383, 372, 0, 0,
-	0, 0, 0, 0, 89, 90, 630, 91, 0, 0,
-	0, 0, 378, 0, 0, 0, 92, 93, 174, 425,
-	426, 94, 427, 428, 0, 95, 179, 96, 393, 411,
-	429, 430, 0, 421, 0, 404, 0, 97, 98, 99,
-	0, 100, 0, 101, 0, 294, 102, 103, 0, 405,
-	407, 0, 406, 408, 104, 105, 106, 107, 431, 108,
-	432, 433, 0, 0, 109, 0, 0, 0, 424, 111,
-	0, 0, 0, 0, 377, 112, 412, 391, 0, 113,
-	114, 434, 115, 0, 0, 0, 295, 0, 116, 422,
-	0, 190, 0, 117, 418, 420, 0, 0, 0, 296,
-	118, 435, 436, 437, 0, 403, 0, 297, 119, 298,
-	120, 0, 0, 423, 299, 121, 300, 0, 250, 0,
-	0, 0, 122, 123, 124, 125, 251, 301, 126, 127,
-	367, 128, 392, 419, 129, 438, 130, 131, 0, 0,
-	0, 0, 0, 132, 200, 302, 133, 303, 413, 134,
-	135, 0, 414, 136, 203, 0, 137, 138, 439, 139,
-	140, 0, 141, 142, 143, 0, 144, 304, 145, 146,
-	381, 147, 0, 148, 149, 0, 150, 252, 409, 151,
-	152, 305, 153, 440, 154, 0, 155, 157, 207, 156,
-	415, 0, 0, 158, 159, 0, 254, 441, 0, 0,
-	253, 416, 417, 390, 160, 161, 162, 163, 0, 0,
-	164, 165, 410, 0, 166, 167, 168, 212, 442, 0,
-	169, 0, 0, 0, 0, 170, 171, 172, 173, 368,
-	0, 396, 384, 385, 386, 383, 372, 0, 0, 364,
-	365, 0, 0, 89, 90, 366, 91, 0, 373, 0,
-	0, 378, 0, 0, 0, 92, 93, 174, 425, 426,
-	94, 427, 428, 0, 95, 179, 96, 393, 411, 429,
-	430, 0, 421, 0, 404, 0, 97, 98, 99, 0,
-	100, 0, 101, 0, 294, 102, 1564, 0, 405, 407,
-	0, 406, 408, 104, 105, 106, 107, 431, 108, 432,
-	433, 0, 0, 109, 0, 0, 0, 424, 111, 0,
-	0, 0, 0, 377, 112, 412, 391, 0, 113, 114,
-	434, 115, 0, 0, 0, 295, 0, 116, 422, 0,
-	190, 0, 117, 418, 420, 0, 0, 0, 296, 118,
-	435, 436, 437, 0, 403, 0, 297, 119, 298, 120,
-	0, 0, 423, 299, 121, 300, 0, 250, 0, 0,
-	0, 122, 123, 124, 125, 251, 301, 126, 127, 367,
-	128, 392, 419, 129, 438, 130, 131, 0, 0, 0,
-	0, 0, 132, 200, 302, 133, 303, 413, 134, 135,
-	0, 414, 136, 203, 0, 137, 138, 439, 139, 140,
-	0, 141, 142, 143, 0, 144, 304, 145, 146, 381,
-	147, 0, 148, 149, 0, 150, 252, 409, 151, 152,
-	305, 153, 440, 154, 0, 155, 157, 207, 156, 415,
-	0, 0, 158, 159, 0, 254, 441, 0, 0, 253,
-	416, 417, 390, 160, 161, 1563, 163, 0, 0, 164,
-	165, 410, 0, 166, 167, 168, 212, 442, 0, 169,
-	0, 0, 0, 0, 170, 171, 172, 173, 368, 0,
-	396, 384, 385, 386, 383, 372, 0, 0, 364, 365,
-	0, 0, 89, 90, 366, 91, 0, 373, 0, 0,
-	378, 0, 0, 0, 92, 93, 1562, 425, 426, 94,
-	427, 428, 0, 95, 179, 96, 393, 411, 429, 430,
-	0, 421, 0, 404, 0, 97, 98, 99, 0, 100,
-	0, 101, 0, 294, 102, 1564, 0, 405, 407, 0,
-	406, 408, 104, 105, 106, 107, 431, 108, 432, 433,
-	0, 0, 109, 0, 0, 0, 424, 111, 0, 0,
-	0, 0, 377, 112, 412, 391, 0, 113, 114, 434,
-	115, 0, 0, 0, 295, 0, 116, 422, 0, 190,
-	0, 117, 418, 420, 0, 0, 0, 296, 118, 435,
-	436, 437, 0, 403, 0, 297, 119, 298, 120, 0,
-	0, 423, 299, 121, 300, 0, 250, 0, 0, 0,
-	122, 123, 124, 125, 251, 301, 126, 127, 367, 128,
-	392, 419, 129, 438, 130, 131, 0, 0, 0, 0,
-	0, 132, 200, 302, 133, 303, 413, 134, 135, 0,
-	414, 136, 203, 0, 137, 138, 439, 139, 140, 0,
-	141, 142, 143, 0, 144, 304, 145, 146, 381, 147,
-	0, 148, 149, 0, 150, 252, 409, 151, 152, 305,
-	153, 440, 154, 0, 155, 157, 207, 156, 415, 0,
-	0, 158, 159, 0, 254, 441, 0, 0, 253, 416,
-	417, 390, 160, 161, 1563, 163, 0, 0, 164, 165,
-	410, 0, 166, 167, 168, 212, 442, 0, 169, 0,
-	0, 0, 0, 170, 171, 172, 173, 368, 0, 396,
-	384, 385, 386, 383, 372, 0, 0, 364, 365, 0,
-	0, 89, 90, 366, 91, 0, 373, 0, 0, 378,
-	0, 0, 0, 92, 93, 174, 425, 426, 94, 427,
-	428, 0, 95, 179, 96, 393, 411, 429, 430, 0,
-	421, 0, 404, 0, 97, 98, 99, 0, 100, 0,
-	101, 0, 294, 102, 103, 0, 405, 407, 0, 406,
-	408, 104, 105, 106, 107, 431, 108, 432, 433, 0,
-	0, 109, 0, 0, 0, 424, 111, 0, 0, 0,
-	0, 377, 112, 412, 391, 0, 113, 114, 434, 115,
-	0, 0, 0, 295, 0, 116, 422, 0, 190, 0,
-	117, 418, 420, 0, 0, 0, 296, 118, 435, 436,
-	437, 0, 403, 0, 297, 119, 298, 120, 0, 0,
-	423, 299, 121, 300, 0, 250, 0, 0, 0, 122,
-	123, 124, 125, 251, 301, 126, 127, 367, 128, 392,
-	419, 129, 438, 130, 131, 0, 0, 0, 0, 0,
-	132, 200, 302, 133, 303, 413, 134, 135, 0, 414,
-	136, 203, 0, 137, 138, 439, 139, 140, 0, 141,
-	142, 143, 0, 144, 304, 145, 146, 381, 147, 0,
-	148, 149, 0, 150, 252, 409, 151, 152, 305, 153,
-	440, 154, 0, 155, 157, 207, 156, 415, 0, 0,
-	158, 159, 0, 254, 441, 0, 0, 253, 416, 417,
-	390, 160, 161, 162, 163, 0, 0, 164, 165, 410,
-	0, 166, 167, 168, 212, 442, 0, 169, 0, 0,
-	0, 0, 170, 171, 172, 173, 368, 0, 396, 384,
-	385, 386, 383, 372, 0, 0, 364, 365, 0, 0,
-	89, 90, 366, 91, 0, 373, 0, 0, 378, 0,
-	0, 0, 92, 93, 174, 425, 426, 94, 427, 428,
-	0, 95, 179, 96, 393, 411, 429, 430, 0, 421,
-	0, 404, 0, 97, 98, 99, 0, 100, 0, 101,
-	0, 294, 102, 103, 0, 405, 407, 0, 406, 408,
-	104, 105, 106, 107, 431, 108, 432, 433, 0, 0,
-	109, 0, 0, 0, 424, 111, 0, 0, 0, 0,
-	377, 112, 412, 391, 0, 113, 114, 434, 115, 0,
-	0, 0, 295, 0, 116, 422, 0, 190, 0, 117,
-	418, 420, 0, 0, 0, 296, 118, 435, 436, 437,
-	0, 403, 0, 297, 119, 298, 120, 0, 0, 423,
-	299, 121, 300, 0, 250, 0, 0, 0, 122, 123,
-	124, 125, 251, 301, 126, 127, 0, 128, 392, 419,
-	129, 438, 130, 131, 0, 0, 0, 0, 0, 132,
-	200, 302, 133, 303, 413, 134, 135, 0, 414, 136,
-	203, 0, 137, 138, 439, 139, 140, 0, 141, 142,
-	143, 0, 144, 304, 145, 146, 962, 147, 0, 148,
-	149, 0, 150, 252, 409, 151, 152, 305, 153, 440,
-	154, 0, 155, 157, 207, 156, 415, 0, 0, 158,
-	159, 0, 254, 441, 0, 0, 253, 416, 417, 390,
-	160, 161, 162, 163, 0, 0, 164, 165, 410, 0,
-	166, 167, 168, 212, 442, 0, 169, 0, 0, 0,
-	0, 170, 171, 172, 173, 396, 384, 385, 386, 383,
-	372, 0, 0, 0, 0, 958, 959, 89, 90, 0,
-	91, 960, 0, 0, 961, 378, 0, 0, 0, 92,
-	93, 0, 425, 426, 94, 427, 428, 0, 95, 179,
-	96, 393, 411, 429, 430, 0, 421, 0, 404, 0,
-	97, 98, 99, 0, 100, 0, 101, 0, 294, 102,
-	1564, 0, 405, 407, 0, 406, 408, 104, 105, 106,
-	107, 431, 108, 432, 433, 0, 0, 109, 0, 0,
-	0, 424, 111, 0, 0, 0, 0, 377, 112, 412,
-	391, 0, 113, 114, 434, 115, 0, 0, 0, 295,
-	0, 116, 422, 0, 190, 0, 117, 418, 420, 0,
-	0, 0, 296, 118, 435, 436, 437, 0, 403, 0,
-	0, 119, 298, 120, 0, 0, 423, 299, 121, 0,
-	0, 250, 0, 0, 0, 122, 123, 124, 125, 251,
-	301, 126, 127, 367, 128, 392, 419, 129, 438, 130,
-	131, 0, 0, 0, 0, 0, 132, 200, 302, 133,
-	303, 413, 134, 135, 0, 414, 136, 203, 0, 137,
-	138, 439, 139, 140, 0, 141, 142, 143, 0, 144,
-	304, 145, 146, 381, 147, 0, 148, 149, 0, 150,
-	252, 409, 151, 152, 0, 153, 440, 154, 0, 155,
-	157, 207, 156, 415, 0, 0, 158, 159, 0, 254,
-	441, 0, 0, 253, 416, 417, 390, 160, 161, 1563,
-	163, 0, 0, 164, 165, 410, 0, 166, 167, 168,
-	212, 442, 0, 169, 0, 0, 0, 0, 170, 171,
-	172, 173, 396, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 364, 365, 89, 90, 0, 91, 366, 0,
-	0, 373, 0, 0, 0, 0, 92, 93, 174, 175,
-	176, 94, 177, 178, 0, 95, 179, 96, 0, 411,
-	180, 181, 0, 421, 0, 404, 0, 97, 98, 99,
-	0, 100, 0, 101, 0, 294, 102, 103, 0, 405,
-	407, 0, 406, 408, 104, 105, 106, 107, 183, 108,
-	184, 185, 0, 0, 109, 0, 0, 0, 110, 111,
-	0, 0, 0, 0, 186, 112, 412, 0, 0, 113,
-	114, 188, 115, 0, 0, 0, 295, 0, 116, 422,
-	0, 190, 0, 117, 418, 420, 0, 0, 0, 296,
-	118, 193, 194, 195, 0, 196, 0, 297, 119, 298,
-	120, 0, 0, 423, 299, 121, 300, 0, 250, 0,
-	0, 0, 122, 123, 124, 125, 251, 301, 126, 127,
-	0, 128, 0, 419, 129, 199, 130, 131, 0, 0,
-	0, 0, 0, 132, 200, 302, 133, 303, 413, 134,
-	135, 0, 414, 136, 203, 0, 137, 138, 204, 139,
-	140, 0, 141, 142, 143, 0, 144, 304, 145, 146,
-	205, 147, 0, 148, 149, 0, 150, 252, 409, 151,
-	152, 305, 153, 206, 154, 0, 155, 157, 207, 156,
-	415, 0, 0, 158, 159, 0, 254, 209, 0, 0,
-	253, 416, 417, 0, 160, 161, 162, 163, 0, 0,
-	164, 165, 410, 0, 166, 167, 168, 212, 213, 0,
-	169, 0, 0, 0, 0, 170, 171, 172, 173, 288,
+	0, 594, 572, 0, 590, 591, 592, 0, 0, 589,
+	0, 0, 0, 0, 593, 0, 0, 0, 597, 0,
+	574, 0, 0, 599, 572, 0, 590, 591, 592, 0,
+	0, 0, 0, 0, 0, 0, 593, 0, 0, 589,
+	573, 0, 574, 0, 0, 599, 587, 0, 597, 0,
+	0, 572, 0, 590, 591, 592, 0, 0, 0, 0,
+	0, 0, 573, 593, 0, 0, 0, 0, 587, 574,
+	0, 596, 599, 0, 584, 585, 586, 0, 583, 580,
+	581, 582, 575, 576, 577, 578, 579, 0, 0, 573,
+	0, 0, 1599, 0, 0, 587, 0, 0, 0, 0,
+	0, 596, 0, 0, 584, 585, 586, 600, 583, 580,
+	581, 582, 575, 576, 577, 578, 579, 0, 0, 598,
+	0, 0, 1594, 0, 0, 0, 0, 0, 595, 600,
+	0, 0, 0, 588, 0, 0, 0, 0, 0, 0,
+	0, 598, 0, 0, 0, 0, 0, 0, 0, 0,
+	595, 0, 0, 594, 0, 588, 600, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 598, 0,
+	0, 0, 0, 0, 0, 594, 0, 595, 0, 0,
+	0, 0, 588, 0, 0, 0, 0, 0, 0, 0,
+	0, 589, 0, 0, 0, 0, 0, 0, 0, 0,
+	597, 0, 594, 572, 0, 590, 591, 592, 0, 0,
+	0, 0, 0, 589, 0, 593, 0, 0, 0, 0,
+	0, 574, 597, 0, 599, 0, 0, 0, 0, 0,
+	0, 0, 0, 572, 0, 590, 591, 592, 0, 0,
+	589, 573, 0, 0, 0, 593, 0, 587, 0, 597,
+	0, 574, 0, 596, 599, 0, 584, 585, 586, 0,
+	583, 580, 581, 582, 575, 576, 577, 578, 579, 0,
+	0, 573, 0, 0, 1590, 596, 0, 587, 584, 585,
+	586, 0, 583, 580, 581, 582, 575, 576, 577, 578,
+	579, 0, 0, 0, 0, 0, 1528, 0, 0, 0,
+	0, 0, 596, 0, 0, 584, 585, 586, 600, 583,
+	580, 581, 582, 575, 576, 577, 578, 579, 0, 0,
+	598, 0, 0, 1527, 0, 0, 0, 0, 0, 595,
+	0, 0, 0, 0, 588, 0, 0, 0, 600, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 89, 90, 0, 91, 0, 0, 0, 1362, 0,
-	0, 0, 0, 92, 93, 174, 175, 176, 94, 177,
-	178, 0, 95, 179, 96, 0, 0, 180, 181, 0,
-	182, 0, 293, 0, 97, 98, 99, 0, 100, 0,
-	101, 0, 294, 102, 103, 0, 0, 0, 0, 0,
-	0, 104, 105, 106, 107, 183, 108, 184, 185, 0,
-	0, 109, 0, 0, 0, 110, 111, 0, 0, 0,
-	0, 186, 112, 187, 0, 0, 113, 114, 188, 115,
-	0, 0, 0, 295, 0, 116, 189, 0, 190, 0,
-	117, 191, 192, 0, 0, 0, 296, 118, 193, 194,
-	195, 0, 196, 0, 297, 119, 298, 120, 0, 0,
-	197, 299, 121, 300, 0, 250, 0, 0, 0, 122,
-	123, 124, 125, 251, 301, 126, 127, 0, 128, 0,
-	198, 129, 199, 130, 131, 0, 0, 0, 0, 0,
-	132, 200, 302, 133, 303, 201, 134, 135, 0, 202,
-	136, 203, 0, 137, 138, 204, 139, 140, 0, 141,
-	142, 143, 0, 144, 304, 145, 146, 205, 147, 0,
-	148, 149, 43, 150, 252, 0, 151, 152, 305, 153,
-	206, 154, 0, 155, 157, 207, 156, 208, 0, 45,
-	158, 159, 0, 254, 209, 0, 0, 253, 210, 211,
-	0, 160, 161, 162, 163, 0, 0, 164, 165, 0,
-	0, 166, 167, 168, 292, 213, 0, 169, 0, 0,
-	0, 41, 170, 171, 172, 173, 0, 42, 288, 507,
-	511, 0, 512, 502, 0, 0, 0, 0, 0, 0,
-	89, 90, 0, 91, 0, 40, 0, 0, 0, 0,
-	0, 0, 92, 93, 174, 175, 176, 94, 177, 178,
-	0, 95, 179, 96, 0, 0, 180, 181, 0, 182,
-	0, 293, 0, 97, 98, 99, 0, 100, 0, 101,
-	0, 294, 102, 103, 0, 0, 0, 0, 0, 0,
-	104, 105, 106, 107, 183, 108, 184, 185, 515, 0,
-	109, 0, 0, 0, 110, 111, 0, 0, 0, 0,
-	186, 112, 187, 504, 0, 113, 114, 188, 115, 0,
-	0, 0, 295, 0, 116, 189, 0, 190, 0, 117,
-	191, 192, 0, 0, 0, 296, 118, 193, 194, 195,
-	0, 196, 0, 297, 119, 298, 120, 0, 0, 197,
-	299, 121, 300, 0, 250, 0, 0, 0, 122, 123,
-	124, 125, 251, 301, 126, 127, 0, 128, 0, 198,
-	129, 199, 130, 131, 0, 505, 0, 0, 0, 132,
-	200, 302, 133, 303, 201, 134, 135, 0, 202, 136,
-	203, 0, 137, 138, 204, 139, 140, 0, 141, 142,
-	143, 0, 144, 304, 145, 146, 205, 147, 0, 148,
-	149, 0, 150, 252, 0, 151, 152, 305, 153, 206,
-	154, 0, 155, 157, 207, 156, 208, 0, 0, 158,
-	159, 0, 254, 209, 0, 0, 253, 210, 211, 503,
-	160, 161, 162, 163, 0, 0, 164, 165, 0, 0,
-	166, 167, 168, 212, 213, 0, 169, 0, 0, 0,
-	0, 170, 171, 172, 173, 288, 507, 511, 0, 512,
-	502, 0, 0, 0, 0, 513, 508, 89, 90, 0,
-	91, 0, 0, 0, 0, 0, 0, 0, 0, 92,
-	93, 174, 175, 176, 94, 177, 178, 0, 95, 179,
-	96, 0, 0, 180, 181, 0, 182, 0, 293, 0,
-	97, 98, 99, 0, 100, 0, 101, 0, 294, 102,
-	103, 0, 0, 0, 0, 0, 0, 104, 105, 106,
-	107, 183, 108, 184, 185, 498, 0, 109, 0, 0,
-	0, 110, 111, 0, 0, 0, 0, 186, 112, 187,
-	504, 0, 113, 114, 188, 115, 0, 0, 0, 295,
-	0, 116, 189, 0, 190, 0, 117, 191, 192, 0,
-	0, 0, 296, 118, 193, 194, 195, 0, 196, 0,
-	297, 119, 298, 120, 0, 0, 197, 299, 121, 300,
-	0, 250, 0, 0, 0, 122, 123, 124, 125, 251,
-	301, 126, 127, 0, 128, 0, 198, 129, 199, 130,
-	131, 0, 505, 0, 0, 0, 132, 200, 302, 133,
-	303, 201, 134, 135, 0, 202, 136, 203, 0, 137,
-	138, 204, 139, 140, 0, 141, 142, 143, 0, 144,
-	304, 145, 146, 205, 147, 0, 148, 149, 0, 150,
-	252, 0, 151, 152, 305, 153, 206, 154, 0, 155,
-	157, 207, 156, 208, 0, 0, 158, 159, 0, 254,
-	209, 0, 0, 253, 210, 211, 503, 160, 161, 162,
-	163, 0, 0, 164, 165, 0, 0, 166, 167, 168,
-	212, 213, 0, 169, 0, 0, 0, 0, 170, 171,
-	172, 173, 288, 507, 511, 0, 512, 502, 0, 0,
-	0, 0, 513, 508, 89, 90, 0, 91, 0, 0,
-	0, 0, 0, 0, 0, 0, 92, 93, 174, 175,
-	176, 94, 177, 178, 0, 95, 179, 96, 0, 0,
-	180, 181, 0, 182, 0, 293, 0, 97, 98, 99,
-	0, 100, 0, 101, 0, 294, 102, 103, 0, 0,
-	0, 0, 0, 0, 104, 105, 106, 107, 183, 108,
-	184, 185, 0, 0, 109, 0, 0, 0, 110, 111,
-	0, 0, 0, 0, 186, 112, 187, 504, 0, 113,
-	114, 188, 115, 0, 0, 0, 295, 0, 116, 189,
-	0, 190, 0, 117, 191, 192, 0, 0, 0, 296,
-	118, 193, 194, 195, 0, 196, 0, 297, 119, 298,
-	120, 0, 0, 197, 299, 121, 300, 0, 250, 0,
-	0, 0, 122, 123, 124, 125, 251, 301, 126, 127,
-	0, 128, 0, 198, 129, 199, 130, 131, 0, 505,
-	0, 0, 0, 132, 200, 302, 133, 303, 201, 134,
-	135, 0, 202, 136, 203, 0, 137, 138, 204, 139,
-	140, 0, 141, 142, 143, 0, 144, 304, 145, 146,
-	205, 147, 0, 148, 149, 0, 150, 252, 0, 151,
-	152, 305, 153, 206, 154, 0, 155, 157, 207, 156,
-	208, 0, 0, 158, 159, 0, 254, 209, 0, 0,
-	253, 210, 211, 503, 160, 161, 162, 163, 0, 0,
-	164, 165, 0, 0, 166, 167, 168, 212, 213, 86,
-	169, 0, 0, 0, 0, 170, 171, 172, 173, 0,
-	0, 89, 90, 0, 91, 0, 0, 0, 0, 513,
-	508, 0, 0, 92, 93, 174, 175, 176, 94, 177,
-	178, 0, 95, 179, 96, 0, 0, 180, 181, 0,
-	182, 0, 0, 0, 97, 98, 99, 0, 100, 0,
-	101, 0, 0, 102, 103, 0, 0, 0, 0, 0,
-	0, 104, 105, 106, 107, 183, 108, 184, 185, 0,
-	0, 109, 0, 0, 0, 110, 111, 0, 0, 0,
-	0, 186, 112, 187, 0, 0, 113, 114, 188, 115,
-	0, 0, 0, 0, 0, 116, 189, 0, 190, 0,
-	117, 191, 192, 0, 0, 0, 0, 118, 193, 194,
-	195, 0, 196, 0, 0, 119, 0, 120, 0, 0,
-	197, 0, 121, 0, 0, 250, 0, 0, 0, 122,
-	123, 124, 125, 251, 0, 126, 127, 0, 128, 0,
-	198, 129, 199, 130, 131, 0, 0, 263, 0, 0,
-	132, 200, 0, 133, 0, 201, 134, 135, 0, 202,
-	136, 203, 0, 137, 138, 204, 139, 140, 0, 141,
-	142, 143, 0, 144, 0, 145, 146, 205, 147, 0,
-	148, 149, 43, 150, 252, 0, 151, 152, 0, 153,
-	206, 154, 0, 155, 157, 207, 156, 208, 0, 45,
-	158, 159, 0, 254, 209, 0, 0, 253, 210, 211,
-	0, 160, 161, 162, 163, 0, 0, 164, 165, 0,
-	0, 166, 167, 168, 292, 213, 0, 169, 0, 0,
-	0, 41, 170, 171, 172, 173, 86, 42, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 89, 90,
-	0, 91, 0, 0, 0, 829, 0, 0, 0, 0,
-	92, 93, 174, 175, 176, 94, 177, 178, 0, 95,
-	179, 96, 0, 0, 180, 181, 0, 182, 0, 0,
-	0, 97, 98, 99, 0, 100, 0, 101, 0, 0,
-	102, 103, 0, 0, 0, 0, 0, 0, 104, 105,
-	106, 107, 183, 108, 184, 185, 0, 0, 109, 0,
-	0, 0, 110, 111, 0, 0, 0, 0, 186, 112,
-	187, 0, 0, 113, 114, 188, 115, 0, 0, 0,
-	0, 0, 116, 189, 0, 190, 0, 117, 191, 192,
-	0, 0, 0, 0, 118, 193, 194, 195, 0, 196,
-	0, 0, 119, 0, 120, 0, 0, 197, 0, 121,
-	0, 0, 250, 0, 0, 0, 122, 123, 124, 125,
-	251, 0, 126, 127, 0, 128, 0, 198, 129, 199,
-	130, 131, 0, 0, 0, 0, 0, 132, 200, 0,
-	133, 0, 201, 134, 135, 0, 202, 136, 203, 0,
-	137, 138, 204, 139, 140, 0, 141, 142, 143, 0,
-	144, 0, 145, 146, 205, 147, 0, 148, 149, 43,
-	150, 252, 0, 151, 152, 0, 153, 206, 154, 0,
-	155, 157, 207, 156, 208, 0, 45, 158, 159, 0,
-	254, 209, 0, 0, 253, 210, 211, 0, 160, 161,
-	162, 163, 0, 0, 164, 165, 0, 0, 166, 167,
-	168, 292, 213, 0, 169, 0, 0, 0, 41, 170,
-	171, 172, 173, 86, 42, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 89, 90, 0, 91, 0,
-	0, 0, 40, 0, 1061, 0, 0, 92, 93, 174,
-	175, 176, 94, 177, 178, 0, 95, 179, 96, 0,
-	0, 180, 181, 0, 182, 0, 0, 0, 97, 98,
-	99, 0, 100, 0, 101, 0, 0, 102, 103, 0,
-	0, 0, 0, 0, 0, 104, 105, 106, 107, 183,
-	108, 184, 185, 0, 0, 109, 0, 0, 0, 110,
-	111, 0, 0, 0, 0, 186, 112, 187, 0, 0,
-	113, 114, 188, 115, 0, 0, 0, 0, 0, 116,
-	189, 0, 190, 0, 117, 191, 192, 0, 0, 0,
-	0, 118, 193, 194, 195, 0, 196, 0, 0, 119,
-	0, 120, 0, 0, 197, 0, 121, 0, 0, 250,
-	0, 0, 0, 122, 123, 124, 125, 251, 0, 126,
-	127, 0, 128, 0, 198, 129, 199, 130, 131, 0,
-	0, 0, 0, 0, 132, 200, 0, 133, 0, 201,
-	134, 135, 0, 202, 136, 203, 0, 137, 138, 204,
-	139, 140, 0, 141, 142, 143, 0, 144, 0, 145,
-	146, 205, 147, 0, 148, 149, 0, 150, 252, 0,
-	151, 152, 0, 153, 206, 154, 0, 155, 157, 207,
-	156, 208, 0, 0, 158, 159, 0, 254, 209, 0,
-	0, 253, 210, 211, 0, 160, 161, 162, 163, 0,
-	86, 164, 165, 0, 0, 166, 167, 168, 212, 213,
-	0, 169, 89, 90, 0, 91, 170, 171, 172, 173,
-	0, 0, 0, 0, 92, 93, 174, 175, 176, 94,
-	177, 178, 0, 95, 179, 96, 0, 0, 180, 181,
-	353, 182, 0, 0, 0, 97, 98, 99, 0, 100,
-	0, 101, 0, 0, 102, 103, 0, 0, 0, 0,
-	0, 0, 104, 105, 106, 107, 183, 108, 184, 185,
-	0, 0, 109, 0, 0, 0, 110, 111, 0, 0,
-	0, 0, 186, 112, 187, 0, 0, 113, 114, 188,
-	115, 0, 0, 0, 0, 0, 116, 189, 0, 190,
-	0, 117, 191, 192, 0, 0, 0, 0, 118, 193,
-	194, 195, 0, 196, 0, 0, 119, 0, 120, 0,
-	0, 197, 0, 121, 0, 0, 250, 0, 0, 0,
-	122, 123, 124, 125, 251, 0, 126, 127, 0, 128,
-	0, 198, 129, 199, 130, 131, 0, 0, 263, 0,
-	0, 132, 200, 0, 133, 0, 201, 134, 135, 0,
-	202, 136, 203, 0, 137, 138, 204, 139, 140, 0,
-	141, 142, 143, 0, 144, 0, 145, 146, 205, 147,
-	0, 148, 149, 0, 150, 252, 0, 151, 152, 0,
-	153, 206, 154, 0, 155, 157, 207, 156, 208, 0,
-	0, 158, 159, 0, 254, 209, 0, 0, 253, 210,
-	211, 0, 160, 161, 162, 163, 0, 0, 164, 165,
-	0, 0, 166, 167, 168, 212, 213, 0, 169, 0,
-	0, 0, 0, 170, 171, 172, 173, 86, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 89,
-	90, 0, 91, 0, 0, 0, 829, 0, 0, 0,
-	0, 92, 93, 174, 175, 176, 94, 177, 178, 0,
-	95, 179, 96, 0, 0, 180, 181, 0, 182, 0,
-	0, 0, 97, 98, 99, 0, 100, 0, 101, 0,
-	0, 102, 103, 0, 0, 0, 0, 0, 0, 104,
-	105, 106, 107, 183, 108, 184, 185, 0, 0, 109,
-	0, 0, 0, 110, 111, 0, 0, 0, 0, 186,
-	112, 187, 0, 0, 113, 114, 188, 115, 0, 0,
-	0, 0, 0, 116, 189, 0, 190, 0, 117, 191,
-	192, 0, 0, 0, 0, 118, 193, 194, 195, 0,
-	196, 0, 0, 119, 0, 120, 0, 0, 197, 0,
-	121, 0, 0, 250, 0, 0, 0, 122, 123, 124,
-	125, 251, 0, 126, 127, 0, 128, 0, 198, 129,
-	199, 130, 131, 0, 0, 0, 0, 0, 132, 200,
-	0, 133, 0, 201, 134, 135, 0, 202, 136, 203,
-	0, 137, 138, 204, 139, 140, 0, 141, 142, 143,
-	0, 144, 0, 145, 146, 205, 147, 0, 148, 149,
-	0, 150, 252, 0, 151, 152, 0, 153, 206, 154,
-	0, 155, 157, 207, 156, 208, 0, 0, 158, 159,
-	0, 254, 209, 0, 0, 253, 210, 211, 0, 160,
-	161, 162, 163, 0, 0, 164, 165, 0, 0, 166,
-	167, 168, 212, 213, 0, 169, 0, 0, 0, 0,
-	170, 171, 172, 173, 86, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 89, 90, 0, 91,
-	0, 0, 0, 774, 0, 0, 0, 0, 92, 93,
-	174, 175, 176, 94, 177, 178, 0, 95, 179, 96,
-	0, 0, 180, 181, 0, 182, 0, 0, 0, 97,
-	98, 99, 0, 100, 0, 101, 0, 0, 102, 103,
-	0, 0, 0, 0, 0, 0, 104, 105, 106, 107,
-	183, 108, 184, 185, 0, 0, 109, 0, 0, 0,
-	110, 111, 0, 0, 0, 0, 186, 112, 187, 0,
-	0, 113, 114, 188, 115, 0, 0, 0, 0, 0,
-	116, 189, 0, 190, 0, 117, 191, 192, 0, 0,
-	0, 0, 118, 193, 194, 195, 0, 196, 0, 0,
-	119, 0, 120, 0, 0, 197, 0, 121, 0, 0,
-	250, 0, 0, 0, 122, 123, 124, 125, 251, 0,
-	126, 127, 0, 128, 0, 198, 129, 199, 130, 131,
-	0, 0, 0, 0, 0, 132, 200, 0, 133, 0,
-	201, 134, 135, 0, 202, 136, 203, 0, 137, 138,
-	204, 139, 140, 0, 141, 142, 143, 0, 144, 0,
-	145, 146, 205, 147, 0, 148, 149, 0, 150, 252,
-	0, 151, 152, 0, 153, 206, 154, 0, 155, 157,
-	207, 156, 208, 0, 0, 158, 159, 0, 254, 209,
-	0, 0, 253, 210, 211, 0, 160, 161, 162, 163,
-	0, 0, 164, 165, 0, 0, 166, 167, 168, 212,
-	213, 0, 169, 0, 0, 0, 0, 170, 171, 172,
-	173, 86, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 89, 90, 0, 91, 0, 0, 0,
-	1269, 0, 0, 0, 0, 92, 93, 174, 175, 176,
-	94, 177, 178, 0, 95, 179, 96, 0, 0, 180,
-	181, 0, 182, 0, 0, 0, 97, 98, 99, 0,
-	100, 0, 101, 0, 0, 102, 103, 0, 0, 0,
-	0, 0, 0, 104, 105, 106, 107, 183, 108, 184,
-	185, 0, 0, 109, 0, 0, 0, 110, 111, 0,
-	0, 0, 0, 186, 112, 187, 0, 0, 113, 114,
-	188, 115, 0, 0, 0, 0, 0, 116, 189, 0,
-	190, 0, 117, 191, 192, 0, 0, 0, 0, 118,
-	193, 194, 195, 0, 196, 0, 0, 119, 0, 120,
-	0, 0, 197, 0, 121, 0, 0, 250, 0, 0,
-	0, 122, 123, 124, 125, 251, 0, 126, 127, 0,
-	128, 0, 198, 129, 199, 130, 131, 0, 0, 0,
-	0, 0, 132, 200, 0, 133, 0, 201, 134, 135,
-	0, 202, 136, 203, 0, 137, 138, 204, 139, 140,
-	0, 141, 142, 143, 0, 144, 0, 145, 146, 205,
-	147, 0, 148, 149, 0, 150, 252, 0, 151, 152,
-	0, 153, 206, 154, 0, 155, 157, 207, 156, 208,
-	0, 0, 158, 159, 0, 254, 209, 0, 0, 253,
-	210, 211, 0, 160, 161, 162, 163, 0, 0, 164,
-	165, 0, 0, 166, 167, 168, 212, 213, 0, 169,
-	0, 0, 0, 0, 170, 171, 172, 173, 288, 0,
+	598, 0, 0, 0, 594, 0, 0, 0, 0, 595,
+	0, 0, 0, 0, 588, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	89, 90, 0, 91, 0, 0, 0, 453, 0, 0,
-	0, 0, 92, 93, 174, 175, 176, 94, 177, 178,
-	0, 95, 179, 96, 0, 0, 180, 181, 0, 182,
-	0, 293, 0, 97, 98, 99, 0, 100, 0, 101,
-	0, 294, 102, 103, 0, 0, 0, 0, 0, 0,
-	104, 105, 106, 107, 183, 108, 184, 185, 0, 0,
-	109, 0, 0, 0, 110, 111, 0, 0, 0, 0,
-	186, 112, 187, 0, 0, 113, 114, 188, 115, 0,
-	0, 0, 295, 0, 116, 189, 0, 190, 0, 117,
-	191, 192, 0, 0, 0, 296, 118, 193, 194, 195,
-	0, 196, 0, 297, 119, 298, 120, 0, 0, 197,
-	299, 121, 300, 0, 250, 0, 0, 0, 122, 123,
-	124, 125, 251, 301, 126, 127, 0, 128, 0, 198,
-	129, 199, 130, 131, 0, 0, 0, 0, 0, 132,
-	200, 302, 133, 303, 201, 134, 135, 0, 202, 136,
-	203, 0, 137, 138, 204, 139, 140, 0, 141, 142,
-	143, 0, 144, 304, 145, 146, 205, 147, 0, 148,
-	149, 0, 150, 252, 0, 151, 152, 305, 153, 206,
-	154, 0, 155, 157, 207, 156, 208, 0, 0, 158,
-	159, 0, 254, 209, 0, 0, 253, 210, 211, 0,
-	160, 161, 162, 163, 0, 86, 164, 165, 0, 0,
-	166, 167, 168, 212, 213, 0, 169, 89, 90, 0,
-	91, 170, 171, 172, 173, 0, 0, 0, 0, 92,
-	93, 174, 175, 176, 94, 177, 178, 0, 95, 179,
-	96, 0, 0, 180, 181, 749, 182, 0, 0, 0,
-	97, 98, 99, 0, 100, 747, 101, 0, 0, 102,
-	103, 0, 0, 0, 0, 0, 0, 104, 105, 106,
-	107, 183, 108, 184, 185, 0, 0, 109, 0, 0,
-	0, 110, 111, 0, 0, 0, 0, 186, 112, 187,
-	0, 0, 113, 114, 188, 115, 0, 752, 0, 0,
-	0, 116, 189, 0, 190, 0, 117, 191, 192, 0,
-	807, 0, 0, 118, 193, 194, 195, 0, 196, 0,
-	0, 119, 0, 120, 0, 0, 197, 0, 121, 0,
-	0, 250, 0, 0, 0, 122, 123, 124, 125, 251,
-	0, 126, 127, 0, 128, 0, 198, 129, 199, 130,
-	131, 0, 0, 0, 0, 0, 132, 200, 0, 133,
-	0, 201, 134, 135, 0, 202, 136, 203, 751, 137,
-	138, 204, 139, 140, 0, 141, 142, 143, 0, 144,
-	0, 145, 146, 205, 147, 0, 148, 149, 0, 150,
-	252, 0, 151, 152, 0, 153, 206, 154, 0, 155,
-	157, 207, 156, 208, 0, 0, 158, 159, 0, 254,
-	209, 0, 0, 253, 210, 211, 0, 160, 161, 162,
-	163, 0, 808, 164, 165, 0, 0, 166, 167, 168,
-	212, 213, 86, 169, 0, 0, 0, 0, 170, 171,
-	172, 173, 0, 0, 89, 90, 0, 91, 0, 0,
-	0, 0, 0, 0, 0, 0, 92, 93, 174, 175,
-	176, 94, 177, 178, 0, 95, 179, 96, 0, 0,
-	180, 181, 749, 182, 0, 0, 744, 97, 98, 99,
-	0, 100, 747, 101, 0, 0, 102, 103, 0, 0,
-	0, 0, 0, 0, 104, 105, 106, 107, 183, 108,
-	184, 185, 0, 0, 109, 0, 0, 0, 110, 111,
-	0, 0, 0, 0, 186, 112, 187, 0, 0, 113,
-	114, 188, 115, 0, 752, 0, 0, 0, 116, 189,
-	0, 190, 0, 117, 743, 192, 0, 0, 0, 0,
-	118, 193, 194, 195, 0, 196, 0, 0, 119, 0,
-	120, 0, 0, 197, 0, 121, 0, 0, 250, 0,
-	0, 0, 122, 123, 124, 125, 251, 0, 126, 127,
-	0, 128, 0, 198, 129, 199, 130, 131, 0, 0,
-	0, 0, 0, 132, 200, 0, 133, 0, 201, 134,
-	135, 0, 202, 136, 203, 751, 137, 138, 204, 139,
-	140, 0, 141, 142, 143, 0, 144, 0, 145, 146,
-	205, 147, 0, 148, 149, 0, 150, 252, 0, 151,
-	152, 0, 153, 206, 154, 0, 155, 157, 207, 156,
-	208, 0, 0, 158, 159, 0, 254, 209, 0, 0,
-	253, 210, 211, 0, 160, 161, 162, 163, 0, 750,
-	164, 165, 0, 0, 166, 167, 168, 212, 213, 86,
-	169, 0, 0, 0, 0, 170, 171, 172, 173, 0,
-	0, 89, 90, 0, 91, 0, 0, 0, 0, 0,
-	1061, 0, 0, 92, 93, 174, 175, 176, 94, 177,
-	178, 0, 95, 179, 96, 0, 0, 180, 181, 0,
-	182, 0, 0, 0, 97, 98, 99, 0, 100, 0,
-	101, 0, 0, 102, 103, 0, 0, 0, 0, 0,
-	0, 104, 105, 106, 107, 183, 108, 184, 185, 0,
-	0, 109, 0, 0, 0, 110, 111, 0, 0, 0,
-	0, 186, 112, 187, 0, 0, 113, 114, 188, 115,
-	0, 0, 0, 0, 0, 116, 189, 0, 190, 0,
-	117, 191, 192, 0, 0, 0, 0, 118, 193, 194,
-	195, 0, 196, 0, 0, 119, 0, 120, 0, 0,
-	197, 0, 121, 0, 0, 250, 0, 0, 0, 122,
-	123, 124, 125, 251, 0, 126, 127, 0, 128, 0,
-	198, 129, 199, 130, 131, 0, 0, 0, 0, 0,
-	132, 200, 0, 133, 0, 201, 134, 135, 0, 202,
-	136, 203, 0, 137, 138, 204, 139, 140, 0, 141,
-	142, 143, 0, 144, 0, 145, 146, 205, 147, 0,
-	148, 149, 0, 150, 252, 0, 151, 152, 0, 153,
-	206, 154, 0, 155, 157, 207, 156, 208, 0, 0,
-	158, 159, 0, 254, 209, 0, 0, 253, 210, 211,
-	0, 160, 161, 162, 163, 0, 86, 164, 165, 0,
-	0, 166, 167, 168, 212, 213, 0, 169, 89, 90,
-	0, 91, 170, 171, 172, 173, 0, 0, 0, 0,
-	92, 93, 174, 175, 176, 94, 177, 178, 0, 95,
-	179, 96, 0, 0, 180, 181, 0, 182, 0, 0,
-	0, 97, 98, 99, 0, 100, 0, 101, 0, 0,
-	102, 103, 0, 0, 0, 0, 0, 0, 104, 105,
-	106, 107, 183, 108, 184, 185, 0, 0, 109, 0,
-	0, 0, 110, 111, 0, 0, 0, 0, 186, 112,
-	187, 0, 0, 113, 114, 188, 115, 0, 0, 0,
-	0, 0, 116, 189, 0, 190, 0, 117, 191, 192,
-	0, 0, 0, 0, 118, 193, 194, 195, 0, 196,
-	0, 0, 119, 0, 120, 0, 0, 197, 0, 121,
-	0, 0, 250, 0, 0, 0, 122, 123, 124, 125,
-	251, 0, 126, 127, 0, 128, 0, 198, 129, 199,
-	130, 131, 0, 0, 263, 0, 0, 132, 200, 0,
-	133, 0, 201, 134, 135, 0, 202, 136, 203, 0,
-	137, 138, 204, 139, 140, 0, 141, 142, 143, 0,
-	144, 0, 145, 146, 205, 147, 0, 148, 149, 0,
-	150, 252, 0, 151, 152, 0, 153, 206, 154, 0,
-	155, 157, 207, 156, 208, 0, 0, 158, 159, 0,
-	254, 209, 0, 0, 253, 210, 211, 0, 160, 161,
-	162, 163, 0, 86, 164, 165, 0, 0, 166, 167,
-	168, 212, 213, 0, 169, 89, 90, 0, 91, 170,
-	171, 172, 173, 0, 0, 0, 0, 92, 93, 174,
-	175, 176, 94, 177, 178, 0, 95, 179, 96, 0,
-	0, 180, 181, 0, 182, 0, 0, 0, 97, 98,
-	99, 0, 100, 0, 101, 0, 0, 102, 103, 0,
-	0, 0, 0, 0, 0, 104, 105, 493, 107, 183,
-	108, 184, 185, 0, 0, 109, 0, 0, 0, 110,
-	111, 0, 0, 0, 0, 186, 112, 187, 0, 0,
-	113, 114, 188, 115, 0, 0, 0, 0, 0, 116,
-	189, 0, 190, 0, 117, 191, 192, 0, 0, 0,
-	0, 118, 193, 194, 195, 0, 196, 0, 0, 119,
-	0, 120, 0, 0, 197, 0, 121, 0, 0, 250,
-	0, 0, 0, 122, 123, 124, 125, 251, 0, 126,
-	127, 0, 128, 0, 198, 129, 199, 130, 131, 0,
-	0, 0, 0, 0, 132, 200, 0, 133, 0, 201,
-	134, 135, 0, 202, 136, 203, 0, 137, 138, 204,
-	139, 140, 0, 141, 142, 143, 0, 144, 0, 145,
-	146, 205, 147, 0, 148, 149, 0, 150, 252, 0,
-	151, 152, 0, 153, 206, 154, 0, 155, 157, 207,
-	156, 208, 0, 492, 158, 159, 0, 254, 209, 0,
-	0, 253, 210, 211, 0, 160, 161, 162, 163, 0,
-	86, 164, 165, 0, 0, 166, 167, 168, 212, 213,
-	0, 169, 89, 90, 0, 91, 170, 171, 172, 173,
-	0, 0, 0, 0, 92, 93, 174, 175, 176, 94,
-	177, 178, 0, 95, 179, 96, 0, 0, 180, 181,
-	0, 182, 0, 0, 0, 97, 98, 99, 0, 100,
-	0, 101, 0, 0, 102, 103, 0, 0, 0, 0,
-	0, 0, 104, 105, 106, 107, 183, 108, 184, 185,
-	0, 0, 109, 0, 0, 0, 110, 111, 0, 0,
-	0, 0, 186, 112, 187, 0, 0, 113, 114, 188,
-	115, 0, 0, 0, 0, 0, 116, 189, 0, 190,
-	0, 117, 269, 192, 0, 0, 0, 0, 118, 193,
-	194, 195, 0, 196, 0, 0, 119, 0, 120, 0,
-	0, 197, 0, 121, 0, 0, 250, 0, 0, 0,
-	122, 123, 124, 125, 251, 0, 126, 127, 0, 128,
-	0, 198, 129, 199, 130, 131, 0, 0, 263, 0,
-	0, 132, 200, 0, 133, 0, 201, 134, 135, 0,
-	202, 136, 203, 0, 137, 138, 204, 139, 140, 0,
-	141, 142, 143, 0, 144, 0, 145, 146, 205, 147,
-	0, 148, 149, 0, 150, 252, 0, 151, 152, 0,
-	153, 206, 154, 0, 155, 157, 207, 156, 208, 0,
-	0, 158, 159, 0, 254, 209, 0, 0, 253, 210,
-	211, 0, 160, 161, 162, 163, 0, 86, 164, 165,
-	0, 0, 166, 167, 168, 212, 213, 0, 169, 89,
-	90, 0, 91, 170, 171, 172, 173, 0, 0, 0,
-	0, 92, 93, 174, 175, 176, 94, 177, 178, 0,
-	95, 179, 96, 0, 0, 180, 181, 0, 182, 0,
-	0, 0, 97, 98, 99, 0, 100, 0, 101, 0,
-	0, 102, 103, 0, 0, 0, 0, 0, 0, 104,
-	105, 106, 107, 183, 108, 184, 185, 0, 0, 109,
-	0, 0, 0, 110, 111, 0, 0, 0, 0, 186,
-	112, 187, 0, 0, 113, 114, 188, 115, 0, 0,
-	0, 0, 0, 116, 189, 0, 190, 0, 117, 191,
-	192, 0, 0, 0, 0, 118, 193, 194, 195, 0,
-	196, 0, 0, 119, 0, 120, 0, 0, 197, 0,
-	121, 0, 0, 250, 0, 0, 0, 122, 123, 124,
-	125, 251, 0, 126, 127, 0, 128, 0, 198, 129,
-	199, 130, 131, 0, 0, 0, 0, 0, 132, 200,
-	0, 133, 0, 201, 134, 135, 0, 202, 136, 203,
-	0, 137, 138, 204, 139, 140, 0, 141, 142, 143,
-	0, 144, 0, 145, 146, 205, 147, 0, 148, 149,
-	0, 150, 252, 0, 151, 152, 0, 153, 206, 154,
-	0, 155, 157, 207, 156, 208, 0, 0, 158, 159,
-	0, 254, 209, 0, 0, 253, 210, 211, 0, 160,
-	161, 162, 163, 0, 86, 164, 165, 0, 0, 166,
-	167, 168, 212, 213, 0, 169, 89, 90, 0, 91,
-	170, 171, 172, 173, 0, 0, 0, 0, 92, 93,
-	174, 175, 176, 94, 177, 178, 0, 95, 179, 96,
-	0, 0, 180, 181, 0, 182, 0, 0, 0, 97,
-	98, 99, 0, 100, 0, 101, 0, 0, 102, 103,
-	0, 0, 0, 0, 0, 0, 104, 105, 106, 107,
-	183, 108, 184, 185, 0, 0, 109, 0, 0, 0,
-	110, 111, 0, 0, 0, 0, 186, 112, 187, 0,
-	0, 113, 114, 188, 115, 0, 0, 0, 0, 0,
-	116, 189, 0, 190, 0, 117, 1006, 192, 0, 0,
-	0, 0, 118, 193, 194, 195, 0, 196, 0, 0,
-	119, 0, 120, 0, 0, 197, 0, 121, 0, 0,
-	250, 0, 0, 0, 122, 123, 124, 125, 251, 0,
-	126, 127, 0, 128, 0, 198, 129, 199, 130, 131,
-	0, 0, 0, 0, 0, 132, 200, 0, 133, 0,
-	201, 134, 135, 0, 202, 136, 203, 0, 137, 138,
-	204, 139, 140, 0, 141, 142, 143, 0, 144, 0,
-	145, 146, 205, 147, 0, 148, 149, 0, 150, 252,
-	0, 151, 152, 0, 153, 206, 154, 0, 155, 157,
-	207, 156, 208, 0, 0, 158, 159, 0, 254, 209,
-	0, 0, 253, 210, 211, 0, 160, 161, 162, 163,
-	0, 86, 164, 165, 0, 0, 166, 167, 168, 212,
-	213, 0, 169, 89, 90, 0, 91, 170, 171, 172,
-	173, 0, 0, 0, 0, 92, 93, 174, 175, 176,
-	94, 177, 178, 0, 95, 179, 96, 0, 0, 180,
-	181, 0, 182, 0, 0, 0, 97, 98, 99, 0,
-	100, 0, 101, 0, 0, 102, 103, 0, 0, 0,
-	0, 0, 0, 104, 105, 106, 107, 183, 108, 184,
-	185, 0, 0, 109, 0, 0, 0, 110, 111, 0,
-	0, 0, 0, 186, 112, 187, 0, 0, 113, 114,
-	188, 115, 0, 0, 0, 0, 0, 116, 189, 0,
-	190, 0, 117, 1004, 192, 0, 0, 0, 0, 118,
-	193, 194, 195, 0, 196, 0, 0, 119, 0, 120,
-	0, 0, 197, 0, 121, 0, 0, 250, 0, 0,
-	0, 122, 123, 124, 125, 251, 0, 126, 127, 0,
-	128, 0, 198, 129, 199, 130, 131, 0, 0, 0,
-	0, 0, 132, 200, 0, 133, 0, 201, 134, 135,
-	0, 202, 136, 203, 0, 137, 138, 204, 139, 140,
-	0, 141, 142, 143, 0, 144, 0, 145, 146, 205,
-	147, 0, 148, 149, 0, 150, 252, 0, 151, 152,
-	0, 153, 206, 154, 0, 155, 157, 207, 156, 208,
-	0, 0, 158, 159, 0, 254, 209, 0, 0, 253,
-	210, 211, 0, 160, 161, 162, 163, 0, 86, 164,
-	165, 0, 0, 166, 167, 168, 212, 213, 0, 169,
-	89, 90, 0, 91, 170, 171, 172, 173, 0, 0,
-	0, 0, 92, 93, 174, 175, 176, 94, 177, 178,
-	0, 95, 179, 96, 0, 0, 180, 181, 0, 182,
-	0, 0, 0, 97, 98, 99, 0, 100, 0, 101,
-	0, 0, 102, 103, 0, 0, 0, 0, 0, 0,
-	104, 105, 106, 107, 183, 108, 184, 185, 0, 0,
-	109, 0, 0, 0, 110, 111, 0, 0, 0, 0,
-	186, 112, 187, 0, 0, 113, 114, 188, 115, 0,
-	0, 0, 0, 0, 116, 189, 0, 190, 0, 117,
-	995, 192, 0, 0, 0, 0, 118, 193, 194, 195,
-	0, 196, 0, 0, 119, 0, 120, 0, 0, 197,
-	0, 121, 0, 0, 250, 0, 0, 0, 122, 123,
-	124, 125, 251, 0, 126, 127, 0, 128, 0, 198,
-	129, 199, 130, 131, 0, 0, 0, 0, 0, 132,
-	200, 0, 133, 0, 201, 134, 135, 0, 202, 136,
-	203, 0, 137, 138, 204, 139, 140, 0, 141, 142,
-	143, 0, 144, 0, 145, 146, 205, 147, 0, 148,
-	149, 0, 150, 252, 0, 151, 152, 0, 153, 206,
-	154, 0, 155, 157, 207, 156, 208, 0, 0, 158,
-	159, 0, 254, 209, 0, 0, 253, 210, 211, 0,
-	160, 161, 162, 163, 0, 86, 164, 165, 0, 0,
-	166, 167, 168, 212, 213, 0, 169, 89, 90, 0,
-	91, 170, 171, 172, 173, 0, 0, 0, 0, 92,
-	93, 174, 175, 176, 94, 177, 178, 0, 95, 179,
-	96, 0, 0, 180, 181, 0, 182, 0, 0, 0,
-	97, 98, 99, 0, 100, 0, 101, 0, 0, 102,
-	103, 0, 0, 0, 0, 0, 0, 104, 105, 106,
-	107, 183, 108, 184, 185, 0, 0, 109, 0, 0,
-	0, 110, 111, 0, 0, 0, 0, 186, 112, 187,
-	0, 0, 113, 114, 188, 115, 0, 0, 0, 0,
-	0, 116, 189, 0, 190, 0, 117, 622, 192, 0,
-	0, 0, 0, 118, 193, 194, 195, 0, 196, 0,
-	0, 119, 0, 120, 0, 0, 197, 0, 121, 0,
-	0, 250, 0, 0, 0, 122, 123, 124, 125, 251,
-	0, 126, 127, 0, 128, 0, 198, 129, 199, 130,
-	131, 0, 0, 0, 0, 0, 132, 200, 0, 133,
-	0, 201, 134, 135, 0, 202, 136, 203, 0, 137,
-	138, 204, 139, 140, 0, 141, 142, 143, 0, 144,
-	0, 145, 146, 205, 147, 0, 148, 149, 0, 150,
-	252, 0, 151, 152, 0, 153, 206, 154, 0, 155,
-	157, 207, 156, 208, 0, 0, 158, 159, 0, 254,
-	209, 0, 0, 253, 210, 211, 0, 160, 161, 162,
-	163, 0, 86, 164, 165, 0, 0, 166, 167, 168,
-	212, 213, 0, 169, 89, 90, 0, 91, 170, 171,
-	172, 173, 0, 479, 0, 0, 92, 93, 174, 175,
-	176, 94, 177, 178, 0, 95, 179, 96, 0, 0,
-	180, 181, 0, 182, 0, 0, 0, 97, 98, 99,
-	0, 100, 0, 101, 0, 0, 102, 103, 0, 0,
-	0, 0, 0, 0, 104, 105, 106, 107, 183, 108,
-	184, 185, 0, 0, 109, 0, 0, 0, 110, 111,
-	0, 0, 0, 0, 186, 112, 187, 0, 0, 113,
-	114, 188, 115, 0, 0, 0, 0, 0, 116, 189,
-	0, 190, 0, 117, 191, 192, 0, 0, 0, 0,
-	118, 193, 194, 195, 0, 196, 0, 0, 119, 0,
-	120, 0, 0, 197, 0, 121, 0, 0, 250, 0,
-	0, 0, 122, 123, 124, 125, 251, 0, 126, 127,
-	0, 128, 0, 198, 129, 199, 130, 131, 0, 0,
-	0, 0, 0, 132, 200, 0, 133, 0, 201, 134,
-	135, 0, 202, 136, 203, 0, 137, 138, 204, 139,
-	140, 0, 141, 142, 143, 0, 144, 0, 145, 146,
-	205, 147, 0, 148, 149, 0, 150, 252, 0, 0,
-	152, 0, 153, 206, 154, 0, 155, 157, 207, 156,
-	208, 0, 0, 158, 159, 0, 254, 209, 0, 0,
-	253, 210, 211, 0, 160, 161, 162, 163, 0, 86,
-	164, 165, 0, 0, 166, 167, 168, 212, 213, 0,
-	169, 89, 90, 0, 91, 170, 171, 172, 173, 0,
-	0, 0, 0, 92, 93, 174, 175, 176, 94, 177,
-	178, 0, 95, 179, 96, 0, 0, 180, 181, 0,
-	182, 0, 0, 0, 97, 98, 99, 0, 100, 0,
-	101, 0, 0, 102, 103, 0, 0, 0, 0, 0,
-	0, 104, 105, 106, 107, 183, 108, 184, 185, 0,
-	0, 109, 0, 0, 0, 110, 111, 0, 0, 0,
-	0, 186, 112, 187, 0, 0, 113, 114, 188, 115,
-	0, 0, 0, 0, 0, 116, 189, 0, 190, 0,
-	117, 338, 192, 0, 0, 0, 0, 118, 193, 194,
-	195, 0, 196, 0, 0, 119, 0, 120, 0, 0,
-	197, 0, 121, 0, 0, 250, 0, 0, 0, 122,
-	123, 124, 125, 251, 0, 126, 127, 0, 128, 0,
-	198, 129, 199, 130, 131, 0, 0, 0, 0, 0,
-	132, 200, 0, 133, 0, 201, 134, 135, 0, 202,
-	136, 203, 0, 137, 138, 204, 139, 140, 0, 141,
-	142, 143, 0, 144, 0, 145, 146, 205, 147, 0,
-	148, 149, 0, 150, 252, 0, 151, 152, 0, 153,
-	206, 154, 0, 155, 157, 207, 156, 208, 0, 0,
-	158, 159, 0, 254, 209, 0, 0, 253, 210, 211,
-	0, 160, 161, 162, 163, 0, 86, 164, 165, 0,
-	0, 166, 167, 168, 212, 213, 0, 169, 89, 90,
-	0, 91, 170, 171, 172, 173, 0, 0, 0, 0,
-	92, 93, 174, 175, 176, 94, 177, 178, 0, 95,
-	179, 96, 0, 0, 180, 181, 0, 182, 0, 0,
-	0, 97, 98, 99, 0, 100, 0, 101, 0, 0,
-	102, 103, 0, 0, 0, 0, 0, 0, 104, 105,
-	106, 107, 183, 108, 184, 185, 0, 0, 109, 0,
-	0, 0, 110, 111, 0, 0, 0, 0, 186, 112,
-	187, 0, 0, 113, 114, 188, 115, 0, 0, 0,
-	0, 0, 116, 189, 0, 190, 0, 117, 335, 192,
-	0, 0, 0, 0, 118, 193, 194, 195, 0, 196,
-	0, 0, 119, 0, 120, 0, 0, 197, 0, 121,
-	0, 0, 250, 0, 0, 0, 122, 123, 124, 125,
-	251, 0, 126, 127, 0, 128, 0, 198, 129, 199,
-	130, 131, 0, 0, 0, 0, 0, 132, 200, 0,
-	133, 0, 201, 134, 135, 0, 202, 136, 203, 0,
-	137, 138, 204, 139, 140, 0, 141, 142, 143, 0,
-	144, 0, 145, 146, 205, 147, 0, 148, 149, 0,
-	150, 252, 0, 151, 152, 0, 153, 206, 154, 0,
-	155, 157, 207, 156, 208, 0, 0, 158, 159, 0,
-	254, 209, 0, 0, 253, 210, 211, 0, 160, 161,
-	162, 163, 0, 86, 164, 165, 0, 0, 166, 167,
-	168, 212, 213, 0, 169, 89, 90, 0, 91, 170,
-	171, 172, 173, 0, 0, 0, 0, 92, 93, 174,
-	175, 176, 94, 177, 178, 0, 95, 179, 96, 0,
-	0, 180, 181, 0, 182, 0, 0, 0, 97, 98,
-	99, 0, 100, 0, 101, 0, 0, 102, 103, 0,
-	0, 0, 0, 0, 0, 104, 105, 106, 107, 183,
-	108, 184, 185, 0, 0, 109, 0, 0, 0, 110,
-	111, 0, 0, 0, 0, 186, 112, 187, 0, 0,
-	113, 114, 188, 115, 0, 0, 0, 0, 0, 116,
-	189, 0, 190, 0, 117, 191, 192, 0, 0, 0,
-	0, 118, 193, 194, 195, 0, 196, 0, 0, 119,
-	0, 120, 0, 0, 197, 0, 121, 0, 0, 250,
-	0, 0, 0, 122, 123, 124, 125, 83, 0, 126,
-	127, 0, 128, 0, 198, 129, 199, 130, 131, 0,
-	0, 0, 0, 0, 132, 200, 0, 133, 0, 201,
-	134, 135, 0, 202, 136, 203, 0, 137, 138, 204,
-	139, 140, 0, 141, 142, 143, 0, 144, 0, 145,
-	146, 205, 147, 0, 148, 149, 0, 150, 252, 0,
-	151, 152, 0, 153, 206, 154, 0, 155, 157, 207,
-	156, 208, 0, 0, 158, 159, 0, 82, 209, 0,
-	0, 78, 210, 211, 0, 160, 161, 162, 163, 0,
-	86, 164, 165, 0, 0, 166, 167, 168, 212, 213,
-	0, 169, 89, 90, 0, 91, 170, 171, 172, 173,
-	0, 0, 0, 0, 92, 93, 174, 175, 176, 94,
-	177, 178, 0, 95, 179, 96, 0, 0, 180, 181,
-	0, 182, 0, 0, 0, 97, 98, 99, 0, 100,
-	0, 101, 0, 0, 102, 103, 0, 0, 0, 0,
-	0, 0, 104, 105, 106, 107, 183, 108, 184, 185,
-	0, 0, 109, 0, 0, 0, 110, 111, 0, 0,
-	0, 0, 186, 112, 187, 0, 0, 113, 114, 188,
-	115, 0, 0, 0, 0, 0, 116, 189, 0, 190,
-	0, 117, 283, 192, 0, 0, 0, 0, 118, 193,
-	194, 195, 0, 196, 0, 0, 119, 0, 120, 0,
-	0, 197, 0, 121, 0, 0, 250, 0, 0, 0,
-	122, 123, 124, 125, 251, 0, 126, 127, 0, 128,
-	0, 198, 129, 199, 130, 131, 0, 0, 0, 0,
-	0, 132, 200, 0, 133, 0, 201, 134, 135, 0,
-	202, 136, 203, 0, 137, 138, 204, 139, 140, 0,
-	141, 142, 143, 0, 144, 0, 145, 146, 205, 147,
-	0, 148, 149, 0, 150, 252, 0, 151, 152, 0,
-	153, 206, 154, 0, 155, 157, 207, 156, 208, 0,
-	0, 158, 159, 0, 254, 209, 0, 0, 253, 210,
-	211, 0, 160, 161, 162, 163, 0, 86, 164, 165,
-	0, 0, 166, 167, 168, 212, 213, 0, 169, 89,
-	90, 0, 91, 170, 171, 172, 173, 0, 0, 0,
-	0, 92, 93, 174, 175, 176, 94, 177, 178, 0,
-	95, 179, 96, 0, 0, 180, 181, 0, 182, 0,
-	0, 0, 97, 98, 99, 0, 100, 0, 101, 0,
-	0, 102, 103, 0, 0, 0, 0, 0, 0, 104,
-	105, 106, 107, 183, 108, 184, 185, 0, 0, 109,
-	0, 0, 0, 110, 111, 0, 0, 0, 0, 186,
-	112, 187, 0, 0, 113, 114, 188, 115, 0, 0,
-	0, 0, 0, 116, 189, 0, 190, 0, 117, 280,
-	192, 0, 0, 0, 0, 118, 193, 194, 195, 0,
-	196, 0, 0, 119, 0, 120, 0, 0, 197, 0,
-	121, 0, 0, 250, 0, 0, 0, 122, 123, 124,
-	125, 251, 0, 126, 127, 0, 128, 0, 198, 129,
-	199, 130, 131, 0, 0, 0, 0, 0, 132, 200,
-	0, 133, 0, 201, 134, 135, 0, 202, 136, 203,
-	0, 137, 138, 204, 139, 140, 0, 141, 142, 143,
-	0, 144, 0, 145, 146, 205, 147, 0, 148, 149,
-	0, 150, 252, 0, 151, 152, 0, 153, 206, 154,
-	0, 155, 157, 207, 156, 208, 0, 0, 158, 159,
-	0, 254, 209, 0, 0, 253, 210, 211, 0, 160,
-	161, 162, 163, 0, 86, 164, 165, 0, 0, 166,
-	167, 168, 212, 213, 0, 169, 89, 90, 0, 91,
-	170, 171, 172, 173, 0, 0, 0, 0, 92, 93,
-	174, 175, 176, 94, 177, 178, 0, 95, 179, 96,
-	0, 0, 180, 181, 0, 182, 0, 0, 0, 97,
-	98, 99, 0, 100, 0, 101, 0, 0, 102, 103,
-	0, 0, 0, 0, 0, 0, 104, 105, 106, 107,
-	183, 108, 184, 185, 0, 0, 109, 0, 0, 0,
-	110, 111, 0, 0, 0, 0, 186, 112, 187, 0,
-	0, 113, 114, 188, 115, 0, 0, 0, 0, 0,
-	116, 189, 0, 190, 0, 117, 278, 192, 0, 0,
-	0, 0, 118, 193, 194, 195, 0, 196, 0, 0,
-	119, 0, 120, 0, 0, 197, 0, 121, 0, 0,
-	250, 0, 0, 0, 122, 123, 124, 125, 251, 0,
-	126, 127, 0, 128, 0, 198, 129, 199, 130, 131,
-	0, 0, 0, 0, 0, 132, 200, 0, 133, 0,
-	201, 134, 135, 0, 202, 136, 203, 0, 137, 138,
-	204, 139, 140, 0, 141, 142, 143, 0, 144, 0,
-	145, 146, 205, 147, 0, 148, 149, 0, 150, 252,
-	0, 151, 152, 0, 153, 206, 154, 0, 155, 157,
-	207, 156, 208, 0, 0, 158, 159, 0, 254, 209,
-	0, 0, 253, 210, 211, 0, 160, 161, 162, 163,
-	0, 86, 164, 165, 0, 0, 166, 167, 168, 212,
-	213, 0, 169, 89, 90, 0, 91, 170, 171, 172,
-	173, 0, 0, 0, 0, 92, 93, 174, 175, 176,
-	94, 177, 178, 0, 95, 179, 96, 0, 0, 180,
-	181, 0, 182, 0, 0, 0, 97, 98, 99, 0,
-	100, 0, 101, 0, 0, 102, 103, 0, 0, 0,
-	0, 0, 0, 104, 105, 106, 107, 183, 108, 184,
-	185, 0, 0, 109, 0, 0, 0, 110, 111, 0,
-	0, 0, 0, 186, 112, 187, 0, 0, 113, 114,
-	188, 115, 0, 0, 0, 0, 0, 116, 189, 0,
-	190, 0, 117, 272, 192, 0, 0, 0, 0, 118,
-	193, 194, 195, 0, 196, 0, 0, 119, 0, 120,
-	0, 0, 197, 0, 121, 0, 0, 250, 0, 0,
-	0, 122, 123, 124, 125, 251, 0, 126, 127, 0,
-	128, 0, 198, 129, 199, 130, 131, 0, 0, 0,
-	0, 0, 132, 200, 0, 133, 0, 201, 134, 135,
-	0, 202, 136, 203, 0, 137, 138, 204, 139, 140,
-	0, 141, 142, 143, 0, 144, 0, 145, 146, 205,
-	147, 0, 148, 149, 0, 150, 252, 0, 151, 152,
-	0, 153, 206, 154, 0, 155, 157, 207, 156, 208,
-	0, 0, 158, 159, 0, 254, 209, 0, 0, 253,
-	210, 211, 0, 160, 161, 162, 163, 0, 86, 164,
-	165, 0, 0, 166, 167, 168, 212, 213, 0, 169,
-	89, 90, 0, 91, 170, 171, 172, 173, 0, 0,
-	0, 0, 92, 93, 174, 175, 176, 94, 177, 178,
-	0, 95, 179, 96, 0, 0, 180, 181, 0, 182,
-	0, 0, 0, 97, 98, 99, 0, 100, 0, 101,
-	0, 0, 102, 103, 0, 0, 0, 0, 0, 0,
-	104, 105, 106, 107, 183, 108, 184, 185, 0, 0,
-	109, 0, 0, 0, 110, 111, 0, 0, 0, 0,
-	186, 112, 187, 0, 0, 113, 114, 188, 115, 0,
-	0, 0, 0, 0, 116, 189, 0, 190, 0, 117,
-	191, 192, 0, 0, 0, 0, 118, 193, 194, 195,
-	0, 196, 0, 0, 119, 0, 120, 0, 0, 197,
-	0, 121, 0, 0, 250, 0, 0, 0, 122, 123,
-	124, 125, 251, 0, 126, 127, 0, 128, 0, 198,
-	129, 199, 130, 131, 0, 0, 0, 0, 0, 132,
-	200, 0, 133, 0, 201, 134, 135, 0, 202, 136,
-	203, 0, 137, 138, 204, 247, 140, 0, 141, 142,
-	143, 0, 144, 0, 145, 146, 205, 147, 0, 148,
-	149, 0, 150, 252, 0, 151, 152, 0, 153, 206,
-	154, 0, 155, 157, 207, 156, 208, 0, 0, 158,
-	159, 0, 254, 209, 0, 0, 253, 210, 211, 0,
-	160, 161, 162, 163, 0, 86, 164, 165, 0, 0,
-	166, 167, 168, 212, 213, 0, 169, 89, 90, 0,
-	91, 170, 171, 172, 173, 0, 0, 0, 0, 92,
-	93, 174, 175, 176, 94, 177, 178, 0, 95, 179,
-	96, 0, 0, 180, 181, 0, 182, 0, 0, 0,
-	97, 98, 99, 0, 100, 0, 101, 0, 0, 102,
-	103, 0, 0, 0, 0, 0, 0, 104, 105, 106,
-	107, 183, 108, 184, 185, 0, 0, 109, 0, 0,
-	0, 110, 111, 0, 0, 0, 0, 186, 112, 187,
-	0, 0, 113, 114, 188, 115, 0, 0, 0, 0,
-	0, 116, 189, 0, 190, 0, 117, 191, 192, 0,
-	0, 0, 0, 118, 193, 194, 195, 0, 196, 0,
-	0, 119, 0, 120, 0, 0, 197, 0, 121, 0,
-	0, 76, 0, 0, 0, 122, 123, 124, 125, 83,
-	0, 126, 127, 0, 128, 0, 198, 129, 199, 130,
-	131, 0, 0, 0, 0, 0, 132, 200, 0, 133,
-	0, 201, 134, 135, 0, 202, 136, 203, 0, 137,
-	138, 204, 139, 140, 0, 141, 142, 143, 0, 144,
-	0, 145, 146, 205, 147, 0, 148, 149, 0, 150,
-	77, 0, 151, 152, 0, 153, 206, 154, 0, 155,
-	157, 207, 156, 208, 0, 0, 158, 159, 0, 82,
-	209, 0, 0, 78, 210, 211, 0, 160, 161, 162,
-	163, 0, 86, 164, 165, 0, 0, 166, 167, 168,
-	212, 213, 0, 169, 89, 90, 0, 91, 170, 171,
-	172, 173, 0, 0, 0, 0, 92, 93, 174, 175,
-	176, 94, 177, 178, 0, 95, 179, 96, 0, 0,
-	180, 181, 0, 182, 0, 0, 0, 97, 98, 99,
-	0, 100, 0, 101, 0, 0, 102, 103, 0, 0,
-	0, 0, 0, 0, 104, 105, 106, 107, 183, 108,
-	184, 185, 0, 0, 109, 0, 0, 0, 110, 111,
-	0, 0, 0, 0, 186, 112, 187, 0, 0, 113,
-	114, 188, 115, 0, 0, 0, 0, 0, 116, 189,
-	0, 190, 0, 117, 191, 192, 0, 0, 0, 0,
-	118, 193, 194, 195, 0, 196, 0, 0, 119, 0,
-	120, 0, 0, 197, 0, 121, 0, 0, 250, 0,
-	0, 0, 122, 123, 124, 125, 251, 0, 126, 127,
-	0, 128, 0, 198, 129, 199, 130, 131, 0, 0,
-	0, 0, 0, 132, 200, 0, 133, 0, 201, 134,
-	0, 0, 202, 136, 203, 0, 0, 138, 204, 139,
-	140, 0, 141, 142, 143, 0, 144, 0, 145, 146,
-	205, 0, 0, 148, 149, 0, 150, 252, 0, 151,
-	152, 0, 153, 206, 154, 0, 155, 157, 207, 156,
-	208, 0, 0, 158, 159, 0, 254, 209, 0, 0,
-	253, 210, 211, 0, 160, 161, 162, 163, 0, 0,
-	164, 165, 0, 0, 166, 167, 168, 212, 213, 646,
-	169, 664, 665, 666, 0, 170, 171, 172, 173, 0,
-	0, 667, 0, 0, 0, 0, 0, 648, 0, 673,
-	0, 0, 0, 0, 0, 646, 0, 664, 665, 666,
-	0, 0, 0, 0, 0, 647, 0, 667, 0, 0,
-	0, 661, 0, 648, 0, 673, 0, 0, 0, 0,
-	0, 0, 646, 0, 664, 665, 666, 0, 0, 0,
-	0, 647, 0, 0, 667, 0, 0, 661, 0, 0,
-	648, 646, 673, 664, 665, 666, 0, 0, 0, 0,
-	0, 0, 0, 667, 0, 0, 0, 0, 647, 648,
-	0, 673, 0, 0, 661, 0, 0, 674, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 647, 672, 0,
-	0, 0, 0, 661, 0, 0, 0, 669, 0, 0,
-	0, 0, 662, 674, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 672, 0, 0, 0, 0, 0,
-	0, 0, 668, 669, 0, 0, 0, 0, 662, 0,
-	674, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 672, 0, 0, 0, 0, 0, 0, 668, 674,
-	669, 0, 0, 663, 0, 662, 0, 0, 0, 0,
-	672, 0, 671, 0, 0, 0, 0, 0, 0, 669,
-	0, 0, 0, 0, 662, 668, 0, 0, 0, 663,
-	0, 0, 0, 0, 0, 0, 0, 0, 671, 0,
-	0, 0, 0, 0, 668, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 663, 0, 0, 0,
-	670, 0, 658, 659, 660, 671, 657, 654, 655, 656,
-	649, 650, 651, 652, 653, 663, 0, 0, 0, 0,
-	0, 0, 0, 1165, 671, 0, 670, 0, 658, 659,
-	660, 0, 657, 654, 655, 656, 649, 650, 651, 652,
-	653, 0, 0, 0, 0, 0, 0, 0, 0, 1164,
-	0, 0, 0, 670, 0, 658, 659, 660, 0, 657,
-	654, 655, 656, 649, 650, 651, 652, 653, 0, 0,
-	0, 0, 670, 1521, 658, 659, 660, 0, 657, 654,
-	655, 656, 649, 650, 651, 652, 653, 646, 0, 664,
-	665, 666, 1520, 0, 0, 0, 0, 0, 0, 667,
-	0, 0, 0, 0, 0, 648, 646, 673, 664, 665,
-	666, 0, 0, 0, 0, 0, 0, 0, 667, 0,
-	0, 0, 0, 647, 648, 0, 673, 0, 0, 661,
-	0, 0, 0, 646, 0, 664, 665, 666, 0, 0,
-	0, 0, 647, 0, 0, 667, 0, 0, 661, 0,
-	0, 648, 0, 673, 0, 0, 0, 0, 0, 646,
-	0, 664, 665, 666, 0, 0, 0, 0, 0, 647,
-	0, 667, 0, 0, 0, 661, 0, 648, 0, 673,
-	0, 0, 0, 0, 0, 674, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 647, 672, 0, 0, 0,
-	0, 661, 0, 0, 674, 669, 0, 0, 0, 0,
-	662, 0, 0, 0, 0, 672, 0, 0, 0, 0,
-	0, 0, 0, 0, 669, 0, 0, 0, 0, 662,
-	668, 674, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 672, 0, 0, 0, 0, 0, 0, 668,
-	0, 669, 0, 0, 0, 0, 662, 674, 0, 0,
-	0, 663, 0, 0, 0, 0, 0, 0, 672, 0,
-	671, 0, 0, 0, 0, 0, 668, 669, 0, 0,
-	663, 0, 662, 0, 0, 0, 0, 0, 0, 671,
+	0, 0, 0, 0, 594, 572, 0, 590, 591, 592,
+	0, 0, 589, 0, 0, 0, 0, 593, 0, 0,
+	0, 597, 0, 574, 0, 0, 599, 572, 0, 590,
+	591, 592, 0, 0, 0, 0, 0, 0, 0, 593,
+	0, 0, 589, 573, 0, 574, 0, 0, 599, 587,
+	0, 597, 0, 0, 572, 0, 590, 591, 592, 0,
+	0, 0, 0, 0, 0, 573, 593, 0, 0, 0,
+	0, 587, 574, 0, 596, 599, 0, 584, 585, 586,
+	0, 583, 580, 581, 582, 575, 576, 577, 578, 579,
+	0, 0, 573, 0, 0, 1475, 0, 0, 587, 0,
+	0, 0, 0, 0, 596, 0, 0, 584, 585, 586,
+	600, 583, 580, 581, 582, 575, 576, 577, 578, 579,
+	0, 0, 598, 0, 0, 1381, 0, 0, 0, 0,
+	0, 595, 600, 0, 0, 0, 588, 0, 0, 0,
+	0, 0, 0, 0, 598, 0, 0, 0, 0, 0,
+	0, 0, 0, 595, 0, 0, 594, 0, 588, 600,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 668, 0, 0, 0, 0, 663, 0, 0,
-	0, 0, 0, 0, 0, 0, 671, 0, 670, 0,
-	658, 659, 660, 0, 657, 654, 655, 656, 649, 650,
-	651, 652, 653, 663, 0, 0, 0, 670, 1507, 658,
-	659, 660, 671, 657, 654, 655, 656, 649, 650, 651,
-	652, 653, 0, 0, 0, 0, 0, 1484, 0, 0,
-	0, 0, 0, 0, 670, 0, 658, 659, 660, 0,
-	657, 654, 655, 656, 649, 650, 651, 652, 653, 0,
-	0, 0, 0, 0, 1479, 0, 0, 0, 0, 0,
-	670, 0, 658, 659, 660, 0, 657, 654, 655, 656,
-	649, 650, 651, 652, 653, 646, 0, 664, 665, 666,
-	1475, 0, 0, 0, 0, 0, 0, 667, 0, 0,
-	0, 0, 0, 648, 646, 673, 664, 665, 666, 0,
-	0, 0, 0, 0, 0, 0, 667, 0, 0, 0,
-	0, 647, 648, 0, 673, 0, 0, 661, 0, 0,
-	0, 646, 0, 664, 665, 666, 0, 0, 0, 0,
-	647, 0, 0, 667, 0, 0, 661, 0, 0, 648,
-	0, 673, 0, 0, 0, 0, 0, 646, 0, 664,
-	665, 666, 0, 0, 0, 0, 0, 647, 0, 667,
-	0, 0, 0, 661, 0, 648, 0, 673, 0, 0,
-	0, 0, 0, 674, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 647, 672, 0, 0, 0, 0, 661,
-	0, 0, 674, 669, 0, 0, 0, 0, 662, 0,
-	0, 0, 0, 672, 0, 0, 0, 0, 0, 0,
-	0, 0, 669, 0, 0, 0, 0, 662, 668, 674,
+	0, 598, 0, 0, 0, 0, 0, 0, 594, 0,
+	595, 0, 0, 0, 0, 588, 0, 0, 0, 0,
+	0, 0, 0, 0, 589, 0, 0, 0, 0, 0,
+	0, 0, 0, 597, 0, 594, 572, 0, 590, 591,
+	592, 0, 0, 0, 0, 0, 589, 0, 593, 0,
+	0, 0, 0, 0, 574, 597, 0, 599, 0, 0,
+	0, 0, 0, 0, 0, 0, 572, 0, 590, 591,
+	592, 0, 0, 589, 573, 0, 0, 0, 593, 0,
+	587, 0, 597, 0, 574, 0, 596, 599, 0, 584,
+	585, 586, 0, 583, 580, 581, 582, 575, 576, 577,
+	578, 579, 0, 0, 573, 0, 0, 1357, 596, 0,
+	587, 584, 585, 586, 0, 583, 580, 581, 582, 575,
+	576, 577, 578, 579, 0, 1731, 0, 0, 0, 958,
+	0, 0, 0, 0, 0, 596, 0, 0, 584, 585,
+	586, 600, 583, 580, 581, 582, 575, 576, 577, 578,
+	579, 0, 0, 598, 1426, 0, 1236, 572, 1235, 590,
+	591, 592, 595, 0, 0, 0, 0, 588, 0, 593,
+	0, 600, 0, 1099, 0, 574, 0, 0, 599, 0,
+	0, 0, 0, 598, 0, 0, 0, 594, 0, 0,
+	0, 0, 595, 0, 0, 573, 0, 588, 0, 0,
+	1730, 587, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 594, 0, 0,
+	0, 0, 0, 0, 0, 589, 0, 0, 0, 1100,
+	0, 0, 0, 0, 597, 0, 841, 0, 0, 0,
+	0, 0, 572, 0, 590, 591, 592, 0, 0, 0,
+	0, 0, 0, 0, 593, 589, 0, 840, 0, 0,
+	574, 0, 600, 599, 597, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 598, 0, 0, 0, 0, 0,
+	573, 0, 0, 595, 0, 0, 587, 596, 588, 0,
+	584, 585, 586, 0, 583, 580, 581, 582, 575, 576,
+	577, 578, 579, 0, 0, 0, 0, 0, 594, 0,
+	0, 0, 0, 0, 0, 0, 0, 596, 0, 0,
+	584, 585, 586, 0, 583, 580, 581, 582, 575, 576,
+	577, 578, 579, 572, 0, 590, 591, 592, 0, 0,
+	0, 0, 0, 0, 0, 593, 589, 600, 0, 0,
+	0, 574, 0, 0, 599, 597, 0, 0, 0, 598,
+	572, 0, 590, 591, 592, 0, 0, 0, 595, 0,
+	0, 573, 593, 588, 0, 0, 0, 587, 574, 0,
+	0, 599, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 594, 0, 0, 0, 0, 573, 0,
+	0, 0, 0, 0, 587, 0, 0, 0, 596, 0,
+	0, 584, 585, 586, 0, 583, 580, 581, 582, 575,
+	576, 577, 578, 579, 0, 0, 0, 0, 0, 0,
+	0, 589, 0, 0, 0, 0, 0, 0, 600, 0,
+	597, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	598, 572, 0, 590, 591, 592, 0, 0, 0, 595,
+	0, 0, 0, 593, 588, 600, 0, 0, 0, 574,
+	0, 0, 599, 0, 0, 0, 0, 598, 0, 0,
+	0, 0, 0, 0, 594, 310, 595, 0, 0, 573,
+	0, 588, 0, 596, 0, 587, 584, 585, 586, 0,
+	583, 580, 581, 582, 575, 576, 577, 578, 579, 0,
+	0, 594, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 589, 0, 0, 0, 0, 0, 0, 0,
+	0, 597, 0, 0, 0, 0, 572, 0, 590, 591,
+	592, 0, 0, 1242, 0, 0, 0, 0, 593, 589,
+	0, 1237, 0, 0, 574, 0, 600, 599, 597, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 598, 0,
+	0, 0, 0, 0, 573, 1375, 0, 595, 0, 0,
+	587, 0, 588, 0, 596, 0, 0, 584, 585, 586,
+	0, 583, 580, 581, 582, 575, 576, 577, 578, 579,
+	0, 0, 594, 0, 0, 0, 0, 0, 0, 0,
+	0, 596, 0, 0, 584, 585, 586, 0, 583, 580,
+	581, 582, 575, 576, 577, 578, 579, 572, 0, 590,
+	591, 592, 0, 0, 0, 0, 0, 0, 0, 593,
+	589, 600, 0, 0, 0, 574, 0, 0, 599, 597,
+	0, 0, 0, 598, 572, 0, 590, 591, 592, 0,
+	0, 0, 595, 0, 0, 573, 593, 588, 0, 1199,
+	0, 587, 574, 0, 0, 599, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 594, 0, 0,
+	0, 0, 573, 0, 0, 0, 0, 0, 587, 0,
+	0, 0, 596, 0, 0, 584, 585, 586, 0, 583,
+	580, 581, 582, 575, 576, 577, 578, 579, 0, 0,
+	0, 0, 0, 0, 0, 589, 0, 0, 0, 0,
+	0, 0, 600, 0, 597, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 598, 0, 0, 0, 0, 0,
+	0, 0, 0, 595, 0, 0, 0, 0, 588, 600,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	672, 0, 0, 0, 0, 0, 0, 668, 0, 669,
-	0, 0, 0, 0, 662, 674, 0, 0, 0, 663,
-	0, 0, 0, 0, 0, 0, 672, 0, 671, 0,
-	0, 0, 0, 0, 668, 669, 0, 0, 663, 0,
-	662, 0, 0, 0, 0, 0, 0, 671, 0, 0,
+	0, 598, 0, 0, 0, 0, 0, 0, 594, 0,
+	595, 0, 0, 0, 0, 588, 0, 596, 0, 1204,
+	584, 585, 586, 0, 583, 580, 581, 582, 575, 576,
+	577, 578, 579, 0, 0, 594, 572, 0, 590, 591,
+	592, 0, 0, 0, 0, 0, 589, 0, 593, 0,
+	0, 0, 0, 0, 574, 597, 0, 599, 572, 0,
+	590, 591, 592, 0, 0, 0, 0, 0, 0, 0,
+	593, 0, 0, 589, 573, 0, 574, 0, 0, 599,
+	587, 0, 597, 0, 0, 0, 572, 0, 590, 591,
+	592, 0, 0, 0, 0, 0, 573, 0, 593, 0,
+	0, 0, 587, 0, 574, 0, 0, 599, 596, 0,
+	0, 584, 585, 586, 0, 583, 580, 581, 582, 575,
+	576, 577, 578, 579, 573, 0, 0, 0, 0, 0,
+	587, 0, 0, 0, 0, 596, 0, 0, 584, 585,
+	586, 600, 583, 580, 581, 582, 575, 576, 577, 578,
+	579, 0, 0, 598, 0, 0, 0, 0, 0, 0,
+	0, 0, 595, 600, 0, 0, 0, 588, 0, 0,
+	0, 0, 0, 0, 0, 598, 0, 572, 0, 0,
+	0, 0, 0, 0, 595, 0, 0, 594, 0, 588,
+	0, 600, 0, 0, 0, 574, 0, 0, 599, 0,
+	0, 0, 0, 598, 0, 0, 0, 0, 0, 594,
+	0, 0, 595, 0, 0, 573, 0, 588, 0, 0,
+	0, 587, 0, 0, 0, 589, 0, 0, 0, 0,
+	0, 0, 0, 0, 597, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 589, 0, 0,
+	0, 0, 0, 0, 0, 0, 597, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 572, 0, 590,
+	591, 592, 0, 0, 0, 589, 0, 0, 0, 0,
+	0, 0, 600, 0, 597, 574, 0, 596, 599, 601,
+	584, 585, 586, 0, 583, 580, 581, 582, 575, 576,
+	577, 578, 579, 595, 0, 573, 0, 0, 588, 596,
+	0, 587, 584, 585, 586, 0, 583, 580, 581, 582,
+	575, 576, 577, 578, 579, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 596, 0, 0,
+	584, 585, 586, 0, 583, 580, 581, 582, 575, 576,
+	577, 578, 579, 0, 0, 0, 894, 909, 886, 902,
+	901, 0, 0, 887, 0, 0, 589, 0, 911, 910,
+	0, 0, 600, 0, 0, 597, 0, 0, 0, 0,
+	0, 0, 0, 0, 598, 0, 0, 0, 0, 0,
+	0, 0, 0, 595, 0, 0, 0, 0, 588, 907,
+	0, 899, 898, 0, 0, 0, 0, 0, 0, 897,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	668, 0, 0, 0, 0, 663, 0, 0, 0, 0,
-	0, 0, 0, 0, 671, 0, 670, 0, 658, 659,
-	660, 0, 657, 654, 655, 656, 649, 650, 651, 652,
-	653, 663, 0, 0, 0, 670, 1417, 658, 659, 660,
-	671, 657, 654, 655, 656, 649, 650, 651, 652, 653,
-	0, 0, 0, 0, 0, 1416, 0, 0, 0, 0,
-	0, 0, 670, 0, 658, 659, 660, 0, 657, 654,
-	655, 656, 649, 650, 651, 652, 653, 0, 0, 0,
-	0, 0, 1334, 0, 0, 0, 0, 0, 670, 0,
-	658, 659, 660, 0, 657, 654, 655, 656, 649, 650,
-	651, 652, 653, 646, 0, 664, 665, 666, 1272, 0,
-	0, 0, 0, 0, 0, 667, 0, 0, 0, 0,
-	0, 648, 646, 673, 664, 665, 666, 0, 0, 0,
-	0, 0, 0, 0, 667, 0, 0, 0, 0, 647,
-	648, 0, 673, 0, 0, 661, 0, 0, 0, 646,
-	0, 664, 665, 666, 0, 0, 0, 0, 647, 0,
-	0, 667, 0, 0, 661, 0, 0, 648, 0, 673,
-	0, 0, 0, 0, 0, 646, 0, 664, 665, 666,
-	0, 0, 0, 0, 0, 647, 0, 667, 0, 0,
-	0, 661, 0, 648, 0, 673, 0, 0, 0, 0,
-	0, 674, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 647, 672, 0, 0, 0, 0, 661, 0, 0,
-	674, 669, 0, 0, 0, 0, 662, 0, 0, 0,
-	0, 672, 0, 0, 0, 0, 0, 0, 0, 0,
-	669, 0, 0, 0, 0, 662, 668, 674, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 672, 1581,
-	0, 0, 0, 0, 0, 668, 0, 669, 0, 0,
-	0, 0, 662, 674, 0, 0, 0, 663, 0, 0,
-	0, 0, 0, 0, 672, 0, 671, 0, 0, 0,
-	0, 0, 668, 669, 0, 0, 663, 0, 662, 0,
-	0, 0, 0, 0, 0, 671, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 668, 0,
-	0, 0, 0, 663, 0, 0, 0, 0, 0, 0,
-	1580, 0, 671, 0, 670, 0, 658, 659, 660, 0,
-	657, 654, 655, 656, 649, 650, 651, 652, 653, 663,
-	0, 0, 0, 670, 1247, 658, 659, 660, 671, 657,
-	654, 655, 656, 649, 650, 651, 652, 653, 0, 0,
-	0, 0, 0, 910, 0, 0, 0, 0, 0, 0,
-	670, 0, 658, 659, 660, 0, 657, 654, 655, 656,
-	649, 650, 651, 652, 653, 0, 0, 0, 1318, 0,
-	0, 0, 0, 0, 0, 0, 670, 0, 658, 659,
-	660, 0, 657, 654, 655, 656, 649, 650, 651, 652,
-	653, 646, 0, 664, 665, 666, 0, 0, 0, 0,
-	0, 0, 0, 667, 0, 0, 0, 0, 0, 648,
-	646, 673, 664, 665, 666, 0, 0, 0, 0, 0,
-	0, 0, 667, 0, 0, 0, 818, 647, 648, 0,
-	673, 0, 676, 661, 0, 0, 0, 0, 646, 0,
-	664, 665, 666, 0, 0, 0, 647, 0, 0, 0,
-	667, 0, 661, 675, 0, 0, 648, 0, 673, 0,
+	0, 0, 896, 0, 0, 0, 0, 0, 596, 0,
+	0, 0, 0, 0, 0, 583, 580, 581, 582, 575,
+	576, 577, 578, 579, 0, 890, 891, 892, 0, 797,
+	0, 0, 0, 0, 0, 0, 589, 0, 0, 0,
+	0, 0, 0, 0, 0, 597, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 900,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 647, 0, 1156, 819, 1155, 0,
-	661, 0, 0, 0, 0, 0, 0, 0, 0, 674,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	672, 0, 0, 0, 0, 0, 0, 0, 674, 669,
-	0, 0, 0, 0, 662, 0, 0, 0, 0, 672,
-	0, 0, 0, 0, 0, 0, 0, 0, 669, 0,
-	0, 0, 0, 662, 668, 0, 674, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 672, 0, 0,
-	0, 0, 0, 668, 0, 0, 669, 0, 0, 0,
-	0, 662, 0, 0, 0, 663, 0, 0, 0, 0,
-	0, 0, 0, 0, 671, 0, 0, 0, 0, 0,
-	0, 668, 0, 0, 663, 0, 0, 0, 0, 0,
-	0, 0, 0, 671, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 895, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 596, 0,
+	0, 584, 585, 586, 0, 583, 580, 581, 582, 575,
+	576, 577, 578, 579, 893, 0, 0, 0, 0, 0,
+	0, 889, 0, 0, 0, 0, 0, 0, 888, 0,
+	0, 908, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 663, 0, 0, 0, 0, 0, 0, 0,
-	0, 671, 670, 0, 658, 659, 660, 0, 657, 654,
-	655, 656, 649, 650, 651, 652, 653, 0, 0, 0,
-	0, 670, 0, 658, 659, 660, 0, 657, 654, 655,
-	656, 649, 650, 651, 652, 653, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 670,
-	0, 658, 659, 660, 0, 657, 654, 655, 656, 649,
-	650, 651, 652, 653, 646, 0, 664, 665, 666, 0,
-	0, 0, 0, 0, 0, 0, 667, 0, 0, 0,
-	0, 0, 648, 646, 673, 664, 665, 666, 0, 0,
-	0, 0, 0, 0, 0, 667, 0, 0, 0, 0,
-	647, 648, 0, 673, 0, 0, 661, 0, 0, 0,
-	0, 646, 0, 664, 665, 666, 0, 0, 0, 647,
-	0, 0, 0, 667, 0, 661, 0, 0, 0, 648,
-	0, 673, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 647, 0, 0,
-	0, 0, 0, 661, 0, 0, 0, 0, 0, 0,
-	0, 0, 674, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 672, 0, 0, 0, 0, 0, 0,
-	0, 674, 669, 0, 0, 0, 0, 662, 0, 0,
-	0, 0, 672, 0, 0, 0, 0, 0, 1162, 0,
-	0, 669, 0, 0, 0, 0, 662, 668, 242, 674,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	672, 0, 0, 0, 0, 0, 668, 0, 0, 669,
-	0, 0, 0, 0, 662, 0, 0, 0, 663, 0,
-	0, 0, 0, 0, 0, 0, 0, 671, 0, 0,
-	0, 0, 0, 0, 668, 0, 0, 663, 0, 0,
-	0, 0, 0, 0, 0, 0, 671, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1266, 0, 0, 0, 0, 663, 0, 0, 0, 0,
-	0, 0, 0, 0, 671, 670, 0, 658, 659, 660,
-	0, 657, 654, 655, 656, 649, 650, 651, 652, 653,
-	0, 0, 0, 0, 670, 0, 658, 659, 660, 0,
-	657, 654, 655, 656, 649, 650, 651, 652, 653, 1126,
-	0, 1142, 1143, 1144, 0, 0, 0, 0, 0, 0,
-	0, 1385, 670, 0, 658, 659, 660, 0, 657, 654,
-	655, 656, 649, 650, 651, 652, 653, 646, 0, 664,
-	665, 666, 0, 0, 0, 0, 0, 0, 0, 667,
-	0, 1139, 1157, 0, 0, 648, 646, 673, 664, 665,
-	666, 0, 0, 0, 0, 0, 0, 0, 667, 0,
-	0, 0, 0, 647, 648, 0, 673, 0, 0, 661,
-	0, 0, 0, 0, 646, 0, 664, 665, 666, 0,
-	0, 0, 647, 0, 0, 0, 667, 0, 661, 1119,
-	0, 0, 648, 0, 673, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1145, 0,
-	647, 0, 0, 0, 0, 0, 661, 0, 0, 0,
-	0, 0, 1140, 0, 0, 674, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 672, 0, 0, 0,
-	0, 0, 0, 0, 674, 669, 0, 0, 0, 0,
-	662, 0, 0, 0, 0, 672, 0, 0, 0, 0,
-	0, 0, 0, 0, 669, 0, 0, 0, 0, 662,
-	668, 0, 674, 1141, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 672, 0, 0, 0, 0, 0, 668,
-	0, 0, 669, 0, 0, 0, 0, 662, 0, 1124,
-	0, 663, 0, 0, 0, 0, 0, 0, 0, 0,
-	671, 0, 0, 0, 0, 0, 0, 668, 0, 0,
-	663, 0, 0, 0, 0, 0, 0, 0, 0, 671,
-	0, 0, 1136, 1137, 1138, 0, 1135, 1132, 1133, 1134,
-	1127, 1128, 1129, 1130, 1131, 0, 0, 0, 663, 0,
-	0, 0, 0, 0, 0, 0, 0, 671, 670, 0,
-	658, 659, 660, 0, 657, 654, 655, 656, 649, 650,
-	651, 652, 653, 0, 0, 0, 0, 670, 0, 658,
-	659, 660, 0, 657, 654, 655, 656, 649, 650, 651,
-	652, 653, 1126, 0, 1142, 1143, 1144, 0, 0, 0,
-	0, 0, 0, 0, 1242, 670, 0, 658, 659, 660,
-	0, 657, 654, 655, 656, 649, 650, 651, 652, 653,
-	646, 0, 664, 665, 666, 0, 0, 0, 0, 0,
-	0, 0, 667, 0, 1139, 0, 0, 0, 648, 646,
-	673, 664, 665, 666, 0, 0, 0, 0, 0, 0,
-	0, 667, 0, 0, 0, 0, 647, 648, 0, 673,
-	0, 0, 661, 0, 0, 0, 646, 0, 664, 665,
-	666, 0, 0, 0, 0, 647, 0, 0, 0, 0,
-	0, 661, 0, 0, 648, 0, 673, 0, 0, 0,
-	0, 0, 1126, 0, 1142, 1143, 1144, 0, 0, 0,
-	0, 1145, 647, 0, 1241, 0, 0, 0, 661, 0,
-	0, 0, 0, 0, 0, 1140, 0, 0, 674, 1126,
-	0, 1142, 1143, 1144, 0, 0, 0, 0, 0, 672,
-	0, 0, 0, 0, 1139, 0, 0, 674, 669, 0,
-	0, 0, 0, 662, 0, 0, 0, 0, 672, 0,
-	0, 0, 0, 0, 0, 0, 0, 669, 0, 0,
-	0, 1139, 662, 668, 674, 1126, 1141, 1142, 1143, 1144,
-	0, 0, 0, 0, 0, 672, 0, 0, 0, 0,
-	0, 0, 0, 0, 669, 0, 0, 0, 0, 662,
-	0, 0, 0, 0, 663, 0, 0, 0, 0, 0,
-	0, 1145, 0, 671, 0, 0, 0, 1139, 0, 0,
-	0, 0, 0, 663, 0, 1140, 0, 0, 0, 0,
-	0, 0, 671, 0, 0, 1136, 1137, 1138, 1145, 1135,
-	1132, 1133, 1134, 1127, 1128, 1129, 1130, 1131, 0, 0,
-	663, 0, 1140, 0, 0, 0, 0, 0, 0, 671,
-	0, 670, 0, 658, 659, 660, 0, 657, 654, 655,
-	656, 649, 650, 651, 652, 653, 1141, 0, 0, 0,
-	670, 0, 658, 659, 660, 0, 657, 654, 655, 656,
-	649, 650, 651, 652, 653, 0, 0, 0, 1140, 0,
-	0, 0, 0, 1141, 0, 0, 0, 670, 0, 658,
-	659, 660, 0, 657, 654, 655, 656, 649, 650, 651,
-	652, 653, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1136, 1137, 1138, 0, 1135,
-	1132, 1133, 1134, 1127, 1128, 1129, 1130, 1131, 0, 1141,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1136, 1137, 1138, 0, 1135, 1132, 1133, 1134,
-	1127, 1128, 1129, 1130, 1131, 0, 0, 0, 0, 0,
-	0, 0, 846, 861, 838, 854, 853, 0, 0, 839,
-	0, 0, 0, 863, 862, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1136, 1137,
-	1138, 0, 1135, 1132, 1133, 1134, 1127, 1128, 1129, 1130,
-	1131, 859, 0, 851, 850, 0, 0, 0, 0, 0,
-	0, 849, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 848, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 842, 843, 844, 0, 524, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 852, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 847, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 845, 0, 0, 0,
-	0, 841, 0, 0, 0, 0, 0, 840, 0, 0,
-	860, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 864,
+	0, 0, 0, 0, 912,
 }
 var sqlPact = [...]int{
 
-	1951, -1000, -12, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 709,
-	-1000, -1000, -1000, 535, 635, 90, 864, 864, -1000, -1000,
-	15081, 1760, 306, 306, 306, 396, 480, 99, -1000, 755,
-	27, 14864, 12043, 1060, -14, 11392, 212, 1951, 11826, 12043,
-	14647, 931, 862, 11392, 14430, 14213, 13996, -1000, 7925, -1000,
-	-1000, -1000, -1000, 682, -1000, -15, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 676, -1000, 13779, 13779, 853, -1000,
-	-1000, 472, 258, 1086, -1000, -7, -1000, -1000, -1000, -1000,
+	2201, -1000, 5, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 769, 12647,
+	664, -1000, -1000, -1000, -1000, 497, 674, 264, 1017, 494,
+	667, 535, 12400, 1017, -1000, -1000, 18081, 2411, 352, 352,
+	352, 456, 540, 101, -1000, 670, 7, 17834, 13882, 1186,
+	2, 13388, 180, 2201, 13882, 13882, 13635, 13882, 17587, 430,
+	7291, -1, 13882, 13882, 477, -1000, -131, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2747,338 +3054,369 @@ var sqlPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 930, -1000, 675, 928,
-	913, 252, 859, -1000, 853, -1000, -1000, -1000, 11392, -1000,
-	13562, 875, 13345, -1000, 755, -1000, -1000, -1000, 805, 1059,
-	1059, 1059, 1075, 79, 77, 99, -16, 12043, -1000, 216,
-	-1000, -1000, -1000, -1000, -1000, -16, 6001, 6001, -1000, -1000,
-	212, -1000, 232, 10267, -139, -1000, 5523, -1000, 602, 987,
-	511, 506, 983, 11392, 12043, 434, 13128, -1000, 980, 78,
-	979, -1000, -32, 977, -1000, -23, -1000, -1000, -1000, -1000,
-	-1000, -1000, 212, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 11609, 910, 11609, -1000,
-	-1000, -1000, 806, 8401, 8164, 1021, 1599, -1000, -1000, -1000,
-	-10, 3356, 12043, 942, 11609, 12043, -1000, 12043, -1000, 800,
-	-1000, -1000, 85, -1000, 210, 765, 12911, -1000, 761, -1000,
-	805, -1000, 631, 788, 6258, 6975, 99, -1000, -1000, 99,
-	99, 6975, -1000, -1000, 12043, -16, 1095, 12043, 911, -18,
-	-1000, 16838, -1000, -1000, 6975, 6975, 6975, 6975, 6975, 578,
-	-1000, -1000, -1000, 3832, -1000, -1000, -139, 202, 222, -1000,
-	-1000, 200, -139, -1000, -1000, -1000, -1000, 197, 1192, 330,
-	-1000, -1000, -1000, 6975, 263, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 939, 196, 194, -1000, -1000, -1000,
-	-1000, 191, 187, 182, 180, 178, 166, 160, 159, 154,
-	152, 150, 146, 145, 569, -1000, 274, -1000, -1000, 274,
-	274, -1000, 131, 131, 132, -1000, -1000, -1000, 131, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 144, 52,
-	-1000, -1000, -1000, 12043, -139, -1000, 3118, 3356, 6975, -24,
-	-1000, 17670, -1000, -71, 562, -1000, 10948, 1036, 1034, 1047,
-	11392, 382, 377, 12043, 268, 55, 1090, 9793, -1000, 12043,
-	12043, -1000, 12043, -1000, -1000, 12043, 12043, 12043, 27, 10504,
-	375, -41, 12043, 12043, -1000, 909, 650, -19, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1172, -1000,
-	-1000, -1000, -1000, 1183, -19, -1000, -1000, -1000, -1000, -1000,
-	1190, -1000, -1000, -1000, -1000, 3356, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 7291,
+	1015, 918, 13388, 17340, 17093, 16846, 16599, 16352, -1000, 7,
+	8838, -1000, -1000, -1000, -1000, 797, -1000, 1, 215, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 13882, 438, 528, 1014,
+	7291, 792, -1000, 16105, 16105, 906, -1000, -1000, 432, 272,
+	1188, -1000, 10, -1000, -1000, -1000, 1012, -1000, 774, -1000,
+	1011, 423, -1000, -1000, 527, 526, 1009, 270, 908, 1179,
+	-1000, 906, -1000, -1000, -1000, 13388, -1000, 15858, 933, 15611,
+	15364, -1000, 670, -1000, -1000, -1000, 791, 1185, 1185, 1185,
+	1244, 96, 87, 101, -7, 13882, -1000, 214, -7, 6755,
+	6755, -1000, -1000, 180, -1000, 236, 11402, -1000, 5981, -1000,
+	1178, 288, 466, 808, 1078, 767, 620, 1077, 7291, 20306,
+	-1000, 7291, 7291, 7291, 7291, 7291, 720, -1000, -1000, -1000,
+	4425, -1000, -1000, -131, 213, 141, -1000, -1000, 212, -131,
+	-1000, -1000, -1000, -1000, 211, 1359, 364, -1000, -1000, -1000,
+	7291, 276, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1026, 208, 207, -1000, -1000, -1000, -1000, 206, 203,
+	202, 200, 199, 198, 196, 194, 192, 191, 189, 188,
+	187, 689, -1000, 301, -1000, -1000, 301, 301, -1000, 148,
+	148, 150, -1000, -1000, -1000, 148, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 183, 13882, -1,
+	-104, -1000, 936, -131, -1000, 3389, 3648, 7291, 20328, 13388,
+	13882, 573, 15117, -1000, 1075, -1000, 1072, -104, 1071, 91,
+	1069, -33, 1067, -1000, -1000, -6, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 180, -1000, 12894, 1732, 13882, 179, 7291,
+	-1000, 7291, -129, 20328, 12894, -1000, -1000, -1000, 887, 9355,
+	9097, 1128, 780, -1000, -1000, -1000, 8, 3648, 13882, 1025,
+	12894, 13882, -1000, -1000, 1007, -1000, 13882, -1000, 886, 1022,
+	-1000, -1000, 92, -1000, 178, 864, 14870, -1000, 852, 283,
+	847, -1000, 791, -1000, 803, 882, 7033, 7291, 101, -1000,
+	-1000, 101, 101, 7291, -1000, -1000, 13882, -7, 1272, 13882,
+	1003, -19, -1000, 19742, -1000, 60, -1000, -1000, -1000, 13882,
+	-8, -1000, 20328, -1000, 328, 322, 712, 269, -59, 706,
+	-1000, 12153, 1175, 1170, 1155, 13388, 268, 408, 405, 13882,
+	20306, 929, 20604, 13882, 451, 7291, 7291, 7291, 7291, 7291,
+	7291, 7291, 7291, 7291, 7291, 7291, 7291, 7291, 7291, 7291,
+	7291, 7291, 7291, 7291, 7291, 7291, 765, 392, 1220, 692,
+	145, 794, 1298, 1298, 1298, 20537, 20537, 176, -143, 19357,
+	-23, -131, -1000, -1000, 5723, 5463, -131, 3905, -1000, 816,
+	1350, 298, 20328, 1031, 965, 177, 83, 78, 7291, 822,
+	7291, 8065, 7291, 7291, 4685, 7291, 7291, 7291, 7291, 7291,
+	7291, -1000, 175, -1000, -1000, -1000, -1000, 1345, -1000, -1000,
+	1344, -1000, 1338, -1000, 13882, 1117, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 12043, -1000, -1000, -1000, -1000, -1000, 11392, 10721,
-	976, 673, 756, -1000, 971, -1000, -1000, -1000, -1000, 17670,
-	-1000, 17670, 448, 867, -1000, 867, -20, -1000, 16810, -1000,
-	143, -25, -1000, 268, 9556, 6001, 18001, 12043, 385, 6975,
-	6975, 6975, 6975, 6975, 6975, 6975, 6975, 6975, 6975, 6975,
-	6975, 6975, 6975, 6975, 6975, 6975, 6975, 6975, 6975, 6975,
-	657, 370, 896, 618, 130, 3356, -1000, 1136, 1136, 1136,
-	17716, 17716, 153, -140, 16492, -21, -139, -1000, -1000, 5027,
-	4788, -139, 2706, -1000, 634, 1181, 271, 17670, 948, 895,
-	142, 72, 70, 6975, 725, 6975, 7214, 6975, 6975, 4071,
-	6975, 6975, 6975, 6975, 6975, 6975, -1000, 138, -1000, -1000,
-	-1000, -1000, 1180, -1000, -1000, 1176, -1000, 1175, 268, 64,
-	-1000, -1000, -1000, -1000, 1826, 5523, -1000, 605, 12043, 12043,
-	12043, -1000, -1000, 742, 12694, -1000, 18001, 12043, -1000, 136,
-	135, 829, 821, 12043, 12043, 12477, 12260, 12043, 528, 12043,
-	12043, 474, -1000, 6975, 659, -1000, 9102, 279, 12043, 50,
-	-1000, -1000, -1000, 243, 12043, -1000, -1000, -1000, 78, -1000,
-	-32, -1000, -1000, 12043, -41, -52, 12043, -1000, 470, 546,
-	-1000, -1000, 8638, -1000, -1000, -1000, 634, -1000, -84, -1000,
-	-1000, 59, -53, -1000, -1000, -1000, -1000, 12043, 209, 12043,
-	12043, 968, 12043, -1000, -1000, -1000, 6975, -1000, -1000, -1000,
-	27, 12043, -1000, 888, -70, 921, 11175, 11175, -1000, 8865,
-	-1000, -1000, 1105, -1000, -1000, -1000, -1000, 61, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 132, 569,
-	131, 131, 131, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 274, 274, 274, -1000, -1000, 249, 383, 383, 1096,
-	1096, 1096, 495, 495, 422, 763, 1985, 1985, 1985, 445,
-	706, 706, 1985, 1985, 1985, 17716, 17689, 2341, 6975, 354,
-	580, 130, 6975, -1000, 975, -1000, -1000, -1000, 908, 129,
-	7214, 7214, -1000, -1000, -1000, 3832, -1000, -1000, 128, 6975,
-	-1000, 6975, -29, -30, -1000, 17670, -1000, -36, -1000, -1000,
-	-38, 6975, 6975, 6975, 51, -1000, 350, -1000, 347, 342,
-	336, -1000, 127, 49, 438, -1000, 6975, 596, 124, 121,
-	6975, -1000, -1000, 17424, 48, 907, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 47, 17396, 46, 2004, -1000, 7214, 7214,
-	7214, 3832, 120, 45, 16791, -116, 17377, 5762, 5762, 5762,
-	44, 17131, 6975, -116, 15545, 15519, 2318, -37, -42, -43,
-	1174, -44, 43, 41, 888, -1000, -1000, 6975, -1000, -1000,
-	-1000, 329, 318, 966, -1000, 734, -1000, 670, 6975, 12043,
-	119, 118, 527, -1000, 964, 694, 962, 694, -1000, -71,
-	551, -1000, -1000, 317, 17670, -1000, 1049, -48, -1000, -1000,
-	268, 9793, 5523, -54, -1000, -84, -84, -1000, -1000, -1000,
-	-1000, -1000, 12043, -1000, 10721, 117, 12043, 116, 115, 12043,
-	-1000, -1000, 40, -1000, -1000, -1000, -1000, -1000, 883, 1073,
-	9556, 851, 844, 9556, 856, 606, 606, 606, -1000, -1000,
-	-1000, 12043, 113, -1000, 9339, 38, 921, 225, 223, -1000,
-	1173, 6975, 2341, 6975, 7214, 7214, -1000, 2341, -1000, -1000,
-	-1000, -1000, 905, 111, 6975, 18001, 17742, 17642, -55, 4549,
-	-95, 16473, 6975, -1000, -1000, 222, -1000, 37, 5284, -1000,
-	17084, -11, -11, -1000, 778, 746, 492, 440, 1161, 1187,
-	978, -1000, 6975, 17103, -1000, 10030, 269, 620, 16227, 18001,
-	-1000, 6975, -1000, 904, 6975, -1000, 18001, 7214, 7214, 7214,
-	7214, 7214, 7214, 7214, 7214, 7214, 7214, 7214, 7214, 7214,
-	7214, 7214, 7214, 7214, 7214, 802, 7214, 1130, 1130, 1130,
-	-103, 4310, -1000, 938, 904, 6975, 6975, 18001, 35, 34,
-	31, -1000, 6975, -116, 6975, 6975, 6975, -1000, -1000, -1000,
-	29, -1000, 1160, -1000, -1000, 883, 16519, 12043, 12043, 12043,
-	955, 1566, -1000, 16201, -56, 12043, 12043, -1000, 768, 882,
-	299, 12043, -1000, 12043, -1000, 12043, 12043, 12043, 12043, 137,
-	27, -1000, -1000, -1000, 241, -1000, -1000, 12043, 103, 10721,
-	7688, 653, -1000, 266, 6975, 6975, 921, 9556, 9556, 1606,
-	831, 9556, -1000, -1000, -1000, -1000, 101, 12043, 11175, 356,
-	1139, 24, 1093, 2341, 17349, 1810, 6975, 18001, 2448, -60,
-	-1000, 6975, 6975, -1000, -61, -1000, 6975, -1000, 17670, -1000,
-	1186, 6975, 22, 21, 20, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 15, -1000, -1000, 17670, 6975, -1000, -1000, 15298,
-	6975, 13, -1000, 11, 17670, 938, 17670, -1000, 553, 553,
-	1130, 1130, 1130, 548, 548, 741, 283, 2414, 2414, 2414,
-	1151, 389, 389, 2414, 2414, 2414, 903, 757, 98, 17769,
-	6975, -62, -1000, -1000, -1000, 17670, 17670, 10, -1000, -1000,
-	-1000, -116, 2161, 16174, 16155, -1000, 9, 266, -1000, -1000,
-	-1000, -1000, 12043, -1000, 12043, -1000, 12043, 697, -1000, -1000,
-	817, 97, 7214, 12043, -1000, 577, -66, -72, 692, -1000,
-	642, 6975, -1000, 18001, 694, 694, -1000, 316, 315, -1000,
-	996, 7688, 1044, -1000, 95, -73, 12043, 5, -74, -1000,
-	65, 1058, 6975, -1000, -1000, 94, 12043, -1000, 12043, 17670,
-	-116, -1000, 1606, -1000, 93, 6975, 9556, -1000, 12043, -80,
-	-1000, -1000, 220, 218, -1000, 6975, 6975, 2448, -82, -1000,
-	18001, 2341, 2341, -1000, 15909, -1000, 17084, -1000, -1000, -1000,
-	-1000, 17670, 557, -1000, 15883, -1000, -1000, -1000, 7214, 901,
-	91, 18001, 15856, -1000, -1000, 6975, -1000, -1000, -1000, -1000,
-	-1000, 1503, -1000, -1000, -1000, 6975, 17769, 62, -1000, 87,
-	-1000, -1000, -1000, 515, -1000, -1000, 17670, 1061, -1000, -1000,
-	12043, 12043, 374, -86, 12043, -1000, -1000, 3593, 577, -87,
-	-1000, 577, 7688, 1057, -139, 12043, 1057, 15837, 2706, 84,
-	-109, -1000, 1089, -1000, 12043, 17670, -1000, -91, -1000, -1000,
-	-1000, 2341, 2341, -1000, -1000, -1000, 4, 620, 1072, -1000,
-	17815, 7214, 18001, -93, -1000, 15591, -1000, 15572, 781, 12043,
-	12043, 12043, 285, 12043, -1000, -1000, 413, -1000, 268, -1000,
-	-1000, 577, -1000, -1000, -1000, -1000, -1000, 1058, -38, 7688,
-	12043, 83, -108, -1000, -1000, 555, 6975, 17815, -120, -1000,
-	-1000, -1000, 624, 684, -126, -128, 62, -1000, 6975, -1000,
-	9793, -1000, -1000, 1057, 3, -133, -1000, -1000, -1000, 1,
-	6736, 6736, -116, -1000, -1000, 643, 641, 497, -1000, -1000,
-	-1000, -1000, -1000, 781, 17670, -104, -1000, -1000, 577, -1000,
-	-1000, -1000, 7451, 630, 453, 16545, -1000, -1000, 1003, -1000,
-	292, 652, 652, 624, -1000, -1000, 1110, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1119, -1000, -1000, 798, -1000, -1000, 6497,
-	-1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 2290, 294, 90, 1271, 10888, -1000, 13882,
+	13882, 13882, 13882, -1000, -1000, -1000, 13882, 13882, 13882, 7,
+	11659, 391, 171, -12, 11906, 20328, -129, 7291, 1000, 590,
+	-25, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1340, -1000, -1000, -1000, -1000, 1333, -25, -1000, -1000,
+	-1000, -1000, -1000, 1358, -1000, -1000, -1000, -1000, 3648, -1000,
+	-1000, -1000, 13882, -1000, -1000, 13882, -1000, -1000, 624, -1000,
+	13388, 11906, 1066, 768, 843, -1000, 1061, -1000, 701, 1047,
+	-1000, -1000, -1000, -1000, 20328, -1000, 20328, 637, 923, -1000,
+	923, -27, -1000, 19657, -1000, 162, -14, 261, 10631, 6755,
+	3648, -1000, 261, 77, 5981, -1000, 13882, 13882, 7291, 7291,
+	550, 13882, 13882, 13882, -1000, -1000, 840, 14623, -1000, 20604,
+	13882, -1000, 156, 155, 897, 896, 13882, 13882, 14376, 14129,
+	13882, 727, 7291, 13882, 13882, 618, 929, -1000, 994, -1000,
+	1275, -1000, -1000, -1000, -1000, 74, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 150, 689, 148, 148,
+	148, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 301,
+	301, 301, -1000, -1000, 267, 493, 493, 1294, 1294, 1294,
+	1018, 1018, 1595, 356, 20447, 20447, 20447, 434, 407, 407,
+	20447, 20447, 20447, 20537, 20356, 139, 7291, 387, 691, 145,
+	7291, -1000, 672, -1000, -1000, -1000, 993, 144, 8065, 8065,
+	-1000, -1000, -1000, 4425, 480, -1000, 143, 7291, -1000, 7291,
+	-26, -30, -1000, -1000, -36, -1000, -1000, -24, 7291, 7291,
+	7291, 75, -1000, 383, -1000, 381, 371, 369, -1000, 142,
+	72, 485, -1000, 7291, 724, 140, 137, 7291, -1000, -1000,
+	20154, 71, 991, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	69, 20127, 66, 3114, -1000, 8065, 8065, 8065, 4425, 135,
+	65, 19566, -129, 20036, 6497, 6497, 6497, 62, 19951, 7291,
+	-129, 2917, 2880, 2648, -37, -38, -42, 1332, -43, 58,
+	54, -1000, 13882, -1000, 7291, -1000, 7291, 766, -1000, 10117,
+	308, 13882, 56, -1000, -1000, -1000, 253, 13882, -1000, -1000,
+	-1000, -104, 91, -1000, -33, -1000, -1000, 13882, 13882, -1000,
+	53, -34, -1000, -1000, -1000, -1000, 13882, 173, 20328, 13882,
+	-1000, 532, 657, -1000, -1000, 9613, -1000, -1000, -1000, 816,
+	-1000, -115, -1000, -1000, 13882, 13882, -1000, 52, 13882, 13882,
+	1045, 13882, 7291, 13882, -1000, -1000, -1000, 7291, -1000, -1000,
+	-1000, 7, -1000, 294, -53, 1596, 13141, 13141, -1000, 9860,
+	-1000, -1000, 294, -1000, -1000, -104, -104, 20328, 20328, -1000,
+	-1000, 365, 362, 1043, -1000, 839, -1000, 708, 7291, 13882,
+	125, 124, 718, -1000, 1040, 761, 1038, 761, -1000, -59,
+	534, 20328, -1000, -1000, 361, -1000, 7291, 229, 225, -1000,
+	1331, 7291, 139, 7291, 8065, 8065, -1000, 139, -1000, -1000,
+	-1000, -1000, 986, 122, 7291, 20604, 3016, 2996, -54, 450,
+	5203, -71, 19335, -1000, -1000, 141, -1000, 50, 6239, -1000,
+	19833, -18, -18, -1000, 871, 746, 665, 581, 1321, 1356,
+	1088, -1000, 7291, 19860, -1000, 11145, 295, 735, 19183, 20604,
+	-1000, 7291, -1000, 981, 7291, -1000, 20604, 8065, 8065, 8065,
+	8065, 8065, 8065, 8065, 8065, 8065, 8065, 8065, 8065, 8065,
+	8065, 8065, 8065, 8065, 8065, 928, 8065, 1297, 1297, 1297,
+	-95, 4943, -1000, 1019, 981, 7291, 7291, 20604, 49, 46,
+	44, -1000, 7291, -129, 7291, 7291, 7291, -1000, -1000, -1000,
+	42, -1000, 1320, -1000, -1000, -104, 19384, 20328, -1000, 1161,
+	-55, -1000, -1000, 294, 10888, 5981, -60, -1000, -115, -61,
+	1135, 11906, 121, 13882, -115, -1000, -1000, -1000, -1000, -1000,
+	13882, -1000, -1000, -1000, 120, 118, 13882, -1000, 20328, 283,
+	-1000, 40, -1000, -1000, -1000, -1000, 958, 10631, 903, 902,
+	10631, 1020, 728, 728, 728, -1000, -1000, -1000, 13882, 117,
+	-1000, 10374, 39, 1596, 958, 13882, 13882, 13882, 1027, 1336,
+	-1000, 19153, -62, 13882, 13882, -1000, 937, 941, 346, 13882,
+	-1000, 13882, -1000, 13882, 13882, 13882, 13882, -129, 389, 1307,
+	37, 1295, 139, 2685, 420, 7291, 20604, 1894, -66, -1000,
+	7291, 7291, -1000, 7291, -70, -1000, 7291, -1000, -1000, 1353,
+	7291, 36, 33, 32, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 29, -1000, -1000, 20328, 7291, -1000, -1000, 18328, 7291,
+	27, -1000, 26, 20328, 1019, 20328, -1000, 501, 501, 1297,
+	1297, 1297, 461, 461, 788, 1132, 455, 455, 455, 546,
+	439, 439, 455, 455, 455, 980, 913, 115, 3077, 7291,
+	-72, -1000, -1000, -1000, 20328, 20328, 25, -1000, -1000, -1000,
+	-129, 2600, 19001, 18974, -1000, 24, -1000, 146, 7, -1000,
+	-1000, -1000, 248, -1000, 1119, -1000, 13882, 113, -1000, 11906,
+	8581, 762, -1000, -1000, 948, 1241, 1596, 10631, 10631, 726,
+	901, 10631, -1000, -1000, -1000, -1000, 112, 13882, 13141, 948,
+	-1000, -1000, -1000, 13882, -1000, 13882, -1000, 13882, 834, -1000,
+	-1000, 894, 111, 8065, 13882, -1000, 663, -74, -78, 830,
+	-1000, 812, 7291, -1000, 20604, 761, 761, -1000, 359, 357,
+	-1000, -1000, 220, 76, -1000, 7291, 7291, 1894, -79, -1000,
+	20604, 139, 139, 20328, -1000, 18952, -1000, 19833, -1000, -1000,
+	-1000, -1000, 20328, 709, -1000, 18800, -1000, -1000, -1000, 8065,
+	977, 109, 20604, 18770, -1000, -1000, 7291, -1000, -1000, -1000,
+	1096, 8581, 1149, -1000, 108, 106, -80, 13882, 20, -84,
+	-1000, 89, 1190, 7291, -1000, -1000, 105, 13882, 291, 7291,
+	7291, -1000, 726, -1000, 102, 7291, 10631, -1000, 13882, -85,
+	-1000, 291, -1000, 921, -1000, -1000, -1000, 7291, 3077, 98,
+	-1000, 100, -1000, -1000, -1000, 646, -1000, -1000, 20328, 1227,
+	-1000, -1000, 13882, 13882, -1000, -1000, 139, 139, -1000, -1000,
+	-1000, 17, 735, 1240, -1000, 3055, 8065, 20604, -86, -1000,
+	18618, 422, -90, 13882, -1000, -1000, 4165, 7291, 663, -91,
+	-1000, 663, 8581, 1189, -131, 13882, 1189, 18591, 3905, 99,
+	-1000, 13882, 20328, -129, -1000, 13882, 20328, -1000, -96, -1000,
+	-1000, -1000, 18569, 876, 13882, 13882, 13882, 313, 13882, -1000,
+	-1000, -1000, 612, 7291, 3055, -100, -1000, -1000, 566, -1000,
+	294, -1000, -102, -1000, 663, -1000, -1000, -1000, -1000, -1000,
+	1190, -24, 8581, -122, -1000, 1258, -106, -1000, -1000, 741,
+	733, -108, -110, 98, -1000, 7291, -1000, 15, 7807, 7807,
+	-129, -1000, 10888, -1000, 282, -1000, 1189, 12, -125, 13882,
+	93, -1000, -1000, 760, 748, 479, -1000, -1000, -1000, -1000,
+	-1000, 876, 20328, -1000, -1000, -1000, 8323, 824, 603, 19536,
+	-1000, -112, -1000, 13882, -1000, -1000, 663, -1000, -1000, -1000,
+	1103, -1000, 339, 850, 850, 741, 1278, -1000, -1000, -1000,
+	-1000, -1000, -1000, -139, -1000, 246, -1000, -1000, 1290, -1000,
+	-1000, 922, -1000, -1000, 7549, 13882, 7291, -1000, -1000, -1000,
+	-1000, -1000, 20328,
 }
 var sqlPgo = [...]int{
 
-	0, 1450, 1449, 1105, 1447, 1445, 1441, 1436, 1434, 83,
-	1432, 1430, 85, 1429, 79, 1428, 1425, 1424, 64, 1423,
-	1421, 1418, 1417, 78, 33, 1754, 114, 102, 1415, 1413,
-	1412, 10, 76, 72, 1409, 37, 1408, 542, 1163, 49,
-	20, 15, 4, 1407, 1397, 1393, 29, 1391, 1390, 1389,
-	11, 40, 25, 1388, 14, 89, 1384, 1379, 74, 1373,
-	127, 42, 96, 97, 1367, 484, 1341, 12, 46, 1340,
-	24, 1339, 19, 51, 103, 1333, 499, 38, 22, 36,
-	1332, 1331, 1329, 1328, 61, 70, 43, 1325, 1324, 45,
-	1322, 95, 98, 1321, 1315, 1314, 1312, 1311, 1310, 1094,
-	1309, 7, 52, 41, 28, 39, 0, 215, 551, 1299,
-	30, 32, 56, 27, 35, 31, 1298, 77, 1293, 1292,
-	1289, 1285, 1283, 54, 1282, 44, 107, 26, 63, 69,
-	23, 101, 57, 88, 112, 84, 1281, 91, 1279, 75,
-	1278, 1277, 633, 73, 1274, 1273, 1272, 617, 614, 474,
-	176, 1270, 1268, 334, 324, 1266, 1263, 65, 1259, 1257,
-	106, 1256, 120, 92, 1254, 87, 1253, 66, 1251, 108,
-	113, 86, 1250, 94, 48, 1248, 1246, 1245, 17, 1,
-	6, 5, 8, 3, 18, 16, 1244, 1238, 82, 67,
-	1236, 511, 1235, 1230, 47, 1228, 1225, 21, 1216, 13,
-	1211, 9, 2, 1210, 100, 1208, 71, 1207, 1136, 1204,
-	110, 1201, 1199, 1144, 62,
+	0, 1594, 1592, 1212, 1591, 1590, 1586, 1581, 1578, 1574,
+	1572, 1569, 1566, 1555, 1554, 1552, 87, 1547, 1543, 103,
+	1539, 1537, 81, 1536, 1535, 1534, 1533, 1532, 49, 1531,
+	1528, 1526, 1524, 77, 40, 1797, 124, 114, 1520, 1519,
+	48, 1518, 16, 94, 91, 1517, 125, 1516, 130, 910,
+	45, 28, 19, 92, 1514, 1513, 1510, 39, 1509, 1508,
+	1506, 13, 64, 17, 1503, 18, 463, 1502, 1500, 72,
+	1499, 100, 80, 15, 106, 34, 93, 4, 1498, 1497,
+	1495, 127, 1493, 11, 56, 1491, 35, 1490, 38, 41,
+	116, 1485, 131, 46, 26, 42, 1482, 1481, 1471, 1467,
+	84, 76, 44, 1465, 1463, 60, 1457, 115, 119, 1456,
+	1453, 1451, 1446, 1445, 1444, 525, 1443, 9, 37, 55,
+	20, 23, 1487, 833, 31, 1442, 53, 36, 85, 29,
+	51, 52, 1441, 109, 1439, 1438, 1436, 1435, 1434, 86,
+	1433, 54, 118, 33, 63, 75, 32, 25, 61, 104,
+	126, 99, 1432, 96, 1431, 95, 1430, 1429, 364, 107,
+	1428, 1425, 1424, 363, 361, 349, 22, 1423, 1422, 304,
+	147, 1420, 1419, 98, 1418, 1416, 123, 1414, 102, 105,
+	1413, 101, 1410, 79, 1400, 0, 117, 89, 1398, 110,
+	57, 1397, 1396, 1392, 24, 1, 8, 5, 6, 3,
+	21, 14, 1391, 1389, 111, 70, 1387, 129, 1383, 1381,
+	27, 1380, 1378, 10, 1377, 7, 1375, 12, 2, 1374,
+	121, 1373, 83, 1371, 1236, 1368, 128, 1367, 1366, 1235,
+	90,
 }
 var sqlR1 = [...]int{
 
 	0, 1, 2, 2, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	4, 4, 33, 33, 32, 32, 32, 32, 32, 32,
-	32, 32, 32, 32, 32, 32, 32, 32, 32, 29,
-	29, 35, 35, 35, 34, 34, 30, 30, 5, 5,
-	5, 9, 10, 10, 10, 10, 10, 10, 62, 62,
-	61, 61, 64, 64, 11, 11, 12, 12, 12, 12,
-	138, 138, 137, 13, 17, 204, 204, 204, 208, 208,
-	209, 209, 210, 210, 210, 210, 210, 210, 210, 206,
-	206, 19, 19, 19, 99, 99, 98, 98, 98, 98,
-	100, 100, 100, 100, 162, 160, 160, 167, 167, 167,
-	44, 44, 44, 44, 44, 159, 159, 159, 159, 168,
-	168, 168, 168, 168, 168, 45, 45, 45, 166, 166,
-	20, 20, 20, 20, 20, 20, 20, 20, 20, 20,
-	161, 161, 205, 205, 207, 207, 8, 8, 46, 46,
-	47, 47, 103, 103, 103, 102, 176, 176, 177, 177,
-	177, 178, 178, 178, 178, 178, 178, 178, 175, 175,
-	173, 173, 174, 174, 174, 174, 211, 211, 101, 101,
-	50, 50, 181, 181, 181, 181, 179, 179, 179, 179,
-	179, 182, 180, 183, 183, 183, 183, 183, 126, 126,
-	126, 22, 7, 7, 88, 88, 54, 54, 130, 130,
-	130, 41, 41, 31, 31, 31, 16, 16, 16, 16,
-	16, 16, 16, 16, 16, 89, 89, 90, 90, 21,
-	21, 21, 213, 213, 36, 36, 37, 6, 6, 14,
-	43, 43, 95, 95, 95, 97, 97, 97, 96, 96,
-	96, 23, 67, 67, 68, 68, 136, 69, 69, 18,
-	18, 25, 25, 24, 24, 24, 24, 24, 24, 26,
-	26, 27, 27, 27, 27, 27, 27, 27, 189, 189,
-	189, 191, 191, 188, 15, 15, 15, 15, 190, 190,
-	212, 212, 76, 76, 76, 49, 48, 48, 52, 52,
-	51, 53, 53, 129, 74, 74, 74, 74, 91, 92,
-	92, 93, 93, 94, 94, 73, 73, 113, 113, 28,
-	28, 58, 58, 59, 59, 131, 131, 131, 131, 132,
-	132, 132, 132, 132, 132, 127, 127, 127, 127, 128,
-	128, 79, 79, 79, 79, 77, 77, 78, 78, 133,
-	133, 133, 133, 75, 75, 134, 134, 134, 104, 104,
-	139, 139, 139, 57, 57, 57, 140, 140, 140, 140,
-	140, 140, 140, 140, 140, 140, 141, 141, 141, 141,
-	143, 143, 143, 142, 142, 142, 142, 142, 142, 142,
-	142, 142, 142, 142, 142, 142, 144, 144, 151, 151,
-	152, 152, 153, 154, 145, 145, 146, 146, 147, 148,
-	155, 155, 155, 157, 157, 149, 149, 150, 85, 85,
-	85, 85, 85, 85, 85, 85, 85, 85, 85, 85,
-	85, 85, 86, 86, 106, 106, 106, 106, 106, 106,
-	106, 106, 106, 106, 106, 106, 106, 106, 106, 106,
-	106, 106, 106, 106, 106, 106, 106, 106, 106, 106,
-	106, 106, 106, 106, 106, 106, 106, 106, 106, 106,
-	106, 106, 106, 106, 106, 106, 106, 106, 106, 106,
-	106, 106, 106, 106, 106, 106, 107, 107, 107, 107,
-	107, 107, 107, 107, 107, 107, 107, 107, 107, 107,
-	107, 107, 107, 107, 107, 107, 107, 107, 107, 107,
-	107, 107, 107, 108, 108, 108, 108, 108, 108, 108,
-	108, 108, 108, 108, 108, 184, 184, 184, 184, 184,
-	184, 184, 186, 186, 187, 187, 185, 185, 185, 185,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 4,
+	4, 6, 7, 7, 5, 5, 44, 44, 43, 43,
+	43, 43, 43, 43, 43, 43, 43, 43, 43, 43,
+	43, 43, 43, 39, 39, 46, 46, 46, 45, 45,
+	41, 41, 9, 11, 11, 11, 11, 16, 17, 17,
+	17, 17, 17, 17, 17, 17, 17, 17, 74, 74,
+	73, 73, 80, 80, 18, 18, 18, 19, 19, 19,
+	19, 154, 154, 153, 153, 8, 8, 76, 76, 26,
+	26, 21, 78, 78, 79, 79, 77, 20, 27, 220,
+	220, 220, 220, 224, 224, 225, 225, 225, 225, 226,
+	226, 226, 226, 226, 226, 226, 222, 222, 29, 29,
+	29, 115, 115, 114, 114, 114, 114, 116, 116, 116,
+	116, 178, 176, 176, 183, 183, 183, 55, 55, 55,
+	55, 55, 175, 175, 175, 175, 184, 184, 184, 184,
+	184, 184, 56, 56, 56, 182, 182, 30, 30, 30,
+	30, 30, 30, 30, 30, 30, 30, 30, 30, 30,
+	30, 30, 30, 30, 30, 177, 177, 221, 221, 223,
+	223, 14, 14, 57, 57, 58, 58, 119, 119, 119,
+	118, 192, 192, 193, 193, 193, 194, 194, 194, 194,
+	194, 194, 194, 191, 191, 189, 189, 190, 190, 190,
+	190, 227, 227, 117, 117, 61, 61, 197, 197, 197,
+	197, 195, 195, 195, 195, 195, 198, 196, 199, 199,
+	199, 199, 199, 142, 142, 142, 32, 13, 13, 104,
+	104, 65, 65, 146, 146, 146, 52, 52, 42, 42,
+	42, 25, 25, 25, 25, 25, 25, 25, 25, 25,
+	105, 105, 106, 106, 10, 24, 31, 31, 31, 229,
+	229, 47, 47, 48, 12, 12, 15, 15, 40, 40,
+	22, 54, 54, 111, 111, 111, 113, 113, 113, 112,
+	112, 112, 33, 83, 83, 84, 84, 152, 85, 85,
+	28, 28, 35, 35, 34, 34, 34, 34, 34, 34,
+	36, 36, 37, 37, 37, 37, 37, 37, 37, 205,
+	205, 205, 207, 207, 204, 23, 23, 23, 23, 206,
+	206, 228, 228, 92, 92, 92, 60, 59, 59, 63,
+	63, 62, 64, 64, 145, 90, 90, 90, 90, 107,
+	108, 108, 109, 109, 110, 110, 89, 89, 129, 129,
+	38, 38, 69, 69, 71, 71, 70, 70, 147, 147,
+	147, 147, 148, 148, 148, 148, 148, 148, 143, 143,
+	143, 143, 144, 144, 95, 95, 95, 95, 93, 93,
+	94, 94, 149, 149, 149, 149, 91, 91, 150, 150,
+	150, 120, 120, 155, 155, 155, 68, 68, 68, 156,
+	156, 156, 156, 156, 156, 156, 156, 156, 156, 157,
+	157, 157, 157, 159, 159, 159, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 160,
+	160, 167, 167, 168, 168, 169, 170, 161, 161, 162,
+	162, 163, 164, 171, 171, 171, 173, 173, 165, 165,
+	166, 101, 101, 101, 101, 101, 101, 101, 101, 101,
+	101, 101, 101, 101, 101, 102, 102, 122, 122, 122,
+	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
+	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
+	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
+	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
+	122, 122, 122, 122, 122, 122, 122, 122, 122, 123,
+	123, 123, 123, 123, 123, 123, 123, 123, 123, 123,
+	123, 123, 123, 123, 123, 123, 123, 123, 123, 123,
+	123, 123, 123, 123, 123, 123, 124, 124, 124, 124,
+	124, 124, 124, 124, 124, 124, 124, 124, 200, 200,
+	200, 200, 200, 200, 200, 202, 202, 203, 203, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 208, 208, 209, 209, 210, 210,
+	211, 211, 213, 214, 214, 214, 215, 219, 219, 212,
+	212, 216, 216, 216, 217, 217, 218, 218, 218, 218,
+	218, 133, 133, 133, 134, 134, 135, 75, 75, 131,
+	131, 130, 130, 130, 132, 132, 96, 172, 172, 172,
+	172, 172, 172, 172, 97, 97, 103, 98, 98, 99,
+	99, 99, 99, 99, 99, 126, 127, 100, 100, 100,
+	128, 128, 136, 140, 140, 139, 138, 138, 137, 137,
+	121, 121, 121, 121, 121, 86, 86, 230, 230, 141,
+	141, 87, 87, 88, 82, 82, 81, 81, 151, 151,
+	151, 151, 72, 72, 53, 53, 66, 66, 67, 67,
+	51, 51, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 174, 174, 174, 49, 49, 49, 50,
+	50, 180, 180, 180, 181, 181, 181, 181, 179, 179,
+	179, 179, 179, 185, 185, 185, 185, 185, 185, 185,
+	185, 185, 185, 185, 185, 185, 185, 185, 185, 185,
+	185, 185, 185, 185, 185, 185, 185, 185, 185, 185,
 	185, 185, 185, 185, 185, 185, 185, 185, 185, 185,
 	185, 185, 185, 185, 185, 185, 185, 185, 185, 185,
-	185, 192, 192, 193, 193, 194, 194, 195, 195, 197,
-	198, 198, 198, 199, 203, 203, 196, 196, 200, 200,
-	200, 201, 201, 202, 202, 202, 202, 202, 117, 117,
-	117, 118, 118, 119, 63, 63, 115, 115, 114, 114,
-	114, 116, 116, 80, 156, 156, 156, 156, 156, 156,
-	156, 81, 81, 87, 82, 82, 83, 83, 83, 83,
-	83, 83, 110, 111, 84, 84, 84, 112, 112, 120,
-	124, 124, 123, 122, 122, 121, 121, 105, 105, 105,
-	105, 105, 70, 70, 214, 214, 125, 125, 71, 71,
-	72, 66, 66, 65, 65, 135, 135, 135, 135, 60,
-	60, 42, 42, 55, 55, 56, 56, 40, 40, 109,
-	109, 109, 109, 109, 109, 109, 109, 109, 109, 109,
-	158, 158, 158, 38, 38, 38, 39, 39, 164, 164,
-	164, 165, 165, 165, 165, 163, 163, 163, 163, 163,
-	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
-	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
-	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
-	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
-	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
-	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
-	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
-	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
-	169, 169, 169, 169, 169, 169, 169, 169, 169, 171,
-	171, 171, 171, 171, 171, 171, 171, 171, 171, 171,
-	171, 171, 171, 171, 171, 171, 171, 171, 171, 171,
-	171, 171, 171, 171, 171, 171, 171, 171, 171, 171,
-	171, 171, 171, 171, 171, 171, 171, 171, 171, 171,
-	170, 170, 170, 170, 170, 170, 170, 170, 170, 170,
-	170, 170, 170, 172, 172, 172, 172, 172, 172, 172,
-	172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
-	172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
-	172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
-	172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
-	172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
-	172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
-	172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
-	172,
+	185, 185, 185, 185, 185, 185, 185, 185, 185, 185,
+	185, 185, 185, 185, 185, 185, 185, 185, 185, 185,
+	185, 185, 185, 185, 185, 185, 185, 185, 185, 185,
+	185, 185, 185, 185, 185, 185, 185, 185, 185, 185,
+	185, 185, 185, 185, 185, 185, 185, 185, 185, 185,
+	185, 185, 185, 185, 185, 185, 185, 185, 185, 185,
+	185, 185, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 186, 186, 186, 186, 186, 186, 186,
+	186, 186, 186, 186, 186, 186, 188, 188, 188, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	188, 188, 188, 188,
 }
 var sqlR2 = [...]int{
 
 	0, 1, 3, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 0,
-	4, 6, 1, 3, 2, 5, 3, 6, 4, 6,
-	6, 6, 4, 8, 2, 3, 3, 6, 4, 3,
-	2, 1, 1, 0, 2, 0, 2, 0, 1, 1,
-	1, 5, 3, 5, 4, 6, 3, 5, 1, 3,
-	1, 2, 2, 3, 2, 5, 1, 1, 1, 1,
-	1, 3, 1, 6, 6, 1, 2, 2, 1, 1,
-	1, 3, 1, 1, 1, 1, 1, 1, 1, 1,
-	3, 2, 3, 3, 2, 1, 3, 3, 3, 3,
-	1, 3, 3, 2, 1, 1, 3, 1, 1, 1,
-	2, 2, 2, 1, 1, 1, 1, 1, 1, 1,
-	1, 3, 1, 1, 1, 1, 1, 0, 1, 1,
-	2, 2, 4, 2, 4, 4, 3, 3, 4, 2,
-	2, 0, 2, 0, 2, 0, 6, 9, 1, 0,
-	1, 3, 1, 1, 1, 3, 2, 0, 3, 1,
-	2, 2, 1, 1, 2, 4, 2, 5, 6, 7,
-	3, 1, 4, 5, 5, 10, 1, 1, 4, 0,
-	3, 0, 2, 2, 2, 0, 1, 1, 2, 2,
-	0, 3, 3, 2, 1, 1, 2, 2, 1, 2,
-	1, 4, 10, 13, 1, 0, 1, 3, 3, 3,
-	5, 2, 0, 1, 1, 0, 6, 6, 8, 6,
-	8, 8, 10, 8, 10, 1, 0, 2, 0, 3,
-	2, 2, 1, 0, 1, 0, 3, 3, 6, 6,
-	1, 3, 1, 4, 2, 8, 5, 0, 4, 3,
-	0, 7, 1, 3, 1, 1, 3, 5, 5, 1,
-	1, 3, 3, 1, 2, 3, 2, 3, 4, 1,
-	1, 8, 8, 1, 2, 4, 4, 4, 2, 2,
-	3, 1, 3, 6, 1, 1, 1, 1, 1, 0,
-	1, 0, 1, 1, 0, 1, 1, 0, 1, 0,
-	3, 1, 3, 2, 2, 2, 1, 1, 2, 2,
-	3, 1, 1, 1, 1, 3, 0, 2, 0, 2,
-	3, 2, 0, 1, 3, 2, 2, 1, 4, 3,
-	4, 5, 4, 5, 4, 5, 2, 4, 1, 1,
-	0, 2, 2, 2, 1, 1, 0, 4, 2, 1,
-	2, 2, 4, 1, 3, 1, 2, 3, 2, 0,
-	2, 5, 2, 3, 4, 0, 1, 1, 1, 1,
-	2, 4, 1, 1, 1, 1, 1, 1, 1, 1,
-	3, 5, 0, 1, 1, 1, 1, 1, 1, 2,
-	2, 2, 2, 2, 1, 1, 3, 0, 1, 1,
-	1, 1, 5, 2, 1, 1, 1, 1, 4, 1,
-	2, 2, 1, 1, 0, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 3, 3, 3, 3, 3, 3,
-	3, 0, 1, 4, 1, 3, 3, 5, 2, 2,
-	2, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 0, 6,
+	6, 6, 6, 6, 4, 6, 1, 3, 2, 5,
+	3, 6, 4, 6, 6, 6, 4, 8, 2, 3,
+	3, 6, 4, 3, 2, 1, 1, 0, 2, 0,
+	2, 0, 3, 1, 1, 1, 1, 5, 3, 5,
+	3, 5, 3, 5, 4, 6, 3, 5, 1, 3,
+	1, 2, 2, 3, 2, 3, 5, 1, 1, 1,
+	1, 1, 3, 1, 1, 6, 5, 3, 0, 4,
+	3, 12, 2, 0, 1, 3, 3, 6, 6, 1,
+	2, 2, 5, 1, 1, 1, 4, 3, 6, 1,
+	1, 1, 1, 1, 1, 1, 1, 3, 2, 3,
+	3, 2, 1, 3, 3, 3, 3, 1, 3, 3,
+	2, 1, 1, 3, 1, 1, 1, 2, 2, 2,
+	1, 1, 1, 1, 1, 1, 1, 1, 3, 1,
+	1, 1, 1, 1, 0, 1, 1, 2, 2, 4,
+	2, 4, 2, 4, 3, 2, 2, 3, 5, 3,
+	3, 4, 6, 6, 2, 2, 0, 2, 0, 2,
+	0, 6, 9, 1, 0, 1, 3, 1, 1, 1,
+	3, 2, 0, 3, 1, 2, 2, 1, 1, 2,
+	4, 2, 5, 6, 7, 3, 1, 4, 5, 5,
+	10, 1, 1, 4, 0, 3, 0, 2, 2, 2,
+	0, 1, 1, 2, 2, 0, 3, 3, 2, 1,
+	1, 2, 2, 1, 2, 1, 4, 10, 13, 1,
+	0, 1, 3, 3, 3, 5, 2, 0, 1, 1,
+	0, 6, 6, 8, 6, 8, 8, 10, 8, 10,
+	1, 0, 2, 0, 3, 4, 3, 2, 2, 1,
+	0, 1, 0, 3, 3, 6, 4, 7, 3, 0,
+	6, 1, 3, 1, 4, 2, 8, 5, 0, 4,
+	3, 0, 7, 1, 3, 1, 1, 3, 5, 5,
+	1, 1, 3, 3, 1, 2, 3, 2, 3, 4,
+	1, 1, 9, 9, 1, 2, 4, 4, 4, 2,
+	2, 3, 1, 3, 6, 1, 1, 1, 1, 1,
+	0, 1, 0, 1, 1, 0, 1, 1, 0, 1,
+	0, 3, 1, 3, 2, 2, 2, 1, 1, 2,
+	2, 3, 1, 1, 1, 1, 3, 0, 2, 0,
+	2, 3, 2, 0, 5, 0, 1, 3, 2, 2,
+	1, 4, 3, 4, 5, 4, 5, 4, 5, 2,
+	4, 1, 1, 0, 2, 2, 2, 1, 1, 0,
+	4, 2, 1, 2, 2, 4, 1, 3, 1, 2,
+	3, 2, 0, 2, 5, 2, 3, 4, 0, 1,
+	1, 1, 1, 2, 4, 1, 1, 1, 1, 1,
+	1, 1, 1, 3, 5, 0, 1, 1, 1, 1,
+	1, 1, 2, 2, 2, 2, 2, 1, 1, 3,
+	0, 1, 1, 1, 1, 5, 2, 1, 1, 1,
+	1, 4, 1, 2, 2, 1, 1, 0, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 3, 3, 3,
+	3, 3, 3, 3, 0, 1, 4, 1, 3, 3,
+	5, 2, 2, 2, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 2, 2, 3, 4, 4, 5,
+	3, 4, 3, 3, 4, 3, 4, 3, 4, 5,
+	6, 6, 7, 6, 7, 6, 7, 3, 4, 1,
+	3, 2, 2, 2, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 2, 2, 3, 4, 4, 5, 3, 4, 3,
-	3, 4, 3, 4, 3, 4, 5, 6, 6, 7,
-	6, 7, 6, 7, 3, 4, 1, 3, 2, 2,
-	2, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 5,
-	6, 6, 7, 1, 1, 1, 3, 1, 1, 1,
-	2, 2, 2, 1, 1, 3, 5, 6, 8, 6,
-	6, 4, 4, 1, 1, 1, 5, 1, 3, 1,
-	3, 1, 1, 1, 1, 6, 4, 4, 4, 4,
-	6, 5, 5, 5, 4, 8, 6, 6, 4, 4,
-	4, 5, 0, 5, 0, 2, 0, 1, 3, 3,
-	2, 2, 0, 6, 1, 0, 3, 0, 2, 2,
-	0, 1, 4, 2, 2, 2, 2, 2, 4, 3,
-	5, 4, 3, 5, 1, 3, 1, 3, 3, 3,
-	2, 1, 3, 3, 1, 1, 1, 1, 1, 1,
-	1, 4, 3, 2, 3, 0, 3, 3, 2, 2,
-	1, 0, 2, 2, 3, 2, 1, 1, 3, 5,
-	1, 2, 4, 2, 0, 1, 0, 2, 2, 2,
-	3, 5, 1, 2, 1, 0, 1, 1, 1, 3,
-	3, 1, 0, 1, 3, 3, 2, 1, 1, 1,
-	3, 1, 2, 1, 3, 3, 0, 1, 2, 1,
-	1, 1, 1, 6, 2, 3, 5, 1, 1, 1,
-	1, 2, 2, 1, 1, 1, 1, 0, 1, 1,
+	3, 3, 5, 6, 6, 7, 1, 1, 1, 3,
+	1, 1, 1, 2, 2, 2, 1, 1, 3, 5,
+	6, 8, 6, 6, 4, 4, 1, 1, 1, 5,
+	1, 3, 1, 3, 1, 1, 1, 1, 6, 4,
+	4, 4, 4, 6, 5, 5, 5, 4, 8, 6,
+	6, 4, 4, 4, 5, 0, 5, 0, 2, 0,
+	1, 3, 3, 2, 2, 0, 6, 1, 0, 3,
+	0, 2, 2, 0, 1, 4, 2, 2, 2, 2,
+	2, 4, 3, 5, 4, 3, 5, 1, 3, 1,
+	3, 3, 3, 2, 1, 3, 3, 1, 1, 1,
+	1, 1, 1, 1, 4, 3, 2, 3, 0, 3,
+	3, 2, 2, 1, 0, 2, 2, 3, 2, 1,
+	1, 3, 5, 1, 2, 4, 2, 0, 1, 0,
+	2, 2, 2, 3, 5, 1, 2, 1, 0, 1,
+	1, 1, 3, 3, 1, 0, 1, 3, 3, 2,
+	1, 1, 1, 3, 1, 2, 1, 3, 3, 0,
+	1, 2, 1, 1, 1, 1, 6, 2, 3, 5,
+	1, 1, 1, 1, 2, 2, 1, 1, 1, 1,
+	0, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -3102,349 +3440,382 @@ var sqlR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1,
 }
 var sqlChk = [...]int{
 
-	-1000, -1, -2, -3, -4, -5, -9, -10, -11, -13,
-	-14, -16, -17, -18, -19, -20, -21, -22, -23, 19,
-	-6, -7, -8, -190, 81, 87, 99, 178, -24, -25,
-	191, 192, 29, 50, 180, 216, 56, -189, -27, -26,
-	260, 236, 242, 187, -28, 204, 229, 263, 204, 68,
-	109, 76, 112, 223, 68, 109, 204, -12, 260, -18,
-	-14, -23, -9, -208, 18, -209, -210, 56, 81, 99,
-	187, 112, 76, 223, -208, -99, 130, 189, 212, -100,
-	-98, -162, 208, 138, -61, -38, 4, -169, -171, 16,
-	17, 19, 28, 29, 33, 37, 39, 49, 50, 51,
-	53, 55, 58, 59, 66, 67, 68, 69, 71, 76,
-	80, 81, 87, 91, 92, 94, 100, 105, 112, 120,
-	122, 127, 134, 135, 136, 137, 140, 141, 143, 146,
-	148, 149, 155, 158, 161, 162, 165, 168, 169, 171,
-	172, 174, 175, 176, 178, 180, 181, 183, 185, 186,
-	188, 191, 192, 194, 196, 198, 201, 199, 205, 206,
-	216, 217, 218, 219, 222, 223, 226, 227, 228, 232,
-	237, 238, 239, 240, 30, 31, 32, 34, 35, 38,
-	42, 43, 45, 70, 72, 73, 86, 88, 93, 101,
-	103, 106, 107, 113, 114, 115, 117, 125, 145, 147,
-	156, 160, 164, 166, 170, 182, 195, 200, 202, 209,
-	213, 214, 229, 230, 4, 68, 49, 69, 100, 109,
-	205, 208, 212, 18, -213, 212, -213, -213, -212, 204,
-	204, -88, 68, 221, -26, -27, -25, -51, -52, 220,
-	116, 85, 154, -24, -25, -189, -191, 171, -188, -38,
-	130, 138, 189, 212, 208, -191, -48, -49, 18, 78,
-	264, -133, -42, 152, -38, -72, 260, -3, -133, 106,
-	-38, -42, 106, 97, 118, -134, -133, -38, 106, -60,
-	106, -42, -62, 106, -61, -138, -137, -165, 4, -169,
-	-171, -170, 229, 47, 57, 98, 111, 119, 121, 126,
-	128, 139, 157, 159, 179, 193, 151, 264, 151, -99,
-	-99, -37, 120, 210, 245, 97, 240, -45, 6, 74,
-	-64, 262, 97, -205, 151, 97, -161, 97, 240, 120,
-	-36, -37, -75, -133, -61, 106, 109, -38, 106, -51,
-	-52, -74, -91, -92, 129, 150, -76, 18, 78, -76,
-	-76, 37, 261, 261, 264, -191, -56, 260, -66, -65,
-	-135, -106, 253, -108, 251, 252, 257, 142, 241, -117,
-	-42, -109, 9, 260, -120, -186, -25, 86, 24, -118,
-	-119, 182, -38, 8, 5, 6, 7, -40, -141, -150,
-	215, 89, 144, 40, -184, -185, 4, -169, -164, -142,
-	-152, -146, -149, 117, 47, 61, 64, 62, 65, 190,
-	224, 41, 88, 160, 164, 202, 213, 214, 106, 145,
-	107, 45, 101, 125, 80, 31, 32, 34, 35, 42,
-	43, 70, 72, 73, 93, 113, 114, 115, 147, 170,
-	195, 209, 230, -170, -153, -154, -147, -148, -155, -65,
-	-72, 253, -42, 260, -70, -105, 262, 265, 258, -71,
-	-125, -106, 74, -33, 174, -32, 17, 19, 81, 227,
-	86, 174, 174, 86, -134, -43, -42, 191, -38, 25,
-	86, -35, 264, 39, 176, 86, 264, 86, 261, 264,
-	-204, -60, 204, 68, -210, -204, 127, -160, 74, -167,
-	-159, -126, 9, 215, 89, 151, -166, 5, 252, -158,
-	-165, 6, 8, 251, -160, 74, 59, -168, 6, 4,
-	-150, -126, 74, 130, 117, 262, -163, 4, -169, -171,
-	-170, -172, 18, 20, 21, 22, 23, 24, 25, 26,
-	27, 36, 40, 41, 44, 46, 48, 54, 56, 60,
-	61, 62, 63, 64, 65, 74, 75, 77, 78, 79,
-	82, 83, 85, 89, 90, 95, 96, 97, 99, 102,
-	104, 108, 109, 110, 116, 118, 123, 124, 129, 131,
-	132, 142, 144, 150, 151, 152, 153, 154, 163, 167,
-	173, 177, 187, 190, 197, 203, 204, 207, 210, 211,
-	215, 220, 221, 224, 225, 231, 233, 234, 235, 236,
-	-162, -207, 95, -204, -162, -162, 127, -35, 264, 260,
-	142, -39, 106, -38, 142, -74, -92, -91, -93, -106,
-	18, -106, -108, -26, -26, -26, -53, -129, -106, -188,
-	25, -55, -38, -58, 97, 264, 10, 46, 28, 251,
-	252, 253, 254, 255, 248, 249, 250, 247, 243, 244,
-	245, 52, 133, 184, 12, 13, 14, 22, 153, 128,
-	241, 193, 119, 30, 108, 25, 4, -106, -106, -106,
-	-106, -106, 159, -25, -106, -63, -70, -25, -114, 258,
-	260, -70, 260, 6, 6, 260, -121, -106, -192, 237,
-	95, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 166, -157, 232, -157,
-	-157, -143, 260, -143, -144, 260, -143, 260, -58, -42,
-	-105, -163, 253, -163, -106, 264, 261, 264, 210, -89,
-	54, 48, -102, 106, 48, -173, -38, 54, -174, 44,
-	221, 167, 96, -89, 54, -89, 54, 54, -133, 210,
-	210, -42, -104, 234, -95, -18, 260, 74, 25, -67,
-	-68, -136, -69, -42, 260, -38, -38, -42, -60, -61,
-	-62, -12, -137, 210, -60, -55, 97, -44, 169, 175,
-	196, 188, 264, 5, 8, 8, 6, -163, -206, -38,
-	-133, -46, -47, -103, -102, -175, -173, 109, 221, 86,
-	151, 142, 86, -94, 182, 183, 264, -31, 26, 77,
-	260, 264, 261, -104, -59, -131, -133, -25, -132, 260,
-	-135, -139, -140, -142, -151, -145, -149, -150, 33, 38,
-	206, 200, 113, 114, 115, 195, 31, 170, 93, 80,
-	73, 72, 147, 35, 34, -153, -154, -147, -148, 70,
-	209, 32, 43, 42, 230, -61, 208, -106, -106, -106,
-	-106, -106, -106, -106, -106, -106, -106, -106, -106, -106,
-	-106, -106, -106, -106, -106, -106, -106, -106, 128, 193,
-	30, 108, 210, 144, 142, 215, 89, 222, 78, 148,
-	-214, 203, 27, -112, -25, 260, -163, -117, 182, 260,
-	261, 264, -63, -116, 259, -106, -114, -63, 261, 261,
-	-63, 231, 18, 78, 253, -85, 239, 136, 71, 105,
-	135, -86, 186, 8, -124, -123, 233, -193, 91, 102,
-	260, 261, 261, -106, -80, -156, 4, 239, 136, 71,
-	105, 135, 186, -81, -106, -82, -107, -108, 251, 252,
-	257, 260, 182, -83, -106, -63, -106, 36, 124, 211,
-	-84, -106, 97, -63, -106, -106, -106, -63, -63, -63,
-	260, 8, 8, 8, -104, 261, 259, 266, -125, -32,
-	-42, -38, -38, 142, -102, 106, -139, -38, 260, 260,
-	122, 122, -38, -38, 106, -38, 106, -38, -38, -33,
-	174, -38, -38, 174, -106, -97, 151, -60, 229, -38,
-	-58, 264, 245, -60, -35, -206, -206, 219, 51, 169,
-	-167, -85, 264, 261, 264, -39, 109, -61, -42, 86,
-	-38, -129, -15, -18, -14, -23, -9, -38, -73, 102,
-	264, 57, -79, 121, 139, 98, 126, 179, 111, -128,
-	-127, 25, -38, -128, -25, -132, -131, -57, 24, -85,
-	260, 240, -106, 210, -214, 203, -112, -106, 144, 215,
-	89, 222, 78, 148, 97, 260, -107, -107, -63, 260,
-	-63, -106, 264, 259, 259, 264, 261, -52, 264, -51,
-	-106, -63, -63, 261, 210, 210, 210, 210, 260, 261,
-	-122, -123, 82, -106, -198, 158, 260, 260, -106, 25,
-	261, 97, 261, -87, 163, 261, 10, 251, 252, 253,
-	254, 255, 248, 249, 250, 247, 243, 244, 245, 52,
-	133, 184, 12, 13, 14, 119, 108, -107, -107, -107,
-	-63, 260, 261, -110, -111, 97, 95, 25, -84, -84,
-	-84, 261, 97, -63, 264, 264, 264, 261, 261, 261,
-	8, 261, 264, 261, 261, -73, -106, 210, 210, 86,
-	142, -176, -174, -106, -55, 260, 260, -29, 81, 191,
-	-90, 86, -35, 86, -35, 210, -89, 54, 210, 53,
-	261, -104, -68, -125, 261, -38, -103, 260, -39, 260,
-	260, -38, 261, -113, 104, 37, -131, 121, 121, -131,
-	-79, 121, -77, 157, -77, -77, -38, 260, 261, 258,
-	258, 8, -106, -106, -107, -107, 97, 260, -106, -115,
-	-139, 22, 22, 261, -63, 261, 264, 261, -106, -114,
-	261, 231, -52, -52, -52, 136, 105, 135, -86, 135,
-	-86, -86, 8, 6, 83, -106, 207, -199, -38, 260,
-	234, -51, 261, -139, -106, -110, -106, -139, -107, -107,
-	-107, -107, -107, -107, -107, -107, -107, -107, -107, -107,
-	-107, -107, -107, -107, -107, -107, 78, 142, 148, -107,
-	264, -63, 261, -111, -110, -106, -106, -139, 261, 261,
-	261, -63, -106, -106, -106, 261, 8, -113, 259, -38,
-	-38, -102, 86, -177, 54, -178, 46, 142, 144, 221,
-	167, 44, 74, 173, 261, 261, -55, -55, 142, 74,
-	142, 74, 67, 217, -38, -38, -42, -38, -38, -38,
-	-96, 260, 151, -18, 245, -55, 260, -46, -54, -130,
-	-38, -187, 260, -184, -185, -40, 151, -194, 235, -106,
-	-63, -131, -131, -78, 225, 151, 121, -131, 260, -55,
-	-127, 259, 8, 8, 261, 22, 22, -106, -115, 261,
-	264, -106, -106, 261, -106, 6, -106, 261, 261, 261,
-	261, -106, -203, -38, -106, 261, 261, -111, 97, 78,
-	148, 260, -106, 261, 261, 264, 261, 261, 261, -194,
-	-102, -38, -61, 144, 122, 260, -107, -42, -101, -211,
-	55, 201, 261, 261, 144, 144, -106, -139, -35, -35,
-	210, 210, 79, -54, 54, -72, -25, 260, 261, -55,
-	261, 261, 264, -41, -70, 46, -41, -106, 260, -42,
-	-195, -197, -38, -78, 260, -106, -131, -55, 261, 259,
-	259, -106, -106, 261, -139, 261, -52, -196, 162, 261,
-	-107, 97, 260, -115, 261, -106, -178, -106, -50, 260,
-	260, 173, -34, 46, -38, -38, 223, 143, 261, -38,
-	-101, 261, -101, -130, -31, -61, -31, 261, -63, 260,
-	264, 25, -55, 261, 261, -52, 37, -107, -115, 261,
-	261, 261, -181, 134, -55, -55, -42, -30, 225, -61,
-	191, -104, -101, -41, -52, -54, -197, -199, 261, -200,
-	168, 183, -63, 261, -179, -182, -180, 151, 98, 161,
-	194, 261, 261, -50, -106, -67, -31, 261, 261, 261,
-	-201, -202, 30, 218, 59, -106, -201, -180, 151, -182,
-	151, 223, 76, -181, -104, -101, -202, 165, 94, 182,
-	165, 94, -183, 141, 176, 39, 191, -183, -179, 22,
-	16, 144, 74, -202,
+	-1000, -1, -2, -3, -4, -5, -6, -7, -8, -9,
+	-11, -16, -17, -18, -20, -21, -22, -24, -25, -10,
+	-26, -27, -28, -29, -30, -31, -32, -33, 19, 29,
+	40, -12, -13, -14, -15, -206, 86, 92, 104, 113,
+	186, 189, 190, 193, -34, -35, 206, 207, 30, 52,
+	195, 235, 60, -205, -37, -36, 281, 256, 262, 202,
+	-38, 222, 249, 284, 107, 243, 222, 73, 117, -220,
+	229, -72, 222, 73, 18, -53, -49, 4, -185, -187,
+	16, 17, 19, 28, 29, 30, 34, 38, 40, 41,
+	51, 52, 53, 55, 56, 57, 59, 62, 63, 64,
+	71, 72, 74, 76, 81, 85, 86, 92, 96, 97,
+	99, 105, 110, 113, 114, 116, 120, 128, 130, 135,
+	138, 142, 143, 144, 145, 146, 148, 149, 151, 154,
+	156, 157, 163, 166, 169, 170, 171, 174, 177, 178,
+	179, 180, 181, 183, 184, 186, 187, 188, 189, 190,
+	191, 193, 195, 196, 198, 200, 201, 203, 204, 206,
+	207, 209, 211, 213, 214, 215, 218, 216, 221, 223,
+	224, 228, 231, 235, 236, 237, 238, 241, 242, 244,
+	246, 247, 248, 252, 257, 258, 259, 260, 31, 32,
+	33, 35, 36, 39, 44, 45, 47, 75, 77, 78,
+	91, 93, 98, 106, 108, 111, 112, 121, 122, 123,
+	125, 133, 153, 155, 164, 168, 173, 175, 182, 197,
+	210, 217, 219, 226, 227, 232, 233, 249, 250, 179,
+	81, 120, 242, 73, 107, 243, 117, 222, -19, 21,
+	281, -28, -22, -33, -16, -224, 18, -225, -226, 60,
+	86, 104, 202, 120, 81, 242, 222, 177, 214, -220,
+	102, -224, -115, 138, 204, 231, -116, -114, -178, 226,
+	146, -73, -49, 73, 4, 73, 51, 74, 105, 114,
+	117, 177, 178, 244, 214, 222, 223, 226, 231, 260,
+	18, -229, 231, -229, -229, -228, 222, 222, -104, 73,
+	243, 240, -36, -37, -35, -62, -63, 239, 124, 90,
+	162, -34, -35, -205, -207, 183, -204, -49, -207, -59,
+	-60, 18, 83, 285, -149, -53, 160, -88, 281, -3,
+	-49, -49, -53, -149, 111, -49, -53, 111, 229, -122,
+	-124, 272, 273, 278, 150, 261, -133, -53, -125, 9,
+	281, -136, -202, -35, 91, 24, -134, -135, 197, -49,
+	8, 5, 6, 7, -51, -157, -166, 234, 94, 152,
+	42, -200, -201, 4, -185, -180, -158, -168, -162, -165,
+	125, 49, 66, 69, 67, 70, 205, 243, 43, 93,
+	168, 173, 219, 232, 233, 111, 153, 112, 47, 106,
+	133, 85, 32, 33, 35, 36, 44, 45, 75, 77,
+	78, 98, 121, 122, 123, 155, 182, 210, 227, 250,
+	-186, -169, -170, -163, -164, 61, 103, 119, 127, 129,
+	134, 136, 147, 165, 167, 194, 208, -171, 285, -72,
+	-66, -49, 223, -86, -121, 283, 286, 279, -122, 102,
+	126, -150, -149, -49, 111, -49, 111, -66, 111, -72,
+	111, -74, 111, -73, -19, -154, -153, -181, 21, 4,
+	-185, -187, -186, 249, 49, 159, 285, 281, -73, 227,
+	215, 102, -75, -122, 159, -115, -115, -48, 128, 229,
+	266, 102, 260, -56, 6, 79, -80, 283, 102, -221,
+	159, 102, 228, 215, 215, -177, 102, 260, 128, 55,
+	-47, -48, -91, -149, -73, 111, 117, -49, 111, -49,
+	111, -62, -63, -90, -107, -108, 137, 158, -92, 18,
+	83, -92, -92, 38, 282, 282, 285, -207, -67, 281,
+	-82, -81, -151, -122, 274, -81, -88, 274, -53, 281,
+	-87, -141, -122, 79, 17, 86, 256, 56, -44, 187,
+	-43, 17, 19, 86, 247, 91, 56, 187, 187, 91,
+	-122, -71, 10, 48, 28, 272, 273, 274, 275, 276,
+	269, 270, 271, 268, 264, 265, 266, 54, 141, 199,
+	12, 13, 14, 22, 161, 136, 261, 208, 127, 31,
+	115, 263, -122, -122, -122, -122, -122, 167, -35, -122,
+	-75, -86, -35, -130, 279, 281, -86, 281, 6, 6,
+	281, -137, -122, -208, 257, 100, 281, 281, 281, 281,
+	281, 281, 281, 281, 281, 281, 281, 281, 281, 281,
+	281, 175, -173, 252, -173, -173, -159, 281, -159, -160,
+	281, -159, 281, -53, 285, 115, -121, -179, 274, 4,
+	-185, -187, -186, -188, 18, 20, 21, 22, 23, 24,
+	25, 26, 27, 37, 42, 43, 46, 48, 50, 58,
+	60, 65, 66, 67, 68, 69, 70, 79, 80, 82,
+	83, 84, 87, 88, 90, 94, 95, 100, 101, 102,
+	104, 107, 109, 115, 117, 118, 124, 126, 131, 132,
+	137, 139, 140, 150, 152, 158, 159, 160, 161, 162,
+	172, 176, 185, 192, 202, 205, 212, 220, 222, 225,
+	229, 230, 234, 239, 240, 243, 245, 251, 253, 254,
+	255, 256, -179, -122, -150, -54, -53, 206, -49, 25,
+	91, 91, 91, -46, 41, 191, 91, 285, 91, 282,
+	285, -220, -226, -66, 281, -122, -75, 285, -220, 135,
+	-176, 79, -183, -175, -142, 9, 234, 94, 159, -182,
+	5, 273, -174, -181, 6, 8, 272, -176, 79, 64,
+	-184, 6, 4, -166, -142, 79, 138, 125, 283, -179,
+	-178, -223, 100, -220, -178, 102, -178, 135, 100, -46,
+	285, 281, 150, -50, 111, -49, 150, -40, 256, 150,
+	-90, -108, -107, -109, -122, 18, -122, -124, -36, -36,
+	-36, -64, -145, -122, -204, 25, -66, -69, 102, 285,
+	25, 4, -69, -53, 285, 282, 243, 243, 171, 260,
+	285, 229, -105, 58, 50, -118, 111, 50, -189, -49,
+	58, -190, 46, 240, 176, 101, -105, 58, -105, 58,
+	58, -149, 260, 229, 229, -53, -71, -76, 116, -155,
+	-156, -158, -167, -161, -165, -166, 34, 39, 224, 217,
+	121, 122, 123, 210, 32, 182, 98, 85, 78, 77,
+	155, 36, 35, -169, -170, -163, -164, 75, 227, 33,
+	45, 44, 250, -73, 226, -122, -122, -122, -122, -122,
+	-122, -122, -122, -122, -122, -122, -122, -122, -122, -122,
+	-122, -122, -122, -122, -122, -122, 136, 208, 31, 115,
+	229, 152, 150, 234, 94, 241, 83, 156, -230, 220,
+	27, -128, -35, 281, 156, -133, 197, 281, 282, 285,
+	-75, -132, 280, -130, -75, 282, 282, -75, 251, 18,
+	83, 274, -101, 259, 144, 76, 110, 143, -102, 201,
+	8, -140, -139, 253, -209, 96, 107, 281, 282, 282,
+	-122, -96, -172, 4, 259, 144, 76, 110, 143, 201,
+	-97, -122, -98, -123, -124, 272, 273, 278, 281, 197,
+	-99, -122, -75, -122, 37, 132, 230, -100, -122, 102,
+	-75, -122, -122, -122, -75, -75, -75, 281, 8, 8,
+	8, -49, 73, 280, 287, -120, 254, -111, -28, 281,
+	79, 25, -83, -84, -152, -85, -53, 281, -49, -49,
+	-49, -66, -72, -73, -74, -19, -153, 229, 281, 282,
+	-57, -58, -119, -118, -191, -189, 117, 240, -122, 102,
+	-55, 181, 188, 211, 203, 285, 5, 8, 8, 6,
+	-179, -222, -49, -178, 222, 73, -149, -57, 91, 159,
+	150, 91, 171, 91, -110, 197, 198, 285, -42, 26,
+	82, 281, 282, -71, -70, -147, -149, -35, -148, 281,
+	-151, -179, -71, 282, -141, -66, -66, -122, -122, -43,
+	-53, -49, -49, 150, -118, 111, -155, -49, 281, 281,
+	130, 130, -49, -49, 111, -49, 111, -49, -49, -44,
+	187, -122, -49, -49, 187, -76, 102, -68, 24, -101,
+	281, 260, -122, 229, -230, 220, -128, -122, 152, 234,
+	94, 241, 83, 156, 102, 281, -123, -123, -75, 221,
+	281, -75, -122, 280, 280, 285, 282, -63, 285, -62,
+	-122, -75, -75, 282, 229, 229, 229, 229, 281, 282,
+	-138, -139, 87, -122, -214, 166, 281, 281, -122, 25,
+	282, 102, 282, -103, 172, 282, 10, 272, 273, 274,
+	275, 276, 269, 270, 271, 268, 264, 265, 266, 54,
+	141, 199, 12, 13, 14, 127, 115, -123, -123, -123,
+	-75, 281, 282, -126, -127, 102, 100, 25, -100, -100,
+	-100, 282, 102, -75, 285, 285, 285, 282, 282, 282,
+	8, 282, 285, 282, 282, -66, -122, -122, -113, 159,
+	-72, 249, -49, -69, 285, 266, -72, -46, -222, -66,
+	282, 285, -50, 117, -222, 238, 53, 181, -183, -101,
+	285, -53, -49, 282, -73, -53, 91, -49, -122, -49,
+	-145, -23, -28, -22, -33, -16, -120, 285, 61, -95,
+	129, 147, 103, 134, 194, 119, -144, -143, 25, -49,
+	-144, -35, -148, -147, -120, 229, 229, 91, 150, -192,
+	-190, -122, -66, 281, 281, -39, 86, 206, -106, 91,
+	-46, 91, -46, 229, -105, 58, 229, -75, 279, 279,
+	8, -122, -122, -123, -123, 102, 281, -122, -131, -155,
+	22, 22, 282, 226, -75, 282, 285, 282, -130, 282,
+	251, -63, -63, -63, 144, 110, 143, -102, 143, -102,
+	-102, 8, 6, 88, -122, 225, -215, -49, 281, 254,
+	-62, 282, -155, -122, -126, -122, -155, -123, -123, -123,
+	-123, -123, -123, -123, -123, -123, -123, -123, -123, -123,
+	-123, -123, -123, -123, -123, 83, 150, 156, -123, 285,
+	-75, 282, -127, -126, -122, -122, -155, 282, 282, 282,
+	-75, -122, -122, -122, 282, 8, 280, 57, 282, -120,
+	-84, -141, 282, 282, 62, -119, 281, -50, -49, 281,
+	281, -49, -40, 282, -89, 107, -147, 129, 129, -147,
+	-95, 129, -93, 165, -93, -93, -49, 281, 282, -89,
+	-49, -49, -118, 91, -193, 58, -194, 48, 150, 152,
+	240, 176, 46, 79, 185, 282, 282, -66, -66, 150,
+	79, 150, 79, 72, 236, -49, -49, -53, -49, -49,
+	-49, 280, 8, 8, 282, 22, 22, -122, -131, 282,
+	285, -122, -122, -122, 282, -122, 6, -122, 282, 282,
+	282, 282, -122, -219, -49, -122, 282, 282, -127, 102,
+	83, 156, 281, -122, 282, 282, 285, 282, 282, 282,
+	-112, 281, 159, -28, 266, 72, -66, 281, -57, -65,
+	-146, -49, -203, 281, -200, -201, -51, 159, -129, 109,
+	38, -147, -147, -94, 245, 159, 129, -147, 281, -66,
+	-143, -129, -118, -49, -73, 152, 130, 281, -123, -53,
+	-117, -227, 59, 218, 282, 282, 152, 152, -122, -155,
+	-46, -46, 229, 229, 280, 280, -122, -122, 282, -155,
+	282, -63, -212, 170, 282, -123, 102, 281, -131, 282,
+	-122, 84, -65, 58, -88, -35, 281, 281, 282, -66,
+	282, 282, 285, -52, -86, 48, -52, -122, 281, -53,
+	-210, 255, -122, -75, -94, 281, -122, -147, -66, 282,
+	-210, -194, -122, -61, 281, 281, 185, -45, 48, -49,
+	-49, 282, -63, 38, -123, -131, 282, 282, 242, 151,
+	282, -49, -75, -117, 282, -117, -146, -42, -73, -42,
+	282, -75, 281, -211, -213, -49, -66, 282, 282, -197,
+	142, -66, -66, -53, -41, 245, -73, -216, 180, 198,
+	-75, 282, 206, -120, 282, -117, -52, -63, -65, 285,
+	25, 282, -195, -198, -196, 159, 103, 169, 209, 282,
+	282, -61, -122, 282, -217, -218, 31, 237, 64, -122,
+	-217, -83, -78, 256, -42, 282, 282, -213, -215, -196,
+	159, -198, 159, 242, 81, -197, -218, 174, 99, 197,
+	174, 99, -120, -79, -77, -49, -117, -199, 149, 191,
+	41, 206, -199, -195, 22, 285, 266, 16, 152, 79,
+	-218, -77, -122,
 }
 var sqlDef = [...]int{
 
 	-2, -2, 1, 3, 4, 5, 6, 7, 8, 9,
-	10, 11, 12, 13, 14, 15, 16, 17, 18, 0,
-	48, 49, 50, 0, 0, 289, 0, 0, 259, -2,
-	0, 0, 233, 233, 233, 291, 205, 288, -2, 299,
-	0, 0, 0, 297, 273, 0, 0, -2, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 64, 0, 66,
-	67, 68, 69, 0, 78, 79, 80, 82, 83, 84,
-	85, 86, 87, 88, 0, 91, 733, 764, 774, 95,
-	100, 0, 824, -2, 104, 60, 683, 684, 685, 700,
-	701, 702, 703, 704, 705, 706, 707, 708, 709, 710,
-	711, 712, 713, 714, 715, 716, 717, 718, 719, 720,
-	721, 722, 723, 724, 725, 726, 727, 728, 729, 730,
-	731, 732, 734, 735, 736, 737, 739, 740, 741, 742,
-	743, 744, 745, 746, 747, 748, 749, 750, 751, 752,
+	10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
+	20, 21, 22, 23, 24, 25, 26, 27, 0, 0,
+	0, 63, 64, 65, 66, 0, 0, 340, 0, 0,
+	0, 0, 0, 0, 310, -2, 0, 0, 280, 280,
+	280, 342, 250, 339, -2, 350, 0, 0, 0, 348,
+	324, 0, 0, -2, 0, 0, 0, 0, 0, 0,
+	0, 109, 0, 775, 0, 712, 714, 736, 737, 738,
 	753, 754, 755, 756, 757, 758, 759, 760, 761, 762,
-	763, 765, 766, 767, 768, 769, 770, 771, 772, 773,
-	775, 776, 777, 778, 779, 780, 781, 782, 783, 784,
-	785, 786, 787, 788, 789, 790, 791, 792, 793, 794,
-	795, 796, 797, 798, 799, 800, 801, 802, 803, 804,
-	805, 806, 807, 808, 809, 810, 811, 812, 813, 814,
-	815, 816, 817, 818, 819, 820, 821, 822, 823, 825,
-	826, 827, 828, 829, 130, 131, 0, 133, 143, 0,
-	141, 0, 0, 139, 235, 232, 230, 231, 0, 290,
-	0, 0, 0, 204, -2, 269, 270, -2, 0, 294,
-	294, 294, 0, 0, 270, 0, 278, 752, 281, 666,
-	733, 738, 764, 774, 824, 279, 652, 0, 296, 295,
-	0, 274, 349, 0, 661, 319, 0, 2, 0, 806,
-	0, 0, 806, 0, 0, 0, 355, 52, 806, 43,
-	806, 659, 56, 806, 58, 0, 70, 72, 691, 692,
-	693, 694, 828, 830, 831, 832, 833, 834, 835, 836,
-	837, 838, 839, 840, 841, 842, 0, 0, 0, 92,
-	93, 94, 0, 0, 0, 0, 0, 103, 125, 126,
-	61, 0, 0, 145, 0, 0, 136, 0, 137, 0,
-	229, 234, 43, 353, 0, 806, 687, 237, 806, -2,
-	0, 265, 306, 307, 0, 0, 0, 292, 293, 0,
-	0, 0, 261, 262, 0, 280, 0, 0, 322, 651,
-	653, 657, 658, 434, 0, 0, 0, 0, 0, 0,
-	513, 514, 515, 0, 517, 518, 519, 801, 0, 523,
-	524, 820, 661, 669, 670, 671, 672, 0, 0, 0,
-	677, 678, 679, 636, 562, 533, -2, -2, 667, 376,
-	377, 378, 379, -2, 830, 537, 539, 541, 542, 543,
-	544, 0, 802, 816, 817, 823, 826, 827, 806, 813,
-	807, 797, 804, 812, 721, -2, -2, -2, -2, -2,
+	763, 764, 765, 766, 767, 768, 769, 770, 771, 772,
+	773, 774, 776, 777, 778, 779, 780, 781, 782, 783,
+	784, 785, 786, 787, 788, 789, 790, 791, 792, 793,
+	794, 795, 796, 797, 798, 799, 800, 801, 802, 803,
+	804, 805, 806, 807, 808, 809, 810, 811, 812, 813,
+	814, 815, 816, 817, 818, 819, 820, 821, 822, 823,
+	824, 825, 826, 827, 828, 829, 830, 831, 832, 833,
+	834, 835, 836, 837, 838, 839, 840, 841, 842, 843,
+	844, 845, 846, 847, 848, 849, 850, 851, 852, 853,
+	854, 855, 856, 857, 858, 859, 860, 861, 862, 863,
+	864, 865, 866, 867, 868, 869, 870, 871, 872, 873,
+	874, 875, 876, 877, 878, 879, 880, 881, 882, 883,
+	884, 885, 886, 887, 888, 889, 890, 891, 892, 893,
+	894, 895, 896, 897, 898, 899, 900, 901, 902, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 84, 340,
+	0, 87, 88, 89, 90, 0, 113, 114, 115, 119,
+	120, 121, 122, 123, 124, 125, 0, 0, 0, 0,
+	0, 0, 128, 794, 832, 846, 132, 137, 0, 897,
+	-2, 141, 80, 775, 167, 168, 0, 170, 188, 172,
+	0, 0, 175, 176, 0, 0, 186, 0, 0, 0,
+	184, 282, 279, 277, 278, 0, 341, 0, 0, 0,
+	0, 249, -2, 320, 321, -2, 0, 345, 345, 345,
+	0, 0, 321, 0, 329, 817, 332, 719, 330, 705,
+	0, 347, 346, 0, 325, 402, 0, 370, 0, 2,
+	0, 0, 402, 0, 879, 0, 0, 879, 0, 375,
+	487, 0, 0, 0, 0, 0, 0, 566, 567, 568,
+	0, 570, 571, 572, 874, 0, 576, 577, 893, 714,
+	722, 723, 724, 725, 0, 0, 0, 730, 731, 732,
+	689, 615, 586, -2, -2, 720, 429, 430, 431, 432,
+	-2, 903, 590, 592, 594, 595, 596, 597, 0, 875,
+	889, 890, 896, 899, 900, 879, 886, 880, 870, 877,
+	885, 779, -2, -2, -2, -2, -2, -2, -2, -2,
 	-2, -2, -2, -2, -2, -2, -2, -2, -2, -2,
-	-2, -2, -2, 690, 400, 401, 406, 407, 409, 322,
-	320, 350, 351, 0, 662, 642, 0, 0, 0, 0,
-	648, 646, 647, 20, 226, 22, 0, 226, 226, 0,
-	0, 0, 0, 0, 359, 0, 240, 0, 356, 0,
-	0, 54, 0, 41, 42, 0, 0, 0, 289, 0,
-	0, 75, 0, 717, 81, 0, 0, 96, 98, 105,
-	107, 108, 109, 115, 116, 117, 118, 198, 0, 200,
-	128, 129, 680, 0, 97, 99, 101, 102, 119, 120,
-	0, 122, 123, 124, 417, 0, 62, 695, 696, 697,
-	698, 699, 843, 844, 845, 846, 847, 848, 849, 850,
-	851, 852, 853, 854, 855, 856, 857, 858, 859, 860,
-	861, 862, 863, 864, 865, 866, 867, 868, 869, 870,
-	871, 872, 873, 874, 875, 876, 877, 878, 879, 880,
-	881, 882, 883, 884, 885, 886, 887, 888, 889, 890,
-	891, 892, 893, 894, 895, 896, 897, 898, 899, 900,
-	901, 902, 903, 904, 905, 906, 907, 908, 909, 910,
-	911, 912, 913, 914, 915, 916, 917, 918, 919, 920,
-	132, 134, 0, 142, 135, 140, 138, 201, 0, 149,
-	0, 0, 806, 686, 0, 268, 304, 305, 308, 311,
-	312, 309, 434, 275, 276, 277, 300, 301, 215, 282,
-	0, 0, 663, 359, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	743, 453, 454, 459, 460, 904, 905, 906, 907, 908,
+	909, 910, 911, 912, 913, 914, 915, 462, 0, 110,
+	111, 716, 0, 715, 695, 0, 0, 0, 62, 0,
+	0, 0, 408, 68, 879, 70, 879, 72, 879, 57,
+	879, 76, 879, 78, 85, 0, 91, 93, 94, 744,
+	745, 746, 747, 901, 903, 0, 0, 0, 0, 0,
+	274, 0, 100, 647, 0, 129, 130, 131, 0, 0,
+	0, 0, 0, 140, 162, 163, 81, 0, 0, 190,
+	0, 0, 174, 177, 0, 179, 0, 180, 0, 0,
+	276, 281, 57, 406, 0, 879, 740, 284, 879, 289,
+	879, -2, 0, 316, 357, 358, 0, 0, 0, 343,
+	344, 0, 0, 0, 312, 313, 0, 331, 0, 0,
+	373, 704, 706, 710, 711, 373, 371, 403, 404, 0,
+	0, 701, 699, 700, 0, 0, 0, 0, 34, 271,
+	36, 0, 271, 271, 0, 0, 0, 0, 0, 0,
+	375, 98, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 645, 0, 0, 656, 438, 439, 440,
-	461, 462, 0, -2, 594, 0, 520, 521, 522, 0,
-	0, -2, 0, 674, 431, 0, 0, 635, 564, 0,
-	0, 0, 0, 0, 0, 0, 615, 621, 0, 0,
-	0, 0, 0, 0, 0, 0, 390, 403, 413, 411,
-	410, 392, 0, 391, 389, 0, 393, 0, 359, 0,
-	643, 637, 638, 639, 0, 0, 650, 0, 0, 0,
-	0, 225, 24, 806, 0, 34, 0, 0, 171, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 698,
+	0, 0, 491, 492, 493, 514, 515, 0, -2, 647,
+	0, 573, 574, 575, 0, 0, -2, 0, 727, 484,
+	0, 0, 688, 617, 0, 0, 0, 0, 0, 0,
+	0, 668, 674, 0, 0, 0, 0, 0, 0, 0,
+	0, 443, 456, 466, 464, 463, 445, 0, 444, 442,
+	0, 446, 0, 713, 0, 0, 696, 690, 691, 748,
+	749, 750, 751, 752, 916, 917, 918, 919, 920, 921,
+	922, 923, 924, 925, 926, 927, 928, 929, 930, 931,
+	932, 933, 934, 935, 936, 937, 938, 939, 940, 941,
+	942, 943, 944, 945, 946, 947, 948, 949, 950, 951,
+	952, 953, 954, 955, 956, 957, 958, 959, 960, 961,
+	962, 963, 964, 965, 966, 967, 968, 969, 970, 971,
+	972, 973, 974, 975, 976, 977, 978, 979, 980, 981,
+	982, 983, 984, 985, 986, 987, 988, 989, 990, 991,
+	992, 993, 692, 0, 412, 0, 291, 0, 409, 0,
+	0, 0, 0, 74, 55, 56, 0, 0, 0, 340,
+	0, 0, 117, 0, 194, 275, 99, 0, 0, 0,
+	133, 135, 142, 144, 145, 146, 152, 153, 154, 155,
+	243, 0, 245, 165, 166, 733, 0, 134, 136, 138,
+	139, 156, 157, 0, 159, 160, 161, 470, 0, 82,
+	169, 171, 0, 187, 173, 0, 185, 181, 0, 246,
+	0, 194, 0, 0, 879, 739, 0, 286, 0, 0,
+	319, 355, 356, 359, 362, 363, 360, 487, 326, 327,
+	328, 351, 352, 260, 333, 0, 0, 375, 0, 0,
+	0, 709, 375, 0, 0, 703, 0, 0, 0, 0,
+	0, 0, 0, 0, 270, 38, 879, 0, 48, 0,
+	0, 216, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 98, 96, 0, 488,
+	418, 419, 420, 421, 422, 484, 425, 426, 427, 428,
+	436, 437, 438, 439, 440, 441, 450, 0, 435, 435,
+	435, 447, 448, 451, 452, 457, 458, 468, 469, 467,
+	467, 467, 465, 489, 0, 494, 495, 496, 497, 498,
+	499, 500, 501, 502, -2, -2, -2, 506, 507, 508,
+	-2, -2, -2, 512, 513, -2, 0, 0, 698, 0,
+	0, 520, 0, 523, 525, 527, 0, 0, 0, 0,
+	697, 537, 680, 0, 0, 522, 0, 0, 569, 0,
+	0, 0, 653, 654, 0, -2, 578, 350, 0, 0,
+	0, 0, 728, 471, 472, 473, 474, 475, 476, 485,
+	0, 687, 683, 0, 625, 0, 0, 0, 591, 593,
+	0, 0, 0, 657, 658, 659, 660, 661, 662, 663,
+	0, 0, 0, 0, 539, 0, 0, 0, 0, 893,
+	0, 647, 673, 0, 0, 0, 0, 0, 647, 0,
+	679, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 717, 0, 693, 0, 67, 0, 298, 293, 0,
+	0, 0, 373, 303, 305, 306, 0, 0, 410, 69,
+	71, 73, 57, 79, 77, 86, 92, 0, 0, 116,
+	0, 193, 195, 197, 198, 199, 740, 0, 648, 0,
+	283, 0, 0, 150, 151, 0, 244, 735, 734, 484,
+	83, 189, 126, 178, 0, 0, 407, 0, 0, 0,
+	0, 0, 0, 0, 361, 364, 365, 0, 354, 258,
+	259, 340, 718, 412, 372, 376, 393, 393, 380, 0,
+	707, 708, 412, 405, 702, 29, 30, 31, 32, 37,
+	262, 0, 0, 0, 40, 879, 202, 0, 0, 0,
+	0, 0, 273, 49, 879, 57, 879, 57, 50, 35,
+	271, 33, 261, 264, 0, 95, 0, 413, 415, 423,
+	0, 0, -2, 0, 0, 0, 538, -2, 521, 524,
+	526, 528, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 648, 651, 652, 0, -2, 0, 0, 349,
+	350, 350, 350, 584, 0, 0, 0, 0, 0, 0,
+	0, 684, 0, 0, 585, 0, 0, 0, 0, 0,
+	599, 0, 600, 0, 0, 601, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 51, 0, 247, 242, 0, 0, 0, 322,
-	252, 254, 255, 0, 0, 357, 53, 660, 43, 59,
-	57, 65, 71, 0, 76, 77, 0, 236, 0, 0,
-	113, 114, 0, 199, 682, 681, 431, 63, 144, 89,
-	354, 0, 148, 150, 152, 153, 154, 687, 0, 0,
-	0, 0, 0, 310, 313, 314, 0, 303, 213, 214,
-	289, 0, 665, 316, 321, 323, 340, 340, 327, 0,
-	654, 435, 365, 366, 367, 368, 369, 431, 372, 373,
-	374, 375, 383, 384, 385, 386, 387, 388, 397, 0,
-	382, 382, 382, 394, 395, 398, 399, 404, 405, 415,
-	416, 414, 414, 414, 412, 436, 0, 441, 442, 443,
-	444, 445, 446, 447, 448, 449, -2, -2, -2, 453,
-	454, 455, -2, -2, -2, 459, 460, -2, 0, 0,
-	645, 0, 0, 467, 0, 470, 472, 474, 0, 0,
-	0, 0, 644, 484, 627, 0, 655, 469, 0, 0,
-	516, 0, 0, 0, 600, 594, 601, 0, -2, 525,
-	299, 0, 0, 0, 0, 675, 418, 419, 420, 421,
-	422, 423, 432, 0, 634, 630, 0, 572, 0, 0,
-	0, 538, 540, 0, 0, 0, 604, 605, 606, 607,
-	608, 609, 610, 0, 0, 0, 0, 486, 0, 0,
-	0, 0, 820, 0, 594, 620, 0, 0, 0, 0,
-	0, 594, 0, 626, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 316, 352, 640, 0, 649, 23,
-	217, 0, 0, 0, 26, 806, 157, 0, 0, 0,
-	0, 0, 228, 35, 806, 43, 806, 43, 36, 21,
-	226, 216, 219, 0, 358, 239, 0, 0, 244, 241,
-	359, 0, 0, 0, 55, 73, 74, 110, 111, 112,
-	106, 121, 0, 146, 0, 0, 687, 0, 0, 0,
-	238, 302, 0, 284, 285, 286, 287, 664, 318, 0,
-	0, 0, 0, 0, 0, 346, 346, 346, 344, 325,
-	339, 0, 338, 326, -2, 327, 0, 360, 362, 370,
-	0, 0, -2, 0, 0, 0, 485, -2, 468, 471,
-	473, 475, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 595, 0, 598, 599, 0, -2, 0, 0, 298,
-	299, 299, 299, 531, 0, 0, 0, 0, 0, 0,
-	0, 631, 0, 0, 532, 0, 0, 0, 0, 0,
-	546, 0, 547, 0, 0, 548, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 488, 489, 490,
-	0, 0, 549, 618, 619, 0, 0, 0, 0, 0,
-	0, 554, 0, 625, 0, 0, 0, 558, 559, 560,
-	0, 380, 0, 396, 408, 318, 0, 0, 0, 0,
-	0, 155, 170, 0, 0, 0, 0, 28, 0, 0,
-	0, 0, 32, 0, 38, 0, 0, 0, 0, 250,
-	0, 251, 253, 256, 0, 90, 151, 0, 0, 149,
-	0, 0, 283, 566, 0, 0, 324, 0, 0, 0,
-	0, 0, 341, 345, 342, 343, 336, 0, 329, 0,
-	0, 0, 437, -2, 0, 0, 0, 0, -2, 0,
-	596, 0, 0, 628, 0, 589, 0, -2, 595, 602,
-	526, 0, 0, 0, 0, 424, 425, 426, 427, 428,
-	429, 430, 0, 676, 629, 633, 0, 570, 571, 575,
-	0, 0, 536, 0, 603, 612, 613, 487, 491, 492,
-	493, 494, 495, 496, 497, 498, 499, -2, -2, -2,
-	503, 504, 505, -2, -2, -2, 0, 0, 0, 614,
-	0, 0, 592, 616, 617, 622, 623, 0, 551, 552,
-	553, 624, 0, 0, 0, 402, 0, 566, 641, 221,
-	223, 25, 0, 156, 0, 159, 0, 0, 162, 163,
-	0, 0, 0, 0, 172, 179, 0, 0, 0, 40,
-	0, 0, 227, 0, 43, 43, 218, 0, 0, 220,
-	0, 0, 0, 243, 0, 0, 0, 0, 0, 206,
-	212, 212, 0, 534, 535, 0, 0, 271, 0, 317,
-	315, 330, 0, 332, 0, 0, 0, 334, 0, 0,
-	328, 363, 0, 0, 371, 0, 0, -2, 0, 478,
-	0, -2, -2, 588, 595, 673, 299, 527, 529, 530,
-	433, 632, 577, 574, 0, 561, 545, 611, 0, 0,
-	0, 0, 595, 591, 550, 0, 556, 557, 381, 272,
-	27, 0, 160, 161, 164, 0, 166, 181, 173, 0,
-	176, 177, 174, 0, 29, 30, 39, 45, 31, 37,
-	0, 0, 0, 0, 0, 257, 258, 0, 179, 0,
-	147, 179, 0, 215, 668, 0, 215, 0, 0, 0,
-	565, 567, 0, 331, 0, 348, 333, 0, 337, 364,
-	361, -2, -2, 479, 597, 590, 0, 299, 0, 563,
-	-2, 0, 0, 0, 593, 0, 158, 0, 185, 0,
-	0, 0, 47, 0, 222, 224, 0, 246, 359, 249,
-	168, 179, 202, 207, 208, 211, 209, 212, 299, 0,
-	0, 0, 0, 335, 528, 580, 0, -2, 0, 511,
-	555, 165, 190, 0, 0, 0, 181, 33, 0, 44,
-	0, 248, 169, 215, 0, 0, 568, 569, 347, 0,
-	0, 0, 576, 512, 167, 186, 187, 0, 182, 183,
-	184, 180, 178, 185, 46, 359, 210, 526, 179, 573,
-	578, 581, -2, 777, 714, 0, 579, 188, 0, 189,
-	0, 0, 0, 190, 245, 203, 0, 583, 584, 585,
-	586, 587, 191, 0, 194, 195, 0, 192, 175, 0,
-	193, 196, 197, 582,
+	0, 0, 0, 0, 0, 0, 0, 541, 542, 543,
+	0, 0, 602, 671, 672, 0, 0, 0, 0, 0,
+	0, 607, 0, 678, 0, 0, 0, 611, 612, 613,
+	0, 433, 0, 449, 461, 112, 0, 411, 290, 0,
+	0, 295, 292, 412, 0, 0, 0, 75, 107, 0,
+	0, 0, 0, 740, 108, 147, 148, 149, 143, 158,
+	0, 182, 183, 191, 0, 0, 0, 285, 288, 289,
+	353, 0, 335, 336, 337, 338, 367, 0, 0, 0,
+	0, 0, 399, 399, 399, 397, 378, 392, 0, 391,
+	379, -2, 380, 0, 367, 0, 0, 0, 0, 200,
+	215, 0, 0, 0, 0, 42, 0, 0, 0, 0,
+	46, 0, 52, 0, 0, 0, 0, 97, 0, 0,
+	0, 490, -2, 0, 0, 0, 0, -2, 0, 649,
+	0, 0, 681, 0, 0, 642, 0, -2, 655, 579,
+	0, 0, 0, 0, 477, 478, 479, 480, 481, 482,
+	483, 0, 729, 682, 686, 0, 623, 624, 628, 0,
+	0, 589, 0, 656, 665, 666, 540, 544, 545, 546,
+	547, 548, 549, 550, 551, 552, -2, -2, -2, 556,
+	557, 558, -2, -2, -2, 0, 0, 0, 667, 0,
+	0, 645, 669, 670, 675, 676, 0, 604, 605, 606,
+	677, 0, 0, 0, 455, 0, 694, 301, 0, 302,
+	304, 307, 0, 118, 0, 196, 0, 0, 127, 194,
+	0, 0, 287, 334, 369, 0, 377, 0, 0, 0,
+	0, 0, 394, 398, 395, 396, 389, 0, 382, 369,
+	266, 268, 39, 0, 201, 0, 204, 0, 0, 207,
+	208, 0, 0, 0, 0, 217, 224, 0, 0, 0,
+	54, 0, 0, 272, 0, 57, 57, 263, 0, 0,
+	265, 416, 0, 0, 424, 0, 0, -2, 0, 531,
+	0, -2, -2, 374, 641, 648, 726, 350, 580, 582,
+	583, 486, 685, 630, 627, 0, 614, 598, 664, 0,
+	0, 0, 0, 648, 644, 603, 0, 609, 610, 434,
+	0, 0, 0, 294, 0, 0, 0, 0, 0, 0,
+	251, 257, 257, 0, 587, 588, 0, 0, 619, 0,
+	0, 383, 0, 385, 0, 0, 0, 387, 0, 0,
+	381, 619, 41, 0, 205, 206, 209, 0, 211, 226,
+	218, 0, 221, 222, 219, 0, 43, 44, 53, 59,
+	45, 51, 0, 0, 417, 414, -2, -2, 532, 650,
+	643, 0, 350, 0, 616, -2, 0, 0, 0, 646,
+	0, 0, 0, 0, 308, 309, 0, 0, 224, 0,
+	192, 224, 0, 260, 721, 0, 260, 0, 0, 0,
+	322, 0, 368, 366, 384, 0, 401, 386, 0, 390,
+	323, 203, 0, 230, 0, 0, 0, 61, 0, 267,
+	269, 581, 633, 0, -2, 0, 564, 608, 0, 297,
+	412, 300, 0, 213, 224, 247, 252, 253, 256, 254,
+	257, 350, 0, 618, 620, 0, 0, 388, 210, 235,
+	0, 0, 0, 226, 47, 0, 58, 0, 0, 0,
+	629, 565, 0, 299, 103, 214, 260, 0, 0, 0,
+	0, 400, 212, 231, 232, 0, 227, 228, 229, 225,
+	223, 230, 60, 626, 631, 634, -2, 849, 772, 0,
+	632, 412, 101, 0, 255, 579, 224, 621, 622, 233,
+	0, 234, 0, 0, 0, 235, 0, 636, 637, 638,
+	639, 640, 296, 102, 104, 0, 248, 236, 0, 239,
+	240, 0, 237, 220, 0, 0, 0, 238, 241, 242,
+	635, 105, 106,
 }
 var sqlTok1 = [...]int{
 
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 249, 3, 255, 250, 3,
-	260, 261, 253, 251, 264, 252, 262, 254, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 266, 263,
-	243, 245, 244, 3, 265, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 270, 3, 276, 271, 3,
+	281, 282, 274, 272, 285, 273, 283, 275, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 287, 284,
+	264, 266, 265, 3, 286, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 258, 3, 259, 248, 3, 3, 3, 3, 3,
+	3, 279, 3, 280, 269, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 247, 3, 257,
+	3, 3, 3, 3, 268, 3, 278,
 }
 var sqlTok2 = [...]int{
 
@@ -3472,10 +3843,12 @@ var sqlTok2 = [...]int{
 	212, 213, 214, 215, 216, 217, 218, 219, 220, 221,
 	222, 223, 224, 225, 226, 227, 228, 229, 230, 231,
 	232, 233, 234, 235, 236, 237, 238, 239, 240, 241,
-	242, 246, 256,
+	242, 243, 244, 245, 246, 247, 248, 249, 250, 251,
+	252, 253, 254, 255, 256, 257,
 }
 var sqlTok3 = [...]int{
-	0,
+	57600, 258, 57601, 259, 57602, 260, 57603, 261, 57604, 262,
+	57605, 263, 57606, 267, 57607, 277, 0,
 }
 
 var sqlErrorMessages = [...]struct {
@@ -3817,13 +4190,13 @@ sqldefault:
 
 	case 1:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:443
+		//line sql.y:465
 		{
 			sqllex.(*scanner).stmts = sqlDollar[1].stmts
 		}
 	case 2:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:449
+		//line sql.y:471
 		{
 			if sqlDollar[3].stmt != nil {
 				sqlVAL.stmts = append(sqlDollar[1].stmts, sqlDollar[3].stmt)
@@ -3831,7 +4204,7 @@ sqldefault:
 		}
 	case 3:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:455
+		//line sql.y:477
 		{
 			if sqlDollar[1].stmt != nil {
 				sqlVAL.stmts = []Statement{sqlDollar[1].stmt}
@@ -3839,549 +4212,714 @@ sqldefault:
 				sqlVAL.stmts = nil
 			}
 		}
-	case 13:
+	case 22:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:474
+		//line sql.y:505
 		{
 			sqlVAL.stmt = sqlDollar[1].selectStmt
 		}
-	case 19:
+	case 28:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:483
+		//line sql.y:514
 		{
 			sqlVAL.stmt = nil
 		}
-	case 20:
+	case 29:
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:521
+		{
+			sqlVAL.stmt = &AlterGroup{Name: Name(sqlDollar[3].str), Add: true, Members: NameList(sqlDollar[6].strs)}
+		}
+	case 30:
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:525
+		{
+			sqlVAL.stmt = &AlterGroup{Name: Name(sqlDollar[3].str), Add: false, Members: NameList(sqlDollar[6].strs)}
+		}
+	case 31:
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:532
+		{
+			sqlVAL.stmt = &AlterUser{Name: Name(sqlDollar[3].str), Password: sqlDollar[6].expr}
+		}
+	case 32:
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:540
+		{
+			sqlVAL.stmt = &ConfigureZone{ZoneSpecifier: ZoneSpecifier{Table: sqlDollar[3].qname}, YAMLConfig: sqlDollar[6].expr}
+		}
+	case 33:
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:544
+		{
+			sqlVAL.stmt = &ConfigureZone{ZoneSpecifier: ZoneSpecifier{Database: Name(sqlDollar[3].str)}, YAMLConfig: sqlDollar[6].expr}
+		}
+	case 34:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:489
+		//line sql.y:550
 		{
 			sqlVAL.stmt = &AlterTable{Table: sqlDollar[3].qname, IfExists: false, Cmds: sqlDollar[4].alterTableCmds}
 		}
-	case 21:
+	case 35:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:493
+		//line sql.y:554
 		{
 			sqlVAL.stmt = &AlterTable{Table: sqlDollar[5].qname, IfExists: true, Cmds: sqlDollar[6].alterTableCmds}
 		}
-	case 22:
+	case 36:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:499
+		//line sql.y:560
 		{
 			sqlVAL.alterTableCmds = AlterTableCmds{sqlDollar[1].alterTableCmd}
 		}
-	case 23:
+	case 37:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:503
+		//line sql.y:564
 		{
 			sqlVAL.alterTableCmds = append(sqlDollar[1].alterTableCmds, sqlDollar[3].alterTableCmd)
 		}
-	case 24:
+	case 38:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:510
+		//line sql.y:571
 		{
 			sqlVAL.alterTableCmd = &AlterTableAddColumn{columnKeyword: false, IfNotExists: false, ColumnDef: sqlDollar[2].colDef}
 		}
-	case 25:
+	case 39:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:515
+		//line sql.y:576
 		{
 			sqlVAL.alterTableCmd = &AlterTableAddColumn{columnKeyword: false, IfNotExists: true, ColumnDef: sqlDollar[5].colDef}
 		}
-	case 26:
+	case 40:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:520
+		//line sql.y:581
 		{
 			sqlVAL.alterTableCmd = &AlterTableAddColumn{columnKeyword: true, IfNotExists: false, ColumnDef: sqlDollar[3].colDef}
 		}
-	case 27:
+	case 41:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:525
+		//line sql.y:586
 		{
 			sqlVAL.alterTableCmd = &AlterTableAddColumn{columnKeyword: true, IfNotExists: true, ColumnDef: sqlDollar[6].colDef}
 		}
-	case 28:
+	case 42:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:529
+		//line sql.y:591
 		{
-			unimplemented()
+			sqlVAL.alterTableCmd = &AlterTableSetDefault{columnKeyword: sqlDollar[2].boolVal, Column: sqlDollar[3].str, Default: sqlDollar[4].expr}
 		}
-	case 29:
+	case 43:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:531
+		//line sql.y:596
 		{
-			unimplemented()
+			sqlVAL.alterTableCmd = &AlterTableDropNotNull{columnKeyword: sqlDollar[2].boolVal, Column: sqlDollar[3].str}
 		}
-	case 30:
+	case 44:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:533
+		//line sql.y:601
 		{
-			unimplemented()
+			sqlVAL.alterTableCmd = &AlterTableSetNotNull{columnKeyword: sqlDollar[2].boolVal, Column: sqlDollar[3].str}
 		}
-	case 31:
+	case 45:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:536
+		//line sql.y:606
 		{
 			sqlVAL.alterTableCmd = &AlterTableDropColumn{columnKeyword: sqlDollar[2].boolVal, IfExists: true, Column: sqlDollar[5].str}
 		}
-	case 32:
+	case 46:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:541
+		//line sql.y:611
 		{
 			sqlVAL.alterTableCmd = &AlterTableDropColumn{columnKeyword: sqlDollar[2].boolVal, IfExists: false, Column: sqlDollar[3].str}
 		}
-	case 33:
+	case 47:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:546
+		//line sql.y:616
 		{
 		}
-	case 34:
+	case 48:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:549
+		//line sql.y:619
 		{
 			sqlVAL.alterTableCmd = &AlterTableAddConstraint{ConstraintDef: sqlDollar[2].constraintDef}
 		}
-	case 35:
+	case 49:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:553
+		//line sql.y:623
 		{
 			unimplemented()
 		}
-	case 36:
+	case 50:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:555
+		//line sql.y:625
 		{
 			unimplemented()
 		}
-	case 37:
+	case 51:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:558
+		//line sql.y:628
 		{
 			sqlVAL.alterTableCmd = &AlterTableDropConstraint{IfExists: true, Constraint: sqlDollar[5].str}
 		}
-	case 38:
+	case 52:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:563
+		//line sql.y:633
 		{
 			sqlVAL.alterTableCmd = &AlterTableDropConstraint{IfExists: false, Constraint: sqlDollar[3].str}
 		}
-	case 39:
+	case 53:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:568
+		//line sql.y:639
 		{
-			unimplemented()
+			sqlVAL.expr = sqlDollar[3].expr
 		}
-	case 40:
+	case 54:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:569
+		//line sql.y:643
 		{
-			unimplemented()
+			sqlVAL.expr = nil
 		}
-	case 41:
+	case 55:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:572
+		//line sql.y:648
 		{
 			unimplemented()
 		}
-	case 42:
+	case 56:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:573
+		//line sql.y:649
 		{
 			unimplemented()
 		}
-	case 43:
+	case 57:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:574
+		//line sql.y:650
 		{
 		}
-	case 44:
+	case 58:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:577
+		//line sql.y:653
 		{
 			unimplemented()
 		}
-	case 45:
+	case 59:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:578
+		//line sql.y:654
 		{
 		}
-	case 46:
+	case 60:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:581
+		//line sql.y:657
 		{
 			unimplemented()
 		}
-	case 47:
+	case 61:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:582
+		//line sql.y:658
 		{
 		}
-	case 51:
+	case 62:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:663
+		{
+			sqlVAL.stmt = &CancelQuery{ID: sqlDollar[3].expr}
+		}
+	case 67:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:593
+		//line sql.y:677
 		{
 			sqlVAL.stmt = &Delete{Table: sqlDollar[4].tblExpr, Where: newWhere(astWhere, sqlDollar[5].expr)}
 		}
-	case 52:
+	case 68:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:600
+		//line sql.y:684
 		{
 			sqlVAL.stmt = &DropDatabase{Name: Name(sqlDollar[3].str), IfExists: false}
 		}
-	case 53:
+	case 69:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:604
+		//line sql.y:688
 		{
 			sqlVAL.stmt = &DropDatabase{Name: Name(sqlDollar[5].str), IfExists: true}
 		}
-	case 54:
+	case 70:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:692
+		{
+			sqlVAL.stmt = &DropGroup{Name: Name(sqlDollar[3].str), IfExists: false}
+		}
+	case 71:
+		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
+		//line sql.y:696
+		{
+			sqlVAL.stmt = &DropGroup{Name: Name(sqlDollar[5].str), IfExists: true}
+		}
+	case 72:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:700
+		{
+			sqlVAL.stmt = &DropUser{Names: NameList(sqlDollar[3].strs), IfExists: false}
+		}
+	case 73:
+		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
+		//line sql.y:704
+		{
+			sqlVAL.stmt = &DropUser{Names: NameList(sqlDollar[5].strs), IfExists: true}
+		}
+	case 74:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:608
+		//line sql.y:708
 		{
 			sqlVAL.stmt = &DropIndex{Names: sqlDollar[3].qnames, IfExists: false}
 		}
-	case 55:
+	case 75:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:612
+		//line sql.y:712
 		{
 			sqlVAL.stmt = &DropIndex{Names: sqlDollar[5].qnames, IfExists: true}
 		}
-	case 56:
+	case 76:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:616
+		//line sql.y:716
 		{
 			sqlVAL.stmt = &DropTable{Names: sqlDollar[3].qnames, IfExists: false}
 		}
-	case 57:
+	case 77:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:620
+		//line sql.y:720
 		{
 			sqlVAL.stmt = &DropTable{Names: sqlDollar[5].qnames, IfExists: true}
 		}
-	case 58:
+	case 78:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:626
+		//line sql.y:726
 		{
 			sqlVAL.qnames = QualifiedNames{sqlDollar[1].qname}
 		}
-	case 59:
+	case 79:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:630
+		//line sql.y:730
 		{
 			sqlVAL.qnames = append(sqlDollar[1].qnames, sqlDollar[3].qname)
 		}
-	case 60:
+	case 80:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:636
+		//line sql.y:736
 		{
 			sqlVAL.qname = &QualifiedName{Base: Name(sqlDollar[1].str)}
 		}
-	case 61:
+	case 81:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:640
+		//line sql.y:740
 		{
 			sqlVAL.qname = &QualifiedName{Base: Name(sqlDollar[1].str), Indirect: sqlDollar[2].indirect}
 		}
-	case 62:
+	case 82:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:646
+		//line sql.y:746
 		{
 			sqlVAL.indirect = Indirection{NameIndirection(sqlDollar[2].str)}
 		}
-	case 63:
+	case 83:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:650
+		//line sql.y:750
 		{
 			sqlVAL.indirect = append(sqlDollar[1].indirect, NameIndirection(sqlDollar[3].str))
 		}
-	case 64:
+	case 84:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:657
+		//line sql.y:757
 		{
 			sqlVAL.stmt = &Explain{Statement: sqlDollar[2].stmt}
 		}
-	case 65:
+	case 85:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:761
+		{
+			sqlVAL.stmt = &Explain{Options: []string{"ANALYZE"}, Statement: sqlDollar[3].stmt}
+		}
+	case 86:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:661
+		//line sql.y:765
 		{
 			sqlVAL.stmt = &Explain{Options: sqlDollar[3].strs, Statement: sqlDollar[5].stmt}
 		}
-	case 66:
+	case 87:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:667
+		//line sql.y:771
 		{
 			sqlVAL.stmt = sqlDollar[1].selectStmt
 		}
-	case 70:
+	case 91:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:676
+		//line sql.y:780
 		{
 			sqlVAL.strs = []string{sqlDollar[1].str}
 		}
-	case 71:
+	case 92:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:680
+		//line sql.y:784
 		{
 			sqlVAL.strs = append(sqlDollar[1].strs, sqlDollar[3].str)
 		}
-	case 73:
+	case 95:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:690
+		//line sql.y:796
 		{
-			sqlVAL.stmt = &Grant{Privileges: sqlDollar[2].privilegeList, Grantees: NameList(sqlDollar[6].strs), Targets: sqlDollar[4].targetList}
+			tmp := sqlDollar[2].targetList
+			sqlVAL.stmt = &Backup{Targets: &tmp, To: sqlDollar[4].expr, AsOf: AsOfClause{Expr: sqlDollar[5].expr}, IncrementalFrom: sqlDollar[6].exprs}
 		}
-	case 74:
-		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:697
+	case 96:
+		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
+		//line sql.y:801
 		{
-			sqlVAL.stmt = &Revoke{Privileges: sqlDollar[2].privilegeList, Grantees: NameList(sqlDollar[6].strs), Targets: sqlDollar[4].targetList}
+			sqlVAL.stmt = &Backup{To: sqlDollar[3].expr, AsOf: AsOfClause{Expr: sqlDollar[4].expr}, IncrementalFrom: sqlDollar[5].exprs}
 		}
-	case 75:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:704
+	case 97:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:807
 		{
-			sqlVAL.targetList = TargetList{Tables: QualifiedNames(sqlDollar[1].qnames)}
+			sqlVAL.exprs = sqlDollar[3].exprs
 		}
-	case 76:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:708
+	case 98:
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+		//line sql.y:811
 		{
-			// TODO(marc): this is postgres' grammar, but do we really need
-			// both "x" and "TABLE X"?
-			sqlVAL.targetList = TargetList{Tables: QualifiedNames(sqlDollar[2].qnames)}
+			sqlVAL.exprs = nil
 		}
-	case 77:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:714
+	case 99:
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:818
 		{
-			sqlVAL.targetList = TargetList{Databases: NameList(sqlDollar[2].strs)}
+			tmp := sqlDollar[2].targetList
+			sqlVAL.stmt = &Restore{Targets: &tmp, From: sqlDollar[4].exprs}
 		}
-	case 78:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:721
+	case 100:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:823
 		{
-			sqlVAL.privilegeList = privilege.List{privilege.ALL}
+			sqlVAL.stmt = &Restore{From: sqlDollar[3].exprs}
 		}
-	case 79:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:724
+	case 101:
+		sqlDollar = sqlS[sqlpt-12 : sqlpt+1]
+		//line sql.y:831
 		{
+			sqlVAL.stmt = &Import{Table: sqlDollar[3].qname, Defs: sqlDollar[5].tblDefs, Files: sqlDollar[10].exprs, Options: sqlDollar[12].kvOptions}
 		}
-	case 80:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:728
+	case 102:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:837
 		{
-			sqlVAL.privilegeList = privilege.List{sqlDollar[1].privilegeType}
+			sqlVAL.kvOptions = sqlDollar[2].kvOptions
 		}
-	case 81:
-		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:732
+	case 103:
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+		//line sql.y:841
 		{
-			sqlVAL.privilegeList = append(sqlDollar[1].privilegeList, sqlDollar[3].privilegeType)
+			sqlVAL.kvOptions = nil
 		}
-	case 82:
+	case 104:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:739
+		//line sql.y:847
 		{
-			sqlVAL.privilegeType = privilege.CREATE
+			sqlVAL.kvOptions = []KVOption{sqlDollar[1].kvOption}
 		}
-	case 83:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:743
+	case 105:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:851
 		{
-			sqlVAL.privilegeType = privilege.DROP
+			sqlVAL.kvOptions = append(sqlDollar[1].kvOptions, sqlDollar[3].kvOption)
 		}
-	case 84:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:747
+	case 106:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:857
 		{
-			sqlVAL.privilegeType = privilege.GRANT
+			sqlVAL.kvOption = KVOption{Key: Name(sqlDollar[1].str), Value: sqlDollar[3].expr}
 		}
-	case 85:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:751
+	case 107:
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:864
 		{
-			sqlVAL.privilegeType = privilege.SELECT
+			sqlVAL.stmt = &Grant{Privileges: sqlDollar[2].privSpec.privileges, ColumnPrivileges: sqlDollar[2].privSpec.columns, Grantees: NameList(sqlDollar[6].strs), Targets: sqlDollar[4].targetList}
 		}
-	case 86:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:755
+	case 108:
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:871
 		{
-			sqlVAL.privilegeType = privilege.INSERT
+			sqlVAL.stmt = &Revoke{Privileges: sqlDollar[2].privSpec.privileges, ColumnPrivileges: sqlDollar[2].privSpec.columns, Grantees: NameList(sqlDollar[6].strs), Targets: sqlDollar[4].targetList}
 		}
-	case 87:
+	case 109:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:759
+		//line sql.y:878
 		{
-			sqlVAL.privilegeType = privilege.DELETE
+			sqlVAL.targetList = TargetList{Tables: QualifiedNames(sqlDollar[1].qnames)}
 		}
-	case 88:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:763
+	case 110:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:882
 		{
-			sqlVAL.privilegeType = privilege.UPDATE
+			// TODO(marc): this is postgres' grammar, but do we really need
+			// both "x" and "TABLE X"?
+			sqlVAL.targetList = TargetList{Tables: QualifiedNames(sqlDollar[2].qnames)}
 		}
-	case 89:
+	case 111:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:888
+		{
+			sqlVAL.targetList = TargetList{Databases: NameList(sqlDollar[2].strs)}
+		}
+	case 112:
+		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
+		//line sql.y:892
+		{
+			// The tables of a database are all matched by "database.*".
+			var tables QualifiedNames
+			for _, db := range sqlDollar[5].strs {
+				tables = append(tables, &QualifiedName{Base: Name(db), Indirect: Indirection{qualifiedStar}})
+			}
+			sqlVAL.targetList = TargetList{Tables: tables}
+		}
+	case 113:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:771
+		//line sql.y:904
+		{
+			sqlVAL.privSpec = privilegeSpec{privileges: privilege.List{privilege.ALL}}
+		}
+	case 114:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:907
+		{
+		}
+	case 115:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:912
+		{
+			sqlVAL.privSpec = privilegeSpec{privileges: privilege.List{sqlDollar[1].privilegeType}}
+		}
+	case 116:
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:916
+		{
+			sqlVAL.privSpec = privilegeSpec{columns: ColumnPrivileges{{Privilege: sqlDollar[1].privilegeType, Columns: NameList(sqlDollar[3].strs)}}}
+		}
+	case 117:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:920
+		{
+			sqlVAL.privSpec = sqlDollar[1].privSpec
+			sqlVAL.privSpec.privileges = append(sqlVAL.privSpec.privileges, sqlDollar[3].privilegeType)
+		}
+	case 118:
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:925
+		{
+			sqlVAL.privSpec = sqlDollar[1].privSpec
+			sqlVAL.privSpec.columns = append(sqlVAL.privSpec.columns, ColumnPrivilege{Privilege: sqlDollar[3].privilegeType, Columns: NameList(sqlDollar[5].strs)})
+		}
+	case 119:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:933
+		{
+			sqlVAL.privilegeType = privilege.CREATE
+		}
+	case 120:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:937
+		{
+			sqlVAL.privilegeType = privilege.DROP
+		}
+	case 121:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:941
+		{
+			sqlVAL.privilegeType = privilege.GRANT
+		}
+	case 122:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:945
+		{
+			sqlVAL.privilegeType = privilege.SELECT
+		}
+	case 123:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:949
+		{
+			sqlVAL.privilegeType = privilege.INSERT
+		}
+	case 124:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:953
+		{
+			sqlVAL.privilegeType = privilege.DELETE
+		}
+	case 125:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:957
+		{
+			sqlVAL.privilegeType = privilege.UPDATE
+		}
+	case 126:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:965
 		{
 			sqlVAL.strs = []string{sqlDollar[1].str}
 		}
-	case 90:
+	case 127:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:775
+		//line sql.y:969
 		{
 			sqlVAL.strs = append(sqlDollar[1].strs, sqlDollar[3].str)
 		}
-	case 91:
+	case 128:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:783
+		//line sql.y:977
 		{
 			sqlVAL.stmt = sqlDollar[2].stmt
 		}
-	case 92:
+	case 129:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:787
+		//line sql.y:981
 		{
 			sqlVAL.stmt = sqlDollar[3].stmt
 		}
-	case 93:
+	case 130:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:791
+		//line sql.y:985
 		{
 			sqlVAL.stmt = sqlDollar[3].stmt
 		}
-	case 94:
+	case 131:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:797
+		//line sql.y:991
 		{
 			sqlVAL.stmt = &SetTransaction{Isolation: sqlDollar[2].isoLevel}
 		}
-	case 96:
+	case 133:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:804
+		//line sql.y:998
 		{
 			sqlVAL.stmt = &Set{Name: sqlDollar[1].qname, Values: sqlDollar[3].exprs}
 		}
-	case 97:
+	case 134:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:808
+		//line sql.y:1002
 		{
 			sqlVAL.stmt = &Set{Name: sqlDollar[1].qname, Values: sqlDollar[3].exprs}
 		}
-	case 98:
+	case 135:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:812
+		//line sql.y:1006
 		{
 			sqlVAL.stmt = &Set{Name: sqlDollar[1].qname}
 		}
-	case 99:
+	case 136:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:816
+		//line sql.y:1010
 		{
 			sqlVAL.stmt = &Set{Name: sqlDollar[1].qname}
 		}
-	case 101:
+	case 138:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:823
+		//line sql.y:1017
 		{
 			unimplemented()
 		}
-	case 102:
+	case 139:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:826
+		//line sql.y:1020
 		{
 			sqlVAL.stmt = &SetTimeZone{Value: sqlDollar[3].expr}
 		}
-	case 103:
+	case 140:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:829
+		//line sql.y:1023
 		{
 			unimplemented()
 		}
-	case 105:
+	case 142:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:836
+		//line sql.y:1030
 		{
 			sqlVAL.exprs = []Expr{sqlDollar[1].expr}
 		}
-	case 106:
+	case 143:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:840
+		//line sql.y:1034
 		{
 			sqlVAL.exprs = append(sqlDollar[1].exprs, sqlDollar[3].expr)
 		}
-	case 109:
+	case 146:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:848
+		//line sql.y:1042
 		{
 			sqlVAL.expr = ValArg{name: sqlDollar[1].str}
 		}
-	case 110:
+	case 147:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:854
+		//line sql.y:1048
 		{
 			// Mapped to the closest supported isolation level.
 			sqlVAL.isoLevel = SnapshotIsolation
 		}
-	case 111:
+	case 148:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:859
+		//line sql.y:1053
 		{
 			// Mapped to the closest supported isolation level.
 			sqlVAL.isoLevel = SnapshotIsolation
 		}
-	case 112:
+	case 149:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:864
+		//line sql.y:1058
 		{
 			// Mapped to the closest supported isolation level.
 			sqlVAL.isoLevel = SnapshotIsolation
 		}
-	case 113:
+	case 150:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:869
+		//line sql.y:1063
 		{
 			sqlVAL.isoLevel = SnapshotIsolation
 		}
-	case 114:
+	case 151:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:873
+		//line sql.y:1067
 		{
 			sqlVAL.isoLevel = SerializableIsolation
 		}
-	case 115:
+	case 152:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:879
+		//line sql.y:1073
 		{
 			sqlVAL.expr = DBool(true)
 		}
-	case 116:
+	case 153:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:883
+		//line sql.y:1077
 		{
 			sqlVAL.expr = DBool(false)
 		}
-	case 117:
+	case 154:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:887
+		//line sql.y:1081
 		{
 			sqlVAL.expr = DString(sqlDollar[1].str)
 		}
-	case 119:
+	case 156:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:902
+		//line sql.y:1096
 		{
 			sqlVAL.expr = DString(sqlDollar[1].str)
 		}
-	case 120:
+	case 157:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:906
+		//line sql.y:1100
 		{
 			sqlVAL.expr = DString(sqlDollar[1].str)
 		}
-	case 121:
+	case 158:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:910
+		//line sql.y:1104
 		{
 			// TODO(pmattis): support opt_interval?
 			expr := &CastExpr{Expr: DString(sqlDollar[2].str), Type: sqlDollar[1].colType}
@@ -4397,250 +4935,298 @@ sqldefault:
 			}
 			sqlVAL.expr = d
 		}
-	case 123:
+	case 160:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:927
+		//line sql.y:1121
 		{
 			sqlVAL.expr = DString(sqlDollar[1].str)
 		}
-	case 124:
+	case 161:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:931
+		//line sql.y:1125
 		{
 			sqlVAL.expr = DString(sqlDollar[1].str)
 		}
-	case 125:
+	case 162:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:936
+		//line sql.y:1130
 		{
 			unimplemented()
 		}
-	case 126:
+	case 163:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:937
+		//line sql.y:1131
 		{
 			unimplemented()
 		}
-	case 127:
+	case 164:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:938
+		//line sql.y:1132
 		{
 		}
-	case 128:
+	case 165:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:942
+		//line sql.y:1136
 		{
 			sqlVAL.expr = DString(sqlDollar[1].str)
 		}
-	case 129:
+	case 166:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:946
+		//line sql.y:1140
 		{
 			sqlVAL.expr = DString(sqlDollar[1].str)
 		}
-	case 130:
+	case 167:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:952
+		//line sql.y:1146
 		{
 			sqlVAL.stmt = &Show{Name: sqlDollar[2].str}
 		}
-	case 131:
+	case 168:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:956
+		//line sql.y:1150
 		{
 			sqlVAL.stmt = &Show{Name: sqlDollar[2].str}
 		}
-	case 132:
+	case 169:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:960
+		//line sql.y:1154
 		{
 			sqlVAL.stmt = &ShowColumns{Table: sqlDollar[4].qname}
 		}
-	case 133:
+	case 170:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:964
+		//line sql.y:1158
 		{
 			sqlVAL.stmt = &ShowDatabases{}
 		}
-	case 134:
+	case 171:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:968
+		//line sql.y:1162
 		{
 			sqlVAL.stmt = &ShowGrants{Targets: sqlDollar[3].targetListPtr, Grantees: sqlDollar[4].strs}
 		}
-	case 135:
+	case 172:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:1166
+		{
+			sqlVAL.stmt = &ShowImports{}
+		}
+	case 173:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:972
+		//line sql.y:1170
 		{
 			sqlVAL.stmt = &ShowIndex{Table: sqlDollar[4].qname}
 		}
-	case 136:
+	case 174:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:1174
+		{
+			sqlVAL.stmt = &ShowProtectedTimestamps{}
+		}
+	case 175:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:1178
+		{
+			sqlVAL.stmt = &ShowQueries{}
+		}
+	case 176:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:1182
+		{
+			sqlVAL.stmt = &ShowUsers{}
+		}
+	case 177:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:1186
+		{
+			sqlVAL.stmt = &ShowStatementStatistics{}
+		}
+	case 178:
+		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
+		//line sql.y:1190
+		{
+			sqlVAL.stmt = &ShowTableStatistics{Table: sqlDollar[5].qname}
+		}
+	case 179:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:976
+		//line sql.y:1194
 		{
 			sqlVAL.stmt = &ShowTables{Name: sqlDollar[3].qname}
 		}
-	case 137:
+	case 180:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:980
+		//line sql.y:1198
 		{
 			sqlVAL.stmt = &Show{Name: "TIME ZONE"}
 		}
-	case 138:
+	case 181:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:984
+		//line sql.y:1202
 		{
 			sqlVAL.stmt = &Show{Name: "TRANSACTION ISOLATION LEVEL"}
 		}
-	case 139:
+	case 182:
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:1206
+		{
+			sqlVAL.stmt = &ShowZoneConfig{ZoneSpecifier: ZoneSpecifier{Table: sqlDollar[6].qname}}
+		}
+	case 183:
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:1210
+		{
+			sqlVAL.stmt = &ShowZoneConfig{ZoneSpecifier: ZoneSpecifier{Database: Name(sqlDollar[6].str)}}
+		}
+	case 184:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:988
+		//line sql.y:1214
 		{
 			sqlVAL.stmt = nil
 		}
-	case 140:
+	case 185:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:994
+		//line sql.y:1220
 		{
 			sqlVAL.qname = sqlDollar[2].qname
 		}
-	case 141:
+	case 186:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:998
+		//line sql.y:1224
 		{
 			sqlVAL.qname = nil
 		}
-	case 142:
+	case 187:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1004
+		//line sql.y:1230
 		{
 			tmp := sqlDollar[2].targetList
 			sqlVAL.targetListPtr = &tmp
 		}
-	case 143:
+	case 188:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1009
+		//line sql.y:1235
 		{
 			sqlVAL.targetListPtr = nil
 		}
-	case 144:
+	case 189:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1015
+		//line sql.y:1241
 		{
 			sqlVAL.strs = sqlDollar[2].strs
 		}
-	case 145:
+	case 190:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1019
+		//line sql.y:1245
 		{
 			sqlVAL.strs = nil
 		}
-	case 146:
+	case 191:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1026
+		//line sql.y:1252
 		{
 			sqlVAL.stmt = &CreateTable{Table: sqlDollar[3].qname, IfNotExists: false, Defs: sqlDollar[5].tblDefs}
 		}
-	case 147:
+	case 192:
 		sqlDollar = sqlS[sqlpt-9 : sqlpt+1]
-		//line sql.y:1030
+		//line sql.y:1256
 		{
 			sqlVAL.stmt = &CreateTable{Table: sqlDollar[6].qname, IfNotExists: true, Defs: sqlDollar[8].tblDefs}
 		}
-	case 149:
+	case 194:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1037
+		//line sql.y:1263
 		{
 			sqlVAL.tblDefs = nil
 		}
-	case 150:
+	case 195:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1043
+		//line sql.y:1269
 		{
 			sqlVAL.tblDefs = TableDefs{sqlDollar[1].tblDef}
 		}
-	case 151:
+	case 196:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1047
+		//line sql.y:1273
 		{
 			sqlVAL.tblDefs = append(sqlDollar[1].tblDefs, sqlDollar[3].tblDef)
 		}
-	case 152:
+	case 197:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1053
+		//line sql.y:1279
 		{
 			sqlVAL.tblDef = sqlDollar[1].colDef
 		}
-	case 154:
+	case 199:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1058
+		//line sql.y:1284
 		{
 			sqlVAL.tblDef = sqlDollar[1].constraintDef
 		}
-	case 155:
+	case 200:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1064
+		//line sql.y:1290
 		{
 			sqlVAL.colDef = newColumnTableDef(Name(sqlDollar[1].str), sqlDollar[2].colType, sqlDollar[3].colQuals)
 		}
-	case 156:
+	case 201:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1070
+		//line sql.y:1296
 		{
 			sqlVAL.colQuals = append(sqlDollar[1].colQuals, sqlDollar[2].colQual)
 		}
-	case 157:
+	case 202:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1074
+		//line sql.y:1300
 		{
 			sqlVAL.colQuals = nil
 		}
-	case 158:
+	case 203:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1080
+		//line sql.y:1306
 		{
 			// TODO(pmattis): Handle constraint name.
 			sqlVAL.colQual = sqlDollar[3].colQual
 		}
-	case 160:
+	case 205:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1085
+		//line sql.y:1311
 		{
 			unimplemented()
 		}
-	case 161:
+	case 206:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1101
+		//line sql.y:1327
 		{
 			sqlVAL.colQual = NotNullConstraint{}
 		}
-	case 162:
+	case 207:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1105
+		//line sql.y:1331
 		{
 			sqlVAL.colQual = NullConstraint{}
 		}
-	case 163:
+	case 208:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1109
+		//line sql.y:1335
 		{
 			sqlVAL.colQual = UniqueConstraint{}
 		}
-	case 164:
+	case 209:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1113
+		//line sql.y:1339
 		{
 			sqlVAL.colQual = PrimaryKeyConstraint{}
 		}
-	case 165:
+	case 210:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1116
+		//line sql.y:1342
 		{
 			unimplemented()
 		}
-	case 166:
+	case 211:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1118
+		//line sql.y:1344
 		{
 			if ContainsVars(sqlDollar[2].expr) {
 				sqllex.Error("default expression contains a variable")
@@ -4652,15 +5238,15 @@ sqldefault:
 			}
 			sqlVAL.colQual = &ColumnDefault{Expr: sqlDollar[2].expr}
 		}
-	case 167:
+	case 212:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1129
+		//line sql.y:1355
 		{
 			unimplemented()
 		}
-	case 168:
+	case 213:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1133
+		//line sql.y:1359
 		{
 			sqlVAL.tblDef = &IndexTableDef{
 				Name:    Name(sqlDollar[2].str),
@@ -4668,9 +5254,9 @@ sqldefault:
 				Storing: sqlDollar[6].strs,
 			}
 		}
-	case 169:
+	case 214:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:1141
+		//line sql.y:1367
 		{
 			sqlVAL.tblDef = &UniqueConstraintTableDef{
 				IndexTableDef: IndexTableDef{
@@ -4680,28 +5266,28 @@ sqldefault:
 				},
 			}
 		}
-	case 170:
+	case 215:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1156
+		//line sql.y:1382
 		{
 			sqlVAL.constraintDef = sqlDollar[3].constraintDef
 			sqlVAL.constraintDef.setName(Name(sqlDollar[2].str))
 		}
-	case 171:
+	case 216:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1161
+		//line sql.y:1387
 		{
 			sqlVAL.constraintDef = sqlDollar[1].constraintDef
 		}
-	case 172:
+	case 217:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1166
+		//line sql.y:1392
 		{
 			unimplemented()
 		}
-	case 173:
+	case 218:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1168
+		//line sql.y:1394
 		{
 			sqlVAL.constraintDef = &UniqueConstraintTableDef{
 				IndexTableDef: IndexTableDef{
@@ -4710,9 +5296,9 @@ sqldefault:
 				},
 			}
 		}
-	case 174:
+	case 219:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1177
+		//line sql.y:1403
 		{
 			sqlVAL.constraintDef = &UniqueConstraintTableDef{
 				IndexTableDef: IndexTableDef{
@@ -4721,157 +5307,157 @@ sqldefault:
 				PrimaryKey: true,
 			}
 		}
-	case 175:
+	case 220:
 		sqlDollar = sqlS[sqlpt-10 : sqlpt+1]
-		//line sql.y:1186
+		//line sql.y:1412
 		{
 			unimplemented()
 		}
-	case 178:
+	case 223:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1203
+		//line sql.y:1429
 		{
 			sqlVAL.strs = sqlDollar[3].strs
 		}
-	case 179:
+	case 224:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1207
+		//line sql.y:1433
 		{
 			sqlVAL.strs = nil
 		}
-	case 180:
+	case 225:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1213
+		//line sql.y:1439
 		{
 			sqlVAL.strs = sqlDollar[2].strs
 		}
-	case 181:
+	case 226:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1217
+		//line sql.y:1443
 		{
 			sqlVAL.strs = nil
 		}
-	case 182:
+	case 227:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1222
+		//line sql.y:1448
 		{
 			unimplemented()
 		}
-	case 183:
+	case 228:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1223
+		//line sql.y:1449
 		{
 			unimplemented()
 		}
-	case 184:
+	case 229:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1224
+		//line sql.y:1450
 		{
 			unimplemented()
 		}
-	case 185:
+	case 230:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1225
+		//line sql.y:1451
 		{
 		}
-	case 186:
+	case 231:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1232
+		//line sql.y:1458
 		{
 			unimplemented()
 		}
-	case 187:
+	case 232:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1233
+		//line sql.y:1459
 		{
 			unimplemented()
 		}
-	case 188:
+	case 233:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1234
+		//line sql.y:1460
 		{
 			unimplemented()
 		}
-	case 189:
+	case 234:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1235
+		//line sql.y:1461
 		{
 			unimplemented()
 		}
-	case 190:
+	case 235:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1236
+		//line sql.y:1462
 		{
 		}
-	case 191:
+	case 236:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1239
+		//line sql.y:1465
 		{
 			unimplemented()
 		}
-	case 192:
+	case 237:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1242
+		//line sql.y:1468
 		{
 			unimplemented()
 		}
-	case 193:
+	case 238:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1245
+		//line sql.y:1471
 		{
 			unimplemented()
 		}
-	case 194:
+	case 239:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1246
+		//line sql.y:1472
 		{
 			unimplemented()
 		}
-	case 195:
+	case 240:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1247
+		//line sql.y:1473
 		{
 			unimplemented()
 		}
-	case 196:
+	case 241:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1248
+		//line sql.y:1474
 		{
 			unimplemented()
 		}
-	case 197:
+	case 242:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1249
+		//line sql.y:1475
 		{
 			unimplemented()
 		}
-	case 198:
+	case 243:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1253
+		//line sql.y:1479
 		{
 			sqlVAL.expr = NumVal(sqlDollar[1].str)
 		}
-	case 199:
+	case 244:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1257
+		//line sql.y:1483
 		{
 			sqlVAL.expr = NumVal("-" + sqlDollar[2].str)
 		}
-	case 200:
+	case 245:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1261
+		//line sql.y:1487
 		{
 			sqlVAL.expr = DInt(sqlDollar[1].ival.Val)
 		}
-	case 201:
+	case 246:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1268
+		//line sql.y:1494
 		{
 			sqlVAL.stmt = &Truncate{Tables: sqlDollar[3].qnames}
 		}
-	case 202:
+	case 247:
 		sqlDollar = sqlS[sqlpt-10 : sqlpt+1]
-		//line sql.y:1275
+		//line sql.y:1501
 		{
 			sqlVAL.stmt = &CreateIndex{
 				Name:    Name(sqlDollar[4].str),
@@ -4881,9 +5467,9 @@ sqldefault:
 				Storing: sqlDollar[10].strs,
 			}
 		}
-	case 203:
+	case 248:
 		sqlDollar = sqlS[sqlpt-13 : sqlpt+1]
-		//line sql.y:1285
+		//line sql.y:1511
 		{
 			sqlVAL.stmt = &CreateIndex{
 				Name:        Name(sqlDollar[7].str),
@@ -4894,325 +5480,361 @@ sqldefault:
 				Storing:     sqlDollar[13].strs,
 			}
 		}
-	case 204:
+	case 249:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1298
+		//line sql.y:1524
 		{
 			sqlVAL.boolVal = true
 		}
-	case 205:
+	case 250:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1302
+		//line sql.y:1528
 		{
 			sqlVAL.boolVal = false
 		}
-	case 206:
+	case 251:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1308
+		//line sql.y:1534
 		{
 			sqlVAL.strs = []string{sqlDollar[1].str}
 		}
-	case 207:
+	case 252:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1312
+		//line sql.y:1538
 		{
 			sqlVAL.strs = append(sqlDollar[1].strs, sqlDollar[3].str)
 		}
-	case 208:
+	case 253:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1321
+		//line sql.y:1547
 		{
 			// TODO(pmattis): Support opt_asc_desc.
 			sqlVAL.str = sqlDollar[1].str
 		}
-	case 209:
+	case 254:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1325
+		//line sql.y:1551
 		{
 			unimplemented()
 		}
-	case 210:
+	case 255:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1326
+		//line sql.y:1552
 		{
 			unimplemented()
 		}
-	case 211:
+	case 256:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1329
+		//line sql.y:1555
 		{
 			unimplemented()
 		}
-	case 212:
+	case 257:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1330
+		//line sql.y:1556
 		{
 		}
-	case 213:
+	case 258:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1334
+		//line sql.y:1560
 		{
 			sqlVAL.dir = Ascending
 		}
-	case 214:
+	case 259:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1338
+		//line sql.y:1564
 		{
 			sqlVAL.dir = Descending
 		}
-	case 215:
+	case 260:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1342
+		//line sql.y:1568
 		{
 			sqlVAL.dir = DefaultDirection
 		}
-	case 216:
+	case 261:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1349
+		//line sql.y:1575
 		{
 			sqlVAL.stmt = &RenameDatabase{Name: Name(sqlDollar[3].str), NewName: Name(sqlDollar[6].str)}
 		}
-	case 217:
+	case 262:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1353
+		//line sql.y:1579
 		{
 			sqlVAL.stmt = &RenameTable{Name: sqlDollar[3].qname, NewName: sqlDollar[6].qname, IfExists: false}
 		}
-	case 218:
+	case 263:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1357
+		//line sql.y:1583
 		{
 			sqlVAL.stmt = &RenameTable{Name: sqlDollar[5].qname, NewName: sqlDollar[8].qname, IfExists: true}
 		}
-	case 219:
+	case 264:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1361
+		//line sql.y:1587
 		{
 			sqlVAL.stmt = &RenameIndex{Name: sqlDollar[3].qname, NewName: Name(sqlDollar[6].str), IfExists: false}
 		}
-	case 220:
+	case 265:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1365
+		//line sql.y:1591
 		{
 			sqlVAL.stmt = &RenameIndex{Name: sqlDollar[5].qname, NewName: Name(sqlDollar[8].str), IfExists: true}
 		}
-	case 221:
+	case 266:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1369
+		//line sql.y:1595
 		{
 			sqlVAL.stmt = &RenameColumn{Table: sqlDollar[3].qname, Name: Name(sqlDollar[6].str), NewName: Name(sqlDollar[8].str), IfExists: false}
 		}
-	case 222:
+	case 267:
 		sqlDollar = sqlS[sqlpt-10 : sqlpt+1]
-		//line sql.y:1373
+		//line sql.y:1599
 		{
 			sqlVAL.stmt = &RenameColumn{Table: sqlDollar[5].qname, Name: Name(sqlDollar[8].str), NewName: Name(sqlDollar[10].str), IfExists: true}
 		}
-	case 223:
+	case 268:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1377
+		//line sql.y:1603
 		{
 			sqlVAL.stmt = nil
 		}
-	case 224:
+	case 269:
 		sqlDollar = sqlS[sqlpt-10 : sqlpt+1]
-		//line sql.y:1381
+		//line sql.y:1607
 		{
 			sqlVAL.stmt = nil
 		}
-	case 225:
+	case 270:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1387
+		//line sql.y:1613
 		{
 			sqlVAL.boolVal = true
 		}
-	case 226:
+	case 271:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1391
+		//line sql.y:1617
 		{
 			sqlVAL.boolVal = false
 		}
-	case 227:
+	case 272:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1396
+		//line sql.y:1622
 		{
 		}
-	case 228:
+	case 273:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1397
+		//line sql.y:1623
 		{
 		}
-	case 229:
+	case 274:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1402
+		//line sql.y:1628
+		{
+			sqlVAL.stmt = &ResetStatementStatistics{}
+		}
+	case 275:
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:1635
+		{
+			sqlVAL.stmt = &ReleaseProtectedTimestamp{ID: sqlDollar[4].expr}
+		}
+	case 276:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:1642
 		{
 			sqlVAL.stmt = &BeginTransaction{Isolation: sqlDollar[3].isoLevel}
 		}
-	case 230:
+	case 277:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1406
+		//line sql.y:1646
 		{
 			sqlVAL.stmt = &CommitTransaction{}
 		}
-	case 231:
+	case 278:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1410
+		//line sql.y:1650
 		{
 			sqlVAL.stmt = &RollbackTransaction{}
 		}
-	case 232:
+	case 279:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1415
+		//line sql.y:1655
 		{
 		}
-	case 233:
+	case 280:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1416
+		//line sql.y:1656
 		{
 		}
-	case 235:
+	case 282:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1421
+		//line sql.y:1661
 		{
 			sqlVAL.isoLevel = UnspecifiedIsolation
 		}
-	case 236:
+	case 283:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1427
+		//line sql.y:1667
 		{
 			sqlVAL.isoLevel = sqlDollar[3].isoLevel
 		}
-	case 237:
+	case 284:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1433
+		//line sql.y:1673
 		{
 			sqlVAL.stmt = &CreateDatabase{Name: Name(sqlDollar[3].str)}
 		}
-	case 238:
+	case 285:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1437
+		//line sql.y:1677
 		{
 			sqlVAL.stmt = &CreateDatabase{IfNotExists: true, Name: Name(sqlDollar[6].str)}
 		}
-	case 239:
+	case 286:
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:1684
+		{
+			sqlVAL.stmt = &CreateUser{Name: Name(sqlDollar[3].str), Password: sqlDollar[4].expr}
+		}
+	case 287:
+		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
+		//line sql.y:1688
+		{
+			sqlVAL.stmt = &CreateUser{IfNotExists: true, Name: Name(sqlDollar[6].str), Password: sqlDollar[7].expr}
+		}
+	case 288:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:1694
+		{
+			sqlVAL.expr = sqlDollar[3].expr
+		}
+	case 289:
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+		//line sql.y:1698
+		{
+			sqlVAL.expr = nil
+		}
+	case 290:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1443
+		//line sql.y:1704
 		{
 			sqlVAL.stmt = sqlDollar[5].stmt
 			sqlVAL.stmt.(*Insert).Table = sqlDollar[4].qname
 		}
-	case 242:
+	case 293:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1459
+		//line sql.y:1720
 		{
 			sqlVAL.stmt = &Insert{Rows: sqlDollar[1].selectStmt}
 		}
-	case 243:
+	case 294:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1463
+		//line sql.y:1724
 		{
 			sqlVAL.stmt = &Insert{Columns: sqlDollar[2].qnames, Rows: sqlDollar[4].selectStmt}
 		}
-	case 244:
+	case 295:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1467
+		//line sql.y:1728
 		{
 			sqlVAL.stmt = &Insert{}
 		}
-	case 245:
+	case 296:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1472
+		//line sql.y:1733
 		{
 			unimplemented()
 		}
-	case 246:
+	case 297:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1473
+		//line sql.y:1734
 		{
 			unimplemented()
 		}
-	case 247:
+	case 298:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1474
+		//line sql.y:1735
 		{
 		}
-	case 248:
+	case 299:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1477
+		//line sql.y:1738
 		{
 			unimplemented()
 		}
-	case 249:
+	case 300:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1478
+		//line sql.y:1739
 		{
 			unimplemented()
 		}
-	case 250:
+	case 301:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1479
+		//line sql.y:1740
 		{
 		}
-	case 251:
+	case 302:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:1484
+		//line sql.y:1745
 		{
 			sqlVAL.stmt = &Update{Table: sqlDollar[3].tblExpr, Exprs: sqlDollar[5].updateExprs, Where: newWhere(astWhere, sqlDollar[7].expr)}
 		}
-	case 252:
+	case 303:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1490
+		//line sql.y:1751
 		{
 			sqlVAL.updateExprs = UpdateExprs{sqlDollar[1].updateExpr}
 		}
-	case 253:
+	case 304:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1494
+		//line sql.y:1755
 		{
 			sqlVAL.updateExprs = append(sqlDollar[1].updateExprs, sqlDollar[3].updateExpr)
 		}
-	case 256:
+	case 307:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1504
+		//line sql.y:1765
 		{
 			sqlVAL.updateExpr = &UpdateExpr{Names: QualifiedNames{sqlDollar[1].qname}, Expr: sqlDollar[3].expr}
 		}
-	case 257:
+	case 308:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1516
+		//line sql.y:1777
 		{
 			sqlVAL.updateExpr = &UpdateExpr{Tuple: true, Names: sqlDollar[2].qnames, Expr: Tuple(sqlDollar[5].exprs)}
 		}
-	case 258:
+	case 309:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1520
+		//line sql.y:1781
 		{
 			sqlVAL.updateExpr = &UpdateExpr{Tuple: true, Names: sqlDollar[2].qnames, Expr: &Subquery{Select: sqlDollar[5].selectStmt}}
 		}
-	case 261:
+	case 312:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1567
+		//line sql.y:1828
 		{
 			sqlVAL.selectStmt = &ParenSelect{Select: sqlDollar[2].selectStmt}
 		}
-	case 262:
+	case 313:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1571
+		//line sql.y:1832
 		{
 			sqlVAL.selectStmt = &ParenSelect{Select: sqlDollar[2].selectStmt}
 		}
-	case 264:
+	case 315:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1587
+		//line sql.y:1848
 		{
 			sqlVAL.selectStmt = sqlDollar[1].selectStmt
 			if s, ok := sqlVAL.selectStmt.(*Select); ok {
 				s.OrderBy = sqlDollar[2].orderBy
 			}
 		}
-	case 265:
+	case 316:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1594
+		//line sql.y:1855
 		{
 			sqlVAL.selectStmt = sqlDollar[1].selectStmt
 			if s, ok := sqlVAL.selectStmt.(*Select); ok {
@@ -5220,24 +5842,24 @@ sqldefault:
 				s.Limit = sqlDollar[3].limit
 			}
 		}
-	case 266:
+	case 317:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1602
+		//line sql.y:1863
 		{
 			sqlVAL.selectStmt = sqlDollar[2].selectStmt
 		}
-	case 267:
+	case 318:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1606
+		//line sql.y:1867
 		{
 			sqlVAL.selectStmt = sqlDollar[2].selectStmt
 			if s, ok := sqlVAL.selectStmt.(*Select); ok {
 				s.OrderBy = sqlDollar[3].orderBy
 			}
 		}
-	case 268:
+	case 319:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1613
+		//line sql.y:1874
 		{
 			sqlVAL.selectStmt = sqlDollar[2].selectStmt
 			if s, ok := sqlVAL.selectStmt.(*Select); ok {
@@ -5245,34 +5867,36 @@ sqldefault:
 				s.Limit = sqlDollar[4].limit
 			}
 		}
-	case 271:
-		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1651
+	case 322:
+		sqlDollar = sqlS[sqlpt-9 : sqlpt+1]
+		//line sql.y:1912
 		{
 			sqlVAL.selectStmt = &Select{
 				Exprs:   sqlDollar[3].selExprs,
 				From:    sqlDollar[4].tblExprs,
-				Where:   newWhere(astWhere, sqlDollar[5].expr),
-				GroupBy: sqlDollar[6].groupBy,
-				Having:  newWhere(astHaving, sqlDollar[7].expr),
+				AsOf:    AsOfClause{Expr: sqlDollar[5].expr},
+				Where:   newWhere(astWhere, sqlDollar[6].expr),
+				GroupBy: sqlDollar[7].groupBy,
+				Having:  newWhere(astHaving, sqlDollar[8].expr),
 			}
 		}
-	case 272:
-		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1663
+	case 323:
+		sqlDollar = sqlS[sqlpt-9 : sqlpt+1]
+		//line sql.y:1925
 		{
 			sqlVAL.selectStmt = &Select{
 				Distinct: sqlDollar[2].boolVal,
 				Exprs:    sqlDollar[3].selExprs,
 				From:     sqlDollar[4].tblExprs,
-				Where:    newWhere(astWhere, sqlDollar[5].expr),
-				GroupBy:  sqlDollar[6].groupBy,
-				Having:   newWhere(astHaving, sqlDollar[7].expr),
+				AsOf:     AsOfClause{Expr: sqlDollar[5].expr},
+				Where:    newWhere(astWhere, sqlDollar[6].expr),
+				GroupBy:  sqlDollar[7].groupBy,
+				Having:   newWhere(astHaving, sqlDollar[8].expr),
 			}
 		}
-	case 274:
+	case 325:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1675
+		//line sql.y:1938
 		{
 			sqlVAL.selectStmt = &Select{
 				Exprs:       SelectExprs{StarSelectExpr()},
@@ -5280,9 +5904,9 @@ sqldefault:
 				tableSelect: true,
 			}
 		}
-	case 275:
+	case 326:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1683
+		//line sql.y:1946
 		{
 			sqlVAL.selectStmt = &Union{
 				Type:  astUnion,
@@ -5291,9 +5915,9 @@ sqldefault:
 				All:   sqlDollar[3].boolVal,
 			}
 		}
-	case 276:
+	case 327:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1692
+		//line sql.y:1955
 		{
 			sqlVAL.selectStmt = &Union{
 				Type:  astIntersect,
@@ -5302,9 +5926,9 @@ sqldefault:
 				All:   sqlDollar[3].boolVal,
 			}
 		}
-	case 277:
+	case 328:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1701
+		//line sql.y:1964
 		{
 			sqlVAL.selectStmt = &Union{
 				Type:  astExcept,
@@ -5313,142 +5937,142 @@ sqldefault:
 				All:   sqlDollar[3].boolVal,
 			}
 		}
-	case 278:
+	case 329:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1719
+		//line sql.y:1982
 		{
 			unimplemented()
 		}
-	case 279:
+	case 330:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1720
+		//line sql.y:1983
 		{
 			unimplemented()
 		}
-	case 280:
+	case 331:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1721
+		//line sql.y:1984
 		{
 			unimplemented()
 		}
-	case 281:
+	case 332:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1724
+		//line sql.y:1987
 		{
 			unimplemented()
 		}
-	case 282:
+	case 333:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1725
+		//line sql.y:1988
 		{
 			unimplemented()
 		}
-	case 283:
+	case 334:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1728
+		//line sql.y:1991
 		{
 			unimplemented()
 		}
-	case 284:
+	case 335:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1732
+		//line sql.y:1995
 		{
 			sqlVAL.stmt = sqlDollar[1].selectStmt
 		}
-	case 288:
+	case 339:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1740
+		//line sql.y:2003
 		{
 			unimplemented()
 		}
-	case 289:
+	case 340:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1741
+		//line sql.y:2004
 		{
 		}
-	case 290:
+	case 341:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1744
+		//line sql.y:2007
 		{
 		}
-	case 291:
+	case 342:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1745
+		//line sql.y:2008
 		{
 		}
-	case 292:
+	case 343:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1749
+		//line sql.y:2012
 		{
 			sqlVAL.boolVal = true
 		}
-	case 293:
+	case 344:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1753
+		//line sql.y:2016
 		{
 			sqlVAL.boolVal = false
 		}
-	case 294:
+	case 345:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1757
+		//line sql.y:2020
 		{
 			sqlVAL.boolVal = false
 		}
-	case 295:
+	case 346:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1763
+		//line sql.y:2026
 		{
 			sqlVAL.boolVal = true
 		}
-	case 296:
+	case 347:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1768
+		//line sql.y:2031
 		{
 		}
-	case 297:
+	case 348:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1769
+		//line sql.y:2032
 		{
 		}
-	case 298:
+	case 349:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1773
+		//line sql.y:2036
 		{
 			sqlVAL.orderBy = sqlDollar[1].orderBy
 		}
-	case 299:
+	case 350:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1777
+		//line sql.y:2040
 		{
 			sqlVAL.orderBy = nil
 		}
-	case 300:
+	case 351:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1783
+		//line sql.y:2046
 		{
 			sqlVAL.orderBy = OrderBy(sqlDollar[3].orders)
 		}
-	case 301:
+	case 352:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1789
+		//line sql.y:2052
 		{
 			sqlVAL.orders = []*Order{sqlDollar[1].order}
 		}
-	case 302:
+	case 353:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1793
+		//line sql.y:2056
 		{
 			sqlVAL.orders = append(sqlDollar[1].orders, sqlDollar[3].order)
 		}
-	case 303:
+	case 354:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1799
+		//line sql.y:2062
 		{
 			sqlVAL.order = &Order{Expr: sqlDollar[1].expr, Direction: sqlDollar[2].dir}
 		}
-	case 304:
+	case 355:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1807
+		//line sql.y:2070
 		{
 			if sqlDollar[1].limit == nil {
 				sqlVAL.limit = sqlDollar[2].limit
@@ -5457,18 +6081,18 @@ sqldefault:
 				sqlVAL.limit.Offset = sqlDollar[2].limit.Offset
 			}
 		}
-	case 305:
+	case 356:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1816
+		//line sql.y:2079
 		{
 			sqlVAL.limit = sqlDollar[1].limit
 			if sqlDollar[2].limit != nil {
 				sqlVAL.limit.Count = sqlDollar[2].limit.Count
 			}
 		}
-	case 308:
+	case 359:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1827
+		//line sql.y:2090
 		{
 			if sqlDollar[2].expr == nil {
 				sqlVAL.limit = nil
@@ -5476,2032 +6100,2044 @@ sqldefault:
 				sqlVAL.limit = &Limit{Count: sqlDollar[2].expr}
 			}
 		}
-	case 309:
+	case 360:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1840
+		//line sql.y:2103
 		{
 			sqlVAL.limit = &Limit{Offset: sqlDollar[2].expr}
 		}
-	case 310:
+	case 361:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1847
+		//line sql.y:2110
 		{
 			sqlVAL.limit = &Limit{Offset: sqlDollar[2].expr}
 		}
-	case 312:
+	case 363:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1854
+		//line sql.y:2117
 		{
 			sqlVAL.expr = nil
 		}
-	case 313:
+	case 364:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1868
+		//line sql.y:2131
 		{
 		}
-	case 314:
+	case 365:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1869
+		//line sql.y:2132
 		{
 		}
-	case 315:
+	case 366:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1895
+		//line sql.y:2158
 		{
 			sqlVAL.groupBy = GroupBy(sqlDollar[3].exprs)
 		}
-	case 316:
+	case 367:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1899
+		//line sql.y:2162
 		{
 			sqlVAL.groupBy = nil
 		}
-	case 317:
+	case 368:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1905
+		//line sql.y:2168
 		{
 			sqlVAL.expr = sqlDollar[2].expr
 		}
-	case 318:
+	case 369:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1909
+		//line sql.y:2172
 		{
 			sqlVAL.expr = nil
 		}
-	case 319:
+	case 370:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1915
+		//line sql.y:2178
 		{
 			sqlVAL.selectStmt = Values{Tuple(sqlDollar[2].exprs)}
 		}
-	case 320:
+	case 371:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1919
+		//line sql.y:2182
 		{
 			sqlVAL.selectStmt = append(sqlDollar[1].selectStmt.(Values), Tuple(sqlDollar[3].exprs))
 		}
-	case 321:
+	case 372:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1929
+		//line sql.y:2192
 		{
 			sqlVAL.tblExprs = sqlDollar[2].tblExprs
 		}
-	case 322:
+	case 373:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1933
+		//line sql.y:2196
 		{
 			sqlVAL.tblExprs = nil
 		}
-	case 323:
+	case 374:
+		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
+		//line sql.y:2202
+		{
+			sqlVAL.expr = sqlDollar[5].expr
+		}
+	case 375:
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+		//line sql.y:2206
+		{
+			sqlVAL.expr = nil
+		}
+	case 376:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1939
+		//line sql.y:2212
 		{
 			sqlVAL.tblExprs = TableExprs{sqlDollar[1].tblExpr}
 		}
-	case 324:
+	case 377:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1943
+		//line sql.y:2216
 		{
 			sqlVAL.tblExprs = append(sqlDollar[1].tblExprs, sqlDollar[3].tblExpr)
 		}
-	case 325:
+	case 378:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1950
+		//line sql.y:2223
 		{
 			sqlVAL.tblExpr = &AliasedTableExpr{Expr: sqlDollar[1].qname, As: Name(sqlDollar[2].str)}
 		}
-	case 326:
+	case 379:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1954
+		//line sql.y:2227
 		{
 			sqlVAL.tblExpr = &AliasedTableExpr{Expr: &Subquery{Select: sqlDollar[1].selectStmt}, As: Name(sqlDollar[2].str)}
 		}
-	case 328:
+	case 381:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1958
+		//line sql.y:2231
 		{
 			unimplemented()
 		}
-	case 329:
+	case 382:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1976
+		//line sql.y:2249
 		{
 			sqlVAL.tblExpr = &ParenTableExpr{Expr: sqlDollar[2].tblExpr}
 		}
-	case 330:
+	case 383:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1980
+		//line sql.y:2253
 		{
 			sqlVAL.tblExpr = &JoinTableExpr{Join: astCrossJoin, Left: sqlDollar[1].tblExpr, Right: sqlDollar[4].tblExpr}
 		}
-	case 331:
+	case 384:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1984
+		//line sql.y:2257
 		{
 			sqlVAL.tblExpr = &JoinTableExpr{Join: sqlDollar[2].str, Left: sqlDollar[1].tblExpr, Right: sqlDollar[4].tblExpr, Cond: sqlDollar[5].joinCond}
 		}
-	case 332:
+	case 385:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1988
+		//line sql.y:2261
 		{
 			sqlVAL.tblExpr = &JoinTableExpr{Join: astJoin, Left: sqlDollar[1].tblExpr, Right: sqlDollar[3].tblExpr, Cond: sqlDollar[4].joinCond}
 		}
-	case 333:
+	case 386:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1992
+		//line sql.y:2265
 		{
 			sqlVAL.tblExpr = &JoinTableExpr{Join: astNaturalJoin, Left: sqlDollar[1].tblExpr, Right: sqlDollar[5].tblExpr}
 		}
-	case 334:
+	case 387:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1996
+		//line sql.y:2269
 		{
 			sqlVAL.tblExpr = &JoinTableExpr{Join: astNaturalJoin, Left: sqlDollar[1].tblExpr, Right: sqlDollar[4].tblExpr}
 		}
-	case 335:
+	case 388:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2001
+		//line sql.y:2274
 		{
 			unimplemented()
 		}
-	case 336:
+	case 389:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2003
+		//line sql.y:2276
 		{
 			sqlVAL.str = sqlDollar[2].str
 		}
-	case 337:
+	case 390:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2006
+		//line sql.y:2279
 		{
 			unimplemented()
 		}
-	case 338:
+	case 391:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2008
+		//line sql.y:2281
 		{
 			sqlVAL.str = sqlDollar[1].str
 		}
-	case 340:
+	case 393:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2015
+		//line sql.y:2288
 		{
 			sqlVAL.str = ""
 		}
-	case 341:
+	case 394:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2021
+		//line sql.y:2294
 		{
 			sqlVAL.str = astFullJoin
 		}
-	case 342:
+	case 395:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2025
+		//line sql.y:2298
 		{
 			sqlVAL.str = astLeftJoin
 		}
-	case 343:
+	case 396:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2029
+		//line sql.y:2302
 		{
 			sqlVAL.str = astRightJoin
 		}
-	case 344:
+	case 397:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2033
+		//line sql.y:2306
 		{
 			sqlVAL.str = astInnerJoin
 		}
-	case 345:
+	case 398:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2039
+		//line sql.y:2312
 		{
 		}
-	case 346:
+	case 399:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2040
+		//line sql.y:2313
 		{
 		}
-	case 347:
+	case 400:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2051
+		//line sql.y:2324
 		{
 			sqlVAL.joinCond = &UsingJoinCond{Cols: NameList(sqlDollar[3].strs)}
 		}
-	case 348:
+	case 401:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2055
+		//line sql.y:2328
 		{
 			sqlVAL.joinCond = &OnJoinCond{Expr: sqlDollar[2].expr}
 		}
-	case 349:
+	case 402:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2061
+		//line sql.y:2334
 		{
 			sqlVAL.qname = sqlDollar[1].qname
 		}
-	case 350:
+	case 403:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2065
+		//line sql.y:2338
 		{
 			// TODO(pmattis): Handle the "*".
 			sqlVAL.qname = sqlDollar[1].qname
 		}
-	case 351:
+	case 404:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2070
+		//line sql.y:2343
 		{
 			// TODO(pmattis): Support ONLY.
 			sqlVAL.qname = sqlDollar[2].qname
 		}
-	case 352:
+	case 405:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2075
+		//line sql.y:2348
 		{
 			// TODO(pmattis): Support ONLY.
 			sqlVAL.qname = sqlDollar[3].qname
 		}
-	case 353:
+	case 406:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2082
+		//line sql.y:2355
 		{
 			sqlVAL.qnames = QualifiedNames{sqlDollar[1].qname}
 		}
-	case 354:
+	case 407:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2086
+		//line sql.y:2359
 		{
 			sqlVAL.qnames = append(sqlDollar[1].qnames, sqlDollar[3].qname)
 		}
-	case 355:
+	case 408:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2099
+		//line sql.y:2372
 		{
 			sqlVAL.tblExpr = &AliasedTableExpr{Expr: sqlDollar[1].qname}
 		}
-	case 356:
+	case 409:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2103
+		//line sql.y:2376
 		{
 			sqlVAL.tblExpr = &AliasedTableExpr{Expr: sqlDollar[1].qname, As: Name(sqlDollar[2].str)}
 		}
-	case 357:
+	case 410:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2107
+		//line sql.y:2380
 		{
 			sqlVAL.tblExpr = &AliasedTableExpr{Expr: sqlDollar[1].qname, As: Name(sqlDollar[3].str)}
 		}
-	case 358:
+	case 411:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2113
+		//line sql.y:2386
 		{
 			sqlVAL.expr = sqlDollar[2].expr
 		}
-	case 359:
+	case 412:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2117
+		//line sql.y:2390
 		{
 			sqlVAL.expr = nil
 		}
-	case 360:
+	case 413:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2129
+		//line sql.y:2402
 		{
 			sqlVAL.colType = sqlDollar[1].colType
 		}
-	case 361:
+	case 414:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2133
+		//line sql.y:2406
 		{
 			unimplemented()
 		}
-	case 362:
+	case 415:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2134
+		//line sql.y:2407
 		{
 			unimplemented()
 		}
-	case 363:
+	case 416:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2137
+		//line sql.y:2410
 		{
 			unimplemented()
 		}
-	case 364:
+	case 417:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2138
+		//line sql.y:2411
 		{
 			unimplemented()
 		}
-	case 365:
+	case 418:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2139
+		//line sql.y:2412
 		{
 		}
-	case 371:
+	case 424:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2147
+		//line sql.y:2420
 		{
 			unimplemented()
 		}
-	case 372:
+	case 425:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2149
+		//line sql.y:2422
 		{
 			sqlVAL.colType = &BytesType{Name: "BLOB"}
 		}
-	case 373:
+	case 426:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2153
+		//line sql.y:2426
 		{
 			sqlVAL.colType = &BytesType{Name: "BYTES"}
 		}
-	case 374:
+	case 427:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2157
+		//line sql.y:2430
 		{
 			sqlVAL.colType = &StringType{Name: "TEXT"}
 		}
-	case 375:
+	case 428:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2161
+		//line sql.y:2434
 		{
 			sqlVAL.colType = &StringType{Name: "STRING"}
 		}
-	case 380:
+	case 433:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2182
+		//line sql.y:2455
 		{
 			sqlVAL.colType = &DecimalType{Prec: int(sqlDollar[2].ival.Val)}
 		}
-	case 381:
+	case 434:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2186
+		//line sql.y:2459
 		{
 			sqlVAL.colType = &DecimalType{Prec: int(sqlDollar[2].ival.Val), Scale: int(sqlDollar[4].ival.Val)}
 		}
-	case 382:
+	case 435:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2190
+		//line sql.y:2463
 		{
 			sqlVAL.colType = &DecimalType{}
 		}
-	case 383:
+	case 436:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2197
+		//line sql.y:2470
 		{
 			sqlVAL.colType = &IntType{Name: "INT"}
 		}
-	case 384:
+	case 437:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2201
+		//line sql.y:2474
 		{
 			sqlVAL.colType = &IntType{Name: "INT64"}
 		}
-	case 385:
+	case 438:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2205
+		//line sql.y:2478
 		{
 			sqlVAL.colType = &IntType{Name: "INTEGER"}
 		}
-	case 386:
+	case 439:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2209
+		//line sql.y:2482
 		{
 			sqlVAL.colType = &IntType{Name: "SMALLINT"}
 		}
-	case 387:
+	case 440:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2213
+		//line sql.y:2486
 		{
 			sqlVAL.colType = &IntType{Name: "BIGINT"}
 		}
-	case 388:
+	case 441:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2217
+		//line sql.y:2490
 		{
 			sqlVAL.colType = &FloatType{Name: "REAL"}
 		}
-	case 389:
+	case 442:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2221
+		//line sql.y:2494
 		{
 			sqlVAL.colType = &FloatType{Name: "FLOAT", Prec: int(sqlDollar[2].ival.Val)}
 		}
-	case 390:
+	case 443:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2225
+		//line sql.y:2498
 		{
 			sqlVAL.colType = &FloatType{Name: "DOUBLE PRECISION"}
 		}
-	case 391:
+	case 444:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2229
+		//line sql.y:2502
 		{
 			sqlVAL.colType = sqlDollar[2].colType
 			sqlVAL.colType.(*DecimalType).Name = "DECIMAL"
 		}
-	case 392:
+	case 445:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2234
+		//line sql.y:2507
 		{
 			sqlVAL.colType = sqlDollar[2].colType
 			sqlVAL.colType.(*DecimalType).Name = "DEC"
 		}
-	case 393:
+	case 446:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2239
+		//line sql.y:2512
 		{
 			sqlVAL.colType = sqlDollar[2].colType
 			sqlVAL.colType.(*DecimalType).Name = "NUMERIC"
 		}
-	case 394:
+	case 447:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2244
+		//line sql.y:2517
 		{
 			sqlVAL.colType = &BoolType{Name: "BOOLEAN"}
 		}
-	case 395:
+	case 448:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2248
+		//line sql.y:2521
 		{
 			sqlVAL.colType = &BoolType{Name: "BOOL"}
 		}
-	case 396:
+	case 449:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2254
+		//line sql.y:2527
 		{
 			sqlVAL.ival = sqlDollar[2].ival
 		}
-	case 397:
+	case 450:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2258
+		//line sql.y:2531
 		{
 			sqlVAL.ival = IntVal{}
 		}
-	case 402:
+	case 455:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2276
+		//line sql.y:2549
 		{
 			sqlVAL.colType = &IntType{Name: "BIT", N: int(sqlDollar[4].ival.Val)}
 		}
-	case 403:
+	case 456:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2282
+		//line sql.y:2555
 		{
 			sqlVAL.colType = &IntType{Name: "BIT"}
 		}
-	case 408:
+	case 461:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2298
+		//line sql.y:2571
 		{
 			sqlVAL.colType = sqlDollar[1].colType
 			sqlVAL.colType.(*StringType).N = int(sqlDollar[3].ival.Val)
 		}
-	case 409:
+	case 462:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2305
+		//line sql.y:2578
 		{
 			sqlVAL.colType = sqlDollar[1].colType
 		}
-	case 410:
+	case 463:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2311
+		//line sql.y:2584
 		{
 			sqlVAL.colType = &StringType{Name: "CHAR"}
 		}
-	case 411:
+	case 464:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2315
+		//line sql.y:2588
 		{
 			sqlVAL.colType = &StringType{Name: "CHAR"}
 		}
-	case 412:
+	case 465:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2319
+		//line sql.y:2592
 		{
 			sqlVAL.colType = &StringType{Name: "VARCHAR"}
 		}
-	case 413:
+	case 466:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2324
+		//line sql.y:2597
 		{
 		}
-	case 414:
+	case 467:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2325
+		//line sql.y:2598
 		{
 		}
-	case 415:
+	case 468:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2330
+		//line sql.y:2603
 		{
 			sqlVAL.colType = &DateType{}
 		}
-	case 416:
+	case 469:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2334
+		//line sql.y:2607
 		{
 			sqlVAL.colType = &TimestampType{}
 		}
-	case 417:
+	case 470:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2339
+		//line sql.y:2612
 		{
 			sqlVAL.colType = &IntervalType{}
 		}
-	case 418:
+	case 471:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2344
+		//line sql.y:2617
 		{
 			unimplemented()
 		}
-	case 419:
+	case 472:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2345
+		//line sql.y:2618
 		{
 			unimplemented()
 		}
-	case 420:
+	case 473:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2346
+		//line sql.y:2619
 		{
 			unimplemented()
 		}
-	case 421:
+	case 474:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2347
+		//line sql.y:2620
 		{
 			unimplemented()
 		}
-	case 422:
+	case 475:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2348
+		//line sql.y:2621
 		{
 			unimplemented()
 		}
-	case 423:
+	case 476:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2349
+		//line sql.y:2622
 		{
 			unimplemented()
 		}
-	case 424:
+	case 477:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2350
+		//line sql.y:2623
 		{
 			unimplemented()
 		}
-	case 425:
+	case 478:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2351
+		//line sql.y:2624
 		{
 			unimplemented()
 		}
-	case 426:
+	case 479:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2352
+		//line sql.y:2625
 		{
 			unimplemented()
 		}
-	case 427:
+	case 480:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2353
+		//line sql.y:2626
 		{
 			unimplemented()
 		}
-	case 428:
+	case 481:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2354
+		//line sql.y:2627
 		{
 			unimplemented()
 		}
-	case 429:
+	case 482:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2355
+		//line sql.y:2628
 		{
 			unimplemented()
 		}
-	case 430:
+	case 483:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2356
+		//line sql.y:2629
 		{
 			unimplemented()
 		}
-	case 431:
+	case 484:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2357
+		//line sql.y:2630
 		{
 		}
-	case 432:
+	case 485:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2360
+		//line sql.y:2633
 		{
 			unimplemented()
 		}
-	case 433:
+	case 486:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2361
+		//line sql.y:2634
 		{
 			unimplemented()
 		}
-	case 435:
+	case 488:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2385
+		//line sql.y:2658
 		{
 			sqlVAL.expr = &CastExpr{Expr: sqlDollar[1].expr, Type: sqlDollar[3].colType}
 		}
-	case 436:
+	case 489:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2388
+		//line sql.y:2661
 		{
 			unimplemented()
 		}
-	case 437:
+	case 490:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2389
+		//line sql.y:2662
 		{
 			unimplemented()
 		}
-	case 438:
+	case 491:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2398
+		//line sql.y:2671
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryPlus, Expr: sqlDollar[2].expr}
 		}
-	case 439:
+	case 492:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2402
+		//line sql.y:2675
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryMinus, Expr: sqlDollar[2].expr}
 		}
-	case 440:
+	case 493:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2406
+		//line sql.y:2679
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryComplement, Expr: sqlDollar[2].expr}
 		}
-	case 441:
+	case 494:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2410
+		//line sql.y:2683
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Plus, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 442:
+	case 495:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2414
+		//line sql.y:2687
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Minus, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 443:
+	case 496:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2418
+		//line sql.y:2691
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Mult, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 444:
+	case 497:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2422
+		//line sql.y:2695
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Div, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 445:
+	case 498:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2426
+		//line sql.y:2699
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Mod, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 446:
+	case 499:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2430
+		//line sql.y:2703
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitxor, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 447:
+	case 500:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2434
+		//line sql.y:2707
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitxor, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 448:
+	case 501:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2438
+		//line sql.y:2711
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitand, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 449:
+	case 502:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2442
+		//line sql.y:2715
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitor, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 450:
+	case 503:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2446
+		//line sql.y:2719
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: LT, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 451:
+	case 504:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2450
+		//line sql.y:2723
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: GT, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 452:
+	case 505:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2454
+		//line sql.y:2727
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: EQ, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 453:
+	case 506:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2458
+		//line sql.y:2731
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Concat, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 454:
+	case 507:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2462
+		//line sql.y:2735
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: LShift, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 455:
+	case 508:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2466
+		//line sql.y:2739
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: RShift, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 456:
+	case 509:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2470
+		//line sql.y:2743
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: LE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 457:
+	case 510:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2474
+		//line sql.y:2747
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: GE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 458:
+	case 511:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2478
+		//line sql.y:2751
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: NE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 459:
+	case 512:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2482
+		//line sql.y:2755
 		{
 			sqlVAL.expr = &AndExpr{Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 460:
+	case 513:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2486
+		//line sql.y:2759
 		{
 			sqlVAL.expr = &OrExpr{Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 461:
+	case 514:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2490
+		//line sql.y:2763
 		{
 			sqlVAL.expr = &NotExpr{Expr: sqlDollar[2].expr}
 		}
-	case 462:
+	case 515:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2494
+		//line sql.y:2767
 		{
 			sqlVAL.expr = &NotExpr{Expr: sqlDollar[2].expr}
 		}
-	case 463:
+	case 516:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2498
+		//line sql.y:2771
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: Like, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 464:
+	case 517:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2502
+		//line sql.y:2775
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: NotLike, Left: sqlDollar[1].expr, Right: sqlDollar[4].expr}
 		}
-	case 465:
+	case 518:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2506
+		//line sql.y:2779
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: SimilarTo, Left: sqlDollar[1].expr, Right: sqlDollar[4].expr}
 		}
-	case 466:
+	case 519:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2510
+		//line sql.y:2783
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: NotSimilarTo, Left: sqlDollar[1].expr, Right: sqlDollar[5].expr}
 		}
-	case 467:
+	case 520:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2514
+		//line sql.y:2787
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: Is, Left: sqlDollar[1].expr, Right: DNull}
 		}
-	case 468:
+	case 521:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2518
+		//line sql.y:2791
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: IsNot, Left: sqlDollar[1].expr, Right: DNull}
 		}
-	case 469:
+	case 522:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2521
+		//line sql.y:2794
 		{
 			unimplemented()
 		}
-	case 470:
+	case 523:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2523
+		//line sql.y:2796
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: Is, Left: sqlDollar[1].expr, Right: DBool(true)}
 		}
-	case 471:
+	case 524:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2527
+		//line sql.y:2800
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: IsNot, Left: sqlDollar[1].expr, Right: DBool(true)}
 		}
-	case 472:
+	case 525:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2531
+		//line sql.y:2804
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: Is, Left: sqlDollar[1].expr, Right: DBool(false)}
 		}
-	case 473:
+	case 526:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2535
+		//line sql.y:2808
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: IsNot, Left: sqlDollar[1].expr, Right: DBool(false)}
 		}
-	case 474:
+	case 527:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2539
+		//line sql.y:2812
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: Is, Left: sqlDollar[1].expr, Right: DNull}
 		}
-	case 475:
+	case 528:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2543
+		//line sql.y:2816
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: IsNot, Left: sqlDollar[1].expr, Right: DNull}
 		}
-	case 476:
+	case 529:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2547
+		//line sql.y:2820
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: IsDistinctFrom, Left: sqlDollar[1].expr, Right: sqlDollar[5].expr}
 		}
-	case 477:
+	case 530:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2551
+		//line sql.y:2824
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: IsNotDistinctFrom, Left: sqlDollar[1].expr, Right: sqlDollar[6].expr}
 		}
-	case 478:
+	case 531:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2555
+		//line sql.y:2828
 		{
 			sqlVAL.expr = &IsOfTypeExpr{Expr: sqlDollar[1].expr, Types: sqlDollar[5].colTypes}
 		}
-	case 479:
+	case 532:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:2559
+		//line sql.y:2832
 		{
 			sqlVAL.expr = &IsOfTypeExpr{Not: true, Expr: sqlDollar[1].expr, Types: sqlDollar[6].colTypes}
 		}
-	case 480:
+	case 533:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2563
+		//line sql.y:2836
 		{
 			sqlVAL.expr = &RangeCond{Left: sqlDollar[1].expr, From: sqlDollar[4].expr, To: sqlDollar[6].expr}
 		}
-	case 481:
+	case 534:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:2567
+		//line sql.y:2840
 		{
 			sqlVAL.expr = &RangeCond{Not: true, Left: sqlDollar[1].expr, From: sqlDollar[5].expr, To: sqlDollar[7].expr}
 		}
-	case 482:
+	case 535:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2571
+		//line sql.y:2844
 		{
 			sqlVAL.expr = &RangeCond{Left: sqlDollar[1].expr, From: sqlDollar[4].expr, To: sqlDollar[6].expr}
 		}
-	case 483:
+	case 536:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:2575
+		//line sql.y:2848
 		{
 			sqlVAL.expr = &RangeCond{Not: true, Left: sqlDollar[1].expr, From: sqlDollar[5].expr, To: sqlDollar[7].expr}
 		}
-	case 484:
+	case 537:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2579
+		//line sql.y:2852
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: In, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 485:
+	case 538:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2583
+		//line sql.y:2856
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: NotIn, Left: sqlDollar[1].expr, Right: sqlDollar[4].expr}
 		}
-	case 487:
+	case 540:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2600
+		//line sql.y:2873
 		{
 			sqlVAL.expr = &CastExpr{Expr: sqlDollar[1].expr, Type: sqlDollar[3].colType}
 		}
-	case 488:
+	case 541:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2604
+		//line sql.y:2877
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryPlus, Expr: sqlDollar[2].expr}
 		}
-	case 489:
+	case 542:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2608
+		//line sql.y:2881
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryMinus, Expr: sqlDollar[2].expr}
 		}
-	case 490:
+	case 543:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2612
+		//line sql.y:2885
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryComplement, Expr: sqlDollar[2].expr}
 		}
-	case 491:
+	case 544:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2616
+		//line sql.y:2889
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Plus, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 492:
+	case 545:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2620
+		//line sql.y:2893
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Minus, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 493:
+	case 546:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2624
+		//line sql.y:2897
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Mult, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 494:
+	case 547:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2628
+		//line sql.y:2901
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Div, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 495:
+	case 548:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2632
+		//line sql.y:2905
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Mod, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 496:
+	case 549:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2636
+		//line sql.y:2909
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitxor, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 497:
+	case 550:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2640
+		//line sql.y:2913
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitxor, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 498:
+	case 551:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2644
+		//line sql.y:2917
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitand, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 499:
+	case 552:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2648
+		//line sql.y:2921
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitor, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 500:
+	case 553:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2652
+		//line sql.y:2925
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: LT, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 501:
+	case 554:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2656
+		//line sql.y:2929
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: GT, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 502:
+	case 555:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2660
+		//line sql.y:2933
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: EQ, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 503:
+	case 556:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2664
+		//line sql.y:2937
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Concat, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 504:
+	case 557:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2668
+		//line sql.y:2941
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: LShift, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 505:
+	case 558:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2672
+		//line sql.y:2945
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: RShift, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 506:
+	case 559:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2676
+		//line sql.y:2949
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: LE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 507:
+	case 560:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2680
+		//line sql.y:2953
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: GE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 508:
+	case 561:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2684
+		//line sql.y:2957
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: NE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 509:
+	case 562:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2688
+		//line sql.y:2961
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: IsDistinctFrom, Left: sqlDollar[1].expr, Right: sqlDollar[5].expr}
 		}
-	case 510:
+	case 563:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2692
+		//line sql.y:2965
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: IsNotDistinctFrom, Left: sqlDollar[1].expr, Right: sqlDollar[6].expr}
 		}
-	case 511:
+	case 564:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2696
+		//line sql.y:2969
 		{
 			sqlVAL.expr = &IsOfTypeExpr{Expr: sqlDollar[1].expr, Types: sqlDollar[5].colTypes}
 		}
-	case 512:
+	case 565:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:2700
+		//line sql.y:2973
 		{
 			sqlVAL.expr = &IsOfTypeExpr{Not: true, Expr: sqlDollar[1].expr, Types: sqlDollar[6].colTypes}
 		}
-	case 513:
+	case 566:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2712
+		//line sql.y:2985
 		{
 			sqlVAL.expr = sqlDollar[1].qname
 		}
-	case 515:
+	case 568:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2717
+		//line sql.y:2990
 		{
 			sqlVAL.expr = ValArg{name: sqlDollar[1].str}
 		}
-	case 516:
+	case 569:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2721
+		//line sql.y:2994
 		{
 			sqlVAL.expr = &ParenExpr{Expr: sqlDollar[2].expr}
 		}
-	case 519:
+	case 572:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2727
+		//line sql.y:3000
 		{
 			sqlVAL.expr = &Subquery{Select: sqlDollar[1].selectStmt}
 		}
-	case 520:
+	case 573:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2731
+		//line sql.y:3004
 		{
 			sqlVAL.expr = &Subquery{Select: sqlDollar[1].selectStmt}
 		}
-	case 521:
+	case 574:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2735
+		//line sql.y:3008
 		{
 			sqlVAL.expr = &ExistsExpr{Subquery: &Subquery{Select: sqlDollar[2].selectStmt}}
 		}
-	case 522:
+	case 575:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2741
+		//line sql.y:3014
 		{
 			sqlVAL.expr = sqlDollar[2].expr
 		}
-	case 523:
+	case 576:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2745
+		//line sql.y:3018
 		{
 			sqlVAL.expr = sqlDollar[1].expr
 		}
-	case 524:
+	case 577:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2749
+		//line sql.y:3022
 		{
 			sqlVAL.expr = sqlDollar[1].expr
 		}
-	case 525:
+	case 578:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2757
+		//line sql.y:3030
 		{
 			sqlVAL.expr = &FuncExpr{Name: sqlDollar[1].qname}
 		}
-	case 526:
+	case 579:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2761
+		//line sql.y:3034
 		{
 			// TODO(pmattis): Support opt_sort_clause or remove it?
 			sqlVAL.expr = &FuncExpr{Name: sqlDollar[1].qname, Exprs: sqlDollar[3].exprs}
 		}
-	case 527:
+	case 580:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2765
+		//line sql.y:3038
 		{
 			unimplemented()
 		}
-	case 528:
+	case 581:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:2766
+		//line sql.y:3039
 		{
 			unimplemented()
 		}
-	case 529:
+	case 582:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2767
+		//line sql.y:3040
 		{
 			unimplemented()
 		}
-	case 530:
+	case 583:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2769
+		//line sql.y:3042
 		{
 			// TODO(pmattis): Support opt_sort_clause or remove it?
 			sqlVAL.expr = &FuncExpr{Name: sqlDollar[1].qname, Distinct: true, Exprs: sqlDollar[4].exprs}
 		}
-	case 531:
+	case 584:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2774
+		//line sql.y:3047
 		{
 			sqlVAL.expr = &FuncExpr{Name: sqlDollar[1].qname, Exprs: Exprs{StarExpr()}}
 		}
-	case 532:
+	case 585:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2787
+		//line sql.y:3060
 		{
 			// TODO(pmattis): Support within_group_clause, filter_clause and
 			// over_clause?
 			sqlVAL.expr = sqlDollar[1].expr
 		}
-	case 533:
+	case 586:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2793
+		//line sql.y:3066
 		{
 			sqlVAL.expr = sqlDollar[1].expr
 		}
-	case 534:
+	case 587:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2802
+		//line sql.y:3075
 		{
 			unimplemented()
 		}
-	case 535:
+	case 588:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2803
+		//line sql.y:3076
 		{
 			unimplemented()
 		}
-	case 536:
+	case 589:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2807
+		//line sql.y:3080
 		{
 			unimplemented()
 		}
-	case 537:
+	case 590:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2809
+		//line sql.y:3082
 		{
 			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name(sqlDollar[1].str)}}
 		}
-	case 538:
+	case 591:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2813
+		//line sql.y:3086
 		{
 			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name(sqlDollar[1].str)}}
 		}
-	case 539:
+	case 592:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2817
+		//line sql.y:3090
 		{
 			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name(sqlDollar[1].str)}}
 		}
-	case 540:
+	case 593:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2821
+		//line sql.y:3094
 		{
 			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name(sqlDollar[1].str)}}
 		}
-	case 541:
+	case 594:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2824
+		//line sql.y:3097
 		{
 			unimplemented()
 		}
-	case 542:
+	case 595:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2825
+		//line sql.y:3098
 		{
 			unimplemented()
 		}
-	case 543:
+	case 596:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2826
+		//line sql.y:3099
 		{
 			unimplemented()
 		}
-	case 544:
+	case 597:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2827
+		//line sql.y:3100
 		{
 			unimplemented()
 		}
-	case 545:
+	case 598:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2829
+		//line sql.y:3102
 		{
 			sqlVAL.expr = &CastExpr{Expr: sqlDollar[3].expr, Type: sqlDollar[5].colType}
 		}
-	case 546:
+	case 599:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2833
+		//line sql.y:3106
 		{
 			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name(sqlDollar[1].str)}, Exprs: sqlDollar[3].exprs}
 		}
-	case 547:
+	case 600:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2837
+		//line sql.y:3110
 		{
 			sqlVAL.expr = &OverlayExpr{FuncExpr{Name: &QualifiedName{Base: Name(sqlDollar[1].str)}, Exprs: sqlDollar[3].exprs}}
 		}
-	case 548:
+	case 601:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2841
+		//line sql.y:3114
 		{
 			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: "STRPOS"}, Exprs: sqlDollar[3].exprs}
 		}
-	case 549:
+	case 602:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2845
+		//line sql.y:3118
 		{
 			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name(sqlDollar[1].str)}, Exprs: sqlDollar[3].exprs}
 		}
-	case 550:
+	case 603:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2848
+		//line sql.y:3121
 		{
 			unimplemented()
 		}
-	case 551:
+	case 604:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2850
+		//line sql.y:3123
 		{
 			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: "BTRIM"}, Exprs: sqlDollar[4].exprs}
 		}
-	case 552:
+	case 605:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2854
+		//line sql.y:3127
 		{
 			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: "LTRIM"}, Exprs: sqlDollar[4].exprs}
 		}
-	case 553:
+	case 606:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2858
+		//line sql.y:3131
 		{
 			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: "RTRIM"}, Exprs: sqlDollar[4].exprs}
 		}
-	case 554:
+	case 607:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2862
+		//line sql.y:3135
 		{
 			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: "BTRIM"}, Exprs: sqlDollar[3].exprs}
 		}
-	case 555:
+	case 608:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:2866
+		//line sql.y:3139
 		{
 			sqlVAL.expr = &IfExpr{Cond: sqlDollar[3].expr, True: sqlDollar[5].expr, Else: sqlDollar[7].expr}
 		}
-	case 556:
+	case 609:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2870
+		//line sql.y:3143
 		{
 			sqlVAL.expr = &NullIfExpr{Expr1: sqlDollar[3].expr, Expr2: sqlDollar[5].expr}
 		}
-	case 557:
+	case 610:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2874
+		//line sql.y:3147
 		{
 			sqlVAL.expr = &CoalesceExpr{Name: "IFNULL", Exprs: Exprs{sqlDollar[3].expr, sqlDollar[5].expr}}
 		}
-	case 558:
+	case 611:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2878
+		//line sql.y:3151
 		{
 			sqlVAL.expr = &CoalesceExpr{Name: "COALESCE", Exprs: sqlDollar[3].exprs}
 		}
-	case 559:
+	case 612:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2882
+		//line sql.y:3155
 		{
 			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name(sqlDollar[1].str)}, Exprs: sqlDollar[3].exprs}
 		}
-	case 560:
+	case 613:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2886
+		//line sql.y:3159
 		{
 			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name(sqlDollar[1].str)}, Exprs: sqlDollar[3].exprs}
 		}
-	case 561:
+	case 614:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2892
+		//line sql.y:3165
 		{
 			unimplemented()
 		}
-	case 562:
+	case 615:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2893
+		//line sql.y:3166
 		{
 		}
-	case 563:
+	case 616:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2896
+		//line sql.y:3169
 		{
 			unimplemented()
 		}
-	case 564:
+	case 617:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2897
+		//line sql.y:3170
 		{
 		}
-	case 565:
+	case 618:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2901
+		//line sql.y:3174
 		{
 			unimplemented()
 		}
-	case 566:
+	case 619:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2902
+		//line sql.y:3175
 		{
 		}
-	case 567:
+	case 620:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2905
+		//line sql.y:3178
 		{
 			unimplemented()
 		}
-	case 568:
+	case 621:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2906
+		//line sql.y:3179
 		{
 			unimplemented()
 		}
-	case 569:
+	case 622:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2909
+		//line sql.y:3182
 		{
 			unimplemented()
 		}
-	case 570:
+	case 623:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2912
+		//line sql.y:3185
 		{
 			unimplemented()
 		}
-	case 571:
+	case 624:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2913
+		//line sql.y:3186
 		{
 			unimplemented()
 		}
-	case 572:
+	case 625:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2914
+		//line sql.y:3187
 		{
 		}
-	case 573:
+	case 626:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2918
+		//line sql.y:3191
 		{
 			unimplemented()
 		}
-	case 574:
+	case 627:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2929
+		//line sql.y:3202
 		{
 			unimplemented()
 		}
-	case 575:
+	case 628:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2930
+		//line sql.y:3203
 		{
 		}
-	case 576:
+	case 629:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2933
+		//line sql.y:3206
 		{
 			unimplemented()
 		}
-	case 577:
+	case 630:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2934
+		//line sql.y:3207
 		{
 		}
-	case 578:
+	case 631:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2942
+		//line sql.y:3215
 		{
 			unimplemented()
 		}
-	case 579:
+	case 632:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2943
+		//line sql.y:3216
 		{
 			unimplemented()
 		}
-	case 580:
+	case 633:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2944
+		//line sql.y:3217
 		{
 		}
-	case 581:
+	case 634:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2947
+		//line sql.y:3220
 		{
 			unimplemented()
 		}
-	case 582:
+	case 635:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2948
+		//line sql.y:3221
 		{
 			unimplemented()
 		}
-	case 583:
+	case 636:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2954
+		//line sql.y:3227
 		{
 			unimplemented()
 		}
-	case 584:
+	case 637:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2955
+		//line sql.y:3228
 		{
 			unimplemented()
 		}
-	case 585:
+	case 638:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2956
+		//line sql.y:3229
 		{
 			unimplemented()
 		}
-	case 586:
+	case 639:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2957
+		//line sql.y:3230
 		{
 			unimplemented()
 		}
-	case 587:
+	case 640:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2958
+		//line sql.y:3231
 		{
 			unimplemented()
 		}
-	case 588:
+	case 641:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2969
+		//line sql.y:3242
 		{
 			sqlVAL.expr = Row(sqlDollar[3].exprs)
 		}
-	case 589:
+	case 642:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2973
+		//line sql.y:3246
 		{
 			sqlVAL.expr = Row(nil)
 		}
-	case 590:
+	case 643:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2977
+		//line sql.y:3250
 		{
 			sqlVAL.expr = Tuple(append(sqlDollar[2].exprs, sqlDollar[4].expr))
 		}
-	case 591:
+	case 644:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2983
+		//line sql.y:3256
 		{
 			sqlVAL.expr = Row(sqlDollar[3].exprs)
 		}
-	case 592:
+	case 645:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2987
+		//line sql.y:3260
 		{
 			sqlVAL.expr = Row(nil)
 		}
-	case 593:
+	case 646:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2993
+		//line sql.y:3266
 		{
 			sqlVAL.expr = Tuple(append(sqlDollar[2].exprs, sqlDollar[4].expr))
 		}
-	case 594:
+	case 647:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3034
+		//line sql.y:3307
 		{
 			sqlVAL.exprs = Exprs{sqlDollar[1].expr}
 		}
-	case 595:
+	case 648:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3038
+		//line sql.y:3311
 		{
 			sqlVAL.exprs = append(sqlDollar[1].exprs, sqlDollar[3].expr)
 		}
-	case 596:
+	case 649:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3044
+		//line sql.y:3317
 		{
 			sqlVAL.colTypes = []ColumnType{sqlDollar[1].colType}
 		}
-	case 597:
+	case 650:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3048
+		//line sql.y:3321
 		{
 			sqlVAL.colTypes = append(sqlDollar[1].colTypes, sqlDollar[3].colType)
 		}
-	case 598:
+	case 651:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3054
+		//line sql.y:3327
 		{
 			sqlVAL.expr = Array(sqlDollar[2].exprs)
 		}
-	case 599:
+	case 652:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3058
+		//line sql.y:3331
 		{
 			sqlVAL.expr = Array(sqlDollar[2].exprs)
 		}
-	case 600:
+	case 653:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3062
+		//line sql.y:3335
 		{
 			sqlVAL.expr = Array(nil)
 		}
-	case 601:
+	case 654:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3068
+		//line sql.y:3341
 		{
 			sqlVAL.exprs = Exprs{sqlDollar[1].expr}
 		}
-	case 602:
+	case 655:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3072
+		//line sql.y:3345
 		{
 			sqlVAL.exprs = append(sqlDollar[1].exprs, sqlDollar[3].expr)
 		}
-	case 603:
+	case 656:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3078
+		//line sql.y:3351
 		{
 			sqlVAL.exprs = Exprs{DString(sqlDollar[1].str), sqlDollar[3].expr}
 		}
-	case 611:
+	case 664:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3100
+		//line sql.y:3373
 		{
 			sqlVAL.exprs = Exprs{sqlDollar[1].expr, sqlDollar[2].expr, sqlDollar[3].expr, sqlDollar[4].expr}
 		}
-	case 612:
+	case 665:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3104
+		//line sql.y:3377
 		{
 			sqlVAL.exprs = Exprs{sqlDollar[1].expr, sqlDollar[2].expr, sqlDollar[3].expr}
 		}
-	case 613:
+	case 666:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3110
+		//line sql.y:3383
 		{
 			sqlVAL.expr = sqlDollar[2].expr
 		}
-	case 614:
+	case 667:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3117
+		//line sql.y:3390
 		{
 			sqlVAL.exprs = Exprs{sqlDollar[3].expr, sqlDollar[1].expr}
 		}
-	case 615:
+	case 668:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3121
+		//line sql.y:3394
 		{
 			sqlVAL.exprs = nil
 		}
-	case 616:
+	case 669:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3138
+		//line sql.y:3411
 		{
 			sqlVAL.exprs = Exprs{sqlDollar[1].expr, sqlDollar[2].expr, sqlDollar[3].expr}
 		}
-	case 617:
+	case 670:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3142
+		//line sql.y:3415
 		{
 			sqlVAL.exprs = Exprs{sqlDollar[1].expr, sqlDollar[3].expr, sqlDollar[2].expr}
 		}
-	case 618:
+	case 671:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3146
+		//line sql.y:3419
 		{
 			sqlVAL.exprs = Exprs{sqlDollar[1].expr, sqlDollar[2].expr}
 		}
-	case 619:
+	case 672:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3150
+		//line sql.y:3423
 		{
 			sqlVAL.exprs = Exprs{sqlDollar[1].expr, DInt(1), sqlDollar[2].expr}
 		}
-	case 620:
+	case 673:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3154
+		//line sql.y:3427
 		{
 			sqlVAL.exprs = sqlDollar[1].exprs
 		}
-	case 621:
+	case 674:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3158
+		//line sql.y:3431
 		{
 			sqlVAL.exprs = nil
 		}
-	case 622:
+	case 675:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3164
+		//line sql.y:3437
 		{
 			sqlVAL.expr = sqlDollar[2].expr
 		}
-	case 623:
+	case 676:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3170
+		//line sql.y:3443
 		{
 			sqlVAL.expr = sqlDollar[2].expr
 		}
-	case 624:
+	case 677:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3176
+		//line sql.y:3449
 		{
 			sqlVAL.exprs = append(sqlDollar[3].exprs, sqlDollar[1].expr)
 		}
-	case 625:
+	case 678:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3180
+		//line sql.y:3453
 		{
 			sqlVAL.exprs = sqlDollar[2].exprs
 		}
-	case 626:
+	case 679:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3184
+		//line sql.y:3457
 		{
 			sqlVAL.exprs = sqlDollar[1].exprs
 		}
-	case 627:
+	case 680:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3190
+		//line sql.y:3463
 		{
 			sqlVAL.expr = &Subquery{Select: sqlDollar[1].selectStmt}
 		}
-	case 628:
+	case 681:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3194
+		//line sql.y:3467
 		{
 			sqlVAL.expr = Tuple(sqlDollar[2].exprs)
 		}
-	case 629:
+	case 682:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3205
+		//line sql.y:3478
 		{
 			sqlVAL.expr = &CaseExpr{Expr: sqlDollar[2].expr, Whens: sqlDollar[3].whens, Else: sqlDollar[4].expr}
 		}
-	case 630:
+	case 683:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3212
+		//line sql.y:3485
 		{
 			sqlVAL.whens = []*When{sqlDollar[1].when}
 		}
-	case 631:
+	case 684:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3216
+		//line sql.y:3489
 		{
 			sqlVAL.whens = append(sqlDollar[1].whens, sqlDollar[2].when)
 		}
-	case 632:
+	case 685:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3222
+		//line sql.y:3495
 		{
 			sqlVAL.when = &When{Cond: sqlDollar[2].expr, Val: sqlDollar[4].expr}
 		}
-	case 633:
+	case 686:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3228
+		//line sql.y:3501
 		{
 			sqlVAL.expr = sqlDollar[2].expr
 		}
-	case 634:
+	case 687:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3232
+		//line sql.y:3505
 		{
 			sqlVAL.expr = nil
 		}
-	case 636:
+	case 689:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3239
+		//line sql.y:3512
 		{
 			sqlVAL.expr = nil
 		}
-	case 637:
+	case 690:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3245
+		//line sql.y:3518
 		{
 			sqlVAL.indirectElem = NameIndirection(sqlDollar[2].str)
 		}
-	case 638:
+	case 691:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3249
+		//line sql.y:3522
 		{
 			sqlVAL.indirectElem = qualifiedStar
 		}
-	case 639:
+	case 692:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3253
+		//line sql.y:3526
 		{
 			sqlVAL.indirectElem = IndexIndirection(sqlDollar[2].str)
 		}
-	case 640:
+	case 693:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3257
+		//line sql.y:3530
 		{
 			sqlVAL.indirectElem = &ArrayIndirection{Begin: sqlDollar[2].expr}
 		}
-	case 641:
+	case 694:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3261
+		//line sql.y:3534
 		{
 			sqlVAL.indirectElem = &ArrayIndirection{Begin: sqlDollar[2].expr, End: sqlDollar[4].expr}
 		}
-	case 642:
+	case 695:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3267
+		//line sql.y:3540
 		{
 			sqlVAL.indirect = Indirection{sqlDollar[1].indirectElem}
 		}
-	case 643:
+	case 696:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3271
+		//line sql.y:3544
 		{
 			sqlVAL.indirect = append(sqlDollar[1].indirect, sqlDollar[2].indirectElem)
 		}
-	case 644:
+	case 697:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3276
+		//line sql.y:3549
 		{
 		}
-	case 645:
+	case 698:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3277
+		//line sql.y:3550
 		{
 		}
-	case 647:
+	case 700:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3286
+		//line sql.y:3559
 		{
 			sqlVAL.expr = DefaultVal{}
 		}
-	case 648:
+	case 701:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3292
+		//line sql.y:3565
 		{
 			sqlVAL.exprs = []Expr{sqlDollar[1].expr}
 		}
-	case 649:
+	case 702:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3296
+		//line sql.y:3569
 		{
 			sqlVAL.exprs = append(sqlDollar[1].exprs, sqlDollar[3].expr)
 		}
-	case 650:
+	case 703:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3305
+		//line sql.y:3578
 		{
 			sqlVAL.exprs = sqlDollar[2].exprs
 		}
-	case 652:
+	case 705:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3313
+		//line sql.y:3586
 		{
 			sqlVAL.selExprs = nil
 		}
-	case 653:
+	case 706:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3319
+		//line sql.y:3592
 		{
 			sqlVAL.selExprs = SelectExprs{sqlDollar[1].selExpr}
 		}
-	case 654:
+	case 707:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3323
+		//line sql.y:3596
 		{
 			sqlVAL.selExprs = append(sqlDollar[1].selExprs, sqlDollar[3].selExpr)
 		}
-	case 655:
+	case 708:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3329
+		//line sql.y:3602
 		{
 			sqlVAL.selExpr = SelectExpr{Expr: sqlDollar[1].expr, As: Name(sqlDollar[3].str)}
 		}
-	case 656:
+	case 709:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3338
+		//line sql.y:3611
 		{
 			sqlVAL.selExpr = SelectExpr{Expr: sqlDollar[1].expr, As: Name(sqlDollar[2].str)}
 		}
-	case 657:
+	case 710:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3342
+		//line sql.y:3615
 		{
 			sqlVAL.selExpr = SelectExpr{Expr: sqlDollar[1].expr}
 		}
-	case 658:
+	case 711:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3346
+		//line sql.y:3619
 		{
 			sqlVAL.selExpr = StarSelectExpr()
 		}
-	case 659:
+	case 712:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3354
+		//line sql.y:3627
 		{
 			sqlVAL.qnames = QualifiedNames{sqlDollar[1].qname}
 		}
-	case 660:
+	case 713:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3358
+		//line sql.y:3631
 		{
 			sqlVAL.qnames = append(sqlDollar[1].qnames, sqlDollar[3].qname)
 		}
-	case 661:
+	case 714:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3369
+		//line sql.y:3642
 		{
 			sqlVAL.qname = &QualifiedName{Base: Name(sqlDollar[1].str)}
 		}
-	case 662:
+	case 715:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3373
+		//line sql.y:3646
 		{
 			sqlVAL.qname = &QualifiedName{Base: Name(sqlDollar[1].str), Indirect: sqlDollar[2].indirect}
 		}
-	case 663:
+	case 716:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3379
+		//line sql.y:3652
 		{
 			sqlVAL.strs = []string{sqlDollar[1].str}
 		}
-	case 664:
+	case 717:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3383
+		//line sql.y:3656
 		{
 			sqlVAL.strs = append(sqlDollar[1].strs, sqlDollar[3].str)
 		}
-	case 665:
+	case 718:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3389
+		//line sql.y:3662
 		{
 			sqlVAL.strs = sqlDollar[2].strs
 		}
-	case 666:
+	case 719:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3392
+		//line sql.y:3665
 		{
 		}
-	case 667:
+	case 720:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3402
+		//line sql.y:3675
 		{
 			sqlVAL.qname = &QualifiedName{Base: Name(sqlDollar[1].str)}
 		}
-	case 668:
+	case 721:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3406
+		//line sql.y:3679
 		{
 			sqlVAL.qname = &QualifiedName{Base: Name(sqlDollar[1].str), Indirect: sqlDollar[2].indirect}
 		}
-	case 669:
+	case 722:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3413
+		//line sql.y:3686
 		{
 			sqlVAL.expr = &IntVal{Val: sqlDollar[1].ival.Val, Str: sqlDollar[1].ival.Str}
 		}
-	case 670:
+	case 723:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3417
+		//line sql.y:3690
 		{
 			sqlVAL.expr = NumVal(sqlDollar[1].str)
 		}
-	case 671:
+	case 724:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3421
+		//line sql.y:3694
 		{
 			sqlVAL.expr = DString(sqlDollar[1].str)
 		}
-	case 672:
+	case 725:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3425
+		//line sql.y:3698
 		{
 			sqlVAL.expr = DBytes(sqlDollar[1].str)
 		}
-	case 673:
+	case 726:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:3428
+		//line sql.y:3701
 		{
 			unimplemented()
 		}
-	case 674:
+	case 727:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3430
+		//line sql.y:3703
 		{
 			sqlVAL.expr = &CastExpr{Expr: DString(sqlDollar[2].str), Type: sqlDollar[1].colType}
 		}
-	case 675:
+	case 728:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3434
+		//line sql.y:3707
 		{
 			// TODO(pmattis): support opt_interval?
 			sqlVAL.expr = &CastExpr{Expr: DString(sqlDollar[2].str), Type: sqlDollar[1].colType}
 		}
-	case 676:
+	case 729:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3439
+		//line sql.y:3712
 		{
 			// TODO(pmattis): Support the precision specification?
 			sqlVAL.expr = &CastExpr{Expr: DString(sqlDollar[5].str), Type: sqlDollar[1].colType}
 		}
-	case 677:
+	case 730:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3444
+		//line sql.y:3717
 		{
 			sqlVAL.expr = DBool(true)
 		}
-	case 678:
+	case 731:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3448
+		//line sql.y:3721
 		{
 			sqlVAL.expr = DBool(false)
 		}
-	case 679:
+	case 732:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3452
+		//line sql.y:3725
 		{
 			sqlVAL.expr = DNull
 		}
-	case 681:
+	case 734:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3459
+		//line sql.y:3732
 		{
 			sqlVAL.ival = sqlDollar[2].ival
 		}
-	case 682:
+	case 735:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3463
+		//line sql.y:3736
 		{
 			sqlVAL.ival = IntVal{Val: -sqlDollar[2].ival.Val, Str: "-" + sqlDollar[2].ival.Str}
 		}
-	case 687:
+	case 740:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3485
+		//line sql.y:3758
 		{
 			sqlVAL.str = ""
 		}
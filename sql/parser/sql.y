@@ -66,6 +66,7 @@ func unimplemented() {
   targetListPtr  *TargetList
   privilegeType  privilege.Kind
   privilegeList  privilege.List
+  privSpec       privilegeSpec
   orderBy        OrderBy
   orders         []*Order
   order          *Order
@@ -74,25 +75,37 @@ func unimplemented() {
   alterTableCmd  AlterTableCmd
   alterTableCmds AlterTableCmds
   isoLevel       IsolationLevel
+  kvOption       KVOption
+  kvOptions      KVOptions
 }
 
 %type <stmts> stmt_block
 %type <stmts> stmt_list
 %type <stmt> stmt
 
+%type <stmt> alter_group_stmt
 %type <stmt> alter_table_stmt
+%type <stmt> alter_user_stmt
+%type <stmt> alter_zone_stmt
+%type <stmt> backup_stmt
+%type <stmt> cancel_stmt
+%type <stmt> reset_stmt
 %type <stmt> create_stmt
 %type <stmt> create_database_stmt
 %type <stmt> create_index_stmt
 %type <stmt> create_table_stmt
+%type <stmt> create_user_stmt
 %type <stmt> delete_stmt
 %type <stmt> drop_stmt
 %type <stmt> explain_stmt
 %type <stmt> explainable_stmt
 %type <stmt> grant_stmt
+%type <stmt> import_stmt
 %type <stmt> insert_stmt
 %type <stmt> preparable_stmt
+%type <stmt> release_stmt
 %type <stmt> rename_stmt
+%type <stmt> restore_stmt
 %type <stmt> revoke_stmt
 %type <selectStmt> select_stmt
 %type <stmt> set_stmt
@@ -104,7 +117,9 @@ func unimplemented() {
 %type <selectStmt> select_no_parens select_with_parens select_clause
 %type <selectStmt> simple_select values_clause
 
-%type <empty> alter_column_default alter_using
+%type <expr> alter_column_default
+%type <expr> opt_password
+%type <empty> alter_using
 %type <dir> opt_asc_desc
 
 %type <alterTableCmd> alter_table_cmd
@@ -140,10 +155,14 @@ func unimplemented() {
 %type <strs> name_list opt_name_list
 %type <empty> opt_array_bounds
 %type <tblExprs> from_clause from_list
+%type <expr>  opt_as_of_clause
 %type <qnames> qualified_name_list
 %type <qname> any_name
 %type <qnames> any_name_list
 %type <exprs> expr_list
+%type <exprs> opt_incremental
+%type <kvOption> kv_option
+%type <kvOptions> opt_with_options kv_option_list
 %type <indirect> attrs
 %type <selExprs> target_list opt_target_list
 %type <updateExprs> set_clause_list
@@ -261,7 +280,7 @@ func unimplemented() {
 %type <targetList>    privilege_target
 %type <targetListPtr> on_privilege_target_clause
 %type <strs>          grantee_list for_grantee_clause
-%type <privilegeList> privileges privilege_list
+%type <privSpec>      privileges privilege_list
 %type <privilegeType> privilege
 
 // Non-keyword token types. These are hard-wired into the "flex" lexer. They
@@ -288,15 +307,15 @@ func unimplemented() {
 %token <str>   ALL ALTER ANALYSE ANALYZE AND ANY ARRAY AS ASC
 %token <str>   ASYMMETRIC AT
 
-%token <str>   BEGIN BETWEEN BIGINT BIT
+%token <str>   BACKUP BEGIN BETWEEN BIGINT BIT
 %token <str>   BLOB BOOL BOOLEAN BOTH BY BYTES
 
-%token <str>   CASCADE CASE CAST CHAR
+%token <str>   CANCEL CASCADE CASE CAST CHAR
 %token <str>   CHARACTER CHECK
 %token <str>   COALESCE COLLATE COLLATION COLUMN COLUMNS COMMIT
-%token <str>   COMMITTED CONCAT CONFLICT CONSTRAINT
+%token <str>   COMMITTED CONCAT CONFIGURATION CONFIGURE CONFLICT CONSTRAINT
 %token <str>   COVERING CREATE
-%token <str>   CROSS CUBE CURRENT CURRENT_CATALOG CURRENT_DATE
+%token <str>   CROSS CSV CUBE CURRENT CURRENT_CATALOG CURRENT_DATE
 %token <str>   CURRENT_ROLE CURRENT_TIME CURRENT_TIMESTAMP
 %token <str>   CURRENT_USER CYCLE
 
@@ -314,8 +333,8 @@ func unimplemented() {
 
 %token <str>   HAVING HOUR
 
-%token <str>   IF IFNULL IN
-%token <str>   INDEX INITIALLY
+%token <str>   IF IFNULL IMPORT IMPORTS IN
+%token <str>   INCREMENTAL INDEX INITIALLY
 %token <str>   INNER INSERT INT INT64 INTEGER
 %token <str>   INTERSECT INTERVAL INTO IS ISOLATION
 
@@ -336,26 +355,28 @@ func unimplemented() {
 %token <str>   OF OFF OFFSET ON ONLY OR
 %token <str>   ORDER ORDINALITY OUT OUTER OVER OVERLAPS OVERLAY
 
-%token <str>   PARTIAL PARTITION PLACING POSITION
-%token <str>   PRECEDING PRECISION PRIMARY
+%token <str>   PARTIAL PARTITION PASSWORD PLACING POSITION
+%token <str>   PRECEDING PRECISION PRIMARY PROTECTED
+
+%token <str>   QUERIES QUERY
 
 %token <str>   RANGE READ REAL RECURSIVE REF REFERENCES
-%token <str>   RENAME REPEATABLE
-%token <str>   RESTRICT RETURNING REVOKE RIGHT ROLLBACK ROLLUP
+%token <str>   RELEASE RENAME REPEATABLE
+%token <str>   RESET RESTORE RESTRICT RETURNING REVOKE RIGHT ROLLBACK ROLLUP
 %token <str>   ROW ROWS RSHIFT
 
 %token <str>   SEARCH SECOND SELECT
 %token <str>   SERIALIZABLE SESSION SESSION_USER SET SHOW
 %token <str>   SIMILAR SIMPLE SMALLINT SNAPSHOT SOME SQL
-%token <str>   STRICT STRING STORING SUBSTRING
-%token <str>   SYMMETRIC
+%token <str>   STATEMENT STATISTICS STRICT STRING STORING SUBSTRING
+%token <str>   SYMMETRIC SYSTEM
 
 %token <str>   TABLE TABLES TEXT THEN
-%token <str>   TIME TIMESTAMP TO TRAILING TRANSACTION TREAT TRIM TRUE
+%token <str>   TIME TIMESTAMP TIMESTAMPS TO TRAILING TRANSACTION TREAT TRIM TRUE
 %token <str>   TRUNCATE TYPE
 
 %token <str>   UNBOUNDED UNCOMMITTED UNION UNIQUE UNKNOWN
-%token <str>   UPDATE USER USING
+%token <str>   UPDATE USER USERS USING
 
 %token <str>   VALID VALIDATE VALUE VALUES VARCHAR VARIADIC VARYING
 
@@ -372,8 +393,9 @@ func unimplemented() {
 // NOT_LA exists so that productions such as NOT LIKE can be given the same
 // precedence as LIKE; otherwise they'd effectively have the same precedence as
 // NOT, at least with respect to their left-hand subexpression. WITH_LA is
-// needed to make the grammar LALR(1).
-%token     NOT_LA WITH_LA
+// needed to make the grammar LALR(1). AS_LA distinguishes AS OF SYSTEM TIME
+// from an alias named "of".
+%token     NOT_LA WITH_LA AS_LA
 
 // Precedence: lowest to highest
 %nonassoc  SET                 // see relation_expr_opt_alias
@@ -461,14 +483,23 @@ stmt_list:
   }
 
 stmt:
-  alter_table_stmt
+  alter_group_stmt
+| alter_table_stmt
+| alter_user_stmt
+| alter_zone_stmt
+| backup_stmt
+| cancel_stmt
 | create_stmt
 | delete_stmt
 | drop_stmt
 | explain_stmt
 | grant_stmt
+| import_stmt
 | insert_stmt
+| release_stmt
 | rename_stmt
+| reset_stmt
+| restore_stmt
 | revoke_stmt
 | select_stmt
   {
@@ -484,6 +515,36 @@ stmt:
     $$ = nil
   }
 
+// ALTER GROUP name { ADD | DROP } USER name [, name ...]
+alter_group_stmt:
+  ALTER GROUP name ADD USER name_list
+  {
+    $$ = &AlterGroup{Name: Name($3), Add: true, Members: NameList($6)}
+  }
+| ALTER GROUP name DROP USER name_list
+  {
+    $$ = &AlterGroup{Name: Name($3), Add: false, Members: NameList($6)}
+  }
+
+// ALTER USER name WITH PASSWORD password
+alter_user_stmt:
+  ALTER USER name WITH PASSWORD a_expr
+  {
+    $$ = &AlterUser{Name: Name($3), Password: $6}
+  }
+
+// ALTER TABLE <name> CONFIGURE ZONE <config>
+// ALTER DATABASE <name> CONFIGURE ZONE <config>
+alter_zone_stmt:
+  ALTER TABLE qualified_name CONFIGURE ZONE a_expr
+  {
+    $$ = &ConfigureZone{ZoneSpecifier: ZoneSpecifier{Table: $3}, YAMLConfig: $6}
+  }
+| ALTER DATABASE name CONFIGURE ZONE a_expr
+  {
+    $$ = &ConfigureZone{ZoneSpecifier: ZoneSpecifier{Database: Name($3)}, YAMLConfig: $6}
+  }
+
 alter_table_stmt:
   ALTER TABLE relation_expr alter_table_cmds
   {
@@ -526,11 +587,20 @@ alter_table_cmd:
     $$ = &AlterTableAddColumn{columnKeyword: true, IfNotExists: true, ColumnDef: $6}
   }
   // ALTER TABLE <name> ALTER [COLUMN] <colname> {SET DEFAULT <expr>|DROP DEFAULT}
-| ALTER opt_column name alter_column_default { unimplemented() }
+| ALTER opt_column name alter_column_default
+  {
+    $$ = &AlterTableSetDefault{columnKeyword: $2, Column: $3, Default: $4}
+  }
   // ALTER TABLE <name> ALTER [COLUMN] <colname> DROP NOT NULL
-| ALTER opt_column name DROP NOT NULL { unimplemented() }
+| ALTER opt_column name DROP NOT NULL
+  {
+    $$ = &AlterTableDropNotNull{columnKeyword: $2, Column: $3}
+  }
   // ALTER TABLE <name> ALTER [COLUMN] <colname> SET NOT NULL
-| ALTER opt_column name SET NOT NULL { unimplemented() }
+| ALTER opt_column name SET NOT NULL
+  {
+    $$ = &AlterTableSetNotNull{columnKeyword: $2, Column: $3}
+  }
   // ALTER TABLE <name> DROP [COLUMN] IF EXISTS <colname> [RESTRICT|CASCADE]
 | DROP opt_column IF EXISTS name opt_drop_behavior
   {
@@ -565,8 +635,14 @@ alter_table_cmd:
   }
 
 alter_column_default:
-  SET DEFAULT a_expr { unimplemented() }
-| DROP DEFAULT { unimplemented() }
+  SET DEFAULT a_expr
+  {
+    $$ = $3
+  }
+| DROP DEFAULT
+  {
+    $$ = nil
+  }
 
 opt_drop_behavior:
   CASCADE { unimplemented() }
@@ -581,11 +657,19 @@ alter_using:
   USING a_expr { unimplemented() }
 | /* EMPTY */ {}
 
-// CREATE [DATABASE|INDEX|TABLE|TABLE AS]
+// CANCEL QUERY
+cancel_stmt:
+  CANCEL QUERY a_expr
+  {
+    $$ = &CancelQuery{ID: $3}
+  }
+
+// CREATE [DATABASE|INDEX|TABLE|TABLE AS|USER]
 create_stmt:
   create_database_stmt
 | create_index_stmt
 | create_table_stmt
+| create_user_stmt
 
 // DELETE FROM query
 delete_stmt:
@@ -604,6 +688,22 @@ drop_stmt:
   {
     $$ = &DropDatabase{Name: Name($5), IfExists: true}
   }
+| DROP GROUP name
+  {
+    $$ = &DropGroup{Name: Name($3), IfExists: false}
+  }
+| DROP GROUP IF EXISTS name
+  {
+    $$ = &DropGroup{Name: Name($5), IfExists: true}
+  }
+| DROP USER name_list
+  {
+    $$ = &DropUser{Names: NameList($3), IfExists: false}
+  }
+| DROP USER IF EXISTS name_list
+  {
+    $$ = &DropUser{Names: NameList($5), IfExists: true}
+  }
 | DROP INDEX qualified_name_list opt_drop_behavior
   {
     $$ = &DropIndex{Names: $3, IfExists: false}
@@ -657,6 +757,10 @@ explain_stmt:
   {
     $$ = &Explain{Statement: $2}
   }
+| EXPLAIN ANALYZE explainable_stmt
+  {
+    $$ = &Explain{Options: []string{"ANALYZE"}, Statement: $3}
+  }
 | EXPLAIN '(' explain_option_list ')' explainable_stmt
   {
     $$ = &Explain{Options: $3, Statement: $5}
@@ -683,19 +787,89 @@ explain_option_list:
 
 explain_option_name:
   non_reserved_word
+| ANALYZE
+
+// BACKUP [privilege_target] TO uri [AS OF SYSTEM TIME expr]
+//   [INCREMENTAL FROM uri [, uri ...]]
+backup_stmt:
+  BACKUP privilege_target TO a_expr opt_as_of_clause opt_incremental
+  {
+    tmp := $2
+    $$ = &Backup{Targets: &tmp, To: $4, AsOf: AsOfClause{Expr: $5}, IncrementalFrom: $6}
+  }
+| BACKUP TO a_expr opt_as_of_clause opt_incremental
+  {
+    $$ = &Backup{To: $3, AsOf: AsOfClause{Expr: $4}, IncrementalFrom: $5}
+  }
+
+opt_incremental:
+  INCREMENTAL FROM expr_list
+  {
+    $$ = $3
+  }
+| /* EMPTY */
+  {
+    $$ = nil
+  }
+
+// RESTORE [privilege_target] FROM uri [, uri ...]
+restore_stmt:
+  RESTORE privilege_target FROM expr_list
+  {
+    tmp := $2
+    $$ = &Restore{Targets: &tmp, From: $4}
+  }
+| RESTORE FROM expr_list
+  {
+    $$ = &Restore{From: $3}
+  }
+
+// IMPORT TABLE name (table_elem_list) CSV DATA (uri [, uri ...])
+//   [WITH option = value [, ...]]
+import_stmt:
+  IMPORT TABLE any_name '(' opt_table_elem_list ')' CSV DATA '(' expr_list ')' opt_with_options
+  {
+    $$ = &Import{Table: $3, Defs: $5, Files: $10, Options: $12}
+  }
+
+opt_with_options:
+  WITH kv_option_list
+  {
+    $$ = $2
+  }
+| /* EMPTY */
+  {
+    $$ = nil
+  }
+
+kv_option_list:
+  kv_option
+  {
+    $$ = []KVOption{$1}
+  }
+| kv_option_list ',' kv_option
+  {
+    $$ = append($1, $3)
+  }
+
+kv_option:
+  name '=' a_expr
+  {
+    $$ = KVOption{Key: Name($1), Value: $3}
+  }
 
 // GRANT privileges ON privilege_target TO grantee_list
 grant_stmt:
   GRANT privileges ON privilege_target TO grantee_list
   {
-    $$ = &Grant{Privileges: $2, Grantees: NameList($6), Targets: $4}
+    $$ = &Grant{Privileges: $2.privileges, ColumnPrivileges: $2.columns, Grantees: NameList($6), Targets: $4}
   }
 
 // REVOKE privileges ON privilege_target FROM grantee_list
 revoke_stmt:
   REVOKE privileges ON privilege_target FROM grantee_list
   {
-    $$ = &Revoke{Privileges: $2, Grantees: NameList($6), Targets: $4}
+    $$ = &Revoke{Privileges: $2.privileges, ColumnPrivileges: $2.columns, Grantees: NameList($6), Targets: $4}
   }
 
 
@@ -714,23 +888,43 @@ privilege_target:
   {
     $$ = TargetList{Databases: NameList($2)}
   }
+| ALL TABLES IN DATABASE name_list
+  {
+    // The tables of a database are all matched by "database.*".
+    var tables QualifiedNames
+    for _, db := range $5 {
+      tables = append(tables, &QualifiedName{Base: Name(db), Indirect: Indirection{qualifiedStar}})
+    }
+    $$ = TargetList{Tables: tables}
+  }
 
 // ALL is always by itself.
 privileges:
   ALL
   {
-    $$ = privilege.List{privilege.ALL}
+    $$ = privilegeSpec{privileges: privilege.List{privilege.ALL}}
   }
   | privilege_list { }
 
+// A privilege followed by a list of columns only applies to those columns.
 privilege_list:
   privilege
   {
-    $$ = privilege.List{$1}
+    $$ = privilegeSpec{privileges: privilege.List{$1}}
+  }
+  | privilege '(' name_list ')'
+  {
+    $$ = privilegeSpec{columns: ColumnPrivileges{{Privilege: $1, Columns: NameList($3)}}}
   }
   | privilege_list ',' privilege
   {
-    $$ = append($1, $3)
+    $$ = $1
+    $$.privileges = append($$.privileges, $3)
+  }
+  | privilege_list ',' privilege '(' name_list ')'
+  {
+    $$ = $1
+    $$.columns = append($$.columns, ColumnPrivilege{Privilege: $3, Columns: NameList($5)})
   }
 
 // This list must match the list of privileges in sql/privilege/privilege.go.
@@ -968,10 +1162,34 @@ show_stmt:
   {
     $$ = &ShowGrants{Targets: $3, Grantees: $4}
   }
+| SHOW IMPORTS
+  {
+    $$ = &ShowImports{}
+  }
 | SHOW INDEX FROM var_name
   {
     $$ = &ShowIndex{Table: $4}
   }
+| SHOW PROTECTED TIMESTAMPS
+  {
+    $$ = &ShowProtectedTimestamps{}
+  }
+| SHOW QUERIES
+  {
+    $$ = &ShowQueries{}
+  }
+| SHOW USERS
+  {
+    $$ = &ShowUsers{}
+  }
+| SHOW STATEMENT STATISTICS
+  {
+    $$ = &ShowStatementStatistics{}
+  }
+| SHOW TABLE STATISTICS FROM var_name
+  {
+    $$ = &ShowTableStatistics{Table: $5}
+  }
 | SHOW TABLES opt_from_var_name_clause
   {
     $$ = &ShowTables{Name: $3}
@@ -984,6 +1202,14 @@ show_stmt:
   {
     $$ = &Show{Name: "TRANSACTION ISOLATION LEVEL"}
   }
+| SHOW ZONE CONFIGURATION FOR TABLE qualified_name
+  {
+    $$ = &ShowZoneConfig{ZoneSpecifier: ZoneSpecifier{Table: $6}}
+  }
+| SHOW ZONE CONFIGURATION FOR DATABASE name
+  {
+    $$ = &ShowZoneConfig{ZoneSpecifier: ZoneSpecifier{Database: Name($6)}}
+  }
 | SHOW ALL
   {
     $$ = nil
@@ -1396,6 +1622,20 @@ opt_set_data:
   SET DATA {}
 | /* EMPTY */ {}
 
+// RESET STATEMENT STATISTICS
+reset_stmt:
+  RESET STATEMENT STATISTICS
+  {
+    $$ = &ResetStatementStatistics{}
+  }
+
+// RELEASE PROTECTED TIMESTAMP
+release_stmt:
+  RELEASE PROTECTED TIMESTAMP a_expr
+  {
+    $$ = &ReleaseProtectedTimestamp{ID: $4}
+  }
+
 // BEGIN / COMMIT / ROLLBACK / ...
 transaction_stmt:
   BEGIN opt_transaction opt_transaction_iso_level
@@ -1438,6 +1678,27 @@ create_database_stmt:
     $$ = &CreateDatabase{IfNotExists: true, Name: Name($6)}
   }
 
+// CREATE USER name [WITH PASSWORD password]
+create_user_stmt:
+  CREATE USER name opt_password
+  {
+    $$ = &CreateUser{Name: Name($3), Password: $4}
+  }
+| CREATE USER IF NOT EXISTS name opt_password
+  {
+    $$ = &CreateUser{IfNotExists: true, Name: Name($6), Password: $7}
+  }
+
+opt_password:
+  WITH PASSWORD a_expr
+  {
+    $$ = $3
+  }
+| /* EMPTY */
+  {
+    $$ = nil
+  }
+
 insert_stmt:
   opt_with_clause INSERT INTO insert_target insert_rest opt_on_conflict
   {
@@ -1646,28 +1907,30 @@ select_clause:
 // this is not checked by the grammar; parse analysis must check it.
 simple_select:
   SELECT opt_all_clause opt_target_list
-    from_clause where_clause
+    from_clause opt_as_of_clause where_clause
     group_clause having_clause window_clause
   {
     $$ = &Select{
       Exprs:   $3,
       From:    $4,
-      Where:   newWhere(astWhere, $5),
-      GroupBy: $6,
-      Having:  newWhere(astHaving, $7),
+      AsOf:    AsOfClause{Expr: $5},
+      Where:   newWhere(astWhere, $6),
+      GroupBy: $7,
+      Having:  newWhere(astHaving, $8),
     }
   }
 | SELECT distinct_clause target_list
-    from_clause where_clause
+    from_clause opt_as_of_clause where_clause
     group_clause having_clause window_clause
   {
     $$ = &Select{
       Distinct: $2,
       Exprs:    $3,
       From:     $4,
-      Where:    newWhere(astWhere, $5),
-      GroupBy:  $6,
-      Having:   newWhere(astHaving, $7),
+      AsOf:     AsOfClause{Expr: $5},
+      Where:    newWhere(astWhere, $6),
+      GroupBy:  $7,
+      Having:   newWhere(astHaving, $8),
     }
   }
 | values_clause
@@ -1934,6 +2197,16 @@ from_clause:
     $$ = nil
   }
 
+opt_as_of_clause:
+  AS_LA OF SYSTEM TIME a_expr
+  {
+    $$ = $5
+  }
+| /* EMPTY */
+  {
+    $$ = nil
+  }
+
 from_list:
   table_ref
   {
@@ -3525,15 +3798,20 @@ unreserved_keyword:
 | ADD
 | ALTER
 | AT
+| BACKUP
 | BEGIN
 | BLOB
 | BY
+| CANCEL
 | CASCADE
 | COLUMNS
 | COMMIT
 | COMMITTED
+| CONFIGURATION
+| CONFIGURE
 | CONFLICT
 | COVERING
+| CSV
 | CUBE
 | CURRENT
 | CYCLE
@@ -3550,6 +3828,9 @@ unreserved_keyword:
 | FOLLOWING
 | GRANTS
 | HOUR
+| IMPORT
+| IMPORTS
+| INCREMENTAL
 | INSERT
 | ISOLATION
 | KEY
@@ -3570,13 +3851,20 @@ unreserved_keyword:
 | OVER
 | PARTIAL
 | PARTITION
+| PASSWORD
 | PRECEDING
+| PROTECTED
+| QUERIES
+| QUERY
 | RANGE
 | READ
 | RECURSIVE
 | REF
+| RELEASE
 | RENAME
 | REPEATABLE
+| RESET
+| RESTORE
 | RESTRICT
 | REVOKE
 | ROLLBACK
@@ -3591,10 +3879,14 @@ unreserved_keyword:
 | SIMPLE
 | SNAPSHOT
 | SQL
+| STATEMENT
+| STATISTICS
 | STORING
 | STRICT
+| SYSTEM
 | TABLES
 | TEXT
+| TIMESTAMPS
 | TRANSACTION
 | TRUNCATE
 | TYPE
@@ -3602,6 +3894,7 @@ unreserved_keyword:
 | UNCOMMITTED
 | UNKNOWN
 | UPDATE
+| USERS
 | VALID
 | VALIDATE
 | VALUE
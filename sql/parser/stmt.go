@@ -58,15 +58,30 @@ type Statement interface {
 	StatementType() StatementType
 }
 
+// StatementType implements the Statement interface.
+func (*AlterGroup) StatementType() StatementType { return DDL }
+
 // StatementType implements the Statement interface.
 func (*AlterTable) StatementType() StatementType { return DDL }
 
+// StatementType implements the Statement interface.
+func (*AlterUser) StatementType() StatementType { return DDL }
+
+// StatementType implements the Statement interface.
+func (*Backup) StatementType() StatementType { return Ack }
+
 // StatementType implements the Statement interface.
 func (*BeginTransaction) StatementType() StatementType { return Ack }
 
+// StatementType implements the Statement interface.
+func (*CancelQuery) StatementType() StatementType { return Ack }
+
 // StatementType implements the Statement interface.
 func (*CommitTransaction) StatementType() StatementType { return Ack }
 
+// StatementType implements the Statement interface.
+func (*ConfigureZone) StatementType() StatementType { return DDL }
+
 // StatementType implements the Statement interface.
 func (*CreateDatabase) StatementType() StatementType { return DDL }
 
@@ -76,30 +91,45 @@ func (*CreateIndex) StatementType() StatementType { return DDL }
 // StatementType implements the Statement interface.
 func (*CreateTable) StatementType() StatementType { return DDL }
 
+// StatementType implements the Statement interface.
+func (*CreateUser) StatementType() StatementType { return DDL }
+
 // StatementType implements the Statement interface.
 func (*Delete) StatementType() StatementType { return RowsAffected }
 
 // StatementType implements the Statement interface.
 func (*DropDatabase) StatementType() StatementType { return DDL }
 
+// StatementType implements the Statement interface.
+func (*DropGroup) StatementType() StatementType { return DDL }
+
 // StatementType implements the Statement interface.
 func (*DropIndex) StatementType() StatementType { return DDL }
 
 // StatementType implements the Statement interface.
 func (*DropTable) StatementType() StatementType { return DDL }
 
+// StatementType implements the Statement interface.
+func (*DropUser) StatementType() StatementType { return DDL }
+
 // StatementType implements the Statement interface.
 func (*Explain) StatementType() StatementType { return Rows }
 
 // StatementType implements the Statement interface.
 func (*Grant) StatementType() StatementType { return DDL }
 
+// StatementType implements the Statement interface.
+func (*Import) StatementType() StatementType { return DDL }
+
 // StatementType implements the Statement interface.
 func (*Insert) StatementType() StatementType { return RowsAffected }
 
 // StatementType implements the Statement interface.
 func (*ParenSelect) StatementType() StatementType { return Rows }
 
+// StatementType implements the Statement interface.
+func (*ReleaseProtectedTimestamp) StatementType() StatementType { return Ack }
+
 // StatementType implements the Statement interface.
 func (*RenameColumn) StatementType() StatementType { return DDL }
 
@@ -112,6 +142,12 @@ func (*RenameIndex) StatementType() StatementType { return DDL }
 // StatementType implements the Statement interface.
 func (*RenameTable) StatementType() StatementType { return DDL }
 
+// StatementType implements the Statement interface.
+func (*ResetStatementStatistics) StatementType() StatementType { return Ack }
+
+// StatementType implements the Statement interface.
+func (*Restore) StatementType() StatementType { return DDL }
+
 // StatementType implements the Statement interface.
 func (*Revoke) StatementType() StatementType { return DDL }
 
@@ -142,12 +178,33 @@ func (*ShowDatabases) StatementType() StatementType { return Rows }
 // StatementType implements the Statement interface.
 func (*ShowGrants) StatementType() StatementType { return Rows }
 
+// StatementType implements the Statement interface.
+func (*ShowImports) StatementType() StatementType { return Rows }
+
 // StatementType implements the Statement interface.
 func (*ShowIndex) StatementType() StatementType { return Rows }
 
+// StatementType implements the Statement interface.
+func (*ShowProtectedTimestamps) StatementType() StatementType { return Rows }
+
+// StatementType implements the Statement interface.
+func (*ShowQueries) StatementType() StatementType { return Rows }
+
+// StatementType implements the Statement interface.
+func (*ShowStatementStatistics) StatementType() StatementType { return Rows }
+
+// StatementType implements the Statement interface.
+func (*ShowTableStatistics) StatementType() StatementType { return Rows }
+
 // StatementType implements the Statement interface.
 func (*ShowTables) StatementType() StatementType { return Rows }
 
+// StatementType implements the Statement interface.
+func (*ShowUsers) StatementType() StatementType { return Rows }
+
+// StatementType implements the Statement interface.
+func (*ShowZoneConfig) StatementType() StatementType { return Rows }
+
 // StatementType implements the Statement interface.
 func (*Truncate) StatementType() StatementType { return Ack }
 
@@ -255,20 +255,54 @@ func FillArgs(stmt Statement, args Args) error {
 // by WalkExpr.
 func WalkStmt(v Visitor, stmt Statement) {
 	switch stmt := stmt.(type) {
+	case *AlterUser:
+		stmt.Password = WalkExpr(v, stmt.Password)
+	case *Backup:
+		stmt.To = WalkExpr(v, stmt.To)
+		if stmt.AsOf.Expr != nil {
+			stmt.AsOf.Expr = WalkExpr(v, stmt.AsOf.Expr)
+		}
+		for i, expr := range stmt.IncrementalFrom {
+			stmt.IncrementalFrom[i] = WalkExpr(v, expr)
+		}
+	case *CancelQuery:
+		stmt.ID = WalkExpr(v, stmt.ID)
+	case *ConfigureZone:
+		stmt.YAMLConfig = WalkExpr(v, stmt.YAMLConfig)
+	case *CreateUser:
+		if stmt.Password != nil {
+			stmt.Password = WalkExpr(v, stmt.Password)
+		}
 	case *Delete:
 		if stmt.Where != nil {
 			stmt.Where.Expr = WalkExpr(v, stmt.Where.Expr)
 		}
 	case *Explain:
 		WalkStmt(v, stmt.Statement)
+	case *Import:
+		for i, expr := range stmt.Files {
+			stmt.Files[i] = WalkExpr(v, expr)
+		}
+		for i, opt := range stmt.Options {
+			stmt.Options[i].Value = WalkExpr(v, opt.Value)
+		}
 	case *Insert:
 		WalkStmt(v, stmt.Rows)
 	case *ParenSelect:
 		WalkStmt(v, stmt.Select)
+	case *ReleaseProtectedTimestamp:
+		stmt.ID = WalkExpr(v, stmt.ID)
+	case *Restore:
+		for i, expr := range stmt.From {
+			stmt.From[i] = WalkExpr(v, expr)
+		}
 	case *Select:
 		for i := range stmt.Exprs {
 			stmt.Exprs[i].Expr = WalkExpr(v, stmt.Exprs[i].Expr)
 		}
+		if stmt.AsOf.Expr != nil {
+			stmt.AsOf.Expr = WalkExpr(v, stmt.AsOf.Expr)
+		}
 		if stmt.Where != nil {
 			stmt.Where.Expr = WalkExpr(v, stmt.Where.Expr)
 		}
@@ -0,0 +1,53 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package parser
+
+import "fmt"
+
+// ZoneSpecifier identifies the table or database whose zone config is
+// configured or shown.
+type ZoneSpecifier struct {
+	Database Name
+	Table    *QualifiedName
+}
+
+func (node ZoneSpecifier) String() string {
+	if node.Table != nil {
+		return fmt.Sprintf("TABLE %s", node.Table)
+	}
+	return fmt.Sprintf("DATABASE %s", node.Database)
+}
+
+// ConfigureZone represents an ALTER TABLE ... CONFIGURE ZONE or an ALTER
+// DATABASE ... CONFIGURE ZONE statement. A NULL config removes the zone
+// config of the object.
+type ConfigureZone struct {
+	ZoneSpecifier
+	YAMLConfig Expr
+}
+
+func (node *ConfigureZone) String() string {
+	return fmt.Sprintf("ALTER %s CONFIGURE ZONE %s", node.ZoneSpecifier, node.YAMLConfig)
+}
+
+// ShowZoneConfig represents a SHOW ZONE CONFIGURATION statement.
+type ShowZoneConfig struct {
+	ZoneSpecifier
+}
+
+func (node *ShowZoneConfig) String() string {
+	return fmt.Sprintf("SHOW ZONE CONFIGURATION FOR %s", node.ZoneSpecifier)
+}
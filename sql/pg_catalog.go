@@ -0,0 +1,269 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"bytes"
+	"fmt"
+	"hash/fnv"
+
+	"github.com/lib/pq/oid"
+
+	"github.com/cockroachdb/cockroach/sql/parser"
+)
+
+const pgCatalogName = "pg_catalog"
+
+// pgCatalog describes the databases, tables, columns, indexes and types
+// as the postgres system catalogs do, for the clients which introspect the
+// schema through them. Databases are namespaces, whose oids are their IDs,
+// as are the oids of the tables. Only the columns clients commonly use are
+// provided.
+// Privileges: None.
+var pgCatalog = virtualSchema{
+	name: pgCatalogName,
+	tables: []virtualSchemaTable{
+		pgCatalogAttribute,
+		pgCatalogClass,
+		pgCatalogIndex,
+		pgCatalogNamespace,
+		pgCatalogType,
+	},
+}
+
+// pgTypeInfo describes the postgres type of a column type.
+type pgTypeInfo struct {
+	kind     ColumnType_Kind
+	oid      oid.Oid
+	name     string
+	length   int
+	byValue  bool
+	category string
+}
+
+// pgTypes are ordered by oid.
+var pgTypes = []pgTypeInfo{
+	{ColumnType_BOOL, oid.T_bool, "bool", 1, true, "B"},
+	{ColumnType_BYTES, oid.T_bytea, "bytea", -1, false, "U"},
+	{ColumnType_INT, oid.T_int8, "int8", 8, true, "N"},
+	{ColumnType_STRING, oid.T_text, "text", -1, false, "S"},
+	{ColumnType_FLOAT, oid.T_float8, "float8", 8, true, "N"},
+	{ColumnType_DATE, oid.T_date, "date", 4, true, "D"},
+	{ColumnType_TIMESTAMP, oid.T_timestamp, "timestamp", 8, true, "D"},
+	{ColumnType_INTERVAL, oid.T_interval, "interval", 16, false, "T"},
+	{ColumnType_DECIMAL, oid.T_numeric, "numeric", -1, false, "N"},
+}
+
+func pgTypeForKind(kind ColumnType_Kind) pgTypeInfo {
+	for _, t := range pgTypes {
+		if t.kind == kind {
+			return t
+		}
+	}
+	return pgTypeInfo{kind: kind, name: kind.String()}
+}
+
+// pgIndexOid returns the oid of an index. Indexes have no descriptor ID of
+// their own, so the oid is derived from the IDs of the table and the index.
+func pgIndexOid(table *TableDescriptor, index *IndexDescriptor) parser.DInt {
+	h := fnv.New32()
+	fmt.Fprintf(h, "%d/%d", table.ID, index.ID)
+	return parser.DInt(h.Sum32())
+}
+
+// pgIndexes returns the indexes of a table. Virtual tables have none.
+func pgIndexes(table *TableDescriptor) []IndexDescriptor {
+	if isVirtualDescriptor(table) {
+		return nil
+	}
+	return append([]IndexDescriptor{table.PrimaryIndex}, table.Indexes...)
+}
+
+var pgCatalogAttribute = virtualSchemaTable{
+	schema: `
+CREATE TABLE pg_catalog.pg_attribute (
+  attrelid     INT NOT NULL,
+  attname      STRING NOT NULL,
+  atttypid     INT NOT NULL,
+  attlen       INT NOT NULL,
+  attnum       INT NOT NULL,
+  attnotnull   BOOL NOT NULL,
+  atthasdef    BOOL NOT NULL,
+  attisdropped BOOL NOT NULL
+);`,
+	populate: func(p *planner, addRow func(...parser.Datum)) error {
+		return p.forEachTableDesc(func(db *DatabaseDescriptor, table *TableDescriptor) {
+			// Column IDs are stable, as are postgres attribute numbers.
+			for _, col := range table.Columns {
+				typ := pgTypeForKind(col.Type.Kind)
+				addRow(
+					parser.DInt(table.ID),
+					parser.DString(col.Name),
+					parser.DInt(typ.oid),
+					parser.DInt(typ.length),
+					parser.DInt(col.ID),
+					parser.DBool(!col.Nullable),
+					parser.DBool(col.DefaultExpr != nil),
+					parser.DBool(false),
+				)
+			}
+			for _, index := range pgIndexes(table) {
+				for i, colID := range index.ColumnIDs {
+					col, err := table.FindColumnByID(colID)
+					if err != nil {
+						continue
+					}
+					typ := pgTypeForKind(col.Type.Kind)
+					addRow(
+						pgIndexOid(table, &index),
+						parser.DString(col.Name),
+						parser.DInt(typ.oid),
+						parser.DInt(typ.length),
+						parser.DInt(i+1),
+						parser.DBool(!col.Nullable),
+						parser.DBool(false),
+						parser.DBool(false),
+					)
+				}
+			}
+		})
+	},
+}
+
+var pgCatalogClass = virtualSchemaTable{
+	schema: `
+CREATE TABLE pg_catalog.pg_class (
+  oid          INT NOT NULL,
+  relname      STRING NOT NULL,
+  relnamespace INT NOT NULL,
+  relkind      STRING NOT NULL,
+  relnatts     INT NOT NULL,
+  relhasindex  BOOL NOT NULL
+);`,
+	populate: func(p *planner, addRow func(...parser.Datum)) error {
+		return p.forEachTableDesc(func(db *DatabaseDescriptor, table *TableDescriptor) {
+			// Tables are "r"elations, virtual tables are "v"iews.
+			relKind := "r"
+			if isVirtualDescriptor(table) {
+				relKind = "v"
+			}
+			indexes := pgIndexes(table)
+			addRow(
+				parser.DInt(table.ID),
+				parser.DString(table.Name),
+				parser.DInt(db.ID),
+				parser.DString(relKind),
+				parser.DInt(len(table.Columns)),
+				parser.DBool(len(indexes) > 0),
+			)
+			for _, index := range indexes {
+				addRow(
+					pgIndexOid(table, &index),
+					parser.DString(index.Name),
+					parser.DInt(db.ID),
+					parser.DString("i"),
+					parser.DInt(len(index.ColumnIDs)),
+					parser.DBool(false),
+				)
+			}
+		})
+	},
+}
+
+var pgCatalogIndex = virtualSchemaTable{
+	schema: `
+CREATE TABLE pg_catalog.pg_index (
+  indexrelid   INT NOT NULL,
+  indrelid     INT NOT NULL,
+  indnatts     INT NOT NULL,
+  indisunique  BOOL NOT NULL,
+  indisprimary BOOL NOT NULL,
+  indkey       STRING NOT NULL
+);`,
+	populate: func(p *planner, addRow func(...parser.Datum)) error {
+		return p.forEachTableDesc(func(db *DatabaseDescriptor, table *TableDescriptor) {
+			for i, index := range pgIndexes(table) {
+				// indkey lists the attribute numbers of the indexed columns.
+				var indKey bytes.Buffer
+				for j, colID := range index.ColumnIDs {
+					if j > 0 {
+						indKey.WriteString(" ")
+					}
+					fmt.Fprintf(&indKey, "%d", colID)
+				}
+				addRow(
+					pgIndexOid(table, &index),
+					parser.DInt(table.ID),
+					parser.DInt(len(index.ColumnIDs)),
+					parser.DBool(index.Unique),
+					parser.DBool(i == 0),
+					parser.DString(indKey.String()),
+				)
+			}
+		})
+	},
+}
+
+var pgCatalogNamespace = virtualSchemaTable{
+	schema: `
+CREATE TABLE pg_catalog.pg_namespace (
+  oid      INT NOT NULL,
+  nspname  STRING NOT NULL,
+  nspowner INT,
+  nspacl   STRING
+);`,
+	populate: func(p *planner, addRow func(...parser.Datum)) error {
+		// Databases have no owner, and their privileges are shown by
+		// SHOW GRANTS.
+		return p.forEachDatabaseDesc(func(db *DatabaseDescriptor) {
+			addRow(
+				parser.DInt(db.ID),
+				parser.DString(db.Name),
+				parser.DNull,
+				parser.DNull,
+			)
+		})
+	},
+}
+
+var pgCatalogType = virtualSchemaTable{
+	schema: `
+CREATE TABLE pg_catalog.pg_type (
+  oid          INT NOT NULL,
+  typname      STRING NOT NULL,
+  typnamespace INT NOT NULL,
+  typlen       INT NOT NULL,
+  typbyval     BOOL NOT NULL,
+  typtype      STRING NOT NULL,
+  typcategory  STRING NOT NULL
+);`,
+	populate: func(p *planner, addRow func(...parser.Datum)) error {
+		namespaceID := getVirtualSchema(pgCatalogName).desc.ID
+		for _, typ := range pgTypes {
+			// All the types are "b"ase types.
+			addRow(
+				parser.DInt(typ.oid),
+				parser.DString(typ.name),
+				parser.DInt(namespaceID),
+				parser.DInt(typ.length),
+				parser.DBool(typ.byValue),
+				parser.DString("b"),
+				parser.DString(typ.category),
+			)
+		}
+		return nil
+	},
+}
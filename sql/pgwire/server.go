@@ -19,28 +19,45 @@ package pgwire
 
 import (
 	"bytes"
+	"math/rand"
 	"net"
+	"sync"
 
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/log"
 )
 
 var (
-	versionSSL = []byte{0x12, 0x34, 0x56, 0x79}
-	version30  = []byte{0x00, 0x03, 0x00, 0x00}
+	versionSSL    = []byte{0x12, 0x34, 0x56, 0x79}
+	version30     = []byte{0x00, 0x03, 0x00, 0x00}
+	versionCancel = []byte{0x04, 0xd2, 0x16, 0x2e}
 )
 
+// cancelKey identifies a connection in a CancelRequest. The process ID is
+// assigned sequentially, the secret key randomly.
+type cancelKey struct {
+	processID int32
+	secretKey int32
+}
+
 // Server implements the server side of the PostgreSQL wire protocol.
 type Server struct {
 	context  *Context
 	listener net.Listener
-	conns    []net.Conn
+
+	mu            sync.Mutex        // Protects the fields below.
+	conns         map[net.Conn]bool // Open connections; true when idle.
+	draining      bool
+	lastProcessID int32
+	cancelConns   map[cancelKey]*v3Conn // v3 connections by their cancel key.
 }
 
-// MakeServer creates a Server.
-func MakeServer(context *Context) Server {
-	s := Server{
-		context: context,
+// NewServer creates a Server.
+func NewServer(context *Context) *Server {
+	s := &Server{
+		context:     context,
+		conns:       map[net.Conn]bool{},
+		cancelConns: map[cancelKey]*v3Conn{},
 	}
 	return s
 }
@@ -78,8 +95,15 @@ func (s *Server) serve(ln net.Listener) {
 			return
 		}
 
-		s.conns = append(s.conns, conn)
+		s.mu.Lock()
+		s.conns[conn] = false
+		s.mu.Unlock()
 		go func() {
+			defer func() {
+				s.mu.Lock()
+				delete(s.conns, conn)
+				s.mu.Unlock()
+			}()
 			if err := s.serveConn(conn); err != nil {
 				log.Error(err)
 			}
@@ -90,11 +114,61 @@ func (s *Server) serve(ln net.Listener) {
 // close this server, and all client connections.
 func (s *Server) close() {
 	s.listener.Close()
-	for _, conn := range s.conns {
+	s.CloseConns()
+}
+
+// SetDraining puts the server into (or takes it out of) draining mode.
+// While draining, idle connections (those not in the middle of a
+// transaction) are closed, and connections which become idle after
+// their transaction completes are closed as well. New connections are
+// refused with an error after the handshake.
+func (s *Server) SetDraining(drain bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.draining = drain
+	if !drain {
+		return
+	}
+	for conn, idle := range s.conns {
+		if idle {
+			conn.Close()
+		}
+	}
+}
+
+// NumConns returns the number of open client connections.
+func (s *Server) NumConns() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.conns)
+}
+
+// CloseConns closes all open client connections, regardless of
+// whether they are in the middle of a transaction.
+func (s *Server) CloseConns() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for conn := range s.conns {
 		conn.Close()
 	}
 }
 
+// setIdle records whether the connection is waiting for a statement
+// outside of a transaction. If the server is draining, setIdle(true)
+// returns false and the caller should close the connection instead of
+// waiting for the client.
+func (s *Server) setIdle(conn net.Conn, idle bool) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if idle && s.draining {
+		return false
+	}
+	if _, ok := s.conns[conn]; ok {
+		s.conns[conn] = idle
+	}
+	return true
+}
+
 // serveConn serves a single connection, driving the handshake process
 // and delegating to the appropriate connection type.
 func (s *Server) serveConn(conn net.Conn) error {
@@ -111,11 +185,55 @@ func (s *Server) serveConn(conn net.Conn) error {
 		}
 		panic("TODO(bdarnell): ssl mode")
 	} else if bytes.Compare(version, version30) == 0 {
-		v3conn, err := newV3Conn(conn, rest, s.context.Executor)
+		v3conn, err := newV3Conn(s, conn, rest, s.context.Executor)
 		if err != nil {
 			return err
 		}
+		s.registerCancelKey(v3conn)
+		defer s.unregisterCancelKey(v3conn)
 		return v3conn.serve()
+	} else if bytes.Compare(version, versionCancel) == 0 {
+		return s.handleCancel(rest)
 	}
 	return util.Errorf("unknown protocol version %q", version)
 }
+
+// registerCancelKey assigns a cancel key to the connection, which the
+// client can use to cancel the statements executed by the connection from
+// another connection.
+func (s *Server) registerCancelKey(c *v3Conn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastProcessID++
+	c.cancelKey = cancelKey{processID: s.lastProcessID, secretKey: rand.Int31()}
+	s.cancelConns[c.cancelKey] = c
+}
+
+func (s *Server) unregisterCancelKey(c *v3Conn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.cancelConns, c.cancelKey)
+}
+
+// handleCancel handles a CancelRequest, canceling the statements being
+// executed by the connection with the specified cancel key. As in
+// postgres, no response is sent: the client checks the result of the
+// statements it canceled.
+func (s *Server) handleCancel(data []byte) error {
+	buf := readBuffer{msg: data}
+	processID, err := buf.getInt32()
+	if err != nil {
+		return err
+	}
+	secretKey, err := buf.getInt32()
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	c, ok := s.cancelConns[cancelKey{processID: processID, secretKey: secretKey}]
+	s.mu.Unlock()
+	if ok {
+		s.context.Executor.CancelQueries(c)
+	}
+	return nil
+}
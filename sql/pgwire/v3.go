@@ -35,6 +35,7 @@ type messageType byte
 // http://www.postgresql.org/docs/9.4/static/protocol-message-formats.html
 const (
 	serverMsgAuth            messageType = 'R'
+	serverMsgBackendKeyData              = 'K'
 	serverMsgCommandComplete             = 'C'
 	serverMsgDataRow                     = 'D'
 	serverMsgErrorResponse               = 'E'
@@ -52,12 +53,16 @@ const (
 	authOK int32 = 0
 )
 
+const errDraining = "server is draining"
+
 type parsedQuery struct {
 	query string
 	types []oid.Oid
 }
 
 type v3Conn struct {
+	server   *Server
+	conn     net.Conn
 	rd       *bufio.Reader
 	wr       *bufio.Writer
 	opts     map[string]string
@@ -67,10 +72,21 @@ type v3Conn struct {
 	writeBuf writeBuffer
 	tagBuf   [64]byte
 	session  sql.Session
+	// The key identifying the connection in a CancelRequest.
+	cancelKey cancelKey
+
+	// The state of the result being streamed to the client.
+	numResults int
+	resultRows bool
+	numRows    uint
 }
 
-func newV3Conn(conn net.Conn, data []byte, executor *sql.Executor) (*v3Conn, error) {
+var _ sql.ResultWriter = &v3Conn{}
+
+func newV3Conn(server *Server, conn net.Conn, data []byte, executor *sql.Executor) (*v3Conn, error) {
 	v3conn := &v3Conn{
+		server:   server,
+		conn:     conn,
 		rd:       bufio.NewReader(conn),
 		wr:       bufio.NewWriter(conn),
 		opts:     map[string]string{},
@@ -108,6 +124,12 @@ func (c *v3Conn) serve() error {
 	if err := c.writeBuf.finishMsg(c.wr); err != nil {
 		return err
 	}
+	c.writeBuf.initMsg(serverMsgBackendKeyData)
+	c.writeBuf.putInt32(c.cancelKey.processID)
+	c.writeBuf.putInt32(c.cancelKey.secretKey)
+	if err := c.writeBuf.finishMsg(c.wr); err != nil {
+		return err
+	}
 	if err := c.wr.Flush(); err != nil {
 		return err
 	}
@@ -134,10 +156,17 @@ func (c *v3Conn) serve() error {
 		if err := c.wr.Flush(); err != nil {
 			return err
 		}
+		// A connection outside of a transaction is idle; when the server is
+		// draining, turn the client away instead of waiting for its next
+		// statement.
+		if !c.server.setIdle(c.conn, txnStatus != 'T') {
+			return c.sendError(errDraining)
+		}
 		typ, err := c.readBuf.readTypedMsg(c.rd)
 		if err != nil {
 			return err
 		}
+		c.server.setIdle(c.conn, false)
 		switch typ {
 		case clientMsgSimpleQuery:
 			err = c.handleSimpleQuery(&c.readBuf)
@@ -174,19 +203,24 @@ func (c *v3Conn) handleSimpleQuery(buf *readBuffer) error {
 		return err
 	}
 
-	resp, _, err := c.executor.Execute(req)
+	c.numResults = 0
+	session, _, err := c.executor.ExecuteStatements(req, c)
 	if err != nil {
 		return c.sendError(err.Error())
 	}
+	if c.numResults == 0 {
+		if err := c.sendCommandComplete(nil); err != nil {
+			return err
+		}
+	}
 
 	c.session.Reset()
-	if err := c.session.Unmarshal(resp.Session); err != nil {
+	if err := c.session.Unmarshal(session); err != nil {
 		return err
 	}
 
 	c.opts["DATABASE"] = c.session.Database
-
-	return c.sendResponse(resp)
+	return nil
 }
 
 func (c *v3Conn) handleParse(buf *readBuffer) error {
@@ -258,86 +292,85 @@ func (c *v3Conn) sendError(errToSend string) error {
 	return c.wr.Flush()
 }
 
-func (c *v3Conn) sendResponse(resp driver.Response) error {
-	if len(resp.Results) == 0 {
-		return c.sendCommandComplete(nil)
+// BeginResult implements the sql.ResultWriter interface.
+func (c *v3Conn) BeginResult(result driver.Response_Result, _ bool) error {
+	c.numResults++
+	c.resultRows = false
+	if result.Error != nil {
+		return c.sendError(*result.Error)
 	}
-	for _, result := range resp.Results {
-		if result.Error != nil {
-			if err := c.sendError(*result.Error); err != nil {
-				return err
-			}
-			continue
-		}
 
-		switch result := result.GetUnion().(type) {
-		case *driver.Response_Result_DDL_:
-			// Send EmptyQueryResponse.
-			c.writeBuf.initMsg(serverMsgEmptyQuery)
-			return c.writeBuf.finishMsg(c.wr)
-
-		case *driver.Response_Result_RowsAffected:
-			// Send CommandComplete.
-			// TODO(bdarnell): tags for other types of commands.
-			tag := append(c.tagBuf[:0], "SELECT "...)
-			tag = strconv.AppendInt(tag, int64(result.RowsAffected), 10)
-			tag = append(tag, byte(0))
-			if err := c.sendCommandComplete(tag); err != nil {
-				return err
+	switch result := result.GetUnion().(type) {
+	case *driver.Response_Result_DDL_:
+		// Send EmptyQueryResponse.
+		c.writeBuf.initMsg(serverMsgEmptyQuery)
+		return c.writeBuf.finishMsg(c.wr)
+
+	case *driver.Response_Result_RowsAffected:
+		// Send CommandComplete.
+		// TODO(bdarnell): tags for other types of commands.
+		tag := append(c.tagBuf[:0], "SELECT "...)
+		tag = strconv.AppendInt(tag, int64(result.RowsAffected), 10)
+		tag = append(tag, byte(0))
+		return c.sendCommandComplete(tag)
+
+	case *driver.Response_Result_Rows_:
+		c.resultRows = true
+		c.numRows = 0
+
+		// Send RowDescription.
+		c.writeBuf.initMsg(serverMsgRowDescription)
+		c.writeBuf.putInt16(int16(len(result.Rows.Columns)))
+		for _, column := range result.Rows.Columns {
+			if log.V(2) {
+				log.Infof("pgwire writing column %s of type: %T", column.Name, column.Typ.Payload)
 			}
-
-		case *driver.Response_Result_Rows_:
-			resultRows := result.Rows
-
-			// Send RowDescription.
-			c.writeBuf.initMsg(serverMsgRowDescription)
-			c.writeBuf.putInt16(int16(len(resultRows.Columns)))
-			for _, column := range resultRows.Columns {
-				if log.V(2) {
-					log.Infof("pgwire writing column %s of type: %T", column.Name, column.Typ.Payload)
-				}
-				if err := c.writeBuf.writeString(column.Name); err != nil {
-					return err
-				}
-
-				typ := typeForDatum(column.Typ)
-				c.writeBuf.putInt32(0) // Table OID (optional).
-				c.writeBuf.putInt16(0) // Column attribute ID (optional).
-				c.writeBuf.putInt32(int32(typ.oid))
-				c.writeBuf.putInt16(int16(typ.size))
-				c.writeBuf.putInt32(0) // Type modifier (none of our supported types have modifiers).
-				c.writeBuf.putInt16(int16(typ.preferredFormat))
-			}
-			if err := c.writeBuf.finishMsg(c.wr); err != nil {
+			if err := c.writeBuf.writeString(column.Name); err != nil {
 				return err
 			}
 
-			// Send DataRows.
-			for _, row := range resultRows.Rows {
-				c.writeBuf.initMsg(serverMsgDataRow)
-				c.writeBuf.putInt16(int16(len(row.Values)))
-				for _, col := range row.Values {
-					if err := c.writeBuf.writeDatum(col); err != nil {
-						return err
-					}
-				}
-				if err := c.writeBuf.finishMsg(c.wr); err != nil {
-					return err
-				}
-			}
+			typ := typeForDatum(column.Typ)
+			c.writeBuf.putInt32(0) // Table OID (optional).
+			c.writeBuf.putInt16(0) // Column attribute ID (optional).
+			c.writeBuf.putInt32(int32(typ.oid))
+			c.writeBuf.putInt16(int16(typ.size))
+			c.writeBuf.putInt32(0) // Type modifier (none of our supported types have modifiers).
+			c.writeBuf.putInt16(int16(typ.preferredFormat))
+		}
+		return c.writeBuf.finishMsg(c.wr)
+	}
+	return nil
+}
 
-			// Send CommandComplete.
-			// TODO(bdarnell): tags for other types of commands.
-			tag := append(c.tagBuf[:0], "SELECT "...)
-			tag = appendUint(tag, uint(len(resultRows.Rows)))
-			tag = append(tag, byte(0))
-			if err := c.sendCommandComplete(tag); err != nil {
-				return err
-			}
+// AddRow implements the sql.ResultWriter interface. The DataRow is
+// buffered; the bufio.Writer sends it once full, blocking while the client
+// is not reading.
+func (c *v3Conn) AddRow(row driver.Response_Result_Rows_Row) error {
+	c.numRows++
+	c.writeBuf.initMsg(serverMsgDataRow)
+	c.writeBuf.putInt16(int16(len(row.Values)))
+	for _, col := range row.Values {
+		if err := c.writeBuf.writeDatum(col); err != nil {
+			return err
 		}
 	}
+	return c.writeBuf.finishMsg(c.wr)
+}
 
-	return nil
+// EndResult implements the sql.ResultWriter interface.
+func (c *v3Conn) EndResult(err error) error {
+	if err != nil {
+		return c.sendError(err.Error())
+	}
+	if !c.resultRows {
+		return nil
+	}
+	// Send CommandComplete.
+	// TODO(bdarnell): tags for other types of commands.
+	tag := append(c.tagBuf[:0], "SELECT "...)
+	tag = appendUint(tag, c.numRows)
+	tag = append(tag, byte(0))
+	return c.sendCommandComplete(tag)
 }
 
 func appendUint(in []byte, u uint) []byte {
@@ -22,6 +22,7 @@ import (
 
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/config"
+	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/sql/parser"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/log"
@@ -46,9 +47,31 @@ type planner struct {
 	// TODO(pmattis): This is a hack to force updating to the latest version of a
 	// lease after a schema change operation such as CREATE INDEX.
 	modifiedSchemas []schemaInfo
+	// Schema changes queued by the statements of the current transaction. They
+	// are executed once the transaction commits.
+	schemaChangers []SchemaChanger
+	// Tables dropped, or truncated, by the statements of the current
+	// transaction. The cached names of these tables are stale.
+	droppedTables map[ID]struct{}
 
 	testingVerifyMetadata func(config.SystemConfig) error
 
+	// The registry of the statements executing on the node, and the entry of
+	// the statement being executed.
+	queries     *queryRegistry
+	activeQuery *queryMeta
+	// The groups of the user, resolved by hasPrivilege at most once per
+	// statement.
+	userGroups         []string
+	userGroupsResolved bool
+	// The statistics of the statements executed by the node.
+	stmtStats *stmtStatsRegistry
+	// The KV activity of the statements executed by the planner.
+	kvStats planStats
+	// The timestamp at which the statement being executed reads, if it
+	// specified AS OF SYSTEM TIME.
+	asOfSystemTime roachpb.Timestamp
+
 	parser                   parser.Parser
 	extractAggregatesVisitor extractAggregatesVisitor
 	params                   parameters
@@ -57,6 +80,11 @@ type planner struct {
 
 func (p *planner) setTxn(txn *client.Txn, timestamp time.Time) {
 	p.txn = txn
+	if txn != nil {
+		// Stop sending KV batches once the statement being executed is
+		// canceled or times out; the error aborts the transaction.
+		txn.SetSendCheck(p.checkQuery)
+	}
 	p.evalCtx.TxnTimestamp = parser.DTimestamp{Time: timestamp}
 }
 
@@ -78,34 +106,54 @@ func (p *planner) makePlan(stmt parser.Statement) (planNode, error) {
 	}
 
 	switch n := stmt.(type) {
+	case *parser.AlterGroup:
+		return p.AlterGroup(n)
 	case *parser.AlterTable:
 		return p.AlterTable(n)
+	case *parser.AlterUser:
+		return p.AlterUser(n)
+	case *parser.Backup:
+		return p.Backup(n)
 	case *parser.BeginTransaction:
 		return p.BeginTransaction(n)
+	case *parser.CancelQuery:
+		return p.CancelQuery(n)
 	case *parser.CommitTransaction:
 		return p.CommitTransaction(n)
+	case *parser.ConfigureZone:
+		return p.ConfigureZone(n)
 	case *parser.CreateDatabase:
 		return p.CreateDatabase(n)
 	case *parser.CreateIndex:
 		return p.CreateIndex(n)
 	case *parser.CreateTable:
 		return p.CreateTable(n)
+	case *parser.CreateUser:
+		return p.CreateUser(n)
 	case *parser.Delete:
 		return p.Delete(n)
 	case *parser.DropDatabase:
 		return p.DropDatabase(n)
+	case *parser.DropGroup:
+		return p.DropGroup(n)
 	case *parser.DropIndex:
 		return p.DropIndex(n)
 	case *parser.DropTable:
 		return p.DropTable(n)
+	case *parser.DropUser:
+		return p.DropUser(n)
 	case *parser.Explain:
 		return p.Explain(n)
 	case *parser.Grant:
 		return p.Grant(n)
+	case *parser.Import:
+		return p.Import(n)
 	case *parser.Insert:
 		return p.Insert(n)
 	case *parser.ParenSelect:
 		return p.makePlan(n.Select)
+	case *parser.ReleaseProtectedTimestamp:
+		return p.ReleaseProtectedTimestamp(n)
 	case *parser.RenameColumn:
 		return p.RenameColumn(n)
 	case *parser.RenameDatabase:
@@ -114,6 +162,10 @@ func (p *planner) makePlan(stmt parser.Statement) (planNode, error) {
 		return p.RenameIndex(n)
 	case *parser.RenameTable:
 		return p.RenameTable(n)
+	case *parser.ResetStatementStatistics:
+		return p.ResetStatementStatistics(n)
+	case *parser.Restore:
+		return p.Restore(n)
 	case *parser.Revoke:
 		return p.Revoke(n)
 	case *parser.RollbackTransaction:
@@ -134,10 +186,24 @@ func (p *planner) makePlan(stmt parser.Statement) (planNode, error) {
 		return p.ShowDatabases(n)
 	case *parser.ShowGrants:
 		return p.ShowGrants(n)
+	case *parser.ShowImports:
+		return p.ShowImports(n)
 	case *parser.ShowIndex:
 		return p.ShowIndex(n)
+	case *parser.ShowProtectedTimestamps:
+		return p.ShowProtectedTimestamps(n)
+	case *parser.ShowQueries:
+		return p.ShowQueries(n)
+	case *parser.ShowStatementStatistics:
+		return p.ShowStatementStatistics(n)
+	case *parser.ShowTableStatistics:
+		return p.ShowTableStatistics(n)
 	case *parser.ShowTables:
 		return p.ShowTables(n)
+	case *parser.ShowUsers:
+		return p.ShowUsers(n)
+	case *parser.ShowZoneConfig:
+		return p.ShowZoneConfig(n)
 	case *parser.Truncate:
 		return p.Truncate(n)
 	case *parser.Update:
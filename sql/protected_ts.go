@@ -0,0 +1,171 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/config"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/sql/privilege"
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// ProtectTimestamp writes a record to system.protected_timestamps which
+// protects the values of 'span' needed to read at 'ts' from garbage
+// collection until 'expiration', and returns the ID of the record. The GC
+// queue only honors the record once the system config including it has
+// been gossiped.
+func ProtectTimestamp(db *client.DB, span roachpb.Span, ts, expiration roachpb.Timestamp,
+	description string) (int64, error) {
+	if bytes.Compare(span.Key, span.EndKey) >= 0 {
+		return 0, util.Errorf("invalid span to protect: [%s, %s)", span.Key, span.EndKey)
+	}
+	if !ts.Less(expiration) {
+		return 0, util.Errorf("protected timestamp %s expires at %s", ts, expiration)
+	}
+	record := config.ProtectedTimestamp{
+		Span:        span,
+		Timestamp:   ts,
+		Expiration:  expiration,
+		Description: description,
+	}
+	err := db.Txn(func(txn *client.Txn) error {
+		p := planner{txn: txn, user: security.RootUser}
+		row, err := p.queryRow(`SELECT MAX(id) FROM system.protected_timestamps`)
+		if err != nil {
+			return err
+		}
+		record.ID = 1
+		if id, ok := row[0].(parser.DInt); ok {
+			record.ID = int64(id) + 1
+		}
+		buf, err := proto.Marshal(&record)
+		if err != nil {
+			return err
+		}
+		const insertRecord = `INSERT INTO system.protected_timestamps VALUES (%d, %s)`
+		_, err = p.exec(fmt.Sprintf(insertRecord, record.ID, parser.DBytes(buf)))
+		return err
+	})
+	return record.ID, err
+}
+
+// ListProtectedTimestamps returns the records of system.protected_timestamps,
+// including the expired ones, ordered by ID.
+func ListProtectedTimestamps(txn *client.Txn) ([]config.ProtectedTimestamp, error) {
+	p := planner{txn: txn, user: security.RootUser}
+	plan, err := p.query(`SELECT record FROM system.protected_timestamps ORDER BY id`)
+	if err != nil {
+		return nil, err
+	}
+	var records []config.ProtectedTimestamp
+	for plan.Next() {
+		var record config.ProtectedTimestamp
+		if err := proto.Unmarshal([]byte(plan.Values()[0].(parser.DBytes)), &record); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, plan.Err()
+}
+
+// ReleaseProtectedTimestamp removes the record with 'id' from
+// system.protected_timestamps, which lets the GC queue collect the values it
+// protected.
+func ReleaseProtectedTimestamp(txn *client.Txn, id int64) error {
+	p := planner{txn: txn, user: security.RootUser}
+	const deleteRecord = `DELETE FROM system.protected_timestamps WHERE id = %d`
+	count, err := p.exec(fmt.Sprintf(deleteRecord, id))
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return fmt.Errorf("protected timestamp %d does not exist", id)
+	}
+	return nil
+}
+
+// ShowProtectedTimestamps lists the protected timestamp records, including
+// the expired ones.
+// Privileges: SELECT on system.protected_timestamps.
+//   Notes: postgres and mysql do not have protected timestamps.
+func (p *planner) ShowProtectedTimestamps(n *parser.ShowProtectedTimestamps) (planNode, error) {
+	desc, err := getTableDescFromID(p.txn, ProtectedTimestampsTable.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.checkPrivilege(desc, privilege.SELECT); err != nil {
+		return nil, err
+	}
+	records, err := ListProtectedTimestamps(p.txn)
+	if err != nil {
+		return nil, err
+	}
+
+	v := &valuesNode{
+		columns: []column{
+			{name: "id", typ: parser.DummyInt},
+			{name: "start", typ: parser.DummyString},
+			{name: "end", typ: parser.DummyString},
+			{name: "timestamp", typ: parser.DummyTimestamp},
+			{name: "expiration", typ: parser.DummyTimestamp},
+			{name: "description", typ: parser.DummyString},
+		},
+	}
+	for _, record := range records {
+		v.rows = append(v.rows, []parser.Datum{
+			parser.DInt(record.ID),
+			parser.DString(record.Span.Key.String()),
+			parser.DString(record.Span.EndKey.String()),
+			parser.DTimestamp{Time: record.Timestamp.GoTime().UTC()},
+			parser.DTimestamp{Time: record.Expiration.GoTime().UTC()},
+			parser.DString(record.Description),
+		})
+	}
+	return v, nil
+}
+
+// ReleaseProtectedTimestamp removes a protected timestamp record.
+// Privileges: DELETE on system.protected_timestamps.
+//   Notes: postgres and mysql do not have protected timestamps.
+func (p *planner) ReleaseProtectedTimestamp(n *parser.ReleaseProtectedTimestamp) (planNode, error) {
+	desc, err := getTableDescFromID(p.txn, ProtectedTimestampsTable.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.checkPrivilege(desc, privilege.DELETE); err != nil {
+		return nil, err
+	}
+	d, err := n.ID.Eval(p.evalCtx)
+	if err != nil {
+		return nil, err
+	}
+	id, ok := d.(parser.DInt)
+	if !ok {
+		return nil, fmt.Errorf("RELEASE PROTECTED TIMESTAMP requires an integer ID: %s is a %s", n.ID, d.Type())
+	}
+	if err := ReleaseProtectedTimestamp(p.txn, int64(id)); err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
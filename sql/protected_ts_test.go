@@ -0,0 +1,104 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/sql"
+	"github.com/cockroachdb/cockroach/testutils"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func TestProtectedTimestamps(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, kvDB := setup(t)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE d;
+CREATE TABLE d.t (a INT PRIMARY KEY);
+`); err != nil {
+		t.Fatal(err)
+	}
+	var tableID uint32
+	if err := sqlDB.QueryRow(`SELECT id FROM system.namespace WHERE name = 't'`).Scan(&tableID); err != nil {
+		t.Fatal(err)
+	}
+
+	// Reading the table before its GC threshold fails.
+	const historicalRead = `SELECT a FROM d.t AS OF SYSTEM TIME '-48h'`
+	if _, err := sqlDB.Exec(historicalRead); !testutils.IsError(err, `older than the GC threshold`) {
+		t.Fatalf("expected GC threshold error, but found %v", err)
+	}
+
+	// Protecting the table at a timestamp older than the read moves its GC
+	// threshold back once the record is gossiped. The read then fails because
+	// the table didn't exist at that time.
+	now := time.Now()
+	prefix := roachpb.Key(keys.MakeTablePrefix(tableID))
+	span := roachpb.Span{Key: prefix, EndKey: prefix.PrefixEnd()}
+	ts := roachpb.Timestamp{WallTime: now.Add(-49 * time.Hour).UnixNano()}
+	expiration := roachpb.Timestamp{WallTime: now.Add(time.Hour).UnixNano()}
+	id, err := sql.ProtectTimestamp(kvDB, span, ts, expiration, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	util.SucceedsWithin(t, 3*time.Second, func() error {
+		if _, err := sqlDB.Exec(historicalRead); !testutils.IsError(err, `table "t" does not exist`) {
+			return fmt.Errorf("expected missing table error, but found %v", err)
+		}
+		return nil
+	})
+
+	var showID int64
+	var start, end, description string
+	var showTS, showExpiration time.Time
+	if err := sqlDB.QueryRow(`SHOW PROTECTED TIMESTAMPS`).Scan(
+		&showID, &start, &end, &showTS, &showExpiration, &description); err != nil {
+		t.Fatal(err)
+	}
+	if showID != id || start != prefix.String() || end != prefix.PrefixEnd().String() ||
+		!showTS.Equal(ts.GoTime()) || !showExpiration.Equal(expiration.GoTime()) || description != "test" {
+		t.Errorf("unexpected protected timestamp %d [%s, %s) at %s until %s: %s",
+			showID, start, end, showTS, showExpiration, description)
+	}
+
+	// Releasing the record restores the GC threshold of the table.
+	if _, err := sqlDB.Exec(`RELEASE PROTECTED TIMESTAMP $1`, id); err != nil {
+		t.Fatal(err)
+	}
+	util.SucceedsWithin(t, 3*time.Second, func() error {
+		if _, err := sqlDB.Exec(historicalRead); !testutils.IsError(err, `older than the GC threshold`) {
+			return fmt.Errorf("expected GC threshold error, but found %v", err)
+		}
+		return nil
+	})
+	if _, err := sqlDB.Exec(`RELEASE PROTECTED TIMESTAMP $1`, id); !testutils.IsError(err, `does not exist`) {
+		t.Fatalf("expected missing protected timestamp error, but found %v", err)
+	}
+
+	// Protecting an empty span fails.
+	emptySpan := roachpb.Span{Key: prefix, EndKey: prefix}
+	if _, err := sql.ProtectTimestamp(kvDB, emptySpan, ts, expiration, ""); !testutils.IsError(err, `invalid span`) {
+		t.Fatalf("expected invalid span error, but found %v", err)
+	}
+}
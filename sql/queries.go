@@ -0,0 +1,206 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/parser"
+)
+
+var errQueryCanceled = errors.New("query canceled")
+var errStatementTimeout = errors.New("query canceled due to statement timeout")
+
+// queryMeta describes a statement being executed.
+type queryMeta struct {
+	id    int64
+	user  string
+	sql   string
+	start time.Time
+	// deadline is the time at which the statement times out; zero if the
+	// statement has no timeout.
+	deadline time.Time
+	// owner identifies the ResultWriter receiving the results of the
+	// statement.
+	owner ResultWriter
+	// canceled is set atomically by cancel.
+	canceled int32
+}
+
+// cancel requests the statement to stop executing. The statement notices
+// the request the next time it checks for it.
+func (q *queryMeta) cancel() {
+	atomic.StoreInt32(&q.canceled, 1)
+}
+
+// check returns an error if the statement was canceled or timed out.
+func (q *queryMeta) check() error {
+	if atomic.LoadInt32(&q.canceled) != 0 {
+		return errQueryCanceled
+	}
+	if !q.deadline.IsZero() && time.Now().After(q.deadline) {
+		return errStatementTimeout
+	}
+	return nil
+}
+
+// queryRegistry tracks the statements executing on a node.
+type queryRegistry struct {
+	mu      sync.Mutex
+	nextID  int64
+	queries map[int64]*queryMeta
+}
+
+func makeQueryRegistry() queryRegistry {
+	return queryRegistry{queries: map[int64]*queryMeta{}}
+}
+
+// register adds a statement to the registry.
+func (r *queryRegistry) register(
+	user string, stmt parser.Statement, timeout time.Duration, owner ResultWriter) *queryMeta {
+	q := &queryMeta{
+		user:  user,
+		sql:   stmt.String(),
+		start: time.Now(),
+		owner: owner,
+	}
+	if timeout > 0 {
+		q.deadline = q.start.Add(timeout)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.nextID++
+	q.id = r.nextID
+	r.queries[q.id] = q
+	return q
+}
+
+// unregister removes a statement from the registry once it completes.
+func (r *queryRegistry) unregister(q *queryMeta) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.queries, q.id)
+}
+
+// list returns the statements in the registry, ordered by ID.
+func (r *queryRegistry) list() []queryMeta {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	queries := make([]queryMeta, 0, len(r.queries))
+	for _, q := range r.queries {
+		queries = append(queries, queryMeta{
+			id:    q.id,
+			user:  q.user,
+			sql:   q.sql,
+			start: q.start,
+		})
+	}
+	sort.Sort(queryMetasByID(queries))
+	return queries
+}
+
+// cancel cancels the statement with the specified ID. Only the root user
+// and the user executing the statement can cancel it.
+func (r *queryRegistry) cancel(id int64, user string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	q, ok := r.queries[id]
+	if !ok || (user != security.RootUser && user != q.user) {
+		return fmt.Errorf("query %d does not exist", id)
+	}
+	q.cancel()
+	return nil
+}
+
+// cancelOwner cancels the statements sending their results to the
+// ResultWriter, returning the number of canceled statements.
+func (r *queryRegistry) cancelOwner(owner ResultWriter) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	count := 0
+	for _, q := range r.queries {
+		if q.owner == owner {
+			q.cancel()
+			count++
+		}
+	}
+	return count
+}
+
+type queryMetasByID []queryMeta
+
+func (q queryMetasByID) Len() int           { return len(q) }
+func (q queryMetasByID) Less(i, j int) bool { return q[i].id < q[j].id }
+func (q queryMetasByID) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+
+// ShowQueries lists the statements executing on the node.
+// Privileges: None.
+//   Notes: only the root user sees the statements of other users.
+func (p *planner) ShowQueries(n *parser.ShowQueries) (planNode, error) {
+	v := &valuesNode{
+		columns: []column{
+			{name: "id", typ: parser.DummyInt},
+			{name: "user", typ: parser.DummyString},
+			{name: "start", typ: parser.DummyTimestamp},
+			{name: "query", typ: parser.DummyString},
+		},
+	}
+	for _, q := range p.queries.list() {
+		if p.user != security.RootUser && p.user != q.user {
+			continue
+		}
+		v.rows = append(v.rows, []parser.Datum{
+			parser.DInt(q.id),
+			parser.DString(q.user),
+			parser.DTimestamp{Time: q.start},
+			parser.DString(q.sql),
+		})
+	}
+	return v, nil
+}
+
+// CancelQuery cancels a statement executing on the node.
+// Privileges: None.
+//   Notes: users other than root can only cancel their own statements.
+func (p *planner) CancelQuery(n *parser.CancelQuery) (planNode, error) {
+	d, err := n.ID.Eval(p.evalCtx)
+	if err != nil {
+		return nil, err
+	}
+	id, ok := d.(parser.DInt)
+	if !ok {
+		return nil, fmt.Errorf("CANCEL QUERY requires an integer ID: %s is a %s", n.ID, d.Type())
+	}
+	if err := p.queries.cancel(int64(id), p.user); err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
+
+// checkQuery returns an error if the statement being executed was canceled
+// or timed out.
+func (p *planner) checkQuery() error {
+	if p.activeQuery == nil {
+		return nil
+	}
+	return p.activeQuery.check()
+}
@@ -0,0 +1,84 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/testutils"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func TestQueryRegistry(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	r := makeQueryRegistry()
+	w1, w2 := &responseBuilder{}, &responseBuilder{}
+	stmt := &parser.ShowQueries{}
+	q1 := r.register("alice", stmt, 0, w1)
+	q2 := r.register("bob", stmt, time.Hour, w2)
+	q3 := r.register("bob", stmt, time.Nanosecond, w2)
+
+	if list := r.list(); len(list) != 3 || list[0].id != q1.id || list[2].id != q3.id {
+		t.Fatalf("unexpected queries %+v", list)
+	}
+	if err := q1.check(); err != nil {
+		t.Fatal(err)
+	}
+	if err := q2.check(); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(time.Millisecond)
+	if err := q3.check(); err != errStatementTimeout {
+		t.Fatalf("expected %s, but found %v", errStatementTimeout, err)
+	}
+
+	// Only root and the user executing a statement can cancel it.
+	if err := r.cancel(q1.id, "bob"); !testutils.IsError(err, "does not exist") {
+		t.Fatalf("expected missing query error, but found %v", err)
+	}
+	if err := r.cancel(q1.id, "alice"); err != nil {
+		t.Fatal(err)
+	}
+	if err := q1.check(); err != errQueryCanceled {
+		t.Fatalf("expected %s, but found %v", errQueryCanceled, err)
+	}
+	if err := r.cancel(q2.id, security.RootUser); err != nil {
+		t.Fatal(err)
+	}
+	if err := q2.check(); err != errQueryCanceled {
+		t.Fatalf("expected %s, but found %v", errQueryCanceled, err)
+	}
+
+	// Statements can be canceled by their ResultWriter.
+	q4 := r.register("alice", stmt, 0, w1)
+	if n := r.cancelOwner(w1); n != 2 {
+		t.Fatalf("expected 2 canceled queries, but found %d", n)
+	}
+	if err := q4.check(); err != errQueryCanceled {
+		t.Fatalf("expected %s, but found %v", errQueryCanceled, err)
+	}
+
+	for _, q := range []*queryMeta{q1, q2, q3, q4} {
+		r.unregister(q)
+	}
+	if list := r.list(); len(list) != 0 {
+		t.Fatalf("expected no queries, but found %+v", list)
+	}
+}
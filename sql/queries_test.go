@@ -0,0 +1,156 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/testutils"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func TestStatementTimeout(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, _ := setup(t)
+	defer cleanup(s, sqlDB)
+	// The session variable is set on the single connection.
+	sqlDB.SetMaxOpenConns(1)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k CHAR PRIMARY KEY, v CHAR);
+INSERT INTO t.kv VALUES ('a', 'b'), ('c', 'd');
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	var timeout string
+	if err := sqlDB.QueryRow(`SHOW STATEMENT_TIMEOUT`).Scan(&timeout); err != nil {
+		t.Fatal(err)
+	} else if timeout != "0" {
+		t.Fatalf("expected no timeout, but found %s", timeout)
+	}
+
+	if _, err := sqlDB.Exec(`SET STATEMENT_TIMEOUT = -1`); !testutils.IsError(err, "must not be negative") {
+		t.Fatalf("expected negative duration error, but found %v", err)
+	}
+
+	// A timeout shorter than any statement.
+	if _, err := sqlDB.Exec(`SET STATEMENT_TIMEOUT = '1ns'`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sqlDB.Query(`SELECT * FROM t.kv`); !testutils.IsError(err, "statement timeout") {
+		t.Fatalf("expected statement timeout, but found %v", err)
+	}
+	if _, err := sqlDB.Exec(`DELETE FROM t.kv`); !testutils.IsError(err, "statement timeout") {
+		t.Fatalf("expected statement timeout, but found %v", err)
+	}
+
+	// Timeouts in milliseconds.
+	if _, err := sqlDB.Exec(`SET STATEMENT_TIMEOUT = 60000`); err != nil {
+		t.Fatal(err)
+	}
+	if err := sqlDB.QueryRow(`SHOW STATEMENT_TIMEOUT`).Scan(&timeout); err != nil {
+		t.Fatal(err)
+	} else if timeout != "1m0s" {
+		t.Fatalf("expected 1m0s timeout, but found %s", timeout)
+	}
+	var count int
+	if err := sqlDB.QueryRow(`SELECT COUNT(*) FROM t.kv`).Scan(&count); err != nil {
+		t.Fatal(err)
+	} else if count != 2 {
+		t.Fatalf("expected 2 rows, but found %d", count)
+	}
+
+	if _, err := sqlDB.Exec(`SET STATEMENT_TIMEOUT = DEFAULT`); err != nil {
+		t.Fatal(err)
+	}
+	if err := sqlDB.QueryRow(`SHOW STATEMENT_TIMEOUT`).Scan(&timeout); err != nil {
+		t.Fatal(err)
+	} else if timeout != "0" {
+		t.Fatalf("expected no timeout, but found %s", timeout)
+	}
+
+	// A statement timing out aborts its transaction.
+	tx, err := sqlDB.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(`INSERT INTO t.kv VALUES ('e', 'f')`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(`SET STATEMENT_TIMEOUT = '1ns'`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(`DELETE FROM t.kv`); !testutils.IsError(err, "statement timeout") {
+		t.Fatalf("expected statement timeout, but found %v", err)
+	}
+	if _, err := tx.Exec(`SELECT * FROM t.kv`); !testutils.IsError(err, "current transaction is aborted") {
+		t.Fatalf("expected aborted transaction, but found %v", err)
+	}
+	if err := tx.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sqlDB.Exec(`SET STATEMENT_TIMEOUT = DEFAULT`); err != nil {
+		t.Fatal(err)
+	}
+	if err := sqlDB.QueryRow(`SELECT COUNT(*) FROM t.kv`).Scan(&count); err != nil {
+		t.Fatal(err)
+	} else if count != 2 {
+		t.Fatalf("expected 2 rows, but found %d", count)
+	}
+}
+
+func TestShowAndCancelQueries(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, _ := setup(t)
+	defer cleanup(s, sqlDB)
+
+	// SHOW QUERIES lists itself.
+	rows, err := sqlDB.Query(`SHOW QUERIES`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	var found []string
+	var id int64
+	for rows.Next() {
+		var user, query string
+		var start time.Time
+		if err := rows.Scan(&id, &user, &start, &query); err != nil {
+			t.Fatal(err)
+		}
+		if user != "root" {
+			t.Errorf("expected query of root, but found %s", user)
+		}
+		found = append(found, query)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != 1 || found[0] != "SHOW QUERIES" {
+		t.Fatalf("expected [SHOW QUERIES], but found %s", found)
+	}
+
+	// The statement is no longer running.
+	if _, err := sqlDB.Exec(`CANCEL QUERY $1`, id); !testutils.IsError(err, "does not exist") {
+		t.Fatalf("expected missing query error, but found %v", err)
+	}
+	if _, err := sqlDB.Exec(`CANCEL QUERY 'a'`); !testutils.IsError(err, "requires an integer ID") {
+		t.Fatalf("expected integer ID error, but found %v", err)
+	}
+}
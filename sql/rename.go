@@ -52,6 +52,12 @@ func (p *planner) RenameDatabase(n *parser.RenameDatabase) (planNode, error) {
 	if err != nil {
 		return nil, err
 	}
+	if isVirtualDescriptor(dbDesc) {
+		return nil, errVirtualReadOnly(dbDesc.TypeName(), dbDesc.Name)
+	}
+	if getVirtualSchema(string(n.NewName)) != nil {
+		return nil, fmt.Errorf("the new database name %q already exists", string(n.NewName))
+	}
 
 	if n.Name == n.NewName {
 		// Noop.
@@ -0,0 +1,151 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"github.com/cockroachdb/cockroach/sql/driver"
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// resultBufferSize is the number of rows of a result buffered by the
+// executor before they are sent to the client. A statement whose result
+// fits in the buffer can be retried transparently.
+var resultBufferSize = 100
+
+// ResultWriter receives the results of the statements executed by the
+// Executor, in order. Calls for a statement are BeginResult, followed by
+// zero or more calls to AddRow, followed by EndResult. An error returned
+// by any of the methods aborts the execution of the request.
+type ResultWriter interface {
+	// BeginResult starts the result of a statement. The rows of the result,
+	// if any, are passed to AddRow. last is true for the result of the last
+	// statement of the request.
+	BeginResult(result driver.Response_Result, last bool) error
+	// AddRow sends a row of the current result.
+	AddRow(row driver.Response_Result_Rows_Row) error
+	// EndResult completes the current result. err is non-nil if the
+	// statement failed after some of its rows were sent.
+	EndResult(err error) error
+}
+
+// resultBuffer accumulates the result of a statement, sending its rows to
+// the ResultWriter once more than resultBufferSize rows are buffered.
+type resultBuffer struct {
+	w    ResultWriter
+	last bool
+	// result is the result of the statement without its rows.
+	result driver.Response_Result
+	rows   []driver.Response_Result_Rows_Row
+	// begun is set once the result has been passed to BeginResult.
+	begun bool
+	// The number of attempts at executing the statement, and the number of
+	// rows returned or affected by the last attempt.
+	attempts int64
+	rowCount int64
+}
+
+// reset discards the buffered result so that the statement can be
+// retried. It returns an error if some of the result was already sent.
+func (b *resultBuffer) reset() error {
+	b.attempts++
+	if b.begun {
+		return util.Errorf("unable to retry the statement: results were already sent to the client")
+	}
+	b.result = driver.Response_Result{}
+	b.rows = b.rows[:0]
+	b.rowCount = 0
+	return nil
+}
+
+// addRow buffers a row of the result.
+func (b *resultBuffer) addRow(row driver.Response_Result_Rows_Row) error {
+	b.rows = append(b.rows, row)
+	b.rowCount++
+	if len(b.rows) > resultBufferSize {
+		return b.flush()
+	}
+	return nil
+}
+
+// flush sends the buffered rows to the ResultWriter.
+func (b *resultBuffer) flush() error {
+	if !b.begun {
+		if err := b.w.BeginResult(b.result, b.last); err != nil {
+			return err
+		}
+		b.begun = true
+	}
+	for _, row := range b.rows {
+		if err := b.w.AddRow(row); err != nil {
+			return err
+		}
+	}
+	b.rows = b.rows[:0]
+	return nil
+}
+
+// finish sends the remainder of the result, or the error that aborted the
+// statement. If we hit an error and there is a pending transaction, the
+// transaction is rolled back.
+func (b *resultBuffer) finish(planMaker *planner, err error) error {
+	if err != nil {
+		cleanupTxnOnError(planMaker, err)
+		if !b.begun {
+			// Nothing was sent: report the error in place of the result.
+			errString := err.Error()
+			b.begun = true
+			if err := b.w.BeginResult(driver.Response_Result{Error: &errString}, b.last); err != nil {
+				return err
+			}
+			return b.w.EndResult(nil)
+		}
+		return b.w.EndResult(err)
+	}
+	if err := b.flush(); err != nil {
+		return err
+	}
+	return b.w.EndResult(nil)
+}
+
+// responseBuilder is a ResultWriter accumulating the results in a
+// driver.Response.
+type responseBuilder struct {
+	resp driver.Response
+}
+
+var _ ResultWriter = &responseBuilder{}
+
+func (rb *responseBuilder) BeginResult(result driver.Response_Result, _ bool) error {
+	rb.resp.Results = append(rb.resp.Results, result)
+	return nil
+}
+
+func (rb *responseBuilder) AddRow(row driver.Response_Result_Rows_Row) error {
+	result := rb.resp.Results[len(rb.resp.Results)-1]
+	rows := result.GetRows()
+	rows.Rows = append(rows.Rows, row)
+	return nil
+}
+
+func (rb *responseBuilder) EndResult(err error) error {
+	if err != nil {
+		// The statement failed after producing some of its rows: replace them
+		// with the error.
+		errString := err.Error()
+		rb.resp.Results[len(rb.resp.Results)-1] = driver.Response_Result{Error: &errString}
+	}
+	return nil
+}
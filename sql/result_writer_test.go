@@ -0,0 +1,137 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/sql/driver"
+	"github.com/cockroachdb/cockroach/testutils"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// recordingWriter is a ResultWriter recording the calls made to it.
+type recordingWriter struct {
+	calls []string
+}
+
+func (w *recordingWriter) BeginResult(result driver.Response_Result, last bool) error {
+	if result.Error != nil {
+		w.calls = append(w.calls, fmt.Sprintf("begin(error=%s, last=%t)", *result.Error, last))
+	} else {
+		w.calls = append(w.calls, fmt.Sprintf("begin(last=%t)", last))
+	}
+	return nil
+}
+
+func (w *recordingWriter) AddRow(row driver.Response_Result_Rows_Row) error {
+	w.calls = append(w.calls, fmt.Sprintf("row(%d)", row.Values[0].GetIntVal()))
+	return nil
+}
+
+func (w *recordingWriter) EndResult(err error) error {
+	w.calls = append(w.calls, fmt.Sprintf("end(%v)", err))
+	return nil
+}
+
+func makeIntRow(i int64) driver.Response_Result_Rows_Row {
+	return driver.Response_Result_Rows_Row{
+		Values: []driver.Datum{{Payload: &driver.Datum_IntVal{IntVal: i}}},
+	}
+}
+
+func TestResultBuffer(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	defer func(size int) { resultBufferSize = size }(resultBufferSize)
+	resultBufferSize = 2
+
+	p := &planner{}
+	testCases := []struct {
+		rows     int
+		err      error
+		expected []string
+		// Whether the statement can be retried after adding the rows.
+		retryable bool
+	}{
+		{0, nil, []string{"begin(last=true)", "end(<nil>)"}, true},
+		{2, nil, []string{"begin(last=true)", "row(0)", "row(1)", "end(<nil>)"}, true},
+		{4, nil, []string{"begin(last=true)", "row(0)", "row(1)", "row(2)", "row(3)", "end(<nil>)"}, false},
+		{2, errors.New("boom"), []string{"begin(error=boom, last=true)", "end(<nil>)"}, true},
+		{3, errors.New("boom"), []string{"begin(last=true)", "row(0)", "row(1)", "row(2)", "end(boom)"}, false},
+	}
+	for i, tc := range testCases {
+		w := &recordingWriter{}
+		buf := resultBuffer{w: w, last: true}
+		for j := 0; j < tc.rows; j++ {
+			if err := buf.addRow(makeIntRow(int64(j))); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := buf.reset(); tc.retryable {
+			if err != nil {
+				t.Fatalf("%d: unexpected error %v", i, err)
+			}
+			// Retry the statement.
+			for j := 0; j < tc.rows; j++ {
+				if err := buf.addRow(makeIntRow(int64(j))); err != nil {
+					t.Fatal(err)
+				}
+			}
+		} else if !testutils.IsError(err, "unable to retry the statement") {
+			t.Fatalf("%d: expected retry error, but found %v", i, err)
+		}
+		if err := buf.finish(p, tc.err); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(tc.expected, w.calls) {
+			t.Errorf("%d: expected %s, but found %s", i, tc.expected, w.calls)
+		}
+	}
+}
+
+func TestResponseBuilder(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	defer func(size int) { resultBufferSize = size }(resultBufferSize)
+	resultBufferSize = 1
+
+	var b responseBuilder
+	p := &planner{}
+	for i, err := range []error{nil, errors.New("boom")} {
+		buf := resultBuffer{w: &b, last: i == 1}
+		buf.result.Union = &driver.Response_Result_Rows_{Rows: &driver.Response_Result_Rows{}}
+		for j := 0; j < 3; j++ {
+			if err := buf.addRow(makeIntRow(int64(j))); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := buf.finish(p, err); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(b.resp.Results) != 2 {
+		t.Fatalf("expected 2 results, but found %d", len(b.resp.Results))
+	}
+	if rows := b.resp.Results[0].GetRows(); rows == nil || len(rows.Rows) != 3 {
+		t.Errorf("expected 3 rows, but found %+v", b.resp.Results[0])
+	}
+	// The rows sent before the error are replaced by the error.
+	if result := b.resp.Results[1]; result.Error == nil || *result.Error != "boom" || result.GetRows() != nil {
+		t.Errorf("expected error result, but found %+v", result)
+	}
+}
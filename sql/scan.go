@@ -177,6 +177,21 @@ type scanNode struct {
 	render           []parser.Expr     // rendering expressions for rows
 	explain          explainMode
 	explainValue     parser.Datum
+	// The rows of a virtual table, which are generated rather than decoded
+	// from key/value pairs.
+	virtual      bool
+	virtualRows  []parser.DTuple
+	virtualIndex int
+	// Set if the user doesn't have SELECT on the table, but on some of its
+	// columns. See checkColumnPrivileges.
+	columnPrivileges bool
+	// The constraints of the index chosen by selectIndex and the fraction of
+	// the index they were estimated to select.
+	constraints    indexConstraints
+	estSelectivity float64
+	// Execution statistics. The KV statistics and the rows scanned are always
+	// collected, the others only when explain is explainAnalyze.
+	stats planStats
 }
 
 func (n *scanNode) Columns() []column {
@@ -192,9 +207,29 @@ func (n *scanNode) Values() parser.DTuple {
 }
 
 func (n *scanNode) Next() bool {
+	if n.explain != explainAnalyze {
+		return n.next()
+	}
+	start := time.Now()
+	ok := n.next()
+	n.stats.elapsed += time.Since(start)
+	if ok {
+		n.stats.rows++
+	}
+	return ok
+}
+
+func (n *scanNode) next() bool {
 	if n.err != nil {
 		return false
 	}
+	if n.err = n.planner.checkQuery(); n.err != nil {
+		return false
+	}
+
+	if n.virtual && n.desc != nil {
+		return n.nextVirtual()
+	}
 
 	if n.kvs == nil {
 		if !n.initScan() {
@@ -222,6 +257,40 @@ func (n *scanNode) Next() bool {
 	}
 }
 
+// nextVirtual outputs the next generated row of a virtual table which
+// matches the filter.
+func (n *scanNode) nextVirtual() bool {
+	if n.virtualRows == nil {
+		if n.virtualRows, n.err = n.planner.getVirtualTableRows(n.desc); n.err != nil {
+			return false
+		}
+	}
+	for n.virtualIndex < len(n.virtualRows) {
+		row := n.virtualRows[n.virtualIndex]
+		n.virtualIndex++
+		for i, col := range n.desc.Columns {
+			if qval, ok := n.qvals[col.ID]; ok {
+				qval.datum = row[i]
+			}
+		}
+		if n.explain == explainDebug {
+			n.explainValue = parser.DNull
+		}
+		output := n.filterRow()
+		if n.err != nil {
+			return false
+		}
+		if output {
+			n.renderRow()
+			return n.err == nil
+		} else if n.explain == explainDebug {
+			n.explainDebug(true, false)
+			return true
+		}
+	}
+	return false
+}
+
 func (n *scanNode) Err() error {
 	return n.err
 }
@@ -234,6 +303,9 @@ func (n *scanNode) ExplainPlan() (name, description string, children []planNode)
 	}
 	if n.desc == nil {
 		description = "-"
+	} else if n.virtual {
+		name = "virtual table"
+		description = n.desc.Name
 	} else {
 		description = fmt.Sprintf("%s@%s %s", n.desc.Name, n.index.Name,
 			prettySpans(n.spans, 2))
@@ -241,6 +313,21 @@ func (n *scanNode) ExplainPlan() (name, description string, children []planNode)
 	return name, description, nil
 }
 
+// checkColumnPrivileges verifies that the user has SELECT on the columns
+// the scan reads, if it doesn't have SELECT on the table.
+func (n *scanNode) checkColumnPrivileges() error {
+	if !n.columnPrivileges {
+		return nil
+	}
+	var cols []ColumnDescriptor
+	for _, col := range n.desc.Columns {
+		if _, ok := n.qvals[col.ID]; ok {
+			cols = append(cols, col)
+		}
+	}
+	return n.planner.checkColumnPrivileges(n.desc, cols, privilege.SELECT)
+}
+
 func (n *scanNode) initFrom(p *planner, from parser.TableExprs) error {
 	switch len(from) {
 	case 0:
@@ -253,8 +340,15 @@ func (n *scanNode) initFrom(p *planner, from parser.TableExprs) error {
 		}
 
 		if err := p.checkPrivilege(n.desc, privilege.SELECT); err != nil {
-			return err
+			// Without SELECT on the table, the columns with SELECT can still be
+			// read. Which columns are read is only known once the targets, filter
+			// and ordering have been added.
+			if err := p.checkColumnPrivileges(n.desc, nil, privilege.SELECT); err != nil {
+				return err
+			}
+			n.columnPrivileges = true
 		}
+		n.virtual = isVirtualDescriptor(n.desc)
 
 		// This is only kosher because we know that getAliasedDesc() succeeded.
 		qname := from[0].(*parser.AliasedTableExpr).Expr.(*parser.QualifiedName)
@@ -346,14 +440,28 @@ func (n *scanNode) initScan() bool {
 		return false
 	}
 
+	batchStats := planStats{kvBatches: 1}
 	for _, result := range b.Results {
 		if n.kvs == nil {
 			n.kvs = result.Rows
 		} else {
 			n.kvs = append(n.kvs, result.Rows...)
 		}
+		for _, kv := range result.Rows {
+			batchStats.kvBytes += int64(len(kv.Key))
+			if kv.Value != nil {
+				batchStats.kvBytes += int64(len(kv.Value.RawBytes))
+			}
+		}
 	}
+	n.stats.addKV(batchStats)
+	n.planner.kvStats.addKV(batchStats)
+
+	return n.initKeyVals()
+}
 
+// initKeyVals prepares the structures used for decoding the key/value pairs.
+func (n *scanNode) initKeyVals() bool {
 	if n.valTypes == nil {
 		// Prepare our index key vals slice.
 		if n.valTypes, n.err = makeKeyVals(n.desc, n.columnIDs); n.err != nil {
@@ -677,6 +785,7 @@ func (n *scanNode) maybeOutputRow() bool {
 // where-clause). May set n.err if an error occurs during expression
 // evaluation.
 func (n *scanNode) filterRow() bool {
+	n.stats.rowsScanned++
 	if n.desc != nil {
 		for _, col := range n.visibleCols {
 			if !col.Nullable {
@@ -18,42 +18,667 @@
 package sql
 
 import (
+	"bytes"
+	"errors"
+	"sync"
+	"time"
+
 	"github.com/cockroachdb/cockroach/client"
-	"github.com/cockroachdb/cockroach/sql/parser"
-	"github.com/gogo/protobuf/proto"
+	"github.com/cockroachdb/cockroach/config"
+	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/retry"
+	"github.com/cockroachdb/cockroach/util/stop"
 )
 
-// Future home of the aysynchronous schema changer that picks up
-// queued schema changes and processes them.
-//
-// applyMutations applies the queued mutations for a table.
-// TODO(vivek): Eliminate the need to pass in tableName.
-func (p *planner) applyMutations(tableDesc *TableDescriptor, tableName *parser.QualifiedName) error {
-	if len(tableDesc.Mutations) == 0 {
-		return nil
+var (
+	// SchemaChangeLeaseDuration is the duration a schema change lease is
+	// acquired for. Exported for testing purposes only.
+	SchemaChangeLeaseDuration = 5 * time.Minute
+	// MinSchemaChangeLeaseDuration is the minimum duration a schema change
+	// lease will have remaining before it is extended. Exported for testing
+	// purposes only.
+	MinSchemaChangeLeaseDuration = time.Minute
+
+	// asyncSchemaChangeDelay is the time a node waits after seeing a pending
+	// schema change in the system config before attempting to execute it
+	// itself. This gives the node that queued the change the chance to
+	// execute it first.
+	asyncSchemaChangeDelay = 30 * time.Second
+
+	errExistingSchemaChangeLease = errors.New("an outstanding schema change lease exists")
+	errSchemaChangeLeaseLost     = errors.New("the schema change lease has been lost")
+	errDescriptorNotFound        = errors.New("descriptor not found")
+	errDidntUpdateDescriptor     = errors.New("didn't update the table descriptor")
+	errTableDropDeferred         = errors.New("the data of the dropped table is cleared later")
+)
+
+// schemaChangeRetryOptions are the options used when waiting for the leases
+// on an old version of a table descriptor to drain.
+var schemaChangeRetryOptions = retry.Options{
+	InitialBackoff: 20 * time.Millisecond,
+	MaxBackoff:     2 * time.Second,
+	Multiplier:     2,
+}
+
+// SchemaChanger is used to change the schema on a table. The schema changer
+// walks the mutations queued on a table descriptor by a schema change
+// statement through the DELETE_ONLY -> WRITE_ONLY -> public states, waiting
+// at every step for the old version of the descriptor to drain from the
+// cluster, and backfills the table data in between.
+type SchemaChanger struct {
+	tableID ID
+	// The mutations queued with this id, and all the ones queued before it,
+	// are processed by the schema changer.
+	mutationID MutationID
+	nodeID     uint32
+	db         client.DB
+	leaseMgr   *LeaseManager
+	// The time at which the schema changer is to be executed. Only used by
+	// the SchemaChangeManager.
+	execAfter time.Time
+}
+
+// notifySchemaChange queues the execution of the schema change on the
+// specified table once the current transaction commits.
+func (p *planner) notifySchemaChange(id ID, mutationID MutationID) {
+	for _, sc := range p.schemaChangers {
+		if sc.tableID == id && sc.mutationID == mutationID {
+			// The statement was retried.
+			return
+		}
+	}
+	sc := SchemaChanger{
+		tableID:    id,
+		mutationID: mutationID,
+		nodeID:     p.evalCtx.NodeID,
+		leaseMgr:   p.leaseMgr,
 	}
-	newTableDesc := proto.Clone(tableDesc).(*TableDescriptor)
-	// Make all mutations active.
-	for _, mutation := range newTableDesc.Mutations {
-		newTableDesc.makeMutationComplete(mutation)
+	p.schemaChangers = append(p.schemaChangers, sc)
+}
+
+// getTableDescFromID retrieves the table descriptor for the specified ID.
+func getTableDescFromID(txn *client.Txn, id ID) (*TableDescriptor, error) {
+	desc := &Descriptor{}
+	if err := txn.GetProto(MakeDescMetadataKey(id), desc); err != nil {
+		return nil, err
 	}
-	newTableDesc.Mutations = nil
-	if err := newTableDesc.Validate(); err != nil {
+	tableDesc := desc.GetTable()
+	if tableDesc == nil {
+		return nil, errDescriptorNotFound
+	}
+	return tableDesc, nil
+}
+
+func (sc *SchemaChanger) createSchemaChangeLease() TableDescriptor_SchemaChangeLease {
+	return TableDescriptor_SchemaChangeLease{
+		NodeID:         sc.nodeID,
+		ExpirationTime: sc.leaseMgr.clock.Now().WallTime + int64(SchemaChangeLeaseDuration),
+	}
+}
+
+// AcquireLease acquires a schema change lease on the table if an unexpired
+// lease doesn't exist. It returns the lease.
+func (sc *SchemaChanger) AcquireLease() (TableDescriptor_SchemaChangeLease, error) {
+	var lease TableDescriptor_SchemaChangeLease
+	err := sc.db.Txn(func(txn *client.Txn) error {
+		tableDesc, err := getTableDescFromID(txn, sc.tableID)
+		if err != nil {
+			return err
+		}
+
+		// A second to deal with the time uncertainty across nodes.
+		// It is perfectly valid for two or more goroutines to hold a valid
+		// lease and execute a schema change in parallel, because schema
+		// changes are executed using transactions that run sequentially.
+		// This just reduces the probability of a write collision.
+		expirationTimeUncertainty := time.Second
+		if tableDesc.Lease != nil &&
+			tableDesc.Lease.ExpirationTime+int64(expirationTimeUncertainty) > sc.leaseMgr.clock.Now().WallTime {
+			return errExistingSchemaChangeLease
+		}
+		lease = sc.createSchemaChangeLease()
+		tableDesc.Lease = &lease
+		txn.SetSystemDBTrigger()
+		return txn.Put(MakeDescMetadataKey(tableDesc.ID), wrapDescriptor(tableDesc))
+	})
+	return lease, err
+}
+
+// findTableWithLease retrieves the table descriptor, verifying that the
+// schema changer still holds the specified lease.
+func (sc *SchemaChanger) findTableWithLease(txn *client.Txn, lease TableDescriptor_SchemaChangeLease) (*TableDescriptor, error) {
+	tableDesc, err := getTableDescFromID(txn, sc.tableID)
+	if err != nil {
+		return nil, err
+	}
+	if tableDesc.Lease == nil || *tableDesc.Lease != lease {
+		return nil, errSchemaChangeLeaseLost
+	}
+	return tableDesc, nil
+}
+
+// ReleaseLease releases the table lease if it is the one registered with
+// the table descriptor.
+func (sc *SchemaChanger) ReleaseLease(lease TableDescriptor_SchemaChangeLease) error {
+	return sc.db.Txn(func(txn *client.Txn) error {
+		tableDesc, err := sc.findTableWithLease(txn, lease)
+		if err != nil {
+			return err
+		}
+		tableDesc.Lease = nil
+		txn.SetSystemDBTrigger()
+		return txn.Put(MakeDescMetadataKey(tableDesc.ID), wrapDescriptor(tableDesc))
+	})
+}
+
+// ExtendLease extends the existing table lease.
+func (sc *SchemaChanger) ExtendLease(existingLease TableDescriptor_SchemaChangeLease) (TableDescriptor_SchemaChangeLease, error) {
+	var lease TableDescriptor_SchemaChangeLease
+	err := sc.db.Txn(func(txn *client.Txn) error {
+		tableDesc, err := sc.findTableWithLease(txn, existingLease)
+		if err != nil {
+			return err
+		}
+		lease = sc.createSchemaChangeLease()
+		tableDesc.Lease = &lease
+		txn.SetSystemDBTrigger()
+		return txn.Put(MakeDescMetadataKey(tableDesc.ID), wrapDescriptor(tableDesc))
+	})
+	return lease, err
+}
+
+// maybeExtendLease extends the lease in place if it is close to expiring.
+func (sc *SchemaChanger) maybeExtendLease(lease *TableDescriptor_SchemaChangeLease) error {
+	remaining := time.Duration(lease.ExpirationTime - sc.leaseMgr.clock.Now().WallTime)
+	if remaining > MinSchemaChangeLeaseDuration {
+		return nil
+	}
+	newLease, err := sc.ExtendLease(*lease)
+	if err != nil {
 		return err
 	}
+	*lease = newLease
+	return nil
+}
 
-	b := client.Batch{}
-	if err := p.backfillBatch(&b, tableName, tableDesc, newTableDesc); err != nil {
+// publish publishes a new version of the table descriptor modified by
+// update, and releases the leases this node holds on the previous version.
+// The update closure returns errDidntUpdateDescriptor to abort publication
+// when it has nothing to change.
+func (sc *SchemaChanger) publish(update func(*TableDescriptor) error) error {
+	var version uint32
+	if err := sc.leaseMgr.Publish(sc.tableID, func(desc *TableDescriptor) error {
+		if err := update(desc); err != nil {
+			return err
+		}
+		version = desc.Version + 1
+		return nil
+	}); err != nil {
 		return err
 	}
-	// TODO(pmattis): This is a hack. Remove when schema change operations work
-	// properly.
-	p.hackNoteSchemaChange(newTableDesc)
+	return sc.leaseMgr.purgeOldLeases(sc.tableID, version)
+}
 
-	b.Put(MakeDescMetadataKey(newTableDesc.GetID()), wrapDescriptor(newTableDesc))
+// NewSchemaChangerForTesting only for tests.
+func NewSchemaChangerForTesting(tableID ID, mutationID MutationID, nodeID uint32, db client.DB, leaseMgr *LeaseManager) SchemaChanger {
+	return SchemaChanger{
+		tableID:    tableID,
+		mutationID: mutationID,
+		nodeID:     nodeID,
+		db:         db,
+		leaseMgr:   leaseMgr,
+	}
+}
 
-	if err := p.txn.Run(&b); err != nil {
-		return convertBatchError(newTableDesc, b, err)
+// Exec executes the entire schema change in steps.
+func (sc *SchemaChanger) Exec() error {
+	// Acquire lease.
+	lease, err := sc.AcquireLease()
+	if err != nil {
+		if err == errDescriptorNotFound {
+			// The table was dropped: nothing left to do.
+			return nil
+		}
+		return err
+	}
+	// Always try to release lease.
+	defer func(l *TableDescriptor_SchemaChangeLease) {
+		if err := sc.ReleaseLease(*l); err != nil && err != errDescriptorNotFound {
+			log.Warning(err)
+		}
+	}(&lease)
+
+	if err := sc.execWithLease(&lease); err != nil && err != errDescriptorNotFound {
+		return err
 	}
 	return nil
 }
+
+func (sc *SchemaChanger) execWithLease(lease *TableDescriptor_SchemaChangeLease) error {
+	// Increment the version and unset tableDescriptor.UpVersion.
+	if err := sc.MaybeIncrementVersion(); err != nil {
+		return err
+	}
+
+	if dropped, err := sc.maybeDropTable(lease); dropped || err != nil {
+		return err
+	}
+
+	// Process the queued mutations in FIFO order, up to and including the ones
+	// queued under sc.mutationID.
+	for {
+		mutationID, err := sc.firstMutationID()
+		if err != nil {
+			return err
+		}
+		if mutationID == invalidMutationID || mutationID > sc.mutationID {
+			return nil
+		}
+		if err := sc.runMutations(mutationID, lease); err != nil {
+			if mutationID != sc.mutationID && isPermanentSchemaChangeError(err) {
+				// The mutations were queued by another schema change statement
+				// and have been reversed. Carry on with the next ones.
+				log.Warningf("table %d: schema change %d failed: %s", sc.tableID, mutationID, err)
+				continue
+			}
+			return err
+		}
+	}
+}
+
+// MaybeIncrementVersion increments the version if needed. This is the first
+// step of every schema change: it makes sure that the whole cluster has
+// stopped using the version of the descriptor that preceded the queued
+// mutations before the mutations are moved forward.
+func (sc *SchemaChanger) MaybeIncrementVersion() error {
+	err := sc.publish(func(desc *TableDescriptor) error {
+		if !desc.UpVersion {
+			// Return error so that Publish() doesn't increment the version.
+			return errDidntUpdateDescriptor
+		}
+		desc.UpVersion = false
+		return nil
+	})
+	if err == errDidntUpdateDescriptor {
+		return nil
+	}
+	return err
+}
+
+// getTableZoneConfig reads the zone config of a table, inheriting the
+// fields it doesn't set from the zone config of its database and then from
+// the default zone config.
+func getTableZoneConfig(txn *client.Txn, tableDesc *TableDescriptor) (*config.ZoneConfig, error) {
+	levels := []zoneLevel{{}, {}, {zone: config.DefaultZoneConfig}}
+	for i, id := range []ID{tableDesc.ID, tableDesc.ParentID} {
+		var err error
+		if levels[i].zone, err = getZoneConfig(txn, id); err != nil {
+			return nil, err
+		}
+	}
+	return resolveZoneConfig(levels), nil
+}
+
+// maybeDropTable clears the data of a dropped table once the GC TTL of its
+// zone has passed, and then deletes the descriptor and zone config of the
+// table. It returns true if the table is dropped, along with
+// errTableDropDeferred if the GC TTL hasn't passed yet.
+func (sc *SchemaChanger) maybeDropTable(lease *TableDescriptor_SchemaChangeLease) (bool, error) {
+	var tableDesc *TableDescriptor
+	var zone *config.ZoneConfig
+	if err := sc.db.Txn(func(txn *client.Txn) error {
+		var err error
+		if tableDesc, err = getTableDescFromID(txn, sc.tableID); err != nil {
+			return err
+		}
+		if !tableDesc.Dropped() {
+			return nil
+		}
+		zone, err = getTableZoneConfig(txn, tableDesc)
+		return err
+	}); err != nil {
+		return false, err
+	}
+	if !tableDesc.Dropped() {
+		return false, nil
+	}
+	if time.Now().Before(dropDeadline(tableDesc, zone)) {
+		return true, errTableDropDeferred
+	}
+
+	// Wait until no node uses the table anymore.
+	if _, err := sc.leaseMgr.waitForOneVersion(sc.tableID, schemaChangeRetryOptions); err != nil {
+		return true, err
+	}
+	if err := sc.maybeExtendLease(lease); err != nil {
+		return true, err
+	}
+
+	// Clear the data of the table, bypassing MVCC: the values are gone
+	// for good, which is fine now that they have outlived the GC TTL.
+	prefix := roachpb.Key(keys.MakeTablePrefix(uint32(sc.tableID)))
+	b := &client.Batch{}
+	b.InternalAddRequest(&roachpb.ClearRangeRequest{
+		Span: roachpb.Span{Key: prefix, EndKey: prefix.PrefixEnd()},
+	})
+	if err := sc.db.Run(b); err != nil {
+		return true, err
+	}
+
+	return true, sc.db.Txn(func(txn *client.Txn) error {
+		if _, err := sc.findTableWithLease(txn, *lease); err != nil {
+			return err
+		}
+		b := txn.NewBatch()
+		b.Del(MakeDescMetadataKey(sc.tableID))
+		b.Del(MakeZoneKey(sc.tableID))
+		txn.SetSystemDBTrigger()
+		return txn.CommitInBatch(b)
+	})
+}
+
+// firstMutationID returns the id of the oldest mutations queued on the table
+// that are to be processed by a schema changer.
+func (sc *SchemaChanger) firstMutationID() (MutationID, error) {
+	mutationID := invalidMutationID
+	err := sc.db.Txn(func(txn *client.Txn) error {
+		mutationID = invalidMutationID
+		tableDesc, err := getTableDescFromID(txn, sc.tableID)
+		if err != nil {
+			return err
+		}
+		for _, m := range tableDesc.Mutations {
+			if m.MutationID != invalidMutationID {
+				mutationID = m.MutationID
+				break
+			}
+		}
+		return nil
+	})
+	return mutationID, err
+}
+
+// runMutations takes the mutations queued with the specified id through all
+// their states, backfilling the table data once the mutations are visible to
+// writes everywhere. A backfill failing with a permanent error reverses the
+// mutations, which are then taken through their states again, and the error
+// is returned.
+func (sc *SchemaChanger) runMutations(mutationID MutationID, lease *TableDescriptor_SchemaChangeLease) error {
+	// Move the mutations to the state in which they can be backfilled.
+	if err := sc.runStateMachine(mutationID); err != nil {
+		return err
+	}
+	// Wait until the whole cluster is using the new version.
+	if _, err := sc.leaseMgr.waitForOneVersion(sc.tableID, schemaChangeRetryOptions); err != nil {
+		return err
+	}
+	if err := sc.runBackfill(mutationID, lease); err != nil {
+		if !isPermanentSchemaChangeError(err) {
+			return err
+		}
+		log.Warningf("table %d: reversing schema change %d: %s", sc.tableID, mutationID, err)
+		if rErr := sc.reverseMutations(mutationID); rErr != nil {
+			return rErr
+		}
+		// Clean up the data written for the reversed mutations. The reversed
+		// mutations only remove data and cannot fail permanently.
+		if rErr := sc.runMutations(mutationID, lease); rErr != nil {
+			return rErr
+		}
+		return err
+	}
+	return sc.done(mutationID)
+}
+
+// runStateMachine moves the mutations with the specified id to the state in
+// which they are backfilled: WRITE_ONLY for the added descriptors and
+// DELETE_ONLY for the dropped ones.
+func (sc *SchemaChanger) runStateMachine(mutationID MutationID) error {
+	err := sc.publish(func(desc *TableDescriptor) error {
+		modified := false
+		for i := range desc.Mutations {
+			m := &desc.Mutations[i]
+			if m.MutationID != mutationID {
+				continue
+			}
+			switch m.Direction {
+			case DescriptorMutation_ADD:
+				if m.State == DescriptorMutation_DELETE_ONLY {
+					m.State = DescriptorMutation_WRITE_ONLY
+					modified = true
+				}
+
+			case DescriptorMutation_DROP:
+				if m.State == DescriptorMutation_WRITE_ONLY {
+					m.State = DescriptorMutation_DELETE_ONLY
+					modified = true
+				}
+			}
+		}
+		if !modified {
+			return errDidntUpdateDescriptor
+		}
+		return nil
+	})
+	if err == errDidntUpdateDescriptor {
+		return nil
+	}
+	return err
+}
+
+// reverseMutations reverses the direction of the mutations with the
+// specified id, discarding their backfill progress.
+func (sc *SchemaChanger) reverseMutations(mutationID MutationID) error {
+	err := sc.publish(func(desc *TableDescriptor) error {
+		modified := false
+		for i := range desc.Mutations {
+			m := &desc.Mutations[i]
+			if m.MutationID != mutationID {
+				continue
+			}
+			switch m.Direction {
+			case DescriptorMutation_ADD:
+				m.Direction = DescriptorMutation_DROP
+			case DescriptorMutation_DROP:
+				m.Direction = DescriptorMutation_ADD
+			}
+			m.ResumeSpan = roachpb.Span{}
+			modified = true
+		}
+		if !modified {
+			return errDidntUpdateDescriptor
+		}
+		return nil
+	})
+	if err == errDidntUpdateDescriptor {
+		return nil
+	}
+	return err
+}
+
+// done makes the mutations with the specified id public (or removes them for
+// good when they are being dropped).
+func (sc *SchemaChanger) done(mutationID MutationID) error {
+	err := sc.publish(func(desc *TableDescriptor) error {
+		i := 0
+		for _, m := range desc.Mutations {
+			if m.MutationID == mutationID {
+				desc.makeMutationComplete(m)
+			} else {
+				desc.Mutations[i] = m
+				i++
+			}
+		}
+		if i == len(desc.Mutations) {
+			return errDidntUpdateDescriptor
+		}
+		desc.Mutations = desc.Mutations[:i]
+		return desc.Validate()
+	})
+	if err == errDidntUpdateDescriptor {
+		return nil
+	}
+	return err
+}
+
+// isPermanentSchemaChangeError returns true if the error is one that retrying
+// the schema change won't fix, in which case the schema change is reversed.
+func isPermanentSchemaChangeError(err error) bool {
+	switch err.(type) {
+	case errUniquenessConstraintViolation, errNotNullConstraintViolation:
+		return true
+	}
+	return false
+}
+
+// resumeSpan returns the part of the span that has yet to be processed for
+// the mutation.
+func resumeSpan(m DescriptorMutation, sp roachpb.Span) roachpb.Span {
+	if m.ResumeSpan.Key == nil {
+		// The mutation hasn't been backfilled yet.
+		return sp
+	}
+	return m.ResumeSpan
+}
+
+// spanDone returns true if there is nothing left to process in the span.
+func spanDone(sp roachpb.Span) bool {
+	return bytes.Compare(sp.Key, sp.EndKey) >= 0
+}
+
+// SchemaChangeManager processes the pending schema changes seen in gossip
+// updates. Most schema changes are executed by the node that queued them,
+// right after the transaction that queued them commits. The manager picks up
+// the others: schema changes queued by a transaction that spanned several
+// requests, and the ones left behind by a node that stopped before finishing
+// them. The persisted progress of an interrupted schema change allows it to
+// resume where it stopped.
+type SchemaChangeManager struct {
+	db       client.DB
+	gossip   *gossip.Gossip
+	leaseMgr *LeaseManager
+
+	mu        sync.Mutex
+	cfg       *config.SystemConfig
+	cfgUpdate chan struct{}
+
+	// Schema changers collected from the latest system config, keyed by
+	// table.
+	schemaChangers map[ID]SchemaChanger
+}
+
+// NewSchemaChangeManager returns a new SchemaChangeManager.
+func NewSchemaChangeManager(db client.DB, gossip *gossip.Gossip, leaseMgr *LeaseManager) *SchemaChangeManager {
+	return &SchemaChangeManager{
+		db:             db,
+		gossip:         gossip,
+		leaseMgr:       leaseMgr,
+		cfgUpdate:      make(chan struct{}, 1),
+		schemaChangers: make(map[ID]SchemaChanger),
+	}
+}
+
+// updateSchemaChangers refreshes the set of pending schema changers from the
+// system config.
+func (s *SchemaChangeManager) updateSchemaChangers(cfg *config.SystemConfig) {
+	schemaChangers := make(map[ID]SchemaChanger)
+	prefix := MakeIndexKeyPrefix(DescriptorTable.ID, DescriptorTable.PrimaryIndex.ID)
+	for _, kv := range cfg.Values {
+		if !bytes.HasPrefix(kv.Key, prefix) {
+			continue
+		}
+		desc := &Descriptor{}
+		if err := kv.Value.GetProto(desc); err != nil {
+			log.Warningf("unable to unmarshal descriptor %s: %s", kv.Key, err)
+			continue
+		}
+		table := desc.GetTable()
+		if table == nil {
+			continue
+		}
+		mutationID := invalidMutationID
+		for _, m := range table.Mutations {
+			if m.MutationID > mutationID {
+				mutationID = m.MutationID
+			}
+		}
+		if !table.UpVersion && !table.Dropped() && mutationID == invalidMutationID {
+			continue
+		}
+		sc, ok := s.schemaChangers[table.ID]
+		if !ok {
+			sc = SchemaChanger{
+				tableID:   table.ID,
+				db:        s.db,
+				leaseMgr:  s.leaseMgr,
+				execAfter: time.Now().Add(asyncSchemaChangeDelay),
+			}
+			if table.Dropped() {
+				// Don't bother before the data of the table can be cleared.
+				zone, err := GetZoneConfig(*cfg, uint32(table.ID))
+				if err != nil {
+					log.Warningf("table %d: unable to read zone config: %s", table.ID, err)
+					continue
+				}
+				if deadline := dropDeadline(table, zone); deadline.After(sc.execAfter) {
+					sc.execAfter = deadline
+				}
+			}
+		}
+		sc.mutationID = mutationID
+		sc.nodeID = s.leaseMgr.nodeID
+		schemaChangers[table.ID] = sc
+	}
+	s.schemaChangers = schemaChangers
+}
+
+// Start starts a goroutine that runs the outstanding schema changes for the
+// tables received in the latest system configuration via gossip.
+func (s *SchemaChangeManager) Start(stopper *stop.Stopper) {
+	s.gossip.RegisterSystemConfigCallback(func(cfg *config.SystemConfig) {
+		s.mu.Lock()
+		s.cfg = cfg
+		s.mu.Unlock()
+		select {
+		case s.cfgUpdate <- struct{}{}:
+		default:
+		}
+	})
+
+	stopper.RunWorker(func() {
+		ticker := time.NewTicker(asyncSchemaChangeDelay)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-s.cfgUpdate:
+				s.mu.Lock()
+				cfg := s.cfg
+				s.mu.Unlock()
+				s.updateSchemaChangers(cfg)
+
+			case <-ticker.C:
+				for id, sc := range s.schemaChangers {
+					if time.Now().Before(sc.execAfter) {
+						continue
+					}
+					if err := sc.Exec(); err != nil {
+						if err != errExistingSchemaChangeLease && err != errTableDropDeferred {
+							log.Warningf("table %d: error executing schema change: %s", id, err)
+						}
+						// Try again later.
+						sc.execAfter = time.Now().Add(asyncSchemaChangeDelay)
+						s.schemaChangers[id] = sc
+						continue
+					}
+					delete(s.schemaChangers, id)
+				}
+
+			case <-stopper.ShouldStop():
+				return
+			}
+		}
+	})
+}
@@ -0,0 +1,293 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	csql "github.com/cockroachdb/cockroach/sql"
+	"github.com/cockroachdb/cockroach/testutils"
+	"github.com/cockroachdb/cockroach/util/encoding"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func getTestTableDesc(t *testing.T, kvDB *client.DB, table string) (*csql.TableDescriptor, roachpb.Key) {
+	nameKey := csql.MakeNameMetadataKey(keys.MaxReservedDescID+1, table)
+	gr, err := kvDB.Get(nameKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !gr.Exists() {
+		t.Fatalf("name entry %q does not exist", nameKey)
+	}
+	descKey := csql.MakeDescMetadataKey(csql.ID(gr.ValueInt()))
+	desc := &csql.Descriptor{}
+	if err := kvDB.GetProto(descKey, desc); err != nil {
+		t.Fatal(err)
+	}
+	return desc.GetTable(), descKey
+}
+
+func countKeys(t *testing.T, kvDB *client.DB, prefix []byte) int {
+	start := roachpb.Key(prefix)
+	kvs, err := kvDB.Scan(start, start.PrefixEnd(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return len(kvs)
+}
+
+func TestSchemaChangeLease(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, kvDB := setup(t)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.test (k CHAR PRIMARY KEY, v CHAR);
+`); err != nil {
+		t.Fatal(err)
+	}
+	tableDesc, _ := getTestTableDesc(t, kvDB, "test")
+
+	leaseMgr := csql.NewLeaseManager(0, *s.DB(), s.Clock())
+	changer := csql.NewSchemaChangerForTesting(tableDesc.ID, 0, 1, *s.DB(), leaseMgr)
+
+	lease, err := changer.AcquireLease()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A second lease can't be acquired.
+	if _, err := changer.AcquireLease(); !testutils.IsError(err, "an outstanding schema change lease exists") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Extending the lease works and invalidates the old one.
+	newLease, err := changer.ExtendLease(lease)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if newLease.ExpirationTime <= lease.ExpirationTime {
+		t.Fatalf("expiration %d not extended beyond %d", newLease.ExpirationTime, lease.ExpirationTime)
+	}
+	if err := changer.ReleaseLease(lease); !testutils.IsError(err, "schema change lease has been lost") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Once released, the lease can be acquired again.
+	if err := changer.ReleaseLease(newLease); err != nil {
+		t.Fatal(err)
+	}
+	lease, err = changer.AcquireLease()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := changer.ReleaseLease(lease); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// Test that schema changes are backfilled in chunks and that each step of
+// the schema change publishes a new version of the descriptor.
+func TestSchemaChangeChunkedBackfill(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	defer csql.TestingSetBackfillChunkSize(7)()
+	s, sqlDB, kvDB := setup(t)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.test (k INT PRIMARY KEY, v INT, w INT);
+`); err != nil {
+		t.Fatal(err)
+	}
+	const numRows = 100
+	for i := 0; i < numRows; i++ {
+		if _, err := sqlDB.Exec(`INSERT INTO t.test VALUES ($1, $2, $3)`, i, numRows-i, i); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tableDesc, _ := getTestTableDesc(t, kvDB, "test")
+	version := tableDesc.Version
+
+	if _, err := sqlDB.Exec(`CREATE UNIQUE INDEX foo ON t.test (v)`); err != nil {
+		t.Fatal(err)
+	}
+	tableDesc, _ = getTestTableDesc(t, kvDB, "test")
+	// The version is incremented to publish the mutation, to move it to the
+	// WRITE_ONLY state and to make the index public.
+	if e := version + 3; tableDesc.Version != e {
+		t.Fatalf("expected version %d, but found %d", e, tableDesc.Version)
+	}
+	if len(tableDesc.Mutations) != 0 || tableDesc.UpVersion || tableDesc.Lease != nil {
+		t.Fatalf("schema change not complete: %+v", tableDesc)
+	}
+	_, i, err := tableDesc.FindIndexByName("foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	indexPrefix := csql.MakeIndexKeyPrefix(tableDesc.ID, tableDesc.Indexes[i].ID)
+	if n := countKeys(t, kvDB, indexPrefix); n != numRows {
+		t.Fatalf("expected %d index entries, but found %d", numRows, n)
+	}
+	var k int
+	if err := sqlDB.QueryRow(`SELECT k FROM t.test@foo WHERE v = 30`).Scan(&k); err != nil {
+		t.Fatal(err)
+	} else if k != numRows-30 {
+		t.Fatalf("expected %d, but found %d", numRows-30, k)
+	}
+
+	// Dropping a column deletes its values.
+	primaryPrefix := csql.MakeIndexKeyPrefix(tableDesc.ID, tableDesc.PrimaryIndex.ID)
+	if n := countKeys(t, kvDB, primaryPrefix); n != 3*numRows {
+		t.Fatalf("expected %d key value pairs, but found %d", 3*numRows, n)
+	}
+	if _, err := sqlDB.Exec(`ALTER TABLE t.test DROP w`); err != nil {
+		t.Fatal(err)
+	}
+	if n := countKeys(t, kvDB, primaryPrefix); n != 2*numRows {
+		t.Fatalf("expected %d key value pairs, but found %d", 2*numRows, n)
+	}
+
+	// Dropping an index deletes its entries.
+	if _, err := sqlDB.Exec(`DROP INDEX t.test@foo`); err != nil {
+		t.Fatal(err)
+	}
+	if n := countKeys(t, kvDB, indexPrefix); n != 0 {
+		t.Fatalf("expected no index entries, but found %d", n)
+	}
+
+	// Adding a column with a default value writes the value in every row.
+	if _, err := sqlDB.Exec(`ALTER TABLE t.test ADD x INT NOT NULL DEFAULT 3`); err != nil {
+		t.Fatal(err)
+	}
+	if n := countKeys(t, kvDB, primaryPrefix); n != 3*numRows {
+		t.Fatalf("expected %d key value pairs, but found %d", 3*numRows, n)
+	}
+	var count int
+	if err := sqlDB.QueryRow(`SELECT COUNT(*) FROM t.test WHERE x = 3`).Scan(&count); err != nil {
+		t.Fatal(err)
+	} else if count != numRows {
+		t.Fatalf("expected %d, but found %d", numRows, count)
+	}
+
+	// A uniqueness violation discovered by the backfill reverses the schema
+	// change and deletes the entries that were written.
+	if _, err := sqlDB.Exec(`UPDATE t.test SET v = 1 WHERE k = 90`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sqlDB.Exec(`CREATE UNIQUE INDEX bar ON t.test (v)`); !testutils.IsError(err,
+		`duplicate key value \(v\)=\(1\) violates unique constraint "bar"`) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tableDesc, _ = getTestTableDesc(t, kvDB, "test")
+	if len(tableDesc.Mutations) != 0 || len(tableDesc.Indexes) != 0 {
+		t.Fatalf("schema change not reversed: %+v", tableDesc)
+	}
+	barPrefix := csql.MakeIndexKeyPrefix(tableDesc.ID, tableDesc.NextIndexID-1)
+	if n := countKeys(t, kvDB, barPrefix); n != 0 {
+		t.Fatalf("expected no index entries, but found %d", n)
+	}
+}
+
+// Test that a schema change interrupted in the middle of its backfill is
+// resumed from the progress persisted in the descriptor.
+func TestSchemaChangeResume(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, kvDB := setup(t)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.test (k INT PRIMARY KEY, v INT);
+`); err != nil {
+		t.Fatal(err)
+	}
+	const numRows = 20
+	for i := 0; i < numRows; i++ {
+		if _, err := sqlDB.Exec(`INSERT INTO t.test VALUES ($1, $2)`, i, i); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Queue an index addition that was interrupted after backfilling the
+	// first half of the table.
+	tableDesc, descKey := getTestTableDesc(t, kvDB, "test")
+	const mutationID = 1
+	primaryPrefix := roachpb.Key(csql.MakeIndexKeyPrefix(tableDesc.ID, tableDesc.PrimaryIndex.ID))
+	resumeKey := roachpb.Key(encoding.EncodeVarint(append([]byte(nil), primaryPrefix...), numRows/2))
+	index := csql.IndexDescriptor{
+		Name:              "foo",
+		ID:                tableDesc.NextIndexID,
+		ColumnNames:       []string{"v"},
+		ColumnIDs:         []csql.ColumnID{2},
+		ImplicitColumnIDs: []csql.ColumnID{1},
+	}
+	tableDesc.NextIndexID++
+	tableDesc.NextMutationID = mutationID + 1
+	tableDesc.Mutations = append(tableDesc.Mutations, csql.DescriptorMutation{
+		Descriptor_: &csql.DescriptorMutation_Index{Index: &index},
+		State:       csql.DescriptorMutation_WRITE_ONLY,
+		Direction:   csql.DescriptorMutation_ADD,
+		MutationID:  mutationID,
+		ResumeSpan:  roachpb.Span{Key: resumeKey, EndKey: primaryPrefix.PrefixEnd()},
+	})
+	if err := tableDesc.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if err := kvDB.Put(descKey, &csql.Descriptor{Union: &csql.Descriptor_Table{Table: tableDesc}}); err != nil {
+		t.Fatal(err)
+	}
+
+	leaseMgr := csql.NewLeaseManager(0, *s.DB(), s.Clock())
+	changer := csql.NewSchemaChangerForTesting(tableDesc.ID, mutationID, 1, *s.DB(), leaseMgr)
+	if err := changer.Exec(); err != nil {
+		t.Fatal(err)
+	}
+
+	tableDesc, _ = getTestTableDesc(t, kvDB, "test")
+	if len(tableDesc.Mutations) != 0 || len(tableDesc.Indexes) != 1 {
+		t.Fatalf("schema change not complete: %+v", tableDesc)
+	}
+	// Only the second half of the table was backfilled.
+	indexPrefix := csql.MakeIndexKeyPrefix(tableDesc.ID, index.ID)
+	if n := countKeys(t, kvDB, indexPrefix); n != numRows/2 {
+		t.Fatalf("expected %d index entries, but found %d", numRows/2, n)
+	}
+	rows, err := sqlDB.Query(`SELECT k FROM t.test@foo`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ks []int
+	for rows.Next() {
+		var k int
+		if err := rows.Scan(&k); err != nil {
+			t.Fatal(err)
+		}
+		ks = append(ks, k)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if e, a := fmt.Sprint([]int{10, 11, 12, 13, 14, 15, 16, 17, 18, 19}), fmt.Sprint(ks); e != a {
+		t.Fatalf("expected %s, but found %s", e, a)
+	}
+}
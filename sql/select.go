@@ -36,10 +36,13 @@ import (
 // arbitrary columns. Full table scans can be avoided when indexes can be used
 // to satisfy the where-clause.
 //
-// Privileges: SELECT on table
+// Privileges: SELECT on table, or on the columns read.
 //   Notes: postgres requires SELECT. Also requires UPDATE on "FOR UPDATE".
 //          mysql requires SELECT.
 func (p *planner) Select(n *parser.Select) (planNode, error) {
+	if n.AsOf.Expr != nil && p.asOfSystemTime == roachpb.ZeroTimestamp {
+		return nil, errAsOfNotTopLevel
+	}
 	scan := &scanNode{planner: p, txn: p.txn}
 	if err := scan.initFrom(p, n.From); err != nil {
 		return nil, err
@@ -64,6 +67,9 @@ func (p *planner) Select(n *parser.Select) (planNode, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err := scan.checkColumnPrivileges(); err != nil {
+		return nil, err
+	}
 
 	plan, err := p.selectIndex(scan, group, sort)
 	if err != nil {
@@ -100,6 +106,12 @@ func (p *planner) selectIndex(s *scanNode, group *groupNode, sort *sortNode) (pl
 		return s, nil
 	}
 
+	if s.virtual {
+		// Virtual tables have no index: their rows are generated in no
+		// particular order and filtered one by one.
+		return s, nil
+	}
+
 	candidates := make([]*indexInfo, 0, len(s.desc.Indexes)+1)
 	if s.isSecondaryIndex {
 		// An explicit secondary index was requested. Only add it to the candidate
@@ -206,6 +218,8 @@ func (p *planner) selectIndex(s *scanNode, group *groupNode, sort *sortNode) (pl
 	}
 	s.filter = applyConstraints(s.filter, c.constraints)
 	s.reverse = c.reverse
+	s.constraints = c.constraints
+	s.estSelectivity = c.selectivity()
 
 	var plan planNode
 	if c.covering {
@@ -328,6 +342,17 @@ func (v *indexInfo) analyzeExprs(exprs []parser.Exprs) {
 	}
 }
 
+// selectivity estimates the fraction of the index selected by the
+// constraints. There are no table statistics: the estimate is the factor by
+// which analyzeExprs scales the cost of the index, relative to an index
+// constrained on none of its columns.
+func (v *indexInfo) selectivity() float64 {
+	if len(v.constraints) == 0 {
+		return 1
+	}
+	return float64(len(v.index.ColumnIDs)) / float64(len(v.constraints)) / 1000
+}
+
 // analyzeOrdering analyzes the ordering provided by the index and determines
 // if it matches the ordering requested by the query. Non-matching orderings
 // increase the cost of using the index.
@@ -31,6 +31,7 @@ import (
 	"github.com/cockroachdb/cockroach/sql/driver"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/hlc"
+	"github.com/cockroachdb/cockroach/util/log"
 	"github.com/gogo/protobuf/proto"
 )
 
@@ -55,7 +56,8 @@ func MakeServer(ctx *base.Context, db client.DB, gossip *gossip.Gossip, clock *h
 // and JSON-encoded requests are supported. The response body is
 // encoded according to the request's Accept header, or if not
 // present, in the same format as the request's incoming Content-Type
-// header.
+// header. A request accepting driver.StreamContentType has its results
+// streamed as they are produced.
 func (s Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	defer r.Body.Close()
 	method := r.URL.Path
@@ -96,6 +98,11 @@ func (s Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if r.Header.Get(util.AcceptHeader) == driver.StreamContentType {
+		s.serveStream(w, args)
+		return
+	}
+
 	reply, code, err := s.Execute(args)
 	if err != nil {
 		http.Error(w, err.Error(), code)
@@ -113,6 +120,95 @@ func (s Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// serveStream executes the request, streaming the results as a sequence of
+// driver.ResponseFrames.
+func (s Server) serveStream(w http.ResponseWriter, args driver.Request) {
+	fw := &frameWriter{w: w}
+	session, code, err := s.ExecuteStatements(args, fw)
+	if err != nil {
+		if !fw.started {
+			http.Error(w, err.Error(), code)
+		} else if log.V(1) {
+			log.Infof("failed to stream response: %s", err)
+		}
+		return
+	}
+	// The last frame holds the session state.
+	if err := fw.writeFrame(&driver.ResponseFrame{Session: session}); err != nil {
+		if log.V(1) {
+			log.Infof("failed to stream response: %s", err)
+		}
+		return
+	}
+	fw.flush()
+}
+
+// frameRows is the maximum number of rows sent in a single frame.
+const frameRows = 100
+
+// frameWriter is a ResultWriter sending the results as ResponseFrames to an
+// HTTP response. Rows are sent in chunks of up to frameRows rows.
+type frameWriter struct {
+	w       http.ResponseWriter
+	started bool
+	rows    []driver.Response_Result_Rows_Row
+}
+
+var _ ResultWriter = &frameWriter{}
+
+func (fw *frameWriter) writeFrame(frame *driver.ResponseFrame) error {
+	if !fw.started {
+		fw.w.Header().Set(util.ContentTypeHeader, driver.StreamContentType)
+		fw.started = true
+	}
+	return driver.WriteFrame(fw.w, frame)
+}
+
+func (fw *frameWriter) flush() {
+	if f, ok := fw.w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (fw *frameWriter) flushRows() error {
+	if len(fw.rows) == 0 {
+		return nil
+	}
+	err := fw.writeFrame(&driver.ResponseFrame{Rows: fw.rows})
+	fw.rows = fw.rows[:0]
+	if err != nil {
+		return err
+	}
+	fw.flush()
+	return nil
+}
+
+func (fw *frameWriter) BeginResult(result driver.Response_Result, last bool) error {
+	return fw.writeFrame(&driver.ResponseFrame{Result: &result, Last: last})
+}
+
+func (fw *frameWriter) AddRow(row driver.Response_Result_Rows_Row) error {
+	fw.rows = append(fw.rows, row)
+	if len(fw.rows) >= frameRows {
+		return fw.flushRows()
+	}
+	return nil
+}
+
+func (fw *frameWriter) EndResult(err error) error {
+	if err := fw.flushRows(); err != nil {
+		return err
+	}
+	if err != nil {
+		errString := err.Error()
+		if err := fw.writeFrame(&driver.ResponseFrame{Error: &errString}); err != nil {
+			return err
+		}
+	}
+	fw.flush()
+	return nil
+}
+
 // RegisterRPC registers the SQL RPC endpoint.
 func (s Server) RegisterRPC(rpcServer *rpc.Server) error {
 	return rpcServer.RegisterPublic(driver.RPCMethod, s.executeCmd, &driver.Request{})
@@ -31,6 +31,9 @@ type Session struct {
 	//	*Session_Location
 	//	*Session_Offset
 	Timezone isSession_Timezone `protobuf_oneof:"timezone"`
+	// The maximum duration of a statement, in nanoseconds. Zero means no
+	// timeout.
+	StatementTimeout int64 `protobuf:"varint,7,opt,name=statement_timeout" json:"statement_timeout"`
 }
 
 func (m *Session) Reset()         { *m = Session{} }
@@ -183,6 +186,9 @@ func (m *Session) MarshalTo(data []byte) (int, error) {
 		}
 		i += nn2
 	}
+	data[i] = 0x38
+	i++
+	i = encodeVarintSession(data, i, uint64(m.StatementTimeout))
 	return i, nil
 }
 
@@ -276,6 +282,7 @@ func (m *Session) Size() (n int) {
 	if m.Timezone != nil {
 		n += m.Timezone.Size()
 	}
+	n += 1 + sovSession(uint64(m.StatementTimeout))
 	return n
 }
 
@@ -494,6 +501,25 @@ func (m *Session) Unmarshal(data []byte) error {
 				}
 			}
 			m.Timezone = &Session_Offset{v}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatementTimeout", wireType)
+			}
+			m.StatementTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.StatementTimeout |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSession(data[iNdEx:])
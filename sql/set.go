@@ -60,12 +60,52 @@ func (p *planner) Set(n *parser.Set) (planNode, error) {
 			return nil, fmt.Errorf("%s: \"%s\" is not in (%q, %q)", name, s, parser.Modern, parser.Traditional)
 		}
 
+	case `STATEMENT_TIMEOUT`:
+		timeout, err := p.getDurationVal(name, n.Values)
+		if err != nil {
+			return nil, err
+		}
+		p.session.StatementTimeout = int64(timeout)
+
 	default:
 		return nil, fmt.Errorf("unknown variable: %q", name)
 	}
 	return &valuesNode{}, nil
 }
 
+// getDurationVal evaluates a duration specified as a string such as '5s',
+// an interval, or an integer number of milliseconds. A nil value (DEFAULT)
+// is the zero duration.
+func (p *planner) getDurationVal(name string, values parser.Exprs) (time.Duration, error) {
+	if values == nil {
+		return 0, nil
+	}
+	if len(values) != 1 {
+		return 0, fmt.Errorf("%s: requires a single value", name)
+	}
+	val, err := values[0].Eval(p.evalCtx)
+	if err != nil {
+		return 0, err
+	}
+	var d time.Duration
+	switch t := val.(type) {
+	case parser.DString:
+		if d, err = time.ParseDuration(string(t)); err != nil {
+			return 0, fmt.Errorf("%s: %s", name, err)
+		}
+	case parser.DInterval:
+		d = t.Duration
+	case parser.DInt:
+		d = time.Duration(t) * time.Millisecond
+	default:
+		return 0, fmt.Errorf("%s: requires a duration: %s is a %s", name, values[0], val.Type())
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("%s: duration %s must not be negative", name, d)
+	}
+	return d, nil
+}
+
 func (p *planner) getStringVal(name string, values parser.Exprs) (string, error) {
 	if len(values) != 1 {
 		return "", fmt.Errorf("%s: requires a single string value", name)
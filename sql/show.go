@@ -21,6 +21,7 @@ import (
 	"bytes"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/sql/parser"
@@ -43,6 +44,13 @@ func (p *planner) Show(n *parser.Show) (planNode, error) {
 			return nil, err
 		}
 		v.rows = append(v.rows, []parser.Datum{parser.DString(loc.String())})
+	case `STATEMENT_TIMEOUT`:
+		// As in postgres, 0 means no timeout.
+		timeout := "0"
+		if p.session.StatementTimeout != 0 {
+			timeout = time.Duration(p.session.StatementTimeout).String()
+		}
+		v.rows = append(v.rows, []parser.Datum{parser.DString(timeout)})
 	case `SYNTAX`:
 		v.rows = append(v.rows, []parser.Datum{parser.DString(parser.Syntax(p.session.Syntax).String())})
 	case `TRANSACTION ISOLATION LEVEL`:
@@ -112,7 +120,10 @@ func (p *planner) ShowDatabases(n *parser.ShowDatabases) (planNode, error) {
 }
 
 // ShowGrants returns grant details for the specified objects and users.
-// TODO(marc): implement multiple targets, or no targets (meaning full scan).
+// Tables are listed as database.table when they span several databases.
+// Privileges granted on columns are not shown: they are listed by
+// information_schema.column_privileges.
+// TODO(marc): implement no targets (meaning full scan).
 // Privileges: None.
 //   Notes: postgres does not have a SHOW GRANTS statement.
 //          mysql only returns the user's privileges.
@@ -120,14 +131,29 @@ func (p *planner) ShowGrants(n *parser.ShowGrants) (planNode, error) {
 	if n.Targets == nil {
 		return nil, util.Errorf("TODO(marc): implement SHOW GRANT with no targets")
 	}
-	descriptor, err := p.getDescriptorFromTargetList(*n.Targets)
+	descriptors, err := p.getDescriptorsFromTargetList(*n.Targets)
 	if err != nil {
 		return nil, err
 	}
 
 	objectType := "Database"
+	// The names of the databases of the tables, if there are several.
+	var dbNames map[ID]string
 	if n.Targets.Tables != nil {
 		objectType = "Table"
+		// The targets have been normalized, and the database of a table or
+		// of a table pattern is the base of its name.
+		dbNames = map[ID]string{}
+		for _, qname := range n.Targets.Tables {
+			dbDesc, err := p.getDatabaseDesc(string(qname.Base))
+			if err != nil {
+				return nil, err
+			}
+			dbNames[dbDesc.ID] = dbDesc.Name
+		}
+		if len(dbNames) == 1 {
+			dbNames = nil
+		}
 	}
 
 	v := &valuesNode{
@@ -145,21 +171,27 @@ func (p *planner) ShowGrants(n *parser.ShowGrants) (planNode, error) {
 		wantedUsers[u] = struct{}{}
 	}
 
-	userPrivileges, err := descriptor.GetPrivileges().Show()
-	if err != nil {
-		return nil, err
-	}
-	for _, userPriv := range userPrivileges {
-		if wantedUsers != nil {
-			if _, ok := wantedUsers[userPriv.User]; !ok {
-				continue
+	for _, descriptor := range descriptors {
+		name := descriptor.GetName()
+		if table, ok := descriptor.(*TableDescriptor); ok && dbNames != nil {
+			name = fmt.Sprintf("%s.%s", dbNames[table.ParentID], name)
+		}
+		userPrivileges, err := descriptor.GetPrivileges().Show()
+		if err != nil {
+			return nil, err
+		}
+		for _, userPriv := range userPrivileges {
+			if wantedUsers != nil {
+				if _, ok := wantedUsers[userPriv.User]; !ok {
+					continue
+				}
 			}
+			v.rows = append(v.rows, []parser.Datum{
+				parser.DString(name),
+				parser.DString(userPriv.User),
+				parser.DString(userPriv.Privileges),
+			})
 		}
-		v.rows = append(v.rows, []parser.Datum{
-			parser.DString(descriptor.GetName()),
-			parser.DString(userPriv.User),
-			parser.DString(userPriv.Privileges),
-		})
 	}
 	return v, nil
 }
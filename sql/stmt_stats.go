@@ -0,0 +1,209 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/parser"
+)
+
+// maxStatementFingerprints bounds the memory used by the statement
+// statistics. Once reached, the statements with new fingerprints are not
+// recorded until the statistics are reset.
+const maxStatementFingerprints = 1000
+
+// StatementLatencyBounds are the upper bounds of the buckets of the latency
+// histograms of the statement statistics. The last bucket of a histogram
+// counts the executions slower than the last bound.
+var StatementLatencyBounds = []time.Duration{
+	time.Millisecond,
+	10 * time.Millisecond,
+	100 * time.Millisecond,
+	time.Second,
+	10 * time.Second,
+}
+
+// StatementStatistics are the execution statistics of the statements with
+// the same fingerprint, i.e. the statements which only differ by their
+// constants.
+type StatementStatistics struct {
+	Fingerprint string `json:"fingerprint"`
+	Count       int64  `json:"count"`
+	// Rows is the number of rows returned or affected by the statements.
+	Rows int64 `json:"rows"`
+	// Retries is the number of times the statements were retried
+	// automatically.
+	Retries      int64         `json:"retries"`
+	Errors       int64         `json:"errors"`
+	LastError    string        `json:"lastError,omitempty"`
+	LastSeen     time.Time     `json:"lastSeen"`
+	TotalLatency time.Duration `json:"totalLatency"`
+	MaxLatency   time.Duration `json:"maxLatency"`
+	// Latency is the histogram of the latencies of the statements, whose
+	// buckets are bounded by StatementLatencyBounds.
+	Latency []int64 `json:"latency"`
+}
+
+// stmtStatsRegistry aggregates the statistics of the statements executed
+// by a node.
+type stmtStatsRegistry struct {
+	mu    sync.Mutex
+	stats map[string]*StatementStatistics
+}
+
+func makeStmtStatsRegistry() stmtStatsRegistry {
+	return stmtStatsRegistry{stats: map[string]*StatementStatistics{}}
+}
+
+// record adds an execution of a statement to the statistics of its
+// fingerprint.
+func (r *stmtStatsRegistry) record(
+	fingerprint string, rows, retries int64, latency time.Duration, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s, ok := r.stats[fingerprint]
+	if !ok {
+		if len(r.stats) >= maxStatementFingerprints {
+			return
+		}
+		s = &StatementStatistics{
+			Fingerprint: fingerprint,
+			Latency:     make([]int64, len(StatementLatencyBounds)+1),
+		}
+		r.stats[fingerprint] = s
+	}
+	s.Count++
+	s.Rows += rows
+	s.Retries += retries
+	if err != nil {
+		s.Errors++
+		s.LastError = err.Error()
+	}
+	s.LastSeen = time.Now()
+	s.TotalLatency += latency
+	if latency > s.MaxLatency {
+		s.MaxLatency = latency
+	}
+	s.Latency[sort.Search(len(StatementLatencyBounds), func(i int) bool {
+		return latency < StatementLatencyBounds[i]
+	})]++
+}
+
+// list returns a copy of the statistics, ordered by fingerprint.
+func (r *stmtStatsRegistry) list() []StatementStatistics {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stats := make([]StatementStatistics, 0, len(r.stats))
+	for _, s := range r.stats {
+		c := *s
+		c.Latency = append([]int64(nil), s.Latency...)
+		stats = append(stats, c)
+	}
+	sort.Sort(statementStatisticsByFingerprint(stats))
+	return stats
+}
+
+// reset discards the statistics.
+func (r *stmtStatsRegistry) reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.stats = map[string]*StatementStatistics{}
+}
+
+type statementStatisticsByFingerprint []StatementStatistics
+
+func (s statementStatisticsByFingerprint) Len() int { return len(s) }
+func (s statementStatisticsByFingerprint) Less(i, j int) bool {
+	return s[i].Fingerprint < s[j].Fingerprint
+}
+func (s statementStatisticsByFingerprint) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+
+// formatLatencyHistogram formats a latency histogram as the list of its
+// non-empty buckets.
+func formatLatencyHistogram(latency []int64) string {
+	var buf bytes.Buffer
+	for i, count := range latency {
+		if count == 0 {
+			continue
+		}
+		if buf.Len() > 0 {
+			buf.WriteString(" ")
+		}
+		if i < len(StatementLatencyBounds) {
+			fmt.Fprintf(&buf, "<%s:%d", StatementLatencyBounds[i], count)
+		} else {
+			fmt.Fprintf(&buf, ">=%s:%d", StatementLatencyBounds[i-1], count)
+		}
+	}
+	return buf.String()
+}
+
+// ShowStatementStatistics returns the statistics of the statements executed
+// by the node, aggregated by fingerprint. The statistics cover the
+// statements of all the users, and the last errors contain the constants
+// stripped from the fingerprints.
+// Privileges: root user.
+func (p *planner) ShowStatementStatistics(n *parser.ShowStatementStatistics) (planNode, error) {
+	if p.user != security.RootUser {
+		return nil, fmt.Errorf("only %s is allowed to show the statement statistics", security.RootUser)
+	}
+	v := &valuesNode{
+		columns: []column{
+			{name: "statement", typ: parser.DummyString},
+			{name: "count", typ: parser.DummyInt},
+			{name: "rows", typ: parser.DummyInt},
+			{name: "retries", typ: parser.DummyInt},
+			{name: "errors", typ: parser.DummyInt},
+			{name: "mean latency", typ: parser.DummyInterval},
+			{name: "max latency", typ: parser.DummyInterval},
+			{name: "latency histogram", typ: parser.DummyString},
+			{name: "last seen", typ: parser.DummyTimestamp},
+			{name: "last error", typ: parser.DummyString},
+		},
+	}
+	for _, s := range p.stmtStats.list() {
+		v.rows = append(v.rows, []parser.Datum{
+			parser.DString(s.Fingerprint),
+			parser.DInt(s.Count),
+			parser.DInt(s.Rows),
+			parser.DInt(s.Retries),
+			parser.DInt(s.Errors),
+			parser.DInterval{Duration: s.TotalLatency / time.Duration(s.Count)},
+			parser.DInterval{Duration: s.MaxLatency},
+			parser.DString(formatLatencyHistogram(s.Latency)),
+			parser.DTimestamp{Time: s.LastSeen},
+			parser.DString(s.LastError),
+		})
+	}
+	return v, nil
+}
+
+// ResetStatementStatistics discards the statistics of the statements
+// executed by the node.
+// Privileges: root user.
+func (p *planner) ResetStatementStatistics(n *parser.ResetStatementStatistics) (planNode, error) {
+	if p.user != security.RootUser {
+		return nil, fmt.Errorf("only %s is allowed to reset the statement statistics", security.RootUser)
+	}
+	p.stmtStats.reset()
+	return &valuesNode{}, nil
+}
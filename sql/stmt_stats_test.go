@@ -0,0 +1,116 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql_test
+
+import (
+	csql "database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+type stmtStatsRow struct {
+	count, rows, retries, errors int64
+	meanLatency, maxLatency      time.Duration
+	histogram                    string
+	lastSeen                     time.Time
+	lastError                    string
+}
+
+func showStatementStatistics(t *testing.T, sqlDB *csql.DB) map[string]stmtStatsRow {
+	rows, err := sqlDB.Query(`SHOW STATEMENT STATISTICS`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	stats := map[string]stmtStatsRow{}
+	for rows.Next() {
+		var fingerprint string
+		var r stmtStatsRow
+		if err := rows.Scan(&fingerprint, &r.count, &r.rows, &r.retries, &r.errors,
+			&r.meanLatency, &r.maxLatency, &r.histogram, &r.lastSeen, &r.lastError); err != nil {
+			t.Fatal(err)
+		}
+		stats[fingerprint] = r
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return stats
+}
+
+func TestStatementStatistics(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, _ := setup(t)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k INT PRIMARY KEY, v INT);
+INSERT INTO t.kv VALUES (1, 1), (2, 2);
+INSERT INTO t.kv VALUES (3, 3);
+SELECT * FROM t.kv WHERE k > 0;
+SELECT * FROM t.kv WHERE k > 5;
+`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sqlDB.Exec(`SELECT * FROM t.missing WHERE k = 1`); err == nil {
+		t.Fatal("expected error")
+	}
+
+	stats := showStatementStatistics(t, sqlDB)
+	testCases := []struct {
+		fingerprint   string
+		count         int64
+		rows          int64
+		errors        int64
+		lastErrorLike string
+	}{
+		{`INSERT INTO t.kv VALUES (_, _), (_, _)`, 1, 2, 0, ``},
+		{`INSERT INTO t.kv VALUES (_, _)`, 1, 1, 0, ``},
+		{`SELECT * FROM t.kv WHERE k > _`, 2, 3, 0, ``},
+		{`SELECT * FROM t.missing WHERE k = _`, 1, 0, 1, `does not exist`},
+	}
+	for _, tc := range testCases {
+		r, ok := stats[tc.fingerprint]
+		if !ok {
+			t.Errorf("%s: no statistics in %+v", tc.fingerprint, stats)
+			continue
+		}
+		if r.count != tc.count || r.rows != tc.rows || r.errors != tc.errors {
+			t.Errorf("%s: expected count=%d rows=%d errors=%d, but found %+v",
+				tc.fingerprint, tc.count, tc.rows, tc.errors, r)
+		}
+		if !strings.Contains(r.lastError, tc.lastErrorLike) {
+			t.Errorf("%s: expected error like %q, but found %q", tc.fingerprint, tc.lastErrorLike, r.lastError)
+		}
+		if r.maxLatency < r.meanLatency || r.histogram == "" || r.lastSeen.IsZero() {
+			t.Errorf("%s: unexpected latency statistics %+v", tc.fingerprint, r)
+		}
+	}
+
+	if _, err := sqlDB.Exec(`RESET STATEMENT STATISTICS`); err != nil {
+		t.Fatal(err)
+	}
+	stats = showStatementStatistics(t, sqlDB)
+	for _, tc := range testCases {
+		if r, ok := stats[tc.fingerprint]; ok {
+			t.Errorf("%s: expected no statistics after reset, but found %+v", tc.fingerprint, r)
+		}
+	}
+}
@@ -39,6 +39,13 @@ type ColumnID uint32
 // IndexID is a custom type for IndexDescriptor IDs.
 type IndexID uint32
 
+// MutationID is a custom type for TableDescriptor mutations.
+type MutationID uint32
+
+// invalidMutationID is the uninitialised mutation id. Mutations with this id
+// are never picked up by the schema changer.
+const invalidMutationID MutationID = 0
+
 const (
 	// PrimaryKeyIndexName is the name of the index for the primary key.
 	PrimaryKeyIndexName = "primary"
@@ -144,6 +151,11 @@ func (desc *TableDescriptor) SetName(name string) {
 	desc.Name = name
 }
 
+// Dropped returns true if the table has been dropped.
+func (desc *TableDescriptor) Dropped() bool {
+	return desc.DropTime != 0
+}
+
 // allNonDropColumns returns all the columns, including those being added
 // in the mutations.
 func (desc *TableDescriptor) allNonDropColumns() []ColumnDescriptor {
@@ -546,9 +558,27 @@ func (desc *TableDescriptor) addMutation(m DescriptorMutation) {
 	case DescriptorMutation_DROP:
 		m.State = DescriptorMutation_WRITE_ONLY
 	}
+	if desc.NextMutationID == invalidMutationID {
+		desc.NextMutationID = 1
+	}
+	m.MutationID = desc.NextMutationID
 	desc.Mutations = append(desc.Mutations, m)
 }
 
+// finalizeMutation returns the id that has been used by mutations appended
+// with addMutation() since the last time this function was called.
+// Future mutations will use a new ID. The descriptor is marked so that the
+// schema changer publishes a new version of it.
+func (desc *TableDescriptor) finalizeMutation() (MutationID, error) {
+	mutationID := desc.NextMutationID
+	if mutationID == invalidMutationID {
+		return invalidMutationID, util.Errorf("table %q has no queued mutations", desc.Name)
+	}
+	desc.NextMutationID++
+	desc.UpVersion = true
+	return mutationID, nil
+}
+
 // SQLString returns the SQL string corresponding to the type.
 func (c *ColumnType) SQLString() string {
 	switch c.Kind {
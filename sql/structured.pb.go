@@ -11,6 +11,8 @@ import cockroach_roachpb1 "github.com/cockroachdb/cockroach/roachpb"
 
 // skipping weak import gogoproto "github.com/cockroachdb/gogoproto"
 
+import github_com_cockroachdb_cockroach_roachpb "github.com/cockroachdb/cockroach/roachpb"
+
 import io "io"
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -196,6 +198,9 @@ type ColumnDescriptor struct {
 	// Default expression to use to populate the column on insert if no
 	// value is provided.
 	DefaultExpr *string `protobuf:"bytes,5,opt,name=default_expr" json:"default_expr,omitempty"`
+	// The privileges granted on the column alone, in addition to those
+	// granted on the table. Unset if none were ever granted.
+	Privileges *PrivilegeDescriptor `protobuf:"bytes,6,opt,name=privileges" json:"privileges,omitempty"`
 }
 
 func (m *ColumnDescriptor) Reset()         { *m = ColumnDescriptor{} }
@@ -242,6 +247,17 @@ type DescriptorMutation struct {
 	Descriptor_ isDescriptorMutation_Descriptor_ `protobuf_oneof:"descriptor"`
 	State       DescriptorMutation_State         `protobuf:"varint,3,opt,name=state,enum=cockroach.sql.DescriptorMutation_State" json:"state"`
 	Direction   DescriptorMutation_Direction     `protobuf:"varint,4,opt,name=direction,enum=cockroach.sql.DescriptorMutation_Direction" json:"direction"`
+	// The mutation id used to group mutations that should be applied together.
+	// This is used for situations like creating a unique column, which
+	// involve adding two mutations: one for the column, and another for the
+	// unique constraint index. Mutations with a zero id are ignored by the
+	// schema changer.
+	MutationID MutationID `protobuf:"varint,5,opt,name=mutation_id,casttype=MutationID" json:"mutation_id"`
+	// The remaining span of the table that has yet to be backfilled for this
+	// mutation. Persisted after every backfill chunk so that a schema change
+	// interrupted by a node restart picks up where it left off. An empty span
+	// with a non-empty key means the backfill is done.
+	ResumeSpan cockroach_roachpb1.Span `protobuf:"bytes,6,opt,name=resume_span" json:"resume_span"`
 }
 
 func (m *DescriptorMutation) Reset()         { *m = DescriptorMutation{} }
@@ -364,6 +380,20 @@ type TableDescriptor struct {
 	Privileges  *PrivilegeDescriptor `protobuf:"bytes,12,opt,name=privileges" json:"privileges,omitempty"`
 	// Columns or indexes being added or deleted in a FIFO order.
 	Mutations []DescriptorMutation `protobuf:"bytes,13,rep,name=mutations" json:"mutations"`
+	// next_mutation_id is the id assigned to the mutations queued by the next
+	// schema change statement.
+	NextMutationID MutationID `protobuf:"varint,14,opt,name=next_mutation_id,casttype=MutationID" json:"next_mutation_id"`
+	// up_version is set when a schema change modified the descriptor in a way
+	// that requires the schema changer to publish a new version and wait for
+	// the old one to drain before the change is considered done.
+	UpVersion bool                               `protobuf:"varint,15,opt,name=up_version" json:"up_version"`
+	Lease     *TableDescriptor_SchemaChangeLease `protobuf:"bytes,16,opt,name=lease" json:"lease,omitempty"`
+	// The time the table was dropped, or truncated, in nanoseconds since the
+	// Unix epoch. A dropped table no longer has a name. Its data is kept for
+	// historical reads until the GC TTL of its zone has passed, after which
+	// it is cleared and the descriptor is deleted.
+	DropTime int64                           `protobuf:"varint,17,opt,name=drop_time" json:"drop_time"`
+	Import   *TableDescriptor_ImportProgress `protobuf:"bytes,18,opt,name=import" json:"import,omitempty"`
 }
 
 func (m *TableDescriptor) Reset()         { *m = TableDescriptor{} }
@@ -461,6 +491,73 @@ func (m *TableDescriptor) GetMutations() []DescriptorMutation {
 	return nil
 }
 
+func (m *TableDescriptor) GetNextMutationID() MutationID {
+	if m != nil {
+		return m.NextMutationID
+	}
+	return 0
+}
+
+func (m *TableDescriptor) GetUpVersion() bool {
+	if m != nil {
+		return m.UpVersion
+	}
+	return false
+}
+
+func (m *TableDescriptor) GetLease() *TableDescriptor_SchemaChangeLease {
+	if m != nil {
+		return m.Lease
+	}
+	return nil
+}
+
+func (m *TableDescriptor) GetDropTime() int64 {
+	if m != nil {
+		return m.DropTime
+	}
+	return 0
+}
+
+func (m *TableDescriptor) GetImport() *TableDescriptor_ImportProgress {
+	if m != nil {
+		return m.Import
+	}
+	return nil
+}
+
+// The schema change lease. A node executes the queued mutations of a table
+// only while holding this lease, which guarantees that a single schema
+// changer runs per table at a time. An expired lease can be taken over by
+// any node, which is how a schema change interrupted by a node failure is
+// resumed.
+type TableDescriptor_SchemaChangeLease struct {
+	NodeID uint32 `protobuf:"varint,1,opt,name=node_id" json:"node_id"`
+	// Nanoseconds since the Unix epoch.
+	ExpirationTime int64 `protobuf:"varint,2,opt,name=expiration_time" json:"expiration_time"`
+}
+
+func (m *TableDescriptor_SchemaChangeLease) Reset()         { *m = TableDescriptor_SchemaChangeLease{} }
+func (m *TableDescriptor_SchemaChangeLease) String() string { return proto.CompactTextString(m) }
+func (*TableDescriptor_SchemaChangeLease) ProtoMessage()    {}
+
+// The progress of the IMPORT statement loading the rows of a new table.
+// The table can't be used until the import completes. An interrupted
+// import is resumed by running the same IMPORT statement again.
+type TableDescriptor_ImportProgress struct {
+	// The IMPORT statement, with its values evaluated.
+	Statement string `protobuf:"bytes,1,opt,name=statement" json:"statement"`
+	// The sorted key/values of the table that precede this key have been
+	// ingested.
+	ResumeKey github_com_cockroachdb_cockroach_roachpb.Key `protobuf:"bytes,2,opt,name=resume_key,casttype=github.com/cockroachdb/cockroach/roachpb.Key" json:"resume_key,omitempty"`
+	// The number of key/values ingested.
+	Ingested int64 `protobuf:"varint,3,opt,name=ingested" json:"ingested"`
+}
+
+func (m *TableDescriptor_ImportProgress) Reset()         { *m = TableDescriptor_ImportProgress{} }
+func (m *TableDescriptor_ImportProgress) String() string { return proto.CompactTextString(m) }
+func (*TableDescriptor_ImportProgress) ProtoMessage()    {}
+
 // DatabaseDescriptor represents a namespace (aka database) and is stored
 // in a structured metadata key. The DatabaseDescriptor has a globally-unique
 // ID shared with the TableDescriptor ID.
@@ -604,6 +701,8 @@ func init() {
 	proto.RegisterType((*IndexDescriptor)(nil), "cockroach.sql.IndexDescriptor")
 	proto.RegisterType((*DescriptorMutation)(nil), "cockroach.sql.DescriptorMutation")
 	proto.RegisterType((*TableDescriptor)(nil), "cockroach.sql.TableDescriptor")
+	proto.RegisterType((*TableDescriptor_SchemaChangeLease)(nil), "cockroach.sql.TableDescriptor.SchemaChangeLease")
+	proto.RegisterType((*TableDescriptor_ImportProgress)(nil), "cockroach.sql.TableDescriptor.ImportProgress")
 	proto.RegisterType((*DatabaseDescriptor)(nil), "cockroach.sql.DatabaseDescriptor")
 	proto.RegisterType((*Descriptor)(nil), "cockroach.sql.Descriptor")
 	proto.RegisterEnum("cockroach.sql.ColumnType_Kind", ColumnType_Kind_name, ColumnType_Kind_value)
@@ -681,6 +780,16 @@ func (m *ColumnDescriptor) MarshalTo(data []byte) (int, error) {
 		i = encodeVarintStructured(data, i, uint64(len(*m.DefaultExpr)))
 		i += copy(data[i:], *m.DefaultExpr)
 	}
+	if m.Privileges != nil {
+		data[i] = 0x32
+		i++
+		i = encodeVarintStructured(data, i, uint64(m.Privileges.Size()))
+		n2, err := m.Privileges.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
 	return i, nil
 }
 
@@ -777,11 +886,11 @@ func (m *DescriptorMutation) MarshalTo(data []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Descriptor_ != nil {
-		nn2, err := m.Descriptor_.MarshalTo(data[i:])
+		nn3, err := m.Descriptor_.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn2
+		i += nn3
 	}
 	data[i] = 0x18
 	i++
@@ -789,6 +898,17 @@ func (m *DescriptorMutation) MarshalTo(data []byte) (int, error) {
 	data[i] = 0x20
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.Direction))
+	data[i] = 0x28
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.MutationID))
+	data[i] = 0x32
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.ResumeSpan.Size()))
+	n4, err := m.ResumeSpan.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n4
 	return i, nil
 }
 
@@ -798,11 +918,11 @@ func (m *DescriptorMutation_Column) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintStructured(data, i, uint64(m.Column.Size()))
-		n3, err := m.Column.MarshalTo(data[i:])
+		n5, err := m.Column.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n3
+		i += n5
 	}
 	return i, nil
 }
@@ -812,11 +932,11 @@ func (m *DescriptorMutation_Index) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x12
 		i++
 		i = encodeVarintStructured(data, i, uint64(m.Index.Size()))
-		n4, err := m.Index.MarshalTo(data[i:])
+		n6, err := m.Index.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n4
+		i += n6
 	}
 	return i, nil
 }
@@ -855,11 +975,11 @@ func (m *TableDescriptor) MarshalTo(data []byte) (int, error) {
 	data[i] = 0x32
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.ModificationTime.Size()))
-	n5, err := m.ModificationTime.MarshalTo(data[i:])
+	n7, err := m.ModificationTime.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n5
+	i += n7
 	if len(m.Columns) > 0 {
 		for _, msg := range m.Columns {
 			data[i] = 0x3a
@@ -878,11 +998,11 @@ func (m *TableDescriptor) MarshalTo(data []byte) (int, error) {
 	data[i] = 0x4a
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.PrimaryIndex.Size()))
-	n6, err := m.PrimaryIndex.MarshalTo(data[i:])
+	n8, err := m.PrimaryIndex.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n6
+	i += n8
 	if len(m.Indexes) > 0 {
 		for _, msg := range m.Indexes {
 			data[i] = 0x52
@@ -902,11 +1022,11 @@ func (m *TableDescriptor) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x62
 		i++
 		i = encodeVarintStructured(data, i, uint64(m.Privileges.Size()))
-		n7, err := m.Privileges.MarshalTo(data[i:])
+		n9, err := m.Privileges.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n9
 	}
 	if len(m.Mutations) > 0 {
 		for _, msg := range m.Mutations {
@@ -920,6 +1040,101 @@ func (m *TableDescriptor) MarshalTo(data []byte) (int, error) {
 			i += n
 		}
 	}
+	data[i] = 0x70
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.NextMutationID))
+	data[i] = 0x78
+	i++
+	if m.UpVersion {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
+	if m.Lease != nil {
+		data[i] = 0x82
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintStructured(data, i, uint64(m.Lease.Size()))
+		n10, err := m.Lease.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	data[i] = 0x88
+	i++
+	data[i] = 0x1
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.DropTime))
+	if m.Import != nil {
+		data[i] = 0x92
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintStructured(data, i, uint64(m.Import.Size()))
+		n11, err := m.Import.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	return i, nil
+}
+
+func (m *TableDescriptor_SchemaChangeLease) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *TableDescriptor_SchemaChangeLease) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.NodeID))
+	data[i] = 0x10
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.ExpirationTime))
+	return i, nil
+}
+
+func (m *TableDescriptor_ImportProgress) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *TableDescriptor_ImportProgress) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintStructured(data, i, uint64(len(m.Statement)))
+	i += copy(data[i:], m.Statement)
+	if m.ResumeKey != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintStructured(data, i, uint64(len(m.ResumeKey)))
+		i += copy(data[i:], m.ResumeKey)
+	}
+	data[i] = 0x18
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.Ingested))
 	return i, nil
 }
 
@@ -949,11 +1164,11 @@ func (m *DatabaseDescriptor) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x1a
 		i++
 		i = encodeVarintStructured(data, i, uint64(m.Privileges.Size()))
-		n8, err := m.Privileges.MarshalTo(data[i:])
+		n12, err := m.Privileges.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n12
 	}
 	return i, nil
 }
@@ -974,11 +1189,11 @@ func (m *Descriptor) MarshalTo(data []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Union != nil {
-		nn9, err := m.Union.MarshalTo(data[i:])
+		nn13, err := m.Union.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn9
+		i += nn13
 	}
 	return i, nil
 }
@@ -989,11 +1204,11 @@ func (m *Descriptor_Table) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintStructured(data, i, uint64(m.Table.Size()))
-		n10, err := m.Table.MarshalTo(data[i:])
+		n14, err := m.Table.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n14
 	}
 	return i, nil
 }
@@ -1003,11 +1218,11 @@ func (m *Descriptor_Database) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x12
 		i++
 		i = encodeVarintStructured(data, i, uint64(m.Database.Size()))
-		n11, err := m.Database.MarshalTo(data[i:])
+		n15, err := m.Database.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n11
+		i += n15
 	}
 	return i, nil
 }
@@ -1060,6 +1275,10 @@ func (m *ColumnDescriptor) Size() (n int) {
 		l = len(*m.DefaultExpr)
 		n += 1 + l + sovStructured(uint64(l))
 	}
+	if m.Privileges != nil {
+		l = m.Privileges.Size()
+		n += 1 + l + sovStructured(uint64(l))
+	}
 	return n
 }
 
@@ -1103,6 +1322,9 @@ func (m *DescriptorMutation) Size() (n int) {
 	}
 	n += 1 + sovStructured(uint64(m.State))
 	n += 1 + sovStructured(uint64(m.Direction))
+	n += 1 + sovStructured(uint64(m.MutationID))
+	l = m.ResumeSpan.Size()
+	n += 1 + l + sovStructured(uint64(l))
 	return n
 }
 
@@ -1162,6 +1384,38 @@ func (m *TableDescriptor) Size() (n int) {
 			n += 1 + l + sovStructured(uint64(l))
 		}
 	}
+	n += 1 + sovStructured(uint64(m.NextMutationID))
+	n += 2
+	if m.Lease != nil {
+		l = m.Lease.Size()
+		n += 2 + l + sovStructured(uint64(l))
+	}
+	n += 2 + sovStructured(uint64(m.DropTime))
+	if m.Import != nil {
+		l = m.Import.Size()
+		n += 2 + l + sovStructured(uint64(l))
+	}
+	return n
+}
+
+func (m *TableDescriptor_SchemaChangeLease) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovStructured(uint64(m.NodeID))
+	n += 1 + sovStructured(uint64(m.ExpirationTime))
+	return n
+}
+
+func (m *TableDescriptor_ImportProgress) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Statement)
+	n += 1 + l + sovStructured(uint64(l))
+	if m.ResumeKey != nil {
+		l = len(m.ResumeKey)
+		n += 1 + l + sovStructured(uint64(l))
+	}
+	n += 1 + sovStructured(uint64(m.Ingested))
 	return n
 }
 
@@ -1483,6 +1737,39 @@ func (m *ColumnDescriptor) Unmarshal(data []byte) error {
 			s := string(data[iNdEx:postIndex])
 			m.DefaultExpr = &s
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Privileges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Privileges == nil {
+				m.Privileges = &PrivilegeDescriptor{}
+			}
+			if err := m.Privileges.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStructured(data[iNdEx:])
@@ -1851,6 +2138,55 @@ func (m *DescriptorMutation) Unmarshal(data []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MutationID", wireType)
+			}
+			m.MutationID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.MutationID |= (MutationID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeSpan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResumeSpan.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStructured(data[iNdEx:])
@@ -2240,6 +2576,344 @@ func (m *TableDescriptor) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextMutationID", wireType)
+			}
+			m.NextMutationID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.NextMutationID |= (MutationID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpVersion", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UpVersion = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lease", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Lease == nil {
+				m.Lease = &TableDescriptor_SchemaChangeLease{}
+			}
+			if err := m.Lease.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropTime", wireType)
+			}
+			m.DropTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.DropTime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Import", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Import == nil {
+				m.Import = &TableDescriptor_ImportProgress{}
+			}
+			if err := m.Import.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructured(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStructured
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TableDescriptor_SchemaChangeLease) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructured
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchemaChangeLease: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchemaChangeLease: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			m.NodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.NodeID |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			m.ExpirationTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ExpirationTime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructured(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStructured
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TableDescriptor_ImportProgress) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructured
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImportProgress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImportProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statement", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Statement = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResumeKey = append([]byte{}, data[iNdEx:postIndex]...)
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ingested", wireType)
+			}
+			m.Ingested = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Ingested |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStructured(data[iNdEx:])
@@ -63,6 +63,21 @@ CREATE TABLE system.zones (
   id     INT PRIMARY KEY,
   config BYTES
 );`
+
+	// Group membership. A member is either a user or a group.
+	groupMembersTableSchema = `
+CREATE TABLE system.group_members (
+  member     STRING,
+  group_name STRING,
+  PRIMARY KEY (member, group_name)
+);`
+
+	// Protected timestamp records, consulted by the GC queue.
+	protectedTimestampsTableSchema = `
+CREATE TABLE system.protected_timestamps (
+  id     INT PRIMARY KEY,
+  record BYTES
+);`
 )
 
 var (
@@ -90,17 +105,27 @@ var (
 	// ZonesTable is the descriptor for the zones table.
 	ZonesTable = createSystemTable(keys.ZonesTableID, zonesTableSchema)
 
+	// GroupMembersTable is the descriptor for the group members table.
+	GroupMembersTable = createSystemTable(keys.GroupMembersTableID, groupMembersTableSchema)
+
+	// ProtectedTimestampsTable is the descriptor for the protected timestamps
+	// table.
+	ProtectedTimestampsTable = createSystemTable(keys.ProtectedTimestampsTableID,
+		protectedTimestampsTableSchema)
+
 	// SystemAllowedPrivileges describes the privileges allowed for each
 	// system object. No user may have more than those privileges, and
 	// the root user must have exactly those privileges.
 	// CREATE|DROP|ALL should always be denied.
 	SystemAllowedPrivileges = map[ID]privilege.List{
-		keys.SystemDatabaseID:  privilege.ReadData,
-		keys.NamespaceTableID:  privilege.ReadData,
-		keys.DescriptorTableID: privilege.ReadData,
-		keys.LeaseTableID:      privilege.ReadWriteData,
-		keys.UsersTableID:      privilege.ReadWriteData,
-		keys.ZonesTableID:      privilege.ReadWriteData,
+		keys.SystemDatabaseID:           privilege.ReadData,
+		keys.NamespaceTableID:           privilege.ReadData,
+		keys.DescriptorTableID:          privilege.ReadData,
+		keys.LeaseTableID:               privilege.ReadWriteData,
+		keys.UsersTableID:               privilege.ReadWriteData,
+		keys.ZonesTableID:               privilege.ReadWriteData,
+		keys.GroupMembersTableID:        privilege.ReadWriteData,
+		keys.ProtectedTimestampsTableID: privilege.ReadWriteData,
 	}
 
 	// NumUsedSystemIDs is only used in tests that need to know the
@@ -147,6 +172,8 @@ func GetInitialSystemValues() []roachpb.KeyValue {
 		{SystemDB.ID, &LeaseTable},
 		{SystemDB.ID, &UsersTable},
 		{SystemDB.ID, &ZonesTable},
+		{SystemDB.ID, &GroupMembersTable},
+		{SystemDB.ID, &ProtectedTimestampsTable},
 	}
 
 	// Initial kv pairs:
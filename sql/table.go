@@ -108,56 +108,38 @@ func makeColumnDefDescs(d *parser.ColumnTableDef) (*ColumnDescriptor, *IndexDesc
 		Nullable: (d.Nullable != parser.NotNull),
 	}
 
-	var colDatumType parser.Datum
 	switch t := d.Type.(type) {
 	case *parser.BoolType:
 		col.Type.Kind = ColumnType_BOOL
-		colDatumType = parser.DummyBool
 	case *parser.IntType:
 		col.Type.Kind = ColumnType_INT
 		col.Type.Width = int32(t.N)
-		colDatumType = parser.DummyInt
 	case *parser.FloatType:
 		col.Type.Kind = ColumnType_FLOAT
 		col.Type.Precision = int32(t.Prec)
-		colDatumType = parser.DummyFloat
 	case *parser.DecimalType:
 		col.Type.Kind = ColumnType_DECIMAL
 		col.Type.Width = int32(t.Scale)
 		col.Type.Precision = int32(t.Prec)
 	case *parser.DateType:
 		col.Type.Kind = ColumnType_DATE
-		colDatumType = parser.DummyDate
 	case *parser.TimestampType:
 		col.Type.Kind = ColumnType_TIMESTAMP
-		colDatumType = parser.DummyTimestamp
 	case *parser.IntervalType:
 		col.Type.Kind = ColumnType_INTERVAL
-		colDatumType = parser.DummyInterval
 	case *parser.StringType:
 		col.Type.Kind = ColumnType_STRING
 		col.Type.Width = int32(t.N)
-		colDatumType = parser.DummyString
 	case *parser.BytesType:
 		col.Type.Kind = ColumnType_BYTES
-		colDatumType = parser.DummyBytes
 	default:
 		return nil, nil, util.Errorf("unexpected type %T", t)
 	}
 
 	if d.DefaultExpr != nil {
-		// Verify the default expression type is compatible with the column type.
-		defaultType, err := d.DefaultExpr.TypeCheck()
-		if err != nil {
+		if err := col.setDefaultExpr(d.DefaultExpr); err != nil {
 			return nil, nil, err
 		}
-		if colDatumType != defaultType {
-			return nil, nil, fmt.Errorf("incompatible column type and default expression: %s vs %s",
-				col.Type.Kind, defaultType.Type())
-		}
-
-		s := d.DefaultExpr.String()
-		col.DefaultExpr = &s
 	}
 
 	var idx *IndexDescriptor
@@ -171,10 +153,57 @@ func makeColumnDefDescs(d *parser.ColumnTableDef) (*ColumnDescriptor, *IndexDesc
 	return col, idx, nil
 }
 
+// setDefaultExpr sets the default expression of the column after verifying
+// that its type is compatible with the column type. A nil expression removes
+// the default.
+func (col *ColumnDescriptor) setDefaultExpr(expr parser.Expr) error {
+	if expr == nil {
+		col.DefaultExpr = nil
+		return nil
+	}
+
+	var colDatumType parser.Datum
+	switch col.Type.Kind {
+	case ColumnType_BOOL:
+		colDatumType = parser.DummyBool
+	case ColumnType_INT:
+		colDatumType = parser.DummyInt
+	case ColumnType_FLOAT:
+		colDatumType = parser.DummyFloat
+	case ColumnType_DATE:
+		colDatumType = parser.DummyDate
+	case ColumnType_TIMESTAMP:
+		colDatumType = parser.DummyTimestamp
+	case ColumnType_INTERVAL:
+		colDatumType = parser.DummyInterval
+	case ColumnType_STRING:
+		colDatumType = parser.DummyString
+	case ColumnType_BYTES:
+		colDatumType = parser.DummyBytes
+	}
+
+	// Verify the default expression type is compatible with the column type.
+	defaultType, err := expr.TypeCheck()
+	if err != nil {
+		return err
+	}
+	if colDatumType != defaultType {
+		return fmt.Errorf("incompatible column type and default expression: %s vs %s",
+			col.Type.Kind, defaultType.Type())
+	}
+
+	s := expr.String()
+	col.DefaultExpr = &s
+	return nil
+}
+
 func (p *planner) getTableDesc(qname *parser.QualifiedName) (*TableDescriptor, error) {
 	if err := qname.NormalizeTableName(p.session.Database); err != nil {
 		return nil, err
 	}
+	if desc, err := getVirtualTableDesc(qname); desc != nil || err != nil {
+		return desc, err
+	}
 	dbDesc, err := p.getDatabaseDesc(qname.Database())
 	if err != nil {
 		return nil, err
@@ -194,6 +223,26 @@ func (p *planner) getTableLease(qname *parser.QualifiedName) (*TableDescriptor,
 		return nil, err
 	}
 
+	// Virtual tables are not stored: their descriptors never change and their
+	// rows are always generated using the planner's transaction.
+	if desc, err := getVirtualTableDesc(qname); desc != nil || err != nil {
+		return desc, err
+	}
+
+	if p.asOfSystemTime != roachpb.ZeroTimestamp {
+		// A historical read uses the descriptor as of the timestamp it reads at,
+		// which it reads using its transaction. Leases only cover the latest
+		// version of a descriptor. The GC threshold is checked first, using the
+		// current name of the table, so that reading too far in the past fails
+		// clearly even if the table didn't exist at the timestamp.
+		if tableID, err := p.getTableID(qname); err == nil {
+			if err := p.checkGCThreshold(tableID, qname.Table()); err != nil {
+				return nil, err
+			}
+		}
+		return p.getTableDesc(qname)
+	}
+
 	if qname.Database() == SystemDB.Name || testDisableTableLeases {
 		// We don't go through the normal lease mechanism for system tables. The
 		// system.lease and system.descriptor table, in particular, are problematic
@@ -206,7 +255,33 @@ func (p *planner) getTableLease(qname *parser.QualifiedName) (*TableDescriptor,
 	if err != nil {
 		return nil, err
 	}
+	if _, ok := p.droppedTables[tableID]; ok {
+		// The table was dropped, or truncated, by the current transaction,
+		// which the cached name of the table doesn't reflect.
+		if tableID, err = p.getUncachedTableID(qname); err != nil {
+			return nil, err
+		}
+	}
+
+	lease, err := p.acquireLease(tableID)
+	if err == errTableDropped {
+		// The cached name of the table is stale: the table was dropped, or
+		// truncated, which gives the table a new ID.
+		if tableID, err = p.getUncachedTableID(qname); err != nil {
+			return nil, err
+		}
+		lease, err = p.acquireLease(tableID)
+	}
+	if err != nil {
+		return nil, err
+	}
 
+	return proto.Clone(&lease.TableDescriptor).(*TableDescriptor), nil
+}
+
+// acquireLease returns the lease of the planner on the specified table,
+// acquiring it if needed.
+func (p *planner) acquireLease(tableID ID) (*LeaseState, error) {
 	if p.leases == nil {
 		p.leases = make(map[ID]*LeaseState)
 	}
@@ -220,8 +295,7 @@ func (p *planner) getTableLease(qname *parser.QualifiedName) (*TableDescriptor,
 		}
 		p.leases[tableID] = lease
 	}
-
-	return proto.Clone(&lease.TableDescriptor).(*TableDescriptor), nil
+	return lease, nil
 }
 
 // getTableID retrieves the table ID for the specified table. It uses the
@@ -252,8 +326,24 @@ func (p *planner) getTableID(qname *parser.QualifiedName) (ID, error) {
 		id, err := nameVal.GetInt()
 		return ID(id), err
 	}
+	return p.lookupTableID(nameKey)
+}
 
-	gr, err := p.txn.Get(key)
+// getUncachedTableID retrieves the table ID for the specified table from
+// the KV store.
+func (p *planner) getUncachedTableID(qname *parser.QualifiedName) (ID, error) {
+	if err := qname.NormalizeTableName(p.session.Database); err != nil {
+		return 0, err
+	}
+	dbDesc, err := p.getDatabaseDesc(qname.Database())
+	if err != nil {
+		return 0, err
+	}
+	return p.lookupTableID(tableKey{dbDesc.ID, qname.Table()})
+}
+
+func (p *planner) lookupTableID(nameKey tableKey) (ID, error) {
+	gr, err := p.txn.Get(nameKey.Key())
 	if err != nil {
 		return 0, err
 	}
@@ -264,18 +354,26 @@ func (p *planner) getTableID(qname *parser.QualifiedName) (ID, error) {
 }
 
 func (p *planner) getTableNames(dbDesc *DatabaseDescriptor) (parser.QualifiedNames, error) {
-	prefix := MakeNameMetadataKey(dbDesc.ID, "")
-	sr, err := p.txn.Scan(prefix, prefix.PrefixEnd(), 0)
-	if err != nil {
-		return nil, err
-	}
-
-	var qualifiedNames parser.QualifiedNames
-	for _, row := range sr {
-		_, tableName, err := encoding.DecodeString(bytes.TrimPrefix(row.Key, prefix), nil)
+	var tableNames []string
+	if isVirtualDescriptor(dbDesc) {
+		tableNames = getVirtualSchema(dbDesc.Name).tableNames
+	} else {
+		prefix := MakeNameMetadataKey(dbDesc.ID, "")
+		sr, err := p.txn.Scan(prefix, prefix.PrefixEnd(), 0)
 		if err != nil {
 			return nil, err
 		}
+		for _, row := range sr {
+			_, tableName, err := encoding.DecodeString(bytes.TrimPrefix(row.Key, prefix), nil)
+			if err != nil {
+				return nil, err
+			}
+			tableNames = append(tableNames, tableName)
+		}
+	}
+
+	var qualifiedNames parser.QualifiedNames
+	for _, tableName := range tableNames {
 		qname := &parser.QualifiedName{
 			Base:     parser.Name(dbDesc.Name),
 			Indirect: parser.Indirection{parser.NameIndirection(tableName)},
@@ -0,0 +1,81 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/storage"
+)
+
+// ShowTableStatistics returns the stats of the data of each of the indexes
+// of a table, rolled up from the index stats published by the stores of
+// the cluster. The stats of each store are only as recent as the last
+// status it published.
+// Privileges: None.
+func (p *planner) ShowTableStatistics(n *parser.ShowTableStatistics) (planNode, error) {
+	desc, err := p.getTableDesc(n.Table)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := keys.StatusStorePrefix
+	sr, err := p.txn.Scan(prefix, prefix.PrefixEnd(), 0)
+	if err != nil {
+		return nil, err
+	}
+	var indexStats []storage.IndexStats
+	for _, kv := range sr {
+		storeStatus := &storage.StoreStatus{}
+		if err := kv.ValueProto(storeStatus); err != nil {
+			return nil, err
+		}
+		for _, is := range storeStatus.IndexStats {
+			if ID(is.TableID) == desc.ID {
+				indexStats = append(indexStats, is)
+			}
+		}
+	}
+	byIndex := map[IndexID]storage.IndexStats{}
+	for _, is := range storage.AggregateIndexStats(indexStats) {
+		byIndex[IndexID(is.IndexID)] = is
+	}
+
+	v := &valuesNode{
+		columns: []column{
+			{name: "index", typ: parser.DummyString},
+			{name: "livebytes", typ: parser.DummyInt},
+			{name: "livecount", typ: parser.DummyInt},
+			{name: "keybytes", typ: parser.DummyInt},
+			{name: "valbytes", typ: parser.DummyInt},
+			{name: "intentbytes", typ: parser.DummyInt},
+			{name: "gcbytes", typ: parser.DummyInt},
+		},
+	}
+	for _, index := range append([]IndexDescriptor{desc.PrimaryIndex}, desc.Indexes...) {
+		ms := byIndex[index.ID].Stats
+		v.rows = append(v.rows, []parser.Datum{
+			parser.DString(index.Name),
+			parser.DInt(ms.LiveBytes),
+			parser.DInt(ms.LiveCount),
+			parser.DInt(ms.KeyBytes),
+			parser.DInt(ms.ValBytes),
+			parser.DInt(ms.IntentBytes),
+			parser.DInt(ms.KeyBytes + ms.ValBytes - ms.LiveBytes),
+		})
+	}
+	return v, nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/storage"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func TestShowTableStatistics(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, kvDB := setup(t)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE d;
+CREATE TABLE d.t (a INT PRIMARY KEY, b INT, INDEX b_idx (b));
+`); err != nil {
+		t.Fatal(err)
+	}
+	var tableID uint32
+	if err := sqlDB.QueryRow(`SELECT id FROM system.namespace WHERE name = 't'`).Scan(&tableID); err != nil {
+		t.Fatal(err)
+	}
+
+	// The table is empty, so the stores of the test server don't publish
+	// any stats for it. Publish the statuses of stores whose ranges split
+	// the primary index of the table.
+	statuses := []storage.StoreStatus{
+		{IndexStats: []storage.IndexStats{
+			{TableID: tableID, IndexID: 1, Stats: engine.MVCCStats{LiveBytes: 10, LiveCount: 1, KeyBytes: 8, ValBytes: 6}},
+			{TableID: tableID + 1, IndexID: 1, Stats: engine.MVCCStats{LiveBytes: 100, LiveCount: 10}},
+		}},
+		{IndexStats: []storage.IndexStats{
+			{TableID: tableID, IndexID: 1, Stats: engine.MVCCStats{LiveBytes: 20, LiveCount: 2, KeyBytes: 12, ValBytes: 10, IntentBytes: 5}},
+			{TableID: tableID, IndexID: 2, Stats: engine.MVCCStats{LiveBytes: 5, LiveCount: 1, KeyBytes: 5}},
+		}},
+	}
+	for i := range statuses {
+		if err := kvDB.Put(keys.StoreStatusKey(int32(100+i)), &statuses[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rows, err := sqlDB.Query(`SHOW TABLE STATISTICS FROM d.t`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	cols, err := rows.Columns()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedCols := []string{"index", "livebytes", "livecount", "keybytes", "valbytes", "intentbytes", "gcbytes"}
+	if !reflect.DeepEqual(cols, expectedCols) {
+		t.Errorf("expected columns %v, but found %v", expectedCols, cols)
+	}
+	type indexStatsRow struct {
+		index                                    string
+		liveBytes, liveCount, keyBytes, valBytes int64
+		intentBytes, gcBytes                     int64
+	}
+	var results []indexStatsRow
+	for rows.Next() {
+		var r indexStatsRow
+		if err := rows.Scan(&r.index, &r.liveBytes, &r.liveCount, &r.keyBytes, &r.valBytes,
+			&r.intentBytes, &r.gcBytes); err != nil {
+			t.Fatal(err)
+		}
+		results = append(results, r)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	expected := []indexStatsRow{
+		{"primary", 30, 3, 20, 16, 5, 6},
+		{"b_idx", 5, 1, 5, 0, 0, 0},
+	}
+	if !reflect.DeepEqual(results, expected) {
+		t.Errorf("expected %+v, but found %+v", expected, results)
+	}
+}
@@ -24,14 +24,17 @@ import (
 	"github.com/cockroachdb/cockroach/sql/parser"
 	"github.com/cockroachdb/cockroach/sql/privilege"
 	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/gogo/protobuf/proto"
 )
 
-// Truncate deletes all rows from a table.
+// Truncate deletes all rows from a table. The table is replaced by an empty
+// copy with a new ID, and the original table is dropped: its data is
+// cleared asynchronously, once the GC TTL of its zone has passed. The rows
+// of a table with schema changes in progress are deleted in place.
 // Privileges: DROP on table.
 //   Notes: postgres requires TRUNCATE.
 //          mysql requires DROP (for mysql >= 5.1.16, DELETE before that).
 func (p *planner) Truncate(n *parser.Truncate) (planNode, error) {
-	b := client.Batch{}
 	for _, tableQualifiedName := range n.Tables {
 		tableDesc, err := p.getTableLease(tableQualifiedName)
 		if err != nil {
@@ -42,20 +45,71 @@ func (p *planner) Truncate(n *parser.Truncate) (planNode, error) {
 			return nil, err
 		}
 
-		tablePrefix := keys.MakeTablePrefix(uint32(tableDesc.ID))
-
-		// Delete rows and indexes starting with the table's prefix.
-		tableStartKey := roachpb.Key(tablePrefix)
-		tableEndKey := tableStartKey.PrefixEnd()
-		if log.V(2) {
-			log.Infof("DelRange %s - %s", prettyKey(tableStartKey, 0), prettyKey(tableEndKey, 0))
+		if err := p.truncateTable(tableDesc.ID); err != nil {
+			return nil, err
 		}
-		b.DelRange(tableStartKey, tableEndKey)
 	}
 
+	return &valuesNode{}, nil
+}
+
+// truncateTable gives the name of a table to an empty copy of the table
+// with a new ID, and drops the original table.
+func (p *planner) truncateTable(id ID) error {
+	tableDesc, err := getTableDescFromID(p.txn, id)
+	if err != nil {
+		return err
+	}
+	if len(tableDesc.Mutations) > 0 {
+		// The schema changes in progress refer to the table by its ID, so
+		// the table keeps it.
+		return p.deleteTableRows(tableDesc)
+	}
+
+	newDesc := proto.Clone(tableDesc).(*TableDescriptor)
+	if ir, err := p.txn.Inc(keys.DescIDGenerator, 1); err == nil {
+		newDesc.ID = ID(ir.ValueInt() - 1)
+	} else {
+		return err
+	}
+	newDesc.Version = 1
+	newDesc.ModificationTime = roachpb.ZeroTimestamp
+	newDesc.UpVersion = false
+	newDesc.Lease = nil
+	if err := newDesc.Validate(); err != nil {
+		return err
+	}
+	if log.V(2) {
+		log.Infof("truncate table %q: ID %d -> %d", tableDesc.Name, tableDesc.ID, newDesc.ID)
+	}
+
+	b := client.Batch{}
+	b.Put(tableKey{tableDesc.ParentID, tableDesc.Name}.Key(), newDesc.ID)
+	b.CPut(MakeDescMetadataKey(newDesc.ID), wrapDescriptor(newDesc), nil)
 	if err := p.txn.Run(&b); err != nil {
-		return nil, err
+		return err
+	}
+	// The new table inherits the zone config of the original one, which is
+	// kept until the data of the original table is cleared.
+	if zone, err := getZoneConfig(p.txn, tableDesc.ID); err != nil {
+		return err
+	} else if zone != nil {
+		if err := p.writeZoneConfig(newDesc.ID, zone); err != nil {
+			return err
+		}
 	}
 
-	return &valuesNode{}, nil
+	return p.dropTable(tableDesc, nil)
+}
+
+// deleteTableRows deletes the rows and index entries of a table.
+func (p *planner) deleteTableRows(tableDesc *TableDescriptor) error {
+	tableStartKey := roachpb.Key(keys.MakeTablePrefix(uint32(tableDesc.ID)))
+	tableEndKey := tableStartKey.PrefixEnd()
+	if log.V(2) {
+		log.Infof("DelRange %s - %s", prettyKey(tableStartKey, 0), prettyKey(tableEndKey, 0))
+	}
+	b := client.Batch{}
+	b.DelRange(tableStartKey, tableEndKey)
+	return p.txn.Run(&b)
 }
@@ -29,7 +29,8 @@ import (
 )
 
 // Update updates columns for a selection of rows from a table.
-// Privileges: UPDATE and SELECT on table. We currently always use a select statement.
+// Privileges: UPDATE on table, or on the columns updated, and SELECT on
+//   table. We currently always use a select statement.
 //   Notes: postgres requires UPDATE. Requires SELECT with WHERE clause with table.
 //          mysql requires UPDATE. Also requires SELECT with WHERE clause with table.
 func (p *planner) Update(n *parser.Update) (planNode, error) {
@@ -38,10 +39,6 @@ func (p *planner) Update(n *parser.Update) (planNode, error) {
 		return nil, err
 	}
 
-	if err := p.checkPrivilege(tableDesc, privilege.UPDATE); err != nil {
-		return nil, err
-	}
-
 	// Determine which columns we're inserting into.
 	var names parser.QualifiedNames
 	for _, expr := range n.Exprs {
@@ -76,6 +73,10 @@ func (p *planner) Update(n *parser.Update) (planNode, error) {
 		return nil, err
 	}
 
+	if err := p.checkColumnPrivileges(tableDesc, cols, privilege.UPDATE); err != nil {
+		return nil, err
+	}
+
 	// Set of columns being updated
 	colIDSet := map[ColumnID]struct{}{}
 	for _, c := range cols {
@@ -283,6 +284,7 @@ func (p *planner) Update(n *parser.Update) (planNode, error) {
 	if err := p.txn.Run(&b); err != nil {
 		return nil, convertBatchError(tableDesc, b, err)
 	}
+	p.kvStats.addKV(writeStats(&b))
 
 	return result, nil
 }
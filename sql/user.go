@@ -0,0 +1,229 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/parser"
+)
+
+var errEmptyUserName = errors.New("empty user name")
+
+// Users are listed in system.users along with the bcrypt hash of their
+// password, which is NULL for the users authenticating with a certificate
+// only. The root user always exists, and isn't listed.
+
+// CreateUser creates a user.
+// Privileges: INSERT on system.users.
+//   Notes: postgres requires the CREATEROLE attribute.
+//          mysql requires the CREATE USER privilege.
+func (p *planner) CreateUser(n *parser.CreateUser) (planNode, error) {
+	name := string(n.Name)
+	if name == "" {
+		return nil, errEmptyUserName
+	}
+	exists, err := p.userExists(name)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		if n.IfNotExists {
+			// Noop.
+			return &valuesNode{}, nil
+		}
+		return nil, fmt.Errorf("user %q already exists", name)
+	}
+	hashed, err := p.hashUserPassword(n.Password)
+	if err != nil {
+		return nil, err
+	}
+	const insertUser = `INSERT INTO system.users VALUES (%s, %s)`
+	if _, err := p.exec(fmt.Sprintf(insertUser, parser.DString(name), hashed)); err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
+
+// AlterUser changes the password of a user.
+// Privileges: UPDATE on system.users, unless users change their own password.
+//   Notes: postgres requires the CREATEROLE attribute, unless users change
+//          their own password.
+//          mysql requires the CREATE USER privilege, unless users change
+//          their own password.
+func (p *planner) AlterUser(n *parser.AlterUser) (planNode, error) {
+	name := string(n.Name)
+	if name == "" {
+		return nil, errEmptyUserName
+	}
+	if name == security.RootUser {
+		return nil, fmt.Errorf("user %s authenticates with a certificate only", security.RootUser)
+	}
+	exists, err := p.userExists(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("user %q does not exist", name)
+	}
+	hashed, err := p.hashUserPassword(n.Password)
+	if err != nil {
+		return nil, err
+	}
+	updater := p
+	if name == p.user {
+		updater = &planner{txn: p.txn, user: security.RootUser}
+	}
+	const updateUser = `UPDATE system.users SET hashedPassword = %s WHERE username = %s`
+	if _, err := updater.exec(fmt.Sprintf(updateUser, hashed, parser.DString(name))); err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
+
+// DropUser drops users, along with their group memberships. Users which
+// hold privileges on databases, tables or columns can't be dropped: their
+// privileges must be revoked first.
+// Privileges: DELETE on system.users and system.group_members.
+//   Notes: postgres requires the CREATEROLE attribute, and also refuses to
+//          drop users owning objects or holding privileges.
+//          mysql requires the CREATE USER privilege, and drops the privileges.
+func (p *planner) DropUser(n *parser.DropUser) (planNode, error) {
+	dbs, tables, err := p.getAllDescriptors()
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range n.Names {
+		if name == "" {
+			return nil, errEmptyUserName
+		}
+		if name == security.RootUser {
+			return nil, fmt.Errorf("user %s cannot be dropped", security.RootUser)
+		}
+		exists, err := p.userExists(name)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			if n.IfExists {
+				continue
+			}
+			return nil, fmt.Errorf("user %q does not exist", name)
+		}
+		if err := checkUserHasNoPrivileges(name, dbs, tables); err != nil {
+			return nil, err
+		}
+
+		const deleteUser = `DELETE FROM system.users WHERE username = %s`
+		if _, err := p.exec(fmt.Sprintf(deleteUser, parser.DString(name))); err != nil {
+			return nil, err
+		}
+		const deleteMembership = `DELETE FROM system.group_members WHERE member = %s`
+		if _, err := p.exec(fmt.Sprintf(deleteMembership, parser.DString(name))); err != nil {
+			return nil, err
+		}
+	}
+	return &valuesNode{}, nil
+}
+
+// checkUserHasNoPrivileges returns an error if the user holds privileges on
+// any of the databases, tables or columns.
+func checkUserHasNoPrivileges(
+	name string, dbs []*DatabaseDescriptor, tables map[ID][]*TableDescriptor,
+) error {
+	errHasPrivileges := func(typeName, objName string) error {
+		return fmt.Errorf("cannot drop user %q: it has privileges on %s %q", name, typeName, objName)
+	}
+	for _, db := range dbs {
+		if _, ok := db.Privileges.findUser(name); ok {
+			return errHasPrivileges(db.TypeName(), db.Name)
+		}
+		for _, table := range tables[db.ID] {
+			if _, ok := table.Privileges.findUser(name); ok {
+				return errHasPrivileges(table.TypeName(), table.Name)
+			}
+			for _, col := range table.Columns {
+				if col.Privileges == nil {
+					continue
+				}
+				if _, ok := col.Privileges.findUser(name); ok {
+					return errHasPrivileges("column", fmt.Sprintf("%s.%s", table.Name, col.Name))
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// ShowUsers returns all the users, except root.
+// Privileges: SELECT on system.users.
+//   Notes: postgres does not have a SHOW USERS statement.
+//          mysql does not have a SHOW USERS statement.
+func (p *planner) ShowUsers(n *parser.ShowUsers) (planNode, error) {
+	plan, err := p.query(`SELECT username FROM system.users`)
+	if err != nil {
+		return nil, err
+	}
+	v := &valuesNode{columns: []column{{name: "User", typ: parser.DummyString}}}
+	for plan.Next() {
+		v.rows = append(v.rows, parser.DTuple{plan.Values()[0]})
+	}
+	if err := plan.Err(); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// userExists returns true if the user exists. Users may check that a user
+// exists without being allowed to read system.users.
+func (p *planner) userExists(name string) (bool, error) {
+	if name == security.RootUser {
+		return true, nil
+	}
+	internal := planner{txn: p.txn, user: security.RootUser}
+	const getUser = `SELECT username FROM system.users WHERE username = %s`
+	values, err := internal.queryRow(fmt.Sprintf(getUser, parser.DString(name)))
+	if err != nil {
+		return false, err
+	}
+	return values != nil, nil
+}
+
+// hashUserPassword evaluates the password of a CREATE or ALTER USER
+// statement and returns its bcrypt hash, or NULL if there is no password.
+func (p *planner) hashUserPassword(expr parser.Expr) (parser.Datum, error) {
+	if expr == nil {
+		return parser.DNull, nil
+	}
+	d, err := expr.Eval(p.evalCtx)
+	if err != nil {
+		return nil, err
+	}
+	if d == parser.DNull {
+		return parser.DNull, nil
+	}
+	password, ok := d.(parser.DString)
+	if !ok {
+		return nil, fmt.Errorf("password must be a string: %s is a %s", expr, d.Type())
+	}
+	hashed, err := security.HashPassword([]byte(password))
+	if err != nil {
+		return nil, err
+	}
+	return parser.DBytes(hashed), nil
+}
@@ -0,0 +1,187 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/sql/privilege"
+)
+
+var errEmptyGroupName = errors.New("empty group name")
+
+// Groups are sets of users and groups. A group has no existence of its own:
+// it exists as long as it has members, which are listed in
+// system.group_members. Privileges granted to a group are inherited by its
+// members, including the members of its member groups.
+
+// AlterGroup adds members to a group, or drops members from it.
+// Privileges: INSERT (ADD) or DELETE (DROP) on system.group_members.
+//   Notes: postgres requires the CREATEROLE attribute.
+//          mysql has no groups.
+func (p *planner) AlterGroup(n *parser.AlterGroup) (planNode, error) {
+	group := string(n.Name)
+	if group == "" {
+		return nil, errEmptyGroupName
+	}
+	for _, member := range n.Members {
+		if n.Add {
+			if err := p.addGroupMember(group, member); err != nil {
+				return nil, err
+			}
+		} else {
+			const deleteMember = `DELETE FROM system.group_members WHERE member = %s AND group_name = %s`
+			if _, err := p.exec(fmt.Sprintf(deleteMember,
+				parser.DString(member), parser.DString(group))); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return &valuesNode{}, nil
+}
+
+func (p *planner) addGroupMember(group, member string) error {
+	if member == "" {
+		return errEmptyUserName
+	}
+	if member == group {
+		return fmt.Errorf("group %q cannot be a member of itself", group)
+	}
+	// root has all privileges regardless of its groups, and members of a
+	// group named root would only be granted its explicit privileges.
+	if group == security.RootUser {
+		return fmt.Errorf("%s cannot be used as a group", security.RootUser)
+	}
+	if member == security.RootUser {
+		return fmt.Errorf("user %s cannot be a member of a group", security.RootUser)
+	}
+	// Refuse cycles: the group must not already be a member of the new member.
+	groups, err := p.getGroups(group)
+	if err != nil {
+		return err
+	}
+	for _, g := range groups {
+		if g == member {
+			return fmt.Errorf("group %q is a member of %q", group, member)
+		}
+	}
+
+	const getMember = `SELECT member FROM system.group_members WHERE member = %s AND group_name = %s`
+	values, err := p.queryRow(fmt.Sprintf(getMember, parser.DString(member), parser.DString(group)))
+	if err != nil {
+		return err
+	}
+	if values != nil {
+		// Already a member: noop.
+		return nil
+	}
+	const insertMember = `INSERT INTO system.group_members VALUES (%s, %s)`
+	_, err = p.exec(fmt.Sprintf(insertMember, parser.DString(member), parser.DString(group)))
+	return err
+}
+
+// DropGroup removes all the members of a group, and removes the group from
+// the groups it is a member of. The privileges granted to the group are left
+// untouched, and are inherited again if the group is recreated.
+// Privileges: DELETE on system.group_members.
+//   Notes: postgres requires the CREATEROLE attribute.
+//          mysql has no groups.
+func (p *planner) DropGroup(n *parser.DropGroup) (planNode, error) {
+	group := string(n.Name)
+	if group == "" {
+		return nil, errEmptyGroupName
+	}
+	const deleteGroup = `DELETE FROM system.group_members WHERE group_name = %s`
+	count, err := p.exec(fmt.Sprintf(deleteGroup, parser.DString(group)))
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 && !n.IfExists {
+		return nil, fmt.Errorf("group %q does not exist", group)
+	}
+	const deleteMembership = `DELETE FROM system.group_members WHERE member = %s`
+	if _, err := p.exec(fmt.Sprintf(deleteMembership, parser.DString(group))); err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
+
+// getGroups returns the groups the user or group is a member of, directly
+// or through its groups.
+func (p *planner) getGroups(member string) ([]string, error) {
+	// Membership is resolved on behalf of the user, who may not be allowed to
+	// read system.group_members, using the planner's transaction.
+	internal := planner{txn: p.txn, user: security.RootUser}
+
+	const getGroups = `SELECT group_name FROM system.group_members WHERE member = %s`
+	var groups []string
+	seen := map[string]struct{}{member: {}}
+	for pending := []string{member}; len(pending) > 0; pending = pending[1:] {
+		plan, err := internal.query(fmt.Sprintf(getGroups, parser.DString(pending[0])))
+		if err != nil {
+			return nil, err
+		}
+		for plan.Next() {
+			group := string(plan.Values()[0].(parser.DString))
+			if _, ok := seen[group]; ok {
+				continue
+			}
+			seen[group] = struct{}{}
+			groups = append(groups, group)
+			pending = append(pending, group)
+		}
+		if err := plan.Err(); err != nil {
+			return nil, err
+		}
+	}
+	return groups, nil
+}
+
+// hasPrivilege returns true if p.user, or one of its groups, has `priv` in
+// the privilege descriptor. The groups are only looked up if the user
+// doesn't have the privilege itself, and some other user or group does, and
+// then at most once per statement.
+func (p *planner) hasPrivilege(privs *PrivilegeDescriptor, priv privilege.Kind) (bool, error) {
+	if privs.CheckPrivilege(p.user, priv) {
+		return true, nil
+	}
+	granted := false
+	for _, u := range privs.Users {
+		if u.User != security.RootUser && privs.CheckPrivilege(u.User, priv) {
+			granted = true
+			break
+		}
+	}
+	if !granted {
+		return false, nil
+	}
+	if !p.userGroupsResolved {
+		groups, err := p.getGroups(p.user)
+		if err != nil {
+			return false, err
+		}
+		p.userGroups, p.userGroupsResolved = groups, true
+	}
+	for _, group := range p.userGroups {
+		if privs.CheckPrivilege(group, priv) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,281 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/sql/privilege"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+// A virtualSchema is a read-only database whose tables are not stored, but
+// generated on the fly from the descriptors when they are scanned.
+type virtualSchema struct {
+	name   string
+	tables []virtualSchemaTable
+}
+
+// A virtualSchemaTable is a table of a virtual schema. Its descriptor is
+// derived from its CREATE TABLE statement, which must not declare any index.
+// Its rows are generated by populate, in the order of the columns.
+type virtualSchemaTable struct {
+	schema   string
+	populate func(p *planner, addRow func(...parser.Datum)) error
+}
+
+var virtualSchemas = []virtualSchema{
+	informationSchema,
+	pgCatalog,
+}
+
+// virtualSchemaEntry and virtualTableEntry hold the descriptors of the
+// virtual schemas and their tables.
+type virtualSchemaEntry struct {
+	desc       DatabaseDescriptor
+	tables     map[string]*virtualTableEntry
+	tableNames []string
+}
+
+type virtualTableEntry struct {
+	schema   *virtualSchemaEntry
+	desc     TableDescriptor
+	populate func(p *planner, addRow func(...parser.Datum)) error
+}
+
+var (
+	// virtualSchemasByName maps the normalized names of the virtual schemas to
+	// their entries. virtualSchemaNames lists the names in order.
+	virtualSchemasByName = map[string]*virtualSchemaEntry{}
+	virtualSchemaNames   []string
+	// virtualDescriptorIDs holds the IDs of the virtual descriptors. They are
+	// allocated downward from the top of the ID space, out of reach of the
+	// IDs of the stored descriptors.
+	virtualDescriptorIDs = map[ID]struct{}{}
+	virtualTablesByID    = map[ID]*virtualTableEntry{}
+)
+
+func init() {
+	nextID := ID(math.MaxUint32)
+	allocateID := func() ID {
+		id := nextID
+		nextID--
+		virtualDescriptorIDs[id] = struct{}{}
+		return id
+	}
+	for _, schema := range virtualSchemas {
+		e := &virtualSchemaEntry{
+			desc: DatabaseDescriptor{
+				Name:       schema.name,
+				ID:         allocateID(),
+				Privileges: NewPrivilegeDescriptor(security.RootUser, privilege.List{privilege.SELECT}),
+			},
+			tables: map[string]*virtualTableEntry{},
+		}
+		for _, table := range schema.tables {
+			desc := createVirtualTable(allocateID(), e.desc.ID, table.schema)
+			te := &virtualTableEntry{schema: e, desc: desc, populate: table.populate}
+			e.tables[normalizeName(desc.Name)] = te
+			e.tableNames = append(e.tableNames, desc.Name)
+			virtualTablesByID[desc.ID] = te
+		}
+		sort.Strings(e.tableNames)
+		virtualSchemasByName[normalizeName(schema.name)] = e
+		virtualSchemaNames = append(virtualSchemaNames, schema.name)
+	}
+	sort.Strings(virtualSchemaNames)
+}
+
+// createVirtualTable creates the descriptor of a virtual table. Unlike the
+// descriptors of stored tables, it has no primary key: its primary index
+// only exists so that the scanNode has an index to refer to.
+func createVirtualTable(id, parentID ID, cmd string) TableDescriptor {
+	stmts, err := parser.ParseTraditional(cmd)
+	if err != nil {
+		log.Fatal(err)
+	}
+	desc, err := makeTableDesc(stmts[0].(*parser.CreateTable), parentID)
+	if err != nil {
+		log.Fatal(err)
+	}
+	desc.ID = id
+	desc.Version = 1
+	desc.Privileges = NewPrivilegeDescriptor(security.RootUser, privilege.List{privilege.SELECT})
+	for i := range desc.Columns {
+		desc.Columns[i].ID = ColumnID(i + 1)
+	}
+	desc.NextColumnID = ColumnID(len(desc.Columns) + 1)
+	desc.PrimaryIndex = IndexDescriptor{Name: PrimaryKeyIndexName, ID: 1}
+	desc.NextIndexID = 2
+	return desc
+}
+
+// getVirtualSchema returns the virtual schema with the given name, or nil.
+func getVirtualSchema(name string) *virtualSchemaEntry {
+	return virtualSchemasByName[normalizeName(name)]
+}
+
+// isVirtualDescriptor returns true if the descriptor is that of a virtual
+// schema or table.
+func isVirtualDescriptor(desc descriptorProto) bool {
+	_, ok := virtualDescriptorIDs[desc.GetID()]
+	return ok
+}
+
+// errVirtualReadOnly returns the error of a statement modifying a virtual
+// schema or table.
+func errVirtualReadOnly(typeName, name string) error {
+	return fmt.Errorf("%s %q is read-only", typeName, name)
+}
+
+// getVirtualTableDesc returns a copy of the descriptor of a virtual table,
+// or nil if the normalized qualified name doesn't refer to a virtual schema.
+func getVirtualTableDesc(qname *parser.QualifiedName) (*TableDescriptor, error) {
+	e := getVirtualSchema(qname.Database())
+	if e == nil {
+		return nil, nil
+	}
+	te, ok := e.tables[normalizeName(qname.Table())]
+	if !ok {
+		return nil, fmt.Errorf("table %q does not exist", qname.Table())
+	}
+	return proto.Clone(&te.desc).(*TableDescriptor), nil
+}
+
+// getVirtualTableRows generates the rows of a virtual table.
+func (p *planner) getVirtualTableRows(desc *TableDescriptor) ([]parser.DTuple, error) {
+	te, ok := virtualTablesByID[desc.ID]
+	if !ok {
+		return nil, util.Errorf("%q is not a virtual table", desc.Name)
+	}
+	var rows []parser.DTuple
+	if err := te.populate(p, func(datums ...parser.Datum) {
+		rows = append(rows, datums)
+	}); err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		if len(row) != len(desc.Columns) {
+			return nil, util.Errorf("%s.%s: expected %d values, but generated %d",
+				te.schema.desc.Name, desc.Name, len(desc.Columns), len(row))
+		}
+	}
+	return rows, nil
+}
+
+// getAllDescriptors reads the descriptors of all the databases and tables,
+// including the virtual ones but not the dropped tables. The databases are
+// ordered by name, and so are the tables of each database, which are keyed
+// by database ID.
+func (p *planner) getAllDescriptors() ([]*DatabaseDescriptor, map[ID][]*TableDescriptor, error) {
+	prefix := roachpb.Key(MakeIndexKeyPrefix(DescriptorTable.ID, DescriptorTable.PrimaryIndex.ID))
+	sr, err := p.txn.Scan(prefix, prefix.PrefixEnd(), 0)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var dbs []*DatabaseDescriptor
+	tables := map[ID][]*TableDescriptor{}
+	for _, kv := range sr {
+		desc := &Descriptor{}
+		if err := kv.ValueProto(desc); err != nil {
+			return nil, nil, err
+		}
+		if db := desc.GetDatabase(); db != nil {
+			dbs = append(dbs, db)
+		} else if table := desc.GetTable(); table != nil && !table.Dropped() {
+			tables[table.ParentID] = append(tables[table.ParentID], table)
+		}
+	}
+	for _, name := range virtualSchemaNames {
+		e := getVirtualSchema(name)
+		dbs = append(dbs, &e.desc)
+		for _, tableName := range e.tableNames {
+			table := &e.tables[normalizeName(tableName)].desc
+			tables[e.desc.ID] = append(tables[e.desc.ID], table)
+		}
+	}
+
+	sort.Sort(databasesByName(dbs))
+	for _, t := range tables {
+		sort.Sort(tablesByName(t))
+	}
+	return dbs, tables, nil
+}
+
+type databasesByName []*DatabaseDescriptor
+
+func (d databasesByName) Len() int           { return len(d) }
+func (d databasesByName) Less(i, j int) bool { return d[i].Name < d[j].Name }
+func (d databasesByName) Swap(i, j int)      { d[i], d[j] = d[j], d[i] }
+
+type tablesByName []*TableDescriptor
+
+func (t tablesByName) Len() int           { return len(t) }
+func (t tablesByName) Less(i, j int) bool { return t[i].Name < t[j].Name }
+func (t tablesByName) Swap(i, j int)      { t[i], t[j] = t[j], t[i] }
+
+// forEachTableDesc calls fn for each table, including the virtual ones,
+// along with its database.
+func (p *planner) forEachTableDesc(fn func(db *DatabaseDescriptor, table *TableDescriptor)) error {
+	dbs, tables, err := p.getAllDescriptors()
+	if err != nil {
+		return err
+	}
+	for _, db := range dbs {
+		for _, table := range tables[db.ID] {
+			fn(db, table)
+		}
+	}
+	return nil
+}
+
+// forEachDatabaseDesc calls fn for each database, including the virtual
+// schemas.
+func (p *planner) forEachDatabaseDesc(fn func(db *DatabaseDescriptor)) error {
+	dbs, _, err := p.getAllDescriptors()
+	if err != nil {
+		return err
+	}
+	for _, db := range dbs {
+		fn(db)
+	}
+	return nil
+}
+
+// dIntOrNull returns i as a datum, or NULL if i is zero.
+func dIntOrNull(i int32) parser.Datum {
+	if i == 0 {
+		return parser.DNull
+	}
+	return parser.DInt(i)
+}
+
+// yesOrNo returns b as the "YES" or "NO" strings used by information_schema.
+func yesOrNo(b bool) parser.Datum {
+	if b {
+		return parser.DString("YES")
+	}
+	return parser.DString("NO")
+}
@@ -0,0 +1,244 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	yaml "gopkg.in/yaml.v1"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/config"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/sql/privilege"
+)
+
+// defaultZoneTarget is the name under which SHOW ZONE CONFIGURATION
+// displays the default zone config.
+const defaultZoneTarget = ".default"
+
+// zoneLevel is a level of the zone config hierarchy: a table, a database
+// or the default zone config. The zone is nil if none is set at the level.
+type zoneLevel struct {
+	target string
+	zone   *config.ZoneConfig
+}
+
+// getZoneConfig reads the zone config set for the object with 'id', and
+// returns nil if there is none.
+func getZoneConfig(txn *client.Txn, id ID) (*config.ZoneConfig, error) {
+	gr, err := txn.Get(MakeZoneKey(id))
+	if err != nil {
+		return nil, err
+	}
+	if !gr.Exists() {
+		return nil, nil
+	}
+	zone := &config.ZoneConfig{}
+	if err := gr.ValueProto(zone); err != nil {
+		return nil, err
+	}
+	return zone, nil
+}
+
+// writeZoneConfig replaces the zone config set for the object with 'id'
+// by 'zone', or removes it if 'zone' is nil. The zone config is written
+// with the privileges of the root user, as system.zones is only writable
+// by root.
+func (p *planner) writeZoneConfig(id ID, zone *config.ZoneConfig) error {
+	root := &planner{txn: p.txn, user: security.RootUser}
+	const deleteZone = `DELETE FROM system.zones WHERE id = %d`
+	if _, err := root.exec(fmt.Sprintf(deleteZone, id)); err != nil {
+		return err
+	}
+	if zone == nil {
+		return nil
+	}
+	buf, err := proto.Marshal(zone)
+	if err != nil {
+		return err
+	}
+	const insertZone = `INSERT INTO system.zones VALUES (%d, %s)`
+	_, err = root.exec(fmt.Sprintf(insertZone, id, parser.DBytes(buf)))
+	return err
+}
+
+// resolveZoneConfig returns the zone config which applies at the first of
+// 'levels': the fields which are not set at a level are inherited from the
+// following levels.
+func resolveZoneConfig(levels []zoneLevel) *config.ZoneConfig {
+	zone := &config.ZoneConfig{}
+	for _, l := range levels {
+		if l.zone != nil {
+			zone.InheritFrom(*l.zone)
+		}
+	}
+	return zone
+}
+
+// getZoneLevels looks up the table or database identified by 'n', and
+// returns its descriptor along with the levels of the zone config hierarchy
+// it inherits from, nearest first. The last level is the default zone config.
+func (p *planner) getZoneLevels(n parser.ZoneSpecifier) (descriptorProto, []zoneLevel, error) {
+	var desc descriptorProto
+	var ids []ID
+	var targets []string
+	if n.Table != nil {
+		tableDesc, err := p.getTableDesc(n.Table)
+		if err != nil {
+			return nil, nil, err
+		}
+		desc = tableDesc
+		ids = []ID{tableDesc.ID, tableDesc.ParentID}
+		targets = []string{n.Table.String(), n.Table.Database()}
+	} else {
+		if n.Database == "" {
+			return nil, nil, errEmptyDatabaseName
+		}
+		dbDesc, err := p.getDatabaseDesc(string(n.Database))
+		if err != nil {
+			return nil, nil, err
+		}
+		desc = dbDesc
+		ids = []ID{dbDesc.ID}
+		targets = []string{dbDesc.Name}
+	}
+
+	levels := make([]zoneLevel, 0, len(ids)+1)
+	for i, id := range ids {
+		zone, err := getZoneConfig(p.txn, id)
+		if err != nil {
+			return nil, nil, err
+		}
+		levels = append(levels, zoneLevel{target: targets[i], zone: zone})
+	}
+	levels = append(levels, zoneLevel{target: defaultZoneTarget, zone: config.DefaultZoneConfig})
+	return desc, levels, nil
+}
+
+// ConfigureZone sets the zone config of a table or database, given as YAML.
+// Fields which are not set are inherited from the zone config of the
+// database of a table, and from the default zone config. A NULL config
+// removes the zone config.
+// Privileges: CREATE on table or database.
+//   Notes: postgres and mysql do not have zone configs.
+func (p *planner) ConfigureZone(n *parser.ConfigureZone) (planNode, error) {
+	desc, levels, err := p.getZoneLevels(n.ZoneSpecifier)
+	if err != nil {
+		return nil, err
+	}
+	if IsSystemID(desc.GetID()) {
+		return nil, fmt.Errorf("the zone config of system %s %q can't be changed",
+			desc.TypeName(), desc.GetName())
+	}
+	if err := p.checkPrivilege(desc, privilege.CREATE); err != nil {
+		return nil, err
+	}
+
+	d, err := n.YAMLConfig.Eval(p.evalCtx)
+	if err != nil {
+		return nil, err
+	}
+	var zone *config.ZoneConfig
+	if d != parser.DNull {
+		s, ok := d.(parser.DString)
+		if !ok {
+			return nil, fmt.Errorf("zone config must be a string: %s is a %s", n.YAMLConfig, d.Type())
+		}
+		zone = &config.ZoneConfig{}
+		if err := yaml.Unmarshal([]byte(s), zone); err != nil {
+			return nil, fmt.Errorf("could not parse zone config: %s", err)
+		}
+	}
+
+	// The zone config is validated as it applies to the object, along with
+	// the zone configs of the tables of a database which inherit from it.
+	levels[0].zone = zone
+	if err := resolveZoneConfig(levels).Validate(); err != nil {
+		return nil, err
+	}
+	if dbDesc, ok := desc.(*DatabaseDescriptor); ok {
+		tbNames, err := p.getTableNames(dbDesc)
+		if err != nil {
+			return nil, err
+		}
+		for _, tbName := range tbNames {
+			tableDesc, err := p.getTableDesc(tbName)
+			if err != nil {
+				return nil, err
+			}
+			tableZone, err := getZoneConfig(p.txn, tableDesc.ID)
+			if err != nil {
+				return nil, err
+			}
+			if tableZone == nil {
+				continue
+			}
+			tableLevels := append([]zoneLevel{{zone: tableZone}}, levels...)
+			if err := resolveZoneConfig(tableLevels).Validate(); err != nil {
+				return nil, fmt.Errorf("invalid zone config for table %q: %s", tableDesc.Name, err)
+			}
+		}
+	}
+
+	if err := p.writeZoneConfig(desc.GetID(), zone); err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
+
+// ShowZoneConfig shows the zone config of a table or database, along with
+// the levels of the hierarchy it inherits from: for each level, the zone
+// config set at that level and the zone config which applies at that level
+// after inheritance.
+// Privileges: None.
+//   Notes: postgres and mysql do not have zone configs.
+func (p *planner) ShowZoneConfig(n *parser.ShowZoneConfig) (planNode, error) {
+	_, levels, err := p.getZoneLevels(n.ZoneSpecifier)
+	if err != nil {
+		return nil, err
+	}
+
+	v := &valuesNode{
+		columns: []column{
+			{name: "Target", typ: parser.DummyString},
+			{name: "Config", typ: parser.DummyString},
+			{name: "Resolved", typ: parser.DummyString},
+		},
+	}
+	for i, l := range levels {
+		var set parser.Datum = parser.DNull
+		if l.zone != nil {
+			s, err := yaml.Marshal(l.zone)
+			if err != nil {
+				return nil, err
+			}
+			set = parser.DString(s)
+		}
+		resolved, err := yaml.Marshal(resolveZoneConfig(levels[i:]))
+		if err != nil {
+			return nil, err
+		}
+		v.rows = append(v.rows, []parser.Datum{
+			parser.DString(l.target),
+			set,
+			parser.DString(resolved),
+		})
+	}
+	return v, nil
+}
@@ -0,0 +1,116 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql_test
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	yaml "gopkg.in/yaml.v1"
+
+	"github.com/cockroachdb/cockroach/config"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func TestZoneConfigSQL(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, _ := setup(t)
+	defer cleanup(s, sqlDB)
+
+	for _, stmt := range []string{
+		`CREATE DATABASE d`,
+		`CREATE TABLE d.t (k INT PRIMARY KEY)`,
+		`ALTER DATABASE d CONFIGURE ZONE 'gc: {ttlseconds: 60}'`,
+	} {
+		if _, err := sqlDB.Exec(stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := sqlDB.Exec(`ALTER TABLE d.t CONFIGURE ZONE $1`, "range_max_bytes: 134217728"); err != nil {
+		t.Fatal(err)
+	}
+
+	checkZone := func(zone config.ZoneConfig, gcTTL int32, rangeMaxBytes int64) {
+		if zone.GC == nil || zone.GC.TTLSeconds != gcTTL || zone.RangeMaxBytes != rangeMaxBytes ||
+			zone.RangeMinBytes != config.DefaultZoneConfig.RangeMinBytes ||
+			zone.ReplicaCount() != config.DefaultZoneConfig.ReplicaCount() {
+			t.Errorf("expected gc ttl %d and range max bytes %d inheriting from the default zone config; got %+v",
+				gcTTL, rangeMaxBytes, zone)
+		}
+	}
+
+	// The zone config of the table inherits from the zone config of the
+	// database, which inherits from the default zone config.
+	checkShowZone := func() {
+		rows, err := sqlDB.Query(`SHOW ZONE CONFIGURATION FOR TABLE d.t`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer rows.Close()
+		var targets []string
+		var resolved []config.ZoneConfig
+		for rows.Next() {
+			var target, resolvedYAML string
+			var setYAML sql.NullString
+			if err := rows.Scan(&target, &setYAML, &resolvedYAML); err != nil {
+				t.Fatal(err)
+			}
+			if !setYAML.Valid {
+				t.Errorf("%s: expected a zone config to be set", target)
+			}
+			var zone config.ZoneConfig
+			if err := yaml.Unmarshal([]byte(resolvedYAML), &zone); err != nil {
+				t.Fatal(err)
+			}
+			targets = append(targets, target)
+			resolved = append(resolved, zone)
+		}
+		if err := rows.Err(); err != nil {
+			t.Fatal(err)
+		}
+		if expected := []string{"d.t", "d", ".default"}; !reflect.DeepEqual(targets, expected) {
+			t.Fatalf("expected targets %s; got %s", expected, targets)
+		}
+		defaultZone := config.DefaultZoneConfig
+		checkZone(resolved[0], 60, 128<<20)
+		checkZone(resolved[1], 60, defaultZone.RangeMaxBytes)
+		checkZone(resolved[2], defaultZone.GC.TTLSeconds, defaultZone.RangeMaxBytes)
+	}
+	checkShowZone()
+
+	// The zone config is kept when the table is truncated.
+	if _, err := sqlDB.Exec(`TRUNCATE TABLE d.t`); err != nil {
+		t.Fatal(err)
+	}
+	checkShowZone()
+
+	// The resolved zone config applies to the data of the table.
+	var id uint32
+	if err := sqlDB.QueryRow(`SELECT id FROM system.namespace WHERE name = 't'`).Scan(&id); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := forceNewConfig(t, s)
+	if err != nil {
+		t.Fatalf("failed to get latest system config: %s", err)
+	}
+	zone, err := cfg.GetZoneConfigForKey(keys.MakeTablePrefix(id))
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkZone(*zone, 60, 128<<20)
+}
@@ -21,6 +21,7 @@ package storage
 
 import (
 	"fmt"
+	"math"
 	"math/rand"
 	"strconv"
 
@@ -52,6 +53,14 @@ const (
 	// probabilistic "jitter" to shouldRebalance() function: the store will not
 	// take every rebalancing opportunity available.
 	rebalanceShouldRebalanceChance = 0.2
+	// leaseRebalanceThreshold is the fraction above the mean number of leases
+	// per store which a store may hold before it starts transferring leases
+	// to less loaded stores.
+	leaseRebalanceThreshold = 0.05 // 5%
+
+	// diversityEpsilon is the tolerance used when comparing locality
+	// diversity scores.
+	diversityEpsilon = 1e-9
 
 	// priorities for various repair operations.
 	removeDeadReplicaPriority  float64 = 10000
@@ -207,15 +216,18 @@ func (a *Allocator) ComputeAction(zone config.ZoneConfig, desc *roachpb.RangeDes
 		return AllocatorRemoveDead, removeDeadReplicaPriority + float64(quorum-liveReplicas)
 	}
 
-	// TODO(mrtracy): Handle non-homogenous and mismatched attribute sets.
-	need := len(zone.ReplicaAttrs)
+	need := zone.ReplicaCount()
 	have := len(desc.Replicas)
-	if have < need {
+	// Replicas on decommissioning nodes or on stores which violate the zone's
+	// constraints don't count towards the desired replication factor; a
+	// replacement is added first, after which the range is over-replicated
+	// and the unsuitable replica is removed.
+	if live := have - len(a.unsuitableReplicas(zone.Constraints, desc.Replicas)); live < need {
 		// Range is under-replicated, and should add an additional replica.
 		// Priority is adjusted by the difference between the current replica
 		// count and the quorum of the desired replica count.
 		neededQuorum := computeQuorum(need)
-		return AllocatorAdd, addMissingReplicaPriority + float64(neededQuorum-have)
+		return AllocatorAdd, addMissingReplicaPriority + float64(neededQuorum-live)
 	}
 	if have > need {
 		// Range is over-replicated, and should remove a replica.
@@ -229,25 +241,40 @@ func (a *Allocator) ComputeAction(zone config.ZoneConfig, desc *roachpb.RangeDes
 }
 
 // AllocateTarget returns a suitable store for a new allocation with the
-// required attributes. Nodes already accommodating existing replicas are ruled
-// out as targets. If relaxConstraints is true, then the required attributes
-// will be relaxed as necessary, from least specific to most specific, in order
-// to allocate a target. If needed, a filter function can be added that further
-// filter the results. The function will be passed the storeDesc and the used
-// and new counts. It returns a bool indicating inclusion or exclusion from the
-// set of stores being considered.
-func (a *Allocator) AllocateTarget(required roachpb.Attributes, existing []roachpb.ReplicaDescriptor, relaxConstraints bool,
+// required attributes and satisfying the supplied constraints. Nodes already
+// accommodating existing replicas are ruled out as targets. If
+// relaxConstraints is true, then the required attributes will be relaxed as
+// necessary, from least specific to most specific, in order to allocate a
+// target; required and prohibited constraints are never relaxed. If needed,
+// a filter function can be added that further filter the results. The
+// function will be passed the storeDesc and the used and new counts. It
+// returns a bool indicating inclusion or exclusion from the set of stores
+// being considered.
+func (a *Allocator) AllocateTarget(required roachpb.Attributes, constraints []config.Constraint,
+	existing []roachpb.ReplicaDescriptor, relaxConstraints bool,
 	filter func(storeDesc *roachpb.StoreDescriptor, count, used *stat) bool) (*roachpb.StoreDescriptor, error) {
 	existingNodes := make(nodeIDSet, len(existing))
 	for _, repl := range existing {
 		existingNodes[repl.NodeID] = struct{}{}
 	}
+	localities := a.localities(existing, 0)
 
 	// Because more redundancy is better than less, if relaxConstraints, the
 	// matching here is lenient, and tries to find a target by relaxing an
 	// attribute constraint, from last attribute to first.
 	for attrs := append([]string(nil), required.Attrs...); ; attrs = attrs[:len(attrs)-1] {
 		sl := a.storePool.getStoreList(roachpb.Attributes{Attrs: attrs}, a.options.Deterministic)
+		// Only consider the stores which best satisfy the constraints and,
+		// among those, the ones with room which are most diverse from the
+		// existing replicas, spreading replicas across failure domains. Ties
+		// are broken by the balancer, which prefers the least loaded stores.
+		// If none of the stores has room, the choice is left to the balancer
+		// alone.
+		sl = constrainStores(sl, constraints)
+		diverse, _ := mostDiverse(sl, localities, existingNodes)
+		if target := a.balancer.selectGood(diverse, existingNodes); target != nil {
+			return target, nil
+		}
 		if target := a.balancer.selectGood(sl, existingNodes); target != nil {
 			return target, nil
 		}
@@ -261,17 +288,24 @@ func (a *Allocator) AllocateTarget(required roachpb.Attributes, existing []roach
 
 // RemoveTarget returns a suitable replica to remove from the provided replica
 // set. It attempts to consider which of the provided replicas would be the best
-// candidate for removal.
+// candidate for removal. Replicas on decommissioning nodes or on stores which
+// violate the supplied constraints are removed first.
 //
 // TODO(mrtracy): removeTarget eventually needs to accept the attributes from
 // the zone config associated with the provided replicas. This will allow it to
 // make correct decisions in the case of ranges with heterogeneous replica
 // requirements (i.e. multiple data centers).
-func (a Allocator) RemoveTarget(existing []roachpb.ReplicaDescriptor) (roachpb.ReplicaDescriptor, error) {
+func (a Allocator) RemoveTarget(constraints []config.Constraint,
+	existing []roachpb.ReplicaDescriptor) (roachpb.ReplicaDescriptor, error) {
 	if len(existing) == 0 {
 		return roachpb.ReplicaDescriptor{}, util.Errorf("must supply at least one replica to allocator.RemoveTarget()")
 	}
 
+	// Unsuitable replicas are always removed first.
+	if unsuitable := a.unsuitableReplicas(constraints, existing); len(unsuitable) > 0 {
+		return unsuitable[0], nil
+	}
+
 	// Retrieve store descriptors for the provided replicas from the StorePool.
 	sl := StoreList{}
 	for i := range existing {
@@ -296,19 +330,25 @@ func (a Allocator) RemoveTarget(existing []roachpb.ReplicaDescriptor) (roachpb.R
 // with required attributes. Rebalance targets are selected via the
 // same mechanism as AllocateTarget(), except the chosen target must
 // follow some additional criteria. Namely, if chosen, it must further
-// the goal of balancing the cluster.
+// the goal of balancing the cluster without decreasing the locality
+// diversity of the range's replicas. A store with room which increases
+// diversity is chosen regardless of balance. If no store with room is as
+// diverse as the current one, the replica is only moved off a store which
+// is nearly full (see maxFractionUsedThreshold), to whichever store
+// improves balance most.
 //
 // The supplied parameters are the StoreID of the replica being rebalanced, the
-// required attributes for the replica being rebalanced, and a list of the
-// existing replicas of the range (which must include the replica being
-// rebalanced).
+// required attributes and constraints for the replica being rebalanced, and a
+// list of the existing replicas of the range (which must include the replica
+// being rebalanced).
 //
 // Simply ignoring a rebalance opportunity in the event that the
 // target chosen by AllocateTarget() doesn't fit balancing criteria
 // is perfectly fine, as other stores in the cluster will also be
 // doing their probabilistic best to rebalance. This helps prevent
 // a stampeding herd targeting an abnormally under-utilized store.
-func (a Allocator) RebalanceTarget(storeID roachpb.StoreID, required roachpb.Attributes, existing []roachpb.ReplicaDescriptor) *roachpb.StoreDescriptor {
+func (a Allocator) RebalanceTarget(storeID roachpb.StoreID, required roachpb.Attributes,
+	constraints []config.Constraint, existing []roachpb.ReplicaDescriptor) *roachpb.StoreDescriptor {
 	if !a.options.AllowRebalance {
 		return nil
 	}
@@ -317,16 +357,30 @@ func (a Allocator) RebalanceTarget(storeID roachpb.StoreID, required roachpb.Att
 		existingNodes[repl.NodeID] = struct{}{}
 	}
 	storeDesc := a.storePool.getStoreDescriptor(storeID)
+	if storeDesc == nil {
+		return nil
+	}
+	localities := a.localities(existing, storeID)
+	current := diversity(storeDesc.Node.Locality, localities)
 	sl := a.storePool.getStoreList(required, a.options.Deterministic)
-	if replacement := a.balancer.improve(storeDesc, sl, existingNodes); replacement != nil {
-		return replacement
+	sl = constrainStores(sl, constraints)
+	diverse, best := mostDiverse(sl, localities, existingNodes)
+	if len(diverse.stores) == 0 || best < current-diversityEpsilon {
+		if storeDesc.Capacity.FractionUsed() > maxFractionUsedThreshold {
+			return a.balancer.improve(storeDesc, sl, existingNodes)
+		}
+		return nil
+	}
+	if best > current+diversityEpsilon {
+		return a.balancer.selectGood(diverse, existingNodes)
 	}
-	return nil
+	return a.balancer.improve(storeDesc, diverse, existingNodes)
 }
 
 // ShouldRebalance returns whether the specified store should attempt to
-// rebalance a replica to another store.
-func (a Allocator) ShouldRebalance(storeID roachpb.StoreID) bool {
+// rebalance its replica of the range with the supplied replicas to another
+// store.
+func (a Allocator) ShouldRebalance(storeID roachpb.StoreID, existing []roachpb.ReplicaDescriptor) bool {
 	if !a.options.AllowRebalance {
 		return false
 	}
@@ -349,10 +403,245 @@ func (a Allocator) ShouldRebalance(storeID roachpb.StoreID) bool {
 
 	sl := a.storePool.getStoreList(*storeDesc.CombinedAttrs(), a.options.Deterministic)
 
+	// A store which improves the locality diversity of the range's replicas
+	// is always a suitable replacement.
+	existingNodes := make(nodeIDSet, len(existing))
+	for _, repl := range existing {
+		existingNodes[repl.NodeID] = struct{}{}
+	}
+	existingNodes[storeDesc.Node.NodeID] = struct{}{}
+	localities := a.localities(existing, storeID)
+	if diverse, best := mostDiverse(sl, localities, existingNodes); len(diverse.stores) > 0 &&
+		best > diversity(storeDesc.Node.Locality, localities)+diversityEpsilon {
+		return true
+	}
+
 	// ShouldRebalance is true if a suitable replacement can be found.
 	return a.balancer.improve(storeDesc, sl, makeNodeIDSet(storeDesc.Node.NodeID)) != nil
 }
 
+// unsuitableReplicas returns the replicas from the supplied slice which are
+// located on live stores of decommissioning nodes or on stores violating
+// any of the supplied required or prohibited constraints.
+func (a Allocator) unsuitableReplicas(constraints []config.Constraint,
+	repls []roachpb.ReplicaDescriptor) []roachpb.ReplicaDescriptor {
+	var unsuitable []roachpb.ReplicaDescriptor
+	for _, repl := range repls {
+		desc := a.storePool.getStoreDescriptor(repl.StoreID)
+		if desc == nil {
+			continue
+		}
+		if desc.Node.Decommissioning || !satisfiesConstraints(*desc, constraints) {
+			unsuitable = append(unsuitable, repl)
+		}
+	}
+	return unsuitable
+}
+
+// satisfiesConstraints returns whether the given store matches all of the
+// supplied required constraints and none of the prohibited ones.
+func satisfiesConstraints(store roachpb.StoreDescriptor, constraints []config.Constraint) bool {
+	for _, c := range constraints {
+		switch c.Type {
+		case config.Constraint_REQUIRED:
+			if !c.Matches(store) {
+				return false
+			}
+		case config.Constraint_PROHIBITED:
+			if c.Matches(store) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// constrainStores returns the stores from the supplied list which satisfy
+// the supplied required and prohibited constraints and, among those, match
+// the largest number of positive constraints. The statistics of the
+// supplied list are retained so that balancing decisions are still made
+// relative to all of its stores.
+func constrainStores(sl StoreList, constraints []config.Constraint) StoreList {
+	if len(constraints) == 0 {
+		return sl
+	}
+	best := -1
+	filtered := StoreList{count: sl.count, used: sl.used, leaseCount: sl.leaseCount}
+	for _, store := range sl.stores {
+		if !satisfiesConstraints(*store, constraints) {
+			continue
+		}
+		var positive int
+		for _, c := range constraints {
+			if c.Type == config.Constraint_POSITIVE && c.Matches(*store) {
+				positive++
+			}
+		}
+		if positive > best {
+			best = positive
+			filtered.stores = nil
+		}
+		if positive == best {
+			filtered.stores = append(filtered.stores, store)
+		}
+	}
+	return filtered
+}
+
+// localities returns the localities of the nodes holding the supplied
+// replicas, skipping the replica on the store with the given ID (if any)
+// and replicas on stores whose descriptors are unknown.
+func (a Allocator) localities(existing []roachpb.ReplicaDescriptor, skip roachpb.StoreID) []roachpb.Locality {
+	var localities []roachpb.Locality
+	for _, repl := range existing {
+		if repl.StoreID == skip {
+			continue
+		}
+		if desc := a.storePool.getStoreDescriptor(repl.StoreID); desc != nil {
+			localities = append(localities, desc.Node.Locality)
+		}
+	}
+	return localities
+}
+
+// diversity returns the mean locality diversity score of the given
+// locality with respect to each of the supplied localities.
+func diversity(locality roachpb.Locality, others []roachpb.Locality) float64 {
+	if len(others) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, other := range others {
+		sum += locality.DiversityScore(other)
+	}
+	return sum / float64(len(others))
+}
+
+// mostDiverse returns the stores from the supplied list which have room
+// for more data (see maxFractionUsedThreshold) and whose nodes' localities
+// are most diverse from the supplied localities, along with their
+// diversity. Stores on excluded nodes are neither considered nor returned;
+// if no store qualifies, the returned list is empty. The statistics of the
+// supplied list are retained so that balancing decisions are still made
+// relative to all of its stores.
+func mostDiverse(sl StoreList, localities []roachpb.Locality, excluded nodeIDSet) (StoreList, float64) {
+	best := -1.0
+	candidates := make([]bool, len(sl.stores))
+	scores := make([]float64, len(sl.stores))
+	for i, store := range sl.stores {
+		if _, ok := excluded[store.Node.NodeID]; ok || store.Capacity.FractionUsed() > maxFractionUsedThreshold {
+			continue
+		}
+		candidates[i] = true
+		scores[i] = diversity(store.Node.Locality, localities)
+		if scores[i] > best {
+			best = scores[i]
+		}
+	}
+	filtered := StoreList{count: sl.count, used: sl.used, leaseCount: sl.leaseCount}
+	for i, store := range sl.stores {
+		if candidates[i] && scores[i] > best-diversityEpsilon {
+			filtered.stores = append(filtered.stores, store)
+		}
+	}
+	return filtered, best
+}
+
+// TransferLeaseTarget returns a replica from the supplied replica set to
+// which the leader lease, currently held by the replica on leaseStoreID,
+// should be transferred, or nil if the lease is best left where it is.
+//
+// Candidates are the replicas on live stores of nodes which aren't being
+// decommissioned and which match the first of the supplied lease preferences
+// that any of them match (or all such replicas if none match). A draining
+// holder is never a candidate. If the current holder is not a candidate, the
+// candidate on the store holding the fewest leases is returned. Otherwise,
+// the lease is only moved if rebalancing is allowed and the holder's store
+// holds more leases than the cluster mean by more than
+// leaseRebalanceThreshold, to a candidate whose store holds fewer leases
+// than the mean.
+func (a *Allocator) TransferLeaseTarget(preferences []roachpb.Attributes,
+	existing []roachpb.ReplicaDescriptor, leaseStoreID roachpb.StoreID, draining bool) *roachpb.ReplicaDescriptor {
+	dead := map[roachpb.StoreID]struct{}{}
+	for _, repl := range a.storePool.deadReplicas(existing) {
+		dead[repl.StoreID] = struct{}{}
+	}
+	descs := map[roachpb.StoreID]*roachpb.StoreDescriptor{}
+	var live []roachpb.ReplicaDescriptor
+	for _, repl := range existing {
+		if _, ok := dead[repl.StoreID]; ok {
+			continue
+		}
+		desc := a.storePool.getStoreDescriptor(repl.StoreID)
+		if desc == nil {
+			continue
+		}
+		descs[repl.StoreID] = desc
+		// Leases are never moved to decommissioning nodes, and a draining
+		// holder can't keep its lease.
+		if desc.Node.Decommissioning || (draining && repl.StoreID == leaseStoreID) {
+			continue
+		}
+		live = append(live, repl)
+	}
+	if _, ok := descs[leaseStoreID]; !ok && !draining {
+		// Without a descriptor for the holder's store there is nothing to
+		// base a decision on.
+		return nil
+	}
+
+	candidates := live
+	for _, pref := range preferences {
+		var matching []roachpb.ReplicaDescriptor
+		for _, repl := range live {
+			if pref.IsSubset(*descs[repl.StoreID].CombinedAttrs()) {
+				matching = append(matching, repl)
+			}
+		}
+		if len(matching) > 0 {
+			candidates = matching
+			break
+		}
+	}
+
+	var target *roachpb.ReplicaDescriptor
+	holderIsCandidate := false
+	for i := range candidates {
+		repl := &candidates[i]
+		if repl.StoreID == leaseStoreID {
+			holderIsCandidate = true
+			continue
+		}
+		if target == nil ||
+			descs[repl.StoreID].Capacity.LeaseCount < descs[target.StoreID].Capacity.LeaseCount {
+			target = repl
+		}
+	}
+	if !holderIsCandidate {
+		// The lease is held outside of the preferred stores; move it there
+		// regardless of balance.
+		return target
+	}
+	if target == nil || !a.options.AllowRebalance {
+		return nil
+	}
+	// In production, add some random jitter so that not all stores act on
+	// the same (possibly stale) lease counts at once.
+	if !a.options.Deterministic && a.randGen.Float32() > rebalanceShouldRebalanceChance {
+		return nil
+	}
+
+	sl := a.storePool.getStoreList(roachpb.Attributes{}, a.options.Deterministic)
+	mean := sl.leaseCount.mean
+	if float64(descs[leaseStoreID].Capacity.LeaseCount) <= math.Ceil(mean*(1+leaseRebalanceThreshold)) {
+		return nil
+	}
+	if float64(descs[target.StoreID].Capacity.LeaseCount) >= mean {
+		return nil
+	}
+	return target
+}
+
 // computeQuorum computes the quorum value for the given number of nodes.
 func computeQuorum(nodes int) int {
 	return (nodes / 2) + 1
@@ -200,7 +200,7 @@ func TestAllocatorSimpleRetrieval(t *testing.T) {
 	stopper, g, _, a := createTestAllocator()
 	defer stopper.Stop()
 	gossiputil.NewStoreGossiper(g).GossipStores(singleStore, t)
-	result, err := a.AllocateTarget(simpleZoneConfig.ReplicaAttrs[0], []roachpb.ReplicaDescriptor{}, false, nil)
+	result, err := a.AllocateTarget(simpleZoneConfig.ReplicaAttrs[0], nil, []roachpb.ReplicaDescriptor{}, false, nil)
 	if err != nil {
 		t.Fatalf("Unable to perform allocation: %v", err)
 	}
@@ -213,7 +213,7 @@ func TestAllocatorNoAvailableDisks(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	stopper, _, _, a := createTestAllocator()
 	defer stopper.Stop()
-	result, err := a.AllocateTarget(simpleZoneConfig.ReplicaAttrs[0], []roachpb.ReplicaDescriptor{}, false, nil)
+	result, err := a.AllocateTarget(simpleZoneConfig.ReplicaAttrs[0], nil, []roachpb.ReplicaDescriptor{}, false, nil)
 	if result != nil {
 		t.Errorf("expected nil result: %+v", result)
 	}
@@ -227,7 +227,7 @@ func TestAllocatorThreeDisksSameDC(t *testing.T) {
 	stopper, g, _, a := createTestAllocator()
 	defer stopper.Stop()
 	gossiputil.NewStoreGossiper(g).GossipStores(sameDCStores, t)
-	result1, err := a.AllocateTarget(multiDisksConfig.ReplicaAttrs[0], []roachpb.ReplicaDescriptor{}, false, nil)
+	result1, err := a.AllocateTarget(multiDisksConfig.ReplicaAttrs[0], nil, []roachpb.ReplicaDescriptor{}, false, nil)
 	if err != nil {
 		t.Fatalf("Unable to perform allocation: %v", err)
 	}
@@ -240,7 +240,7 @@ func TestAllocatorThreeDisksSameDC(t *testing.T) {
 			StoreID: result1.StoreID,
 		},
 	}
-	result2, err := a.AllocateTarget(multiDisksConfig.ReplicaAttrs[1], exReplicas, false, nil)
+	result2, err := a.AllocateTarget(multiDisksConfig.ReplicaAttrs[1], nil, exReplicas, false, nil)
 	if err != nil {
 		t.Errorf("Unable to perform allocation: %v", err)
 	}
@@ -250,7 +250,7 @@ func TestAllocatorThreeDisksSameDC(t *testing.T) {
 	if result1.Node.NodeID == result2.Node.NodeID {
 		t.Errorf("Expected node ids to be different %+v vs %+v", result1, result2)
 	}
-	result3, err := a.AllocateTarget(multiDisksConfig.ReplicaAttrs[2], []roachpb.ReplicaDescriptor{}, false, nil)
+	result3, err := a.AllocateTarget(multiDisksConfig.ReplicaAttrs[2], nil, []roachpb.ReplicaDescriptor{}, false, nil)
 	if err != nil {
 		t.Errorf("Unable to perform allocation: %v", err)
 	}
@@ -264,11 +264,11 @@ func TestAllocatorTwoDatacenters(t *testing.T) {
 	stopper, g, _, a := createTestAllocator()
 	defer stopper.Stop()
 	gossiputil.NewStoreGossiper(g).GossipStores(multiDCStores, t)
-	result1, err := a.AllocateTarget(multiDCConfig.ReplicaAttrs[0], []roachpb.ReplicaDescriptor{}, false, nil)
+	result1, err := a.AllocateTarget(multiDCConfig.ReplicaAttrs[0], nil, []roachpb.ReplicaDescriptor{}, false, nil)
 	if err != nil {
 		t.Fatalf("Unable to perform allocation: %v", err)
 	}
-	result2, err := a.AllocateTarget(multiDCConfig.ReplicaAttrs[1], []roachpb.ReplicaDescriptor{}, false, nil)
+	result2, err := a.AllocateTarget(multiDCConfig.ReplicaAttrs[1], nil, []roachpb.ReplicaDescriptor{}, false, nil)
 	if err != nil {
 		t.Fatalf("Unable to perform allocation: %v", err)
 	}
@@ -276,7 +276,7 @@ func TestAllocatorTwoDatacenters(t *testing.T) {
 		t.Errorf("Expected nodes 1 & 2: %+v vs %+v", result1.Node, result2.Node)
 	}
 	// Verify that no result is forthcoming if we already have a replica.
-	_, err = a.AllocateTarget(multiDCConfig.ReplicaAttrs[1], []roachpb.ReplicaDescriptor{
+	_, err = a.AllocateTarget(multiDCConfig.ReplicaAttrs[1], nil, []roachpb.ReplicaDescriptor{
 		{
 			NodeID:  result2.Node.NodeID,
 			StoreID: result2.StoreID,
@@ -292,7 +292,7 @@ func TestAllocatorExistingReplica(t *testing.T) {
 	stopper, g, _, a := createTestAllocator()
 	defer stopper.Stop()
 	gossiputil.NewStoreGossiper(g).GossipStores(sameDCStores, t)
-	result, err := a.AllocateTarget(multiDisksConfig.ReplicaAttrs[1], []roachpb.ReplicaDescriptor{
+	result, err := a.AllocateTarget(multiDisksConfig.ReplicaAttrs[1], nil, []roachpb.ReplicaDescriptor{
 		{
 			NodeID:  2,
 			StoreID: 2,
@@ -346,7 +346,7 @@ func TestAllocatorRelaxConstraints(t *testing.T) {
 		for _, id := range test.existing {
 			existing = append(existing, roachpb.ReplicaDescriptor{NodeID: roachpb.NodeID(id), StoreID: roachpb.StoreID(id)})
 		}
-		result, err := a.AllocateTarget(roachpb.Attributes{Attrs: test.required}, existing, test.relaxConstraints, nil)
+		result, err := a.AllocateTarget(roachpb.Attributes{Attrs: test.required}, nil, existing, test.relaxConstraints, nil)
 		if haveErr := (err != nil); haveErr != test.expErr {
 			t.Errorf("%d: expected error %t; got %t: %s", i, test.expErr, haveErr, err)
 		} else if err == nil && roachpb.StoreID(test.expID) != result.StoreID {
@@ -390,7 +390,7 @@ func TestAllocatorRandomAllocation(t *testing.T) {
 	// store 1 or store 2 will be chosen, as the least loaded of the
 	// three random choices is returned.
 	for i := 0; i < 10; i++ {
-		result, err := a.AllocateTarget(roachpb.Attributes{}, []roachpb.ReplicaDescriptor{}, false, nil)
+		result, err := a.AllocateTarget(roachpb.Attributes{}, nil, []roachpb.ReplicaDescriptor{}, false, nil)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -433,7 +433,7 @@ func TestAllocatorRebalance(t *testing.T) {
 
 	// Every rebalance target must be either stores 1 or 2.
 	for i := 0; i < 10; i++ {
-		result := a.RebalanceTarget(3, roachpb.Attributes{}, []roachpb.ReplicaDescriptor{})
+		result := a.RebalanceTarget(3, roachpb.Attributes{}, nil, []roachpb.ReplicaDescriptor{})
 		if result == nil {
 			t.Fatal("nil result")
 		}
@@ -445,7 +445,7 @@ func TestAllocatorRebalance(t *testing.T) {
 	// Verify ShouldRebalance results.
 	a.options.Deterministic = true
 	for i, store := range stores {
-		result := a.ShouldRebalance(store.StoreID)
+		result := a.ShouldRebalance(store.StoreID, nil)
 		if expResult := (i >= 2); expResult != result {
 			t.Errorf("%d: expected rebalance %t; got %t", i, expResult, result)
 		}
@@ -485,7 +485,7 @@ func TestAllocatorRebalanceByCapacity(t *testing.T) {
 
 	// Every rebalance target must be store 4 (if not nil).
 	for i := 0; i < 10; i++ {
-		result := a.RebalanceTarget(1, roachpb.Attributes{}, []roachpb.ReplicaDescriptor{})
+		result := a.RebalanceTarget(1, roachpb.Attributes{}, nil, []roachpb.ReplicaDescriptor{})
 		if result != nil && result.StoreID != 4 {
 			t.Errorf("expected store 4; got %d", result.StoreID)
 		}
@@ -494,7 +494,7 @@ func TestAllocatorRebalanceByCapacity(t *testing.T) {
 	// Verify ShouldRebalance results.
 	a.options.Deterministic = true
 	for i, store := range stores {
-		result := a.ShouldRebalance(store.StoreID)
+		result := a.ShouldRebalance(store.StoreID, nil)
 		if expResult := (i < 3); expResult != result {
 			t.Errorf("%d: expected rebalance %t; got %t", i, expResult, result)
 		}
@@ -536,7 +536,7 @@ func TestAllocatorRebalanceByCount(t *testing.T) {
 
 	// Every rebalance target must be store 4 (or nil for case of missing the only option).
 	for i := 0; i < 10; i++ {
-		result := a.RebalanceTarget(1, roachpb.Attributes{}, []roachpb.ReplicaDescriptor{})
+		result := a.RebalanceTarget(1, roachpb.Attributes{}, nil, []roachpb.ReplicaDescriptor{})
 		if result != nil && result.StoreID != 4 {
 			t.Errorf("expected store 4; got %d", result.StoreID)
 		}
@@ -545,13 +545,432 @@ func TestAllocatorRebalanceByCount(t *testing.T) {
 	// Verify ShouldRebalance results.
 	a.options.Deterministic = true
 	for i, store := range stores {
-		result := a.ShouldRebalance(store.StoreID)
+		result := a.ShouldRebalance(store.StoreID, nil)
 		if expResult := (i < 3); expResult != result {
 			t.Errorf("%d: expected rebalance %t; got %t", i, expResult, result)
 		}
 	}
 }
 
+// TestAllocatorTransferLeaseTarget verifies that leases are moved to
+// preferred stores first and otherwise away from stores holding more than
+// their share of leases.
+func TestAllocatorTransferLeaseTarget(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper, g, _, a := createTestAllocator()
+	defer stopper.Stop()
+	a.options.Deterministic = true
+
+	// The mean lease count is 8, so only stores 1 through 3 are overfull and
+	// only store 4 is a target for balancing.
+	stores := []*roachpb.StoreDescriptor{
+		{
+			StoreID:  1,
+			Attrs:    roachpb.Attributes{Attrs: []string{"ssd"}},
+			Node:     roachpb.NodeDescriptor{NodeID: 1},
+			Capacity: roachpb.StoreCapacity{Capacity: 100, Available: 100, LeaseCount: 10},
+		},
+		{
+			StoreID:  2,
+			Attrs:    roachpb.Attributes{Attrs: []string{"ssd"}},
+			Node:     roachpb.NodeDescriptor{NodeID: 2},
+			Capacity: roachpb.StoreCapacity{Capacity: 100, Available: 100, LeaseCount: 10},
+		},
+		{
+			StoreID:  3,
+			Attrs:    roachpb.Attributes{Attrs: []string{"hdd"}},
+			Node:     roachpb.NodeDescriptor{NodeID: 3},
+			Capacity: roachpb.StoreCapacity{Capacity: 100, Available: 100, LeaseCount: 10},
+		},
+		{
+			StoreID:  4,
+			Attrs:    roachpb.Attributes{Attrs: []string{"hdd"}},
+			Node:     roachpb.NodeDescriptor{NodeID: 4},
+			Capacity: roachpb.StoreCapacity{Capacity: 100, Available: 100, LeaseCount: 2},
+		},
+	}
+	gossiputil.NewStoreGossiper(g).GossipStores(stores, t)
+
+	replicas := func(storeIDs ...roachpb.StoreID) []roachpb.ReplicaDescriptor {
+		var repls []roachpb.ReplicaDescriptor
+		for _, storeID := range storeIDs {
+			repls = append(repls, roachpb.ReplicaDescriptor{
+				NodeID:    roachpb.NodeID(storeID),
+				StoreID:   storeID,
+				ReplicaID: roachpb.ReplicaID(storeID),
+			})
+		}
+		return repls
+	}
+	ssd := []roachpb.Attributes{{Attrs: []string{"ssd"}}}
+	mem := []roachpb.Attributes{{Attrs: []string{"mem"}}}
+
+	testCases := []struct {
+		preferences []roachpb.Attributes
+		existing    []roachpb.ReplicaDescriptor
+		holder      roachpb.StoreID
+		expected    roachpb.StoreID // 0 for no transfer
+	}{
+		// Overfull holder moves its lease to the underfull store.
+		{nil, replicas(1, 2, 4), 1, 4},
+		// Underfull holder keeps its lease.
+		{nil, replicas(1, 2, 4), 4, 0},
+		// No underfull store among the replicas.
+		{nil, replicas(1, 2, 3), 1, 0},
+		// Holder outside of the preferred stores moves its lease there.
+		{ssd, replicas(1, 3, 4), 3, 1},
+		{ssd, replicas(1, 3, 4), 4, 1},
+		// Holder is the only preferred store and keeps its lease.
+		{ssd, replicas(1, 3, 4), 1, 0},
+		// Underfull stores which aren't preferred are not considered.
+		{ssd, replicas(1, 2, 4), 1, 0},
+		// Unsatisfiable preferences are ignored.
+		{mem, replicas(1, 2, 4), 1, 4},
+	}
+	for i, test := range testCases {
+		target := a.TransferLeaseTarget(test.preferences, test.existing, test.holder, false)
+		var targetStoreID roachpb.StoreID
+		if target != nil {
+			targetStoreID = target.StoreID
+		}
+		if targetStoreID != test.expected {
+			t.Errorf("%d: expected transfer to store %d; got %d", i, test.expected, targetStoreID)
+		}
+	}
+
+	// Balancing is disabled along with rebalancing, but preferences are not.
+	a.options.AllowRebalance = false
+	if target := a.TransferLeaseTarget(nil, replicas(1, 2, 4), 1, false); target != nil {
+		t.Errorf("expected no transfer with rebalancing disabled; got store %d", target.StoreID)
+	}
+	if target := a.TransferLeaseTarget(ssd, replicas(1, 3, 4), 3, false); target == nil || target.StoreID != 1 {
+		t.Errorf("expected transfer to preferred store 1; got %+v", target)
+	}
+
+	// A draining holder always gives up its lease.
+	if target := a.TransferLeaseTarget(nil, replicas(1, 2, 3), 1, true); target == nil || target.StoreID != 2 {
+		t.Errorf("expected draining holder to transfer to store 2; got %+v", target)
+	}
+	if target := a.TransferLeaseTarget(ssd, replicas(1, 3, 4), 1, true); target == nil || target.StoreID != 4 {
+		t.Errorf("expected draining holder to transfer to store 4; got %+v", target)
+	}
+}
+
+// TestAllocatorDecommissioning verifies that the allocator moves replicas
+// and leases off of the stores of decommissioning nodes.
+func TestAllocatorDecommissioning(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper, g, _, a := createTestAllocator()
+	defer stopper.Stop()
+	a.options.Deterministic = true
+
+	var stores []*roachpb.StoreDescriptor
+	var replicas []roachpb.ReplicaDescriptor
+	for i := 1; i <= 4; i++ {
+		stores = append(stores, &roachpb.StoreDescriptor{
+			StoreID: roachpb.StoreID(i),
+			Attrs:   roachpb.Attributes{Attrs: []string{"ssd"}},
+			Node: roachpb.NodeDescriptor{
+				NodeID:          roachpb.NodeID(i),
+				Decommissioning: i == 4,
+			},
+			Capacity: roachpb.StoreCapacity{Capacity: 100, Available: 100},
+		})
+		replicas = append(replicas, roachpb.ReplicaDescriptor{
+			NodeID:    roachpb.NodeID(i),
+			StoreID:   roachpb.StoreID(i),
+			ReplicaID: roachpb.ReplicaID(i),
+		})
+	}
+	gossiputil.NewStoreGossiper(g).GossipStores(stores, t)
+
+	zone := config.ZoneConfig{
+		ReplicaAttrs: []roachpb.Attributes{{}, {}, {}},
+	}
+
+	// A replica on a decommissioning node doesn't count towards the
+	// replication factor.
+	desc := &roachpb.RangeDescriptor{Replicas: []roachpb.ReplicaDescriptor{replicas[0], replicas[1], replicas[3]}}
+	if action, _ := a.ComputeAction(zone, desc); action != AllocatorAdd {
+		t.Errorf("expected AllocatorAdd; got %d", action)
+	}
+	// The decommissioning node is not a target for the new replica.
+	result, err := a.AllocateTarget(roachpb.Attributes{Attrs: []string{"ssd"}}, nil, desc.Replicas, false, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.StoreID != 3 {
+		t.Errorf("expected allocation to store 3; got %d", result.StoreID)
+	}
+
+	// Once the replacement has been added, the decommissioning replica is
+	// removed.
+	desc.Replicas = replicas
+	if action, _ := a.ComputeAction(zone, desc); action != AllocatorRemove {
+		t.Errorf("expected AllocatorRemove; got %d", action)
+	}
+	remove, err := a.RemoveTarget(nil, desc.Replicas)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if remove.StoreID != 4 {
+		t.Errorf("expected removal of store 4; got %d", remove.StoreID)
+	}
+
+	// Leases are moved off of the decommissioning node as well.
+	if target := a.TransferLeaseTarget(nil, replicas, 4, false); target == nil || target.StoreID != 1 {
+		t.Errorf("expected lease transfer to store 1; got %+v", target)
+	}
+}
+
+// TestAllocatorDiversity verifies that the allocator spreads replicas across
+// node localities.
+func TestAllocatorDiversity(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper, g, _, a := createTestAllocator()
+	defer stopper.Stop()
+	a.options.Deterministic = true
+
+	var stores []*roachpb.StoreDescriptor
+	for i, l := range []string{"region=us,dc=1", "region=us,dc=1", "region=us,dc=2", "region=eu,dc=1"} {
+		var locality roachpb.Locality
+		if err := locality.Set(l); err != nil {
+			t.Fatal(err)
+		}
+		stores = append(stores, &roachpb.StoreDescriptor{
+			StoreID:  roachpb.StoreID(i + 1),
+			Node:     roachpb.NodeDescriptor{NodeID: roachpb.NodeID(i + 1), Locality: locality},
+			Capacity: roachpb.StoreCapacity{Capacity: 100, Available: 100},
+		})
+	}
+	gossiputil.NewStoreGossiper(g).GossipStores(stores, t)
+
+	replicas := func(storeIDs ...roachpb.StoreID) []roachpb.ReplicaDescriptor {
+		var repls []roachpb.ReplicaDescriptor
+		for _, storeID := range storeIDs {
+			repls = append(repls, roachpb.ReplicaDescriptor{
+				NodeID:  roachpb.NodeID(storeID),
+				StoreID: storeID,
+			})
+		}
+		return repls
+	}
+
+	allocTestCases := []struct {
+		existing []roachpb.ReplicaDescriptor
+		expected roachpb.StoreID
+	}{
+		// The other region is the most diverse.
+		{replicas(1), 4},
+		// Another datacenter in the same region beats the same datacenter.
+		{replicas(1, 4), 3},
+	}
+	for i, test := range allocTestCases {
+		result, err := a.AllocateTarget(roachpb.Attributes{}, nil, test.existing, false, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.StoreID != test.expected {
+			t.Errorf("%d: expected allocation to store %d; got %d", i, test.expected, result.StoreID)
+		}
+	}
+
+	// Store 2 shares its datacenter with store 1; moving its replica to the
+	// other region improves diversity regardless of balance.
+	if !a.ShouldRebalance(2, replicas(1, 2, 3)) {
+		t.Error("expected store 2 to rebalance")
+	}
+	if result := a.RebalanceTarget(2, roachpb.Attributes{}, nil, replicas(1, 2, 3)); result == nil || result.StoreID != 4 {
+		t.Errorf("expected rebalance to store 4; got %+v", result)
+	}
+	// Rebalancing never decreases diversity.
+	if result := a.RebalanceTarget(4, roachpb.Attributes{}, nil, replicas(1, 3, 4)); result != nil {
+		t.Errorf("expected no rebalance; got store %d", result.StoreID)
+	}
+}
+
+// TestAllocatorDiversityCapacity verifies that locality diversity doesn't
+// override store capacity: full stores are passed over, ties in diversity
+// are broken by capacity, and a replica on a full store is moved even if
+// that decreases diversity.
+func TestAllocatorDiversityCapacity(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper, g, _, a := createTestAllocator()
+	defer stopper.Stop()
+	a.options.Deterministic = true
+
+	var stores []*roachpb.StoreDescriptor
+	for i, s := range []struct {
+		locality  string
+		available int64
+	}{
+		{"region=us,dc=1", 100},
+		{"region=eu,dc=1", 1},
+		{"region=us,dc=2", 100},
+		{"region=eu,dc=2", 40},
+		{"region=eu,dc=3", 80},
+		{"region=ap,dc=1", 1},
+	} {
+		var locality roachpb.Locality
+		if err := locality.Set(s.locality); err != nil {
+			t.Fatal(err)
+		}
+		stores = append(stores, &roachpb.StoreDescriptor{
+			StoreID:  roachpb.StoreID(i + 1),
+			Node:     roachpb.NodeDescriptor{NodeID: roachpb.NodeID(i + 1), Locality: locality},
+			Capacity: roachpb.StoreCapacity{Capacity: 100, Available: s.available},
+		})
+	}
+	gossiputil.NewStoreGossiper(g).GossipStores(stores, t)
+
+	replicas := func(storeIDs ...roachpb.StoreID) []roachpb.ReplicaDescriptor {
+		var repls []roachpb.ReplicaDescriptor
+		for _, storeID := range storeIDs {
+			repls = append(repls, roachpb.ReplicaDescriptor{
+				NodeID:  roachpb.NodeID(storeID),
+				StoreID: storeID,
+			})
+		}
+		return repls
+	}
+
+	// Stores 2, 4, 5 and 6 are equally diverse from store 1; stores 2 and 6
+	// are full and store 5 has the most room.
+	for i := 0; i < 10; i++ {
+		result, err := a.AllocateTarget(roachpb.Attributes{}, nil, replicas(1), false, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.StoreID != 5 {
+			t.Errorf("%d: expected allocation to store 5; got %d", i, result.StoreID)
+		}
+	}
+
+	// Every store with room is less diverse than full store 6, whose
+	// replica is nonetheless moved to the least used of them.
+	if result := a.RebalanceTarget(6, roachpb.Attributes{}, nil, replicas(1, 4, 6)); result == nil || result.StoreID != 3 {
+		t.Errorf("expected rebalance to store 3; got %+v", result)
+	}
+}
+
+// TestAllocatorConstraints verifies that zone config constraints restrict
+// and order the stores chosen by the allocator, and that replicas violating
+// them are replaced.
+func TestAllocatorConstraints(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper, g, _, a := createTestAllocator()
+	defer stopper.Stop()
+	a.options.Deterministic = true
+
+	var stores []*roachpb.StoreDescriptor
+	var replicas []roachpb.ReplicaDescriptor
+	for i, s := range []struct {
+		attr, locality string
+		rangeCount     int32
+	}{
+		{"ssd", "region=us", 10},
+		{"hdd", "region=us", 0},
+		{"ssd", "region=eu", 0},
+		{"ssd", "region=us", 0},
+		{"ssd", "region=us", 10},
+	} {
+		var locality roachpb.Locality
+		if err := locality.Set(s.locality); err != nil {
+			t.Fatal(err)
+		}
+		stores = append(stores, &roachpb.StoreDescriptor{
+			StoreID:  roachpb.StoreID(i + 1),
+			Attrs:    roachpb.Attributes{Attrs: []string{s.attr}},
+			Node:     roachpb.NodeDescriptor{NodeID: roachpb.NodeID(i + 1), Locality: locality},
+			Capacity: roachpb.StoreCapacity{Capacity: 100, Available: 100, RangeCount: s.rangeCount},
+		})
+		replicas = append(replicas, roachpb.ReplicaDescriptor{
+			NodeID:    roachpb.NodeID(i + 1),
+			StoreID:   roachpb.StoreID(i + 1),
+			ReplicaID: roachpb.ReplicaID(i + 1),
+		})
+	}
+	gossiputil.NewStoreGossiper(g).GossipStores(stores, t)
+
+	parse := func(strs ...string) []config.Constraint {
+		var constraints []config.Constraint
+		for _, s := range strs {
+			var c config.Constraint
+			if err := c.Parse(s); err != nil {
+				t.Fatal(err)
+			}
+			constraints = append(constraints, c)
+		}
+		return constraints
+	}
+
+	allocTestCases := []struct {
+		constraints []config.Constraint
+		existing    []roachpb.ReplicaDescriptor
+		expected    roachpb.StoreID
+	}{
+		// Only stores 4 and 5 satisfy both the required and the prohibited
+		// constraint; store 4 has fewer ranges.
+		{parse("+region=us", "-hdd"), replicas[:1], 4},
+		// Positive constraints are preferred but not required. Stores 3, 4 and
+		// 5 have SSDs; store 3 is the most diverse.
+		{parse("ssd"), replicas[:1], 3},
+		// A positive constraint no store satisfies is ignored.
+		{parse("region=ap"), replicas[:3], 4},
+	}
+	for i, test := range allocTestCases {
+		result, err := a.AllocateTarget(roachpb.Attributes{}, test.constraints, test.existing, false, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.StoreID != test.expected {
+			t.Errorf("%d: expected allocation to store %d; got %d", i, test.expected, result.StoreID)
+		}
+	}
+	// Required constraints are never relaxed.
+	if _, err := a.AllocateTarget(roachpb.Attributes{}, parse("+region=ap"), nil, true, nil); err == nil {
+		t.Error("expected an error allocating with an unsatisfiable constraint")
+	}
+
+	zone := config.ZoneConfig{
+		NumReplicas: 3,
+		Constraints: parse("+region=us", "-hdd"),
+	}
+
+	// The replica on store 2 violates the prohibited constraint and doesn't
+	// count towards the replication factor.
+	desc := &roachpb.RangeDescriptor{Replicas: []roachpb.ReplicaDescriptor{replicas[0], replicas[1], replicas[3]}}
+	if action, _ := a.ComputeAction(zone, desc); action != AllocatorAdd {
+		t.Errorf("expected AllocatorAdd; got %d", action)
+	}
+	result, err := a.AllocateTarget(zone.RequiredAttrs(), zone.Constraints, desc.Replicas, true, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.StoreID != 5 {
+		t.Errorf("expected allocation to store 5; got %d", result.StoreID)
+	}
+
+	// Once the replacement has been added, the violating replica is removed.
+	desc.Replicas = append(desc.Replicas, replicas[4])
+	if action, _ := a.ComputeAction(zone, desc); action != AllocatorRemove {
+		t.Errorf("expected AllocatorRemove; got %d", action)
+	}
+	remove, err := a.RemoveTarget(zone.Constraints, desc.Replicas)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if remove.StoreID != 2 {
+		t.Errorf("expected removal of store 2; got %d", remove.StoreID)
+	}
+
+	// Store 1 has more ranges than the mean, but its replica may only be
+	// rebalanced onto a store satisfying the constraints.
+	if result := a.RebalanceTarget(1, zone.RequiredAttrs(), parse("+region=eu"), replicas[:1]); result == nil || result.StoreID != 3 {
+		t.Errorf("expected rebalance to store 3; got %+v", result)
+	}
+}
+
 // TestAllocatorRemoveTarget verifies that the replica chosen by RemoveTarget is
 // the one with the lowest capacity.
 func TestAllocatorRemoveTarget(t *testing.T) {
@@ -609,7 +1028,7 @@ func TestAllocatorRemoveTarget(t *testing.T) {
 	sg := gossiputil.NewStoreGossiper(g)
 	sg.GossipStores(stores, t)
 
-	targetRepl, err := a.RemoveTarget(replicas)
+	targetRepl, err := a.RemoveTarget(nil, replicas)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -643,7 +1062,7 @@ func TestAllocatorRemoveTarget(t *testing.T) {
 	}
 	sg.GossipStores(stores, t)
 
-	targetRepl, err = a.RemoveTarget(replicas)
+	targetRepl, err = a.RemoveTarget(nil, replicas)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1119,8 +1538,8 @@ func Example_rebalancing() {
 		// Next loop through test stores and maybe rebalance.
 		for j := 0; j < len(testStores); j++ {
 			ts := &testStores[j]
-			if alloc.ShouldRebalance(ts.StoreID) {
-				target := alloc.RebalanceTarget(ts.StoreID, roachpb.Attributes{}, []roachpb.ReplicaDescriptor{{NodeID: ts.Node.NodeID, StoreID: ts.StoreID}})
+			if alloc.ShouldRebalance(ts.StoreID, nil) {
+				target := alloc.RebalanceTarget(ts.StoreID, roachpb.Attributes{}, nil, []roachpb.ReplicaDescriptor{{NodeID: ts.Node.NodeID, StoreID: ts.StoreID}})
 				if target != nil {
 					testStores[j].rebalance(&testStores[int(target.StoreID)], alloc.randGen.Int63n(1<<20))
 				}
@@ -70,6 +70,12 @@ func TestRaftLogQueue(t *testing.T) {
 		}
 	}
 
+	// The raft log size is tracked by each replica.
+	originalSize := raftLeaderRepl.RaftLogSize()
+	if originalSize <= 0 {
+		t.Fatalf("expected a positive raft log size; got %d", originalSize)
+	}
+
 	// Sadly, occasionally the queue has a race with the force processing so
 	// this succeeds within will captures those rare cases.
 	var afterTruncationIndex uint64
@@ -107,4 +113,9 @@ func TestRaftLogQueue(t *testing.T) {
 		t.Fatalf("raft log was truncated again and it shouldn't have been, afterTruncationIndex:%d after2ndTruncationIndex:%d",
 			afterTruncationIndex, after2ndTruncationIndex)
 	}
+
+	// Truncation reduces the raft log size.
+	if size := raftLeaderRepl.RaftLogSize(); size >= originalSize {
+		t.Fatalf("expected the raft log size to decrease from %d; got %d", originalSize, size)
+	}
 }
@@ -0,0 +1,137 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package engine
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// The header of the files of an encrypted RocksDB store. Its layout must
+// match the one written by rocksdb/encryption.cc.
+const (
+	encryptionMagic      = "CRDBENC1"
+	encryptionKeyIDSize  = 16
+	encryptionHeaderSize = 64
+)
+
+// An EncryptionKey is an AES key used to encrypt the files of a store.
+type EncryptionKey struct {
+	// ID identifies the key in the headers of the files it encrypts. It is
+	// derived from the key, so that the same key file always yields the
+	// same ID.
+	ID  string
+	Key []byte
+}
+
+// LoadEncryptionKey reads the key file at the specified path, which holds
+// 16, 24 or 32 random bytes to select AES-128, AES-192 or AES-256. Such a
+// file can be generated with e.g. `openssl rand -out store.key 32`.
+func LoadEncryptionKey(path string) (EncryptionKey, error) {
+	key, err := ioutil.ReadFile(path)
+	if err != nil {
+		return EncryptionKey{}, util.Errorf("unable to read encryption key: %s", err)
+	}
+	switch len(key) {
+	case 16, 24, 32:
+	default:
+		return EncryptionKey{}, util.Errorf("encryption key %q must be 16, 24 or 32 bytes long, found %d bytes",
+			path, len(key))
+	}
+	sum := sha256.Sum256(key)
+	return EncryptionKey{
+		ID:  hex.EncodeToString(sum[:encryptionKeyIDSize/2]),
+		Key: key,
+	}, nil
+}
+
+// EncryptionOptions configures the encryption at rest of a RocksDB store.
+type EncryptionOptions struct {
+	// ActiveKey encrypts the files written to the store.
+	ActiveKey EncryptionKey
+	// OldKeys are the keys which were active before a key rotation. Files
+	// still encrypted with them are readable until they are rewritten
+	// with the active key in the background.
+	OldKeys []EncryptionKey
+}
+
+// encryptedFile is a file of a RocksDB store along with the ID of the key
+// it is encrypted with, which is empty if the file is plaintext.
+type encryptedFile struct {
+	name  string
+	keyID string
+	size  int64
+}
+
+// readEncryptionHeader returns the ID of the key the file at the specified
+// path is encrypted with, or an empty string if it is not encrypted.
+func readEncryptionHeader(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	var header [encryptionHeaderSize]byte
+	if _, err := io.ReadFull(f, header[:]); err == io.EOF || err == io.ErrUnexpectedEOF {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+	if !bytes.Equal(header[:len(encryptionMagic)], []byte(encryptionMagic)) {
+		return "", nil
+	}
+	return string(header[len(encryptionMagic) : len(encryptionMagic)+encryptionKeyIDSize]), nil
+}
+
+// listEncryptedFiles returns the files of the RocksDB store in dir, along
+// with the keys they are encrypted with. The lock file and empty files,
+// which are never encrypted, are skipped.
+func listEncryptedFiles(dir string) ([]encryptedFile, error) {
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var files []encryptedFile
+	for _, info := range infos {
+		if info.IsDir() || info.Name() == "LOCK" || info.Size() == 0 {
+			continue
+		}
+		keyID, err := readEncryptionHeader(filepath.Join(dir, info.Name()))
+		if err != nil {
+			if os.IsNotExist(err) {
+				// The file was deleted since the directory was read.
+				continue
+			}
+			return nil, err
+		}
+		size := info.Size()
+		if keyID != "" {
+			size -= encryptionHeaderSize
+		}
+		files = append(files, encryptedFile{name: info.Name(), keyID: keyID, size: size})
+	}
+	return files, nil
+}
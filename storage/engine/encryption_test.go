@@ -0,0 +1,219 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package engine
+
+import (
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/storage/engine/rocksdb"
+	"github.com/cockroachdb/cockroach/testutils"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/cockroachdb/cockroach/util/stop"
+)
+
+// writeEncryptionKey writes a key file of the specified size with random
+// contents, and loads it.
+func writeEncryptionKey(t *testing.T, dir, name string, size int) EncryptionKey {
+	buf := make([]byte, size)
+	if _, err := rand.Read(buf); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(path, buf, 0600); err != nil {
+		t.Fatal(err)
+	}
+	key, err := LoadEncryptionKey(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+// TestLoadEncryptionKey verifies that key files of the AES key sizes are
+// loaded, with IDs derived from their contents.
+func TestLoadEncryptionKey(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	dirs := util.CreateNTempDirs(t, "_encryption_test", 1)
+	defer util.CleanupDirs(dirs)
+
+	for _, size := range []int{16, 24, 32} {
+		key := writeEncryptionKey(t, dirs[0], fmt.Sprintf("%d.key", size), size)
+		if len(key.ID) != encryptionKeyIDSize || len(key.Key) != size {
+			t.Errorf("unexpected key %s of %d bytes", key.ID, len(key.Key))
+		}
+		reloaded, err := LoadEncryptionKey(filepath.Join(dirs[0], fmt.Sprintf("%d.key", size)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if reloaded.ID != key.ID {
+			t.Errorf("expected key ID %s on reload, got %s", key.ID, reloaded.ID)
+		}
+	}
+
+	path := filepath.Join(dirs[0], "invalid.key")
+	if err := ioutil.WriteFile(path, make([]byte, 20), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadEncryptionKey(path); !testutils.IsError(err, "must be 16, 24 or 32 bytes long") {
+		t.Errorf("expected invalid key error, got %v", err)
+	}
+	if _, err := LoadEncryptionKey(filepath.Join(dirs[0], "missing.key")); err == nil {
+		t.Error("expected an error loading a missing key")
+	}
+}
+
+// TestXORKeyStream verifies that the key stream applied at an offset
+// matches the one applied to the whole file contents.
+func TestXORKeyStream(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	key := make([]byte, 32)
+	iv := bytes.Repeat([]byte{0xff}, rocksdb.IVSize)
+	if err := rocksdb.AddEncryptionKey("xorkeystreamtest", key); err != nil {
+		t.Fatal(err)
+	}
+	plaintext := []byte("the quick brown fox jumps over the lazy dog, again and again and again")
+	whole := append([]byte(nil), plaintext...)
+	if !rocksdb.XORKeyStream("xorkeystreamtest", iv, 0, whole) {
+		t.Fatal("unknown key")
+	}
+	if bytes.Equal(whole, plaintext) {
+		t.Fatal("expected the data to be encrypted")
+	}
+	pieces := append([]byte(nil), plaintext...)
+	for _, bounds := range [][2]int{{0, 7}, {7, 16}, {16, 41}, {41, len(pieces)}} {
+		rocksdb.XORKeyStream("xorkeystreamtest", iv, uint64(bounds[0]), pieces[bounds[0]:bounds[1]])
+	}
+	if !bytes.Equal(whole, pieces) {
+		t.Errorf("expected %x, got %x", whole, pieces)
+	}
+	rocksdb.XORKeyStream("xorkeystreamtest", iv, 0, pieces)
+	if !bytes.Equal(pieces, plaintext) {
+		t.Errorf("expected decryption to yield %q, got %q", plaintext, pieces)
+	}
+	if rocksdb.XORKeyStream("unknown", iv, 0, pieces) {
+		t.Error("expected an unknown key to be reported")
+	}
+}
+
+// TestRocksDBEncryption verifies that the files of an encrypted store don't
+// contain its data in plaintext, that the store can't be opened without
+// its key, and that rotating the key rewrites its files.
+func TestRocksDBEncryption(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	dirs := util.CreateNTempDirs(t, "_encryption_test", 2)
+	defer util.CleanupDirs(dirs)
+	dir := dirs[0]
+	oldKey := writeEncryptionKey(t, dirs[1], "old.key", 32)
+	newKey := writeEncryptionKey(t, dirs[1], "new.key", 16)
+
+	key := MVCCKey("secret-key")
+	value := bytes.Repeat([]byte("secret-value"), 100)
+	open := func(encryption *EncryptionOptions) (*RocksDB, *stop.Stopper, error) {
+		stopper := stop.NewStopper()
+		var r *RocksDB
+		if encryption == nil {
+			r = NewRocksDB(roachpb.Attributes{}, dir, testCacheSize, stopper)
+		} else {
+			r = NewEncryptedRocksDB(roachpb.Attributes{}, dir, testCacheSize, *encryption, stopper)
+		}
+		if err := r.Open(); err != nil {
+			stopper.Stop()
+			return nil, nil, err
+		}
+		return r, stopper, nil
+	}
+	checkValue := func(r *RocksDB) {
+		if v, err := r.Get(key); err != nil {
+			t.Fatal(err)
+		} else if !bytes.Equal(v, value) {
+			t.Fatalf("expected %q, got %q", value, v)
+		}
+	}
+
+	r, stopper, err := open(&EncryptionOptions{ActiveKey: oldKey})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Put(key, value); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	checkValue(r)
+	stopper.Stop()
+
+	files, err := listEncryptedFiles(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("expected the store to have files")
+	}
+	for _, file := range files {
+		if file.keyID != oldKey.ID {
+			t.Errorf("expected file %s to be encrypted with key %s, got %q", file.name, oldKey.ID, file.keyID)
+		}
+		content, err := ioutil.ReadFile(filepath.Join(dir, file.name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if bytes.Contains(content, []byte("secret")) {
+			t.Errorf("file %s contains plaintext data", file.name)
+		}
+	}
+
+	// The store can't be opened without its key.
+	if _, _, err := open(nil); !testutils.IsError(err, "no encryption key was specified") {
+		t.Fatalf("expected missing key error, got %v", err)
+	}
+	if _, _, err := open(&EncryptionOptions{ActiveKey: newKey}); !testutils.IsError(err, "unknown key "+oldKey.ID) {
+		t.Fatalf("expected unknown key error, got %v", err)
+	}
+
+	// After rotating the key, the files are rewritten with the new key.
+	r, stopper, err = open(&EncryptionOptions{ActiveKey: newKey, OldKeys: []EncryptionKey{oldKey}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkValue(r)
+	util.SucceedsWithin(t, 5*time.Second, func() error {
+		status, err := r.EncryptionStatus()
+		if err != nil {
+			return err
+		}
+		if status.ActiveKeyID != newKey.ID || status.Rewriting || status.ActiveFiles != status.TotalFiles {
+			return fmt.Errorf("files not yet rewritten with the new key: %+v", status)
+		}
+		return nil
+	})
+	stopper.Stop()
+
+	r, stopper, err = open(&EncryptionOptions{ActiveKey: newKey})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stopper.Stop()
+	checkValue(r)
+}
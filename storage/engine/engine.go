@@ -71,9 +71,13 @@ type Iterator interface {
 	// always adjusted to avoid counting local keys in the event stats are being
 	// recomputed for the first range (i.e. the one with start key ==
 	// KeyMin). The nowNanos arg specifies the wall time in nanoseconds since the
-	// epoch and is used to compute the total age of all intents. The computed
-	// stats are accumulated (not assigned) to the ms parameter.
+	// epoch and is used to compute the total age of all intents. Keys deleted
+	// by range tombstones are accounted for as deleted. The computed stats
+	// are accumulated (not assigned) to the ms parameter.
 	ComputeStats(ms *MVCCStats, start, end []byte, nowNanos int64) error
+	// computeKeyStats computes the stats counters like ComputeStats,
+	// without accounting for the keys deleted by range tombstones.
+	computeKeyStats(ms *MVCCStats, start, end []byte, nowNanos int64) error
 }
 
 // Engine is the interface that wraps the core operations of a
@@ -131,6 +135,9 @@ type Engine interface {
 	// Flush causes the engine to write all in-memory data to disk
 	// immediately.
 	Flush() error
+	// GetStats returns statistics gathered from within the storage
+	// engine, such as cache hit rates and compaction activity.
+	GetStats() (*Stats, error)
 	// NewIterator returns a new instance of an Iterator over this
 	// engine. The caller must invoke Iterator.Close() when finished with
 	// the iterator to free resources.
@@ -156,6 +163,45 @@ type Engine interface {
 	Defer(fn func())
 }
 
+// Stats is a set of statistics gathered from within the storage
+// engine. Counters are cumulative since the engine was opened; the
+// remaining fields describe the engine at the time of the call.
+type Stats struct {
+	// Block cache counters and the number of bytes currently cached.
+	BlockCacheHits   int64
+	BlockCacheMisses int64
+	BlockCacheUsage  int64
+	// The approximate size of all memtables, in bytes.
+	MemtableTotalSize int64
+	// Bytes written by clients, by memtable flushes and by
+	// compactions. Together they determine the write amplification.
+	BytesWritten           int64
+	FlushBytesWritten      int64
+	CompactionBytesRead    int64
+	CompactionBytesWritten int64
+	// CompactionPending is 1 if at least one compaction is pending.
+	CompactionPending int64
+	// The estimated memory used by open table readers, not counting
+	// the block cache.
+	TableReadersMemEstimate int64
+	// ReadAmplification is the worst-case number of files a point
+	// lookup has to consult.
+	ReadAmplification int64
+	// StallMicros is the total time writes have been stalled waiting
+	// for flushes or compactions to catch up.
+	StallMicros int64
+}
+
+// WriteAmplification returns the number of bytes written to disk by
+// flushes and compactions for every byte written by clients, or zero
+// if nothing has been written yet.
+func (s *Stats) WriteAmplification() float64 {
+	if s.BytesWritten == 0 {
+		return 0
+	}
+	return float64(s.FlushBytesWritten+s.CompactionBytesWritten) / float64(s.BytesWritten)
+}
+
 var bufferPool = sync.Pool{
 	New: func() interface{} {
 		return proto.NewBuffer(nil)
@@ -200,24 +246,36 @@ func Scan(engine Engine, start, end MVCCKey, max int64) ([]MVCCKeyValue, error)
 	return kvs, err
 }
 
+// clearRangeChunkSize is the number of entries ClearRange collects before
+// removing them.
+var clearRangeChunkSize = 1000
+
 // ClearRange removes a set of entries, from start (inclusive) to end
 // (exclusive). This function returns the number of entries
-// removed. Either all entries within the range will be deleted, or
-// none, and an error will be returned. Note that this function
-// actually removes entries from the storage engine, rather than
-// inserting tombstones, as with deletion through the MVCC.
+// removed. Note that this function actually removes entries from the
+// storage engine, rather than inserting tombstones, as with deletion
+// through the MVCC. The entries are removed from the engine directly, in
+// chunks collected before being removed as an engine can't be modified
+// while it is iterated over; pass a batch for the removal to be atomic.
 func ClearRange(engine Engine, start, end MVCCKey) (int, error) {
-	b := engine.NewBatch()
-	defer b.Close()
 	count := 0
-	if err := engine.Iterate(start, end, func(kv MVCCKeyValue) (bool, error) {
-		if err := b.Clear(kv.Key); err != nil {
-			return false, err
+	for {
+		var chunk []MVCCKey
+		if err := engine.Iterate(start, end, func(kv MVCCKeyValue) (bool, error) {
+			chunk = append(chunk, kv.Key)
+			return len(chunk) == clearRangeChunkSize, nil
+		}); err != nil {
+			return count, err
 		}
-		count++
-		return false, nil
-	}); err != nil {
-		return 0, err
+		for _, key := range chunk {
+			if err := engine.Clear(key); err != nil {
+				return count, err
+			}
+			count++
+		}
+		if len(chunk) < clearRangeChunkSize {
+			return count, nil
+		}
+		start = chunk[len(chunk)-1].Next()
 	}
-	return count, b.Commit()
 }
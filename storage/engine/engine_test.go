@@ -59,6 +59,8 @@ func runWithAllEngines(test func(e Engine, t *testing.T), t *testing.T) {
 	defer stopper.Stop()
 	inMem := NewInMem(inMemAttrs, testCacheSize, stopper)
 	test(inMem, t)
+	goInMem := NewGoInMem(inMemAttrs, testCacheSize, stopper)
+	test(goInMem, t)
 }
 
 // TestEngineBatchCommit writes a batch containing 10K rows (all the
@@ -526,6 +528,39 @@ func TestEngineDeleteRange(t *testing.T) {
 	}, t)
 }
 
+func TestEngineClearRangeBatch(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	defer func(size int) { clearRangeChunkSize = size }(clearRangeChunkSize)
+	clearRangeChunkSize = 2
+	runWithAllEngines(func(engine Engine, t *testing.T) {
+		keys := []MVCCKey{
+			MVCCKey("a"),
+			MVCCKey("aa"),
+			MVCCKey("aaa"),
+			MVCCKey("ab"),
+			MVCCKey("abc"),
+		}
+		insertKeys(keys, engine, t)
+
+		// The entries are removed in several chunks, and only once the batch
+		// is committed.
+		b := engine.NewBatch()
+		defer b.Close()
+		numDeleted, err := ClearRange(b, MVCCKey("aa"), MVCCKey(roachpb.RKeyMax))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if numDeleted != 4 {
+			t.Errorf("Expected to delete 4 entries; was %v", numDeleted)
+		}
+		verifyScan(MVCCKey(roachpb.RKeyMin), MVCCKey(roachpb.RKeyMax), 10, keys, engine, t)
+		if err := b.Commit(); err != nil {
+			t.Fatal(err)
+		}
+		verifyScan(MVCCKey(roachpb.RKeyMin), MVCCKey(roachpb.RKeyMax), 10, keys[:1], engine, t)
+	}, t)
+}
+
 func TestSnapshot(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	runWithAllEngines(func(engine Engine, t *testing.T) {
@@ -595,7 +630,7 @@ func TestSnapshotMethods(t *testing.T) {
 		// Verify Attrs.
 		var attrs roachpb.Attributes
 		switch engine.(type) {
-		case InMem:
+		case InMem, *GoInMem:
 			attrs = inMemAttrs
 		}
 		if !reflect.DeepEqual(engine.Attrs(), attrs) {
@@ -28,15 +28,19 @@ import (
 // versions and maximum age.
 type GarbageCollector struct {
 	expiration roachpb.Timestamp
+	protected  roachpb.Timestamp
 	policy     config.GCPolicy
 }
 
 // NewGarbageCollector allocates and returns a new GC, with expiration
-// computed based on current time and policy.TTLSeconds.
-func NewGarbageCollector(now roachpb.Timestamp, policy config.GCPolicy) *GarbageCollector {
+// computed based on current time and policy.TTLSeconds. Unless the
+// protected timestamp is zero, the versions needed to read at it are
+// never garbage collected.
+func NewGarbageCollector(now, protected roachpb.Timestamp, policy config.GCPolicy) *GarbageCollector {
 	ttlNanos := int64(policy.TTLSeconds) * 1E9
 	return &GarbageCollector{
 		expiration: roachpb.Timestamp{WallTime: now.WallTime - ttlNanos},
+		protected:  protected,
 		policy:     policy,
 	}
 }
@@ -57,6 +61,7 @@ func (gc *GarbageCollector) Filter(keys []MVCCKey, values [][]byte) roachpb.Time
 	// Loop over values. All should be MVCC versions.
 	delTS := roachpb.ZeroTimestamp
 	survivors := false
+	protecting := !gc.protected.Equal(roachpb.ZeroTimestamp)
 	for i, key := range keys {
 		_, ts, isValue, err := MVCCDecodeKey(key)
 		if err != nil {
@@ -68,6 +73,15 @@ func (gc *GarbageCollector) Filter(keys []MVCCKey, values [][]byte) roachpb.Time
 			return roachpb.ZeroTimestamp
 		}
 		deleted := len(values[i]) == 0
+		// The versions newer than the protected timestamp and the version
+		// visible at it are needed to read at the protected timestamp.
+		if protecting {
+			protecting = gc.protected.Less(ts)
+			if !deleted {
+				survivors = true
+			}
+			continue
+		}
 		if i == 0 {
 			// If the first value isn't a deletion tombstone, don't consider
 			// it for GC. It should always survive if non-deleted.
@@ -98,3 +112,10 @@ func (gc *GarbageCollector) Filter(keys []MVCCKey, values [][]byte) roachpb.Time
 	}
 	return delTS
 }
+
+// Expired returns whether the given timestamp is older than the GC
+// expiration. A deletion written at an expired timestamp may be garbage
+// collected once the versions it deletes are gone.
+func (gc *GarbageCollector) Expired(ts roachpb.Timestamp) bool {
+	return gc.policy.TTLSeconds > 0 && ts.Less(gc.expiration)
+}
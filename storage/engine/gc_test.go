@@ -55,8 +55,12 @@ func serializedValue(deleted bool, t *testing.T) []byte {
 // different sorts of MVCC keys.
 func TestGarbageCollectorFilter(t *testing.T) {
 	defer leaktest.AfterTest(t)
-	gcA := NewGarbageCollector(makeTS(0, 0), config.GCPolicy{TTLSeconds: 1})
-	gcB := NewGarbageCollector(makeTS(0, 0), config.GCPolicy{TTLSeconds: 2})
+	gcA := NewGarbageCollector(makeTS(0, 0), roachpb.ZeroTimestamp, config.GCPolicy{TTLSeconds: 1})
+	gcB := NewGarbageCollector(makeTS(0, 0), roachpb.ZeroTimestamp, config.GCPolicy{TTLSeconds: 2})
+	// gcC protects the versions needed to read at 1.5s, and gcD those
+	// needed to read at 1s.
+	gcC := NewGarbageCollector(makeTS(0, 0), makeTS(1.5E9, 0), config.GCPolicy{TTLSeconds: 1})
+	gcD := NewGarbageCollector(makeTS(0, 0), makeTS(1E9, 0), config.GCPolicy{TTLSeconds: 1})
 	n := serializedValue(false, t)
 	d := serializedValue(true, t)
 	testData := []struct {
@@ -83,6 +87,12 @@ func TestGarbageCollectorFilter(t *testing.T) {
 		{gcA, makeTS(5E9, 0), aKeys, [][]byte{n, n, n}, makeTS(1E9, 1)},
 		{gcB, makeTS(5E9, 0), bKeys, [][]byte{n, n}, makeTS(1E9, 0)},
 		{gcB, makeTS(5E9, 0), bKeys, [][]byte{d, n}, makeTS(2E9, 0)},
+		{gcC, makeTS(5E9, 0), aKeys, [][]byte{n, n, n}, makeTS(1E9, 0)},
+		{gcC, makeTS(5E9, 0), aKeys, [][]byte{d, n, n}, makeTS(1E9, 0)},
+		{gcC, makeTS(5E9, 0), aKeys, [][]byte{d, d, n}, makeTS(2E9, 0)},
+		{gcC, makeTS(5E9, 0), aKeys, [][]byte{d, d, d}, makeTS(2E9, 0)},
+		{gcD, makeTS(5E9, 0), aKeys, [][]byte{n, n, n}, roachpb.ZeroTimestamp},
+		{gcD, makeTS(5E9, 0), bKeys, [][]byte{d, n}, roachpb.ZeroTimestamp},
 	}
 	for i, test := range testData {
 		test.gc.expiration = test.time
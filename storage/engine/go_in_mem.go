@@ -0,0 +1,1013 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package engine
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	"github.com/biogo/store/llrb"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/encoding"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/stop"
+	"github.com/gogo/protobuf/proto"
+)
+
+// memKV is a key/value pair stored in the ordered tree of a GoInMem
+// engine. Stored pairs are never modified: writes replace them.
+type memKV struct {
+	key   MVCCKey
+	value []byte
+}
+
+// Compare implements the llrb.Comparable interface.
+func (kv *memKV) Compare(b llrb.Comparable) int {
+	return bytes.Compare(kv.key, b.(*memKV).key)
+}
+
+// memReader is the read interface of a GoInMem engine, its snapshots and
+// its batches, on top of which their iterators are built. The returned
+// key/value pairs share memory with the reader and must not be modified.
+type memReader interface {
+	// seekGE returns the first key/value pair with a key >= the provided
+	// key, if any.
+	seekGE(key MVCCKey) (MVCCKeyValue, bool, error)
+	// seekLT returns the last key/value pair with a key < the provided
+	// key, if any. An empty key returns the last key/value pair.
+	seekLT(key MVCCKey) (MVCCKeyValue, bool, error)
+}
+
+// treeReader implements memReader on a tree which isn't modified anymore.
+type treeReader struct {
+	tree *llrb.Tree
+}
+
+func (r treeReader) seekGE(key MVCCKey) (MVCCKeyValue, bool, error) {
+	kv, ok := treeSeekGE(r.tree, key)
+	return kv, ok, nil
+}
+
+func (r treeReader) seekLT(key MVCCKey) (MVCCKeyValue, bool, error) {
+	kv, ok := treeSeekLT(r.tree, key)
+	return kv, ok, nil
+}
+
+func treeGet(tree *llrb.Tree, key MVCCKey) ([]byte, bool) {
+	if kv, ok := tree.Get(&memKV{key: key}).(*memKV); ok {
+		return kv.value, true
+	}
+	return nil, false
+}
+
+func treeSeekGE(tree *llrb.Tree, key MVCCKey) (MVCCKeyValue, bool) {
+	if kv, ok := tree.Ceil(&memKV{key: key}).(*memKV); ok {
+		return MVCCKeyValue{Key: kv.key, Value: kv.value}, true
+	}
+	return MVCCKeyValue{}, false
+}
+
+func treeSeekLT(tree *llrb.Tree, key MVCCKey) (MVCCKeyValue, bool) {
+	if len(key) == 0 {
+		if kv, ok := tree.Max().(*memKV); ok {
+			return MVCCKeyValue{Key: kv.key, Value: kv.value}, true
+		}
+		return MVCCKeyValue{}, false
+	}
+	// The llrb package only provides Floor, which includes the key itself.
+	var last *memKV
+	for n := tree.Root; n != nil; {
+		kv := n.Elem.(*memKV)
+		if kv.key.Less(key) {
+			last = kv
+			n = n.Right
+		} else {
+			n = n.Left
+		}
+	}
+	if last == nil {
+		return MVCCKeyValue{}, false
+	}
+	return MVCCKeyValue{Key: last.key, Value: last.value}, true
+}
+
+// cloneTree returns a copy of the tree sharing its key/value pairs.
+func cloneTree(tree *llrb.Tree) *llrb.Tree {
+	var cloneNode func(n *llrb.Node) *llrb.Node
+	cloneNode = func(n *llrb.Node) *llrb.Node {
+		if n == nil {
+			return nil
+		}
+		c := *n
+		c.Left = cloneNode(n.Left)
+		c.Right = cloneNode(n.Right)
+		return &c
+	}
+	return &llrb.Tree{Root: cloneNode(tree.Root), Count: tree.Count}
+}
+
+// GoInMem is a pure Go in-memory engine, which keeps key/value pairs in
+// an ordered tree. It doesn't require cgo, and is intended for tests and
+// for embedding a store in tools.
+//
+// Snapshots and iterators pin the current tree, which is copied by the
+// next write as long as it is pinned. Merges are computed when written,
+// using the same semantics as the RocksDB merge operator. Transaction
+// records aren't garbage collected, as there are no compactions.
+type GoInMem struct {
+	attrs   roachpb.Attributes
+	size    int64
+	stopper *stop.Stopper
+
+	mu    sync.RWMutex
+	tree  *llrb.Tree // Nil until opened.
+	pins  int        // Number of snapshots and iterators pinning tree.
+	bytes int64      // Total size of the keys and values in tree.
+}
+
+// NewGoInMem allocates and returns a new, opened GoInMem engine, with a
+// capacity of size bytes.
+func NewGoInMem(attrs roachpb.Attributes, size int64, stopper *stop.Stopper) *GoInMem {
+	e := &GoInMem{
+		attrs:   attrs,
+		size:    size,
+		stopper: stopper,
+	}
+	if err := e.Open(); err != nil {
+		panic(err)
+	}
+	return e
+}
+
+// String formatter.
+func (e *GoInMem) String() string {
+	return fmt.Sprintf("%s=gomem:%d", e.attrs.Attrs, e.size)
+}
+
+// Open initializes the engine. Subsequent calls are noops.
+func (e *GoInMem) Open() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.tree != nil {
+		return nil
+	}
+	e.tree = &llrb.Tree{}
+	e.stopper.AddCloser(e)
+	return nil
+}
+
+// Close releases the key/value pairs. Open snapshots keep the pairs they
+// pin.
+func (e *GoInMem) Close() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.tree == nil {
+		log.Errorf("closing unopened go in-memory engine")
+		return
+	}
+	e.tree = nil
+	e.pins = 0
+	e.bytes = 0
+}
+
+// Attrs returns the list of attributes describing this engine.
+func (e *GoInMem) Attrs() roachpb.Attributes {
+	return e.attrs
+}
+
+// mutableTree returns the tree to modify, copying it first if it is
+// pinned. Requires mu to be held for writing.
+func (e *GoInMem) mutableTree() *llrb.Tree {
+	if e.pins > 0 {
+		e.tree = cloneTree(e.tree)
+		e.pins = 0
+	}
+	return e.tree
+}
+
+// pin returns the current tree, which won't be modified until unpin is
+// called.
+func (e *GoInMem) pin() *llrb.Tree {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.pins++
+	return e.tree
+}
+
+func (e *GoInMem) unpin(tree *llrb.Tree) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.tree == tree {
+		e.pins--
+	}
+}
+
+// putLocked sets key to value, or removes key if value is nil. Requires
+// mu to be held for writing.
+func (e *GoInMem) putLocked(key MVCCKey, value []byte) {
+	tree := e.mutableTree()
+	if old, ok := treeGet(tree, key); ok {
+		e.bytes -= int64(len(key) + len(old))
+		if value == nil {
+			tree.Delete(&memKV{key: key})
+			return
+		}
+	} else if value == nil {
+		return
+	}
+	e.bytes += int64(len(key) + len(value))
+	tree.Insert(&memKV{key: key, value: value})
+}
+
+// Put sets the given key to the value provided. The key and value byte
+// slices may be reused safely.
+func (e *GoInMem) Put(key MVCCKey, value []byte) error {
+	if len(key) == 0 {
+		return emptyKeyError()
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.putLocked(append(MVCCKey(nil), key...), append([]byte{}, value...))
+	return nil
+}
+
+// Merge merges value into the existing value of key. See Engine.Merge
+// for details.
+func (e *GoInMem) Merge(key MVCCKey, value []byte) error {
+	if len(key) == 0 {
+		return emptyKeyError()
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	existing, _ := treeGet(e.tree, key)
+	merged, err := mergeValues(existing, value)
+	if err != nil {
+		return err
+	}
+	e.putLocked(append(MVCCKey(nil), key...), merged)
+	return nil
+}
+
+// get returns the value for the given key, which must not be modified.
+func (e *GoInMem) get(key MVCCKey) ([]byte, bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return treeGet(e.tree, key)
+}
+
+// Get returns the value for the given key, nil otherwise.
+func (e *GoInMem) Get(key MVCCKey) ([]byte, error) {
+	return memGet(e.get, key)
+}
+
+// GetProto fetches the value at the specified key and unmarshals it.
+func (e *GoInMem) GetProto(key MVCCKey, msg proto.Message) (
+	ok bool, keyBytes, valBytes int64, err error) {
+	return memGetProto(e.get, key, msg)
+}
+
+// Iterate iterates from start to end keys, invoking f on each
+// key/value pair. See engine.Iterate for details.
+func (e *GoInMem) Iterate(start, end MVCCKey, f func(MVCCKeyValue) (bool, error)) error {
+	return memIterate(e.NewIterator(), start, end, f)
+}
+
+// Clear removes the item from the engine with the given key.
+func (e *GoInMem) Clear(key MVCCKey) error {
+	if len(key) == 0 {
+		return emptyKeyError()
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.putLocked(key, nil)
+	return nil
+}
+
+// Capacity returns the size of the engine as its capacity, and the size
+// not used by the keys and values as available.
+func (e *GoInMem) Capacity() (roachpb.StoreCapacity, error) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	capacity := roachpb.StoreCapacity{
+		Capacity:  e.size,
+		Available: e.size - e.bytes,
+	}
+	if capacity.Available < 0 {
+		capacity.Available = 0
+	}
+	return capacity, nil
+}
+
+// SetGCTimeouts is a noop, as there are no compactions to garbage collect
+// transaction records.
+func (e *GoInMem) SetGCTimeouts(minTxnTS int64) {
+}
+
+// ApproximateSize returns the size of the keys and values in the given
+// range of keys.
+func (e *GoInMem) ApproximateSize(start, end MVCCKey) (uint64, error) {
+	var size uint64
+	err := e.Iterate(start, end, func(kv MVCCKeyValue) (bool, error) {
+		size += uint64(len(kv.Key) + len(kv.Value))
+		return false, nil
+	})
+	return size, err
+}
+
+// Flush is a noop.
+func (e *GoInMem) Flush() error {
+	return nil
+}
+
+// GetStats returns empty stats; the in-memory engine does not collect
+// any.
+func (e *GoInMem) GetStats() (*Stats, error) {
+	return &Stats{}, nil
+}
+
+// NewIterator returns an iterator over the key/value pairs of the engine
+// at the time of the call.
+func (e *GoInMem) NewIterator() Iterator {
+	tree := e.pin()
+	return &goInMemIterator{
+		reader:  treeReader{tree: tree},
+		release: func() { e.unpin(tree) },
+	}
+}
+
+// NewSnapshot returns a read-only snapshot of the engine.
+func (e *GoInMem) NewSnapshot() Engine {
+	tree := e.pin()
+	if tree == nil {
+		panic("GoInMem is not initialized yet")
+	}
+	return &goInMemSnapshot{
+		parent: e,
+		tree:   tree,
+	}
+}
+
+// NewBatch returns a new batch wrapping this engine.
+func (e *GoInMem) NewBatch() Engine {
+	return newGoInMemBatch(e)
+}
+
+// Commit is a noop for GoInMem engine.
+func (e *GoInMem) Commit() error {
+	return nil
+}
+
+// Defer is not implemented for GoInMem engine.
+func (e *GoInMem) Defer(func()) {
+	panic("only implemented for goInMemBatch")
+}
+
+func memGet(get func(MVCCKey) ([]byte, bool), key MVCCKey) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, emptyKeyError()
+	}
+	value, ok := get(key)
+	if !ok {
+		return nil, nil
+	}
+	return append([]byte{}, value...), nil
+}
+
+func memGetProto(get func(MVCCKey) ([]byte, bool), key MVCCKey,
+	msg proto.Message) (ok bool, keyBytes, valBytes int64, err error) {
+	if len(key) == 0 {
+		err = emptyKeyError()
+		return
+	}
+	value, _ := get(key)
+	if len(value) == 0 {
+		if msg != nil {
+			msg.Reset()
+		}
+		return
+	}
+	ok = true
+	if msg != nil {
+		err = proto.Unmarshal(value, msg)
+	}
+	keyBytes = int64(len(key))
+	valBytes = int64(len(value))
+	return
+}
+
+func memIterate(it Iterator, start, end MVCCKey, f func(MVCCKeyValue) (bool, error)) error {
+	defer it.Close()
+	if bytes.Compare(start, end) >= 0 {
+		return nil
+	}
+	for it.Seek(start); it.Valid(); it.Next() {
+		k := it.Key()
+		if !k.Less(end) {
+			break
+		}
+		if done, err := f(MVCCKeyValue{Key: k, Value: it.Value()}); done || err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
+type goInMemSnapshot struct {
+	parent *GoInMem
+	tree   *llrb.Tree
+}
+
+// Open is a noop.
+func (s *goInMemSnapshot) Open() error {
+	return nil
+}
+
+// Close releases the snapshot.
+func (s *goInMemSnapshot) Close() {
+	if s.tree != nil {
+		s.parent.unpin(s.tree)
+		s.tree = nil
+	}
+}
+
+// Attrs returns the engine/store attributes.
+func (s *goInMemSnapshot) Attrs() roachpb.Attributes {
+	return s.parent.Attrs()
+}
+
+// Put is illegal for snapshot and returns an error.
+func (s *goInMemSnapshot) Put(key MVCCKey, value []byte) error {
+	return util.Errorf("cannot Put to a snapshot")
+}
+
+func (s *goInMemSnapshot) get(key MVCCKey) ([]byte, bool) {
+	return treeGet(s.tree, key)
+}
+
+// Get returns the value for the given key, nil otherwise.
+func (s *goInMemSnapshot) Get(key MVCCKey) ([]byte, error) {
+	return memGet(s.get, key)
+}
+
+// GetProto fetches the value at the specified key and unmarshals it.
+func (s *goInMemSnapshot) GetProto(key MVCCKey, msg proto.Message) (
+	ok bool, keyBytes, valBytes int64, err error) {
+	return memGetProto(s.get, key, msg)
+}
+
+// Iterate iterates over the keys between start inclusive and end
+// exclusive, invoking f() on each key/value pair.
+func (s *goInMemSnapshot) Iterate(start, end MVCCKey, f func(MVCCKeyValue) (bool, error)) error {
+	return memIterate(s.NewIterator(), start, end, f)
+}
+
+// Clear is illegal for snapshot and returns an error.
+func (s *goInMemSnapshot) Clear(key MVCCKey) error {
+	return util.Errorf("cannot Clear from a snapshot")
+}
+
+// Merge is illegal for snapshot and returns an error.
+func (s *goInMemSnapshot) Merge(key MVCCKey, value []byte) error {
+	return util.Errorf("cannot Merge to a snapshot")
+}
+
+// Capacity returns capacity details for the engine's available storage.
+func (s *goInMemSnapshot) Capacity() (roachpb.StoreCapacity, error) {
+	return s.parent.Capacity()
+}
+
+// SetGCTimeouts is a noop for a snapshot.
+func (s *goInMemSnapshot) SetGCTimeouts(minTxnTS int64) {
+}
+
+// ApproximateSize returns the approximate number of bytes the engine is
+// using to store data for the given range of keys.
+func (s *goInMemSnapshot) ApproximateSize(start, end MVCCKey) (uint64, error) {
+	return s.parent.ApproximateSize(start, end)
+}
+
+// Flush is a no-op for snapshots.
+func (s *goInMemSnapshot) Flush() error {
+	return nil
+}
+
+// GetStats returns the stats of the underlying engine.
+func (s *goInMemSnapshot) GetStats() (*Stats, error) {
+	return s.parent.GetStats()
+}
+
+// NewIterator returns a new instance of an Iterator over the snapshot.
+func (s *goInMemSnapshot) NewIterator() Iterator {
+	return &goInMemIterator{reader: treeReader{tree: s.tree}}
+}
+
+// NewSnapshot is illegal for snapshot.
+func (s *goInMemSnapshot) NewSnapshot() Engine {
+	panic("cannot create a NewSnapshot from a snapshot")
+}
+
+// NewBatch is illegal for snapshot.
+func (s *goInMemSnapshot) NewBatch() Engine {
+	panic("cannot create a NewBatch from a snapshot")
+}
+
+// Commit is illegal for snapshot and returns an error.
+func (s *goInMemSnapshot) Commit() error {
+	return util.Errorf("cannot Commit to a snapshot")
+}
+
+// Defer is not implemented for goInMemSnapshot.
+func (s *goInMemSnapshot) Defer(func()) {
+	panic("only implemented for goInMemBatch")
+}
+
+// memBatchEntry is the pending update of a key in a goInMemBatch.
+type memBatchEntry struct {
+	key MVCCKey
+	// set is true if the key was put or cleared by the batch, in which case
+	// value replaces the value of the engine, and is nil if cleared.
+	set   bool
+	value []byte
+	// merges are the merge operands applied after value.
+	merges [][]byte
+}
+
+// Compare implements the llrb.Comparable interface.
+func (b *memBatchEntry) Compare(c llrb.Comparable) int {
+	return bytes.Compare(b.key, c.(*memBatchEntry).key)
+}
+
+// resolve returns the value of the entry on top of the base value of
+// its key, and false if the key is cleared.
+func (b *memBatchEntry) resolve(base []byte, baseOK bool) ([]byte, bool, error) {
+	value, ok := base, baseOK
+	if b.set {
+		value, ok = b.value, b.value != nil
+	}
+	for _, operand := range b.merges {
+		var err error
+		if value, err = mergeValues(value, operand); err != nil {
+			return nil, false, err
+		}
+		ok = true
+	}
+	return value, ok, nil
+}
+
+// batchReader implements memReader for the updates of a batch on top of
+// a base reader.
+type batchReader struct {
+	base    memReader
+	updates *llrb.Tree
+}
+
+func (r batchReader) seekGE(key MVCCKey) (MVCCKeyValue, bool, error) {
+	for {
+		kv, ok, err := r.base.seekGE(key)
+		if err != nil {
+			return MVCCKeyValue{}, false, err
+		}
+		u, uOK := r.updates.Ceil(&memBatchEntry{key: key}).(*memBatchEntry)
+		if !uOK || (ok && kv.Key.Less(u.key)) {
+			return kv, ok, nil
+		}
+		baseOK := ok && kv.Key.Equal(u.key)
+		value, ok, err := u.resolve(kv.Value, baseOK)
+		if err != nil || ok {
+			return MVCCKeyValue{Key: u.key, Value: value}, ok, err
+		}
+		// The key is cleared by the batch.
+		key = u.key.Next()
+	}
+}
+
+func (r batchReader) seekLT(key MVCCKey) (MVCCKeyValue, bool, error) {
+	for {
+		kv, ok, err := r.base.seekLT(key)
+		if err != nil {
+			return MVCCKeyValue{}, false, err
+		}
+		var u *memBatchEntry
+		if len(key) == 0 {
+			u, _ = r.updates.Max().(*memBatchEntry)
+		} else {
+			// The llrb package only provides Floor, which includes the key
+			// itself.
+			for n := r.updates.Root; n != nil; {
+				e := n.Elem.(*memBatchEntry)
+				if e.key.Less(key) {
+					u = e
+					n = n.Right
+				} else {
+					n = n.Left
+				}
+			}
+		}
+		if u == nil || (ok && u.key.Less(kv.Key)) {
+			return kv, ok, nil
+		}
+		baseOK := ok && kv.Key.Equal(u.key)
+		value, ok, err := u.resolve(kv.Value, baseOK)
+		if err != nil || ok {
+			return MVCCKeyValue{Key: u.key, Value: value}, ok, err
+		}
+		// The key is cleared by the batch.
+		key = u.key
+	}
+}
+
+// goInMemBatch accumulates the updates to a GoInMem engine, and applies
+// them atomically on commit.
+type goInMemBatch struct {
+	parent    *GoInMem
+	updates   llrb.Tree
+	defers    []func()
+	committed bool
+}
+
+func newGoInMemBatch(e *GoInMem) *goInMemBatch {
+	return &goInMemBatch{parent: e}
+}
+
+func (b *goInMemBatch) Open() error {
+	return util.Errorf("cannot open a batch")
+}
+
+func (b *goInMemBatch) Close() {
+}
+
+// Attrs returns the engine/store attributes.
+func (b *goInMemBatch) Attrs() roachpb.Attributes {
+	return b.parent.Attrs()
+}
+
+// entry returns the pending update of the key, creating it if needed.
+func (b *goInMemBatch) entry(key MVCCKey) *memBatchEntry {
+	if e, ok := b.updates.Get(&memBatchEntry{key: key}).(*memBatchEntry); ok {
+		return e
+	}
+	e := &memBatchEntry{key: append(MVCCKey(nil), key...)}
+	b.updates.Insert(e)
+	return e
+}
+
+func (b *goInMemBatch) Put(key MVCCKey, value []byte) error {
+	if len(key) == 0 {
+		return emptyKeyError()
+	}
+	e := b.entry(key)
+	e.set, e.value, e.merges = true, append([]byte{}, value...), nil
+	return nil
+}
+
+func (b *goInMemBatch) Merge(key MVCCKey, value []byte) error {
+	if len(key) == 0 {
+		return emptyKeyError()
+	}
+	e := b.entry(key)
+	e.merges = append(e.merges, append([]byte(nil), value...))
+	return nil
+}
+
+func (b *goInMemBatch) get(key MVCCKey) ([]byte, bool, error) {
+	base, baseOK := b.parent.get(key)
+	if e, ok := b.updates.Get(&memBatchEntry{key: key}).(*memBatchEntry); ok {
+		return e.resolve(base, baseOK)
+	}
+	return base, baseOK, nil
+}
+
+func (b *goInMemBatch) Get(key MVCCKey) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, emptyKeyError()
+	}
+	value, ok, err := b.get(key)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return append([]byte{}, value...), nil
+}
+
+func (b *goInMemBatch) GetProto(key MVCCKey, msg proto.Message) (
+	ok bool, keyBytes, valBytes int64, err error) {
+	if len(key) == 0 {
+		err = emptyKeyError()
+		return
+	}
+	var value []byte
+	if value, _, err = b.get(key); err != nil {
+		return
+	}
+	return memGetProto(func(MVCCKey) ([]byte, bool) { return value, true }, key, msg)
+}
+
+func (b *goInMemBatch) Iterate(start, end MVCCKey, f func(MVCCKeyValue) (bool, error)) error {
+	return memIterate(b.NewIterator(), start, end, f)
+}
+
+func (b *goInMemBatch) Clear(key MVCCKey) error {
+	if len(key) == 0 {
+		return emptyKeyError()
+	}
+	e := b.entry(key)
+	e.set, e.value, e.merges = true, nil, nil
+	return nil
+}
+
+func (b *goInMemBatch) Capacity() (roachpb.StoreCapacity, error) {
+	return b.parent.Capacity()
+}
+
+func (b *goInMemBatch) SetGCTimeouts(minTxnTS int64) {
+	// no-op
+}
+
+func (b *goInMemBatch) ApproximateSize(start, end MVCCKey) (uint64, error) {
+	return b.parent.ApproximateSize(start, end)
+}
+
+func (b *goInMemBatch) Flush() error {
+	return util.Errorf("cannot flush a batch")
+}
+
+func (b *goInMemBatch) GetStats() (*Stats, error) {
+	return b.parent.GetStats()
+}
+
+// NewIterator returns an iterator over the updates of the batch on top of
+// the key/value pairs of the engine at the time of the call.
+func (b *goInMemBatch) NewIterator() Iterator {
+	tree := b.parent.pin()
+	return &goInMemIterator{
+		reader:  batchReader{base: treeReader{tree: tree}, updates: &b.updates},
+		release: func() { b.parent.unpin(tree) },
+	}
+}
+
+func (b *goInMemBatch) NewSnapshot() Engine {
+	panic("cannot create a NewSnapshot from a batch")
+}
+
+func (b *goInMemBatch) NewBatch() Engine {
+	return newGoInMemBatch(b.parent)
+}
+
+// Commit applies the updates of the batch to the engine atomically: either
+// all the updates are applied, or none if a merge fails.
+func (b *goInMemBatch) Commit() error {
+	if b.committed {
+		panic("this batch was already committed")
+	}
+	e := b.parent
+	e.mu.Lock()
+	var values []memKV
+	var err error
+	b.updates.Do(func(c llrb.Comparable) bool {
+		u := c.(*memBatchEntry)
+		base, baseOK := treeGet(e.tree, u.key)
+		var value []byte
+		var ok bool
+		if value, ok, err = u.resolve(base, baseOK); err != nil {
+			return true
+		}
+		if !ok {
+			value = nil
+		} else if value == nil {
+			value = []byte{}
+		}
+		values = append(values, memKV{key: u.key, value: value})
+		return false
+	})
+	if err == nil {
+		for _, kv := range values {
+			e.putLocked(kv.key, kv.value)
+		}
+	}
+	e.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	b.committed = true
+	b.updates = llrb.Tree{}
+
+	// On success, run the deferred functions in reverse order.
+	for i := len(b.defers) - 1; i >= 0; i-- {
+		b.defers[i]()
+	}
+	b.defers = nil
+
+	return nil
+}
+
+func (b *goInMemBatch) Defer(fn func()) {
+	b.defers = append(b.defers, fn)
+}
+
+// goInMemIterator implements Iterator on a memReader. The iterator is
+// positioned by key, so that it can be used while the batch it iterates
+// over is modified.
+type goInMemIterator struct {
+	reader  memReader
+	release func()
+	valid   bool
+	kv      MVCCKeyValue
+	err     error
+}
+
+// The following methods implement the Iterator interface.
+func (it *goInMemIterator) Close() {
+	if it.release != nil {
+		it.release()
+		it.release = nil
+	}
+}
+
+func (it *goInMemIterator) setState(kv MVCCKeyValue, ok bool, err error) {
+	it.kv, it.valid, it.err = kv, ok && err == nil, err
+}
+
+func (it *goInMemIterator) Seek(key []byte) {
+	it.setState(it.reader.seekGE(key))
+}
+
+func (it *goInMemIterator) SeekReverse(key []byte) {
+	if len(key) != 0 {
+		kv, ok, err := it.reader.seekGE(key)
+		if err != nil || (ok && kv.Key.Equal(key)) {
+			it.setState(kv, ok, err)
+			return
+		}
+	}
+	it.setState(it.reader.seekLT(key))
+}
+
+func (it *goInMemIterator) Valid() bool {
+	return it.valid
+}
+
+func (it *goInMemIterator) Next() {
+	it.setState(it.reader.seekGE(it.kv.Key.Next()))
+}
+
+func (it *goInMemIterator) Prev() {
+	it.setState(it.reader.seekLT(it.kv.Key))
+}
+
+func (it *goInMemIterator) Key() MVCCKey {
+	return append(MVCCKey(nil), it.kv.Key...)
+}
+
+func (it *goInMemIterator) Value() []byte {
+	return append([]byte(nil), it.kv.Value...)
+}
+
+func (it *goInMemIterator) ValueProto(msg proto.Message) error {
+	if len(it.kv.Value) == 0 {
+		return nil
+	}
+	return proto.Unmarshal(it.kv.Value, msg)
+}
+
+func (it *goInMemIterator) unsafeKey() MVCCKey {
+	return it.kv.Key
+}
+
+func (it *goInMemIterator) unsafeValue() []byte {
+	return it.kv.Value
+}
+
+func (it *goInMemIterator) Error() error {
+	return it.err
+}
+
+// ComputeStats implements the Iterator interface.
+func (it *goInMemIterator) ComputeStats(ms *MVCCStats, start, end []byte, nowNanos int64) error {
+	if err := it.computeKeyStats(ms, start, end, nowNanos); err != nil {
+		return err
+	}
+	return computeRangeTombstoneStats(it, ms, roachpb.KeyMin, start, end, nowNanos)
+}
+
+// computeKeyStats implements the Iterator interface, following
+// MVCCComputeStats in db.cc.
+func (it *goInMemIterator) computeKeyStats(ms *MVCCStats, start, end []byte, nowNanos int64) error {
+	const mvccVersionTimestampSize = 12
+
+	var stats MVCCStats
+	// Ages are computed in floating point, and accumulated with truncation.
+	addAge := func(age *int64, bytes int64, wallTime int64) {
+		*age = int64(float64(*age) + float64(bytes)*(float64(nowNanos-wallTime)/1e9))
+	}
+	var meta MVCCMetadata
+	first := false
+
+	for it.Seek(start); it.Valid() && bytes.Compare(it.unsafeKey(), end) < 0; it.Next() {
+		key, value := it.unsafeKey(), it.unsafeValue()
+
+		buf, decoded, err := encoding.DecodeBytes(key, nil)
+		if err != nil {
+			return util.Errorf("unable to decode key")
+		}
+		isSys := bytes.Compare(decoded, keys.LocalMax) < 0
+		isValue := len(buf) == mvccVersionTimestampSize
+
+		if !isValue {
+			if len(buf) != 0 {
+				return util.Errorf("there should be %d bytes for encoded timestamp", mvccVersionTimestampSize)
+			}
+			totalBytes := int64(len(key) + len(value))
+			first = true
+			if err := proto.Unmarshal(value, &meta); err != nil {
+				return util.Errorf("unable to decode MVCCMetadata")
+			}
+			if isSys {
+				stats.SysBytes += totalBytes
+				stats.SysCount++
+			} else {
+				if !meta.Deleted {
+					stats.LiveBytes += totalBytes
+					stats.LiveCount++
+				} else {
+					addAge(&stats.GCBytesAge, totalBytes, meta.Timestamp.WallTime)
+				}
+				stats.KeyBytes += int64(len(key))
+				stats.ValBytes += int64(len(value))
+				stats.KeyCount++
+				if meta.Value != nil {
+					stats.ValCount++
+				}
+			}
+			continue
+		}
+
+		totalBytes := int64(len(value)) + mvccVersionTimestampSize
+		if isSys {
+			stats.SysBytes += totalBytes
+			continue
+		}
+		if first {
+			first = false
+			if !meta.Deleted {
+				stats.LiveBytes += totalBytes
+			} else {
+				addAge(&stats.GCBytesAge, totalBytes, meta.Timestamp.WallTime)
+			}
+			if meta.Txn != nil {
+				stats.IntentBytes += totalBytes
+				stats.IntentCount++
+				addAge(&stats.IntentAge, 1, meta.Timestamp.WallTime)
+			}
+			if meta.KeyBytes != mvccVersionTimestampSize {
+				return util.Errorf("expected mvcc metadata key bytes to equal %d; got %d",
+					mvccVersionTimestampSize, meta.KeyBytes)
+			}
+			if meta.ValBytes != int64(len(value)) {
+				return util.Errorf("expected mvcc metadata val bytes to equal %d; got %d",
+					len(value), meta.ValBytes)
+			}
+		} else {
+			_, wallTime, err := encoding.DecodeUint64Decreasing(buf)
+			if err != nil {
+				return util.Errorf("unable to decode mvcc timestamp")
+			}
+			addAge(&stats.GCBytesAge, totalBytes, int64(wallTime))
+		}
+		stats.KeyBytes += mvccVersionTimestampSize
+		stats.ValBytes += int64(len(value))
+		stats.ValCount++
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+
+	ms.LiveBytes += stats.LiveBytes
+	ms.KeyBytes += stats.KeyBytes
+	ms.ValBytes += stats.ValBytes
+	ms.IntentBytes += stats.IntentBytes
+	ms.LiveCount += stats.LiveCount
+	ms.KeyCount += stats.KeyCount
+	ms.ValCount += stats.ValCount
+	ms.IntentCount += stats.IntentCount
+	ms.IntentAge += stats.IntentAge
+	ms.GCBytesAge += stats.GCBytesAge
+	ms.SysBytes += stats.SysBytes
+	ms.SysCount += stats.SysCount
+	ms.LastUpdateNanos = nowNanos
+	return nil
+}
@@ -0,0 +1,195 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package engine
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/randutil"
+	"github.com/cockroachdb/cockroach/util/stop"
+)
+
+// reverseScan returns all the key/value pairs of the engine in reverse
+// order, using SeekReverse and Prev.
+func reverseScan(e Engine, t *testing.T) []MVCCKeyValue {
+	iter := e.NewIterator()
+	defer iter.Close()
+	var kvs []MVCCKeyValue
+	for iter.SeekReverse(nil); iter.Valid(); iter.Prev() {
+		kvs = append(kvs, MVCCKeyValue{Key: iter.Key(), Value: iter.Value()})
+	}
+	if err := iter.Error(); err != nil {
+		t.Fatal(err)
+	}
+	return kvs
+}
+
+// TestGoInMemEquivalence applies the same random sequence of MVCC
+// operations, directly and through batches, to a RocksDB and a GoInMem
+// engine, and verifies that both engines end up with the same key/value
+// pairs and compute the same stats.
+func TestGoInMemEquivalence(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	rng, seed := randutil.NewPseudoRand()
+	log.Infof("using pseudo random number generator with seed %d", seed)
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+	engines := []Engine{
+		NewInMem(inMemAttrs, testCacheSize, stopper),
+		NewGoInMem(inMemAttrs, testCacheSize, stopper),
+	}
+
+	var keys []roachpb.Key
+	for i := 0; i < 500; i++ {
+		ts := makeTS(int64(i+1)*1E9, 0)
+		key := roachpb.Key(fmt.Sprintf("%s-%d", randutil.RandBytes(rng, int(rng.Int31n(8))), i))
+		if len(keys) > 0 && rng.Int31n(2) == 0 {
+			key = keys[rng.Int31n(int32(len(keys)))]
+		} else {
+			keys = append(keys, key)
+		}
+		var txn *roachpb.Transaction
+		if rng.Int31n(3) == 0 {
+			txn = &roachpb.Transaction{ID: []byte(fmt.Sprintf("txn-%d", i)), Timestamp: ts}
+		}
+		value := roachpb.MakeValueFromBytes(randutil.RandBytes(rng, int(rng.Int31n(64))))
+		resolveTxn := &roachpb.Transaction{
+			ID:        []byte(fmt.Sprintf("txn-%d", rng.Int31n(int32(i+1)))),
+			Status:    roachpb.COMMITTED,
+			Timestamp: ts,
+		}
+		op := rng.Int31n(4)
+		useBatch := rng.Int31n(2) == 0
+
+		var errs []error
+		for _, e := range engines {
+			rw := e
+			if useBatch {
+				rw = e.NewBatch()
+			}
+			var err error
+			switch op {
+			case 0:
+				err = MVCCPut(rw, nil, key, ts, value, txn)
+			case 1:
+				err = MVCCDelete(rw, nil, key, ts, txn)
+			case 2:
+				err = MVCCMerge(rw, nil, append(roachpb.Key("merge-"), key...), value)
+			case 3:
+				_, err = MVCCResolveWriteIntentRange(rw, nil, roachpb.KeyMin, roachpb.KeyMax, 0, ts, resolveTxn)
+			}
+			if useBatch {
+				if err == nil {
+					err = rw.Commit()
+				}
+				rw.Close()
+			}
+			errs = append(errs, err)
+		}
+		if fmt.Sprint(errs[0]) != fmt.Sprint(errs[1]) {
+			t.Fatalf("%d: op %d: errors differ: %v != %v", i, op, errs[0], errs[1])
+		}
+
+		if i%50 == 0 {
+			var kvs, reverseKVs [][]MVCCKeyValue
+			var stats []MVCCStats
+			for _, e := range engines {
+				scanned, err := Scan(e, MVCCKey(roachpb.RKeyMin), MVCCKey(roachpb.RKeyMax), 0)
+				if err != nil {
+					t.Fatal(err)
+				}
+				kvs = append(kvs, scanned)
+				reverseKVs = append(reverseKVs, reverseScan(e, t))
+
+				var ms MVCCStats
+				iter := e.NewIterator()
+				err = iter.ComputeStats(&ms, roachpb.KeyMin, roachpb.KeyMax, ts.WallTime)
+				iter.Close()
+				if err != nil {
+					t.Fatal(err)
+				}
+				stats = append(stats, ms)
+			}
+			if !reflect.DeepEqual(kvs[0], kvs[1]) {
+				t.Fatalf("%d: key/values differ:\n%v\n%v", i, kvs[0], kvs[1])
+			}
+			if !reflect.DeepEqual(reverseKVs[0], reverseKVs[1]) {
+				t.Fatalf("%d: reverse key/values differ:\n%v\n%v", i, reverseKVs[0], reverseKVs[1])
+			}
+			if !reflect.DeepEqual(stats[0], stats[1]) {
+				t.Fatalf("%d: stats differ:\n%+v\n%+v", i, stats[0], stats[1])
+			}
+		}
+	}
+}
+
+// TestGoInMemSnapshotPinning verifies that snapshots and iterators keep
+// the key/value pairs of the time they were created, while the engine
+// is modified.
+func TestGoInMemSnapshotPinning(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+	e := NewGoInMem(inMemAttrs, testCacheSize, stopper)
+
+	if err := e.Put(MVCCKey("a"), []byte("1")); err != nil {
+		t.Fatal(err)
+	}
+	snap := e.NewSnapshot()
+	defer snap.Close()
+	iter := e.NewIterator()
+	defer iter.Close()
+
+	if err := e.Put(MVCCKey("a"), []byte("2")); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Put(MVCCKey("b"), []byte("3")); err != nil {
+		t.Fatal(err)
+	}
+
+	expKVs := []MVCCKeyValue{{Key: MVCCKey("a"), Value: []byte("1")}}
+	kvs, err := Scan(snap, MVCCKey(roachpb.RKeyMin), MVCCKey(roachpb.RKeyMax), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(kvs, expKVs) {
+		t.Errorf("expected snapshot %v; got %v", expKVs, kvs)
+	}
+	kvs = nil
+	for iter.Seek(nil); iter.Valid(); iter.Next() {
+		kvs = append(kvs, MVCCKeyValue{Key: iter.Key(), Value: iter.Value()})
+	}
+	if !reflect.DeepEqual(kvs, expKVs) {
+		t.Errorf("expected iterator %v; got %v", expKVs, kvs)
+	}
+
+	expKVs = []MVCCKeyValue{
+		{Key: MVCCKey("a"), Value: []byte("2")},
+		{Key: MVCCKey("b"), Value: []byte("3")},
+	}
+	kvs, err = Scan(e, MVCCKey(roachpb.RKeyMin), MVCCKey(roachpb.RKeyMax), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(kvs, expKVs) {
+		t.Errorf("expected engine %v; got %v", expKVs, kvs)
+	}
+}
@@ -18,7 +18,11 @@
 package engine
 
 import (
+	"math"
+	"sort"
+
 	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util"
 	"github.com/gogo/protobuf/proto"
 )
 
@@ -67,3 +71,179 @@ func MergeInternalTimeSeriesData(sources ...*roachpb.InternalTimeSeriesData) (
 	}
 	return &mergedTS, nil
 }
+
+// dblMin is the C++ numeric_limits<double>::min(), which db.cc uses as the
+// maximum of a time series sample without any measurement.
+const dblMin = 2.2250738585072014e-308
+
+// mergeValues is the pure Go counterpart of the C++ merge operator in
+// db.cc: it merges the marshalled MVCCMetadata update into the marshalled
+// MVCCMetadata existing, which may be nil, and returns the marshalled
+// result. Byte slices are concatenated, and time series observations are
+// sorted and combined by offset.
+func mergeValues(existing, update []byte) ([]byte, error) {
+	var meta, updateMeta MVCCMetadata
+	if err := proto.Unmarshal(existing, &meta); err != nil {
+		return nil, util.Errorf("corrupted existing value: %s", err)
+	}
+	if err := proto.Unmarshal(update, &updateMeta); err != nil {
+		return nil, util.Errorf("corrupted update value: %s", err)
+	}
+	if meta.Value == nil {
+		meta.Value = &roachpb.Value{}
+	}
+	right := updateMeta.Value
+	if right == nil {
+		right = &roachpb.Value{}
+	}
+	if err := mergeValue(meta.Value, right); err != nil {
+		return nil, util.Errorf("%s: existing=%q, update=%q", err, existing, update)
+	}
+	return proto.Marshal(&meta)
+}
+
+func isTimeSeriesData(v *roachpb.Value) bool {
+	return v.Tag == roachpb.ValueType_TIMESERIES
+}
+
+// mergeValue merges right into left, following MergeValues in db.cc
+// for a full merge.
+func mergeValue(left, right *roachpb.Value) error {
+	if left.RawBytes == nil {
+		*left = *right
+		if isTimeSeriesData(left) {
+			// Errors are ignored by db.cc as well, leaving the value as is.
+			_ = consolidateTimeSeriesValue(left)
+		}
+		return nil
+	}
+	if right.RawBytes == nil {
+		return util.Errorf("inconsistent value types for merge (left = bytes, right = ?)")
+	}
+	if isTimeSeriesData(left) || isTimeSeriesData(right) {
+		if !isTimeSeriesData(left) || !isTimeSeriesData(right) {
+			return util.Errorf("inconsistent value types for merging time series data (type(left) != type(right))")
+		}
+		return mergeTimeSeriesValues(left, right)
+	}
+	left.RawBytes = append(left.RawBytes, right.RawBytes...)
+	return nil
+}
+
+// timeSeriesSamples sorts time series samples by offset. The sort is stable
+// so that samples with the same offset are accumulated in their original
+// order.
+type timeSeriesSamples []*roachpb.InternalTimeSeriesSample
+
+func (s timeSeriesSamples) Len() int           { return len(s) }
+func (s timeSeriesSamples) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s timeSeriesSamples) Less(i, j int) bool { return s[i].Offset < s[j].Offset }
+
+// sampleMax and sampleMin return the extrema of a sample. A sample without
+// any accumulated measurement has no sum.
+func sampleMax(s *roachpb.InternalTimeSeriesSample, hasSum bool) float64 {
+	if s.Max != nil {
+		return *s.Max
+	}
+	if hasSum {
+		return s.Sum
+	}
+	return dblMin
+}
+
+func sampleMin(s *roachpb.InternalTimeSeriesSample, hasSum bool) float64 {
+	if s.Min != nil {
+		return *s.Min
+	}
+	if hasSum {
+		return s.Sum
+	}
+	return math.MaxFloat64
+}
+
+// accumulateTimeSeriesSamples accumulates src, which has the same offset,
+// into dest.
+func accumulateTimeSeriesSamples(dest, src *roachpb.InternalTimeSeriesSample) {
+	totalCount := dest.Count + src.Count
+	if totalCount > 1 {
+		// Keep explicit max and min values.
+		dest.Max = proto.Float64(math.Max(sampleMax(dest, dest.Count > 0), sampleMax(src, true)))
+		dest.Min = proto.Float64(math.Min(sampleMin(dest, dest.Count > 0), sampleMin(src, true)))
+	}
+	if totalCount > 0 {
+		dest.Sum += src.Sum
+	}
+	dest.Count = totalCount
+}
+
+// mergeTimeSeriesSamples merges the samples of left, which are sorted, and
+// right into a new sorted slice of samples, accumulating the samples which
+// have the same offset.
+func mergeTimeSeriesSamples(left, right []*roachpb.InternalTimeSeriesSample) []*roachpb.InternalTimeSeriesSample {
+	sort.Stable(timeSeriesSamples(right))
+	var merged []*roachpb.InternalTimeSeriesSample
+	for len(left) > 0 || len(right) > 0 {
+		// Select the lowest offset from either side.
+		var offset int32
+		if len(left) == 0 {
+			offset = right[0].Offset
+		} else if len(right) == 0 || left[0].Offset <= right[0].Offset {
+			offset = left[0].Offset
+		} else {
+			offset = right[0].Offset
+		}
+		ns := &roachpb.InternalTimeSeriesSample{Offset: offset}
+		for len(left) > 0 && left[0].Offset == offset {
+			accumulateTimeSeriesSamples(ns, left[0])
+			left = left[1:]
+		}
+		for len(right) > 0 && right[0].Offset == offset {
+			accumulateTimeSeriesSamples(ns, right[0])
+			right = right[1:]
+		}
+		merged = append(merged, ns)
+	}
+	return merged
+}
+
+// mergeTimeSeriesValues merges two values which contain
+// InternalTimeSeriesData with the same start timestamp and sample duration.
+func mergeTimeSeriesValues(left, right *roachpb.Value) error {
+	leftTS, err := left.GetTimeseries()
+	if err != nil {
+		return util.Errorf("left InternalTimeSeriesData could not be parsed from bytes")
+	}
+	rightTS, err := right.GetTimeseries()
+	if err != nil {
+		return util.Errorf("right InternalTimeSeriesData could not be parsed from bytes")
+	}
+	if leftTS.StartTimestampNanos != rightTS.StartTimestampNanos {
+		return util.Errorf("TimeSeries merge failed due to mismatched start timestamps")
+	}
+	if leftTS.SampleDurationNanos != rightTS.SampleDurationNanos {
+		return util.Errorf("TimeSeries merge failed due to mismatched sample durations")
+	}
+	newTS := roachpb.InternalTimeSeriesData{
+		StartTimestampNanos: leftTS.StartTimestampNanos,
+		SampleDurationNanos: leftTS.SampleDurationNanos,
+		Samples:             mergeTimeSeriesSamples(leftTS.Samples, rightTS.Samples),
+	}
+	left.RawBytes, err = proto.Marshal(&newTS)
+	return err
+}
+
+// consolidateTimeSeriesValue sorts the samples of a value which contains
+// InternalTimeSeriesData, combining the samples with the same offset.
+func consolidateTimeSeriesValue(v *roachpb.Value) error {
+	ts, err := v.GetTimeseries()
+	if err != nil {
+		return err
+	}
+	newTS := roachpb.InternalTimeSeriesData{
+		StartTimestampNanos: ts.StartTimestampNanos,
+		SampleDurationNanos: ts.SampleDurationNanos,
+		Samples:             mergeTimeSeriesSamples(nil, ts.Samples),
+	}
+	v.RawBytes, err = proto.Marshal(&newTS)
+	return err
+}
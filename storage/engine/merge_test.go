@@ -87,8 +87,9 @@ func timeSeries(start int64, duration int64, samples ...tsSample) []byte {
 	return mustMarshal(&MVCCMetadata{Value: &v})
 }
 
-// TestGoMerge tests the function goMerge but not the integration with
-// the storage engines. For that, see the engine tests.
+// TestGoMerge tests the function goMerge, and its pure Go counterpart
+// mergeValues, but not the integration with the storage engines. For
+// that, see the engine tests.
 func TestGoMerge(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	// Let's start with stuff that should go wrong.
@@ -136,6 +137,9 @@ func TestGoMerge(t *testing.T) {
 		if err == nil {
 			t.Errorf("goMerge: %d: expected error", i)
 		}
+		if _, err := mergeValues(c.existing, c.update); err == nil {
+			t.Errorf("mergeValues: %d: expected error", i)
+		}
 	}
 
 	gibber1, gibber2 := gibberishString(100), gibberishString(200)
@@ -167,6 +171,20 @@ func TestGoMerge(t *testing.T) {
 		if !reflect.DeepEqual(resultV, expectedV) {
 			t.Errorf("goMerge error: %d: want %+v, got %+v", i, expectedV, resultV)
 		}
+
+		// The pure Go merge must agree with the C++ implementation.
+		result, err = mergeValues(c.existing, c.update)
+		if err != nil {
+			t.Errorf("mergeValues error: %d: %v", i, err)
+			continue
+		}
+		resultV = MVCCMetadata{}
+		if err := proto.Unmarshal(result, &resultV); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(resultV, expectedV) {
+			t.Errorf("mergeValues error: %d: want %+v, got %+v", i, expectedV, resultV)
+		}
 	}
 
 	testCasesTimeSeries := []struct {
@@ -267,6 +285,13 @@ func TestGoMerge(t *testing.T) {
 			t.Errorf("goMerge returned wrong result on case %d: expected %v, returned %v", i, e, a)
 		}
 
+		// The pure Go merge must agree with the C++ implementation.
+		if result, err := mergeValues(c.existing, c.update); err != nil {
+			t.Errorf("mergeValues error on case %d: %s", i, err.Error())
+		} else if a, e := unmarshalTimeSeries(t, result), expectedTS; !reflect.DeepEqual(a, e) {
+			t.Errorf("mergeValues returned wrong result on case %d: expected %v, returned %v", i, e, a)
+		}
+
 		// Test the MergeInternalTimeSeriesData method separately.
 		if existingTS == nil {
 			resultTS, err = MergeInternalTimeSeriesData(updateTS)
@@ -498,6 +498,19 @@ func MVCCGet(engine Engine, key roachpb.Key, timestamp roachpb.Timestamp, consis
 	}
 
 	value, intents, err := mvccGetInternal(iter, key, metaKey, timestamp, consistent, txn, buf)
+	if value != nil && err == nil {
+		// The value may be deleted by a range tombstone.
+		rts, err := mvccGetRangeTombstones(iter, key, key.Next())
+		if err != nil {
+			return nil, nil, err
+		}
+		var rtIntents []roachpb.Intent
+		value, rtIntents, err = rts.filter(key, value, timestamp, consistent, txn)
+		if err != nil {
+			return nil, nil, err
+		}
+		intents = append(intents, rtIntents...)
+	}
 	if value == &buf.value {
 		value = &roachpb.Value{}
 		*value = buf.value
@@ -715,6 +728,21 @@ func MVCCDelete(engine Engine, ms *MVCCStats, key roachpb.Key, timestamp roachpb
 	return err
 }
 
+// mvccGetMetadata reads the metadata at metaKey with iter, and returns
+// whether it exists along with the sizes of its key and value.
+func mvccGetMetadata(iter Iterator, metaKey MVCCKey, meta *MVCCMetadata) (
+	ok bool, keyBytes, valBytes int64, err error) {
+	iter.Seek(metaKey)
+	if !iter.Valid() || !bytes.Equal(iter.unsafeKey(), metaKey) || len(iter.unsafeValue()) == 0 {
+		meta.Reset()
+		return false, 0, 0, iter.Error()
+	}
+	if err := iter.ValueProto(meta); err != nil {
+		return false, 0, 0, err
+	}
+	return true, int64(len(metaKey)), int64(len(iter.unsafeValue())), nil
+}
+
 // mvccPutInternal adds a new timestamped value to the specified key.
 // If value is nil, creates a deletion tombstone value.
 func mvccPutInternal(engine Engine, ms *MVCCStats, key roachpb.Key, timestamp roachpb.Timestamp,
@@ -724,13 +752,25 @@ func mvccPutInternal(engine Engine, ms *MVCCStats, key roachpb.Key, timestamp ro
 	}
 
 	metaKey := mvccEncodeKey(buf.key[:0], key)
-	ok, origMetaKeySize, origMetaValSize, err := engine.GetProto(metaKey, &buf.meta)
+	putIsInline := timestamp.Equal(roachpb.ZeroTimestamp)
+	var iter Iterator
+	var ok bool
+	var origMetaKeySize, origMetaValSize int64
+	var err error
+	if putIsInline {
+		ok, origMetaKeySize, origMetaValSize, err = engine.GetProto(metaKey, &buf.meta)
+	} else {
+		// The metadata of a versioned value is read with an iterator, which
+		// is then used to load the range tombstones.
+		iter = engine.NewIterator()
+		defer iter.Close()
+		ok, origMetaKeySize, origMetaValSize, err = mvccGetMetadata(iter, metaKey, &buf.meta)
+	}
 	if err != nil {
 		return err
 	}
 
 	// Verify we're not mixing inline and non-inline values.
-	putIsInline := timestamp.Equal(roachpb.ZeroTimestamp)
 	if ok && putIsInline != buf.meta.IsInline() {
 		return util.Errorf("%q: put is inline=%t, but existing value is inline=%t",
 			metaKey, putIsInline, buf.meta.IsInline())
@@ -747,6 +787,15 @@ func mvccPutInternal(engine Engine, ms *MVCCStats, key roachpb.Key, timestamp ro
 		return err
 	}
 
+	// Ensure our write doesn't conflict with a range tombstone.
+	rts, err := mvccGetRangeTombstones(iter, key, key.Next())
+	if err != nil {
+		return err
+	}
+	if err := rts.checkWrite(key, key.Next(), timestamp, txn); err != nil {
+		return err
+	}
+
 	var meta *MVCCMetadata
 	var origAgeSeconds int64
 	if ok {
@@ -782,6 +831,14 @@ func mvccPutInternal(engine Engine, ms *MVCCStats, key roachpb.Key, timestamp ro
 				return &roachpb.WriteTooOldError{Timestamp: timestamp, ExistingTimestamp: meta.Timestamp}
 			}
 		}
+		if t := rts.deleting(key, meta.Timestamp); t != nil && meta.Txn == nil && !meta.Deleted {
+			// The existing value is deleted by a range tombstone; account
+			// for it as if it were a deletion tombstone.
+			covered := *meta
+			covered.Deleted = true
+			meta = &covered
+			origAgeSeconds = timestamp.WallTime/1E9 - t.Timestamp.WallTime/1E9
+		}
 	} else {
 		// No existing metadata record. If this is a delete, do nothing;
 		// otherwise we can perform the write.
@@ -927,8 +984,13 @@ func MVCCMerge(engine Engine, ms *MVCCStats, key roachpb.Key, value roachpb.Valu
 }
 
 // MVCCDeleteRange deletes the range of key/value pairs specified by
-// start and end keys. Specify max=0 for unbounded deletes.
+// start and end keys, and returns the number of keys deleted. Specify
+// max=0 for unbounded deletes. Unbounded deletes of non-local keys write
+// a single range tombstone instead of a deletion tombstone per key.
 func MVCCDeleteRange(engine Engine, ms *MVCCStats, key, endKey roachpb.Key, max int64, timestamp roachpb.Timestamp, txn *roachpb.Transaction) (int64, error) {
+	if max == 0 && !timestamp.Equal(roachpb.ZeroTimestamp) && bytes.Compare(key, keys.LocalMax) >= 0 {
+		return mvccDeleteRangeTombstone(engine, ms, key, endKey, timestamp, txn)
+	}
 	// In order to detect the potential write intent by another
 	// concurrent transaction with a newer timestamp, we need
 	// to use the max timestamp for scan.
@@ -1046,6 +1108,16 @@ func MVCCIterate(engine Engine, startKey, endKey roachpb.Key, timestamp roachpb.
 		return nil, emptyKeyError()
 	}
 
+	// Get a new iterator.
+	iter := engine.NewIterator()
+	defer iter.Close()
+
+	// Load the range tombstones which may delete the values.
+	rts, err := mvccGetRangeTombstones(iter, startKey, endKey)
+	if err != nil {
+		return nil, err
+	}
+
 	buf := getBufferPool.Get().(*getBuffer)
 	defer getBufferPool.Put(buf)
 
@@ -1071,10 +1143,6 @@ func MVCCIterate(engine Engine, startKey, endKey roachpb.Key, timestamp roachpb.
 		getMetaKey = getScanMetaKey
 	}
 
-	// Get a new iterator.
-	iter := engine.NewIterator()
-	defer iter.Close()
-
 	// Seeking for the first defined position.
 	if reverse {
 		iter.SeekReverse(encKey)
@@ -1112,12 +1180,33 @@ func MVCCIterate(engine Engine, startKey, endKey roachpb.Key, timestamp roachpb.
 		if key == nil && metaKey == nil {
 			break
 		}
+		if bytes.HasPrefix(key, keys.LocalRangeTombstonePrefix) {
+			// Range tombstones are internal to MVCC; skip them.
+			if reverse {
+				iter.Seek(MVCCEncodeKey(keys.LocalRangeTombstonePrefix))
+				iter.Prev()
+			} else {
+				iter.Seek(mvccEncodeKey(keyBuf, keys.LocalRangeTombstoneMax))
+			}
+			if !iter.Valid() {
+				if err := iter.Error(); err != nil {
+					return nil, err
+				}
+				break
+			}
+			continue
+		}
 
 		if err := iter.ValueProto(&buf.meta); err != nil {
 			return nil, err
 		}
 		value, newIntents, err := mvccGetInternal(iter, key, metaKey, timestamp, consistent, txn, buf)
-		intents = append(intents, newIntents...)
+		if value != nil && err == nil && rts != nil {
+			var rtIntents []roachpb.Intent
+			value, rtIntents, err = rts.filter(key, value, timestamp, consistent, txn)
+			newIntents = append(newIntents, rtIntents...)
+		}
+		intents = appendIntents(intents, newIntents...)
 		if value != nil {
 			done, err := f(roachpb.KeyValue{Key: key, Value: *value})
 			if err != nil {
@@ -1135,7 +1224,7 @@ func MVCCIterate(engine Engine, startKey, endKey roachpb.Key, timestamp roachpb.
 				if wiErr == nil {
 					wiErr = tErr
 				} else {
-					wiErr.(*roachpb.WriteIntentError).Intents = append(wiErr.(*roachpb.WriteIntentError).Intents, tErr.Intents...)
+					wiErr.(*roachpb.WriteIntentError).Intents = appendIntents(wiErr.(*roachpb.WriteIntentError).Intents, tErr.Intents...)
 				}
 			default:
 				return nil, err
@@ -1174,6 +1263,143 @@ func MVCCIterate(engine Engine, startKey, endKey roachpb.Key, timestamp roachpb.
 	return intents, wiErr
 }
 
+// MVCCExport returns the key/value revisions of the key range [key,endKey)
+// for backups. If startTime is zero, it returns the latest value of each
+// key as of endTime, like MVCCScan. Otherwise, it returns all the
+// revisions written in the interval (startTime,endTime], in descending
+// timestamp order for each key, with deletions returned as values which
+// are empty apart from their timestamp. Inline values are always
+// returned. The range tombstones written in the interval are returned as
+// deletions of the keys which have earlier versions. The intents of other
+// transactions which aren't later than endTime result in a
+// WriteIntentError listing all of them. If max is positive, only the
+// revisions of the first max keys are returned.
+func MVCCExport(engine Engine, key, endKey roachpb.Key, max int64, startTime, endTime roachpb.Timestamp,
+	txn *roachpb.Transaction) ([]roachpb.KeyValue, error) {
+	if startTime == roachpb.ZeroTimestamp {
+		kvs, _, err := MVCCScan(engine, key, endKey, max, endTime, true /* consistent */, txn)
+		return kvs, err
+	}
+	if len(endKey) == 0 {
+		return nil, emptyKeyError()
+	}
+	encEndKey := MVCCEncodeKey(endKey)
+
+	var kvs []roachpb.KeyValue
+	// The number of keys with revisions in kvs.
+	var numKeys int64
+	add := func(kv roachpb.KeyValue) {
+		if len(kvs) == 0 || !kvs[len(kvs)-1].Key.Equal(kv.Key) {
+			numKeys++
+		}
+		kvs = append(kvs, kv)
+	}
+	iter := engine.NewIterator()
+	defer iter.Close()
+
+	var wiErr *roachpb.WriteIntentError
+	rts, err := mvccGetRangeTombstones(iter, key, endKey)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rts {
+		if t := &rts[i]; t.Txn != nil && !t.isIntentOf(txn) && !endTime.Less(t.Timestamp) {
+			if wiErr == nil {
+				wiErr = &roachpb.WriteIntentError{}
+			}
+			wiErr.Intents = append(wiErr.Intents, t.intent())
+		}
+	}
+
+	var meta MVCCMetadata
+	// The provisional value of an intent is skipped, unless it was written
+	// by txn.
+	var skipIntentValue bool
+	// The timestamps, in decreasing order, of the range tombstones written
+	// in the interval which delete the current key and haven't been
+	// returned yet.
+	var deletions []roachpb.Timestamp
+	for iter.Seek(MVCCEncodeKey(key)); iter.Valid(); iter.Next() {
+		if bytes.Compare(iter.unsafeKey(), encEndKey) >= 0 {
+			break
+		}
+		k, ts, isValue, err := MVCCDecodeKey(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		if max > 0 && numKeys == max && !k.Equal(kvs[len(kvs)-1].Key) {
+			break
+		}
+		if !isValue {
+			if bytes.HasPrefix(k, keys.LocalRangeTombstonePrefix) {
+				continue
+			}
+			if err := iter.ValueProto(&meta); err != nil {
+				return nil, err
+			}
+			if meta.IsInline() {
+				if err := meta.Value.Verify(k); err != nil {
+					return nil, err
+				}
+				add(roachpb.KeyValue{Key: k, Value: *meta.Value})
+				continue
+			}
+			skipIntentValue = meta.Txn != nil && !meta.IsIntentOf(txn)
+			if skipIntentValue && !endTime.Less(meta.Timestamp) {
+				intent := roachpb.Intent{Span: roachpb.Span{Key: k}, Txn: *meta.Txn}
+				if wiErr == nil {
+					wiErr = &roachpb.WriteIntentError{}
+				}
+				wiErr.Intents = append(wiErr.Intents, intent)
+			}
+			deletions = deletions[:0]
+			for i := range rts {
+				t := &rts[i]
+				if !t.contains(k) || !startTime.Less(t.Timestamp) || endTime.Less(t.Timestamp) ||
+					(t.Txn != nil && !t.isIntentOf(txn)) {
+					continue
+				}
+				deletions = append(deletions, t.Timestamp)
+				for j := len(deletions) - 1; j > 0 && deletions[j-1].Less(deletions[j]); j-- {
+					deletions[j-1], deletions[j] = deletions[j], deletions[j-1]
+				}
+			}
+			continue
+		}
+		if skipIntentValue {
+			// The provisional value is the most recent version of the key.
+			skipIntentValue = false
+			continue
+		}
+		for len(deletions) > 0 && ts.Less(deletions[0]) {
+			delTS := deletions[0]
+			add(roachpb.KeyValue{Key: k, Value: roachpb.Value{Timestamp: &delTS}})
+			deletions = deletions[1:]
+		}
+		if !startTime.Less(ts) || endTime.Less(ts) {
+			continue
+		}
+		value := roachpb.Value{}
+		if len(iter.unsafeValue()) > 0 {
+			if err := iter.ValueProto(&value); err != nil {
+				return nil, err
+			}
+			if err := value.Verify(k); err != nil {
+				return nil, err
+			}
+		}
+		value.Timestamp = &ts
+		add(roachpb.KeyValue{Key: k, Value: value})
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+	if wiErr != nil {
+		return nil, wiErr
+	}
+	return kvs, nil
+}
+
 // MVCCResolveWriteIntent either commits or aborts (rolls back) an
 // extant write intent for a given txn according to commit parameter.
 // ResolveWriteIntent will skip write intents of other txns.
@@ -1273,10 +1499,16 @@ func MVCCResolveWriteIntent(engine Engine, ms *MVCCStats, key roachpb.Key, times
 		return nil
 	}
 
-	// Otherwise, we're deleting the intent. We must find the next
-	// versioned value and reset the metadata's latest timestamp. If
-	// there are no other versioned values, we delete the metadata
-	// key.
+	// Otherwise, we're deleting the intent.
+	return mvccAbortIntent(engine, ms, key, metaKey, meta, origMetaKeySize, origMetaValSize, timestamp)
+}
+
+// mvccAbortIntent deletes the intent described by meta. We must find the
+// next versioned value and reset the metadata's latest timestamp. If
+// there are no other versioned values, we delete the metadata key.
+func mvccAbortIntent(engine Engine, ms *MVCCStats, key roachpb.Key, metaKey MVCCKey, meta *MVCCMetadata,
+	origMetaKeySize, origMetaValSize int64, timestamp roachpb.Timestamp) error {
+	origAgeSeconds := timestamp.WallTime/1E9 - meta.Timestamp.WallTime/1E9
 
 	// First clear the intent value.
 	latestKey := MVCCEncodeVersionKey(key, meta.Timestamp)
@@ -1309,6 +1541,7 @@ func MVCCResolveWriteIntent(engine Engine, ms *MVCCStats, key roachpb.Key, times
 		}
 		// Get the bytes for the next version so we have size for stat counts.
 		value := roachpb.Value{}
+		var ok bool
 		var valueSize int64
 		ok, _, valueSize, err = engine.GetProto(kvs[0].Key, &value)
 		if err != nil {
@@ -1327,8 +1560,26 @@ func MVCCResolveWriteIntent(engine Engine, ms *MVCCStats, key roachpb.Key, times
 		}
 		restoredAgeSeconds := timestamp.WallTime/1E9 - ts.WallTime/1E9
 
+		// The restored version may be deleted by a range tombstone, in
+		// which case it's accounted for as a deletion tombstone.
+		restored := newMeta
+		if ms != nil && !newMeta.Deleted {
+			iter := engine.NewIterator()
+			rts, err := mvccGetRangeTombstones(iter, key, key.Next())
+			iter.Close()
+			if err != nil {
+				return err
+			}
+			if t := rts.deleting(key, ts); t != nil {
+				restored = &MVCCMetadata{}
+				*restored = *newMeta
+				restored.Deleted = true
+				restoredAgeSeconds = timestamp.WallTime/1E9 - t.Timestamp.WallTime/1E9
+			}
+		}
+
 		// Update stat counters with older version.
-		updateStatsOnAbort(ms, key, origMetaKeySize, origMetaValSize, metaKeySize, metaValSize, meta, newMeta, origAgeSeconds, restoredAgeSeconds)
+		updateStatsOnAbort(ms, key, origMetaKeySize, origMetaValSize, metaKeySize, metaValSize, meta, restored, origAgeSeconds, restoredAgeSeconds)
 	}
 
 	return nil
@@ -1336,18 +1587,26 @@ func MVCCResolveWriteIntent(engine Engine, ms *MVCCStats, key roachpb.Key, times
 
 // MVCCResolveWriteIntentRange commits or aborts (rolls back) the
 // range of write intents specified by start and end keys for a given
-// txn. ResolveWriteIntentRange will skip write intents of other
+// txn, including the range tombstones of txn overlapping the key
+// range. ResolveWriteIntentRange will skip write intents of other
 // txns. Specify max=0 for unbounded resolves.
 func MVCCResolveWriteIntentRange(engine Engine, ms *MVCCStats, key, endKey roachpb.Key, max int64, timestamp roachpb.Timestamp, txn *roachpb.Transaction) (int64, error) {
 	if txn == nil {
 		return 0, util.Errorf("no txn specified")
 	}
 
+	// Resolve the range tombstones first: aborting an intent may restore
+	// a version which the stats must see as deleted by them only if they
+	// remain.
+	num, err := mvccResolveRangeTombstones(engine, ms, key, endKey, timestamp, txn)
+	if err != nil {
+		return num, err
+	}
+
 	encKey := MVCCEncodeKey(key)
 	encEndKey := MVCCEncodeKey(endKey)
 	nextKey := encKey
 
-	num := int64(0)
 	for {
 		kvs, err := Scan(engine, nextKey, encEndKey, 1)
 		if err != nil {
@@ -1370,7 +1629,7 @@ func MVCCResolveWriteIntentRange(engine Engine, ms *MVCCStats, key, endKey roach
 			log.Warningf("failed to resolve intent for key %q: %v", currentKey, err)
 		} else {
 			num++
-			if max != 0 && max == num {
+			if max != 0 && max <= num {
 				break
 			}
 		}
@@ -1391,6 +1650,26 @@ func MVCCResolveWriteIntentRange(engine Engine, ms *MVCCStats, key, endKey roach
 func MVCCGarbageCollect(engine Engine, ms *MVCCStats, keys []roachpb.GCRequest_GCKey, timestamp roachpb.Timestamp) error {
 	iter := engine.NewIterator()
 	defer iter.Close()
+	if len(keys) == 0 {
+		return nil
+	}
+
+	// Load the range tombstones which may delete the keys, once for the
+	// span of all of them.
+	minKey, maxKey := keys[0].Key, keys[0].Key
+	for _, gcKey := range keys[1:] {
+		if bytes.Compare(gcKey.Key, minKey) < 0 {
+			minKey = gcKey.Key
+		}
+		if bytes.Compare(gcKey.Key, maxKey) > 0 {
+			maxKey = gcKey.Key
+		}
+	}
+	rts, err := mvccGetRangeTombstones(iter, minKey, maxKey.Next())
+	if err != nil {
+		return err
+	}
+
 	// Iterate through specified GC keys.
 	for _, gcKey := range keys {
 		encKey := MVCCEncodeKey(gcKey.Key)
@@ -1404,17 +1683,22 @@ func MVCCGarbageCollect(engine Engine, ms *MVCCStats, keys []roachpb.GCRequest_G
 			return util.Errorf("unable to marshal mvcc meta: %s", err)
 		}
 		if !gcKey.Timestamp.Less(meta.Timestamp) {
-			// For version keys, don't allow GC'ing the meta key if it's
-			// not marked deleted. However, for inline values we allow it;
-			// they are internal and GCing them directly saves the extra
-			// deletion step.
-			if !meta.Deleted && !gcKey.Timestamp.Equal(roachpb.ZeroTimestamp) {
-				return util.Errorf("request to GC non-deleted, latest value of %q", gcKey.Key)
-			}
 			if meta.Txn != nil {
 				return util.Errorf("request to GC intent at %q", gcKey.Key)
 			}
 			ageSeconds := timestamp.WallTime/1E9 - meta.Timestamp.WallTime/1E9
+			// For version keys, don't allow GC'ing the meta key if it's
+			// not marked deleted, or deleted by a range tombstone which is
+			// being GC'd. However, for inline values we allow it; they are
+			// internal and GCing them directly saves the extra deletion
+			// step.
+			if !meta.Deleted && !gcKey.Timestamp.Equal(roachpb.ZeroTimestamp) {
+				t := rts.deleting(gcKey.Key, meta.Timestamp)
+				if t == nil || t.Txn != nil || gcKey.Timestamp.Less(t.Timestamp.Prev()) {
+					return util.Errorf("request to GC non-deleted, latest value of %q", gcKey.Key)
+				}
+				ageSeconds = timestamp.WallTime/1E9 - t.Timestamp.WallTime/1E9
+			}
 			updateStatsOnGC(ms, gcKey.Key, int64(len(iter.Key())), int64(len(iter.Value())), meta, ageSeconds)
 			if err := engine.Clear(iter.Key()); err != nil {
 				return err
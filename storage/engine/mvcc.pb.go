@@ -2,16 +2,6 @@
 // source: cockroach/storage/engine/mvcc.proto
 // DO NOT EDIT!
 
-/*
-	Package engine is a generated protocol buffer package.
-
-	It is generated from these files:
-		cockroach/storage/engine/mvcc.proto
-
-	It has these top-level messages:
-		MVCCMetadata
-		MVCCStats
-*/
 package engine
 
 import proto "github.com/gogo/protobuf/proto"
@@ -1179,6 +1179,91 @@ func TestMVCCDeleteRange(t *testing.T) {
 	}
 }
 
+// TestMVCCExport verifies that MVCCExport returns the latest values, or
+// the revisions written in a time interval, and reports intents.
+func TestMVCCExport(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+	engine := createTestEngine(stopper)
+
+	if err := MVCCPut(engine, nil, testKey1, makeTS(1, 0), value1, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey1, makeTS(3, 0), value2, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey2, makeTS(2, 0), value2, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCDelete(engine, nil, testKey2, makeTS(4, 0), nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey3, makeTS(5, 0), value3, makeTxn(txn1, makeTS(5, 0))); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey4, roachpb.ZeroTimestamp, value4, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	deletion := roachpb.Value{Timestamp: &roachpb.Timestamp{WallTime: 4}}
+	testCases := []struct {
+		max                int64
+		startTime, endTime roachpb.Timestamp
+		txn                *roachpb.Transaction
+		expKVs             []roachpb.KeyValue
+		expIntentKey       roachpb.Key
+	}{
+		// Latest values.
+		{0, roachpb.ZeroTimestamp, makeTS(4, 0), nil, []roachpb.KeyValue{
+			{Key: testKey1, Value: mkVal("testValue2", makeTS(3, 0))},
+			{Key: testKey4, Value: value4},
+		}, nil},
+		// Revisions, including deletions.
+		{0, makeTS(1, 0), makeTS(4, 0), nil, []roachpb.KeyValue{
+			{Key: testKey1, Value: mkVal("testValue2", makeTS(3, 0))},
+			{Key: testKey2, Value: deletion},
+			{Key: testKey2, Value: mkVal("testValue2", makeTS(2, 0))},
+			{Key: testKey4, Value: value4},
+		}, nil},
+		{0, makeTS(3, 0), makeTS(4, 0), nil, []roachpb.KeyValue{
+			{Key: testKey2, Value: deletion},
+			{Key: testKey4, Value: value4},
+		}, nil},
+		// The revisions of a key aren't split by the maximum number of keys.
+		{1, roachpb.ZeroTimestamp, makeTS(4, 0), nil, []roachpb.KeyValue{
+			{Key: testKey1, Value: mkVal("testValue2", makeTS(3, 0))},
+		}, nil},
+		{2, makeTS(1, 0), makeTS(4, 0), nil, []roachpb.KeyValue{
+			{Key: testKey1, Value: mkVal("testValue2", makeTS(3, 0))},
+			{Key: testKey2, Value: deletion},
+			{Key: testKey2, Value: mkVal("testValue2", makeTS(2, 0))},
+		}, nil},
+		// The intent is returned to its transaction only.
+		{0, makeTS(4, 0), makeTS(5, 0), nil, nil, testKey3},
+		{0, makeTS(4, 0), makeTS(5, 0), txn1, []roachpb.KeyValue{
+			{Key: testKey3, Value: mkVal("testValue3", makeTS(5, 0))},
+			{Key: testKey4, Value: value4},
+		}, nil},
+	}
+	for i, test := range testCases {
+		kvs, err := MVCCExport(engine, testKey1, keyMax, test.max, test.startTime, test.endTime, test.txn)
+		if test.expIntentKey != nil {
+			wiErr, ok := err.(*roachpb.WriteIntentError)
+			if !ok || len(wiErr.Intents) != 1 || !wiErr.Intents[0].Key.Equal(test.expIntentKey) {
+				t.Errorf("%d: expected a write intent error on %s; got %v", i, test.expIntentKey, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if !reflect.DeepEqual(kvs, test.expKVs) {
+			t.Errorf("%d: expected %v; got %v", i, test.expKVs, kvs)
+		}
+	}
+}
+
 func TestMVCCDeleteRangeFailed(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	stopper := stop.NewStopper()
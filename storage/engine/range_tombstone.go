@@ -0,0 +1,653 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package engine
+
+import (
+	"bytes"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// A range tombstone deletes, at its timestamp, the versions of all the
+// keys in its span written at earlier timestamps, without writing a
+// deletion tombstone for each of them. The versions of a key written at
+// or after the timestamp of the tombstone are unaffected. Range
+// tombstones are kept as inline values in the range-local
+// keys.LocalRangeTombstonePrefix keyspace, keyed by start key and
+// timestamp, and only apply to non-local keys.
+//
+// The latest version of a key deleted by a range tombstone is accounted
+// for in MVCCStats as if a deletion tombstone had been written at the
+// timestamp of the range tombstone: the key isn't live, and its latest
+// version and metadata age from the time they were respectively written
+// and deleted. Keys whose latest version is an intent are never
+// considered deleted, as intents are always written after the range
+// tombstones they overlap.
+//
+// A transactional range deletion writes a range tombstone carrying the
+// transaction, which acts as a single intent over the whole span and is
+// resolved as a unit along with the point intents of the transaction.
+//
+// Range tombstones are split at the bounds of ranges, so the range
+// tombstones deleting a key start between the start key of its range and
+// the key. The MVCC functions only know the start key of the range when
+// they operate on an engine returned by NewRangeEngine; otherwise they
+// look for the range tombstones from KeyMin.
+
+// NewRangeEngine returns an engine for the commands of the range starting
+// at start. The range tombstones applying to the keys of the range are
+// looked for from start rather than from KeyMin, which, when the range
+// has no range tombstones, amounts to a single seek. The iterators,
+// batches and snapshots of the engine are bounded the same way.
+func NewRangeEngine(engine Engine, start roachpb.Key) Engine {
+	return &rangeEngine{Engine: engine, start: start}
+}
+
+// rangeEngine is an engine for the commands of the range starting at
+// start.
+type rangeEngine struct {
+	Engine
+	start roachpb.Key
+}
+
+func (r *rangeEngine) NewIterator() Iterator {
+	return &rangeIterator{Iterator: r.Engine.NewIterator(), start: r.start}
+}
+
+func (r *rangeEngine) NewSnapshot() Engine {
+	return NewRangeEngine(r.Engine.NewSnapshot(), r.start)
+}
+
+func (r *rangeEngine) NewBatch() Engine {
+	return NewRangeEngine(r.Engine.NewBatch(), r.start)
+}
+
+// rangeIterator is an iterator of a rangeEngine.
+type rangeIterator struct {
+	Iterator
+	start roachpb.Key
+}
+
+func (r *rangeIterator) ComputeStats(ms *MVCCStats, start, end []byte, nowNanos int64) error {
+	if err := r.computeKeyStats(ms, start, end, nowNanos); err != nil {
+		return err
+	}
+	minStart := roachpb.KeyMin
+	if bytes.Compare(MVCCEncodeKey(r.start), start) <= 0 {
+		minStart = r.start
+	}
+	return computeRangeTombstoneStats(r, ms, minStart, start, end, nowNanos)
+}
+
+// rangeTombstonesMinStart returns the key from which to look for the range
+// tombstones overlapping key.
+func rangeTombstonesMinStart(iter Iterator, key roachpb.Key) roachpb.Key {
+	if r, ok := iter.(*rangeIterator); ok && bytes.Compare(r.start, key) <= 0 {
+		return r.start
+	}
+	return roachpb.KeyMin
+}
+
+// contains returns whether the span of the range tombstone contains key.
+func (t *MVCCRangeTombstone) contains(key roachpb.Key) bool {
+	return bytes.Compare(t.StartKey, key) <= 0 && bytes.Compare(key, t.EndKey) < 0
+}
+
+// isIntentOf returns whether the range tombstone is an intent written by
+// txn.
+func (t *MVCCRangeTombstone) isIntentOf(txn *roachpb.Transaction) bool {
+	return t.Txn != nil && txn != nil && bytes.Equal(t.Txn.ID, txn.ID)
+}
+
+// equal returns whether the range tombstones are stored at the same key.
+func (t *MVCCRangeTombstone) equal(o *MVCCRangeTombstone) bool {
+	return t.StartKey.Equal(o.StartKey) && t.Timestamp.Equal(o.Timestamp)
+}
+
+// intent returns the intent corresponding to the range tombstone.
+func (t *MVCCRangeTombstone) intent() roachpb.Intent {
+	return roachpb.Intent{Span: roachpb.Span{Key: t.StartKey, EndKey: t.EndKey}, Txn: *t.Txn}
+}
+
+// rangeTombstones is a slice of range tombstones, ordered by start key
+// and then by decreasing timestamp.
+type rangeTombstones []MVCCRangeTombstone
+
+// deleting returns the oldest of the range tombstones which deletes the
+// version of key written at timestamp, or nil if there is none.
+func (rts rangeTombstones) deleting(key roachpb.Key, timestamp roachpb.Timestamp) *MVCCRangeTombstone {
+	var oldest *MVCCRangeTombstone
+	for i := range rts {
+		t := &rts[i]
+		if !t.contains(key) || !timestamp.Less(t.Timestamp) {
+			continue
+		}
+		if oldest == nil || t.Timestamp.Less(oldest.Timestamp) {
+			oldest = t
+		}
+	}
+	return oldest
+}
+
+// without returns the range tombstones other than t.
+func (rts rangeTombstones) without(t *MVCCRangeTombstone) rangeTombstones {
+	var res rangeTombstones
+	for i := range rts {
+		if !rts[i].equal(t) {
+			res = append(res, rts[i])
+		}
+	}
+	return res
+}
+
+// filter applies the range tombstones to the value read for key at
+// timestamp, following the rules mvccGetInternal applies to the
+// versions of the key: it returns nil if the value is deleted by a
+// range tombstone visible at timestamp, and an error if the value may be
+// deleted by the intent of another transaction or by a range tombstone
+// within the uncertainty interval of txn. Intents skipped by
+// inconsistent reads are returned.
+func (rts rangeTombstones) filter(key roachpb.Key, value *roachpb.Value, timestamp roachpb.Timestamp,
+	consistent bool, txn *roachpb.Transaction) (*roachpb.Value, []roachpb.Intent, error) {
+	var intents []roachpb.Intent
+	var wiErr *roachpb.WriteIntentError
+	var uncertain *MVCCRangeTombstone
+	for i := range rts {
+		t := &rts[i]
+		if !t.contains(key) || !value.Timestamp.Less(t.Timestamp) {
+			continue
+		}
+		if t.isIntentOf(txn) {
+			// The own intent of txn deletes the value regardless of the
+			// timestamp, unless it was written in an earlier epoch.
+			if t.Txn.Epoch == txn.Epoch {
+				return nil, nil, nil
+			}
+			continue
+		}
+		if !timestamp.Less(t.Timestamp) {
+			if t.Txn == nil {
+				return nil, nil, nil
+			}
+			if consistent {
+				if wiErr == nil {
+					wiErr = &roachpb.WriteIntentError{}
+				}
+				wiErr.Intents = append(wiErr.Intents, t.intent())
+			} else {
+				intents = append(intents, t.intent())
+			}
+			continue
+		}
+		if txn != nil && !txn.MaxTimestamp.Less(t.Timestamp) && uncertain == nil {
+			uncertain = t
+		}
+	}
+	if wiErr != nil {
+		return nil, nil, wiErr
+	}
+	if uncertain != nil {
+		return nil, nil, &roachpb.ReadWithinUncertaintyIntervalError{
+			Timestamp:         timestamp,
+			ExistingTimestamp: uncertain.Timestamp,
+		}
+	}
+	return value, intents, nil
+}
+
+// checkWrite returns an error if a write at timestamp to the keys in
+// [key,endKey) conflicts with one of the range tombstones: either an
+// intent of another transaction, or a range tombstone at the same or a
+// later timestamp.
+func (rts rangeTombstones) checkWrite(key, endKey roachpb.Key, timestamp roachpb.Timestamp,
+	txn *roachpb.Transaction) error {
+	var wiErr *roachpb.WriteIntentError
+	for i := range rts {
+		t := &rts[i]
+		if bytes.Compare(t.EndKey, key) <= 0 || bytes.Compare(endKey, t.StartKey) <= 0 {
+			continue
+		}
+		if t.Txn != nil {
+			if !t.isIntentOf(txn) {
+				if wiErr == nil {
+					wiErr = &roachpb.WriteIntentError{}
+				}
+				wiErr.Intents = append(wiErr.Intents, t.intent())
+			}
+			continue
+		}
+		if !t.Timestamp.Less(timestamp) {
+			return &roachpb.WriteTooOldError{Timestamp: timestamp, ExistingTimestamp: t.Timestamp}
+		}
+	}
+	if wiErr != nil {
+		return wiErr
+	}
+	return nil
+}
+
+// appendIntents appends the intents to the slice, skipping the range
+// intents it already contains; the range intent of a span is
+// encountered at each of the keys it deletes.
+func appendIntents(intents []roachpb.Intent, newIntents ...roachpb.Intent) []roachpb.Intent {
+outer:
+	for _, intent := range newIntents {
+		if len(intent.EndKey) > 0 {
+			for _, i := range intents {
+				if i.Key.Equal(intent.Key) && i.EndKey.Equal(intent.EndKey) &&
+					bytes.Equal(i.Txn.ID, intent.Txn.ID) {
+					continue outer
+				}
+			}
+		}
+		intents = append(intents, intent)
+	}
+	return intents
+}
+
+// MVCCGetRangeTombstones returns the range tombstones overlapping the
+// key range [key,endKey), ordered by start key and then by decreasing
+// timestamp.
+func MVCCGetRangeTombstones(engine Engine, key, endKey roachpb.Key) ([]MVCCRangeTombstone, error) {
+	iter := engine.NewIterator()
+	defer iter.Close()
+	return mvccGetRangeTombstones(iter, key, endKey)
+}
+
+// mvccGetRangeTombstones returns the range tombstones overlapping the key
+// range [key,endKey), read with iter, whose position is then undefined.
+func mvccGetRangeTombstones(iter Iterator, key, endKey roachpb.Key) (rangeTombstones, error) {
+	if bytes.Compare(endKey, keys.LocalMax) <= 0 {
+		// Range tombstones don't apply to local keys.
+		return nil, nil
+	}
+	var rts rangeTombstones
+	minStart := rangeTombstonesMinStart(iter, key)
+	err := mvccIterateRangeTombstones(iter, minStart, endKey, func(t MVCCRangeTombstone) (bool, error) {
+		if bytes.Compare(key, t.EndKey) < 0 {
+			rts = append(rts, t)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rts, nil
+}
+
+// mvccIterateRangeTombstones invokes f with each of the range tombstones
+// starting in [key,endKey). If f returns an error or if the first result
+// of f is true, the iteration stops.
+func mvccIterateRangeTombstones(iter Iterator, key, endKey roachpb.Key,
+	f func(MVCCRangeTombstone) (bool, error)) error {
+	encEndKey := MVCCEncodeKey(keys.MakeRangeTombstoneKeyPrefix(roachpb.RKey(endKey)))
+	var meta MVCCMetadata
+	for iter.Seek(MVCCEncodeKey(keys.MakeRangeTombstoneKeyPrefix(roachpb.RKey(key)))); iter.Valid(); iter.Next() {
+		if bytes.Compare(iter.unsafeKey(), encEndKey) >= 0 {
+			break
+		}
+		if err := iter.ValueProto(&meta); err != nil {
+			return err
+		}
+		if !meta.IsInline() {
+			return util.Errorf("expected an inline range tombstone at %q", iter.Key())
+		}
+		var t MVCCRangeTombstone
+		if err := meta.Value.GetProto(&t); err != nil {
+			return err
+		}
+		if done, err := f(t); done || err != nil {
+			return err
+		}
+	}
+	return iter.Error()
+}
+
+// mvccIterateMetadata invokes f with each of the keys in the encoded key
+// range [start,end), along with the sizes of its encoded metadata key and
+// value, and its metadata.
+func mvccIterateMetadata(iter Iterator, start, end MVCCKey,
+	f func(key roachpb.Key, metaKeySize, metaValSize int64, meta *MVCCMetadata) error) error {
+	var meta MVCCMetadata
+	iter.Seek(start)
+	for iter.Valid() && bytes.Compare(iter.unsafeKey(), end) < 0 {
+		key, _, isValue, err := MVCCDecodeKey(iter.Key())
+		if err != nil {
+			return err
+		}
+		if isValue {
+			return util.Errorf("expected an MVCC metadata key: %q", iter.Key())
+		}
+		if err := iter.ValueProto(&meta); err != nil {
+			return err
+		}
+		if err := f(key, int64(len(iter.unsafeKey())), int64(len(iter.unsafeValue())), &meta); err != nil {
+			return err
+		}
+		// Skip the versions of the key.
+		iter.Seek(MVCCEncodeKey(key.Next()))
+	}
+	return iter.Error()
+}
+
+// putRangeTombstone writes the range tombstone, extending the span of an
+// existing range tombstone with the same start key and timestamp, which
+// is then expected to be part of rts.
+func putRangeTombstone(engine Engine, ms *MVCCStats, t *MVCCRangeTombstone, rts rangeTombstones) error {
+	for i := range rts {
+		if rts[i].equal(t) && bytes.Compare(t.EndKey, rts[i].EndKey) < 0 {
+			t.EndKey = rts[i].EndKey
+		}
+	}
+	return MVCCPutProto(engine, ms, keys.RangeTombstoneKey(t.StartKey, t.Timestamp), roachpb.ZeroTimestamp, nil, t)
+}
+
+// clearRangeTombstone removes the range tombstone.
+func clearRangeTombstone(engine Engine, ms *MVCCStats, t *MVCCRangeTombstone) error {
+	return MVCCDelete(engine, ms, keys.RangeTombstoneKey(t.StartKey, t.Timestamp), roachpb.ZeroTimestamp, nil)
+}
+
+// mvccDeleteRangeTombstone deletes the keys in [key,endKey) by writing a
+// range tombstone, and returns the number of keys which were live. The
+// intents of txn in the key range are aborted, as the range tombstone
+// deletes the versions which they replaced.
+func mvccDeleteRangeTombstone(engine Engine, ms *MVCCStats, key, endKey roachpb.Key,
+	timestamp roachpb.Timestamp, txn *roachpb.Transaction) (int64, error) {
+	iter := engine.NewIterator()
+	defer iter.Close()
+	rts, err := mvccGetRangeTombstones(iter, key, endKey)
+	if err != nil {
+		return 0, err
+	}
+	var wiErr *roachpb.WriteIntentError
+	if err := rts.checkWrite(key, endKey, timestamp, txn); err != nil {
+		var ok bool
+		if wiErr, ok = err.(*roachpb.WriteIntentError); !ok {
+			return 0, err
+		}
+	}
+
+	type ownIntent struct {
+		key                              roachpb.Key
+		meta                             MVCCMetadata
+		origMetaKeySize, origMetaValSize int64
+	}
+	var ownIntents []ownIntent
+	num := int64(0)
+	err = mvccIterateMetadata(iter, MVCCEncodeKey(key), MVCCEncodeKey(endKey),
+		func(k roachpb.Key, metaKeySize, metaValSize int64, meta *MVCCMetadata) error {
+			if meta.IsInline() {
+				return util.Errorf("%q: cannot delete inline value with a range tombstone", k)
+			}
+			if meta.Txn != nil {
+				if !meta.IsIntentOf(txn) {
+					if wiErr == nil {
+						wiErr = &roachpb.WriteIntentError{}
+					}
+					wiErr.Intents = append(wiErr.Intents, roachpb.Intent{Span: roachpb.Span{Key: k}, Txn: *meta.Txn})
+					return nil
+				}
+				if txn.Epoch < meta.Txn.Epoch {
+					return util.Errorf("delete range with epoch %d came after put with epoch %d in txn %s",
+						txn.Epoch, meta.Txn.Epoch, txn.ID)
+				}
+				if !meta.Deleted && meta.Txn.Epoch == txn.Epoch {
+					num++
+				}
+				ownIntents = append(ownIntents, ownIntent{k, *meta, metaKeySize, metaValSize})
+				return nil
+			}
+			if !meta.Timestamp.Less(timestamp) {
+				return &roachpb.WriteTooOldError{Timestamp: timestamp, ExistingTimestamp: meta.Timestamp}
+			}
+			if !meta.Deleted && rts.deleting(k, meta.Timestamp) == nil {
+				num++
+			}
+			return nil
+		})
+	if err != nil {
+		return 0, err
+	}
+	if wiErr != nil {
+		return 0, wiErr
+	}
+
+	for i := range ownIntents {
+		oi := &ownIntents[i]
+		if err := mvccAbortIntent(engine, ms, oi.key, MVCCEncodeKey(oi.key), &oi.meta,
+			oi.origMetaKeySize, oi.origMetaValSize, timestamp); err != nil {
+			return 0, err
+		}
+	}
+
+	t := MVCCRangeTombstone{StartKey: key, EndKey: endKey, Timestamp: timestamp, Txn: txn}
+	if err := putRangeTombstone(engine, ms, &t, rts); err != nil {
+		return 0, err
+	}
+	if err := updateStatsForRangeTombstone(engine, ms, &t, rts, timestamp, true); err != nil {
+		return 0, err
+	}
+	return num, nil
+}
+
+// updateStatsForRangeTombstone updates stat counters for the keys whose
+// deletion is affected by adding (or removing, if add is false) the range
+// tombstone t, given the other range tombstones rts overlapping it. Live
+// keys deleted by t have their bytes and count moved out of the live
+// counters, with their latest version aging from the time it was written
+// and their metadata from the time of the deletion. Keys already deleted
+// by a later range tombstone only have the age of their metadata
+// adjusted.
+func updateStatsForRangeTombstone(engine Engine, ms *MVCCStats, t *MVCCRangeTombstone,
+	rts rangeTombstones, timestamp roachpb.Timestamp, add bool) error {
+	if ms == nil {
+		return nil
+	}
+	sign := int64(1)
+	if !add {
+		sign = -1
+	}
+	ageSeconds := timestamp.WallTime/1E9 - t.Timestamp.WallTime/1E9
+
+	iter := engine.NewIterator()
+	defer iter.Close()
+	return mvccIterateMetadata(iter, MVCCEncodeKey(t.StartKey), MVCCEncodeKey(t.EndKey),
+		func(key roachpb.Key, metaKeySize, metaValSize int64, meta *MVCCMetadata) error {
+			if meta.IsInline() || meta.Txn != nil || meta.Deleted || !meta.Timestamp.Less(t.Timestamp) {
+				return nil
+			}
+			metaBytes := metaKeySize + metaValSize
+			if o := rts.deleting(key, meta.Timestamp); o != nil {
+				if !t.Timestamp.Less(o.Timestamp) {
+					// The key remains deleted by o.
+					return nil
+				}
+				// The key is deleted earlier, by t.
+				ms.GCBytesAge += sign * MVCCComputeGCBytesAge(metaBytes, o.Timestamp.WallTime/1E9-t.Timestamp.WallTime/1E9)
+				return nil
+			}
+			versionBytes := meta.KeyBytes + meta.ValBytes
+			versionAgeSeconds := timestamp.WallTime/1E9 - meta.Timestamp.WallTime/1E9
+			ms.LiveBytes -= sign * (metaBytes + versionBytes)
+			ms.LiveCount -= sign
+			ms.GCBytesAge += sign * (MVCCComputeGCBytesAge(versionBytes, versionAgeSeconds) +
+				MVCCComputeGCBytesAge(metaBytes, ageSeconds))
+			return nil
+		})
+}
+
+// mvccResolveRangeTombstones commits or aborts the range tombstones
+// written by txn which overlap the key range [key,endKey), and returns
+// their number. A range tombstone is resolved as a unit, like the intent
+// of a single key in MVCCResolveWriteIntent.
+func mvccResolveRangeTombstones(engine Engine, ms *MVCCStats, key, endKey roachpb.Key,
+	timestamp roachpb.Timestamp, txn *roachpb.Transaction) (int64, error) {
+	iter := engine.NewIterator()
+	rts, err := mvccGetRangeTombstones(iter, key, endKey)
+	iter.Close()
+	if err != nil {
+		return 0, err
+	}
+	num := int64(0)
+	for i := range rts {
+		if !rts[i].isIntentOf(txn) {
+			continue
+		}
+		if err := mvccResolveRangeTombstone(engine, ms, rts[i], timestamp, txn); err != nil {
+			return num, err
+		}
+		num++
+	}
+	return num, nil
+}
+
+func mvccResolveRangeTombstone(engine Engine, ms *MVCCStats, t MVCCRangeTombstone,
+	timestamp roachpb.Timestamp, txn *roachpb.Transaction) error {
+	iter := engine.NewIterator()
+	rts, err := mvccGetRangeTombstones(iter, t.StartKey, t.EndKey)
+	iter.Close()
+	if err != nil {
+		return err
+	}
+	rts = rts.without(&t)
+
+	commit := txn.Status == roachpb.COMMITTED
+	pushed := txn.Status == roachpb.PENDING && t.Txn.Timestamp.Less(txn.Timestamp)
+	if (commit || pushed) && t.Txn.Epoch == txn.Epoch {
+		newT := t
+		newT.Timestamp = txn.Timestamp
+		if pushed { // keep intent if we're pushing timestamp
+			newT.Txn = txn
+		} else {
+			newT.Txn = nil
+		}
+		if newT.Timestamp.Equal(t.Timestamp) {
+			return putRangeTombstone(engine, ms, &newT, rts)
+		}
+		// The range tombstone moves to its new timestamp.
+		if err := updateStatsForRangeTombstone(engine, ms, &t, rts, timestamp, false); err != nil {
+			return err
+		}
+		if err := clearRangeTombstone(engine, ms, &t); err != nil {
+			return err
+		}
+		if err := putRangeTombstone(engine, ms, &newT, rts); err != nil {
+			return err
+		}
+		return updateStatsForRangeTombstone(engine, ms, &newT, rts, timestamp, true)
+	}
+
+	// There's nothing to do if the range tombstone's epoch is greater than
+	// or equal to txn's epoch and the state is still PENDING.
+	if txn.Status == roachpb.PENDING && t.Txn.Epoch >= txn.Epoch {
+		return nil
+	}
+
+	// Otherwise, we're aborting the range tombstone.
+	if err := updateStatsForRangeTombstone(engine, ms, &t, rts, timestamp, false); err != nil {
+		return err
+	}
+	return clearRangeTombstone(engine, ms, &t)
+}
+
+// computeRangeTombstoneStats adjusts the stats computed by the
+// computeKeyStats method of iter for the keys in the encoded key range
+// [start,end) to account for the keys deleted by range tombstones, which
+// are looked for from minStart. It follows the computation of ages of
+// MVCCComputeStats in db.cc.
+func computeRangeTombstoneStats(iter Iterator, ms *MVCCStats, minStart roachpb.Key,
+	start, end []byte, nowNanos int64) error {
+	if bytes.Compare(end, MVCCEncodeKey(keys.LocalMax)) <= 0 {
+		return nil
+	}
+	addAge := func(age *int64, bytes int64, wallTime int64) {
+		*age = int64(float64(*age) + float64(bytes)*(float64(nowNanos-wallTime)/1e9))
+	}
+
+	var rts rangeTombstones
+	if err := mvccIterateRangeTombstones(iter, minStart, roachpb.KeyMax, func(t MVCCRangeTombstone) (bool, error) {
+		if bytes.Compare(MVCCEncodeKey(t.StartKey), end) >= 0 {
+			// The remaining range tombstones start past the key range.
+			return true, nil
+		}
+		if bytes.Compare(start, MVCCEncodeKey(t.EndKey)) < 0 {
+			rts = append(rts, t)
+		}
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for i := range rts {
+		t := &rts[i]
+		tStart, tEnd := MVCCEncodeKey(t.StartKey), MVCCEncodeKey(t.EndKey)
+		if tStart.Less(start) {
+			tStart = start
+		}
+		if MVCCKey(end).Less(tEnd) {
+			tEnd = end
+		}
+		if err := mvccIterateMetadata(iter, tStart, tEnd,
+			func(key roachpb.Key, metaKeySize, metaValSize int64, meta *MVCCMetadata) error {
+				if meta.IsInline() || meta.Txn != nil || meta.Deleted || rts.deleting(key, meta.Timestamp) != t {
+					return nil
+				}
+				metaBytes := metaKeySize + metaValSize
+				versionBytes := meta.KeyBytes + meta.ValBytes
+				ms.LiveBytes -= metaBytes + versionBytes
+				ms.LiveCount--
+				addAge(&ms.GCBytesAge, versionBytes, meta.Timestamp.WallTime)
+				addAge(&ms.GCBytesAge, metaBytes, t.Timestamp.WallTime)
+				return nil
+			}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MVCCSplitRangeTombstones splits the range tombstones starting in
+// [key,splitKey) whose span extends past splitKey in two, so that the
+// range tombstones of a range never extend past its end key. It doesn't
+// affect the keys deleted by the range tombstones.
+func MVCCSplitRangeTombstones(engine Engine, ms *MVCCStats, key, splitKey roachpb.Key) error {
+	var split rangeTombstones
+	iter := engine.NewIterator()
+	err := mvccIterateRangeTombstones(iter, key, splitKey, func(t MVCCRangeTombstone) (bool, error) {
+		if bytes.Compare(splitKey, t.EndKey) < 0 {
+			split = append(split, t)
+		}
+		return false, nil
+	})
+	iter.Close()
+	if err != nil {
+		return err
+	}
+	for _, t := range split {
+		rhs := t
+		rhs.StartKey = splitKey
+		t.EndKey = splitKey
+		if err := putRangeTombstone(engine, ms, &t, nil); err != nil {
+			return err
+		}
+		if err := putRangeTombstone(engine, ms, &rhs, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
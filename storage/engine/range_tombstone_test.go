@@ -0,0 +1,481 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package engine
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/randutil"
+	"github.com/cockroachdb/cockroach/util/stop"
+)
+
+// scanKeys returns the keys returned by a scan of [testKey1,keyMax) at
+// the given timestamp, both forward and in reverse.
+func scanKeys(t *testing.T, engine Engine, ts roachpb.Timestamp) ([]string, []string) {
+	var fwd, rev []string
+	kvs, _, err := MVCCScan(engine, testKey1, keyMax, 0, ts, true, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, kv := range kvs {
+		fwd = append(fwd, string(kv.Key))
+	}
+	kvs, _, err = MVCCReverseScan(engine, testKey1, keyMax, 0, ts, true, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := len(kvs) - 1; i >= 0; i-- {
+		rev = append(rev, string(kvs[i].Key))
+	}
+	return fwd, rev
+}
+
+// computeStats computes the stats of all the keys of the engine.
+func computeStats(t *testing.T, engine Engine, nowNanos int64) MVCCStats {
+	iter := engine.NewIterator()
+	defer iter.Close()
+	var ms MVCCStats
+	if err := iter.ComputeStats(&ms, roachpb.KeyMin, roachpb.KeyMax, nowNanos); err != nil {
+		t.Fatal(err)
+	}
+	return ms
+}
+
+// TestMVCCDeleteRangeTombstone verifies that an unbounded MVCCDeleteRange
+// writes a single range tombstone, which hides the earlier versions of
+// the keys it deletes from reads.
+func TestMVCCDeleteRangeTombstone(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+	engine := createTestEngine(stopper)
+
+	for _, key := range []roachpb.Key{testKey1, testKey2, testKey3, testKey4} {
+		if err := MVCCPut(engine, nil, key, makeTS(1, 0), value1, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	num, err := MVCCDeleteRange(engine, nil, testKey2, testKey4, 0, makeTS(2, 0), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if num != 2 {
+		t.Fatalf("expected 2 keys deleted; got %d", num)
+	}
+	if err := MVCCPut(engine, nil, testKey3, makeTS(3, 0), value3, nil); err != nil {
+		t.Fatal(err)
+	}
+	rts, err := MVCCGetRangeTombstones(engine, keyMin, keyMax)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expRTs := []MVCCRangeTombstone{{StartKey: testKey2, EndKey: testKey4, Timestamp: makeTS(2, 0)}}
+	if !reflect.DeepEqual(rts, expRTs) {
+		t.Fatalf("expected range tombstones %v; got %v", expRTs, rts)
+	}
+
+	testCases := []struct {
+		key    roachpb.Key
+		ts     roachpb.Timestamp
+		expVal *roachpb.Value
+	}{
+		{testKey1, makeTS(2, 0), &value1},
+		{testKey2, makeTS(1, 0), &value1},
+		{testKey2, makeTS(2, 0), nil},
+		{testKey3, makeTS(2, 0), nil},
+		{testKey3, makeTS(3, 0), &value3},
+		{testKey4, makeTS(2, 0), &value1},
+	}
+	for i, test := range testCases {
+		val, _, err := MVCCGet(engine, test.key, test.ts, true, nil)
+		if err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if (val == nil) != (test.expVal == nil) ||
+			(val != nil && !bytes.Equal(val.RawBytes, test.expVal.RawBytes)) {
+			t.Errorf("%d: expected %v; got %v", i, test.expVal, val)
+		}
+	}
+
+	for _, test := range []struct {
+		ts      roachpb.Timestamp
+		expKeys []string
+	}{
+		{makeTS(1, 0), []string{"/db1", "/db2", "/db3", "/db4"}},
+		{makeTS(2, 0), []string{"/db1", "/db4"}},
+		{makeTS(3, 0), []string{"/db1", "/db3", "/db4"}},
+	} {
+		fwd, rev := scanKeys(t, engine, test.ts)
+		if !reflect.DeepEqual(fwd, test.expKeys) || !reflect.DeepEqual(rev, test.expKeys) {
+			t.Errorf("at %s: expected %v; got %v and %v in reverse", test.ts, test.expKeys, fwd, rev)
+		}
+	}
+
+	// A transactional read below the range tombstone whose uncertainty
+	// interval includes it must be retried.
+	txn := makeTxn(txn1, makeTS(1, 0))
+	txn.MaxTimestamp = makeTS(2, 0)
+	if _, _, err := MVCCGet(engine, testKey2, makeTS(1, 0), true, txn); err == nil {
+		t.Fatal("expected a read within uncertainty interval error")
+	} else if _, ok := err.(*roachpb.ReadWithinUncertaintyIntervalError); !ok {
+		t.Fatalf("expected a read within uncertainty interval error; got %s", err)
+	}
+}
+
+// TestMVCCRangeTombstoneWriteTooOld verifies that writes below a range
+// tombstone are rejected.
+func TestMVCCRangeTombstoneWriteTooOld(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+	engine := createTestEngine(stopper)
+
+	if err := MVCCPut(engine, nil, testKey2, makeTS(1, 0), value1, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := MVCCDeleteRange(engine, nil, testKey1, testKey4, 0, makeTS(3, 0), nil); err != nil {
+		t.Fatal(err)
+	}
+	for _, ts := range []roachpb.Timestamp{makeTS(2, 0), makeTS(3, 0)} {
+		if err := MVCCPut(engine, nil, testKey3, ts, value2, nil); err == nil {
+			t.Errorf("expected write too old error at %s", ts)
+		} else if _, ok := err.(*roachpb.WriteTooOldError); !ok {
+			t.Errorf("expected write too old error at %s; got %s", ts, err)
+		}
+		if _, err := MVCCDeleteRange(engine, nil, testKey2, testKey3, 0, ts, nil); err == nil {
+			t.Errorf("expected write too old error at %s", ts)
+		} else if _, ok := err.(*roachpb.WriteTooOldError); !ok {
+			t.Errorf("expected write too old error at %s; got %s", ts, err)
+		}
+	}
+	if err := MVCCPut(engine, nil, testKey3, makeTS(4, 0), value2, nil); err != nil {
+		t.Fatal(err)
+	}
+	if fwd, _ := scanKeys(t, engine, makeTS(4, 0)); !reflect.DeepEqual(fwd, []string{"/db3"}) {
+		t.Errorf("expected only %s; got %v", testKey3, fwd)
+	}
+}
+
+// TestMVCCRangeTombstoneTxn verifies that a transactional range deletion
+// acts as a single intent over its span, which is resolved as a unit.
+func TestMVCCRangeTombstoneTxn(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	for _, commit := range []bool{true, false} {
+		stopper := stop.NewStopper()
+		engine := createTestEngine(stopper)
+
+		for _, key := range []roachpb.Key{testKey1, testKey2, testKey3} {
+			if err := MVCCPut(engine, nil, key, makeTS(1, 0), value1, nil); err != nil {
+				t.Fatal(err)
+			}
+		}
+		// The own intents of the transaction in the span are replaced.
+		txn := makeTxn(txn1, makeTS(2, 0))
+		if err := MVCCPut(engine, nil, testKey2, makeTS(2, 0), value2, txn); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := MVCCDeleteRange(engine, nil, testKey1, testKey3, 0, makeTS(2, 0), txn); err != nil {
+			t.Fatal(err)
+		}
+
+		// The transaction doesn't see the deleted keys.
+		kvs, _, err := MVCCScan(engine, testKey1, keyMax, 0, makeTS(2, 0), true, txn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(kvs) != 1 || !kvs[0].Key.Equal(testKey3) {
+			t.Fatalf("expected only %s; got %v", testKey3, kvs)
+		}
+
+		// Other readers and writers run into a single range intent.
+		expIntent := roachpb.Intent{Span: roachpb.Span{Key: testKey1, EndKey: testKey3}, Txn: *txn}
+		_, _, err = MVCCScan(engine, testKey1, keyMax, 0, makeTS(3, 0), true, nil)
+		if wiErr, ok := err.(*roachpb.WriteIntentError); !ok || !reflect.DeepEqual(wiErr.Intents, []roachpb.Intent{expIntent}) {
+			t.Fatalf("expected a write intent error for %v; got %v", expIntent, err)
+		}
+		kvs, intents, err := MVCCScan(engine, testKey1, keyMax, 0, makeTS(3, 0), false, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(kvs) != 3 || !reflect.DeepEqual(intents, []roachpb.Intent{expIntent}) {
+			t.Fatalf("expected 3 values and intent %v; got %v and %v", expIntent, kvs, intents)
+		}
+		err = MVCCPut(engine, nil, testKey2, makeTS(3, 0), value3, makeTxn(txn2, makeTS(3, 0)))
+		if wiErr, ok := err.(*roachpb.WriteIntentError); !ok || !reflect.DeepEqual(wiErr.Intents, []roachpb.Intent{expIntent}) {
+			t.Fatalf("expected a write intent error for %v; got %v", expIntent, err)
+		}
+		// Reads below the intent are unaffected.
+		if val, _, err := MVCCGet(engine, testKey1, makeTS(1, 0), true, nil); err != nil || val == nil {
+			t.Fatalf("expected a value below the intent; got %v, %v", val, err)
+		}
+
+		// Resolve the intents, committing at a pushed timestamp.
+		resolveTxn := makeTxn(txn1Commit, makeTS(4, 0))
+		if !commit {
+			resolveTxn = makeTxn(txn1Abort, makeTS(4, 0))
+		}
+		if _, err := MVCCResolveWriteIntentRange(engine, nil, testKey1, testKey3, 0, makeTS(4, 0), resolveTxn); err != nil {
+			t.Fatal(err)
+		}
+		rts, err := MVCCGetRangeTombstones(engine, keyMin, keyMax)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var expRTs []MVCCRangeTombstone
+		expKeys := []string{"/db1", "/db2", "/db3"}
+		if commit {
+			expRTs = []MVCCRangeTombstone{{StartKey: testKey1, EndKey: testKey3, Timestamp: makeTS(4, 0)}}
+			expKeys = []string{"/db3"}
+		}
+		if !reflect.DeepEqual(rts, expRTs) {
+			t.Errorf("commit=%t: expected range tombstones %v; got %v", commit, expRTs, rts)
+		}
+		if fwd, _ := scanKeys(t, engine, makeTS(4, 0)); !reflect.DeepEqual(fwd, expKeys) {
+			t.Errorf("commit=%t: expected %v; got %v", commit, expKeys, fwd)
+		}
+		// Either way, the own intent replaced by the range deletion is gone.
+		if val, _, err := MVCCGet(engine, testKey2, makeTS(3, 0), true, nil); err != nil {
+			t.Fatal(err)
+		} else if val != nil && !bytes.Equal(val.RawBytes, value1.RawBytes) {
+			t.Errorf("commit=%t: expected the aborted intent to be gone; got %v", commit, val)
+		}
+		stopper.Stop()
+	}
+}
+
+// TestMVCCRangeTombstoneStatsWithRandomRuns verifies that the stats
+// updated by range deletions, and by the writes and resolutions of
+// intents over the keys they delete, match the computed stats.
+func TestMVCCRangeTombstoneStatsWithRandomRuns(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	rng, seed := randutil.NewPseudoRand()
+	log.Infof("using pseudo random number generator with seed %d", seed)
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+	engine := createTestEngine(stopper)
+
+	ms := &MVCCStats{}
+	key := func() roachpb.Key {
+		return roachpb.Key(fmt.Sprintf("key-%02d", rng.Int31n(50)))
+	}
+	var pending []*roachpb.Transaction
+	for i := int32(0); i < int32(500); i++ {
+		// Manually advance aggregate intent and gc'able bytes ages based on
+		// one extra second of simulation.
+		ms.IntentAge += ms.IntentCount
+		ms.GCBytesAge += ms.KeyBytes + ms.ValBytes - ms.LiveBytes
+
+		ts := makeTS(int64(i+1)*1E9, 0)
+		var txn *roachpb.Transaction
+		if rng.Int31n(2) == 0 {
+			txn = &roachpb.Transaction{ID: []byte(fmt.Sprintf("txn-%d", i)), Timestamp: ts, MaxTimestamp: ts}
+		}
+		var err error
+		switch op := rng.Int31n(10); {
+		case op < 5:
+			err = MVCCPut(engine, ms, key(), ts, roachpb.MakeValueFromBytes(randutil.RandBytes(rng, int(rng.Int31n(32)))), txn)
+		case op < 6:
+			err = MVCCDelete(engine, ms, key(), ts, txn)
+		default:
+			start, end := key(), key()
+			if end.Compare(start) < 0 {
+				start, end = end, start
+			}
+			_, err = MVCCDeleteRange(engine, ms, start, end.Next(), 0, ts, txn)
+			if err == nil && txn != nil {
+				// Also write to a key of the span within the transaction.
+				err = MVCCPut(engine, ms, start, ts, roachpb.MakeValueFromString("own"), txn)
+			}
+		}
+		if err != nil {
+			if _, ok := err.(*roachpb.WriteIntentError); !ok {
+				t.Fatalf("%d: %s", i, err)
+			}
+			continue
+		}
+		if txn != nil {
+			pending = append(pending, txn)
+		}
+		// Resolve a pending transaction with 50% probability, aborting it
+		// with 20% probability.
+		if len(pending) > 0 && rng.Int31n(2) == 0 {
+			idx := rng.Int31n(int32(len(pending)))
+			resolveTxn := *pending[idx]
+			resolveTxn.Status = roachpb.COMMITTED
+			if rng.Int31n(5) == 0 {
+				resolveTxn.Status = roachpb.ABORTED
+			}
+			if _, err := MVCCResolveWriteIntentRange(engine, ms, keyMin, keyMax, 0, ts, &resolveTxn); err != nil {
+				t.Fatal(err)
+			}
+			pending = append(pending[:idx], pending[idx+1:]...)
+		}
+
+		expMS := computeStats(t, engine, ts.WallTime)
+		verifyStats(fmt.Sprintf("cycle %d", i), ms, &expMS, t)
+		if t.Failed() {
+			t.FailNow()
+		}
+	}
+}
+
+// TestMVCCRangeTombstoneGC verifies that the values deleted by a range
+// tombstone may be garbage collected, after which the range tombstone
+// itself may be.
+func TestMVCCRangeTombstoneGC(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+	engine := createTestEngine(stopper)
+
+	ms := &MVCCStats{}
+	ts1, ts2, ts3 := makeTS(1E9, 0), makeTS(2E9, 0), makeTS(3E9, 0)
+	for _, key := range []roachpb.Key{testKey1, testKey2} {
+		if err := MVCCPut(engine, ms, key, ts1, value1, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ms.GCBytesAge += ms.KeyBytes + ms.ValBytes - ms.LiveBytes
+	if _, err := MVCCDeleteRange(engine, ms, testKey1, testKey3, 0, ts2, nil); err != nil {
+		t.Fatal(err)
+	}
+	ms.GCBytesAge += ms.KeyBytes + ms.ValBytes - ms.LiveBytes
+
+	// The latest values can't be GC'd below the range tombstone.
+	if err := MVCCGarbageCollect(engine, ms, []roachpb.GCRequest_GCKey{
+		{Key: testKey1, Timestamp: ts1},
+	}, ts3); err == nil {
+		t.Fatal("expected an error GC'ing a value not deleted at the GC timestamp")
+	}
+	rt := keys.RangeTombstoneKey(testKey1, ts2)
+	if err := MVCCGarbageCollect(engine, ms, []roachpb.GCRequest_GCKey{
+		{Key: testKey1, Timestamp: ts2.Prev()},
+		{Key: testKey2, Timestamp: ts2.Prev()},
+		{Key: rt},
+	}, ts3); err != nil {
+		t.Fatal(err)
+	}
+	kvs, err := Scan(engine, MVCCEncodeKey(keyMin), MVCCEncodeKey(keyMax), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(kvs) != 0 {
+		t.Errorf("expected all keys to be GC'd; got %d", len(kvs))
+	}
+	expMS := computeStats(t, engine, ts3.WallTime)
+	verifyStats("verification", ms, &expMS, t)
+}
+
+// TestMVCCSplitRangeTombstones verifies that splitting range tombstones
+// doesn't affect the keys they delete.
+func TestMVCCSplitRangeTombstones(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+	engine := createTestEngine(stopper)
+
+	for _, key := range []roachpb.Key{testKey1, testKey2, testKey3} {
+		if err := MVCCPut(engine, nil, key, makeTS(1, 0), value1, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := MVCCDeleteRange(engine, nil, testKey1, testKey3, 0, makeTS(2, 0), nil); err != nil {
+		t.Fatal(err)
+	}
+	before := computeStats(t, engine, 2)
+	if err := MVCCSplitRangeTombstones(engine, nil, keyMin, testKey2); err != nil {
+		t.Fatal(err)
+	}
+	rts, err := MVCCGetRangeTombstones(engine, keyMin, keyMax)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expRTs := []MVCCRangeTombstone{
+		{StartKey: testKey1, EndKey: testKey2, Timestamp: makeTS(2, 0)},
+		{StartKey: testKey2, EndKey: testKey3, Timestamp: makeTS(2, 0)},
+	}
+	if !reflect.DeepEqual(rts, expRTs) {
+		t.Fatalf("expected range tombstones %v; got %v", expRTs, rts)
+	}
+	if fwd, _ := scanKeys(t, engine, makeTS(2, 0)); !reflect.DeepEqual(fwd, []string{"/db3"}) {
+		t.Errorf("expected only %s; got %v", testKey3, fwd)
+	}
+	after := computeStats(t, engine, 2)
+	if before.LiveBytes != after.LiveBytes || before.LiveCount != after.LiveCount || before.GCBytesAge != after.GCBytesAge {
+		t.Errorf("expected the stats of the data to be unaffected: %+v != %+v", before, after)
+	}
+}
+
+// TestMVCCRangeEngine verifies that the MVCC functions operating on an
+// engine returned by NewRangeEngine only look for range tombstones from
+// the start key of the range.
+func TestMVCCRangeEngine(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+	engine := createTestEngine(stopper)
+
+	for _, key := range []roachpb.Key{testKey1, testKey2, testKey3} {
+		if err := MVCCPut(engine, nil, key, makeTS(1, 0), value1, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := MVCCDeleteRange(engine, nil, testKey1, testKey3, 0, makeTS(2, 0), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// The range tombstone isn't split at testKey2, so it's invisible to a
+	// range starting there.
+	rangeEngine := NewRangeEngine(engine, testKey2)
+	if value, _, err := MVCCGet(engine, testKey2, makeTS(2, 0), true, nil); err != nil || value != nil {
+		t.Fatalf("expected %s to be deleted; got %v, %v", testKey2, value, err)
+	}
+	if value, _, err := MVCCGet(rangeEngine, testKey2, makeTS(2, 0), true, nil); err != nil || value == nil {
+		t.Fatalf("expected %s to be visible in the range; got %v, %v", testKey2, value, err)
+	}
+
+	// Once split, the range tombstones of the range are found, and the
+	// stats of the range are unaffected by the bound.
+	if err := MVCCSplitRangeTombstones(engine, nil, keyMin, testKey2); err != nil {
+		t.Fatal(err)
+	}
+	if value, _, err := MVCCGet(rangeEngine, testKey2, makeTS(2, 0), true, nil); err != nil || value != nil {
+		t.Fatalf("expected %s to be deleted; got %v, %v", testKey2, value, err)
+	}
+	var expMS, ms MVCCStats
+	start, end := MVCCEncodeKey(testKey2), MVCCEncodeKey(keyMax)
+	iter := engine.NewIterator()
+	defer iter.Close()
+	if err := iter.ComputeStats(&expMS, start, end, 2E9); err != nil {
+		t.Fatal(err)
+	}
+	rangeIter := rangeEngine.NewIterator()
+	defer rangeIter.Close()
+	if err := rangeIter.ComputeStats(&ms, start, end, 2E9); err != nil {
+		t.Fatal(err)
+	}
+	if expMS.LiveCount != 1 || !reflect.DeepEqual(ms, expMS) {
+		t.Errorf("expected stats %+v with a single live key; got %+v", expMS, ms)
+	}
+}
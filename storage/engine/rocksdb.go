@@ -24,6 +24,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"sync/atomic"
 	"syscall"
 	"unsafe"
 
@@ -54,6 +55,8 @@ type RocksDB struct {
 	attrs       roachpb.Attributes // Attributes for this engine
 	dir         string             // The data directory
 	cacheSize   int64              // Memory to use to cache values.
+	encryption  *EncryptionOptions // Nil unless the data files are encrypted
+	rewriting   int32              // Set while files are rewritten with the active key
 	stopper     *stop.Stopper
 	deallocated chan struct{} // Closed when the underlying handle is deallocated.
 }
@@ -72,6 +75,20 @@ func NewRocksDB(attrs roachpb.Attributes, dir string, cacheSize int64, stopper *
 	}
 }
 
+// NewEncryptedRocksDB allocates and returns a new RocksDB object whose
+// files are encrypted at rest with the active key of the encryption
+// options. Files encrypted with other keys, or not encrypted, are rewritten
+// with the active key in the background after the database is opened. The
+// files are encrypted with AES in counter mode without authentication, and
+// every read and write of a file makes a cgo callback to apply the key
+// stream.
+func NewEncryptedRocksDB(attrs roachpb.Attributes, dir string, cacheSize int64,
+	encryption EncryptionOptions, stopper *stop.Stopper) *RocksDB {
+	r := NewRocksDB(attrs, dir, cacheSize, stopper)
+	r.encryption = &encryption
+	return r
+}
+
 func newMemRocksDB(attrs roachpb.Attributes, cacheSize int64, stopper *stop.Stopper) *RocksDB {
 	return &RocksDB{
 		attrs: attrs,
@@ -101,12 +118,20 @@ func (r *RocksDB) Open() error {
 
 	if len(r.dir) != 0 {
 		log.Infof("opening rocksdb instance at %q", r.dir)
+		if err := r.checkEncryptionKeys(); err != nil {
+			return util.Errorf("could not open rocksdb instance: %s", err)
+		}
+	}
+	var activeKeyID []byte
+	if r.encryption != nil {
+		activeKeyID = []byte(r.encryption.ActiveKey.ID)
 	}
 	status := C.DBOpen(&r.rdb, goToCSlice([]byte(r.dir)),
 		C.DBOptions{
-			cache_size:      C.int64_t(r.cacheSize),
-			allow_os_buffer: C.bool(true),
-			logging_enabled: C.bool(log.V(3)),
+			cache_size:        C.int64_t(r.cacheSize),
+			allow_os_buffer:   C.bool(true),
+			logging_enabled:   C.bool(log.V(3)),
+			encryption_key_id: goToCSlice(activeKeyID),
 		})
 	err := statusToError(status)
 	if err != nil {
@@ -119,9 +144,97 @@ func (r *RocksDB) Open() error {
 		<-r.deallocated
 	}()
 	r.stopper.AddCloser(r)
+	if r.encryption != nil && len(r.dir) != 0 {
+		r.stopper.RunWorker(r.rewriteEncryptedFiles)
+	}
+	return nil
+}
+
+// checkEncryptionKeys registers the encryption keys of the database, and
+// verifies that the keys its existing files are encrypted with are known.
+func (r *RocksDB) checkEncryptionKeys() error {
+	known := map[string]struct{}{}
+	if r.encryption != nil {
+		for _, key := range append([]EncryptionKey{r.encryption.ActiveKey}, r.encryption.OldKeys...) {
+			if err := rocksdb.AddEncryptionKey(key.ID, key.Key); err != nil {
+				return err
+			}
+			known[key.ID] = struct{}{}
+		}
+	}
+	files, err := listEncryptedFiles(r.dir)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		if file.keyID == "" {
+			continue
+		}
+		if r.encryption == nil {
+			return util.Errorf("file %s is encrypted with key %s, but no encryption key was specified",
+				file.name, file.keyID)
+		}
+		if _, ok := known[file.keyID]; !ok {
+			return util.Errorf("file %s is encrypted with unknown key %s", file.name, file.keyID)
+		}
+	}
 	return nil
 }
 
+// EncryptionStatus returns the active encryption key of the database, and
+// how many of its files are encrypted with it.
+func (r *RocksDB) EncryptionStatus() (EncryptionStatus, error) {
+	var status EncryptionStatus
+	if r.encryption != nil {
+		status.ActiveKeyID = r.encryption.ActiveKey.ID
+	}
+	if len(r.dir) == 0 {
+		return status, nil
+	}
+	files, err := listEncryptedFiles(r.dir)
+	if err != nil {
+		return status, err
+	}
+	for _, file := range files {
+		status.TotalFiles++
+		status.TotalBytes += file.size
+		if file.keyID == status.ActiveKeyID {
+			status.ActiveFiles++
+			status.ActiveBytes += file.size
+		}
+	}
+	status.Rewriting = atomic.LoadInt32(&r.rewriting) == 1
+	return status, nil
+}
+
+// rewriteEncryptedFiles rewrites the files of the database which are not
+// encrypted with the active key, e.g. after a key rotation. Opening the
+// database already rewrote its manifest and write-ahead log; flushing the
+// memtable and compacting the whole key space rewrites the data files.
+func (r *RocksDB) rewriteEncryptedFiles() {
+	status, err := r.EncryptionStatus()
+	if err != nil {
+		log.Warningf("unable to determine the encryption status of rocksdb instance at %q: %s", r.dir, err)
+		return
+	}
+	if status.ActiveFiles == status.TotalFiles {
+		return
+	}
+	atomic.StoreInt32(&r.rewriting, 1)
+	defer atomic.StoreInt32(&r.rewriting, 0)
+	log.Infof("rewriting %d files of rocksdb instance at %q with encryption key %s",
+		status.TotalFiles-status.ActiveFiles, r.dir, status.ActiveKeyID)
+	if err := r.Flush(); err != nil {
+		log.Warningf("unable to flush rocksdb instance at %q: %s", r.dir, err)
+		return
+	}
+	r.CompactRange(nil, nil)
+	if status, err = r.EncryptionStatus(); err == nil {
+		log.Infof("%d of %d files of rocksdb instance at %q are encrypted with key %s",
+			status.ActiveFiles, status.TotalFiles, r.dir, status.ActiveKeyID)
+	}
+}
+
 // Close closes the database by deallocating the underlying handle.
 func (r *RocksDB) Close() {
 	if r.rdb == nil {
@@ -251,6 +364,28 @@ func (r *RocksDB) Flush() error {
 	return statusToError(C.DBFlush(r.rdb))
 }
 
+// GetStats retrieves stats from the RocksDB statistics and properties.
+func (r *RocksDB) GetStats() (*Stats, error) {
+	var s C.DBStatsResult
+	if err := statusToError(C.DBGetStats(r.rdb, &s)); err != nil {
+		return nil, err
+	}
+	return &Stats{
+		BlockCacheHits:          int64(s.block_cache_hits),
+		BlockCacheMisses:        int64(s.block_cache_misses),
+		BlockCacheUsage:         int64(s.block_cache_usage),
+		MemtableTotalSize:       int64(s.memtable_total_size),
+		BytesWritten:            int64(s.bytes_written),
+		FlushBytesWritten:       int64(s.flush_bytes_written),
+		CompactionBytesRead:     int64(s.compaction_bytes_read),
+		CompactionBytesWritten:  int64(s.compaction_bytes_written),
+		CompactionPending:       int64(s.compaction_pending),
+		TableReadersMemEstimate: int64(s.table_readers_mem_estimate),
+		ReadAmplification:       int64(s.read_amplification),
+		StallMicros:             int64(s.stall_micros),
+	}, nil
+}
+
 // NewIterator returns an iterator over this rocksdb engine.
 func (r *RocksDB) NewIterator() Iterator {
 	return newRocksDBIterator(r.rdb)
@@ -356,6 +491,11 @@ func (r *rocksDBSnapshot) Flush() error {
 	return nil
 }
 
+// GetStats returns the stats of the underlying engine.
+func (r *rocksDBSnapshot) GetStats() (*Stats, error) {
+	return r.parent.GetStats()
+}
+
 // NewIterator returns a new instance of an Iterator over the
 // engine using the snapshot handle.
 func (r *rocksDBSnapshot) NewIterator() Iterator {
@@ -451,6 +591,10 @@ func (r *rocksDBBatch) Flush() error {
 	return util.Errorf("cannot flush a batch")
 }
 
+func (r *rocksDBBatch) GetStats() (*Stats, error) {
+	return r.parent.GetStats()
+}
+
 func (r *rocksDBBatch) NewIterator() Iterator {
 	return newRocksDBIterator(r.batch)
 }
@@ -595,6 +739,13 @@ func (r *rocksDBIterator) setState(state C.DBIterState) {
 }
 
 func (r *rocksDBIterator) ComputeStats(ms *MVCCStats, start, end []byte, nowNanos int64) error {
+	if err := r.computeKeyStats(ms, start, end, nowNanos); err != nil {
+		return err
+	}
+	return computeRangeTombstoneStats(r, ms, roachpb.KeyMin, start, end, nowNanos)
+}
+
+func (r *rocksDBIterator) computeKeyStats(ms *MVCCStats, start, end []byte, nowNanos int64) error {
 	result := C.MVCCComputeStats(r.iter, goToCSlice(start), goToCSlice(end), C.int64_t(nowNanos))
 	if err := statusToError(result.status); err != nil {
 		return err
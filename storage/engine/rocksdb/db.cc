@@ -19,6 +19,9 @@
 #include <algorithm>
 #include <limits>
 #include <stdarg.h>
+#include <stdlib.h>
+#include <string.h>
+#include <string>
 #include <google/protobuf/repeated_field.h>
 #include <google/protobuf/stubs/stringprintf.h>
 #include "rocksdb/cache.h"
@@ -27,6 +30,7 @@
 #include "rocksdb/env.h"
 #include "rocksdb/merge_operator.h"
 #include "rocksdb/options.h"
+#include "rocksdb/statistics.h"
 #include "rocksdb/table.h"
 #include "rocksdb/utilities/write_batch_with_index.h"
 #include "cockroach/roachpb/api.pb.h"
@@ -35,6 +39,7 @@
 #include "cockroach/storage/engine/mvcc.pb.h"
 #include "db.h"
 #include "encoding.h"
+#include "encryption.h"
 
 extern "C" {
 #include "_cgo_export.h"
@@ -53,20 +58,24 @@ struct DBEngine {
   virtual DBStatus WriteBatch() = 0;
   virtual DBStatus Get(DBSlice key, DBString* value) = 0;
   virtual DBIterator* NewIter() = 0;
+  virtual DBStatus GetStats(DBStatsResult* stats) = 0;
 };
 
 struct DBImpl : public DBEngine {
-  std::unique_ptr<rocksdb::Env> memenv;
+  std::unique_ptr<rocksdb::Env> env;
   std::unique_ptr<rocksdb::DB> rep_deleter;
+  std::shared_ptr<rocksdb::Cache> block_cache;
   rocksdb::ReadOptions const read_opts;
 
   // Construct a new DBImpl from the specified DB and Env. Both the DB
   // and Env will be deleted when the DBImpl is deleted. It is ok to
-  // pass NULL for the Env.
-  DBImpl(rocksdb::DB* r, rocksdb::Env* m)
+  // pass NULL for the Env. The block cache, which may be NULL, is only
+  // retained for reporting its usage.
+  DBImpl(rocksdb::DB* r, rocksdb::Env* e, std::shared_ptr<rocksdb::Cache> bc)
       : DBEngine(r),
-        memenv(m),
-        rep_deleter(r) {
+        env(e),
+        rep_deleter(r),
+        block_cache(bc) {
   }
   virtual ~DBImpl() {
   }
@@ -77,6 +86,7 @@ struct DBImpl : public DBEngine {
   virtual DBStatus WriteBatch();
   virtual DBStatus Get(DBSlice key, DBString* value);
   virtual DBIterator* NewIter();
+  virtual DBStatus GetStats(DBStatsResult* stats);
 };
 
 struct DBBatch : public DBEngine {
@@ -97,6 +107,7 @@ struct DBBatch : public DBEngine {
   virtual DBStatus WriteBatch();
   virtual DBStatus Get(DBSlice key, DBString* value);
   virtual DBIterator* NewIter();
+  virtual DBStatus GetStats(DBStatsResult* stats);
 };
 
 struct DBSnapshot : public DBEngine {
@@ -118,6 +129,7 @@ struct DBSnapshot : public DBEngine {
   virtual DBStatus WriteBatch();
   virtual DBStatus Get(DBSlice key, DBString* value);
   virtual DBIterator* NewIter();
+  virtual DBStatus GetStats(DBStatsResult* stats);
 };
 
 struct DBIterator {
@@ -1097,6 +1109,7 @@ DBStatus DBOpen(DBEngine **db, DBSlice dir, DBOptions db_opts) {
   options.create_if_missing = true;
   options.info_log.reset(new DBLogger(db_opts.logging_enabled));
   options.merge_operator.reset(new DBMergeOperator);
+  options.statistics = rocksdb::CreateDBStatistics();
   options.table_factory.reset(rocksdb::NewBlockBasedTableFactory(table_options));
   options.write_buffer_size = 64 << 20;           // 64 MB
   options.target_file_size_base = 64 << 20;       // 64 MB
@@ -1106,10 +1119,13 @@ DBStatus DBOpen(DBEngine **db, DBSlice dir, DBOptions db_opts) {
         row_cache_size, num_cache_shard_bits);
   }
 
-  std::unique_ptr<rocksdb::Env> memenv;
+  std::unique_ptr<rocksdb::Env> env;
   if (dir.len == 0) {
-    memenv.reset(rocksdb::NewMemEnv(rocksdb::Env::Default()));
-    options.env = memenv.get();
+    env.reset(rocksdb::NewMemEnv(rocksdb::Env::Default()));
+    options.env = env.get();
+  } else if (db_opts.encryption_key_id.len > 0) {
+    env.reset(NewEncryptedEnv(rocksdb::Env::Default(), ToString(db_opts.encryption_key_id)));
+    options.env = env.get();
   }
 
   rocksdb::DB *db_ptr;
@@ -1117,7 +1133,7 @@ DBStatus DBOpen(DBEngine **db, DBSlice dir, DBOptions db_opts) {
   if (!status.ok()) {
     return ToDBStatus(status);
   }
-  *db = new DBImpl(db_ptr, memenv.release());
+  *db = new DBImpl(db_ptr, env.release(), table_options.block_cache);
   return kSuccess;
 }
 
@@ -1165,6 +1181,65 @@ uint64_t DBApproximateSize(DBEngine* db, DBSlice start, DBSlice end) {
   return result;
 }
 
+DBStatus DBImpl::GetStats(DBStatsResult* stats) {
+  const std::shared_ptr<rocksdb::Statistics>& s = rep->GetOptions().statistics;
+  if (s == NULL) {
+    return FmtStatus("statistics are not enabled");
+  }
+  memset(stats, 0, sizeof(*stats));
+  stats->block_cache_hits = (int64_t)s->getTickerCount(rocksdb::BLOCK_CACHE_HIT);
+  stats->block_cache_misses = (int64_t)s->getTickerCount(rocksdb::BLOCK_CACHE_MISS);
+  if (block_cache != NULL) {
+    stats->block_cache_usage = (int64_t)block_cache->GetUsage();
+  }
+  stats->bytes_written = (int64_t)s->getTickerCount(rocksdb::BYTES_WRITTEN);
+  stats->flush_bytes_written = (int64_t)s->getTickerCount(rocksdb::FLUSH_WRITE_BYTES);
+  stats->compaction_bytes_read = (int64_t)s->getTickerCount(rocksdb::COMPACT_READ_BYTES);
+  stats->compaction_bytes_written = (int64_t)s->getTickerCount(rocksdb::COMPACT_WRITE_BYTES);
+  stats->stall_micros = (int64_t)s->getTickerCount(rocksdb::STALL_MICROS);
+
+  uint64_t value;
+  if (rep->GetIntProperty("rocksdb.cur-size-all-mem-tables", &value)) {
+    stats->memtable_total_size = (int64_t)value;
+  }
+  if (rep->GetIntProperty("rocksdb.compaction-pending", &value)) {
+    stats->compaction_pending = (int64_t)value;
+  }
+  if (rep->GetIntProperty("rocksdb.estimate-table-readers-mem", &value)) {
+    stats->table_readers_mem_estimate = (int64_t)value;
+  }
+
+  // The read amplification is the number of sstables a point lookup
+  // may need to consult in the worst case: every file in level 0
+  // (whose key ranges overlap) plus one file per non-empty lower
+  // level.
+  for (int level = 0; level < rep->NumberLevels(); level++) {
+    std::string files;
+    if (!rep->GetProperty("rocksdb.num-files-at-level" + std::to_string(level), &files)) {
+      continue;
+    }
+    const int64_t n = atoll(files.c_str());
+    if (level == 0) {
+      stats->read_amplification += n;
+    } else if (n > 0) {
+      stats->read_amplification++;
+    }
+  }
+  return kSuccess;
+}
+
+DBStatus DBBatch::GetStats(DBStatsResult* stats) {
+  return FmtStatus("unsupported");
+}
+
+DBStatus DBSnapshot::GetStats(DBStatsResult* stats) {
+  return FmtStatus("unsupported");
+}
+
+DBStatus DBGetStats(DBEngine* db, DBStatsResult* stats) {
+  return db->GetStats(stats);
+}
+
 DBStatus DBImpl::Put(DBSlice key, DBSlice value) {
   rocksdb::WriteOptions options;
   return ToDBStatus(rep->Put(options, ToSlice(key), ToSlice(value)));
@@ -57,6 +57,9 @@ typedef struct {
   int64_t cache_size;
   bool allow_os_buffer;
   bool logging_enabled;
+  // The ID of the key the files of the database are encrypted with, or
+  // empty for a plaintext database.
+  DBSlice encryption_key_id;
 } DBOptions;
 
 // Opens the database located in "dir", creating it if it doesn't
@@ -88,6 +91,28 @@ DBStatus DBCompactRange(DBEngine* db, DBSlice* start, DBSlice* end);
 // range [start,end].
 uint64_t DBApproximateSize(DBEngine* db, DBSlice start, DBSlice end);
 
+// DBStatsResult contains statistics gathered from within the
+// database. The ticker counts are cumulative since the database was
+// opened; the remaining fields are point-in-time gauges.
+typedef struct {
+  int64_t block_cache_hits;
+  int64_t block_cache_misses;
+  int64_t block_cache_usage;
+  int64_t memtable_total_size;
+  int64_t bytes_written;
+  int64_t flush_bytes_written;
+  int64_t compaction_bytes_read;
+  int64_t compaction_bytes_written;
+  int64_t compaction_pending;
+  int64_t table_readers_mem_estimate;
+  int64_t read_amplification;
+  int64_t stall_micros;
+} DBStatsResult;
+
+// Retrieves the statistics of the database. Only supported on the
+// engine returned by DBOpen, not on snapshots or batches.
+DBStatus DBGetStats(DBEngine* db, DBStatsResult* stats);
+
 // Sets the database entry for "key" to "value".
 DBStatus DBPut(DBEngine* db, DBSlice key, DBSlice value);
 
@@ -0,0 +1,364 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.  See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+#include <string.h>
+#include "encryption.h"
+
+extern "C" {
+#include "_cgo_export.h"
+}  // extern "C"
+
+namespace {
+
+// FileCipher encrypts and decrypts the contents of a file, given the key
+// ID and IV of its header. A FileCipher without a key ID leaves the data
+// of a plaintext file untouched.
+class FileCipher {
+ public:
+  FileCipher() { }
+  FileCipher(const std::string& key_id, const std::string& iv)
+      : key_id_(key_id),
+        iv_(iv) {
+  }
+
+  bool encrypted() const { return !key_id_.empty(); }
+
+  // XOR applies the key stream at the specified offset of the file
+  // contents to the n bytes at data, which encrypts plaintext and decrypts
+  // ciphertext.
+  rocksdb::Status XOR(uint64_t offset, char* data, size_t n) const {
+    if (!encrypted() || n == 0) {
+      return rocksdb::Status::OK();
+    }
+    if (rocksDBEncryptionXOR(const_cast<char*>(key_id_.data()), key_id_.size(),
+                             const_cast<char*>(iv_.data()), offset, data, n) != 0) {
+      return rocksdb::Status::Corruption("unknown encryption key", key_id_);
+    }
+    return rocksdb::Status::OK();
+  }
+
+ private:
+  std::string key_id_;
+  std::string iv_;
+};
+
+// ReadHeader reads the header of the file fname, if it has one, and
+// initializes cipher accordingly.
+rocksdb::Status ReadHeader(rocksdb::Env* env, const std::string& fname,
+                           const rocksdb::EnvOptions& options, FileCipher* cipher) {
+  std::unique_ptr<rocksdb::SequentialFile> file;
+  rocksdb::Status status = env->NewSequentialFile(fname, &file, options);
+  if (!status.ok()) {
+    return status;
+  }
+  char scratch[kEncryptionHeaderSize];
+  rocksdb::Slice header;
+  status = file->Read(kEncryptionHeaderSize, &header, scratch);
+  if (!status.ok()) {
+    return status;
+  }
+  if (header.size() < kEncryptionHeaderSize ||
+      memcmp(header.data(), kEncryptionMagic, kEncryptionMagicSize) != 0) {
+    *cipher = FileCipher();
+    return rocksdb::Status::OK();
+  }
+  *cipher = FileCipher(
+      std::string(header.data() + kEncryptionMagicSize, kEncryptionKeyIDSize),
+      std::string(header.data() + kEncryptionMagicSize + kEncryptionKeyIDSize, kEncryptionIVSize));
+  return rocksdb::Status::OK();
+}
+
+class EncryptedSequentialFile : public rocksdb::SequentialFile {
+ public:
+  EncryptedSequentialFile(rocksdb::SequentialFile* file, const FileCipher& cipher)
+      : file_(file),
+        cipher_(cipher),
+        offset_(0) {
+  }
+
+  virtual rocksdb::Status Read(size_t n, rocksdb::Slice* result, char* scratch) {
+    rocksdb::Status status = file_->Read(n, result, scratch);
+    if (!status.ok() || !cipher_.encrypted()) {
+      return status;
+    }
+    if (result->data() != scratch) {
+      memcpy(scratch, result->data(), result->size());
+      *result = rocksdb::Slice(scratch, result->size());
+    }
+    status = cipher_.XOR(offset_, scratch, result->size());
+    offset_ += result->size();
+    return status;
+  }
+
+  virtual rocksdb::Status Skip(uint64_t n) {
+    rocksdb::Status status = file_->Skip(n);
+    if (status.ok()) {
+      offset_ += n;
+    }
+    return status;
+  }
+
+  virtual rocksdb::Status InvalidateCache(size_t offset, size_t length) {
+    return file_->InvalidateCache(offset + HeaderSize(), length);
+  }
+
+ private:
+  size_t HeaderSize() const {
+    return cipher_.encrypted() ? kEncryptionHeaderSize : 0;
+  }
+
+  std::unique_ptr<rocksdb::SequentialFile> file_;
+  const FileCipher cipher_;
+  uint64_t offset_;
+};
+
+class EncryptedRandomAccessFile : public rocksdb::RandomAccessFile {
+ public:
+  EncryptedRandomAccessFile(rocksdb::RandomAccessFile* file, const FileCipher& cipher)
+      : file_(file),
+        cipher_(cipher) {
+  }
+
+  virtual rocksdb::Status Read(uint64_t offset, size_t n, rocksdb::Slice* result,
+                               char* scratch) const {
+    if (!cipher_.encrypted()) {
+      return file_->Read(offset, n, result, scratch);
+    }
+    rocksdb::Status status = file_->Read(offset + kEncryptionHeaderSize, n, result, scratch);
+    if (!status.ok()) {
+      return status;
+    }
+    if (result->data() != scratch) {
+      memcpy(scratch, result->data(), result->size());
+      *result = rocksdb::Slice(scratch, result->size());
+    }
+    return cipher_.XOR(offset, scratch, result->size());
+  }
+
+  virtual size_t GetUniqueId(char* id, size_t max_size) const {
+    return file_->GetUniqueId(id, max_size);
+  }
+
+  virtual void Hint(AccessPattern pattern) {
+    file_->Hint(pattern);
+  }
+
+  virtual rocksdb::Status InvalidateCache(size_t offset, size_t length) {
+    return file_->InvalidateCache(offset + HeaderSize(), length);
+  }
+
+ private:
+  size_t HeaderSize() const {
+    return cipher_.encrypted() ? kEncryptionHeaderSize : 0;
+  }
+
+  std::unique_ptr<rocksdb::RandomAccessFile> file_;
+  const FileCipher cipher_;
+};
+
+// EncryptedWritableFile encrypts the data appended to a file whose header
+// has already been written.
+class EncryptedWritableFile : public rocksdb::WritableFile {
+ public:
+  EncryptedWritableFile(rocksdb::WritableFile* file, const FileCipher& cipher)
+      : file_(file),
+        cipher_(cipher),
+        offset_(0),
+        truncated_(false) {
+  }
+
+  virtual rocksdb::Status Append(const rocksdb::Slice& data) {
+    if (truncated_) {
+      return rocksdb::Status::NotSupported("append to a truncated encrypted file");
+    }
+    buf_.assign(data.data(), data.size());
+    rocksdb::Status status = cipher_.XOR(offset_, &buf_[0], buf_.size());
+    if (!status.ok()) {
+      return status;
+    }
+    status = file_->Append(buf_);
+    if (status.ok()) {
+      offset_ += data.size();
+    }
+    return status;
+  }
+
+  // Truncate changes the size of the file. Appending to a file truncated
+  // below its size would encrypt new data with the key stream already
+  // used at the same offsets, which reveals the XOR of the old and new
+  // plaintexts, so it is refused.
+  virtual rocksdb::Status Truncate(uint64_t size) {
+    rocksdb::Status status = file_->Truncate(size + kEncryptionHeaderSize);
+    if (status.ok()) {
+      if (size < offset_) {
+        truncated_ = true;
+      }
+      offset_ = size;
+    }
+    return status;
+  }
+
+  virtual rocksdb::Status Close() {
+    return file_->Close();
+  }
+
+  virtual rocksdb::Status Flush() {
+    return file_->Flush();
+  }
+
+  virtual rocksdb::Status Sync() {
+    return file_->Sync();
+  }
+
+  virtual rocksdb::Status Fsync() {
+    return file_->Fsync();
+  }
+
+  virtual bool IsSyncThreadSafe() const {
+    return file_->IsSyncThreadSafe();
+  }
+
+  virtual uint64_t GetFileSize() {
+    return offset_;
+  }
+
+  virtual size_t GetUniqueId(char* id, size_t max_size) const {
+    return file_->GetUniqueId(id, max_size);
+  }
+
+  virtual rocksdb::Status InvalidateCache(size_t offset, size_t length) {
+    return file_->InvalidateCache(offset + kEncryptionHeaderSize, length);
+  }
+
+ private:
+  std::unique_ptr<rocksdb::WritableFile> file_;
+  const FileCipher cipher_;
+  uint64_t offset_;
+  // Set once the file is truncated below its size.
+  bool truncated_;
+  std::string buf_;
+};
+
+class EncryptedEnv : public rocksdb::EnvWrapper {
+ public:
+  EncryptedEnv(rocksdb::Env* base, const std::string& active_key_id)
+      : rocksdb::EnvWrapper(base),
+        active_key_id_(active_key_id) {
+  }
+
+  virtual rocksdb::Status NewSequentialFile(const std::string& fname,
+                                            std::unique_ptr<rocksdb::SequentialFile>* result,
+                                            const rocksdb::EnvOptions& options) {
+    FileCipher cipher;
+    rocksdb::Status status = ReadHeader(target(), fname, options, &cipher);
+    if (!status.ok()) {
+      return status;
+    }
+    std::unique_ptr<rocksdb::SequentialFile> file;
+    status = target()->NewSequentialFile(fname, &file, options);
+    if (!status.ok()) {
+      return status;
+    }
+    if (cipher.encrypted()) {
+      status = file->Skip(kEncryptionHeaderSize);
+      if (!status.ok()) {
+        return status;
+      }
+    }
+    result->reset(new EncryptedSequentialFile(file.release(), cipher));
+    return rocksdb::Status::OK();
+  }
+
+  virtual rocksdb::Status NewRandomAccessFile(const std::string& fname,
+                                              std::unique_ptr<rocksdb::RandomAccessFile>* result,
+                                              const rocksdb::EnvOptions& options) {
+    FileCipher cipher;
+    rocksdb::Status status = ReadHeader(target(), fname, options, &cipher);
+    if (!status.ok()) {
+      return status;
+    }
+    std::unique_ptr<rocksdb::RandomAccessFile> file;
+    status = target()->NewRandomAccessFile(fname, &file, options);
+    if (!status.ok()) {
+      return status;
+    }
+    result->reset(new EncryptedRandomAccessFile(file.release(), cipher));
+    return rocksdb::Status::OK();
+  }
+
+  // NewWritableFile creates a file encrypted with the active key and a
+  // new IV, which must never be reused with the same key.
+  virtual rocksdb::Status NewWritableFile(const std::string& fname,
+                                          std::unique_ptr<rocksdb::WritableFile>* result,
+                                          const rocksdb::EnvOptions& options) {
+    char header[kEncryptionHeaderSize];
+    memset(header, 0, sizeof(header));
+    memcpy(header, kEncryptionMagic, kEncryptionMagicSize);
+    memcpy(header + kEncryptionMagicSize, active_key_id_.data(), kEncryptionKeyIDSize);
+    char* iv = header + kEncryptionMagicSize + kEncryptionKeyIDSize;
+    if (rocksDBEncryptionNewIV(iv) != 0) {
+      return rocksdb::Status::IOError("unable to generate an encryption IV", fname);
+    }
+
+    std::unique_ptr<rocksdb::WritableFile> file;
+    rocksdb::Status status = target()->NewWritableFile(fname, &file, options);
+    if (!status.ok()) {
+      return status;
+    }
+    status = file->Append(rocksdb::Slice(header, sizeof(header)));
+    if (!status.ok()) {
+      return status;
+    }
+    FileCipher cipher(active_key_id_, std::string(iv, kEncryptionIVSize));
+    result->reset(new EncryptedWritableFile(file.release(), cipher));
+    return rocksdb::Status::OK();
+  }
+
+  virtual rocksdb::Status ReuseWritableFile(const std::string& fname,
+                                            const std::string& old_fname,
+                                            std::unique_ptr<rocksdb::WritableFile>* result,
+                                            const rocksdb::EnvOptions& options) {
+    // A reused file gets a new IV, so it is rewritten from scratch.
+    rocksdb::Status status = target()->RenameFile(old_fname, fname);
+    if (!status.ok()) {
+      return status;
+    }
+    return NewWritableFile(fname, result, options);
+  }
+
+  // GetFileSize returns the size of the contents of the file, excluding
+  // its header.
+  virtual rocksdb::Status GetFileSize(const std::string& fname, uint64_t* file_size) {
+    FileCipher cipher;
+    rocksdb::Status status = ReadHeader(target(), fname, rocksdb::EnvOptions(), &cipher);
+    if (!status.ok()) {
+      return status;
+    }
+    status = target()->GetFileSize(fname, file_size);
+    if (status.ok() && cipher.encrypted()) {
+      *file_size -= kEncryptionHeaderSize;
+    }
+    return status;
+  }
+
+ private:
+  const std::string active_key_id_;
+};
+
+}  // namespace
+
+rocksdb::Env* NewEncryptedEnv(rocksdb::Env* base, const std::string& active_key_id) {
+  return new EncryptedEnv(base, active_key_id);
+}
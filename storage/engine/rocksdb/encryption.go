@@ -0,0 +1,109 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package rocksdb
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"unsafe"
+)
+
+// #include <stdint.h>
+import "C"
+
+// IVSize is the size of the initialization vectors of encrypted files.
+const IVSize = aes.BlockSize
+
+var encryptionKeys struct {
+	sync.RWMutex
+	blocks map[string]cipher.Block
+}
+
+// AddEncryptionKey makes the key with the specified ID available to
+// encrypt and decrypt the files of encrypted databases. The key must be
+// 16, 24 or 32 bytes long, to select AES-128, AES-192 or AES-256.
+func AddEncryptionKey(id string, key []byte) error {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return fmt.Errorf("invalid encryption key %s: %s", id, err)
+	}
+	encryptionKeys.Lock()
+	defer encryptionKeys.Unlock()
+	if encryptionKeys.blocks == nil {
+		encryptionKeys.blocks = map[string]cipher.Block{}
+	}
+	encryptionKeys.blocks[id] = block
+	return nil
+}
+
+// XORKeyStream applies the AES-CTR key stream of the key with the
+// specified ID and the IV at the specified offset of a file to data,
+// which encrypts plaintext and decrypts ciphertext. It returns false if
+// the key is unknown.
+func XORKeyStream(id string, iv []byte, offset uint64, data []byte) bool {
+	encryptionKeys.RLock()
+	block, ok := encryptionKeys.blocks[id]
+	encryptionKeys.RUnlock()
+	if !ok {
+		return false
+	}
+
+	// The counter of the block containing the offset is the IV, taken as a
+	// big-endian 128-bit integer, plus the index of the block.
+	var counter [aes.BlockSize]byte
+	copy(counter[:], iv)
+	hi := binary.BigEndian.Uint64(counter[:8])
+	lo := binary.BigEndian.Uint64(counter[8:])
+	index := offset / aes.BlockSize
+	if lo+index < lo {
+		hi++
+	}
+	lo += index
+	binary.BigEndian.PutUint64(counter[:8], hi)
+	binary.BigEndian.PutUint64(counter[8:], lo)
+
+	stream := cipher.NewCTR(block, counter[:])
+	if skip := offset % aes.BlockSize; skip > 0 {
+		var discard [aes.BlockSize]byte
+		stream.XORKeyStream(discard[:skip], discard[:skip])
+	}
+	stream.XORKeyStream(data, data)
+	return true
+}
+
+//export rocksDBEncryptionNewIV
+func rocksDBEncryptionNewIV(iv *C.char) C.int {
+	buf := (*[IVSize]byte)(unsafe.Pointer(iv))[:]
+	if _, err := rand.Read(buf); err != nil {
+		Logger("unable to generate an encryption IV: %s", err)
+		return 1
+	}
+	return 0
+}
+
+//export rocksDBEncryptionXOR
+func rocksDBEncryptionXOR(keyID *C.char, keyIDLen C.int, iv *C.char, offset C.uint64_t,
+	data *C.char, n C.int) C.int {
+	buf := (*[1 << 30]byte)(unsafe.Pointer(data))[:n:n]
+	if !XORKeyStream(C.GoStringN(keyID, keyIDLen), C.GoBytes(unsafe.Pointer(iv), IVSize), uint64(offset), buf) {
+		return 1
+	}
+	return 0
+}
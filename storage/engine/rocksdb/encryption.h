@@ -0,0 +1,54 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.  See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+#ifndef ROACHLIB_ENCRYPTION_H
+#define ROACHLIB_ENCRYPTION_H
+
+#include <string>
+#include "rocksdb/env.h"
+
+// The files written by an encrypted Env start with a plaintext header
+// identifying the key and the initialization vector the rest of the file
+// is encrypted with using AES in counter mode. The layout must match the
+// one parsed by engine.readEncryptionHeader:
+//
+//   magic (8 bytes) | key ID (16 bytes) | IV (16 bytes) | zeros (24 bytes)
+//
+// Files without the magic are read as plaintext, which lets a plaintext
+// store be encrypted in place.
+//
+// The encryption only provides confidentiality: the ciphertext is not
+// authenticated, so a modification of a file flips the same bits of its
+// plaintext without being detected, beyond what the checksums of RocksDB
+// catch. The key stream is generated by the Go side, so every read and
+// write of an encrypted file makes a cgo callback.
+const char kEncryptionMagic[] = "CRDBENC1";
+const size_t kEncryptionMagicSize = 8;
+const size_t kEncryptionKeyIDSize = 16;
+const size_t kEncryptionIVSize = 16;
+const size_t kEncryptionHeaderSize = 64;
+
+// NewEncryptedEnv returns an Env which encrypts the files it writes with
+// the key identified by active_key_id, and decrypts the files it reads
+// with the key identified in their header. The keys themselves are held by
+// the Go side, which does the encryption. The returned Env does not own
+// base.
+rocksdb::Env* NewEncryptedEnv(rocksdb::Env* base, const std::string& active_key_id);
+
+#endif // ROACHLIB_ENCRYPTION_H
+
+// local variables:
+// mode: c++
+// end:
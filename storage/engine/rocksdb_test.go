@@ -120,6 +120,62 @@ func TestRocksDBCompaction(t *testing.T) {
 	}
 }
 
+func TestRocksDBGetStats(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+	rocksdb := newMemRocksDB(roachpb.Attributes{}, testCacheSize, stopper)
+	if err := rocksdb.Open(); err != nil {
+		t.Fatalf("could not create new in-memory rocksdb db instance: %v", err)
+	}
+
+	key := MVCCEncodeKey(roachpb.Key("a"))
+	if err := rocksdb.Put(key, []byte("value")); err != nil {
+		t.Fatal(err)
+	}
+	if err := rocksdb.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	// Read the key back from the flushed sstable to exercise the block
+	// cache.
+	for i := 0; i < 2; i++ {
+		if _, err := rocksdb.Get(key); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	stats, err := rocksdb.GetStats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.BytesWritten == 0 {
+		t.Errorf("expected bytes written to be non-zero: %+v", stats)
+	}
+	if stats.FlushBytesWritten == 0 {
+		t.Errorf("expected flush bytes written to be non-zero: %+v", stats)
+	}
+	if stats.WriteAmplification() <= 0 {
+		t.Errorf("expected positive write amplification: %+v", stats)
+	}
+	if stats.BlockCacheHits+stats.BlockCacheMisses == 0 {
+		t.Errorf("expected block cache to be accessed: %+v", stats)
+	}
+	if stats.ReadAmplification != 1 {
+		t.Errorf("expected read amplification of 1 with a single sstable: %+v", stats)
+	}
+
+	// Snapshots and batches report the stats of the underlying engine.
+	snap := rocksdb.NewSnapshot()
+	defer snap.Close()
+	batch := rocksdb.NewBatch()
+	defer batch.Close()
+	for _, e := range []Engine{snap, batch} {
+		if _, err := e.GetStats(); err != nil {
+			t.Errorf("%T: %s", e, err)
+		}
+	}
+}
+
 // setupMVCCData writes up to numVersions values at each of numKeys
 // keys. The number of versions written for each key is chosen
 // randomly according to a uniform distribution. Each successive
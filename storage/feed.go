@@ -87,7 +87,8 @@ type StartStoreEvent struct {
 // from other events, this event should be periodically broadcast by the store
 // independently of other operations.
 type StoreStatusEvent struct {
-	Desc *roachpb.StoreDescriptor
+	Desc       *roachpb.StoreDescriptor
+	Encryption engine.EncryptionStatus
 }
 
 // ReplicationStatusEvent contains statistics on the replication status of the
@@ -106,6 +107,33 @@ type ReplicationStatusEvent struct {
 	LeaderRangeCount     int32
 	ReplicatedRangeCount int32
 	AvailableRangeCount  int32
+
+	// The total size in bytes of the raft logs of the store's replicas. Raft
+	// logs are specific to each replica, so their size isn't part of the
+	// range stats.
+	RaftLogSize int64
+}
+
+// EngineStatsEvent contains statistics gathered from within the storage
+// engine of the store.
+//
+// Because these statistics cannot be computed from other events, this event
+// should be periodically broadcast by the store independently of other
+// operations.
+type EngineStatsEvent struct {
+	StoreID roachpb.StoreID
+	Stats   engine.Stats
+}
+
+// IndexStatsEvent contains the stats of the table indexes in the ranges the
+// store holds the leader lease of.
+//
+// Because the stats are split at the index boundaries, they cannot be
+// computed from the deltas of other events; this event should be
+// periodically broadcast by the store independently of other operations.
+type IndexStatsEvent struct {
+	StoreID roachpb.StoreID
+	Stats   []IndexStats
 }
 
 // BeginScanRangesEvent occurs when the store is about to scan over all ranges.
@@ -180,19 +208,37 @@ func (sef StoreEventFeed) startStore(startedAt int64) {
 }
 
 // storeStatus publishes a StoreStatusEvent to this feed.
-func (sef StoreEventFeed) storeStatus(desc *roachpb.StoreDescriptor) {
+func (sef StoreEventFeed) storeStatus(desc *roachpb.StoreDescriptor, encryption engine.EncryptionStatus) {
 	sef.f.Publish(&StoreStatusEvent{
-		Desc: desc,
+		Desc:       desc,
+		Encryption: encryption,
 	})
 }
 
 // replicationStatus publishes a ReplicationStatusEvent to this feed.
-func (sef StoreEventFeed) replicationStatus(leaders, replicated, available int32) {
+func (sef StoreEventFeed) replicationStatus(leaders, replicated, available int32, raftLogSize int64) {
 	sef.f.Publish(&ReplicationStatusEvent{
 		StoreID:              sef.id,
 		LeaderRangeCount:     leaders,
 		ReplicatedRangeCount: replicated,
 		AvailableRangeCount:  available,
+		RaftLogSize:          raftLogSize,
+	})
+}
+
+// engineStats publishes an EngineStatsEvent to this feed.
+func (sef StoreEventFeed) engineStats(stats engine.Stats) {
+	sef.f.Publish(&EngineStatsEvent{
+		StoreID: sef.id,
+		Stats:   stats,
+	})
+}
+
+// indexStats publishes an IndexStatsEvent to this feed.
+func (sef StoreEventFeed) indexStats(stats []IndexStats) {
+	sef.f.Publish(&IndexStatsEvent{
+		StoreID: sef.id,
+		Stats:   stats,
 	})
 }
 
@@ -219,6 +265,8 @@ type StoreEventListener interface {
 	OnEndScanRanges(event *EndScanRangesEvent)
 	OnStoreStatus(event *StoreStatusEvent)
 	OnReplicationStatus(event *ReplicationStatusEvent)
+	OnEngineStats(event *EngineStatsEvent)
+	OnIndexStats(event *IndexStatsEvent)
 }
 
 // ProcessStoreEvent dispatches an event on the StoreEventListener.
@@ -244,6 +292,10 @@ func ProcessStoreEvent(l StoreEventListener, event interface{}) {
 		l.OnStoreStatus(specificEvent)
 	case *ReplicationStatusEvent:
 		l.OnReplicationStatus(specificEvent)
+	case *EngineStatsEvent:
+		l.OnEngineStats(specificEvent)
+	case *IndexStatsEvent:
+		l.OnIndexStats(specificEvent)
 	}
 }
 
@@ -246,22 +246,44 @@ func TestStoreEventFeed(t *testing.T) {
 		{
 			"StoreStatus",
 			func(feed StoreEventFeed) {
-				feed.storeStatus(storeDesc)
+				feed.storeStatus(storeDesc, engine.EncryptionStatus{ActiveKeyID: "0123456789abcdef"})
 			},
 			&StoreStatusEvent{
-				Desc: storeDesc,
+				Desc:       storeDesc,
+				Encryption: engine.EncryptionStatus{ActiveKeyID: "0123456789abcdef"},
 			},
 		},
 		{
 			"ReplicationStatus",
 			func(feed StoreEventFeed) {
-				feed.replicationStatus(3, 2, 1)
+				feed.replicationStatus(3, 2, 1, 4)
 			},
 			&ReplicationStatusEvent{
 				StoreID:              roachpb.StoreID(1),
 				LeaderRangeCount:     3,
 				ReplicatedRangeCount: 2,
 				AvailableRangeCount:  1,
+				RaftLogSize:          4,
+			},
+		},
+		{
+			"EngineStats",
+			func(feed StoreEventFeed) {
+				feed.engineStats(engine.Stats{BlockCacheHits: 5, ReadAmplification: 2})
+			},
+			&EngineStatsEvent{
+				StoreID: roachpb.StoreID(1),
+				Stats:   engine.Stats{BlockCacheHits: 5, ReadAmplification: 2},
+			},
+		},
+		{
+			"IndexStats",
+			func(feed StoreEventFeed) {
+				feed.indexStats([]IndexStats{{TableID: 50, IndexID: 1, Stats: engine.MVCCStats{LiveBytes: 10}}})
+			},
+			&IndexStatsEvent{
+				StoreID: roachpb.StoreID(1),
+				Stats:   []IndexStats{{TableID: 50, IndexID: 1, Stats: engine.MVCCStats{LiveBytes: 10}}},
 			},
 		},
 		{
@@ -18,7 +18,9 @@
 package storage
 
 import (
+	"bytes"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -128,8 +130,8 @@ func (*gcQueue) shouldQueue(now roachpb.Timestamp, repl *Replica,
 func (gcq *gcQueue) process(now roachpb.Timestamp, repl *Replica,
 	sysCfg *config.SystemConfig) error {
 
-	snap := repl.store.Engine().NewSnapshot()
 	desc := repl.Desc()
+	snap := engine.NewRangeEngine(repl.store.Engine().NewSnapshot(), desc.StartKey.AsRawKey())
 	iter := newReplicaDataIterator(desc, snap)
 	defer iter.Close()
 	defer snap.Close()
@@ -141,8 +143,15 @@ func (gcq *gcQueue) process(now roachpb.Timestamp, repl *Replica,
 	}
 	policy := zone.GC
 
+	// Keep the versions needed to read at the timestamps protected by
+	// protected timestamp records over this key range.
+	protected, _, err := sysCfg.GetProtectedTimestampForSpan(desc.StartKey, desc.EndKey, now)
+	if err != nil {
+		return fmt.Errorf("could not find protected timestamps for range %s: %s", repl, err)
+	}
+
 	gcMeta := roachpb.NewGCMetadata(now.WallTime)
-	gc := engine.NewGarbageCollector(now, *policy)
+	gc := engine.NewGarbageCollector(now, protected, *policy)
 
 	// Compute intent expiration (intent age at which we attempt to resolve).
 	intentExp := now
@@ -166,6 +175,15 @@ func (gcq *gcQueue) process(now roachpb.Timestamp, repl *Replica,
 	txnMap := map[string]*roachpb.Transaction{}
 	intentSpanMap := map[string][]roachpb.Span{}
 
+	// The committed range tombstones act as deletions of the keys they
+	// delete, written just before their timestamp. A range tombstone is
+	// needed as long as some of the versions it deletes remain.
+	rangeTombstones, err := engine.MVCCGetRangeTombstones(snap, desc.StartKey.AsRawKey(), desc.EndKey.AsRawKey())
+	if err != nil {
+		return err
+	}
+	rangeTombstoneNeeded := make([]bool, len(rangeTombstones))
+
 	// processKeysAndValues is invoked with each key and its set of
 	// values. Intents older than the intent age threshold are sent for
 	// resolution and values after the MVCC metadata, and possible
@@ -191,13 +209,34 @@ func (gcq *gcQueue) process(now roachpb.Timestamp, repl *Replica,
 					// With an active intent, GC ignores MVCC metadata & intent value.
 					startIdx = 2
 				}
+				versionKeys, versionVals := keys[startIdx:], vals[startIdx:]
+				var deleters []int
+				for i := range rangeTombstones {
+					t := &rangeTombstones[i]
+					if t.Txn == nil && bytes.Compare(t.StartKey, expBaseKey) <= 0 && bytes.Compare(expBaseKey, t.EndKey) < 0 {
+						deleters = append(deleters, i)
+						versionKeys, versionVals = insertVersion(versionKeys, versionVals,
+							engine.MVCCEncodeVersionKey(expBaseKey, t.Timestamp.Prev()), nil)
+					}
+				}
 				// See if any values may be GC'd.
-				if gcTS := gc.Filter(keys[startIdx:], vals[startIdx:]); !gcTS.Equal(roachpb.ZeroTimestamp) {
+				gcTS := gc.Filter(versionKeys, versionVals)
+				if !gcTS.Equal(roachpb.ZeroTimestamp) {
 					// TODO(spencer): need to split the requests up into
 					// multiple requests in the event that more than X keys
 					// are added to the request.
 					gcArgs.Keys = append(gcArgs.Keys, roachpb.GCRequest_GCKey{Key: expBaseKey, Timestamp: gcTS})
 				}
+				for _, i := range deleters {
+					for _, key := range keys[startIdx:] {
+						_, ts, _, err := engine.MVCCDecodeKey(key)
+						if err != nil || (ts.Less(rangeTombstones[i].Timestamp) &&
+							(gcTS.Equal(roachpb.ZeroTimestamp) || gcTS.Less(ts))) {
+							rangeTombstoneNeeded[i] = true
+							break
+						}
+					}
+				}
 			}
 		}
 	}
@@ -230,6 +269,9 @@ func (gcq *gcQueue) process(now roachpb.Timestamp, repl *Replica,
 	// Handle last collected set of keys/vals.
 	processKeysAndValues()
 
+	gcArgs.Keys = append(gcArgs.Keys, processRangeTombstones(desc, gc, rangeTombstones,
+		rangeTombstoneNeeded, intentExp, txnMap, intentSpanMap)...)
+
 	txnKeys, err := processTransactionTable(repl, txnMap, txnExp)
 	if err != nil {
 		return err
@@ -292,6 +334,47 @@ func (gcq *gcQueue) process(now roachpb.Timestamp, repl *Replica,
 	return nil
 }
 
+// insertVersion returns copies of the version keys and values of a key,
+// with the given version inserted in order.
+func insertVersion(versionKeys []engine.MVCCKey, vals [][]byte, key engine.MVCCKey,
+	val []byte) ([]engine.MVCCKey, [][]byte) {
+	i := sort.Search(len(versionKeys), func(i int) bool {
+		return bytes.Compare(versionKeys[i], key) >= 0
+	})
+	newKeys := make([]engine.MVCCKey, 0, len(versionKeys)+1)
+	newKeys = append(append(append(newKeys, versionKeys[:i]...), key), versionKeys[i:]...)
+	newVals := make([][]byte, 0, len(vals)+1)
+	newVals = append(append(append(newVals, vals[:i]...), val), vals[i:]...)
+	return newKeys, newVals
+}
+
+// processRangeTombstones updates txnMap and intentSpanMap with the range
+// tombstones which are old intents, and returns the keys of the committed
+// range tombstones of the range which can be GC'd: those which are
+// expired and no longer needed, as the versions they delete are all being
+// GC'd.
+func processRangeTombstones(desc *roachpb.RangeDescriptor, gc *engine.GarbageCollector,
+	rangeTombstones []engine.MVCCRangeTombstone, needed []bool, intentExp roachpb.Timestamp,
+	txnMap map[string]*roachpb.Transaction, intentSpanMap map[string][]roachpb.Span) []roachpb.GCRequest_GCKey {
+	var gcKeys []roachpb.GCRequest_GCKey
+	for i := range rangeTombstones {
+		t := &rangeTombstones[i]
+		if t.Txn != nil {
+			if t.Timestamp.Less(intentExp) {
+				id := string(t.Txn.ID)
+				txnMap[id] = t.Txn
+				intentSpanMap[id] = append(intentSpanMap[id], roachpb.Span{Key: t.StartKey, EndKey: t.EndKey})
+			}
+			continue
+		}
+		if needed[i] || !gc.Expired(t.Timestamp) || bytes.Compare(t.StartKey, desc.StartKey) < 0 {
+			continue
+		}
+		gcKeys = append(gcKeys, roachpb.GCRequest_GCKey{Key: keys.RangeTombstoneKey(t.StartKey, t.Timestamp)})
+	}
+	return gcKeys
+}
+
 // processTransactionTable scans the transaction table and updates txnMap with
 // those transactions which are old and either PENDING or with intents
 // registered. In the first case we want to push the transaction so that it is
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/config"
 	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/storage/engine"
@@ -314,6 +315,87 @@ func TestGCQueueProcess(t *testing.T) {
 	}
 }
 
+// TestGCQueueProtectedTimestamp verifies that the versions needed to read
+// at a timestamp protected by an unexpired protected timestamp record are
+// not garbage collected.
+func TestGCQueueProtectedTimestamp(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	const now int64 = 48 * 60 * 60 * 1E9 // 2d past the epoch
+	tc.manualClock.Set(now)
+
+	ts1 := makeTS(now-2*24*60*60*1E9+1, 0) // 2d old
+	ts2 := makeTS(now-25*60*60*1E9, 0)     // 25h old
+	ts3 := makeTS(now-1E9, 0)              // 1s old
+	key := roachpb.Key("a")
+	for _, ts := range []roachpb.Timestamp{ts1, ts2, ts3} {
+		pArgs := putArgs(key, []byte("value"))
+		if _, err := client.SendWrappedWith(tc.Sender(), tc.rng.context(), roachpb.Header{
+			Timestamp: ts,
+		}, &pArgs); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cfg := tc.gossip.GetSystemConfig()
+	if cfg == nil {
+		t.Fatal("nil config")
+	}
+
+	// withRecord returns the system config with a protected timestamp record
+	// protecting the key at ts1 until 'expiration'.
+	withRecord := func(expiration int64) *config.SystemConfig {
+		var value roachpb.Value
+		if err := value.SetProto(&config.ProtectedTimestamp{
+			ID:         1,
+			Span:       roachpb.Span{Key: key, EndKey: key.Next()},
+			Timestamp:  ts1,
+			Expiration: makeTS(expiration, 0),
+		}); err != nil {
+			t.Fatal(err)
+		}
+		recordKey := roachpb.Key(keys.MakeTablePrefix(keys.ProtectedTimestampsTableID))
+		values := append([]roachpb.KeyValue(nil), cfg.Values...)
+		values = append(values, roachpb.KeyValue{Key: recordKey, Value: value})
+		return &config.SystemConfig{Values: values}
+	}
+
+	verifyVersions := func(expected []roachpb.Timestamp) {
+		kvs, err := engine.Scan(tc.store.Engine(), engine.MVCCEncodeKey(key),
+			engine.MVCCEncodeKey(key.Next()), 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var versions []roachpb.Timestamp
+		for _, kv := range kvs {
+			if _, ts, isValue, err := engine.MVCCDecodeKey(kv.Key); err != nil {
+				t.Fatal(err)
+			} else if isValue {
+				versions = append(versions, ts)
+			}
+		}
+		if !reflect.DeepEqual(versions, expected) {
+			t.Errorf("expected versions %s; got %s", expected, versions)
+		}
+	}
+
+	// With an unexpired record, the version visible at ts1 is kept.
+	gcQ := newGCQueue(tc.gossip)
+	if err := gcQ.process(tc.clock.Now(), tc.rng, withRecord(now+1)); err != nil {
+		t.Fatal(err)
+	}
+	verifyVersions([]roachpb.Timestamp{ts3, ts2, ts1})
+
+	// An expired record doesn't hold off GC.
+	if err := gcQ.process(tc.clock.Now(), tc.rng, withRecord(now)); err != nil {
+		t.Fatal(err)
+	}
+	verifyVersions([]roachpb.Timestamp{ts3})
+}
+
 func TestGCQueueTransactionTable(t *testing.T) {
 	defer leaktest.AfterTest(t)
 
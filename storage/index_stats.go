@@ -0,0 +1,255 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util/encoding"
+)
+
+const (
+	// indexStatsInterval is the minimum duration between two computations
+	// of the index stats of a range whose stats changed.
+	indexStatsInterval = 1 * time.Minute
+	// indexStatsMaxRanges is the maximum number of ranges whose index
+	// stats are computed each time the store publishes its status. The
+	// other ranges keep their cached index stats until their turn comes.
+	indexStatsMaxRanges = 16
+)
+
+// indexStatsCache caches the index stats of the ranges a store holds the
+// leader lease of. The index stats of a range are recomputed when its
+// bounds change, and when its stats change, at most every
+// indexStatsInterval. At most indexStatsMaxRanges ranges are rescanned at
+// a time, so that the rescans of the ranges written to are amortized.
+type indexStatsCache struct {
+	sync.Mutex
+	ranges map[roachpb.RangeID]rangeIndexStats
+}
+
+// rangeIndexStats holds the index stats of a range, along with the bounds
+// and stats of the range they were computed from, and the wall time at
+// which they were computed.
+type rangeIndexStats struct {
+	rangeID          roachpb.RangeID
+	startKey, endKey roachpb.RKey
+	stats            engine.MVCCStats
+	computed         int64
+	indexes          []IndexStats
+}
+
+type rangeIndexStatsByComputed []*rangeIndexStats
+
+func (s rangeIndexStatsByComputed) Len() int           { return len(s) }
+func (s rangeIndexStatsByComputed) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s rangeIndexStatsByComputed) Less(i, j int) bool { return s[i].computed < s[j].computed }
+
+// decodeIndexPrefix returns the table and index IDs of a key in the
+// structured key space, along with the key prefix of the index data.
+func decodeIndexPrefix(key roachpb.Key) (tableID, indexID uint32, prefix roachpb.Key, ok bool) {
+	if !bytes.HasPrefix(key, keys.TableDataPrefix) {
+		return 0, 0, nil, false
+	}
+	remaining, tableID64, err := encoding.DecodeUvarint(key[len(keys.TableDataPrefix):])
+	if err != nil {
+		return 0, 0, nil, false
+	}
+	remaining, indexID64, err := encoding.DecodeUvarint(remaining)
+	if err != nil {
+		return 0, 0, nil, false
+	}
+	return uint32(tableID64), uint32(indexID64), key[:len(key)-len(remaining)], true
+}
+
+// rangeIndex returns the table and index IDs of the index whose data holds
+// the whole range [start,end), if any.
+func rangeIndex(start, end roachpb.Key) (tableID, indexID uint32, ok bool) {
+	tableID, indexID, prefix, ok := decodeIndexPrefix(start)
+	if !ok || bytes.Compare(end, prefix.PrefixEnd()) > 0 {
+		return 0, 0, false
+	}
+	return tableID, indexID, true
+}
+
+// computeIndexStats computes the stats of the data of each of the table
+// indexes in the range [start,end) of the engine, splitting the stats at
+// the index boundaries. The keys outside of the structured key space are
+// not accounted for.
+func computeIndexStats(e engine.Engine, start, end roachpb.Key, nowNanos int64) ([]IndexStats, error) {
+	iter := engine.NewRangeEngine(e, start).NewIterator()
+	if bytes.Compare(start, keys.TableDataPrefix) < 0 {
+		start = keys.TableDataPrefix
+	}
+	defer iter.Close()
+
+	var indexes []IndexStats
+	for key := start; bytes.Compare(key, end) < 0; {
+		iter.Seek(engine.MVCCEncodeKey(key))
+		if !iter.Valid() {
+			break
+		}
+		k, _, _, err := engine.MVCCDecodeKey(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		if bytes.Compare(k, end) >= 0 {
+			break
+		}
+		tableID, indexID, prefix, ok := decodeIndexPrefix(k)
+		if !ok {
+			key = k.Next()
+			continue
+		}
+		indexEnd := prefix.PrefixEnd()
+		if bytes.Compare(end, indexEnd) < 0 {
+			indexEnd = end
+		}
+		is := IndexStats{TableID: tableID, IndexID: indexID}
+		if err := iter.ComputeStats(&is.Stats, engine.MVCCEncodeKey(k), engine.MVCCEncodeKey(indexEnd), nowNanos); err != nil {
+			return nil, err
+		}
+		indexes = append(indexes, is)
+		key = indexEnd
+	}
+	return indexes, iter.Error()
+}
+
+type indexStatsByID []IndexStats
+
+func (s indexStatsByID) Len() int      { return len(s) }
+func (s indexStatsByID) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s indexStatsByID) Less(i, j int) bool {
+	if s[i].TableID != s[j].TableID {
+		return s[i].TableID < s[j].TableID
+	}
+	return s[i].IndexID < s[j].IndexID
+}
+
+// AggregateIndexStats sums up the stats of the same table indexes, for
+// instance the index stats of the stores of a cluster. The result is
+// ordered by table and index ID.
+func AggregateIndexStats(indexStats []IndexStats) []IndexStats {
+	type indexKey struct {
+		tableID, indexID uint32
+	}
+	byIndex := map[indexKey]int{}
+	var result []IndexStats
+	for _, is := range indexStats {
+		k := indexKey{is.TableID, is.IndexID}
+		if i, ok := byIndex[k]; ok {
+			result[i].Stats.Add(&is.Stats)
+			continue
+		}
+		byIndex[k] = len(result)
+		result = append(result, is)
+	}
+	sort.Sort(indexStatsByID(result))
+	return result
+}
+
+// computeIndexStats returns the stats of the table indexes in the ranges
+// this store holds the leader lease of, so that the data of each range is
+// accounted for by a single store of the cluster. The index stats of the
+// ranges are taken from the cache, which is refreshed as described in
+// indexStatsCache; a range whose index stats were never computed since its
+// bounds last changed is left out until they are. A range holding the data
+// of a single index isn't scanned: its stats are those of the index.
+func (s *Store) computeIndexStats(now int64) ([]IndexStats, error) {
+	s.mu.RLock()
+	replicas := make([]*Replica, 0, len(s.replicas))
+	for _, rng := range s.replicas {
+		replicas = append(replicas, rng)
+	}
+	s.mu.RUnlock()
+
+	// The stats of the ranges are read before the snapshot is taken, so that
+	// index stats computed from data newer than the stats they're cached
+	// with are recomputed the next time around. A range is attributed to
+	// the store holding its latest leader lease, even if the lease expired,
+	// so that idle ranges remain accounted for.
+	var leaseRanges []rangeIndexStats
+	for _, rng := range replicas {
+		if lease := rng.getLease(); lease == nil || !lease.OwnedBy(s.StoreID()) {
+			continue
+		}
+		desc := rng.Desc()
+		leaseRanges = append(leaseRanges, rangeIndexStats{
+			rangeID:  desc.RangeID,
+			startKey: desc.StartKey,
+			endKey:   desc.EndKey,
+			stats:    rng.stats.GetMVCC(),
+		})
+	}
+
+	s.indexStats.Lock()
+	defer s.indexStats.Unlock()
+
+	// The index stats of a range are stale if they were never computed, if
+	// the bounds of the range changed, or if its stats changed and they
+	// were computed at least indexStatsInterval ago. The stale index stats
+	// are recomputed in that order, the least recently computed first.
+	ranges := make(map[roachpb.RangeID]rangeIndexStats, len(leaseRanges))
+	var stale rangeIndexStatsByComputed
+	for i := range leaseRanges {
+		lr := &leaseRanges[i]
+		if tableID, indexID, ok := rangeIndex(lr.startKey.AsRawKey(), lr.endKey.AsRawKey()); ok {
+			lr.computed = now
+			lr.indexes = []IndexStats{{TableID: tableID, IndexID: indexID, Stats: lr.stats}}
+			ranges[lr.rangeID] = *lr
+			continue
+		}
+		cached, ok := s.indexStats.ranges[lr.rangeID]
+		if !ok || !cached.startKey.Equal(lr.startKey) || !cached.endKey.Equal(lr.endKey) {
+			stale = append(stale, lr)
+			continue
+		}
+		if cached.stats != lr.stats && now-cached.computed >= indexStatsInterval.Nanoseconds() {
+			lr.computed = cached.computed
+			stale = append(stale, lr)
+		}
+		ranges[lr.rangeID] = cached
+	}
+	sort.Sort(stale)
+	if len(stale) > indexStatsMaxRanges {
+		stale = stale[:indexStatsMaxRanges]
+	}
+	if len(stale) > 0 {
+		snap := s.engine.NewSnapshot()
+		defer snap.Close()
+		for _, lr := range stale {
+			var err error
+			if lr.indexes, err = computeIndexStats(snap, lr.startKey.AsRawKey(), lr.endKey.AsRawKey(), now); err != nil {
+				return nil, err
+			}
+			lr.computed = now
+			ranges[lr.rangeID] = *lr
+		}
+	}
+	s.indexStats.ranges = ranges
+
+	var indexStats []IndexStats
+	for _, ris := range ranges {
+		indexStats = append(indexStats, ris.indexes...)
+	}
+	return AggregateIndexStats(indexStats), nil
+}
@@ -0,0 +1,166 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util/encoding"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/cockroachdb/cockroach/util/stop"
+)
+
+// makeIndexKey returns a key of the data of the given table index.
+func makeIndexKey(tableID, indexID uint32, suffix string) roachpb.Key {
+	key := encoding.EncodeUvarint(keys.MakeTablePrefix(tableID), uint64(indexID))
+	return roachpb.Key(append(key, suffix...))
+}
+
+// TestComputeIndexStats verifies that the stats of a span of the engine are
+// split at the boundaries of the table indexes, and that the index stats of
+// adjacent spans add up.
+func TestComputeIndexStats(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+	eng := engine.NewInMem(roachpb.Attributes{}, 1<<20, stopper)
+
+	ts := roachpb.Timestamp{WallTime: 1E9}
+	for _, key := range []roachpb.Key{
+		roachpb.Key("a"),
+		makeIndexKey(50, 1, "a"),
+		makeIndexKey(50, 1, "b"),
+		makeIndexKey(50, 1, "c"),
+		makeIndexKey(50, 2, "a"),
+		makeIndexKey(51, 1, "a"),
+	} {
+		if err := engine.MVCCPut(eng, nil, key, ts, roachpb.MakeValueFromString("value"), nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The expected stats are those of the spans of each of the indexes.
+	nowNanos := int64(2E9)
+	var expected []IndexStats
+	for _, index := range []struct{ tableID, indexID uint32 }{{50, 1}, {50, 2}, {51, 1}} {
+		prefix := makeIndexKey(index.tableID, index.indexID, "")
+		is := IndexStats{TableID: index.tableID, IndexID: index.indexID}
+		iter := eng.NewIterator()
+		err := iter.ComputeStats(&is.Stats, engine.MVCCEncodeKey(prefix), engine.MVCCEncodeKey(prefix.PrefixEnd()), nowNanos)
+		iter.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected = append(expected, is)
+	}
+	if expected[0].Stats.LiveCount != 3 {
+		t.Fatalf("expected 3 live keys in the first index, but found %+v", expected[0].Stats)
+	}
+
+	indexStats, err := computeIndexStats(eng, roachpb.KeyMin, roachpb.KeyMax, nowNanos)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(indexStats, expected) {
+		t.Errorf("expected %+v, but found %+v", expected, indexStats)
+	}
+
+	// Split the span in the middle of the first index.
+	splitKey := makeIndexKey(50, 1, "b")
+	left, err := computeIndexStats(eng, roachpb.KeyMin, splitKey, nowNanos)
+	if err != nil {
+		t.Fatal(err)
+	}
+	right, err := computeIndexStats(eng, splitKey, roachpb.KeyMax, nowNanos)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(left) != 1 || left[0].Stats.LiveCount != 1 || len(right) != 3 || right[0].Stats.LiveCount != 2 {
+		t.Errorf("unexpected index stats for the split span: %+v and %+v", left, right)
+	}
+	if indexStats := AggregateIndexStats(append(right, left...)); !reflect.DeepEqual(indexStats, expected) {
+		t.Errorf("expected %+v, but found %+v", expected, indexStats)
+	}
+}
+
+// TestRangeIndex verifies that a range is attributed to an index only when
+// the data of that index holds the whole range.
+func TestRangeIndex(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	testCases := []struct {
+		start, end       roachpb.Key
+		tableID, indexID uint32
+		ok               bool
+	}{
+		{makeIndexKey(50, 1, ""), makeIndexKey(50, 2, ""), 50, 1, true},
+		{makeIndexKey(50, 1, "a"), makeIndexKey(50, 1, "b"), 50, 1, true},
+		{makeIndexKey(50, 1, "a"), makeIndexKey(50, 2, "a"), 0, 0, false},
+		{makeIndexKey(50, 1, "a"), roachpb.KeyMax, 0, 0, false},
+		{roachpb.KeyMin, makeIndexKey(50, 1, "a"), 0, 0, false},
+	}
+	for i, tc := range testCases {
+		tableID, indexID, ok := rangeIndex(tc.start, tc.end)
+		if tableID != tc.tableID || indexID != tc.indexID || ok != tc.ok {
+			t.Errorf("%d: expected (%d, %d, %t), but found (%d, %d, %t)",
+				i, tc.tableID, tc.indexID, tc.ok, tableID, indexID, ok)
+		}
+	}
+}
+
+// TestStoreComputeIndexStats verifies that the index stats of a range are
+// recomputed once its stats changed and indexStatsInterval elapsed.
+func TestStoreComputeIndexStats(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, manual, stopper := createTestStore(t)
+	defer stopper.Stop()
+
+	put := func(key roachpb.Key) {
+		pArgs := putArgs(key, []byte("value"))
+		if _, err := client.SendWrapped(store.testSender(), nil, &pArgs); err != nil {
+			t.Fatal(err)
+		}
+	}
+	liveCount := func() int64 {
+		indexStats, err := store.computeIndexStats(store.Clock().Now().WallTime)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, is := range indexStats {
+			if is.TableID == 50 && is.IndexID == 1 {
+				return is.Stats.LiveCount
+			}
+		}
+		return 0
+	}
+
+	put(makeIndexKey(50, 1, "a"))
+	if count := liveCount(); count != 1 {
+		t.Fatalf("expected 1 live key; got %d", count)
+	}
+	put(makeIndexKey(50, 1, "b"))
+	if count := liveCount(); count != 1 {
+		t.Fatalf("expected the index stats to be cached with 1 live key; got %d", count)
+	}
+	manual.Increment(indexStatsInterval.Nanoseconds())
+	if count := liveCount(); count != 2 {
+		t.Fatalf("expected 2 live keys; got %d", count)
+	}
+}
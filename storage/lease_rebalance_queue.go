@@ -0,0 +1,100 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"time"
+
+	"github.com/cockroachdb/cockroach/config"
+	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+const (
+	// leaseRebalanceQueueMaxSize is the max size of the lease rebalance queue.
+	leaseRebalanceQueueMaxSize = 100
+
+	// leaseRebalanceQueueTimerDuration is the duration between lease
+	// transfers. Transfers are paced so that the gossiped lease counts the
+	// decisions are based on have a chance to catch up.
+	leaseRebalanceQueueTimerDuration = 1 * time.Second
+)
+
+// leaseRebalanceQueue manages a queue of replicas holding the leader lease
+// for their range which should hand it off to another replica, either to
+// honor the lease preferences of the range's zone or to spread leases
+// evenly across stores.
+type leaseRebalanceQueue struct {
+	baseQueue
+	allocator Allocator
+}
+
+// newLeaseRebalanceQueue returns a new instance of leaseRebalanceQueue.
+func newLeaseRebalanceQueue(gossip *gossip.Gossip, allocator Allocator) *leaseRebalanceQueue {
+	lq := &leaseRebalanceQueue{
+		allocator: allocator,
+	}
+	lq.baseQueue = makeBaseQueue("leaseRebalance", lq, gossip, leaseRebalanceQueueMaxSize)
+	return lq
+}
+
+func (*leaseRebalanceQueue) needsLeaderLease() bool {
+	return true
+}
+
+// acceptsUnsplitRanges is false because the lease preferences cannot be
+// determined for ranges that span zone configs.
+func (*leaseRebalanceQueue) acceptsUnsplitRanges() bool {
+	return false
+}
+
+func (lq *leaseRebalanceQueue) shouldQueue(now roachpb.Timestamp, repl *Replica,
+	sysCfg *config.SystemConfig) (shouldQ bool, priority float64) {
+	target, err := lq.transferTarget(repl, sysCfg)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	return target != nil, 0
+}
+
+func (lq *leaseRebalanceQueue) process(now roachpb.Timestamp, repl *Replica, sysCfg *config.SystemConfig) error {
+	target, err := lq.transferTarget(repl, sysCfg)
+	if err != nil || target == nil {
+		return err
+	}
+	if log.V(1) {
+		log.Infof("transferring leader lease of range %d to store %d", repl.Desc().RangeID, target.StoreID)
+	}
+	return repl.AdminTransferLease(target.StoreID)
+}
+
+// transferTarget returns the replica to which the leader lease held by the
+// supplied replica should be transferred, if any.
+func (lq *leaseRebalanceQueue) transferTarget(repl *Replica,
+	sysCfg *config.SystemConfig) (*roachpb.ReplicaDescriptor, error) {
+	desc := repl.Desc()
+	zone, err := sysCfg.GetZoneConfigForKey(desc.StartKey)
+	if err != nil {
+		return nil, err
+	}
+	return lq.allocator.TransferLeaseTarget(zone.LeasePreferences, desc.Replicas, repl.store.StoreID(), repl.store.IsDraining()), nil
+}
+
+func (*leaseRebalanceQueue) timer() time.Duration {
+	return leaseRebalanceQueueTimerDuration
+}
@@ -18,6 +18,7 @@
 package storage
 
 import (
+	"sort"
 	"time"
 
 	"github.com/cockroachdb/cockroach/client"
@@ -36,9 +37,14 @@ const (
 	// raft logs.
 	RaftLogQueueTimerDuration = time.Second
 	// RaftLogQueueStaleThreshold is the minimum threshold for stale raft log
-	// entries. A stale entry is one which all replicas of the range have
+	// entries. A stale entry is one which all live replicas of the range have
 	// progressed past and thus is no longer needed and can be pruned.
 	RaftLogQueueStaleThreshold = 1
+	// RaftLogMaxSize is the size in bytes above which the raft log is
+	// truncated even if doing so requires slow followers to catch up via
+	// a snapshot. The log is never truncated past the index which a quorum
+	// of the replicas has acknowledged.
+	RaftLogMaxSize = 4 << 20 // 4 MB
 )
 
 // raftLogQueue manages a queue of replicas slated to have their raft logs
@@ -65,70 +71,117 @@ func (*raftLogQueue) acceptsUnsplitRanges() bool {
 	return true
 }
 
-// getTruncatableIndexes returns the total number of stale raft log entries that
-// can be truncated and the oldest index that cannot be pruned.
-func getTruncatableIndexes(r *Replica) (uint64, uint64, error) {
-	rangeID := r.Desc().RangeID
-	raftStatus := r.store.RaftStatus(rangeID)
+// getTruncatableIndexes returns the total number of stale raft log entries
+// that can be truncated, the oldest index that cannot be pruned and the size
+// of the raft log in bytes.
+func getTruncatableIndexes(r *Replica) (uint64, uint64, int64, error) {
+	desc := r.Desc()
+	raftStatus := r.store.RaftStatus(desc.RangeID)
 	if raftStatus == nil {
-		return 0, 0, util.Errorf("the raft group doesn't exist for range %d", rangeID)
+		return 0, 0, 0, util.Errorf("the raft group doesn't exist for range %d", desc.RangeID)
 	}
 
 	// Is this the raft leader?
 	if raftStatus.RaftState != raft.StateLeader {
-		return 0, 0, nil
+		return 0, 0, 0, nil
 	}
 
-	// Find the oldest index still in use by the range.
-	oldestIndex := raftStatus.Applied
-	for _, progress := range raftStatus.Progress {
-		if progress.Match < oldestIndex {
-			oldestIndex = progress.Match
+	// Replicas on dead stores are expected to need a snapshot to catch up
+	// once they are back, so the raft log isn't retained for them.
+	var dead map[uint64]struct{}
+	if sp := r.store.ctx.StorePool; sp != nil {
+		dead = map[uint64]struct{}{}
+		for _, repl := range sp.deadReplicas(desc.Replicas) {
+			dead[uint64(repl.ReplicaID)] = struct{}{}
 		}
 	}
 
 	firstIndex, err := r.FirstIndex()
 	if err != nil {
-		return 0, 0, util.Errorf("error retrieving first index for range %d: %s", rangeID, err)
+		return 0, 0, 0, util.Errorf("error retrieving first index for range %d: %s", desc.RangeID, err)
 	}
 
+	logSize := r.RaftLogSize()
+	oldestIndex := computeTruncatableIndex(raftStatus, logSize, dead)
 	if oldestIndex < firstIndex {
-		return 0, 0, util.Errorf("raft log's oldest index is less than the first index for range %d", rangeID)
+		return 0, 0, 0, util.Errorf("raft log's oldest index is less than the first index for range %d", desc.RangeID)
 	}
 
 	// Return the number of truncatable indexes.
-	return oldestIndex - firstIndex, oldestIndex, nil
+	return oldestIndex - firstIndex, oldestIndex, logSize, nil
+}
+
+// computeTruncatableIndex returns the oldest index of the raft log which
+// must be retained: the index up to which the slowest live follower has
+// caught up or, if the log is larger than RaftLogMaxSize, the index up to
+// which a quorum of the replicas has caught up. The index never exceeds
+// the applied index.
+func computeTruncatableIndex(raftStatus *raft.Status, logSize int64, dead map[uint64]struct{}) uint64 {
+	oldestIndex := raftStatus.Applied
+	var matches []uint64
+	for id, progress := range raftStatus.Progress {
+		matches = append(matches, progress.Match)
+		if _, ok := dead[id]; ok {
+			continue
+		}
+		if progress.Match < oldestIndex {
+			oldestIndex = progress.Match
+		}
+	}
+
+	if logSize > RaftLogMaxSize && len(matches) > 0 {
+		// Retain only the entries which haven't been acknowledged by a
+		// quorum. Sort the match indexes in decreasing order; the index at
+		// position quorum-1 has been acknowledged by a quorum.
+		sort.Sort(sort.Reverse(uint64Slice(matches)))
+		if quorumIndex := matches[computeQuorum(len(matches))-1]; quorumIndex > oldestIndex {
+			oldestIndex = quorumIndex
+		}
+		if oldestIndex > raftStatus.Applied {
+			oldestIndex = raftStatus.Applied
+		}
+	}
+	return oldestIndex
 }
 
 // shouldQueue determines whether a range should be queued for truncating. This
 // is true only if the replica is the raft leader and if the total number of
-// the range's raft log's stale entries exceeds RaftLogQueueStaleThreshold.
+// the range's raft log's stale entries exceeds RaftLogQueueStaleThreshold or
+// the raft log is larger than RaftLogMaxSize. Large logs are prioritized.
 func (*raftLogQueue) shouldQueue(now roachpb.Timestamp, r *Replica, _ *config.SystemConfig) (shouldQ bool,
 	priority float64) {
 
-	truncatableIndexes, _, err := getTruncatableIndexes(r)
+	truncatableIndexes, _, logSize, err := getTruncatableIndexes(r)
 	if err != nil {
 		log.Warning(err)
 		return false, 0
 	}
 
-	return truncatableIndexes > RaftLogQueueStaleThreshold, float64(truncatableIndexes)
+	priority = float64(truncatableIndexes)
+	if logSize > RaftLogMaxSize {
+		priority += float64(logSize) / RaftLogMaxSize
+	}
+	shouldQ = truncatableIndexes > RaftLogQueueStaleThreshold ||
+		(truncatableIndexes > 0 && logSize > RaftLogMaxSize)
+	return shouldQ, priority
 }
 
 // process truncates the raft log of the range if the replica is the raft
 // leader and if the total number of the range's raft log's stale entries
-// exceeds RaftLogQueueStaleThreshold.
+// exceeds RaftLogQueueStaleThreshold or the raft log is larger than
+// RaftLogMaxSize.
 func (rlq *raftLogQueue) process(now roachpb.Timestamp, r *Replica, _ *config.SystemConfig) error {
 
-	truncatableIndexes, oldestIndex, err := getTruncatableIndexes(r)
+	truncatableIndexes, oldestIndex, logSize, err := getTruncatableIndexes(r)
 	if err != nil {
 		return err
 	}
 
 	// Can and should the raft logs be truncated?
-	if truncatableIndexes > RaftLogQueueStaleThreshold {
+	if truncatableIndexes > RaftLogQueueStaleThreshold ||
+		(truncatableIndexes > 0 && logSize > RaftLogMaxSize) {
 		if log.V(1) {
-			log.Infof("truncating the raft log of range %d to %d", r.Desc().RangeID, oldestIndex)
+			log.Infof("truncating the raft log of range %d to %d (%d bytes)", r.Desc().RangeID, oldestIndex, logSize)
 		}
 		b := &client.Batch{}
 		b.InternalAddRequest(&roachpb.TruncateLogRequest{
@@ -145,3 +198,11 @@ func (rlq *raftLogQueue) process(now roachpb.Timestamp, r *Replica, _ *config.Sy
 func (*raftLogQueue) timer() time.Duration {
 	return RaftLogQueueTimerDuration
 }
+
+// uint64Slice attaches the methods of sort.Interface to []uint64, sorting in
+// increasing order.
+type uint64Slice []uint64
+
+func (p uint64Slice) Len() int           { return len(p) }
+func (p uint64Slice) Less(i, j int) bool { return p[i] < p[j] }
+func (p uint64Slice) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
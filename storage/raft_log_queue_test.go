@@ -0,0 +1,67 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/coreos/etcd/raft"
+)
+
+// TestComputeTruncatableIndex verifies that the raft log is retained for the
+// slowest live follower unless it has grown too large.
+func TestComputeTruncatableIndex(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	testCases := []struct {
+		applied  uint64
+		matches  []uint64
+		dead     []uint64
+		logSize  int64
+		expected uint64
+	}{
+		// The slowest follower determines the index.
+		{100, []uint64{100, 100, 50}, nil, 0, 50},
+		// The applied index is never exceeded.
+		{80, []uint64{100, 100, 90}, nil, 0, 80},
+		// Dead followers are ignored.
+		{100, []uint64{100, 90, 50}, []uint64{3}, 0, 90},
+		{100, []uint64{100, 90, 50}, []uint64{2, 3}, 0, 100},
+		// A large log is truncated up to the index acknowledged by a quorum.
+		{100, []uint64{100, 90, 50}, nil, RaftLogMaxSize + 1, 90},
+		{100, []uint64{100, 90, 80, 70, 50}, nil, RaftLogMaxSize + 1, 80},
+		{80, []uint64{100, 90, 50}, nil, RaftLogMaxSize + 1, 80},
+		// Small logs are retained for slow followers.
+		{100, []uint64{100, 90, 50}, nil, RaftLogMaxSize, 50},
+	}
+	for i, test := range testCases {
+		status := &raft.Status{
+			Applied:  test.applied,
+			Progress: map[uint64]raft.Progress{},
+		}
+		for j, match := range test.matches {
+			status.Progress[uint64(j+1)] = raft.Progress{Match: match}
+		}
+		dead := map[uint64]struct{}{}
+		for _, id := range test.dead {
+			dead[id] = struct{}{}
+		}
+		if index := computeTruncatableIndex(status, test.logSize, dead); index != test.expected {
+			t.Errorf("%d: expected index %d; got %d", i, test.expected, index)
+		}
+	}
+}
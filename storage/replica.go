@@ -155,10 +155,16 @@ type Replica struct {
 	lastIndex uint64
 	// Last index applied to the state machine. Updated atomically.
 	appliedIndex uint64
-	systemDBHash []byte         // sha1 hash of the system config @ last gossip
-	lease        unsafe.Pointer // Information for leader lease, updated atomically
-	llMu         sync.Mutex     // Synchronizes readers' requests for leader lease
-	sequence     *SequenceCache // Provides txn replay protection
+	// Approximate size of the raft log in bytes. The raft log is specific to
+	// each replica, so its size is persisted in the RaftLogSizeKey of the
+	// range rather than in the replicated range stats. Updated atomically,
+	// along with the persisted size under raftLogSizeMu.
+	raftLogSize   int64
+	raftLogSizeMu sync.Mutex
+	systemDBHash  []byte         // sha1 hash of the system config @ last gossip
+	lease         unsafe.Pointer // Information for leader lease, updated atomically
+	llMu          sync.Mutex     // Synchronizes readers' requests for leader lease
+	sequence      *SequenceCache // Provides txn replay protection
 
 	// proposeRaftCommandFn can be set to mock out the propose operation.
 	proposeRaftCommandFn func(cmdIDKey, roachpb.RaftCommand) <-chan error
@@ -186,6 +192,12 @@ type Replica struct {
 		value roachpb.ReplicaDescriptor
 	}
 	truncatedState unsafe.Pointer // *roachpb.RaftTruncatedState
+
+	// pendingLeaseTransfer is the replica to which this replica is
+	// transferring its leader lease, if any. While it is set, requests are
+	// redirected to the target instead of being served under the current
+	// lease. It is protected by the RWMutex.
+	pendingLeaseTransfer *roachpb.ReplicaDescriptor
 }
 
 var _ client.Sender = &Replica{}
@@ -214,6 +226,12 @@ func NewReplica(desc *roachpb.RangeDescriptor, rm *Store) (*Replica, error) {
 	}
 	atomic.StoreUint64(&r.appliedIndex, appliedIndex)
 
+	raftLogSize, err := loadRaftLogSize(r.store.Engine(), desc.RangeID)
+	if err != nil {
+		return nil, err
+	}
+	atomic.StoreInt64(&r.raftLogSize, raftLogSize)
+
 	lease, err := loadLeaderLease(r.store.Engine(), desc.RangeID)
 	if err != nil {
 		return nil, err
@@ -400,12 +418,28 @@ func (r *Replica) redirectOnOrAcquireLeaderLease(trace *tracer.Trace, timestamp
 
 	if lease := r.getLease(); lease.Covers(timestamp) {
 		if lease.OwnedBy(r.store.StoreID()) {
+			// While our lease is being transferred away, redirect to the
+			// replica which is about to receive it.
+			if target := r.getPendingLeaseTransfer(); target != nil {
+				return &roachpb.NotLeaderError{
+					RangeID: r.Desc().RangeID,
+					Leader:  target,
+				}
+			}
 			// Happy path: We have an active lease, nothing to do.
 			return nil
 		}
 		// If lease is currently held by another, redirect to holder.
 		return r.newNotLeaderError(lease, r.store.StoreID())
 	}
+	// A transfer which is still pending at this point can no longer apply
+	// since it would have to replace the lease we are about to renew.
+	r.setPendingLeaseTransfer(nil)
+	// A draining store doesn't acquire new leases, leaving its ranges to
+	// be served by other replicas.
+	if r.store.IsDraining() {
+		return r.newNotLeaderError(nil, r.store.StoreID())
+	}
 	defer trace.Epoch("request leader lease")()
 	// Otherwise, no active lease: Request renewal.
 	err := r.requestLeaderLease(timestamp)
@@ -433,6 +467,101 @@ func (r *Replica) redirectOnOrAcquireLeaderLease(trace *tracer.Trace, timestamp
 	return err
 }
 
+// AdminTransferLease transfers the leader lease for this range from this
+// replica, which must be its current holder, to the replica on the given
+// store. Rather than letting the lease expire, this replica stops serving
+// requests under its lease, redirecting them to the target, and proposes a
+// lease for the target which starts at the current time. The target then
+// takes over immediately once the transfer has been applied.
+func (r *Replica) AdminTransferLease(target roachpb.StoreID) error {
+	desc := r.Desc()
+	_, nextLeader := desc.FindReplica(target)
+	if nextLeader == nil {
+		return util.Errorf("unable to find store %d in range %s", target, desc)
+	}
+
+	r.llMu.Lock()
+	prevLease := r.getLease()
+	if !prevLease.OwnedBy(r.store.StoreID()) || !prevLease.Covers(r.store.Clock().Now()) {
+		r.llMu.Unlock()
+		return r.newNotLeaderError(prevLease, r.store.StoreID())
+	}
+	if prevLease.OwnedBy(target) {
+		r.llMu.Unlock()
+		return nil
+	}
+	if r.getPendingLeaseTransfer() != nil {
+		r.llMu.Unlock()
+		return util.Errorf("range %d: leader lease transfer already in progress", desc.RangeID)
+	}
+	r.setPendingLeaseTransfer(nextLeader)
+	r.llMu.Unlock()
+
+	// From here on, no new requests are served under our lease. Any request
+	// which was admitted before has had its timestamp forwarded to the store's
+	// clock, so the new lease can start at the current time without the new
+	// holder serving writes below any timestamp we may have served reads at.
+	start := r.store.Clock().Now()
+	duration := DefaultLeaderLeaseDuration
+	args := &roachpb.TransferLeaseRequest{
+		Span: roachpb.Span{
+			Key: desc.StartKey.AsRawKey(),
+		},
+		Lease: roachpb.Lease{
+			Start:      start,
+			Expiration: start.Add(int64(duration), 0),
+			Replica:    *nextLeader,
+		},
+		PrevLease: *prevLease,
+	}
+	ba := roachpb.BatchRequest{}
+	ba.Timestamp = start
+	ba.RangeID = desc.RangeID
+	ba.Add(args)
+
+	ctx, cancel := context.WithDeadline(r.context(), time.Now().Add(duration))
+	defer cancel()
+
+	errChan, pendingCmd := r.proposeRaftCommand(ctx, ba)
+	var err error
+	select {
+	case err = <-errChan:
+		if err == nil {
+			select {
+			case c := <-pendingCmd.done:
+				err = c.Err
+			case <-ctx.Done():
+				// The transfer may still apply, so we must not resume
+				// serving under our lease. The pending transfer is cleared
+				// once the lease expires (see redirectOnOrAcquireLeaderLease).
+				return r.newNotLeaderError(nil, r.store.StoreID())
+			}
+		}
+	case <-ctx.Done():
+		return r.newNotLeaderError(nil, r.store.StoreID())
+	}
+	// The transfer has either been applied, in which case the lease is no
+	// longer ours, or it has definitely failed and we can resume serving.
+	r.setPendingLeaseTransfer(nil)
+	return err
+}
+
+// getPendingLeaseTransfer returns the target of the leader lease transfer in
+// progress, if any.
+func (r *Replica) getPendingLeaseTransfer() *roachpb.ReplicaDescriptor {
+	r.RLock()
+	defer r.RUnlock()
+	return r.pendingLeaseTransfer
+}
+
+// setPendingLeaseTransfer sets the target of the leader lease transfer in
+// progress. A nil target clears it.
+func (r *Replica) setPendingLeaseTransfer(target *roachpb.ReplicaDescriptor) {
+	r.Lock()
+	defer r.Unlock()
+	r.pendingLeaseTransfer = target
+}
+
 // isInitialized is true if we know the metadata of this range, either
 // because we created it or we have received an initial snapshot from
 // another node. It is false when a range has been created in response
@@ -1175,6 +1304,10 @@ func (r *Replica) executeBatch(batch engine.Engine, ms *engine.MVCCStats, ba roa
 	// Have to discuss how we go about it.
 	fiddleWithTimestamps := ba.Txn == nil && ba.IsWrite()
 
+	// The commands only apply to the keys of the range, and only need to
+	// look for range tombstones from its start key.
+	batch = engine.NewRangeEngine(batch, r.Desc().StartKey.AsRawKey())
+
 	// TODO(tschottdorf): provisionals ahead. This loop needs to execute each
 	// command and propagate txn and timestamp to the next (and, eventually,
 	// to the batch response header). We're currently in an intermediate stage
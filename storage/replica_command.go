@@ -96,6 +96,14 @@ func (r *Replica) executeCmd(batch engine.Engine, ms *engine.MVCCStats, h roachp
 		var resp roachpb.ReverseScanResponse
 		resp, intents, err = r.ReverseScan(batch, h, *tArgs)
 		reply = &resp
+	case *roachpb.ExportRequest:
+		var resp roachpb.ExportResponse
+		resp, err = r.Export(batch, h, *tArgs)
+		reply = &resp
+	case *roachpb.ClearRangeRequest:
+		var resp roachpb.ClearRangeResponse
+		resp, err = r.ClearRange(batch, ms, h, *tArgs)
+		reply = &resp
 	case *roachpb.BeginTransactionRequest:
 		var resp roachpb.BeginTransactionResponse
 		resp, err = r.BeginTransaction(batch, ms, h, *tArgs)
@@ -140,6 +148,10 @@ func (r *Replica) executeCmd(batch engine.Engine, ms *engine.MVCCStats, h roachp
 		var resp roachpb.LeaderLeaseResponse
 		resp, err = r.LeaderLease(batch, ms, h, *tArgs)
 		reply = &resp
+	case *roachpb.TransferLeaseRequest:
+		var resp roachpb.TransferLeaseResponse
+		resp, err = r.TransferLease(batch, ms, h, *tArgs)
+		reply = &resp
 	default:
 		err = util.Errorf("unrecognized command %s", args.Method())
 	}
@@ -251,6 +263,58 @@ func (r *Replica) ReverseScan(batch engine.Engine, h roachpb.Header, args roachp
 	return reply, intents, err
 }
 
+// Export returns the key/value revisions of the key range specified by
+// start key through end key, as of the timestamp of the request.
+func (r *Replica) Export(batch engine.Engine, h roachpb.Header, args roachpb.ExportRequest) (roachpb.ExportResponse, error) {
+	var reply roachpb.ExportResponse
+
+	rows, err := engine.MVCCExport(batch, args.Key, args.EndKey, args.MaxResults, args.StartTime, h.Timestamp, h.Txn)
+	reply.Rows = rows
+	return reply, err
+}
+
+// ClearRange removes all the key/value revisions of the key range specified
+// by start key through end key from the engine, without writing MVCC
+// tombstones, and subtracts their contribution from the range's stats.
+// The range tombstones of the key range are removed as well.
+func (r *Replica) ClearRange(batch engine.Engine, ms *engine.MVCCStats, h roachpb.Header, args roachpb.ClearRangeRequest) (roachpb.ClearRangeResponse, error) {
+	var reply roachpb.ClearRangeResponse
+
+	// Split the range tombstones at the bounds of the key range, so that
+	// those starting in the key range don't extend past it.
+	for _, splitKey := range []roachpb.Key{args.Key, args.EndKey} {
+		if err := engine.MVCCSplitRangeTombstones(batch, ms, roachpb.KeyMin, splitKey); err != nil {
+			return reply, err
+		}
+	}
+
+	// The stats of the keys are computed while the range tombstones
+	// deleting them still exist.
+	spans := []keyRange{
+		{start: engine.MVCCEncodeKey(args.Key), end: engine.MVCCEncodeKey(args.EndKey)},
+		{
+			start: engine.MVCCEncodeKey(keys.MakeRangeTombstoneKeyPrefix(roachpb.RKey(args.Key))),
+			end:   engine.MVCCEncodeKey(keys.MakeRangeTombstoneKeyPrefix(roachpb.RKey(args.EndKey))),
+		},
+	}
+	iter := batch.NewIterator()
+	defer iter.Close()
+	var cleared engine.MVCCStats
+	for _, span := range spans {
+		if err := iter.ComputeStats(&cleared, span.start, span.end, h.Timestamp.WallTime); err != nil {
+			return reply, err
+		}
+	}
+	ms.Subtract(&cleared)
+
+	for _, span := range spans {
+		if _, err := engine.ClearRange(batch, span.start, span.end); err != nil {
+			return reply, err
+		}
+	}
+	return reply, nil
+}
+
 func verifyTransaction(h roachpb.Header, args roachpb.Request) error {
 	if h.Txn == nil {
 		return util.Errorf("no transaction specified to HeartbeatTxn")
@@ -1103,11 +1167,20 @@ func (r *Replica) TruncateLog(batch engine.Engine, ms *engine.MVCCStats, h roach
 	}
 	start := keys.RaftLogKey(rangeID, 0)
 	end := keys.RaftLogKey(rangeID, args.Index)
+	var truncatedSize int64
 	if err = batch.Iterate(engine.MVCCEncodeKey(start), engine.MVCCEncodeKey(end), func(kv engine.MVCCKeyValue) (bool, error) {
+		truncatedSize += int64(len(kv.Key) + len(kv.Value))
 		return false, batch.Clear(kv.Key)
 	}); err != nil {
 		return reply, err
 	}
+	// The raft log size isn't part of the replicated stats; it is adjusted
+	// once the truncation has been committed.
+	batch.Defer(func() {
+		if err := r.addRaftLogSize(-truncatedSize); err != nil {
+			log.Warningf("range %d: unable to persist the raft log size: %s", rangeID, err)
+		}
+	})
 	tState := roachpb.RaftTruncatedState{
 		Index: args.Index - 1,
 		Term:  term,
@@ -1128,27 +1201,57 @@ func (r *Replica) LeaderLease(batch engine.Engine, ms *engine.MVCCStats, h roach
 	r.Lock()
 	defer r.Unlock()
 
+	return reply, r.applyNewLeaseLocked(batch, ms, args.Lease, false /* isTransfer */)
+}
+
+// TransferLease hands the leader lease for this range off to the replica
+// named in the request. It is proposed by the current lease holder, which
+// stops serving requests under its lease before doing so; the new lease
+// therefore starts right away instead of after the expiration of the
+// previous one. The command fails if the lease changed since the transfer
+// was proposed.
+func (r *Replica) TransferLease(batch engine.Engine, ms *engine.MVCCStats, h roachpb.Header, args roachpb.TransferLeaseRequest) (roachpb.TransferLeaseResponse, error) {
+	var reply roachpb.TransferLeaseResponse
+
+	r.Lock()
+	defer r.Unlock()
+
+	if prevLease := r.getLease(); *prevLease != args.PrevLease {
+		return reply, &roachpb.LeaseRejectedError{
+			Existing:  *prevLease,
+			Requested: args.Lease,
+			Message:   "lease changed while transfer was pending",
+		}
+	}
+	return reply, r.applyNewLeaseLocked(batch, ms, args.Lease, true /* isTransfer */)
+}
+
+// applyNewLeaseLocked verifies the given lease against the current one and,
+// if it is valid, stores it to disk and in memory. For transfers, the new
+// lease is allowed to overlap the previous one. The replica mutex must be
+// held.
+func (r *Replica) applyNewLeaseLocked(batch engine.Engine, ms *engine.MVCCStats, lease roachpb.Lease, isTransfer bool) error {
 	prevLease := r.getLease()
-	isExtension := prevLease.Replica.StoreID == args.Lease.Replica.StoreID
-	effectiveStart := args.Lease.Start
+	isExtension := prevLease.Replica.StoreID == lease.Replica.StoreID
+	effectiveStart := lease.Start
 	// We return this error in "normal" lease-overlap related failures.
 	rErr := &roachpb.LeaseRejectedError{
 		Existing:  *prevLease,
-		Requested: args.Lease,
+		Requested: lease,
 	}
 
 	// Verify details of new lease request. The start of this lease must
 	// obviously precede its expiration.
-	if !args.Lease.Start.Less(args.Lease.Expiration) {
+	if !lease.Start.Less(lease.Expiration) {
 		rErr.Message = "expiration precedes start"
-		return reply, rErr
+		return rErr
 	}
 
 	// Verify that requestion replica is part of the current replica set.
 	desc := r.Desc()
-	if idx, _ := desc.FindReplica(args.Lease.Replica.StoreID); idx == -1 {
+	if idx, _ := desc.FindReplica(lease.Replica.StoreID); idx == -1 {
 		rErr.Message = "replica not found"
-		return reply, rErr
+		return rErr
 	}
 
 	// Wind the start timestamp back as far to the previous lease's expiration
@@ -1164,53 +1267,65 @@ func (r *Replica) LeaderLease(batch engine.Engine, ms *engine.MVCCStats, h roach
 	// If no old lease exists or this is our lease, we don't need to add an
 	// extra tick. This allows multiple requests from the same replica to
 	// merge without ticking away from the minimal common start timestamp.
-	if prevLease.Replica.StoreID == 0 || isExtension {
-		// TODO(tschottdorf) Think about whether it'd be better to go all the
-		// way back to prevLease.Start(), so that whenever the last lease is
-		// the own one, the original start is preserved.
-		effectiveStart.Backward(prevLease.Expiration)
-	} else {
-		effectiveStart.Backward(prevLease.Expiration.Next())
+	//
+	// A transferred lease is never wound back: the previous holder has
+	// stopped serving requests at its start, which is thus the earliest
+	// timestamp the new holder may take responsibility for.
+	if !isTransfer {
+		if prevLease.Replica.StoreID == 0 || isExtension {
+			// TODO(tschottdorf) Think about whether it'd be better to go all the
+			// way back to prevLease.Start(), so that whenever the last lease is
+			// the own one, the original start is preserved.
+			effectiveStart.Backward(prevLease.Expiration)
+		} else {
+			effectiveStart.Backward(prevLease.Expiration.Next())
+		}
 	}
 
 	if isExtension {
 		if effectiveStart.Less(prevLease.Start) {
 			rErr.Message = "extension moved start timestamp backwards"
-			return reply, rErr
+			return rErr
 		}
 		// Note that the lease expiration can be shortened by the holder.
 		// This could be used to effect a faster lease handoff.
-	} else if effectiveStart.Less(prevLease.Expiration) {
+	} else if !isTransfer && effectiveStart.Less(prevLease.Expiration) {
 		rErr.Message = "requested lease overlaps previous lease"
-		return reply, rErr
+		return rErr
 	}
 
-	args.Lease.Start = effectiveStart
+	lease.Start = effectiveStart
 
 	rangeID := desc.RangeID
 
 	// Store the lease to disk & in-memory.
-	if err := engine.MVCCPutProto(batch, ms, keys.RaftLeaderLeaseKey(rangeID), roachpb.ZeroTimestamp, nil, &args.Lease); err != nil {
-		return reply, err
+	if err := engine.MVCCPutProto(batch, ms, keys.RaftLeaderLeaseKey(rangeID), roachpb.ZeroTimestamp, nil, &lease); err != nil {
+		return err
 	}
-	atomic.StorePointer(&r.lease, unsafe.Pointer(&args.Lease))
+	atomic.StorePointer(&r.lease, unsafe.Pointer(&lease))
 
 	// If this replica is a new holder of the lease, update the
 	// low water mark in the timestamp cache. We add the maximum
 	// clock offset to account for any difference in clocks
 	// between the expiration (set by a remote node) and this
-	// node.
+	// node. A transferred lease starts at a timestamp which the
+	// previous holder has not served any requests past, so the
+	// low water mark can be moved up to the start only.
 	if r.getLease().Replica.StoreID == r.store.StoreID() &&
 		prevLease.Replica.StoreID != r.getLease().Replica.StoreID {
-		r.tsCache.SetLowWater(prevLease.Expiration.Add(int64(r.store.Clock().MaxOffset()), 0))
-		log.Infof("range %d: new leader lease %s", rangeID, args.Lease)
+		if isTransfer {
+			r.tsCache.SetLowWater(lease.Start)
+		} else {
+			r.tsCache.SetLowWater(prevLease.Expiration.Add(int64(r.store.Clock().MaxOffset()), 0))
+		}
+		log.Infof("range %d: new leader lease %s", rangeID, lease)
 	}
 
 	// Gossip system config if this range includes the system span.
 	if r.ContainsKey(keys.SystemDBSpan.Key) {
 		r.maybeGossipSystemConfigLocked()
 	}
-	return reply, nil
+	return nil
 }
 
 // AdminSplit divides the range into into two ranges, using either
@@ -1323,7 +1438,7 @@ func (r *Replica) AdminSplit(args roachpb.AdminSplitRequest, desc *roachpb.Range
 }
 
 func (r *Replica) computeStats(d *roachpb.RangeDescriptor, e engine.Engine, nowNanos int64) (engine.MVCCStats, error) {
-	iter := e.NewIterator()
+	iter := engine.NewRangeEngine(e, d.StartKey.AsRawKey()).NewIterator()
 	defer iter.Close()
 
 	ms := &engine.MVCCStats{}
@@ -1367,6 +1482,12 @@ func (r *Replica) splitTrigger(batch engine.Engine, split *roachpb.SplitTrigger)
 		return util.Errorf("unable to copy last verification timestamp: %s", err)
 	}
 
+	// Split the range tombstones straddling the split key, so that each
+	// range holds the range tombstones of its keys.
+	if err := engine.MVCCSplitRangeTombstones(batch, nil, desc.StartKey.AsRawKey(), split.NewDesc.StartKey.AsRawKey()); err != nil {
+		return util.Errorf("unable to split range tombstones: %s", err)
+	}
+
 	// Compute stats for updated range.
 	now := r.store.Clock().Timestamp()
 	ms, err := r.computeStats(&split.UpdatedDesc, batch, now.WallTime)
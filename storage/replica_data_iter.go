@@ -58,6 +58,10 @@ func makeReplicaKeyRanges(d *roachpb.RangeDescriptor) []keyRange {
 			start: engine.MVCCEncodeKey(keys.MakeRangeKeyPrefix(d.StartKey)),
 			end:   engine.MVCCEncodeKey(keys.MakeRangeKeyPrefix(d.EndKey)),
 		},
+		{
+			start: engine.MVCCEncodeKey(keys.MakeRangeTombstoneKeyPrefix(d.StartKey)),
+			end:   engine.MVCCEncodeKey(keys.MakeRangeTombstoneKeyPrefix(d.EndKey)),
+		},
 		{
 			start: engine.MVCCEncodeKey(dataStartKey),
 			end:   engine.MVCCEncodeKey(d.EndKey.AsRawKey()),
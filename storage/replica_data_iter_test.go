@@ -76,6 +76,7 @@ func createRangeData(r *Replica, t *testing.T) []engine.MVCCKey {
 		{keys.TransactionKey(roachpb.Key(r.Desc().StartKey), []byte("1234")), ts0},
 		{keys.TransactionKey(roachpb.Key(r.Desc().StartKey.Next()), []byte("5678")), ts0},
 		{keys.TransactionKey(fakePrevKey(r.Desc().EndKey), []byte("2468")), ts0},
+		{keys.RangeTombstoneKey(roachpb.Key(r.Desc().StartKey), ts), ts0},
 		// TODO(bdarnell): KeyMin.Next() results in a key in the reserved system-local space.
 		// Once we have resolved https://github.com/cockroachdb/cockroach/issues/437,
 		// replace this with something that reliably generates the first valid key in the range.
@@ -378,8 +378,11 @@ func (r *Replica) Append(entries []raftpb.Entry) error {
 
 	rangeID := r.Desc().RangeID
 
+	// The raft log consists solely of system-local keys, so the change in
+	// the raft log size is tracked as the change in system bytes.
+	var ms engine.MVCCStats
 	for _, ent := range entries {
-		err := engine.MVCCPutProto(batch, nil, keys.RaftLogKey(rangeID, ent.Index),
+		err := engine.MVCCPutProto(batch, &ms, keys.RaftLogKey(rangeID, ent.Index),
 			roachpb.ZeroTimestamp, nil, &ent)
 		if err != nil {
 			return err
@@ -389,25 +392,88 @@ func (r *Replica) Append(entries []raftpb.Entry) error {
 	prevLastIndex := atomic.LoadUint64(&r.lastIndex)
 	// Delete any previously appended log entries which never committed.
 	for i := lastIndex + 1; i <= prevLastIndex; i++ {
-		err := engine.MVCCDelete(batch, nil,
+		err := engine.MVCCDelete(batch, &ms,
 			keys.RaftLogKey(rangeID, i), roachpb.ZeroTimestamp, nil)
 		if err != nil {
 			return err
 		}
 	}
 
-	// Commit the batch and update the last index.
+	// Commit the batch and update the last index and raft log size.
 	if err := setLastIndex(batch, rangeID, lastIndex); err != nil {
 		return err
 	}
+	r.raftLogSizeMu.Lock()
+	defer r.raftLogSizeMu.Unlock()
+	raftLogSize := r.RaftLogSize() + ms.SysBytes
+	if err := setRaftLogSize(batch, rangeID, raftLogSize); err != nil {
+		return err
+	}
 	if err := batch.Commit(); err != nil {
 		return err
 	}
 
 	atomic.StoreUint64(&r.lastIndex, lastIndex)
+	atomic.StoreInt64(&r.raftLogSize, raftLogSize)
+	return nil
+}
+
+// RaftLogSize returns the approximate size in bytes of the replica's raft
+// log. The size is specific to the replica.
+func (r *Replica) RaftLogSize() int64 {
+	return atomic.LoadInt64(&r.raftLogSize)
+}
+
+// addRaftLogSize adds delta to the size of the replica's raft log, and
+// persists the new size.
+func (r *Replica) addRaftLogSize(delta int64) error {
+	r.raftLogSizeMu.Lock()
+	defer r.raftLogSizeMu.Unlock()
+	raftLogSize := r.RaftLogSize() + delta
+	if err := setRaftLogSize(r.store.Engine(), r.Desc().RangeID, raftLogSize); err != nil {
+		return err
+	}
+	atomic.StoreInt64(&r.raftLogSize, raftLogSize)
 	return nil
 }
 
+// loadRaftLogSize retrieves the size in bytes of the raft log of the range
+// from storage.
+func loadRaftLogSize(eng engine.Engine, rangeID roachpb.RangeID) (int64, error) {
+	v, _, err := engine.MVCCGet(eng, keys.RaftLogSizeKey(rangeID),
+		roachpb.ZeroTimestamp, true /* consistent */, nil)
+	if err != nil || v == nil {
+		return 0, err
+	}
+	return v.GetInt()
+}
+
+// setRaftLogSize persists the size in bytes of the raft log of the range.
+func setRaftLogSize(eng engine.Engine, rangeID roachpb.RangeID, raftLogSize int64) error {
+	var value roachpb.Value
+	value.SetInt(raftLogSize)
+
+	return engine.MVCCPut(eng, nil, keys.RaftLogSizeKey(rangeID),
+		roachpb.ZeroTimestamp,
+		value,
+		nil /* txn */)
+}
+
+// computeRaftLogSize returns the size in bytes of the raft log of the range,
+// scanning the whole log.
+func computeRaftLogSize(eng engine.Engine, rangeID roachpb.RangeID) (int64, error) {
+	prefix := keys.RaftLogPrefix(rangeID)
+	var size int64
+	if err := eng.Iterate(engine.MVCCEncodeKey(prefix), engine.MVCCEncodeKey(prefix.PrefixEnd()),
+		func(kv engine.MVCCKeyValue) (bool, error) {
+			size += int64(len(kv.Key) + len(kv.Value))
+			return false, nil
+		}); err != nil {
+		return 0, err
+	}
+	return size, nil
+}
+
 // updateRangeInfo is called whenever a range is updated by ApplySnapshot
 // or is created by range splitting to setup the fields which are
 // uninitialized or need updating.
@@ -518,10 +584,23 @@ func (r *Replica) ApplySnapshot(snap raftpb.Snapshot) error {
 		return err
 	}
 
+	// The raft log was replaced by the one in the snapshot.
+	r.raftLogSizeMu.Lock()
+	defer r.raftLogSizeMu.Unlock()
+	raftLogSize, err := computeRaftLogSize(batch, rangeID)
+	if err != nil {
+		return err
+	}
+	if err := setRaftLogSize(batch, rangeID, raftLogSize); err != nil {
+		return err
+	}
+
 	if err := batch.Commit(); err != nil {
 		return err
 	}
 
+	atomic.StoreInt64(&r.raftLogSize, raftLogSize)
+
 	// As outlined above, last and applied index are the same after applying
 	// the snapshot.
 	atomic.StoreUint64(&r.lastIndex, snap.Metadata.Index)
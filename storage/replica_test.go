@@ -562,6 +562,84 @@ func TestRangeNotLeaderError(t *testing.T) {
 	}
 }
 
+// TestRangeTransferLease verifies that the leader lease can be handed off
+// to another replica before it expires, that the previous holder then
+// redirects to the new one, and that a transfer is rejected if the lease
+// changed since it was proposed.
+func TestRangeTransferLease(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	// Modify range descriptor to include a second replica; leader lease can
+	// only be obtained by Replicas which are part of the range descriptor. This
+	// workaround is sufficient for the purpose of this test.
+	secondReplica := roachpb.ReplicaDescriptor{
+		NodeID:    2,
+		StoreID:   2,
+		ReplicaID: 2,
+	}
+	rngDesc := tc.rng.Desc()
+	rngDesc.Replicas = append(rngDesc.Replicas, secondReplica)
+	tc.rng.setDescWithoutProcessUpdate(rngDesc)
+
+	if err := tc.rng.redirectOnOrAcquireLeaderLease(nil, tc.clock.Now()); err != nil {
+		t.Fatal(err)
+	}
+	prevLease := *tc.rng.getLease()
+	tc.manualClock.Increment(10)
+
+	// A transfer based on an outdated lease is rejected.
+	now := tc.clock.Now()
+	ba := roachpb.BatchRequest{}
+	ba.Timestamp = now
+	ba.Add(&roachpb.TransferLeaseRequest{
+		Lease: roachpb.Lease{
+			Start:      now,
+			Expiration: now.Add(10, 0),
+			Replica:    secondReplica,
+		},
+	})
+	errChan, pendingCmd := tc.rng.proposeRaftCommand(tc.rng.context(), ba)
+	err := <-errChan
+	if err == nil {
+		err = (<-pendingCmd.done).Err
+	}
+	if _, ok := err.(*roachpb.LeaseRejectedError); !ok {
+		t.Fatalf("expected %T, got %v", &roachpb.LeaseRejectedError{}, err)
+	}
+	if lease := tc.rng.getLease(); *lease != prevLease {
+		t.Fatalf("expected lease to be unchanged; got %s", lease)
+	}
+
+	start := tc.clock.Now()
+	if err := tc.rng.AdminTransferLease(secondReplica.StoreID); err != nil {
+		t.Fatal(err)
+	}
+	lease := tc.rng.getLease()
+	if !lease.OwnedBy(secondReplica.StoreID) {
+		t.Fatalf("expected lease to be held by store %d; got %s", secondReplica.StoreID, lease)
+	}
+	// The new lease starts right away instead of after the previous one
+	// expired.
+	if lease.Start.Less(start) || !lease.Start.Less(prevLease.Expiration) {
+		t.Errorf("expected lease to start at %s, before %s; got %s", start, prevLease.Expiration, lease.Start)
+	}
+	if target := tc.rng.getPendingLeaseTransfer(); target != nil {
+		t.Errorf("expected no pending lease transfer; got %s", target)
+	}
+
+	err = tc.rng.redirectOnOrAcquireLeaderLease(nil, tc.clock.Now())
+	if lErr, ok := err.(*roachpb.NotLeaderError); !ok || lErr.Leader == nil || lErr.Leader.StoreID != secondReplica.StoreID {
+		t.Fatalf("expected redirect to store %d; got %v", secondReplica.StoreID, err)
+	}
+	// Only the lease holder can transfer the lease.
+	if err := tc.rng.AdminTransferLease(tc.store.StoreID()); err == nil {
+		t.Fatal("expected transfer by previous lease holder to fail")
+	}
+}
+
 // TestRangeGossipConfigsOnLease verifies that config info is gossiped
 // upon acquisition of the leader lease.
 func TestRangeGossipConfigsOnLease(t *testing.T) {
@@ -2697,6 +2775,60 @@ func TestRangeStatsComputation(t *testing.T) {
 	verifyRangeStats(tc.engine, tc.rng.Desc().RangeID, expMS, t)
 }
 
+// TestRangeClearRange verifies that ClearRange removes all the revisions
+// of the keys of a span, without writing tombstones, and subtracts them
+// from the range stats.
+func TestRangeClearRange(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{
+		bootstrapMode: bootstrapRangeOnly,
+	}
+	tc.Start(t)
+	defer tc.Stop()
+
+	var reqs []roachpb.Request
+	for _, key := range []string{"a", "b", "c"} {
+		pArgs := putArgs([]byte(key), []byte("value1"))
+		reqs = append(reqs, &pArgs)
+	}
+	pArgs := putArgs([]byte("a"), []byte("value2"))
+	dArgs := deleteArgs([]byte("b"))
+	reqs = append(reqs, &pArgs, &dArgs)
+	for _, req := range reqs {
+		if _, err := client.SendWrapped(tc.Sender(), tc.rng.context(), req); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cArgs := &roachpb.ClearRangeRequest{
+		Span: roachpb.Span{
+			Key:    roachpb.Key("a"),
+			EndKey: roachpb.Key("c"),
+		},
+	}
+	if _, err := client.SendWrapped(tc.Sender(), tc.rng.context(), cArgs); err != nil {
+		t.Fatal(err)
+	}
+
+	// Only "c" is left, without any tombstone for the cleared keys.
+	kvs, err := engine.Scan(tc.engine, engine.MVCCEncodeKey(roachpb.Key("a")),
+		engine.MVCCEncodeKey(roachpb.Key("d")), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, kv := range kvs {
+		key, _, _, err := engine.MVCCDecodeKey(kv.Key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !key.Equal(roachpb.Key("c")) {
+			t.Errorf("expected key %q to be cleared", key)
+		}
+	}
+	expMS := engine.MVCCStats{LiveBytes: 38, KeyBytes: 16, ValBytes: 22, IntentBytes: 0, LiveCount: 1, KeyCount: 1, ValCount: 1, IntentCount: 0, SysBytes: 63, SysCount: 1}
+	verifyRangeStats(tc.engine, tc.rng.Desc().RangeID, expMS, t)
+}
+
 // TestMerge verifies that the Merge command is behaving as
 // expected. Merge semantics for different data types are tested more
 // robustly at the engine level; this test is intended only to show
@@ -2834,6 +2966,62 @@ func TestTruncateLog(t *testing.T) {
 	}
 }
 
+// TestRaftLogSizePersisted verifies that the size of the raft log is
+// persisted as entries are appended and truncated, so that it can be
+// loaded without scanning the log.
+func TestRaftLogSizePersisted(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+	tc.rng.store.DisableRaftLogQueue(true)
+
+	rangeID := tc.rng.Desc().RangeID
+	verifySize := func() int64 {
+		var size int64
+		util.SucceedsWithin(t, time.Second, func() error {
+			persisted, err := loadRaftLogSize(tc.engine, rangeID)
+			if err != nil {
+				return err
+			}
+			computed, err := computeRaftLogSize(tc.engine, rangeID)
+			if err != nil {
+				return err
+			}
+			if size = tc.rng.RaftLogSize(); persisted != size || computed != size {
+				return util.Errorf("expected a raft log size of %d, but found %d persisted and %d in memory",
+					computed, persisted, size)
+			}
+			return nil
+		})
+		return size
+	}
+
+	var lastIndex uint64
+	for i := 0; i < 10; i++ {
+		args := incrementArgs([]byte("a"), int64(i))
+		if _, err := client.SendWrapped(tc.Sender(), tc.rng.context(), &args); err != nil {
+			t.Fatal(err)
+		}
+		var err error
+		if lastIndex, err = tc.rng.LastIndex(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	size := verifySize()
+	if size == 0 {
+		t.Fatal("expected a non-empty raft log")
+	}
+
+	truncateArgs := truncateLogArgs(lastIndex, rangeID)
+	if _, err := client.SendWrapped(tc.Sender(), tc.rng.context(), &truncateArgs); err != nil {
+		t.Fatal(err)
+	}
+	if truncatedSize := verifySize(); truncatedSize >= size {
+		t.Errorf("expected the raft log to shrink from %d bytes, but found %d", size, truncatedSize)
+	}
+}
+
 func TestRaftStorage(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	var eng engine.Engine
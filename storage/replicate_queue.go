@@ -87,7 +87,7 @@ func (rq *replicateQueue) shouldQueue(now roachpb.Timestamp, repl *Replica,
 		return true, priority
 	}
 	// See if there is a rebalancing opportunity present.
-	shouldRebalance := rq.allocator.ShouldRebalance(repl.store.StoreID())
+	shouldRebalance := rq.allocator.ShouldRebalance(repl.store.StoreID(), desc.Replicas)
 	return shouldRebalance, 0
 }
 
@@ -111,7 +111,7 @@ func (rq *replicateQueue) process(now roachpb.Timestamp, repl *Replica, sysCfg *
 
 	switch action {
 	case AllocatorAdd:
-		newStore, err := rq.allocator.AllocateTarget(zone.ReplicaAttrs[0], desc.Replicas, true, nil)
+		newStore, err := rq.allocator.AllocateTarget(zone.RequiredAttrs(), zone.Constraints, desc.Replicas, true, nil)
 		if err != nil {
 			return err
 		}
@@ -123,7 +123,7 @@ func (rq *replicateQueue) process(now roachpb.Timestamp, repl *Replica, sysCfg *
 			return err
 		}
 	case AllocatorRemove:
-		removeReplica, err := rq.allocator.RemoveTarget(desc.Replicas)
+		removeReplica, err := rq.allocator.RemoveTarget(zone.Constraints, desc.Replicas)
 		if err != nil {
 			return err
 		}
@@ -147,7 +147,7 @@ func (rq *replicateQueue) process(now roachpb.Timestamp, repl *Replica, sysCfg *
 	case AllocatorNoop:
 		// The Noop case will result if this replica was queued in order to
 		// rebalance. Attempt to find a rebalancing target.
-		rebalanceStore := rq.allocator.RebalanceTarget(repl.store.StoreID(), zone.ReplicaAttrs[0], desc.Replicas)
+		rebalanceStore := rq.allocator.RebalanceTarget(repl.store.StoreID(), zone.RequiredAttrs(), zone.Constraints, desc.Replicas)
 		if rebalanceStore == nil {
 			// No action was necessary and no rebalance target was found. Return
 			// without re-queueing this replica.
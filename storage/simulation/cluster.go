@@ -266,7 +266,7 @@ func (c *Cluster) prepareActions() {
 		for storeID, replica := range r.replicas {
 			replica.action, replica.priority = r.allocator.ComputeAction(r.zone, &r.desc)
 			if replica.action == storage.AllocatorNoop {
-				replica.rebalance = r.allocator.ShouldRebalance(storeID)
+				replica.rebalance = r.allocator.ShouldRebalance(storeID, r.desc.Replicas)
 				// Set the priority to 1 so that rebalances will occur in
 				// performActions.
 				replica.priority = 1
@@ -115,7 +115,7 @@ func (r *Range) splitRange(originalRange *Range) {
 // getAllocateTarget queries the allocator for the store that would be the best
 // candidate to take on a new replica.
 func (r *Range) getAllocateTarget() (roachpb.StoreID, error) {
-	newStore, err := r.allocator.AllocateTarget(r.zone.ReplicaAttrs[0], r.desc.Replicas, true, nil)
+	newStore, err := r.allocator.AllocateTarget(r.zone.RequiredAttrs(), r.zone.Constraints, r.desc.Replicas, true, nil)
 	if err != nil {
 		return 0, err
 	}
@@ -125,7 +125,7 @@ func (r *Range) getAllocateTarget() (roachpb.StoreID, error) {
 // getRemoveTarget queries the allocator for the store that contains a replica
 // that can be removed.
 func (r *Range) getRemoveTarget() (roachpb.StoreID, error) {
-	removeStore, err := r.allocator.RemoveTarget(r.desc.Replicas)
+	removeStore, err := r.allocator.RemoveTarget(r.zone.Constraints, r.desc.Replicas)
 	if err != nil {
 		return 0, err
 	}
@@ -136,7 +136,7 @@ func (r *Range) getRemoveTarget() (roachpb.StoreID, error) {
 // candidate to add a replica for rebalancing. Returns true only if a target is
 // found.
 func (r *Range) getRebalanceTarget(storeID roachpb.StoreID) (roachpb.StoreID, bool) {
-	rebalanceTarget := r.allocator.RebalanceTarget(storeID, r.zone.ReplicaAttrs[0], r.desc.Replicas)
+	rebalanceTarget := r.allocator.RebalanceTarget(storeID, r.zone.RequiredAttrs(), r.zone.Constraints, r.desc.Replicas)
 	if rebalanceTarget == nil {
 		return 0, false
 	}
@@ -152,7 +152,7 @@ func (r *Range) String() string {
 	sort.Sort(storeIDs)
 
 	var buf bytes.Buffer
-	fmt.Fprintf(&buf, "Range:%d, Factor:%d, Stores:[", r.desc.RangeID, len(r.zone.ReplicaAttrs))
+	fmt.Fprintf(&buf, "Range:%d, Factor:%d, Stores:[", r.desc.RangeID, r.zone.ReplicaCount())
 
 	first := true
 	for _, storeID := range storeIDs {
@@ -10,6 +10,7 @@
 
 	It has these top-level messages:
 		StoreStatus
+		IndexStats
 */
 package storage
 
@@ -18,6 +19,7 @@ import fmt "fmt"
 import math "math"
 import cockroach_roachpb "github.com/cockroachdb/cockroach/roachpb"
 import cockroach_storage_engine "github.com/cockroachdb/cockroach/storage/engine"
+import cockroach_storage_engine1 "github.com/cockroachdb/cockroach/storage/engine"
 
 // skipping weak import gogoproto "github.com/cockroachdb/gogoproto"
 
@@ -38,18 +40,34 @@ type StoreStatus struct {
 	RangeCount           int32                                           `protobuf:"varint,3,opt,name=range_count" json:"range_count"`
 	StartedAt            int64                                           `protobuf:"varint,4,opt,name=started_at" json:"started_at"`
 	UpdatedAt            int64                                           `protobuf:"varint,5,opt,name=updated_at" json:"updated_at"`
-	Stats                cockroach_storage_engine.MVCCStats              `protobuf:"bytes,6,opt,name=stats" json:"stats"`
+	Stats                cockroach_storage_engine1.MVCCStats             `protobuf:"bytes,6,opt,name=stats" json:"stats"`
 	LeaderRangeCount     int32                                           `protobuf:"varint,7,opt,name=leader_range_count" json:"leader_range_count"`
 	ReplicatedRangeCount int32                                           `protobuf:"varint,8,opt,name=replicated_range_count" json:"replicated_range_count"`
 	AvailableRangeCount  int32                                           `protobuf:"varint,9,opt,name=available_range_count" json:"available_range_count"`
+	Encryption           cockroach_storage_engine.EncryptionStatus       `protobuf:"bytes,10,opt,name=encryption" json:"encryption"`
+	// index_stats are the stats of the table indexes in the ranges the store
+	// holds the leader lease of.
+	IndexStats []IndexStats `protobuf:"bytes,11,rep,name=index_stats" json:"index_stats"`
 }
 
 func (m *StoreStatus) Reset()         { *m = StoreStatus{} }
 func (m *StoreStatus) String() string { return proto.CompactTextString(m) }
 func (*StoreStatus) ProtoMessage()    {}
 
+// IndexStats contains the MVCC stats of the data of a table index.
+type IndexStats struct {
+	TableID uint32                              `protobuf:"varint,1,opt,name=table_id" json:"table_id"`
+	IndexID uint32                              `protobuf:"varint,2,opt,name=index_id" json:"index_id"`
+	Stats   cockroach_storage_engine1.MVCCStats `protobuf:"bytes,3,opt,name=stats" json:"stats"`
+}
+
+func (m *IndexStats) Reset()         { *m = IndexStats{} }
+func (m *IndexStats) String() string { return proto.CompactTextString(m) }
+func (*IndexStats) ProtoMessage()    {}
+
 func init() {
 	proto.RegisterType((*StoreStatus)(nil), "cockroach.storage.StoreStatus")
+	proto.RegisterType((*IndexStats)(nil), "cockroach.storage.IndexStats")
 }
 func (m *StoreStatus) Marshal() (data []byte, err error) {
 	size := m.Size()
@@ -103,6 +121,58 @@ func (m *StoreStatus) MarshalTo(data []byte) (int, error) {
 	data[i] = 0x48
 	i++
 	i = encodeVarintStatus(data, i, uint64(m.AvailableRangeCount))
+	data[i] = 0x52
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.Encryption.Size()))
+	n3, err := m.Encryption.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n3
+	if len(m.IndexStats) > 0 {
+		for _, msg := range m.IndexStats {
+			data[i] = 0x5a
+			i++
+			i = encodeVarintStatus(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *IndexStats) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *IndexStats) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.TableID))
+	data[i] = 0x10
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.IndexID))
+	data[i] = 0x1a
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.Stats.Size()))
+	n4, err := m.Stats.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n4
 	return i, nil
 }
 
@@ -147,6 +217,24 @@ func (m *StoreStatus) Size() (n int) {
 	n += 1 + sovStatus(uint64(m.LeaderRangeCount))
 	n += 1 + sovStatus(uint64(m.ReplicatedRangeCount))
 	n += 1 + sovStatus(uint64(m.AvailableRangeCount))
+	l = m.Encryption.Size()
+	n += 1 + l + sovStatus(uint64(l))
+	if len(m.IndexStats) > 0 {
+		for _, e := range m.IndexStats {
+			l = e.Size()
+			n += 1 + l + sovStatus(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IndexStats) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovStatus(uint64(m.TableID))
+	n += 1 + sovStatus(uint64(m.IndexID))
+	l = m.Stats.Size()
+	n += 1 + l + sovStatus(uint64(l))
 	return n
 }
 
@@ -385,6 +473,185 @@ func (m *StoreStatus) Unmarshal(data []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encryption", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStatus
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Encryption.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStatus
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexStats = append(m.IndexStats, IndexStats{})
+			if err := m.IndexStats[len(m.IndexStats)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStatus(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStatus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexStats) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStatus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableID", wireType)
+			}
+			m.TableID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.TableID |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexID", wireType)
+			}
+			m.IndexID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.IndexID |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStatus
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stats.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStatus(data[iNdEx:])
@@ -231,21 +231,25 @@ type Store struct {
 	Ident             roachpb.StoreIdent
 	ctx               StoreContext
 	db                *client.DB
-	engine            engine.Engine   // The underlying key-value store
-	allocator         Allocator       // Makes allocation decisions
-	rangeIDAlloc      *idAllocator    // Range ID allocator
-	gcQueue           *gcQueue        // Garbage collection queue
-	splitQueue        *splitQueue     // Range splitting queue
-	verifyQueue       *verifyQueue    // Checksum verification queue
-	replicateQueue    *replicateQueue // Replication queue
-	replicaGCQueue    *replicaGCQueue // Replica GC queue
-	raftLogQueue      *raftLogQueue   // Raft Log Truncation queue
-	scanner           *replicaScanner // Replica scanner
-	feed              StoreEventFeed  // Event Feed
+	engine            engine.Engine        // The underlying key-value store
+	allocator         Allocator            // Makes allocation decisions
+	rangeIDAlloc      *idAllocator         // Range ID allocator
+	gcQueue           *gcQueue             // Garbage collection queue
+	splitQueue        *splitQueue          // Range splitting queue
+	verifyQueue       *verifyQueue         // Checksum verification queue
+	replicateQueue    *replicateQueue      // Replication queue
+	leaseQueue        *leaseRebalanceQueue // Leader lease rebalancing queue
+	replicaGCQueue    *replicaGCQueue      // Replica GC queue
+	raftLogQueue      *raftLogQueue        // Raft Log Truncation queue
+	scanner           *replicaScanner      // Replica scanner
+	feed              StoreEventFeed       // Event Feed
+	indexStats        indexStatsCache      // Index stats of the leased ranges
 	removeReplicaChan chan removeReplicaOp
 	proposeChan       chan proposeOp
 	multiraft         *multiraft.MultiRaft
 	started           int32
+	draining          int32 // Non-zero while draining; accessed atomically
+	decommissioning   int32 // Non-zero while decommissioning; accessed atomically
 	stopper           *stop.Stopper
 	startedAt         int64
 	nodeDesc          *roachpb.NodeDescriptor
@@ -375,7 +379,8 @@ func NewStore(ctx StoreContext, eng engine.Engine, nodeDesc *roachpb.NodeDescrip
 	s.replicateQueue = newReplicateQueue(s.ctx.Gossip, s.allocator, s.ctx.Clock, s.ctx.AllocatorOptions)
 	s.replicaGCQueue = newReplicaGCQueue(s.db, s.ctx.Gossip, s.RaftLocker())
 	s.raftLogQueue = newRaftLogQueue(s.db, s.ctx.Gossip)
-	s.scanner.AddQueues(s.gcQueue, s.splitQueue, s.verifyQueue, s.replicateQueue, s.replicaGCQueue, s.raftLogQueue)
+	s.leaseQueue = newLeaseRebalanceQueue(s.ctx.Gossip, s.allocator)
+	s.scanner.AddQueues(s.gcQueue, s.splitQueue, s.verifyQueue, s.replicateQueue, s.replicaGCQueue, s.raftLogQueue, s.leaseQueue)
 
 	return s
 }
@@ -425,6 +430,13 @@ func (s *Store) Start(stopper *stop.Stopper) error {
 		} else if !ok {
 			return &NotBootstrappedError{}
 		}
+
+		// Restore the decommissioning state of the node.
+		value, _, err := engine.MVCCGet(s.engine, keys.StoreDecommissioningKey(), roachpb.ZeroTimestamp, true, nil)
+		if err != nil {
+			return err
+		}
+		s.setDecommissioning(value != nil)
 	}
 
 	// If the nodeID is 0, it has not be assigned yet.
@@ -1206,11 +1218,16 @@ func (s *Store) Descriptor() (*roachpb.StoreDescriptor, error) {
 		return nil, err
 	}
 	capacity.RangeCount = int32(s.ReplicaCount())
+	capacity.LeaseCount = int32(s.LeaseCount())
+	// The node descriptor is shared with the node and the other stores, so
+	// the decommissioning flag is applied to a copy.
+	node := *s.nodeDesc
+	node.Decommissioning = s.IsDecommissioning()
 	// Initialize the store descriptor.
 	return &roachpb.StoreDescriptor{
 		StoreID:  s.Ident.StoreID,
 		Attrs:    s.Attrs(),
-		Node:     *s.nodeDesc,
+		Node:     node,
 		Capacity: capacity,
 	}, nil
 }
@@ -1222,6 +1239,106 @@ func (s *Store) ReplicaCount() int {
 	return len(s.replicas)
 }
 
+// LeaseCount returns the number of replicas on this store which currently
+// hold the leader lease for their range.
+func (s *Store) LeaseCount() int {
+	now := s.ctx.Clock.Now()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var count int
+	for _, r := range s.replicas {
+		if lease := r.getLease(); lease.OwnedBy(s.StoreID()) && lease.Covers(now) {
+			count++
+		}
+	}
+	return count
+}
+
+// SetDraining (when true) prevents the store from acquiring leader
+// leases and transfers the leases it currently holds to other replicas
+// of their ranges. It returns the number of leases which could not be
+// transferred; those are left to expire.
+func (s *Store) SetDraining(drain bool) int {
+	if !drain {
+		atomic.StoreInt32(&s.draining, 0)
+		return 0
+	}
+	atomic.StoreInt32(&s.draining, 1)
+
+	now := s.ctx.Clock.Now()
+	var held []*Replica
+	s.mu.RLock()
+	for _, r := range s.replicas {
+		if lease := r.getLease(); lease.OwnedBy(s.StoreID()) && lease.Covers(now) {
+			held = append(held, r)
+		}
+	}
+	s.mu.RUnlock()
+
+	sysCfg := s.ctx.Gossip.GetSystemConfig()
+	var remaining int
+	for _, r := range held {
+		desc := r.Desc()
+		var preferences []roachpb.Attributes
+		if sysCfg != nil {
+			if zone, err := sysCfg.GetZoneConfigForKey(desc.StartKey); err == nil {
+				preferences = zone.LeasePreferences
+			}
+		}
+		target := s.allocator.TransferLeaseTarget(preferences, desc.Replicas, s.StoreID(), true)
+		if target == nil {
+			remaining++
+			continue
+		}
+		if err := r.AdminTransferLease(target.StoreID); err != nil {
+			log.Warningf("unable to transfer leader lease of range %d to store %d: %s",
+				desc.RangeID, target.StoreID, err)
+			remaining++
+		}
+	}
+	return remaining
+}
+
+// IsDraining returns true if the store is draining its leader leases.
+func (s *Store) IsDraining() bool {
+	return atomic.LoadInt32(&s.draining) != 0
+}
+
+// SetDecommissioning sets whether the store's node is being
+// decommissioned, which is reflected in the node descriptor embedded in
+// the store descriptor returned by Descriptor. The state is persisted in
+// the store, and restored when the store is started.
+func (s *Store) SetDecommissioning(decommission bool) error {
+	key := keys.StoreDecommissioningKey()
+	var err error
+	if decommission {
+		var value roachpb.Value
+		value.SetInt(1)
+		err = engine.MVCCPut(s.engine, nil, key, roachpb.ZeroTimestamp, value, nil)
+	} else {
+		err = engine.MVCCDelete(s.engine, nil, key, roachpb.ZeroTimestamp, nil)
+	}
+	if err != nil {
+		return err
+	}
+	s.setDecommissioning(decommission)
+	return nil
+}
+
+func (s *Store) setDecommissioning(decommission bool) {
+	var v int32
+	if decommission {
+		v = 1
+	}
+	atomic.StoreInt32(&s.decommissioning, v)
+}
+
+// IsDecommissioning returns true if the store's node is being
+// decommissioned.
+func (s *Store) IsDecommissioning() bool {
+	return atomic.LoadInt32(&s.decommissioning) != 0
+}
+
 // Send fetches a range based on the header's replica, assembles
 // method, args & reply into a Raft Cmd struct and executes the
 // command using the fetched range.
@@ -1800,7 +1917,7 @@ func (s *Store) computeReplicationStatus(now int64) (
 			// TODO(bram): Compare attributes of the stores so we can track
 			// ranges that have enough replicas but still need to be migrated
 			// onto nodes with the desired attributes.
-			if len(raftStatus.Progress) >= len(zoneConfig.ReplicaAttrs) {
+			if len(raftStatus.Progress) >= zoneConfig.ReplicaCount() {
 				replicatedRangeCount++
 			}
 
@@ -1827,22 +1944,61 @@ func (s *Store) computeReplicationStatus(now int64) (
 	return
 }
 
+// computeRaftLogSize returns the total size in bytes of the raft logs of
+// the replicas in this store.
+func (s *Store) computeRaftLogSize() int64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var size int64
+	for _, rng := range s.replicas {
+		size += rng.RaftLogSize()
+	}
+	return size
+}
+
+// encryptedEngine is implemented by the engines which can encrypt their
+// files at rest.
+type encryptedEngine interface {
+	EncryptionStatus() (engine.EncryptionStatus, error)
+}
+
 // PublishStatus publishes periodically computed status events to the store's
 // events feed. This method itself should be periodically called by some
 // external mechanism.
 func (s *Store) PublishStatus() error {
-	// broadcast store descriptor.
+	// broadcast store descriptor and encryption status.
 	desc, err := s.Descriptor()
 	if err != nil {
 		return err
 	}
-	s.feed.storeStatus(desc)
+	var encryption engine.EncryptionStatus
+	if e, ok := s.engine.(encryptedEngine); ok {
+		if encryption, err = e.EncryptionStatus(); err != nil {
+			return err
+		}
+	}
+	s.feed.storeStatus(desc, encryption)
 
 	// broadcast replication status.
 	now := s.ctx.Clock.Now().WallTime
 	leaderRangeCount, replicatedRangeCount, availableRangeCount :=
 		s.computeReplicationStatus(now)
-	s.feed.replicationStatus(leaderRangeCount, replicatedRangeCount, availableRangeCount)
+	s.feed.replicationStatus(leaderRangeCount, replicatedRangeCount, availableRangeCount,
+		s.computeRaftLogSize())
+
+	// broadcast engine statistics.
+	stats, err := s.engine.GetStats()
+	if err != nil {
+		return err
+	}
+	s.feed.engineStats(*stats)
+
+	// broadcast index statistics.
+	indexStats, err := s.computeIndexStats(now)
+	if err != nil {
+		return err
+	}
+	s.feed.indexStats(indexStats)
 	return nil
 }
 
@@ -283,11 +283,11 @@ func (s *stat) update(x float64) {
 	s.mean += (x - s.mean) / s.n
 }
 
-// StoreList holds a list of store descriptors and associated count and used
-// stats for those stores.
+// StoreList holds a list of store descriptors and associated count, used
+// and lease count stats for those stores.
 type StoreList struct {
-	stores      []*roachpb.StoreDescriptor
-	count, used stat
+	stores                  []*roachpb.StoreDescriptor
+	count, used, leaseCount stat
 }
 
 // add includes the store descriptor to the list of stores and updates
@@ -296,6 +296,7 @@ func (sl *StoreList) add(s *roachpb.StoreDescriptor) {
 	sl.stores = append(sl.stores, s)
 	sl.count.update(float64(s.Capacity.RangeCount))
 	sl.used.update(s.Capacity.FractionUsed())
+	sl.leaseCount.update(float64(s.Capacity.LeaseCount))
 }
 
 // GetStoreList returns a storeList that contains all active stores that
@@ -319,7 +320,9 @@ func (sp *StorePool) getStoreList(required roachpb.Attributes, deterministic boo
 	sl := StoreList{}
 	for _, storeID := range storeIDs {
 		detail := sp.stores[roachpb.StoreID(storeID)]
-		if !detail.dead && required.IsSubset(*detail.desc.CombinedAttrs()) {
+		// Stores of decommissioning nodes are never suitable targets.
+		if !detail.dead && !detail.desc.Node.Decommissioning &&
+			required.IsSubset(*detail.desc.CombinedAttrs()) {
 			desc := detail.desc
 			sl.add(&desc)
 		}
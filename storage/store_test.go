@@ -216,6 +216,49 @@ func TestStoreInitAndBootstrap(t *testing.T) {
 	}
 }
 
+// TestStoreDecommissioningPersisted verifies that the decommissioning
+// state of a store is restored when it's started.
+func TestStoreDecommissioningPersisted(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	ctx := TestStoreContext
+	manual := hlc.NewManualClock(0)
+	ctx.Clock = hlc.NewClock(manual.UnixNano)
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+	eng := engine.NewInMem(roachpb.Attributes{}, 1<<20, stopper)
+	ctx.Transport = multiraft.NewLocalRPCTransport(stopper)
+	stopper.AddCloser(ctx.Transport)
+	store := NewStore(ctx, eng, &roachpb.NodeDescriptor{NodeID: 1})
+	if err := store.Bootstrap(testIdent, stopper); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.BootstrapRange(nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.SetDecommissioning(true); err != nil {
+		t.Fatal(err)
+	}
+
+	store = NewStore(ctx, eng, &roachpb.NodeDescriptor{NodeID: 1})
+	if err := store.Start(stopper); err != nil {
+		t.Fatal(err)
+	}
+	if !store.IsDecommissioning() {
+		t.Fatal("expected the restarted store to be decommissioning")
+	}
+	if desc, err := store.Descriptor(); err != nil || !desc.Node.Decommissioning {
+		t.Fatalf("expected a decommissioning node descriptor; got %+v, %v", desc, err)
+	}
+
+	if err := store.SetDecommissioning(false); err != nil {
+		t.Fatal(err)
+	}
+	value, _, err := engine.MVCCGet(eng, keys.StoreDecommissioningKey(), roachpb.ZeroTimestamp, true, nil)
+	if err != nil || value != nil {
+		t.Fatalf("expected the decommissioning state to be cleared; got %v, %v", value, err)
+	}
+}
+
 // TestBootstrapOfNonEmptyStore verifies bootstrap failure if engine
 // is not empty.
 func TestBootstrapOfNonEmptyStore(t *testing.T) {